@@ -93,6 +93,13 @@ func (p *mockStatusProvider) GetAllTaskStatuses(ctx context.Context, changefeedI
 	return args.Get(0).(map[model.CaptureID]*model.TaskStatus), args.Error(1)
 }
 
+func (p *mockStatusProvider) GetSpanStatuses(ctx context.Context, changefeedID model.ChangeFeedID) (
+	[]*model.SpanReplicationStatus, error,
+) {
+	args := p.Called(ctx)
+	return args.Get(0).([]*model.SpanReplicationStatus), args.Error(1)
+}
+
 func (p *mockStatusProvider) GetProcessors(ctx context.Context) ([]*model.ProcInfoSnap, error) {
 	args := p.Called(ctx)
 	return args.Get(0).([]*model.ProcInfoSnap), args.Error(1)
@@ -60,8 +60,13 @@ func RegisterOpenAPIV2Routes(router *gin.Engine, api OpenAPIV2) {
 	changefeedGroup.GET("/:changefeed_id/meta_info", ownerMiddleware, api.getChangeFeedMetaInfo)
 	changefeedGroup.POST("/:changefeed_id/resume", ownerMiddleware, authenticateMiddleware, api.resumeChangefeed)
 	changefeedGroup.POST("/:changefeed_id/pause", ownerMiddleware, authenticateMiddleware, api.pauseChangefeed)
+	changefeedGroup.POST("/:changefeed_id/clone", ownerMiddleware, authenticateMiddleware, api.cloneChangefeed)
 	changefeedGroup.GET("/:changefeed_id/status", ownerMiddleware, api.status)
 	changefeedGroup.GET("/:changefeed_id/synced", ownerMiddleware, api.synced)
+	changefeedGroup.GET("/:changefeed_id/lag", ownerMiddleware, api.getChangefeedLag)
+	changefeedGroup.GET("/:changefeed_id/tables", ownerMiddleware, api.listTables)
+	changefeedGroup.POST("/:changefeed_id/tables/move", ownerMiddleware, authenticateMiddleware, api.moveTable)
+	changefeedGroup.POST("/:changefeed_id/tables/rebalance", ownerMiddleware, authenticateMiddleware, api.rebalanceTables)
 
 	// capture apis
 	captureGroup := v2.Group("/captures")
@@ -36,6 +36,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/filter"
 	"github.com/pingcap/tiflow/pkg/security"
 	"github.com/pingcap/tiflow/pkg/sink"
+	"github.com/pingcap/tiflow/pkg/transform"
 	"github.com/pingcap/tiflow/pkg/txnutil/gc"
 	"github.com/pingcap/tiflow/pkg/util"
 	"github.com/pingcap/tiflow/pkg/version"
@@ -559,6 +560,15 @@ func (h APIV2HelpersImpl) getVerifiedTables(
 	if err != nil {
 		return nil, nil, err
 	}
+	transformer, err := transform.New(replicaConfig, 0, time.UTC)
+	if err != nil {
+		return nil, nil, err
+	}
+	if transformer != nil {
+		if err = transformer.VerifyTables(tableInfos); err != nil {
+			return nil, nil, err
+		}
+	}
 	if !sink.IsMQScheme(scheme) {
 		return ineligibleTables, eligibleTables, nil
 	}
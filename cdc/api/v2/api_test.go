@@ -69,6 +69,8 @@ type mockStatusProvider struct {
 	changefeedInfos        map[model.ChangeFeedID]*model.ChangeFeedInfo
 	changefeedStatuses     map[model.ChangeFeedID]*model.ChangeFeedStatusForAPI
 	changeFeedSyncedStatus *model.ChangeFeedSyncedStatusForAPI
+	spanStatuses           []*model.SpanReplicationStatus
+	captures               []*model.CaptureInfo
 	err                    error
 }
 
@@ -128,3 +130,17 @@ func (m *mockStatusProvider) GetChangeFeedSyncedStatus(_ context.Context, change
 ) {
 	return m.changeFeedSyncedStatus, m.err
 }
+
+func (m *mockStatusProvider) GetSpanStatuses(_ context.Context, changefeedID model.ChangeFeedID) (
+	[]*model.SpanReplicationStatus,
+	error,
+) {
+	return m.spanStatuses, m.err
+}
+
+func (m *mockStatusProvider) GetCaptures(_ context.Context) (
+	[]*model.CaptureInfo,
+	error,
+) {
+	return m.captures, m.err
+}
@@ -61,13 +61,102 @@ const (
 // @Failure 500,400 {object} model.HTTPError
 // @Router	/api/v2/changefeeds [post]
 func (h *OpenAPIV2) createChangefeed(c *gin.Context) {
-	ctx := c.Request.Context()
 	cfg := &ChangefeedConfig{ReplicaConfig: GetDefaultReplicaConfig()}
 
 	if err := c.BindJSON(&cfg); err != nil {
 		_ = c.Error(cerror.WrapError(cerror.ErrAPIInvalidParam, err))
 		return
 	}
+	h.createChangefeedWithConfig(c, cfg)
+}
+
+// cloneChangefeed handles clone changefeed request, it creates a new changefeed
+// which starts at the checkpoint ts of the source changefeed.
+// @Summary Clone a changefeed
+// @Description create a new changefeed from the checkpoint ts and config of an existing changefeed
+// @Tags changefeed,v2
+// @Accept json
+// @Produce json
+// @Param changefeed_id  path  string  true  "changefeed_id"
+// @Param namespace query string false "default"
+// @Param cloneConfig body CloneChangefeedConfig true "clone changefeed config"
+// @Success 200 {object} ChangeFeedInfo
+// @Failure 500,400 {object} model.HTTPError
+// @Router /api/v2/changefeeds/{changefeed_id}/clone [post]
+func (h *OpenAPIV2) cloneChangefeed(c *gin.Context) {
+	ctx := c.Request.Context()
+	namespace := getNamespaceValueWithDefault(c)
+	changefeedID := model.ChangeFeedID{Namespace: namespace, ID: c.Param(api.APIOpVarChangefeedID)}
+	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
+			changefeedID.ID))
+		return
+	}
+
+	cloneCfg := &CloneChangefeedConfig{}
+	if err := c.BindJSON(cloneCfg); err != nil {
+		_ = c.Error(cerror.WrapError(cerror.ErrAPIInvalidParam, err))
+		return
+	}
+
+	cfInfo, err := h.capture.StatusProvider().GetChangeFeedInfo(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	status, err := h.capture.StatusProvider().GetChangeFeedStatus(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	cfg := &ChangefeedConfig{
+		Namespace:     changefeedID.Namespace,
+		ID:            cloneCfg.ID,
+		SinkURI:       cfInfo.SinkURI,
+		StartTs:       status.CheckpointTs,
+		TargetTs:      cfInfo.TargetTs,
+		ReplicaConfig: ToAPIReplicaConfig(cfInfo.Config),
+	}
+	if cloneCfg.SinkURI != "" {
+		cfg.SinkURI = cloneCfg.SinkURI
+	}
+	if cloneCfg.Filter != nil {
+		cfg.ReplicaConfig.Filter = cloneCfg.Filter
+	}
+
+	// the new changefeed replicates from the same upstream as the source one.
+	up, err := getCaptureDefaultUpstream(h.capture)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	if up.ID != cfInfo.UpstreamID {
+		upInfo, err := h.capture.GetUpstreamInfo(ctx, cfInfo.UpstreamID, cfInfo.Namespace)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+		cfg.PDConfig = PDConfig{
+			PDAddrs:       strings.Split(upInfo.PDEndpoints, ","),
+			CAPath:        upInfo.CAPath,
+			CertPath:      upInfo.CertPath,
+			KeyPath:       upInfo.KeyPath,
+			CertAllowedCN: upInfo.CertAllowedCN,
+		}
+	}
+
+	log.Info("clone changefeed",
+		zap.String("namespace", changefeedID.Namespace),
+		zap.String("source", changefeedID.ID),
+		zap.String("changefeed", cfg.ID),
+		zap.Uint64("checkpointTs", status.CheckpointTs))
+	h.createChangefeedWithConfig(c, cfg)
+}
+
+// createChangefeedWithConfig verifies the config and creates a changefeed.
+func (h *OpenAPIV2) createChangefeedWithConfig(c *gin.Context, cfg *ChangefeedConfig) {
+	ctx := c.Request.Context()
 	var pdClient pd.Client
 	var kvStorage kv.Storage
 	// if PDAddrs is empty, use the default pdClient
@@ -947,11 +1036,13 @@ func (h *OpenAPIV2) status(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, &ChangefeedStatus{
-		State:        string(info.State),
-		CheckpointTs: status.CheckpointTs,
-		ResolvedTs:   status.ResolvedTs,
-		LastError:    lastError,
-		LastWarning:  lastWarning,
+		State:           string(info.State),
+		CheckpointTs:    status.CheckpointTs,
+		ResolvedTs:      status.ResolvedTs,
+		LastError:       lastError,
+		LastWarning:     lastWarning,
+		DeadLetterCount: status.DeadLetterCount,
+		RateLimited:     status.RateLimited,
 	})
 }
 
@@ -252,6 +252,124 @@ func TestCreateChangefeed(t *testing.T) {
 	require.Equal(t, http.StatusOK, w.Code)
 }
 
+func TestCloneChangefeed(t *testing.T) {
+	t.Parallel()
+	clone := testCase{url: "/api/v2/changefeeds/%s/clone?namespace=abc", method: "POST"}
+
+	pdClient := &mockPDClient{}
+	helpers := NewMockAPIV2Helpers(gomock.NewController(t))
+	cp := mock_capture.NewMockCapture(gomock.NewController(t))
+	etcdClient := mock_etcd.NewMockCDCEtcdClient(gomock.NewController(t))
+	apiV2 := NewOpenAPIV2ForTest(cp, helpers)
+	router := newRouter(apiV2)
+	integration.BeforeTestExternal(t)
+	testEtcdCluster := integration.NewClusterV3(
+		t, &integration.ClusterConfig{Size: 1},
+	)
+	defer testEtcdCluster.Terminate(t)
+
+	mockUpManager := upstream.NewManager4Test(pdClient)
+	statusProvider := &mockStatusProvider{
+		changefeedInfo: &model.ChangeFeedInfo{
+			Namespace: changeFeedID.Namespace,
+			ID:        changeFeedID.ID,
+			SinkURI:   blackholeSink,
+			Config:    config.GetDefaultReplicaConfig(),
+		},
+		changefeedStatus: &model.ChangeFeedStatusForAPI{CheckpointTs: 1000},
+	}
+	etcdClient.EXPECT().
+		GetEnsureGCServiceID(gomock.Any()).
+		Return(etcd.GcServiceIDForTest()).AnyTimes()
+	etcdClient.EXPECT().GetEtcdClient().
+		Return(etcd.Wrap(testEtcdCluster.RandClient(), nil)).AnyTimes()
+	cp.EXPECT().GetEtcdClient().Return(etcdClient).AnyTimes()
+	cp.EXPECT().GetUpstreamManager().Return(mockUpManager, nil).AnyTimes()
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+	mo := mock_owner.NewMockOwner(gomock.NewController(t))
+	cp.EXPECT().GetOwner().Return(mo, nil).AnyTimes()
+	cp.EXPECT().StatusProvider().Return(statusProvider).AnyTimes()
+
+	// Mock UpstreamDownstreamNotSame check
+	oldGetClusterID := check.GetGetClusterIDBySinkURIFn()
+	defer func() { check.SetGetClusterIDBySinkURIFnForTest(oldGetClusterID) }()
+	check.SetGetClusterIDBySinkURIFnForTest(
+		func(_ context.Context, _ string, _ model.ChangeFeedID, _ *config.ReplicaConfig) (uint64, bool, error) {
+			return 0, false, nil
+		})
+
+	// case 1: invalid changefeed id
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(), clone.method,
+		fmt.Sprintf(clone.url, "@^Invalid"), bytes.NewReader([]byte("{}")))
+	router.ServeHTTP(w, req)
+	respErr := model.HTTPError{}
+	err := json.NewDecoder(w.Body).Decode(&respErr)
+	require.Nil(t, err)
+	require.Contains(t, respErr.Code, "ErrAPIInvalidParam")
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	// case 2: the start ts is not covered by the gc safepoint
+	helpers.EXPECT().
+		verifyCreateChangefeedConfig(gomock.Any(), gomock.Any(), gomock.Any(),
+			gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, cerrors.ErrStartTsBeforeGC.GenWithStackByArgs(1000, 2000)).Times(1)
+	cloneCfg := &CloneChangefeedConfig{ID: "cloned"}
+	body, err := json.Marshal(cloneCfg)
+	require.Nil(t, err)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(), clone.method,
+		fmt.Sprintf(clone.url, changeFeedID.ID), bytes.NewReader(body))
+	router.ServeHTTP(w, req)
+	respErr = model.HTTPError{}
+	err = json.NewDecoder(w.Body).Decode(&respErr)
+	require.Nil(t, err)
+	require.Contains(t, respErr.Code, "ErrStartTsBeforeGC")
+
+	// case 3: success, the sink uri and filter are overridden
+	helpers.EXPECT().
+		verifyCreateChangefeedConfig(gomock.Any(), gomock.Any(), gomock.Any(),
+			gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context,
+			cfg *ChangefeedConfig,
+			pdClient pd.Client,
+			provider owner.StatusProvider,
+			ensureGCServiceID string,
+			kvStorage tidbkv.Storage,
+		) (*model.ChangeFeedInfo, error) {
+			require.Equal(t, "cloned", cfg.ID)
+			require.Equal(t, changeFeedID.Namespace, cfg.Namespace)
+			require.Equal(t, uint64(1000), cfg.StartTs)
+			require.Equal(t, mysqlSink, cfg.SinkURI)
+			require.Equal(t, []string{"test.*"}, cfg.ReplicaConfig.Filter.Rules)
+			require.Empty(t, cfg.PDAddrs)
+			return &model.ChangeFeedInfo{
+				ID:        cfg.ID,
+				Namespace: cfg.Namespace,
+				SinkURI:   cfg.SinkURI,
+				StartTs:   cfg.StartTs,
+			}, nil
+		}).Times(1)
+	mo.EXPECT().
+		CreateChangefeed(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil).Times(1)
+	cloneCfg.SinkURI = mysqlSink
+	cloneCfg.Filter = &FilterConfig{Rules: []string{"test.*"}}
+	body, err = json.Marshal(cloneCfg)
+	require.Nil(t, err)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(), clone.method,
+		fmt.Sprintf(clone.url, changeFeedID.ID), bytes.NewReader(body))
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := ChangeFeedInfo{}
+	err = json.NewDecoder(w.Body).Decode(&resp)
+	require.Nil(t, err)
+	require.Equal(t, "cloned", resp.ID)
+	require.Equal(t, uint64(1000), resp.StartTs)
+}
+
 func TestGetChangeFeed(t *testing.T) {
 	t.Parallel()
 
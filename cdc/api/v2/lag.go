@@ -0,0 +1,130 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"encoding/hex"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/tiflow/cdc/api"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+// getChangefeedLag returns the lag breakdown of all table spans of a changefeed
+// @Summary Get the lag breakdown of a changefeed
+// @Description get the progress of each stage of all table spans of a changefeed,
+// @Description the spans with the largest checkpoint lag are listed first
+// @Tags changefeed,v2
+// @Produce json
+// @Param changefeed_id  path  string  true  "changefeed_id"
+// @Param namespace query string false "default"
+// @Param capture_id query string false "only list spans replicated by the capture"
+// @Success 200 {array} TableSpanLag
+// @Failure 500,400 {object} model.HTTPError
+// @Router /api/v2/changefeeds/{changefeed_id}/lag [get]
+func (h *OpenAPIV2) getChangefeedLag(c *gin.Context) {
+	ctx := c.Request.Context()
+	namespace := getNamespaceValueWithDefault(c)
+	changefeedID := model.ChangeFeedID{Namespace: namespace, ID: c.Param(api.APIOpVarChangefeedID)}
+	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
+			changefeedID.ID))
+		return
+	}
+
+	statuses, err := h.capture.StatusProvider().GetSpanStatuses(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	captureID := c.Query(apiOpVarCaptureID)
+	statuses = filterSpanStatuses(statuses, captureID)
+	// The worst offenders first.
+	sort.SliceStable(statuses, func(i, j int) bool {
+		if statuses[i].CheckpointLag != statuses[j].CheckpointLag {
+			return statuses[i].CheckpointLag > statuses[j].CheckpointLag
+		}
+		return statuses[i].MemoryQuotaWait > statuses[j].MemoryQuotaWait
+	})
+
+	lags := make([]TableSpanLag, 0, len(statuses))
+	for _, status := range statuses {
+		lags = append(lags, TableSpanLag{
+			TableID:          status.Span.TableID,
+			StartKey:         hex.EncodeToString(status.Span.StartKey),
+			EndKey:           hex.EncodeToString(status.Span.EndKey),
+			CaptureID:        status.CaptureID,
+			CheckpointLag:    status.CheckpointLag.Milliseconds(),
+			PullerResolvedTs: status.PullerResolvedTs,
+			SorterResolvedTs: status.SorterResolvedTs,
+			SinkCheckpointTs: status.SinkCheckpointTs,
+			RedoResolvedTs:   status.RedoResolvedTs,
+			MemoryQuotaWait:  status.MemoryQuotaWait.Milliseconds(),
+			Bottleneck:       lagBottleneck(status),
+		})
+	}
+	c.JSON(http.StatusOK, &ListResponse[TableSpanLag]{
+		Total: len(lags),
+		Items: lags,
+	})
+}
+
+func filterSpanStatuses(
+	statuses []*model.SpanReplicationStatus, captureID string,
+) []*model.SpanReplicationStatus {
+	if captureID == "" {
+		return statuses
+	}
+	ret := make([]*model.SpanReplicationStatus, 0, len(statuses))
+	for _, status := range statuses {
+		if status.CaptureID == captureID {
+			ret = append(ret, status)
+		}
+	}
+	return ret
+}
+
+// lagBottleneck returns the stage which contributes the most to the lag of
+// the span. The lag is split into the gaps between adjacent stages:
+// now -> puller -> sorter -> redo -> sink.
+func lagBottleneck(status *model.SpanReplicationStatus) string {
+	if status.PullerResolvedTs == 0 || status.SorterResolvedTs == 0 || status.SinkCheckpointTs == 0 {
+		// The stage progresses are not collected yet.
+		return ""
+	}
+	physical := func(ts model.Ts) time.Time {
+		return oracle.GetTimeFromTS(ts)
+	}
+	now := physical(status.CheckpointTs).Add(status.CheckpointLag)
+
+	bottleneck, maxGap := LagStagePuller, now.Sub(physical(status.PullerResolvedTs))
+	check := func(stage string, gap time.Duration) {
+		if gap > maxGap {
+			bottleneck, maxGap = stage, gap
+		}
+	}
+	check(LagStageSorter, physical(status.PullerResolvedTs).Sub(physical(status.SorterResolvedTs)))
+	upstream := status.SorterResolvedTs
+	if status.RedoResolvedTs != 0 {
+		check(LagStageRedo, physical(status.SorterResolvedTs).Sub(physical(status.RedoResolvedTs)))
+		upstream = status.RedoResolvedTs
+	}
+	check(LagStageSink, physical(upstream).Sub(physical(status.SinkCheckpointTs)))
+	return bottleneck
+}
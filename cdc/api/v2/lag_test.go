@@ -0,0 +1,149 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mock_capture "github.com/pingcap/tiflow/cdc/capture/mock"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
+	cerrors "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+func TestGetChangefeedLag(t *testing.T) {
+	t.Parallel()
+
+	lag := testCase{url: "/api/v2/changefeeds/%s/lag?namespace=abc", method: "GET"}
+	cp := mock_capture.NewMockCapture(gomock.NewController(t))
+	apiV2 := NewOpenAPIV2ForTest(cp, APIV2HelpersImpl{})
+	router := newRouter(apiV2)
+	statusProvider := &mockStatusProvider{}
+	cp.EXPECT().StatusProvider().Return(statusProvider).AnyTimes()
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+
+	// case 1: changefeed not exists
+	statusProvider.err = cerrors.ErrChangeFeedNotExists.GenWithStackByArgs(changeFeedID.ID)
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(), lag.method,
+		fmt.Sprintf(lag.url, changeFeedID.ID), nil)
+	router.ServeHTTP(w, req)
+	respErr := model.HTTPError{}
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&respErr))
+	require.Contains(t, respErr.Code, "ErrChangeFeedNotExists")
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	// case 2: the worst offenders first
+	now := time.Now()
+	ts := func(d time.Duration) uint64 {
+		return oracle.GoTimeToTS(now.Add(-d))
+	}
+	statusProvider.err = nil
+	statusProvider.spanStatuses = []*model.SpanReplicationStatus{{
+		Span:             tablepb.Span{TableID: 1, StartKey: []byte{1}, EndKey: []byte{2}},
+		CaptureID:        "a",
+		CheckpointTs:     ts(time.Second),
+		CheckpointLag:    time.Second,
+		PullerResolvedTs: ts(100 * time.Millisecond),
+		SorterResolvedTs: ts(200 * time.Millisecond),
+		SinkCheckpointTs: ts(time.Second),
+	}, {
+		Span:             tablepb.Span{TableID: 2, StartKey: []byte{3}, EndKey: []byte{4}},
+		CaptureID:        "b",
+		CheckpointTs:     ts(time.Minute),
+		CheckpointLag:    time.Minute,
+		PullerResolvedTs: ts(50 * time.Second),
+		SorterResolvedTs: ts(50 * time.Second),
+		SinkCheckpointTs: ts(time.Minute),
+		MemoryQuotaWait:  2 * time.Second,
+	}, {
+		Span:          tablepb.Span{TableID: 3, StartKey: []byte{5}, EndKey: []byte{6}},
+		CaptureID:     "a",
+		CheckpointTs:  ts(10 * time.Second),
+		CheckpointLag: 10 * time.Second,
+	}}
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(), lag.method,
+		fmt.Sprintf(lag.url, changeFeedID.ID), nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := ListResponse[TableSpanLag]{}
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	require.Equal(t, 3, resp.Total)
+	require.Equal(t, TableSpanLag{
+		TableID:          2,
+		StartKey:         "03",
+		EndKey:           "04",
+		CaptureID:        "b",
+		CheckpointLag:    60000,
+		PullerResolvedTs: ts(50 * time.Second),
+		SorterResolvedTs: ts(50 * time.Second),
+		SinkCheckpointTs: ts(time.Minute),
+		MemoryQuotaWait:  2000,
+		Bottleneck:       LagStagePuller,
+	}, resp.Items[0])
+	require.Equal(t, int64(3), resp.Items[1].TableID)
+	require.Empty(t, resp.Items[1].Bottleneck)
+	require.Equal(t, int64(1), resp.Items[2].TableID)
+	require.Equal(t, LagStageSink, resp.Items[2].Bottleneck)
+
+	// case 3: filter by capture
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(), lag.method,
+		fmt.Sprintf(lag.url+"&capture_id=a", changeFeedID.ID), nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp = ListResponse[TableSpanLag]{}
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	require.Equal(t, 2, resp.Total)
+	require.Equal(t, int64(3), resp.Items[0].TableID)
+	require.Equal(t, int64(1), resp.Items[1].TableID)
+}
+
+func TestLagBottleneck(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	ts := func(d time.Duration) uint64 {
+		return oracle.GoTimeToTS(now.Add(-d))
+	}
+	status := &model.SpanReplicationStatus{
+		CheckpointTs:     ts(10 * time.Second),
+		CheckpointLag:    10 * time.Second,
+		PullerResolvedTs: ts(time.Second),
+		SorterResolvedTs: ts(8 * time.Second),
+		SinkCheckpointTs: ts(10 * time.Second),
+	}
+	require.Equal(t, LagStageSorter, lagBottleneck(status))
+
+	status.SorterResolvedTs = ts(2 * time.Second)
+	status.RedoResolvedTs = ts(9 * time.Second)
+	require.Equal(t, LagStageRedo, lagBottleneck(status))
+
+	status.RedoResolvedTs = ts(3 * time.Second)
+	require.Equal(t, LagStageSink, lagBottleneck(status))
+
+	status.SinkCheckpointTs = 0
+	require.Empty(t, lagBottleneck(status))
+}
@@ -82,6 +82,16 @@ type ResumeChangefeedConfig struct {
 	OverwriteCheckpointTs uint64 `json:"overwrite_checkpoint_ts"`
 }
 
+// CloneChangefeedConfig is used by clone changefeed api
+type CloneChangefeedConfig struct {
+	// ID is the ID of the new changefeed, a random one is generated if empty.
+	ID string `json:"changefeed_id"`
+	// SinkURI overrides the sink uri of the source changefeed if it is not empty.
+	SinkURI string `json:"sink_uri,omitempty"`
+	// Filter overrides the filter config of the source changefeed if it is not nil.
+	Filter *FilterConfig `json:"filter,omitempty"`
+}
+
 // PDConfig is a configuration used to connect to pd
 type PDConfig struct {
 	PDAddrs       []string `json:"pd_addrs,omitempty"`
@@ -150,6 +160,56 @@ type ProcessorCommonInfo struct {
 	CaptureID    string `json:"capture_id"`
 }
 
+// TableSpanStatus holds the replication status of a table span
+type TableSpanStatus struct {
+	TableID int64 `json:"table_id"`
+	// StartKey and EndKey are hex encoded keys of the span.
+	StartKey     string `json:"start_key"`
+	EndKey       string `json:"end_key"`
+	CaptureID    string `json:"capture_id"`
+	State        string `json:"state"`
+	CheckpointTs uint64 `json:"checkpoint_ts"`
+	ResolvedTs   uint64 `json:"resolved_ts"`
+	// CheckpointLag is the lag of checkpoint ts in milliseconds.
+	CheckpointLag int64 `json:"checkpoint_lag"`
+}
+
+// Stages of a table span which may be responsible for the lag.
+const (
+	LagStagePuller = "puller"
+	LagStageSorter = "sorter"
+	LagStageRedo   = "redo"
+	LagStageSink   = "sink"
+)
+
+// TableSpanLag holds the lag breakdown of a table span
+type TableSpanLag struct {
+	TableID int64 `json:"table_id"`
+	// StartKey and EndKey are hex encoded keys of the span.
+	StartKey  string `json:"start_key"`
+	EndKey    string `json:"end_key"`
+	CaptureID string `json:"capture_id"`
+	// CheckpointLag is the lag of checkpoint ts in milliseconds.
+	CheckpointLag    int64  `json:"checkpoint_lag"`
+	PullerResolvedTs uint64 `json:"puller_resolved_ts"`
+	SorterResolvedTs uint64 `json:"sorter_resolved_ts"`
+	SinkCheckpointTs uint64 `json:"sink_checkpoint_ts"`
+	// RedoResolvedTs is 0 if the redo log is disabled.
+	RedoResolvedTs uint64 `json:"redo_resolved_ts"`
+	// MemoryQuotaWait is the total time in milliseconds the span has
+	// waited for the memory quota.
+	MemoryQuotaWait int64 `json:"memory_quota_wait"`
+	// Bottleneck is the stage which contributes the most to the lag,
+	// it is empty if the stage progresses are not collected yet.
+	Bottleneck string `json:"bottleneck,omitempty"`
+}
+
+// MoveTableConfig is used by move table api
+type MoveTableConfig struct {
+	TableID   int64  `json:"table_id"`
+	CaptureID string `json:"capture_id"`
+}
+
 // JSONDuration used to wrap duration into json format
 type JSONDuration struct {
 	duration time.Duration
@@ -204,6 +264,8 @@ type ReplicaConfig struct {
 	Integrity                    *IntegrityConfig           `json:"integrity"`
 	ChangefeedErrorStuckDuration *JSONDuration              `json:"changefeed_error_stuck_duration,omitempty"`
 	SyncedStatus                 *SyncedStatusConfig        `json:"synced_status,omitempty"`
+	Transforms                   []*TransformRule           `json:"transforms,omitempty"`
+	PauseWindows                 []*PauseWindow             `json:"pause_windows,omitempty"`
 
 	// Deprecated: we don't use this field since v8.0.0.
 	SQLMode string `json:"sql_mode,omitempty"`
@@ -439,6 +501,66 @@ func (c *ReplicaConfig) toInternalReplicaConfigWithOriginConfig(
 				FileCleanupCronSpec:  c.Sink.CloudStorageConfig.FileCleanupCronSpec,
 				FlushConcurrency:     c.Sink.CloudStorageConfig.FlushConcurrency,
 				OutputRawChangeEvent: c.Sink.CloudStorageConfig.OutputRawChangeEvent,
+				TableFormat:          c.Sink.CloudStorageConfig.TableFormat,
+
+				IcebergSnapshotRetention: c.Sink.CloudStorageConfig.IcebergSnapshotRetention,
+				IcebergMaxMetadataFiles:  c.Sink.CloudStorageConfig.IcebergMaxMetadataFiles,
+			}
+		}
+		var webhookConfig *config.WebhookConfig
+		if c.Sink.WebhookConfig != nil {
+			webhookConfig = &config.WebhookConfig{
+				WorkerCount:          c.Sink.WebhookConfig.WorkerCount,
+				MaxBatchRows:         c.Sink.WebhookConfig.MaxBatchRows,
+				Timeout:              c.Sink.WebhookConfig.Timeout,
+				MaxRetries:           c.Sink.WebhookConfig.MaxRetries,
+				BackoffBaseInMs:      c.Sink.WebhookConfig.BackoffBaseInMs,
+				BackoffMaxInMs:       c.Sink.WebhookConfig.BackoffMaxInMs,
+				SSLCa:                c.Sink.WebhookConfig.SSLCa,
+				SSLCert:              c.Sink.WebhookConfig.SSLCert,
+				SSLKey:               c.Sink.WebhookConfig.SSLKey,
+				Headers:              c.Sink.WebhookConfig.Headers,
+				OutputRawChangeEvent: c.Sink.WebhookConfig.OutputRawChangeEvent,
+			}
+		}
+		var redisConfig *config.RedisConfig
+		if c.Sink.RedisConfig != nil {
+			var rules []*config.RedisRule
+			for _, rule := range c.Sink.RedisConfig.Rules {
+				rules = append(rules, &config.RedisRule{
+					Matcher: rule.Matcher,
+					Mode:    rule.Mode,
+					Key:     rule.Key,
+					TTL:     rule.TTL,
+				})
+			}
+			redisConfig = &config.RedisConfig{
+				WorkerCount:  c.Sink.RedisConfig.WorkerCount,
+				MaxBatchRows: c.Sink.RedisConfig.MaxBatchRows,
+				Timeout:      c.Sink.RedisConfig.Timeout,
+				MaxRetries:   c.Sink.RedisConfig.MaxRetries,
+				SSLCa:        c.Sink.RedisConfig.SSLCa,
+				SSLCert:      c.Sink.RedisConfig.SSLCert,
+				SSLKey:       c.Sink.RedisConfig.SSLKey,
+				Rules:        rules,
+			}
+		}
+		var elasticsearchConfig *config.ElasticsearchConfig
+		if c.Sink.ElasticsearchConfig != nil {
+			elasticsearchConfig = &config.ElasticsearchConfig{
+				Index:           c.Sink.ElasticsearchConfig.Index,
+				WorkerCount:     c.Sink.ElasticsearchConfig.WorkerCount,
+				MaxBatchRows:    c.Sink.ElasticsearchConfig.MaxBatchRows,
+				Timeout:         c.Sink.ElasticsearchConfig.Timeout,
+				MaxRetries:      c.Sink.ElasticsearchConfig.MaxRetries,
+				BackoffBaseInMs: c.Sink.ElasticsearchConfig.BackoffBaseInMs,
+				BackoffMaxInMs:  c.Sink.ElasticsearchConfig.BackoffMaxInMs,
+				Username:        c.Sink.ElasticsearchConfig.Username,
+				Password:        c.Sink.ElasticsearchConfig.Password,
+				APIKey:          c.Sink.ElasticsearchConfig.APIKey,
+				SSLCa:           c.Sink.ElasticsearchConfig.SSLCa,
+				SSLCert:         c.Sink.ElasticsearchConfig.SSLCert,
+				SSLKey:          c.Sink.ElasticsearchConfig.SSLKey,
 			}
 		}
 		var debeziumConfig *config.DebeziumConfig
@@ -447,6 +569,19 @@ func (c *ReplicaConfig) toInternalReplicaConfigWithOriginConfig(
 				OutputOldValue: c.Sink.DebeziumConfig.OutputOldValue,
 			}
 		}
+		var deadLetterConfig *config.DeadLetterConfig
+		if c.Sink.DeadLetterConfig != nil {
+			deadLetterConfig = &config.DeadLetterConfig{
+				URI: c.Sink.DeadLetterConfig.URI,
+			}
+		}
+		var rateLimitConfig *config.RateLimitConfig
+		if c.Sink.RateLimitConfig != nil {
+			rateLimitConfig = &config.RateLimitConfig{
+				RowsPerSecond:  c.Sink.RateLimitConfig.RowsPerSecond,
+				BytesPerSecond: c.Sink.RateLimitConfig.BytesPerSecond,
+			}
+		}
 		var openProtocolConfig *config.OpenProtocolConfig
 		if c.Sink.OpenProtocolConfig != nil {
 			openProtocolConfig = &config.OpenProtocolConfig{
@@ -473,9 +608,14 @@ func (c *ReplicaConfig) toInternalReplicaConfigWithOriginConfig(
 			MySQLConfig:                      mysqlConfig,
 			PulsarConfig:                     pulsarConfig,
 			CloudStorageConfig:               cloudStorageConfig,
+			WebhookConfig:                    webhookConfig,
+			ElasticsearchConfig:              elasticsearchConfig,
+			RedisConfig:                      redisConfig,
 			SafeMode:                         c.Sink.SafeMode,
 			OpenProtocol:                     openProtocolConfig,
 			Debezium:                         debeziumConfig,
+			DeadLetter:                       deadLetterConfig,
+			RateLimit:                        rateLimitConfig,
 		}
 
 		if c.Sink.TxnAtomicity != nil {
@@ -514,6 +654,16 @@ func (c *ReplicaConfig) toInternalReplicaConfigWithOriginConfig(
 			EnableTableAcrossNodes: c.Scheduler.EnableTableAcrossNodes,
 			RegionThreshold:        c.Scheduler.RegionThreshold,
 			WriteKeyThreshold:      c.Scheduler.WriteKeyThreshold,
+			EnableLoadBalance:      c.Scheduler.EnableLoadBalance,
+			LoadImbalanceThreshold: c.Scheduler.LoadImbalanceThreshold,
+		}
+		for _, constraint := range c.Scheduler.PlacementConstraints {
+			res.Scheduler.PlacementConstraints = append(res.Scheduler.PlacementConstraints,
+				&config.PlacementConstraint{
+					Key:    constraint.Key,
+					Op:     constraint.Op,
+					Values: constraint.Values,
+				})
 		}
 	}
 	if c.Integrity != nil {
@@ -531,6 +681,23 @@ func (c *ReplicaConfig) toInternalReplicaConfigWithOriginConfig(
 			CheckpointInterval:  c.SyncedStatus.CheckpointInterval,
 		}
 	}
+	for _, rule := range c.Transforms {
+		res.Transforms = append(res.Transforms, &config.TransformRule{
+			Matcher: rule.Matcher,
+			Columns: rule.Columns,
+			Action:  config.TransformAction(rule.Action),
+			Salt:    rule.Salt,
+			Length:  rule.Length,
+			Value:   rule.Value,
+		})
+	}
+	for _, window := range c.PauseWindows {
+		res.PauseWindows = append(res.PauseWindows, &config.PauseWindow{
+			Cron:     window.Cron,
+			Duration: window.Duration.duration,
+			TimeZone: window.TimeZone,
+		})
+	}
 	return res
 }
 
@@ -743,6 +910,66 @@ func ToAPIReplicaConfig(c *config.ReplicaConfig) *ReplicaConfig {
 				FileCleanupCronSpec:  cloned.Sink.CloudStorageConfig.FileCleanupCronSpec,
 				FlushConcurrency:     cloned.Sink.CloudStorageConfig.FlushConcurrency,
 				OutputRawChangeEvent: cloned.Sink.CloudStorageConfig.OutputRawChangeEvent,
+				TableFormat:          cloned.Sink.CloudStorageConfig.TableFormat,
+
+				IcebergSnapshotRetention: cloned.Sink.CloudStorageConfig.IcebergSnapshotRetention,
+				IcebergMaxMetadataFiles:  cloned.Sink.CloudStorageConfig.IcebergMaxMetadataFiles,
+			}
+		}
+		var webhookConfig *WebhookConfig
+		if cloned.Sink.WebhookConfig != nil {
+			webhookConfig = &WebhookConfig{
+				WorkerCount:          cloned.Sink.WebhookConfig.WorkerCount,
+				MaxBatchRows:         cloned.Sink.WebhookConfig.MaxBatchRows,
+				Timeout:              cloned.Sink.WebhookConfig.Timeout,
+				MaxRetries:           cloned.Sink.WebhookConfig.MaxRetries,
+				BackoffBaseInMs:      cloned.Sink.WebhookConfig.BackoffBaseInMs,
+				BackoffMaxInMs:       cloned.Sink.WebhookConfig.BackoffMaxInMs,
+				SSLCa:                cloned.Sink.WebhookConfig.SSLCa,
+				SSLCert:              cloned.Sink.WebhookConfig.SSLCert,
+				SSLKey:               cloned.Sink.WebhookConfig.SSLKey,
+				Headers:              cloned.Sink.WebhookConfig.Headers,
+				OutputRawChangeEvent: cloned.Sink.WebhookConfig.OutputRawChangeEvent,
+			}
+		}
+		var redisConfig *RedisConfig
+		if cloned.Sink.RedisConfig != nil {
+			var rules []*RedisRule
+			for _, rule := range cloned.Sink.RedisConfig.Rules {
+				rules = append(rules, &RedisRule{
+					Matcher: rule.Matcher,
+					Mode:    rule.Mode,
+					Key:     rule.Key,
+					TTL:     rule.TTL,
+				})
+			}
+			redisConfig = &RedisConfig{
+				WorkerCount:  cloned.Sink.RedisConfig.WorkerCount,
+				MaxBatchRows: cloned.Sink.RedisConfig.MaxBatchRows,
+				Timeout:      cloned.Sink.RedisConfig.Timeout,
+				MaxRetries:   cloned.Sink.RedisConfig.MaxRetries,
+				SSLCa:        cloned.Sink.RedisConfig.SSLCa,
+				SSLCert:      cloned.Sink.RedisConfig.SSLCert,
+				SSLKey:       cloned.Sink.RedisConfig.SSLKey,
+				Rules:        rules,
+			}
+		}
+		var elasticsearchConfig *ElasticsearchConfig
+		if cloned.Sink.ElasticsearchConfig != nil {
+			elasticsearchConfig = &ElasticsearchConfig{
+				Index:           cloned.Sink.ElasticsearchConfig.Index,
+				WorkerCount:     cloned.Sink.ElasticsearchConfig.WorkerCount,
+				MaxBatchRows:    cloned.Sink.ElasticsearchConfig.MaxBatchRows,
+				Timeout:         cloned.Sink.ElasticsearchConfig.Timeout,
+				MaxRetries:      cloned.Sink.ElasticsearchConfig.MaxRetries,
+				BackoffBaseInMs: cloned.Sink.ElasticsearchConfig.BackoffBaseInMs,
+				BackoffMaxInMs:  cloned.Sink.ElasticsearchConfig.BackoffMaxInMs,
+				Username:        cloned.Sink.ElasticsearchConfig.Username,
+				Password:        cloned.Sink.ElasticsearchConfig.Password,
+				APIKey:          cloned.Sink.ElasticsearchConfig.APIKey,
+				SSLCa:           cloned.Sink.ElasticsearchConfig.SSLCa,
+				SSLCert:         cloned.Sink.ElasticsearchConfig.SSLCert,
+				SSLKey:          cloned.Sink.ElasticsearchConfig.SSLKey,
 			}
 		}
 		var debeziumConfig *DebeziumConfig
@@ -751,6 +978,19 @@ func ToAPIReplicaConfig(c *config.ReplicaConfig) *ReplicaConfig {
 				OutputOldValue: cloned.Sink.Debezium.OutputOldValue,
 			}
 		}
+		var deadLetterConfig *DeadLetterConfig
+		if cloned.Sink.DeadLetter != nil {
+			deadLetterConfig = &DeadLetterConfig{
+				URI: cloned.Sink.DeadLetter.URI,
+			}
+		}
+		var rateLimitConfig *RateLimitConfig
+		if cloned.Sink.RateLimit != nil {
+			rateLimitConfig = &RateLimitConfig{
+				RowsPerSecond:  cloned.Sink.RateLimit.RowsPerSecond,
+				BytesPerSecond: cloned.Sink.RateLimit.BytesPerSecond,
+			}
+		}
 		var openProtocolConfig *OpenProtocolConfig
 		if cloned.Sink.OpenProtocol != nil {
 			openProtocolConfig = &OpenProtocolConfig{
@@ -776,9 +1016,14 @@ func ToAPIReplicaConfig(c *config.ReplicaConfig) *ReplicaConfig {
 			MySQLConfig:                      mysqlConfig,
 			PulsarConfig:                     pulsarConfig,
 			CloudStorageConfig:               cloudStorageConfig,
+			WebhookConfig:                    webhookConfig,
+			ElasticsearchConfig:              elasticsearchConfig,
+			RedisConfig:                      redisConfig,
 			SafeMode:                         cloned.Sink.SafeMode,
 			DebeziumConfig:                   debeziumConfig,
 			OpenProtocolConfig:               openProtocolConfig,
+			DeadLetterConfig:                 deadLetterConfig,
+			RateLimitConfig:                  rateLimitConfig,
 		}
 
 		if cloned.Sink.TxnAtomicity != nil {
@@ -838,6 +1083,16 @@ func ToAPIReplicaConfig(c *config.ReplicaConfig) *ReplicaConfig {
 			EnableTableAcrossNodes: cloned.Scheduler.EnableTableAcrossNodes,
 			RegionThreshold:        cloned.Scheduler.RegionThreshold,
 			WriteKeyThreshold:      cloned.Scheduler.WriteKeyThreshold,
+			EnableLoadBalance:      cloned.Scheduler.EnableLoadBalance,
+			LoadImbalanceThreshold: cloned.Scheduler.LoadImbalanceThreshold,
+		}
+		for _, constraint := range cloned.Scheduler.PlacementConstraints {
+			res.Scheduler.PlacementConstraints = append(res.Scheduler.PlacementConstraints,
+				&PlacementConstraint{
+					Key:    constraint.Key,
+					Op:     constraint.Op,
+					Values: constraint.Values,
+				})
 		}
 	}
 
@@ -856,6 +1111,23 @@ func ToAPIReplicaConfig(c *config.ReplicaConfig) *ReplicaConfig {
 			CheckpointInterval:  cloned.SyncedStatus.CheckpointInterval,
 		}
 	}
+	for _, rule := range cloned.Transforms {
+		res.Transforms = append(res.Transforms, &TransformRule{
+			Matcher: rule.Matcher,
+			Columns: rule.Columns,
+			Action:  string(rule.Action),
+			Salt:    rule.Salt,
+			Length:  rule.Length,
+			Value:   rule.Value,
+		})
+	}
+	for _, window := range cloned.PauseWindows {
+		res.PauseWindows = append(res.PauseWindows, &PauseWindow{
+			Cron:     window.Cron,
+			Duration: JSONDuration{window.Duration},
+			TimeZone: window.TimeZone,
+		})
+	}
 	return res
 }
 
@@ -945,34 +1217,39 @@ type Table struct {
 // SinkConfig represents sink config for a changefeed
 // This is a duplicate of config.SinkConfig
 type SinkConfig struct {
-	Protocol                         *string             `json:"protocol,omitempty"`
-	SchemaRegistry                   *string             `json:"schema_registry,omitempty"`
-	CSVConfig                        *CSVConfig          `json:"csv,omitempty"`
-	DispatchRules                    []*DispatchRule     `json:"dispatchers,omitempty"`
-	ColumnSelectors                  []*ColumnSelector   `json:"column_selectors,omitempty"`
-	TxnAtomicity                     *string             `json:"transaction_atomicity,omitempty"`
-	EncoderConcurrency               *int                `json:"encoder_concurrency,omitempty"`
-	Terminator                       *string             `json:"terminator,omitempty"`
-	DateSeparator                    *string             `json:"date_separator,omitempty"`
-	EnablePartitionSeparator         *bool               `json:"enable_partition_separator,omitempty"`
-	FileIndexWidth                   *int                `json:"file_index_width,omitempty"`
-	EnableKafkaSinkV2                *bool               `json:"enable_kafka_sink_v2,omitempty"`
-	OnlyOutputUpdatedColumns         *bool               `json:"only_output_updated_columns,omitempty"`
-	DeleteOnlyOutputHandleKeyColumns *bool               `json:"delete_only_output_handle_key_columns"`
-	ContentCompatible                *bool               `json:"content_compatible"`
-	SafeMode                         *bool               `json:"safe_mode,omitempty"`
-	KafkaConfig                      *KafkaConfig        `json:"kafka_config,omitempty"`
-	PulsarConfig                     *PulsarConfig       `json:"pulsar_config,omitempty"`
-	MySQLConfig                      *MySQLConfig        `json:"mysql_config,omitempty"`
-	CloudStorageConfig               *CloudStorageConfig `json:"cloud_storage_config,omitempty"`
-	AdvanceTimeoutInSec              *uint               `json:"advance_timeout,omitempty"`
-	SendBootstrapIntervalInSec       *int64              `json:"send_bootstrap_interval_in_sec,omitempty"`
-	SendBootstrapInMsgCount          *int32              `json:"send_bootstrap_in_msg_count,omitempty"`
-	SendBootstrapToAllPartition      *bool               `json:"send_bootstrap_to_all_partition,omitempty"`
-	SendAllBootstrapAtStart          *bool               `json:"send-all-bootstrap-at-start,omitempty"`
-	DebeziumDisableSchema            *bool               `json:"debezium_disable_schema,omitempty"`
-	DebeziumConfig                   *DebeziumConfig     `json:"debezium,omitempty"`
-	OpenProtocolConfig               *OpenProtocolConfig `json:"open,omitempty"`
+	Protocol                         *string              `json:"protocol,omitempty"`
+	SchemaRegistry                   *string              `json:"schema_registry,omitempty"`
+	CSVConfig                        *CSVConfig           `json:"csv,omitempty"`
+	DispatchRules                    []*DispatchRule      `json:"dispatchers,omitempty"`
+	ColumnSelectors                  []*ColumnSelector    `json:"column_selectors,omitempty"`
+	TxnAtomicity                     *string              `json:"transaction_atomicity,omitempty"`
+	EncoderConcurrency               *int                 `json:"encoder_concurrency,omitempty"`
+	Terminator                       *string              `json:"terminator,omitempty"`
+	DateSeparator                    *string              `json:"date_separator,omitempty"`
+	EnablePartitionSeparator         *bool                `json:"enable_partition_separator,omitempty"`
+	FileIndexWidth                   *int                 `json:"file_index_width,omitempty"`
+	EnableKafkaSinkV2                *bool                `json:"enable_kafka_sink_v2,omitempty"`
+	OnlyOutputUpdatedColumns         *bool                `json:"only_output_updated_columns,omitempty"`
+	DeleteOnlyOutputHandleKeyColumns *bool                `json:"delete_only_output_handle_key_columns"`
+	ContentCompatible                *bool                `json:"content_compatible"`
+	SafeMode                         *bool                `json:"safe_mode,omitempty"`
+	KafkaConfig                      *KafkaConfig         `json:"kafka_config,omitempty"`
+	PulsarConfig                     *PulsarConfig        `json:"pulsar_config,omitempty"`
+	MySQLConfig                      *MySQLConfig         `json:"mysql_config,omitempty"`
+	CloudStorageConfig               *CloudStorageConfig  `json:"cloud_storage_config,omitempty"`
+	WebhookConfig                    *WebhookConfig       `json:"webhook_config,omitempty"`
+	ElasticsearchConfig              *ElasticsearchConfig `json:"elasticsearch_config,omitempty"`
+	RedisConfig                      *RedisConfig         `json:"redis_config,omitempty"`
+	AdvanceTimeoutInSec              *uint                `json:"advance_timeout,omitempty"`
+	SendBootstrapIntervalInSec       *int64               `json:"send_bootstrap_interval_in_sec,omitempty"`
+	SendBootstrapInMsgCount          *int32               `json:"send_bootstrap_in_msg_count,omitempty"`
+	SendBootstrapToAllPartition      *bool                `json:"send_bootstrap_to_all_partition,omitempty"`
+	SendAllBootstrapAtStart          *bool                `json:"send-all-bootstrap-at-start,omitempty"`
+	DebeziumDisableSchema            *bool                `json:"debezium_disable_schema,omitempty"`
+	DebeziumConfig                   *DebeziumConfig      `json:"debezium,omitempty"`
+	OpenProtocolConfig               *OpenProtocolConfig  `json:"open,omitempty"`
+	DeadLetterConfig                 *DeadLetterConfig    `json:"dead_letter,omitempty"`
+	RateLimitConfig                  *RateLimitConfig     `json:"rate_limit,omitempty"`
 }
 
 // CSVConfig denotes the csv config
@@ -1014,6 +1291,25 @@ type ColumnSelector struct {
 	Columns []string `json:"columns,omitempty"`
 }
 
+// TransformRule represents a column transform rule for tables.
+// This is a duplicate of config.TransformRule
+type TransformRule struct {
+	Matcher []string `json:"matcher,omitempty"`
+	Columns []string `json:"columns,omitempty"`
+	Action  string   `json:"action"`
+	Salt    *string  `json:"salt,omitempty"`
+	Length  *int     `json:"length,omitempty"`
+	Value   *string  `json:"value,omitempty"`
+}
+
+// PauseWindow represents a maintenance window of a changefeed.
+// This is a duplicate of config.PauseWindow
+type PauseWindow struct {
+	Cron     string       `json:"cron"`
+	Duration JSONDuration `json:"duration" swaggertype:"string"`
+	TimeZone string       `json:"time_zone,omitempty"`
+}
+
 // ConsistentConfig represents replication consistency config for a changefeed
 // This is a duplicate of config.ConsistentConfig
 type ConsistentConfig struct {
@@ -1046,6 +1342,23 @@ type ChangefeedSchedulerConfig struct {
 	RegionThreshold int `toml:"region_threshold" json:"region_threshold"`
 	// WriteKeyThreshold is the written keys threshold of splitting a table.
 	WriteKeyThreshold int `toml:"write_key_threshold" json:"write_key_threshold"`
+	// EnableLoadBalance set true to balance spans among captures by their
+	// throughput and lag instead of by the number of spans.
+	EnableLoadBalance bool `toml:"enable_load_balance" json:"enable_load_balance"`
+	// LoadImbalanceThreshold is the ratio by which the load of the busiest
+	// capture must exceed the average load before spans are moved.
+	LoadImbalanceThreshold float64 `toml:"load_imbalance_threshold" json:"load_imbalance_threshold"`
+	// PlacementConstraints restricts the captures that tables of the
+	// changefeed can be placed on by capture labels.
+	PlacementConstraints []*PlacementConstraint `toml:"placement_constraints" json:"placement_constraints,omitempty"`
+}
+
+// PlacementConstraint is a constraint on capture labels.
+// This is a duplicate of config.PlacementConstraint
+type PlacementConstraint struct {
+	Key    string   `json:"key"`
+	Op     string   `json:"op"`
+	Values []string `json:"values"`
 }
 
 // IntegrityConfig is the config for integrity check
@@ -1179,10 +1492,11 @@ type ServerStatus struct {
 
 // Capture holds common information of a capture in cdc
 type Capture struct {
-	ID            string `json:"id"`
-	IsOwner       bool   `json:"is_owner"`
-	AdvertiseAddr string `json:"address"`
-	ClusterID     string `json:"cluster_id"`
+	ID            string            `json:"id"`
+	IsOwner       bool              `json:"is_owner"`
+	AdvertiseAddr string            `json:"address"`
+	ClusterID     string            `json:"cluster_id"`
+	Labels        map[string]string `json:"labels,omitempty"`
 }
 
 // CodecConfig represents a MQ codec configuration
@@ -1297,6 +1611,62 @@ type CloudStorageConfig struct {
 	FileCleanupCronSpec  *string `json:"file_cleanup_cron_spec,omitempty"`
 	FlushConcurrency     *int    `json:"flush_concurrency,omitempty"`
 	OutputRawChangeEvent *bool   `json:"output_raw_change_event,omitempty"`
+	TableFormat          *string `json:"table_format,omitempty"`
+
+	IcebergSnapshotRetention *string `json:"iceberg_snapshot_retention,omitempty"`
+	IcebergMaxMetadataFiles  *int    `json:"iceberg_max_metadata_files,omitempty"`
+}
+
+// WebhookConfig represents a webhook sink configuration
+type WebhookConfig struct {
+	WorkerCount          *int              `json:"worker_count,omitempty"`
+	MaxBatchRows         *int              `json:"max_batch_rows,omitempty"`
+	Timeout              *string           `json:"timeout,omitempty"`
+	MaxRetries           *int              `json:"max_retries,omitempty"`
+	BackoffBaseInMs      *int64            `json:"backoff_base_in_ms,omitempty"`
+	BackoffMaxInMs       *int64            `json:"backoff_max_in_ms,omitempty"`
+	SSLCa                *string           `json:"ssl_ca,omitempty"`
+	SSLCert              *string           `json:"ssl_cert,omitempty"`
+	SSLKey               *string           `json:"ssl_key,omitempty"`
+	Headers              map[string]string `json:"headers,omitempty"`
+	OutputRawChangeEvent *bool             `json:"output_raw_change_event,omitempty"`
+}
+
+// ElasticsearchConfig represents an elasticsearch sink configuration
+type ElasticsearchConfig struct {
+	Index           *string `json:"index,omitempty"`
+	WorkerCount     *int    `json:"worker_count,omitempty"`
+	MaxBatchRows    *int    `json:"max_batch_rows,omitempty"`
+	Timeout         *string `json:"timeout,omitempty"`
+	MaxRetries      *int    `json:"max_retries,omitempty"`
+	BackoffBaseInMs *int64  `json:"backoff_base_in_ms,omitempty"`
+	BackoffMaxInMs  *int64  `json:"backoff_max_in_ms,omitempty"`
+	Username        *string `json:"username,omitempty"`
+	Password        *string `json:"password,omitempty"`
+	APIKey          *string `json:"api_key,omitempty"`
+	SSLCa           *string `json:"ssl_ca,omitempty"`
+	SSLCert         *string `json:"ssl_cert,omitempty"`
+	SSLKey          *string `json:"ssl_key,omitempty"`
+}
+
+// RedisConfig represents a redis sink configuration
+type RedisConfig struct {
+	WorkerCount  *int         `json:"worker_count,omitempty"`
+	MaxBatchRows *int         `json:"max_batch_rows,omitempty"`
+	Timeout      *string      `json:"timeout,omitempty"`
+	MaxRetries   *int         `json:"max_retries,omitempty"`
+	SSLCa        *string      `json:"ssl_ca,omitempty"`
+	SSLCert      *string      `json:"ssl_cert,omitempty"`
+	SSLKey       *string      `json:"ssl_key,omitempty"`
+	Rules        []*RedisRule `json:"rules,omitempty"`
+}
+
+// RedisRule represents how the rows of the matched tables are written to redis.
+type RedisRule struct {
+	Matcher []string `json:"matcher"`
+	Mode    string   `json:"mode"`
+	Key     string   `json:"key"`
+	TTL     *string  `json:"ttl,omitempty"`
 }
 
 // ChangefeedStatus holds common information of a changefeed in cdc
@@ -1306,6 +1676,12 @@ type ChangefeedStatus struct {
 	CheckpointTs uint64        `json:"checkpoint_ts"`
 	LastError    *RunningError `json:"last_error,omitempty"`
 	LastWarning  *RunningError `json:"last_warning,omitempty"`
+	// DeadLetterCount is the number of rows written to the dead-letter queue
+	// by the running processors of the changefeed.
+	DeadLetterCount uint64 `json:"dead_letter_count,omitempty"`
+	// RateLimited indicates the changefeed is throttled by `sink.rate-limit`,
+	// so the lag is self-imposed rather than caused by the downstream.
+	RateLimited bool `json:"rate_limited,omitempty"`
 }
 
 // GlueSchemaRegistryConfig represents a glue schema registry configuration
@@ -1330,3 +1706,15 @@ type OpenProtocolConfig struct {
 type DebeziumConfig struct {
 	OutputOldValue bool `json:"output_old_value"`
 }
+
+// DeadLetterConfig represents the configurations of the dead-letter queue
+type DeadLetterConfig struct {
+	URI *string `json:"uri,omitempty"`
+}
+
+// RateLimitConfig represents the throughput limit of a changefeed
+// This is the same as config.RateLimitConfig
+type RateLimitConfig struct {
+	RowsPerSecond  *uint64 `json:"rows_per_second,omitempty"`
+	BytesPerSecond *uint64 `json:"bytes_per_second,omitempty"`
+}
@@ -154,6 +154,9 @@ func TestToAPIReplicaConfig(t *testing.T) {
 	cfg.Scheduler = &config.ChangefeedSchedulerConfig{
 		EnableTableAcrossNodes: true, RegionThreshold: 10001, WriteKeyThreshold: 10001,
 	}
+	cfg.PauseWindows = []*config.PauseWindow{
+		{Cron: "0 2 * * *", Duration: 2 * time.Hour, TimeZone: "Asia/Shanghai"},
+	}
 	cfg2 := ToAPIReplicaConfig(cfg).ToInternalReplicaConfig()
 	require.Equal(t, "", cfg2.Sink.DispatchRules[0].DispatcherRule)
 	cfg.Sink.DispatchRules[0].DispatcherRule = ""
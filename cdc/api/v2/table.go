@@ -0,0 +1,180 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"encoding/hex"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/tiflow/cdc/api"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// listTables lists the replication statuses of all table spans of a changefeed
+// @Summary List table spans of a changefeed
+// @Description list the replication status of all table spans of a changefeed
+// @Tags changefeed,v2
+// @Produce json
+// @Param changefeed_id  path  string  true  "changefeed_id"
+// @Param namespace query string false "default"
+// @Param capture_id query string false "only list spans replicated by the capture"
+// @Success 200 {array} TableSpanStatus
+// @Failure 500,400 {object} model.HTTPError
+// @Router /api/v2/changefeeds/{changefeed_id}/tables [get]
+func (h *OpenAPIV2) listTables(c *gin.Context) {
+	ctx := c.Request.Context()
+	namespace := getNamespaceValueWithDefault(c)
+	changefeedID := model.ChangeFeedID{Namespace: namespace, ID: c.Param(api.APIOpVarChangefeedID)}
+	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
+			changefeedID.ID))
+		return
+	}
+
+	statuses, err := h.capture.StatusProvider().GetSpanStatuses(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	captureID := c.Query(apiOpVarCaptureID)
+	tables := make([]TableSpanStatus, 0, len(statuses))
+	for _, status := range statuses {
+		if captureID != "" && status.CaptureID != captureID {
+			continue
+		}
+		tables = append(tables, TableSpanStatus{
+			TableID:       status.Span.TableID,
+			StartKey:      hex.EncodeToString(status.Span.StartKey),
+			EndKey:        hex.EncodeToString(status.Span.EndKey),
+			CaptureID:     status.CaptureID,
+			State:         status.State,
+			CheckpointTs:  status.CheckpointTs,
+			ResolvedTs:    status.ResolvedTs,
+			CheckpointLag: status.CheckpointLag.Milliseconds(),
+		})
+	}
+	c.JSON(http.StatusOK, &ListResponse[TableSpanStatus]{
+		Total: len(tables),
+		Items: tables,
+	})
+}
+
+// moveTable moves all spans of a table to the target capture
+// @Summary Move a table
+// @Description move all spans of a table to the target capture
+// @Tags changefeed,v2
+// @Accept json
+// @Produce json
+// @Param changefeed_id  path  string  true  "changefeed_id"
+// @Param namespace query string false "default"
+// @Param moveTableConfig body MoveTableConfig true "move table config"
+// @Success 200 {object} EmptyResponse
+// @Failure 500,400 {object} model.HTTPError
+// @Router /api/v2/changefeeds/{changefeed_id}/tables/move [post]
+func (h *OpenAPIV2) moveTable(c *gin.Context) {
+	ctx := c.Request.Context()
+	namespace := getNamespaceValueWithDefault(c)
+	changefeedID := model.ChangeFeedID{Namespace: namespace, ID: c.Param(api.APIOpVarChangefeedID)}
+	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
+			changefeedID.ID))
+		return
+	}
+	cfg := &MoveTableConfig{}
+	if err := c.BindJSON(cfg); err != nil {
+		_ = c.Error(cerror.WrapError(cerror.ErrAPIInvalidParam, err))
+		return
+	}
+	if err := model.ValidateChangefeedID(cfg.CaptureID); err != nil {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid capture_id: %s",
+			cfg.CaptureID))
+		return
+	}
+	// check if the changefeed exists
+	cfInfo, err := h.capture.StatusProvider().GetChangeFeedInfo(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	// check if the target capture exists
+	captures, err := h.capture.StatusProvider().GetCaptures(ctx)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	var target *model.CaptureInfo
+	for _, capture := range captures {
+		if capture.ID == cfg.CaptureID {
+			target = capture
+			break
+		}
+	}
+	if target == nil {
+		_ = c.Error(cerror.ErrCaptureNotExist.GenWithStackByArgs(cfg.CaptureID))
+		return
+	}
+	// check if the target capture satisfies placement constraints
+	if cfInfo.Config != nil && cfInfo.Config.Scheduler != nil &&
+		!config.MatchPlacementConstraints(
+			cfInfo.Config.Scheduler.PlacementConstraints, target.Labels) {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack(
+			"capture %s does not satisfy placement constraints of changefeed %s",
+			cfg.CaptureID, changefeedID.ID))
+		return
+	}
+
+	err = api.HandleOwnerScheduleTable(
+		ctx, h.capture, changefeedID, cfg.CaptureID, cfg.TableID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, &EmptyResponse{})
+}
+
+// rebalanceTables rebalances all tables of a changefeed among captures
+// @Summary Rebalance tables
+// @Description rebalance all tables of a changefeed among captures
+// @Tags changefeed,v2
+// @Produce json
+// @Param changefeed_id  path  string  true  "changefeed_id"
+// @Param namespace query string false "default"
+// @Success 200 {object} EmptyResponse
+// @Failure 500,400 {object} model.HTTPError
+// @Router /api/v2/changefeeds/{changefeed_id}/tables/rebalance [post]
+func (h *OpenAPIV2) rebalanceTables(c *gin.Context) {
+	ctx := c.Request.Context()
+	namespace := getNamespaceValueWithDefault(c)
+	changefeedID := model.ChangeFeedID{Namespace: namespace, ID: c.Param(api.APIOpVarChangefeedID)}
+	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
+			changefeedID.ID))
+		return
+	}
+	// check if the changefeed exists
+	_, err := h.capture.StatusProvider().GetChangeFeedStatus(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	if err := api.HandleOwnerBalance(ctx, h.capture, changefeedID); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, &EmptyResponse{})
+}
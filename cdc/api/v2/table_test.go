@@ -0,0 +1,193 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mock_capture "github.com/pingcap/tiflow/cdc/capture/mock"
+	"github.com/pingcap/tiflow/cdc/model"
+	mock_owner "github.com/pingcap/tiflow/cdc/owner/mock"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerrors "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListTables(t *testing.T) {
+	t.Parallel()
+
+	list := testCase{url: "/api/v2/changefeeds/%s/tables?namespace=abc", method: "GET"}
+	cp := mock_capture.NewMockCapture(gomock.NewController(t))
+	apiV2 := NewOpenAPIV2ForTest(cp, APIV2HelpersImpl{})
+	router := newRouter(apiV2)
+	statusProvider := &mockStatusProvider{}
+	cp.EXPECT().StatusProvider().Return(statusProvider).AnyTimes()
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+
+	// case 1: changefeed not exists
+	statusProvider.err = cerrors.ErrChangeFeedNotExists.GenWithStackByArgs(changeFeedID.ID)
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(), list.method,
+		fmt.Sprintf(list.url, changeFeedID.ID), nil)
+	router.ServeHTTP(w, req)
+	respErr := model.HTTPError{}
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&respErr))
+	require.Contains(t, respErr.Code, "ErrChangeFeedNotExists")
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	// case 2: success
+	statusProvider.err = nil
+	statusProvider.spanStatuses = []*model.SpanReplicationStatus{{
+		Span:          tablepb.Span{TableID: 1, StartKey: []byte{1}, EndKey: []byte{2}},
+		CaptureID:     "a",
+		State:         "Replicating",
+		CheckpointTs:  10,
+		ResolvedTs:    11,
+		CheckpointLag: 3 * time.Second,
+	}, {
+		Span:      tablepb.Span{TableID: 2, StartKey: []byte{3}, EndKey: []byte{4}},
+		CaptureID: "b",
+		State:     "Prepare",
+	}}
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(), list.method,
+		fmt.Sprintf(list.url, changeFeedID.ID), nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := ListResponse[TableSpanStatus]{}
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	require.Equal(t, 2, resp.Total)
+	require.Equal(t, TableSpanStatus{
+		TableID:       1,
+		StartKey:      "01",
+		EndKey:        "02",
+		CaptureID:     "a",
+		State:         "Replicating",
+		CheckpointTs:  10,
+		ResolvedTs:    11,
+		CheckpointLag: 3000,
+	}, resp.Items[0])
+
+	// case 3: filter by capture
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(), list.method,
+		fmt.Sprintf(list.url+"&capture_id=b", changeFeedID.ID), nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp = ListResponse[TableSpanStatus]{}
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	require.Equal(t, 1, resp.Total)
+	require.Equal(t, int64(2), resp.Items[0].TableID)
+}
+
+func TestMoveTable(t *testing.T) {
+	t.Parallel()
+
+	move := testCase{url: "/api/v2/changefeeds/%s/tables/move?namespace=abc", method: "POST"}
+	cp := mock_capture.NewMockCapture(gomock.NewController(t))
+	owner := mock_owner.NewMockOwner(gomock.NewController(t))
+	apiV2 := NewOpenAPIV2ForTest(cp, APIV2HelpersImpl{})
+	router := newRouter(apiV2)
+	statusProvider := &mockStatusProvider{
+		changefeedInfo: &model.ChangeFeedInfo{Config: &config.ReplicaConfig{
+			Scheduler: &config.ChangefeedSchedulerConfig{
+				PlacementConstraints: []*config.PlacementConstraint{
+					{Key: "pool", Op: config.PlacementOpNotIn, Values: []string{"payment"}},
+				},
+			},
+		}},
+		captures: []*model.CaptureInfo{
+			{ID: "capture-1"},
+			{ID: "capture-3", Labels: map[string]string{"pool": "payment"}},
+		},
+	}
+	cp.EXPECT().StatusProvider().Return(statusProvider).AnyTimes()
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+	cp.EXPECT().GetOwner().Return(owner, nil).AnyTimes()
+	owner.EXPECT().ScheduleTable(changeFeedID, "capture-1", int64(1), gomock.Any()).
+		Do(func(_ model.ChangeFeedID, _ model.CaptureID, _ model.TableID, done chan<- error) {
+			close(done)
+		}).Times(1)
+
+	send := func(cfg *MoveTableConfig) *httptest.ResponseRecorder {
+		body, err := json.Marshal(cfg)
+		require.NoError(t, err)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(context.Background(), move.method,
+			fmt.Sprintf(move.url, changeFeedID.ID), bytes.NewReader(body))
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	// case 1: invalid capture id
+	w := send(&MoveTableConfig{TableID: 1, CaptureID: "@^Invalid"})
+	respErr := model.HTTPError{}
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&respErr))
+	require.Contains(t, respErr.Code, "ErrAPIInvalidParam")
+
+	// case 2: capture not exists
+	w = send(&MoveTableConfig{TableID: 1, CaptureID: "capture-2"})
+	respErr = model.HTTPError{}
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&respErr))
+	require.Contains(t, respErr.Code, "ErrCaptureNotExist")
+
+	// case 3: capture does not satisfy placement constraints
+	w = send(&MoveTableConfig{TableID: 1, CaptureID: "capture-3"})
+	respErr = model.HTTPError{}
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&respErr))
+	require.Contains(t, respErr.Code, "ErrAPIInvalidParam")
+	require.Contains(t, respErr.Error, "placement constraints")
+
+	// case 4: success
+	w = send(&MoveTableConfig{TableID: 1, CaptureID: "capture-1"})
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "{}", w.Body.String())
+}
+
+func TestRebalanceTables(t *testing.T) {
+	t.Parallel()
+
+	rebalance := testCase{url: "/api/v2/changefeeds/%s/tables/rebalance?namespace=abc", method: "POST"}
+	cp := mock_capture.NewMockCapture(gomock.NewController(t))
+	owner := mock_owner.NewMockOwner(gomock.NewController(t))
+	apiV2 := NewOpenAPIV2ForTest(cp, APIV2HelpersImpl{})
+	router := newRouter(apiV2)
+	statusProvider := &mockStatusProvider{changefeedStatus: &model.ChangeFeedStatusForAPI{}}
+	cp.EXPECT().StatusProvider().Return(statusProvider).AnyTimes()
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+	cp.EXPECT().GetOwner().Return(owner, nil).AnyTimes()
+	owner.EXPECT().RebalanceTables(changeFeedID, gomock.Any()).
+		Do(func(_ model.ChangeFeedID, done chan<- error) {
+			close(done)
+		}).Times(1)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(), rebalance.method,
+		fmt.Sprintf(rebalance.url, changeFeedID.ID), nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "{}", w.Body.String())
+}
@@ -239,6 +239,7 @@ func (c *captureImpl) reset(ctx context.Context) (*vars.GlobalVars, error) {
 		GitHash:        version.GitHash,
 		DeployPath:     deployPath,
 		StartTimestamp: time.Now().Unix(),
+		Labels:         c.config.Labels,
 	}
 
 	if c.upstreamManager != nil {
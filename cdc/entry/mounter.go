@@ -38,6 +38,7 @@ import (
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	pfilter "github.com/pingcap/tiflow/pkg/filter"
 	"github.com/pingcap/tiflow/pkg/integrity"
+	"github.com/pingcap/tiflow/pkg/transform"
 	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 )
@@ -81,6 +82,8 @@ type mounter struct {
 	metricIgnoredDMLEventCounter prometheus.Counter
 
 	integrity *integrity.Config
+	// transformer applies the column transform rules, nil if no rule is set.
+	transformer *transform.Transformer
 
 	// decoder and preDecoder are used to decode the raw value, also used to extract checksum,
 	// they should not be nil after decode at least one event in the row format v2.
@@ -96,6 +99,7 @@ func NewMounter(schemaStorage SchemaStorage,
 	tz *time.Location,
 	filter pfilter.Filter,
 	integrity *integrity.Config,
+	transformer *transform.Transformer,
 ) Mounter {
 	return &mounter{
 		schemaStorage: schemaStorage,
@@ -105,8 +109,9 @@ func NewMounter(schemaStorage SchemaStorage,
 			WithLabelValues(changefeedID.Namespace, changefeedID.ID),
 		metricIgnoredDMLEventCounter: ignoredDMLEventCounter.
 			WithLabelValues(changefeedID.Namespace, changefeedID.ID),
-		tz:        tz,
-		integrity: integrity,
+		tz:          tz,
+		integrity:   integrity,
+		transformer: transformer,
 	}
 }
 
@@ -211,6 +216,11 @@ func (m *mounter) unmarshalAndMountRowChanged(ctx context.Context, raw *model.Ra
 				m.metricIgnoredDMLEventCounter.Inc()
 				return nil, nil
 			}
+			if m.transformer != nil {
+				if err := m.transformer.Apply(row); err != nil {
+					return nil, err
+				}
+			}
 			return row, nil
 		}
 		return nil, nil
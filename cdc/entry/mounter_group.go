@@ -21,6 +21,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/pkg/filter"
 	"github.com/pingcap/tiflow/pkg/integrity"
+	"github.com/pingcap/tiflow/pkg/transform"
 	"github.com/pingcap/tiflow/pkg/util"
 	"golang.org/x/sync/errgroup"
 )
@@ -39,6 +40,7 @@ type mounterGroup struct {
 	tz            *time.Location
 	filter        filter.Filter
 	integrity     *integrity.Config
+	transformer   *transform.Transformer
 
 	workerNum int
 
@@ -59,6 +61,7 @@ func NewMounterGroup(
 	tz *time.Location,
 	changefeedID model.ChangeFeedID,
 	integrity *integrity.Config,
+	transformer *transform.Transformer,
 ) *mounterGroup {
 	if workerNum <= 0 {
 		workerNum = defaultMounterWorkerNum
@@ -69,7 +72,8 @@ func NewMounterGroup(
 		filter:        filter,
 		tz:            tz,
 
-		integrity: integrity,
+		integrity:   integrity,
+		transformer: transformer,
 
 		workerNum: workerNum,
 
@@ -108,7 +112,7 @@ func (m *mounterGroup) WaitForReady(_ context.Context) {}
 func (m *mounterGroup) Close() {}
 
 func (m *mounterGroup) runWorker(ctx context.Context) error {
-	mounter := NewMounter(m.schemaStorage, m.changefeedID, m.tz, m.filter, m.integrity)
+	mounter := NewMounter(m.schemaStorage, m.changefeedID, m.tz, m.filter, m.integrity, m.transformer)
 	for {
 		select {
 		case <-ctx.Done():
@@ -314,7 +314,7 @@ func testMounterDisableOldValue(t *testing.T, tc struct {
 	filter, err := filter.NewFilter(config, "")
 	require.Nil(t, err)
 	mounter := NewMounter(scheamStorage,
-		model.DefaultChangeFeedID("c1"), time.UTC, filter, config.Integrity).(*mounter)
+		model.DefaultChangeFeedID("c1"), time.UTC, filter, config.Integrity, nil).(*mounter)
 	mounter.tz = time.Local
 	ctx := context.Background()
 
@@ -1104,6 +1104,35 @@ func TestChecksumAfterAlterSetDefaultValue(t *testing.T) {
 	require.NotNil(t, polymorphicEvent.Row)
 }
 
+func TestDecodeEventWithTransforms(t *testing.T) {
+	replicaConfig := config.GetDefaultReplicaConfig()
+	replicaConfig.Transforms = []*config.TransformRule{
+		{
+			Matcher: []string{"test.t"}, Columns: []string{"name"},
+			Action: config.TransformActionRedact,
+		},
+		{
+			Matcher: []string{"test.t"}, Columns: []string{"_commit_ts"},
+			Action: config.TransformActionAddColumn, Value: util.AddressOf(config.ComputedColumnCommitTs),
+		},
+	}
+
+	helper := NewSchemaTestHelperWithReplicaConfig(t, replicaConfig)
+	defer helper.Close()
+
+	helper.Tk().MustExec("use test")
+	_ = helper.DDL2Event("create table t (id int primary key, name varchar(32), age int)")
+	event := helper.DML2Event("insert into t values (1, 'alice', 20)", "test", "t")
+	require.NotNil(t, event)
+
+	columns := event.GetColumns()
+	require.Len(t, columns, 4)
+	require.Equal(t, []byte("*****"), columns[1].Value)
+	require.Equal(t, int64(20), columns[2].Value)
+	require.Equal(t, "_commit_ts", columns[3].Name)
+	require.Equal(t, event.CommitTs, columns[3].Value)
+}
+
 func TestTimezoneDefaultValue(t *testing.T) {
 	replicaConfig := config.GetDefaultReplicaConfig()
 	replicaConfig.Integrity.IntegrityCheckLevel = integrity.CheckLevelCorrectness
@@ -1268,7 +1297,7 @@ func TestDecodeRow(t *testing.T) {
 
 	schemaStorage.AdvanceResolvedTs(ver.Ver)
 
-	mounter := NewMounter(schemaStorage, changefeed, time.Local, filter, cfg.Integrity).(*mounter)
+	mounter := NewMounter(schemaStorage, changefeed, time.Local, filter, cfg.Integrity, nil).(*mounter)
 
 	helper.Tk().MustExec(`insert into student values(1, "dongmen", 20, "male")`)
 	helper.Tk().MustExec(`update student set age = 27 where id = 1`)
@@ -1347,7 +1376,7 @@ func TestDecodeEventIgnoreRow(t *testing.T) {
 
 	ts := schemaStorage.GetLastSnapshot().CurrentTs()
 	schemaStorage.AdvanceResolvedTs(ver.Ver)
-	mounter := NewMounter(schemaStorage, cfID, time.Local, f, cfg.Integrity).(*mounter)
+	mounter := NewMounter(schemaStorage, cfID, time.Local, f, cfg.Integrity, nil).(*mounter)
 
 	type testCase struct {
 		schema  string
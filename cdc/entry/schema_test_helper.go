@@ -36,6 +36,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/filter"
 	"github.com/pingcap/tiflow/pkg/integrity"
 	"github.com/pingcap/tiflow/pkg/spanz"
+	"github.com/pingcap/tiflow/pkg/transform"
 	"github.com/pingcap/tiflow/pkg/util"
 	"github.com/stretchr/testify/require"
 	"github.com/tikv/client-go/v2/oracle"
@@ -88,8 +89,11 @@ func NewSchemaTestHelperWithReplicaConfig(
 		changefeedID, util.RoleTester, filter)
 	require.NoError(t, err)
 
+	transformer, err := transform.New(replicaConfig, 0, time.Local)
+	require.NoError(t, err)
+
 	mounter := NewMounter(schemaStorage, changefeedID, time.Local,
-		filter, replicaConfig.Integrity)
+		filter, replicaConfig.Integrity, transformer)
 
 	return &SchemaTestHelper{
 		t:             t,
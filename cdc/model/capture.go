@@ -32,6 +32,9 @@ type CaptureInfo struct {
 	GitHash        string `json:"git-hash"`
 	DeployPath     string `json:"deploy-path"`
 	StartTimestamp int64  `json:"start-timestamp"`
+
+	// Labels are used by changefeed placement constraints.
+	Labels map[string]string `json:"labels,omitempty"`
 }
 
 // Marshal using json.Marshal.
@@ -173,6 +173,18 @@ type ChangeFeedInfo struct {
 	CreatorVersion string `json:"creator-version"`
 	// Epoch is the epoch of a changefeed, changes on every restart.
 	Epoch uint64 `json:"epoch"`
+	// ScheduledPause is set when the changefeed is paused by a pause window,
+	// it is persisted so that a new owner can resume the changefeed in time.
+	ScheduledPause *ScheduledPause `json:"scheduled-pause,omitempty"`
+}
+
+// ScheduledPause records the pause window the changefeed is in.
+type ScheduledPause struct {
+	// Until is the end of the pause window.
+	Until time.Time `json:"until"`
+	// Skipped is true if the changefeed was resumed manually during the
+	// window, the owner doesn't pause it again until the window is over.
+	Skipped bool `json:"skipped"`
 }
 
 const changeFeedIDMaxLen = 128
@@ -361,8 +373,9 @@ func (info *ChangeFeedInfo) RmUnusedFields() {
 		info.rmMQOnlyFields()
 	} else {
 		// remove schema registry for MQ downstream with
-		// protocol other than avro
-		if util.GetOrZero(info.Config.Sink.Protocol) != config.ProtocolAvro.String() {
+		// protocol other than avro and protobuf
+		protocol := util.GetOrZero(info.Config.Sink.Protocol)
+		if protocol != config.ProtocolAvro.String() && protocol != config.ProtocolProtobuf.String() {
 			info.Config.Sink.SchemaRegistry = nil
 		}
 	}
@@ -371,9 +384,24 @@ func (info *ChangeFeedInfo) RmUnusedFields() {
 		info.rmStorageOnlyFields()
 	}
 
+	if !sink.IsWebhookScheme(uri.Scheme) {
+		info.Config.Sink.WebhookConfig = nil
+	}
+
+	if !sink.IsElasticsearchScheme(uri.Scheme) {
+		info.Config.Sink.ElasticsearchConfig = nil
+	}
+
+	if !sink.IsRedisScheme(uri.Scheme) {
+		info.Config.Sink.RedisConfig = nil
+	}
+
 	if !sink.IsMySQLCompatibleScheme(uri.Scheme) {
 		info.rmDBOnlyFields()
-	} else {
+	}
+
+	if sink.IsMySQLCompatibleScheme(uri.Scheme) || sink.IsPostgresScheme(uri.Scheme) ||
+		sink.IsElasticsearchScheme(uri.Scheme) || sink.IsRedisScheme(uri.Scheme) {
 		// remove fields only being used by MQ and Storage downstream
 		info.Config.Sink.Protocol = nil
 		info.Config.Sink.Terminator = nil
@@ -583,6 +611,10 @@ func (info *ChangeFeedInfo) fixScheduler(inheritV66 bool) {
 type ChangeFeedStatusForAPI struct {
 	ResolvedTs   uint64 `json:"resolved-ts"`
 	CheckpointTs uint64 `json:"checkpoint-ts"`
+	// DeadLetterCount is the number of rows written to the dead-letter queue.
+	DeadLetterCount uint64 `json:"dead-letter-count,omitempty"`
+	// RateLimited indicates the changefeed is throttled by the rate limit.
+	RateLimited bool `json:"rate-limited,omitempty"`
 }
 
 // ChangeFeedSyncedStatusForAPI uses to transfer the synced status of changefeed for API.
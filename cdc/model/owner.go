@@ -16,6 +16,7 @@ package model
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/pingcap/errors"
 	timodel "github.com/pingcap/tidb/pkg/meta/model"
@@ -95,6 +96,12 @@ type TaskPosition struct {
 	Error *RunningError `json:"error"`
 	// Warning when module error happens
 	Warning *RunningError `json:"warning"`
+	// DeadLetterCount is the number of rows written to the dead-letter queue
+	// since the processor started. This is updated by corresponding processor.
+	DeadLetterCount uint64 `json:"dead-letter-count,omitempty"`
+	// RateLimited indicates the processor is throttled by the rate limit
+	// recently. This is updated by corresponding processor.
+	RateLimited bool `json:"rate-limited,omitempty"`
 }
 
 // Marshal returns the json marshal format of a TaskStatus
@@ -119,9 +126,11 @@ func (tp *TaskPosition) String() string {
 // Clone returns a deep clone of TaskPosition
 func (tp *TaskPosition) Clone() *TaskPosition {
 	ret := &TaskPosition{
-		CheckPointTs: tp.CheckPointTs,
-		ResolvedTs:   tp.ResolvedTs,
-		Count:        tp.Count,
+		CheckPointTs:    tp.CheckPointTs,
+		ResolvedTs:      tp.ResolvedTs,
+		Count:           tp.Count,
+		DeadLetterCount: tp.DeadLetterCount,
+		RateLimited:     tp.RateLimited,
 	}
 	if tp.Error != nil {
 		ret.Error = &RunningError{
@@ -298,3 +307,26 @@ type ProcInfoSnap struct {
 	CfID      ChangeFeedID `json:"changefeed-id"`
 	CaptureID string       `json:"capture-id"`
 }
+
+// SpanReplicationStatus holds the replication status of a table span
+// in a changefeed, it is reported by the scheduler.
+type SpanReplicationStatus struct {
+	Span tablepb.Span `json:"span"`
+	// CaptureID is the capture which is replicating the span,
+	// it is empty if the span is not replicated by any capture.
+	CaptureID     CaptureID     `json:"capture-id"`
+	State         string        `json:"state"`
+	CheckpointTs  Ts            `json:"checkpoint-ts"`
+	ResolvedTs    Ts            `json:"resolved-ts"`
+	CheckpointLag time.Duration `json:"checkpoint-lag"`
+
+	// Progresses of each stage, they are collected from the capture
+	// periodically, so they may fall behind the checkpoint ts.
+	PullerResolvedTs Ts `json:"puller-resolved-ts"`
+	SorterResolvedTs Ts `json:"sorter-resolved-ts"`
+	SinkCheckpointTs Ts `json:"sink-checkpoint-ts"`
+	// RedoResolvedTs is 0 if the redo log is disabled.
+	RedoResolvedTs Ts `json:"redo-resolved-ts"`
+	// MemoryQuotaWait is the total time the span has waited for the memory quota.
+	MemoryQuotaWait time.Duration `json:"memory-quota-wait"`
+}
@@ -69,6 +69,10 @@ type Changefeed interface {
 
 var _ Changefeed = (*changefeed)(nil)
 
+// placementWarningInterval is the min interval to report the warning that no
+// alive capture satisfies the placement constraints.
+const placementWarningInterval = time.Minute
+
 // newScheduler creates a new scheduler from context.
 // This function is factored out to facilitate unit testing.
 func newScheduler(
@@ -108,6 +112,13 @@ type changefeed struct {
 	lastSyncedTs     model.Ts
 	pullerResolvedTs model.Ts
 
+	// deadLetterCount is the number of rows written to the dead-letter queue
+	// by all processors, it is updated in every tick of the owner.
+	deadLetterCount uint64
+	// rateLimited indicates any processor is throttled by the rate limit,
+	// it is updated in every tick of the owner.
+	rateLimited bool
+
 	// ddl related fields
 	ddlManager  *ddlManager
 	redoDDLMgr  redo.DDLManager
@@ -133,6 +144,9 @@ type changefeed struct {
 	isReleased bool
 	errCh      chan error
 	warningCh  chan error
+	// lastPlacementWarning is the last time a warning is reported because no
+	// alive capture satisfies the placement constraints.
+	lastPlacementWarning time.Time
 	// cancel the running goroutine start by `DDLPuller`
 	cancel context.CancelFunc
 
@@ -359,6 +373,28 @@ func (c *changefeed) handleWarning(err error) {
 	})
 }
 
+// checkPlacementConstraints reports a warning if no alive capture satisfies the
+// placement constraints of the changefeed, since tables can't be scheduled then.
+func (c *changefeed) checkPlacementConstraints(
+	cfInfo *model.ChangeFeedInfo,
+	captures map[model.CaptureID]*model.CaptureInfo,
+) {
+	cfg := cfInfo.Config.Scheduler
+	if cfg == nil || len(cfg.PlacementConstraints) == 0 || len(captures) == 0 {
+		return
+	}
+	for _, capture := range captures {
+		if config.MatchPlacementConstraints(cfg.PlacementConstraints, capture.Labels) {
+			return
+		}
+	}
+	if time.Since(c.lastPlacementWarning) < placementWarningInterval {
+		return
+	}
+	c.lastPlacementWarning = time.Now()
+	c.handleWarning(cerror.ErrPlacementConstraintUnsatisfiable.GenWithStackByArgs(len(captures)))
+}
+
 func (c *changefeed) checkStaleCheckpointTs(
 	ctx context.Context, checkpointTs uint64,
 	cfInfo *model.ChangeFeedInfo,
@@ -455,6 +491,7 @@ func (c *changefeed) tick(ctx context.Context,
 		return 0, 0, nil
 	}
 
+	c.checkPlacementConstraints(cfInfo, captures)
 	watermark, err := c.scheduler.Tick(
 		ctx, preCheckpointTs, allPhysicalTables, captures,
 		barrier)
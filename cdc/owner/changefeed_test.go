@@ -33,6 +33,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/scheduler/schedulepb"
 	"github.com/pingcap/tiflow/cdc/vars"
 	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/etcd"
 	"github.com/pingcap/tiflow/pkg/filter"
 	"github.com/pingcap/tiflow/pkg/orchestrator"
@@ -340,6 +341,35 @@ func TestChangefeedHandleError(t *testing.T) {
 	require.Equal(t, state.Info.Error.Message, "fake error")
 }
 
+func TestChangefeedPlacementConstraintsWarning(t *testing.T) {
+	globalvars, changefeedInfo := vars.NewGlobalVarsAndChangefeedInfo4Test()
+	ctx := context.Background()
+	changefeedInfo.Config.Scheduler.PlacementConstraints = []*config.PlacementConstraint{
+		{Key: "pool", Op: config.PlacementOpIn, Values: []string{"payment"}},
+	}
+	cf, captures, tester, state := createChangefeed4Test(globalvars, changefeedInfo, newMockDDLSink, t)
+	defer cf.Close(ctx)
+
+	// no capture has the label.
+	cf.checkPlacementConstraints(state.Info, captures)
+	tester.MustApplyPatches()
+	require.Equal(t, model.StateWarning, state.Info.State)
+	require.Equal(t, string(cerror.ErrPlacementConstraintUnsatisfiable.RFCCode()), state.Info.Warning.Code)
+
+	// the warning is not reported again in a short time.
+	lastWarning := cf.lastPlacementWarning
+	cf.checkPlacementConstraints(state.Info, captures)
+	require.Equal(t, lastWarning, cf.lastPlacementWarning)
+
+	// a capture has the label.
+	cf.lastPlacementWarning = time.Time{}
+	for _, capture := range captures {
+		capture.Labels = map[string]string{"pool": "payment"}
+	}
+	cf.checkPlacementConstraints(state.Info, captures)
+	require.True(t, cf.lastPlacementWarning.IsZero())
+}
+
 func TestTrySendBootstrapMeetError(t *testing.T) {
 	helper := entry.NewSchemaTestHelper(t)
 	defer helper.Close()
@@ -31,6 +31,8 @@ type ChangefeedState interface {
 	SetWarning(*model.RunningError)
 	// TakeProcessorWarnings reuturns the warning of the changefeed and clean the warning.
 	TakeProcessorWarnings() []*model.RunningError
+	// SetScheduledPause sets the pause window the changefeed is in
+	SetScheduledPause(*model.ScheduledPause)
 	// SetError sets the error to changefeed
 	SetError(*model.RunningError)
 	// TakeProcessorErrors reuturns the error of the changefeed and clean the error.
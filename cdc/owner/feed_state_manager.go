@@ -21,6 +21,7 @@ import (
 	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
 	cerrors "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/upstream"
 	"github.com/pingcap/tiflow/pkg/util"
@@ -181,6 +182,13 @@ func (m *feedStateManager) Tick(resolvedTs model.Ts,
 		return
 	}
 
+	if m.handlePauseWindows(info) {
+		// the changefeed is paused or resumed by a pause window,
+		// skip to the next tick until the new state is applied
+		adminJobPending = true
+		return
+	}
+
 	switch info.State {
 	case model.StateUnInitialized:
 		m.patchState(model.StateNormal)
@@ -285,6 +293,19 @@ func (m *feedStateManager) handleAdminJob() (jobsPending bool) {
 	case model.AdminStop:
 		switch m.state.GetChangefeedInfo().State {
 		case model.StateNormal, model.StateWarning, model.StatePending:
+		case model.StateStopped:
+			if pause := m.state.GetChangefeedInfo().ScheduledPause; pause != nil && !pause.Skipped {
+				// the changefeed is paused by a pause window, pausing it manually
+				// prevents the changefeed from being resumed when the window is over.
+				log.Info("changefeed paused by a pause window is paused manually",
+					zap.String("namespace", m.state.GetID().Namespace),
+					zap.String("changefeed", m.state.GetID().ID))
+				m.shouldBeRunning = false
+				jobsPending = true
+				m.state.SetScheduledPause(nil)
+				return
+			}
+			fallthrough
 		default:
 			log.Warn("can not pause the changefeed in the current state",
 				zap.String("namespace", m.state.GetID().Namespace),
@@ -323,6 +344,13 @@ func (m *feedStateManager) handleAdminJob() (jobsPending bool) {
 		jobsPending = true
 		m.patchState(model.StateNormal)
 		m.state.ResumeChangefeed(job.OverwriteCheckpointTs)
+		// a changefeed resumed manually during a pause window
+		// is not paused again until the window is over.
+		if until, active := m.activePauseWindowUntil(m.state.GetChangefeedInfo()); active {
+			m.state.SetScheduledPause(&model.ScheduledPause{Until: until, Skipped: true})
+		} else {
+			m.state.SetScheduledPause(nil)
+		}
 
 	case model.AdminFinish:
 		switch m.state.GetChangefeedInfo().State {
@@ -347,6 +375,70 @@ func (m *feedStateManager) handleAdminJob() (jobsPending bool) {
 	return
 }
 
+// handlePauseWindows pauses the changefeed when it enters a pause window, and
+// resumes the changefeed paused by a pause window once the window is over.
+// It returns true if the state of the changefeed is changed.
+func (m *feedStateManager) handlePauseWindows(info *model.ChangeFeedInfo) bool {
+	if info == nil || info.Config == nil {
+		return false
+	}
+	pause := info.ScheduledPause
+	if len(info.Config.PauseWindows) == 0 && pause == nil {
+		return false
+	}
+	until, active := m.activePauseWindowUntil(info)
+
+	switch info.State {
+	case model.StateNormal, model.StateWarning, model.StatePending:
+		if !active {
+			if pause != nil {
+				m.state.SetScheduledPause(nil)
+			}
+			return false
+		}
+		if pause != nil && pause.Skipped {
+			m.state.SetScheduledPause(&model.ScheduledPause{Until: until, Skipped: true})
+			return false
+		}
+		log.Info("changefeed enters a pause window, it will be paused",
+			zap.String("namespace", m.state.GetID().Namespace),
+			zap.String("changefeed", m.state.GetID().ID),
+			zap.Time("until", until))
+		m.shouldBeRunning = false
+		m.patchState(model.StateStopped)
+		m.state.SetScheduledPause(&model.ScheduledPause{Until: until})
+		return true
+	case model.StateStopped:
+		if pause == nil || pause.Skipped {
+			return false
+		}
+		if active {
+			// the window may be extended by an overlapped one.
+			m.state.SetScheduledPause(&model.ScheduledPause{Until: until})
+			return false
+		}
+		log.Info("pause window is over, changefeed will be resumed",
+			zap.String("namespace", m.state.GetID().Namespace),
+			zap.String("changefeed", m.state.GetID().ID),
+			zap.Time("until", pause.Until))
+		m.resetErrRetry()
+		m.isRetrying = false
+		m.patchState(model.StateNormal)
+		m.state.SetScheduledPause(nil)
+		m.state.ResumeChangefeed(0)
+		return true
+	default:
+	}
+	return false
+}
+
+func (m *feedStateManager) activePauseWindowUntil(info *model.ChangeFeedInfo) (time.Time, bool) {
+	if info == nil || info.Config == nil || len(info.Config.PauseWindows) == 0 {
+		return time.Time{}, false
+	}
+	return config.ActivePauseWindowUntil(info.Config.PauseWindows, m.upstream.PDClock.CurrentTime())
+}
+
 func (m *feedStateManager) popAdminJob() *model.AdminJob {
 	if len(m.adminJobQueue) == 0 {
 		return nil
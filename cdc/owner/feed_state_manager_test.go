@@ -1166,3 +1166,107 @@ func TestUpdateChangefeedWithChangefeedErrorStuckDuration(t *testing.T) {
 	require.False(t, manager.ShouldRunning())
 	require.Equal(t, state.Info.State, model.StateFailed)
 }
+
+type mockClock struct {
+	pdutil.Clock
+	now time.Time
+}
+
+func (c *mockClock) CurrentTime() time.Time {
+	return c.now
+}
+
+func TestPauseWindows(t *testing.T) {
+	_, changefeedInfo := vars.NewGlobalVarsAndChangefeedInfo4Test()
+	manager := newFeedStateManager4Test(200, 1600, 0, 2.0)
+	day := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	clock := &mockClock{now: day}
+	manager.upstream.PDClock = clock
+	state := orchestrator.NewChangefeedReactorState(etcd.DefaultCDCClusterID,
+		model.DefaultChangeFeedID(changefeedInfo.ID))
+	manager.state = state
+	tester := orchestrator.NewReactorStateTester(t, state, nil)
+	state.PatchInfo(func(info *model.ChangeFeedInfo) (*model.ChangeFeedInfo, bool, error) {
+		require.Nil(t, info)
+		return &model.ChangeFeedInfo{SinkURI: "123", Config: &config.ReplicaConfig{
+			PauseWindows: []*config.PauseWindow{{Cron: "0 2 * * *", Duration: 2 * time.Hour}},
+		}}, true, nil
+	})
+	state.PatchStatus(func(status *model.ChangeFeedStatus) (*model.ChangeFeedStatus, bool, error) {
+		require.Nil(t, status)
+		return &model.ChangeFeedStatus{}, true, nil
+	})
+	tester.MustApplyPatches()
+	tick := func() bool {
+		pending := manager.Tick(0, state.Status, state.Info)
+		tester.MustApplyPatches()
+		return pending
+	}
+	tick()
+	require.True(t, manager.ShouldRunning())
+	require.Equal(t, model.StateNormal, state.Info.State)
+
+	// the changefeed is paused when it enters the window.
+	clock.now = day.Add(2*time.Hour + time.Minute)
+	require.True(t, tick())
+	require.False(t, manager.ShouldRunning())
+	require.Equal(t, model.StateStopped, state.Info.State)
+	require.Equal(t, model.AdminStop, state.Info.AdminJobType)
+	require.True(t, state.Info.ScheduledPause.Until.Equal(day.Add(4*time.Hour)))
+	require.False(t, state.Info.ScheduledPause.Skipped)
+	require.True(t, state.Info.NeedBlockGC())
+
+	// a new owner keeps the changefeed paused until the window is over.
+	manager = newFeedStateManager4Test(200, 1600, 0, 2.0)
+	manager.upstream.PDClock = clock
+	manager.state = state
+	clock.now = day.Add(3 * time.Hour)
+	tick()
+	require.False(t, manager.ShouldRunning())
+	require.Equal(t, model.StateStopped, state.Info.State)
+
+	// the changefeed is resumed once the window is over.
+	clock.now = day.Add(4 * time.Hour)
+	require.True(t, tick())
+	require.True(t, manager.ShouldRunning())
+	require.Equal(t, model.StateNormal, state.Info.State)
+	require.Equal(t, model.AdminNone, state.Info.AdminJobType)
+	require.Nil(t, state.Info.ScheduledPause)
+	tick()
+	require.True(t, manager.ShouldRunning())
+
+	// a changefeed resumed manually during the window is not paused again.
+	clock.now = day.Add(26 * time.Hour)
+	tick()
+	require.Equal(t, model.StateStopped, state.Info.State)
+	manager.PushAdminJob(&model.AdminJob{
+		CfID: model.DefaultChangeFeedID(changefeedInfo.ID),
+		Type: model.AdminResume,
+	})
+	tick()
+	require.Equal(t, model.StateNormal, state.Info.State)
+	require.True(t, state.Info.ScheduledPause.Skipped)
+	clock.now = day.Add(27 * time.Hour)
+	tick()
+	require.True(t, manager.ShouldRunning())
+	require.Equal(t, model.StateNormal, state.Info.State)
+	clock.now = day.Add(28 * time.Hour)
+	tick()
+	require.True(t, manager.ShouldRunning())
+	require.Nil(t, state.Info.ScheduledPause)
+
+	// a changefeed paused manually during the window is not resumed.
+	clock.now = day.Add(50 * time.Hour)
+	tick()
+	require.Equal(t, model.StateStopped, state.Info.State)
+	manager.PushAdminJob(&model.AdminJob{
+		CfID: model.DefaultChangeFeedID(changefeedInfo.ID),
+		Type: model.AdminStop,
+	})
+	tick()
+	require.Nil(t, state.Info.ScheduledPause)
+	clock.now = day.Add(52 * time.Hour)
+	tick()
+	require.False(t, manager.ShouldRunning())
+	require.Equal(t, model.StateStopped, state.Info.State)
+}
@@ -155,6 +155,21 @@ func (mr *MockStatusProviderMockRecorder) GetProcessors(ctx interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProcessors", reflect.TypeOf((*MockStatusProvider)(nil).GetProcessors), ctx)
 }
 
+// GetSpanStatuses mocks base method.
+func (m *MockStatusProvider) GetSpanStatuses(ctx context.Context, changefeedID model.ChangeFeedID) ([]*model.SpanReplicationStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSpanStatuses", ctx, changefeedID)
+	ret0, _ := ret[0].([]*model.SpanReplicationStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSpanStatuses indicates an expected call of GetSpanStatuses.
+func (mr *MockStatusProviderMockRecorder) GetSpanStatuses(ctx, changefeedID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpanStatuses", reflect.TypeOf((*MockStatusProvider)(nil).GetSpanStatuses), ctx, changefeedID)
+}
+
 // IsChangefeedExists mocks base method.
 func (m *MockStatusProvider) IsChangefeedExists(ctx context.Context, id model.ChangeFeedID) (bool, error) {
 	m.ctrl.T.Helper()
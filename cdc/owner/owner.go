@@ -235,6 +235,8 @@ func (o *ownerImpl) Tick(stdCtx context.Context, rawState orchestrator.ReactorSt
 		}
 		checkpointTs, minTableBarrierTs := cfReactor.Tick(stdCtx, changefeedState.Info, changefeedState.Status, captures)
 		updateStatus(changefeedState, checkpointTs, minTableBarrierTs)
+		cfReactor.deadLetterCount = sumDeadLetterCount(changefeedState.TaskPositions)
+		cfReactor.rateLimited = anyRateLimited(changefeedState.TaskPositions)
 	}
 	o.changefeedTicked = true
 
@@ -322,6 +324,29 @@ func preflightCheck(changefeed *orchestrator.ChangefeedReactorState,
 	return
 }
 
+// sumDeadLetterCount returns the total number of rows written to the
+// dead-letter queue reported by the processors.
+func sumDeadLetterCount(positions map[model.CaptureID]*model.TaskPosition) uint64 {
+	var count uint64
+	for _, position := range positions {
+		if position != nil {
+			count += position.DeadLetterCount
+		}
+	}
+	return count
+}
+
+// anyRateLimited returns whether any processor reports that it's throttled
+// by the rate limit of the changefeed.
+func anyRateLimited(positions map[model.CaptureID]*model.TaskPosition) bool {
+	for _, position := range positions {
+		if position != nil && position.RateLimited {
+			return true
+		}
+	}
+	return false
+}
+
 func updateStatus(changefeed *orchestrator.ChangefeedReactorState,
 	checkpointTs, minTableBarrierTs model.Ts,
 ) {
@@ -672,6 +697,8 @@ func (o *ownerImpl) handleQueries(query *Query) error {
 		ret := &model.ChangeFeedStatusForAPI{}
 		ret.ResolvedTs = cfReactor.resolvedTs
 		ret.CheckpointTs = cfReactor.latestStatus.CheckpointTs
+		ret.DeadLetterCount = cfReactor.deadLetterCount
+		ret.RateLimited = cfReactor.rateLimited
 		query.Data = ret
 	case QueryChangeFeedSyncedStatus:
 		cfReactor, ok := o.changefeeds[query.ChangeFeedID]
@@ -727,6 +754,24 @@ func (o *ownerImpl) handleQueries(query *Query) error {
 			return errors.Trace(err)
 		}
 		query.Data = ret
+	case QuerySpanStatuses:
+		cfReactor, ok := o.changefeeds[query.ChangeFeedID]
+		if !ok {
+			return cerror.ErrChangeFeedNotExists.GenWithStackByArgs(query.ChangeFeedID)
+		}
+
+		provider := cfReactor.GetInfoProvider()
+		if provider == nil {
+			// The scheduler has not been initialized yet.
+			query.Data = []*model.SpanReplicationStatus{}
+			return nil
+		}
+
+		ret, err := provider.GetSpanStatuses()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		query.Data = ret
 	case QueryProcessors:
 		var ret []*model.ProcInfoSnap
 		for cfID, cfReactor := range o.changefeeds {
@@ -996,3 +996,28 @@ func TestFixChangefeedSinkProtocol(t *testing.T) {
 	require.Equal(t, owner.changefeeds[changefeedID].latestInfo.SinkURI,
 		"kafka://127.0.0.1:9092/ticdc-test2?protocol=open-protocol")
 }
+
+func TestSumDeadLetterCount(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, uint64(0), sumDeadLetterCount(nil))
+	require.Equal(t, uint64(5), sumDeadLetterCount(map[model.CaptureID]*model.TaskPosition{
+		"capture-1": {DeadLetterCount: 2},
+		"capture-2": {DeadLetterCount: 3},
+		"capture-3": nil,
+	}))
+}
+
+func TestAnyRateLimited(t *testing.T) {
+	t.Parallel()
+
+	require.False(t, anyRateLimited(nil))
+	require.False(t, anyRateLimited(map[model.CaptureID]*model.TaskPosition{
+		"capture-1": {},
+		"capture-2": nil,
+	}))
+	require.True(t, anyRateLimited(map[model.CaptureID]*model.TaskPosition{
+		"capture-1": {},
+		"capture-2": {RateLimited: true},
+	}))
+}
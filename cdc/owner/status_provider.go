@@ -44,6 +44,9 @@ type StatusProvider interface {
 	// GetAllTaskStatuses returns the task statuses for the specified changefeed.
 	GetAllTaskStatuses(ctx context.Context, changefeedID model.ChangeFeedID) (map[model.CaptureID]*model.TaskStatus, error)
 
+	// GetSpanStatuses returns the replication statuses of all spans for the specified changefeed.
+	GetSpanStatuses(ctx context.Context, changefeedID model.ChangeFeedID) ([]*model.SpanReplicationStatus, error)
+
 	// GetProcessors returns the statuses of all processors
 	GetProcessors(ctx context.Context) ([]*model.ProcInfoSnap, error)
 
@@ -82,6 +85,8 @@ const (
 	QueryAllChangeFeedSCheckpointTs
 	// QueryExists is the type of query check if a changefeed exists
 	QueryExists
+	// QuerySpanStatuses is the type of query all span replication statuses.
+	QuerySpanStatuses
 )
 
 // Query wraps query command and return results.
@@ -162,6 +167,19 @@ func (p *ownerStatusProvider) GetAllTaskStatuses(ctx context.Context,
 	return query.Data.(map[model.CaptureID]*model.TaskStatus), nil
 }
 
+func (p *ownerStatusProvider) GetSpanStatuses(ctx context.Context,
+	changefeedID model.ChangeFeedID,
+) ([]*model.SpanReplicationStatus, error) {
+	query := &Query{
+		Tp:           QuerySpanStatuses,
+		ChangeFeedID: changefeedID,
+	}
+	if err := p.sendQueryToOwner(ctx, query); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return query.Data.([]*model.SpanReplicationStatus), nil
+}
+
 func (p *ownerStatusProvider) GetProcessors(ctx context.Context) ([]*model.ProcInfoSnap, error) {
 	query := &Query{
 		Tp: QueryProcessors,
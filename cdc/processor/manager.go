@@ -165,7 +165,14 @@ func (m *managerImpl) Tick(stdCtx context.Context, state orchestrator.ReactorSta
 			// patchProcessorErr have already patched its error to tell the owner
 			// manager can just close the processor and continue to tick other processors
 			m.closeProcessor(changefeedID)
+			continue
+		}
+		if count, ok := p.deadLetterCount(); ok {
+			patchDeadLetterCount(p.captureInfo, changefeedState, count)
 		}
+		patchRateLimited(p.captureInfo, changefeedState, p.rateLimited())
+		// Each capture replicating the changefeed has a task position.
+		p.setCaptureCount(len(changefeedState.TaskPositions))
 	}
 	// check if the processors in memory is leaked
 	if len(globalState.Changefeeds)-inactiveChangefeedCount != len(m.processors) {
@@ -277,6 +284,51 @@ func patchProcessorWarning(captureInfo *model.CaptureInfo,
 		})
 }
 
+// patchDeadLetterCount patches the dead-letter count to the task position
+// if it is changed, so that the owner can report it in changefeed status.
+func patchDeadLetterCount(captureInfo *model.CaptureInfo,
+	changefeed *orchestrator.ChangefeedReactorState, count uint64,
+) {
+	if position, ok := changefeed.TaskPositions[captureInfo.ID]; ok &&
+		position.DeadLetterCount == count {
+		return
+	}
+	changefeed.PatchTaskPosition(captureInfo.ID,
+		func(position *model.TaskPosition) (*model.TaskPosition, bool, error) {
+			if position == nil {
+				position = &model.TaskPosition{}
+			}
+			if position.DeadLetterCount == count {
+				return position, false, nil
+			}
+			position.DeadLetterCount = count
+			return position, true, nil
+		})
+}
+
+// patchRateLimited patches whether the processor is throttled by the rate
+// limit to the task position if it is changed, so that the owner can report
+// the lag of the changefeed is self-imposed.
+func patchRateLimited(captureInfo *model.CaptureInfo,
+	changefeed *orchestrator.ChangefeedReactorState, rateLimited bool,
+) {
+	if position, ok := changefeed.TaskPositions[captureInfo.ID]; ok &&
+		position.RateLimited == rateLimited {
+		return
+	}
+	changefeed.PatchTaskPosition(captureInfo.ID,
+		func(position *model.TaskPosition) (*model.TaskPosition, bool, error) {
+			if position == nil {
+				position = &model.TaskPosition{}
+			}
+			if position.RateLimited == rateLimited {
+				return position, false, nil
+			}
+			position.RateLimited = rateLimited
+			return position, true, nil
+		})
+}
+
 func (m *managerImpl) closeProcessor(changefeedID model.ChangeFeedID) {
 	processor, exist := m.processors[changefeedID]
 	if exist {
@@ -44,6 +44,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/retry"
 	"github.com/pingcap/tiflow/pkg/sink"
 	"github.com/pingcap/tiflow/pkg/sink/mysql"
+	"github.com/pingcap/tiflow/pkg/transform"
 	"github.com/pingcap/tiflow/pkg/upstream"
 	"github.com/pingcap/tiflow/pkg/util"
 	"github.com/prometheus/client_golang/prometheus"
@@ -389,8 +390,10 @@ func (p *processor) getStatsFromSourceManagerAndSinkManager(
 	pullerStats := p.sourceManager.r.GetTablePullerStats(span)
 
 	stats := tablepb.Stats{
-		RegionCount: pullerStats.RegionCount,
-		BarrierTs:   sinkStats.BarrierTs,
+		RegionCount:        pullerStats.RegionCount,
+		BarrierTs:          sinkStats.BarrierTs,
+		ReceivedEventCount: sinkStats.ReceivedEventCount,
+		MemoryQuotaWaitMs:  uint64(sinkStats.MemQuotaWaitDuration.Milliseconds()),
 		StageCheckpoints: map[string]tablepb.Checkpoint{
 			"puller-ingress": {
 				CheckpointTs: pullerStats.CheckpointTsIngress,
@@ -413,8 +416,14 @@ func (p *processor) getStatsFromSourceManagerAndSinkManager(
 		ResolvedTs:   sortStats.ReceivedMaxResolvedTs,
 	}
 	stats.StageCheckpoints["sorter-egress"] = tablepb.Checkpoint{
-		CheckpointTs: sinkStats.ResolvedTs,
-		ResolvedTs:   sinkStats.ResolvedTs,
+		CheckpointTs: sinkStats.SorterResolvedTs,
+		ResolvedTs:   sinkStats.SorterResolvedTs,
+	}
+	if p.redo.r.Enabled() {
+		stats.StageCheckpoints["redo"] = tablepb.Checkpoint{
+			CheckpointTs: sinkStats.RedoResolvedTs,
+			ResolvedTs:   sinkStats.RedoResolvedTs,
+		}
 	}
 
 	return stats
@@ -657,9 +666,13 @@ func (p *processor) lazyInitImpl(_ context.Context) (err error) {
 	p.ddlHandler.changefeedID = p.changefeedID
 	p.ddlHandler.spawn(ctx)
 
+	transformer, err := transform.New(cfConfig, p.upstream.ID, tz)
+	if err != nil {
+		return errors.Trace(err)
+	}
 	p.mg.r = entry.NewMounterGroup(p.ddlHandler.r.schemaStorage,
 		cfConfig.Mounter.WorkerNum,
-		p.filter, tz, p.changefeedID, cfConfig.Integrity)
+		p.filter, tz, p.changefeedID, cfConfig.Integrity, transformer)
 	p.mg.name = "MounterGroup"
 	p.mg.changefeedID = p.changefeedID
 	p.mg.spawn(ctx)
@@ -881,6 +894,33 @@ func (p *processor) doGCSchemaStorage() {
 	p.metricSchemaStorageGcTsGauge.Set(float64(lastSchemaPhysicalTs))
 }
 
+// deadLetterCount returns the number of rows written to the dead-letter queue
+// by the processor. It returns false if the count is not available for now.
+func (p *processor) deadLetterCount() (uint64, bool) {
+	if !p.initialized.Load() {
+		return 0, false
+	}
+	return p.sinkManager.r.DeadLetterCount()
+}
+
+// rateLimited returns whether the processor is throttled by the rate limit
+// recently. It returns false before the processor is initialized.
+func (p *processor) rateLimited() bool {
+	if !p.initialized.Load() {
+		return false
+	}
+	return p.sinkManager.r.RateLimited()
+}
+
+// setCaptureCount sets the number of captures replicating the changefeed,
+// so that the rate limit of the changefeed is divided among them.
+func (p *processor) setCaptureCount(n int) {
+	if !p.initialized.Load() {
+		return
+	}
+	p.sinkManager.r.SetCaptureCount(n)
+}
+
 func (p *processor) refreshMetrics() {
 	// Before the processor is initialized, we should not refresh metrics.
 	// Otherwise, it will cause panic.
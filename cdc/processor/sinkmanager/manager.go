@@ -60,6 +60,17 @@ type TableStats struct {
 	ResolvedTs   model.Ts
 	LastSyncedTs model.Ts
 	BarrierTs    model.Ts
+	// ReceivedEventCount is the total number of row changed events
+	// received by the table sink.
+	ReceivedEventCount uint64
+	// SorterResolvedTs is the resolved ts received from the sorter.
+	SorterResolvedTs model.Ts
+	// RedoResolvedTs is the resolved ts of the redo log,
+	// it's 0 if the redo log is disabled.
+	RedoResolvedTs model.Ts
+	// MemQuotaWaitDuration is the total time the table has waited
+	// for the memory quota.
+	MemQuotaWaitDuration time.Duration
 }
 
 // SinkManager is the implementation of SinkManager.
@@ -94,6 +105,9 @@ type SinkManager struct {
 		// sink factories in table sinks.
 		version uint64
 		errors  chan error
+		// deadLetterCount is the number of rows written to the dead-letter
+		// queue by the closed sink factories.
+		deadLetterCount uint64
 	}
 
 	// tableSinks is a map from tableID to tableSink.
@@ -107,6 +121,9 @@ type SinkManager struct {
 	// sinkMemQuota is used to control the total memory usage of the table sink.
 	sinkMemQuota *memquota.MemQuota
 	sinkRetry    *retry.ErrorRetry
+	// sinkRateLimiter is used to control the throughput of the table sink,
+	// it's nil if `sink.rate-limit` is not set.
+	sinkRateLimiter *rateLimiter
 	// redoWorkers used to pull data from source manager.
 	redoWorkers []*redoWorker
 	// redoTaskChan is used to send tasks to redoWorkers.
@@ -189,6 +206,8 @@ func New(
 		m.redoMemQuota = memquota.NewMemQuota(changefeedID, 0, "redo")
 	}
 
+	m.sinkRateLimiter = newRateLimiter(changefeedID, config.Sink.RateLimit)
+
 	m.ready = make(chan struct{})
 	return m
 }
@@ -382,6 +401,7 @@ func (m *SinkManager) clearSinkFactory() {
 			zap.String("changefeed", m.changefeedID.ID),
 			zap.Uint64("factoryVersion", m.sinkFactory.version))
 		m.sinkFactory.f.Close()
+		m.sinkFactory.deadLetterCount += m.sinkFactory.f.DeadLetterCount()
 		m.sinkFactory.f = nil
 		log.Info("Sink manager has closed sink factory",
 			zap.String("namespace", m.changefeedID.Namespace),
@@ -396,10 +416,37 @@ func (m *SinkManager) clearSinkFactory() {
 	}
 }
 
+// DeadLetterCount returns the number of rows written to the dead-letter queue
+// since the sink manager is created. It returns false if the sink factory is
+// busy, the caller can try again later.
+func (m *SinkManager) DeadLetterCount() (uint64, bool) {
+	if !m.sinkFactory.TryLock() {
+		return 0, false
+	}
+	defer m.sinkFactory.Unlock()
+	count := m.sinkFactory.deadLetterCount
+	if m.sinkFactory.f != nil {
+		count += m.sinkFactory.f.DeadLetterCount()
+	}
+	return count, true
+}
+
+// RateLimited returns whether the sink workers are throttled by the rate limit
+// recently, which means the lag of the changefeed is self-imposed.
+func (m *SinkManager) RateLimited() bool {
+	return m.sinkRateLimiter.isThrottled()
+}
+
+// SetCaptureCount sets the number of captures replicating the changefeed,
+// the rate limit of the changefeed is divided evenly among them.
+func (m *SinkManager) SetCaptureCount(n int) {
+	m.sinkRateLimiter.setCaptureCount(n)
+}
+
 func (m *SinkManager) startSinkWorkers(ctx context.Context, eg *errgroup.Group, splitTxn bool) {
 	for i := 0; i < sinkWorkerNum; i++ {
 		w := newSinkWorker(m.changefeedID, m.sourceManager,
-			m.sinkMemQuota, splitTxn)
+			m.sinkMemQuota, m.sinkRateLimiter, splitTxn)
 		m.sinkWorkers = append(m.sinkWorkers, w)
 		eg.Go(func() error { return w.handleTasks(ctx, m.sinkTaskChan) })
 	}
@@ -579,14 +626,17 @@ func (m *SinkManager) generateSinkTasks(ctx context.Context) error {
 
 			// The table has no available progress.
 			if lowerBound.Compare(upperBound) >= 0 {
+				tableSink.sinkMemQuotaWait.stop()
 				m.sinkProgressHeap.push(slowestTableProgress)
 				continue
 			}
 
 			// No available memory, skip this round directly.
 			if !m.sinkMemQuota.TryAcquire(requestMemSize) {
+				tableSink.sinkMemQuotaWait.start()
 				break LOOP
 			}
+			tableSink.sinkMemQuotaWait.stop()
 
 			log.Debug("MemoryQuotaTracing: try acquire memory for table sink task",
 				zap.String("namespace", m.changefeedID.Namespace),
@@ -711,14 +761,17 @@ func (m *SinkManager) generateRedoTasks(ctx context.Context) error {
 
 			// The table has no available progress.
 			if lowerBound.Compare(upperBound) >= 0 {
+				tableSink.redoMemQuotaWait.stop()
 				m.redoProgressHeap.push(slowestTableProgress)
 				continue
 			}
 
 			// No available memory, skip this round directly.
 			if !m.redoMemQuota.TryAcquire(requestMemSize) {
+				tableSink.redoMemQuotaWait.start()
 				break LOOP
 			}
+			tableSink.redoMemQuotaWait.stop()
 
 			log.Debug("MemoryQuotaTracing: try acquire memory for redo log task",
 				zap.String("namespace", m.changefeedID.Namespace),
@@ -1003,12 +1056,14 @@ func (m *SinkManager) GetTableStats(span tablepb.Span) TableStats {
 	m.sinkMemQuota.Release(span, checkpointTs)
 	m.redoMemQuota.Release(span, checkpointTs)
 
-	var resolvedTs model.Ts
+	var resolvedTs, redoResolvedTs model.Ts
+	sorterResolvedTs := tableSink.getReceivedSorterResolvedTs()
 	// If redo log is enabled, we have to use redo log's resolved ts to calculate processor's min resolved ts.
 	if m.redoDMLMgr != nil {
-		resolvedTs = m.redoDMLMgr.GetResolvedTs(span)
+		redoResolvedTs = m.redoDMLMgr.GetResolvedTs(span)
+		resolvedTs = redoResolvedTs
 	} else {
-		resolvedTs = tableSink.getReceivedSorterResolvedTs()
+		resolvedTs = sorterResolvedTs
 	}
 
 	sinkUpperBound := tableSink.getUpperBoundTs()
@@ -1025,6 +1080,12 @@ func (m *SinkManager) GetTableStats(span tablepb.Span) TableStats {
 		ResolvedTs:   resolvedTs,
 		LastSyncedTs: lastSyncedTs,
 		BarrierTs:    tableSink.barrierTs.Load(),
+
+		ReceivedEventCount: tableSink.getReceivedEventCount(),
+
+		SorterResolvedTs:     sorterResolvedTs,
+		RedoResolvedTs:       redoResolvedTs,
+		MemQuotaWaitDuration: tableSink.getMemQuotaWaitDuration(),
 	}
 }
 
@@ -1057,6 +1118,7 @@ func (m *SinkManager) Close() {
 	m.waitSubroutines()
 	// NOTE: It's unnecceary to close table sinks before clear sink factory.
 	m.clearSinkFactory()
+	m.sinkRateLimiter.close()
 
 	log.Info("Closed sink manager",
 		zap.String("namespace", m.changefeedID.Namespace),
@@ -46,6 +46,14 @@ var (
 		Name:      "output_event_count",
 		Help:      "The number of events output by the sorter",
 	}, []string{"namespace", "changefeed", "type"})
+
+	// rateLimitThrottledDuration is the time the sink workers are throttled by the rate limit.
+	rateLimitThrottledDuration = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "ticdc",
+		Subsystem: "sinkmanager",
+		Name:      "rate_limit_throttled_seconds",
+		Help:      "The time the sink workers are throttled by the rate limit of the changefeed",
+	}, []string{"namespace", "changefeed"})
 )
 
 // InitMetrics registers all metrics in this file.
@@ -53,4 +61,5 @@ func InitMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(RedoEventCache)
 	registry.MustRegister(RedoEventCacheAccess)
 	registry.MustRegister(outputEventCount)
+	registry.MustRegister(rateLimitThrottledDuration)
 }
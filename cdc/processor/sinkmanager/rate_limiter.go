@@ -0,0 +1,233 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sinkmanager
+
+import (
+	"context"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	pconfig "github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/util"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+)
+
+// rateLimitedReportWindow is how long a changefeed is reported as rate limited
+// after the last time it's throttled.
+const rateLimitedReportWindow = 10 * time.Second
+
+// tokenBucket is a token bucket which can be in debt, so that an event larger
+// than the burst can still pass, and the following events wait for the debt.
+type tokenBucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate uint64, now time.Time) *tokenBucket {
+	return &tokenBucket{
+		rate:   float64(rate),
+		burst:  float64(rate),
+		tokens: float64(rate),
+		last:   now,
+	}
+}
+
+// setRate changes the rate and the burst of the bucket, the tokens exceeding
+// the new burst are dropped.
+func (b *tokenBucket) setRate(rate uint64) {
+	b.rate = float64(rate)
+	b.burst = float64(rate)
+	b.tokens = math.Min(b.tokens, b.burst)
+}
+
+// take consumes n tokens, and returns how long the caller should wait until
+// the bucket is out of debt.
+func (b *tokenBucket) take(now time.Time, n float64) time.Duration {
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens = math.Min(b.burst, b.tokens+elapsed.Seconds()*b.rate)
+		b.last = now
+	}
+	b.tokens -= n
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// rateLimiter limits the throughput of the table sink tasks of a changefeed,
+// it's shared by all sink workers.
+//
+// The limit is set for the whole changefeed, so it's divided evenly among the
+// captures replicating the changefeed, and each capture enforces its part.
+//
+// To share the throughput fairly across tables, a task only takes its share of
+// the limit of one task generating interval, and then yields the worker at the
+// next transaction boundary. The tables are scheduled in the order of their
+// progress, so the slowest tables get the throughput first.
+type rateLimiter struct {
+	changefeedID model.ChangeFeedID
+
+	// rowsLimit and bytesLimit are the limits of the whole changefeed.
+	rowsLimit  uint64
+	bytesLimit uint64
+
+	mu    sync.Mutex
+	rows  *tokenBucket
+	bytes *tokenBucket
+	// captureCount is the number of captures the limit is divided among.
+	captureCount int
+
+	// taskRows and taskBytes are the share of a task.
+	taskRows  uint64
+	taskBytes uint64
+
+	// lastThrottled is the unix nano of the last time a task is throttled.
+	lastThrottled atomic.Int64
+
+	metricThrottledDuration prometheus.Counter
+}
+
+// newRateLimiter creates a rateLimiter, it returns nil if no limit is set.
+func newRateLimiter(changefeedID model.ChangeFeedID, cfg *pconfig.RateLimitConfig) *rateLimiter {
+	if !cfg.IsEnabled() {
+		return nil
+	}
+	now := time.Now()
+	l := &rateLimiter{
+		changefeedID: changefeedID,
+		rowsLimit:    util.GetOrZero(cfg.RowsPerSecond),
+		bytesLimit:   util.GetOrZero(cfg.BytesPerSecond),
+		captureCount: 1,
+		metricThrottledDuration: rateLimitThrottledDuration.
+			WithLabelValues(changefeedID.Namespace, changefeedID.ID),
+	}
+	if l.rowsLimit > 0 {
+		l.rows = newTokenBucket(l.rowsLimit, now)
+		l.taskRows = taskShare(l.rowsLimit)
+	}
+	if l.bytesLimit > 0 {
+		l.bytes = newTokenBucket(l.bytesLimit, now)
+		l.taskBytes = taskShare(l.bytesLimit)
+	}
+	log.Info("Sink manager rate limit is enabled",
+		zap.String("namespace", changefeedID.Namespace),
+		zap.String("changefeed", changefeedID.ID),
+		zap.Uint64("rowsPerSecond", util.GetOrZero(cfg.RowsPerSecond)),
+		zap.Uint64("bytesPerSecond", util.GetOrZero(cfg.BytesPerSecond)))
+	return l
+}
+
+// taskShare returns the share of a task, which is the limit of one task
+// generating interval divided by the number of sink workers.
+func taskShare(rate uint64) uint64 {
+	n := uint64(float64(rate) * defaultGenerateTaskInterval.Seconds() / sinkWorkerNum)
+	return max(n, 1)
+}
+
+// setCaptureCount divides the limit of the changefeed among the given number
+// of captures.
+func (l *rateLimiter) setCaptureCount(n int) {
+	if l == nil {
+		return
+	}
+	n = max(n, 1)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if n == l.captureCount {
+		return
+	}
+	l.captureCount = n
+	if l.rows != nil {
+		rate := max(l.rowsLimit/uint64(n), 1)
+		l.rows.setRate(rate)
+		l.taskRows = taskShare(rate)
+	}
+	if l.bytes != nil {
+		rate := max(l.bytesLimit/uint64(n), 1)
+		l.bytes.setRate(rate)
+		l.taskBytes = taskShare(rate)
+	}
+	log.Info("Sink manager rate limit is divided among captures",
+		zap.String("namespace", l.changefeedID.Namespace),
+		zap.String("changefeed", l.changefeedID.ID),
+		zap.Int("captureCount", n),
+		zap.Uint64("taskRows", l.taskRows),
+		zap.Uint64("taskBytes", l.taskBytes))
+}
+
+// wait blocks until the given rows can be written without exceeding the limit.
+func (l *rateLimiter) wait(ctx context.Context, rows int, bytes uint64) error {
+	if l == nil || (rows == 0 && bytes == 0) {
+		return nil
+	}
+
+	var delay time.Duration
+	now := time.Now()
+	l.mu.Lock()
+	if l.rows != nil {
+		delay = max(delay, l.rows.take(now, float64(rows)))
+	}
+	if l.bytes != nil {
+		delay = max(delay, l.bytes.take(now, float64(bytes)))
+	}
+	l.mu.Unlock()
+	if delay <= 0 {
+		return nil
+	}
+
+	l.lastThrottled.Store(now.UnixNano())
+	l.metricThrottledDuration.Add(delay.Seconds())
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// taskQuotaExhausted returns whether a task has taken its share of the limit.
+func (l *rateLimiter) taskQuotaExhausted(rows int, bytes uint64) bool {
+	if l == nil {
+		return false
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return (l.rows != nil && uint64(rows) >= l.taskRows) ||
+		(l.bytes != nil && bytes >= l.taskBytes)
+}
+
+// isThrottled returns whether any task is throttled recently.
+func (l *rateLimiter) isThrottled() bool {
+	if l == nil {
+		return false
+	}
+	last := l.lastThrottled.Load()
+	return last > 0 && time.Since(time.Unix(0, last)) < rateLimitedReportWindow
+}
+
+func (l *rateLimiter) close() {
+	if l == nil {
+		return
+	}
+	rateLimitThrottledDuration.DeleteLabelValues(l.changefeedID.Namespace, l.changefeedID.ID)
+}
@@ -0,0 +1,115 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sinkmanager
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenBucket(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	b := newTokenBucket(100, now)
+	require.Zero(t, b.take(now, 60))
+	require.Zero(t, b.take(now, 40))
+	// The bucket can be in debt.
+	require.Equal(t, 500*time.Millisecond, b.take(now, 50))
+	// Refill 100 tokens, the bucket has 50 tokens.
+	now = now.Add(time.Second)
+	require.Zero(t, b.take(now, 50))
+	// The refilled tokens never exceed the burst.
+	now = now.Add(time.Hour)
+	require.Zero(t, b.take(now, 100))
+	require.Equal(t, 10*time.Millisecond, b.take(now, 1))
+}
+
+func TestRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	changefeedID := model.DefaultChangeFeedID("test-rate-limiter")
+	// No limit.
+	var l *rateLimiter
+	require.Nil(t, newRateLimiter(changefeedID, nil))
+	require.Nil(t, newRateLimiter(changefeedID, &config.RateLimitConfig{
+		RowsPerSecond: util.AddressOf(uint64(0)),
+	}))
+	require.NoError(t, l.wait(context.Background(), 1000, 1000))
+	require.False(t, l.taskQuotaExhausted(1000, 1000))
+	require.False(t, l.isThrottled())
+	l.close()
+
+	l = newRateLimiter(changefeedID, &config.RateLimitConfig{
+		RowsPerSecond:  util.AddressOf(uint64(1000)),
+		BytesPerSecond: util.AddressOf(uint64(800 * 1024)),
+	})
+	defer l.close()
+	// The share of a task is the limit of a task generating interval
+	// divided by the number of sink workers.
+	require.Equal(t, uint64(1000*0.1)/sinkWorkerNum, l.taskRows)
+	require.Equal(t, uint64(800*1024*0.1)/sinkWorkerNum, l.taskBytes)
+	require.False(t, l.taskQuotaExhausted(1, 1))
+	require.True(t, l.taskQuotaExhausted(int(l.taskRows), 1))
+	require.True(t, l.taskQuotaExhausted(1, l.taskBytes))
+
+	// The burst is not throttled.
+	require.NoError(t, l.wait(context.Background(), 1000, 1))
+	require.False(t, l.isThrottled())
+	// Throttled by the rows limit.
+	start := time.Now()
+	require.NoError(t, l.wait(context.Background(), 100, 1))
+	require.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+	require.True(t, l.isThrottled())
+
+	// The wait can be canceled.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.ErrorIs(t, l.wait(ctx, 0, 10*1024*1024), context.Canceled)
+}
+
+func TestRateLimiterSetCaptureCount(t *testing.T) {
+	t.Parallel()
+
+	var l *rateLimiter
+	l.setCaptureCount(2)
+
+	l = newRateLimiter(model.DefaultChangeFeedID("test-capture-count"), &config.RateLimitConfig{
+		RowsPerSecond:  util.AddressOf(uint64(1000)),
+		BytesPerSecond: util.AddressOf(uint64(800 * 1024)),
+	})
+	defer l.close()
+	taskRows, taskBytes := l.taskRows, l.taskBytes
+
+	// The limit of the changefeed is divided among the captures.
+	l.setCaptureCount(4)
+	require.Equal(t, float64(250), l.rows.rate)
+	require.Equal(t, float64(250), l.rows.tokens)
+	require.Equal(t, float64(200*1024), l.bytes.rate)
+	require.Equal(t, taskShare(250), l.taskRows)
+	require.Equal(t, taskShare(200*1024), l.taskBytes)
+
+	// A capture gets the whole limit if it's the only one.
+	l.setCaptureCount(0)
+	require.Equal(t, float64(1000), l.rows.rate)
+	require.Equal(t, float64(800*1024), l.bytes.rate)
+	require.Equal(t, taskRows, l.taskRows)
+	require.Equal(t, taskBytes, l.taskBytes)
+}
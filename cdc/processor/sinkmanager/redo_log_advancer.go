@@ -15,6 +15,7 @@ package sinkmanager
 
 import (
 	"context"
+	"time"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
@@ -173,9 +174,11 @@ func (a *redoLogAdvancer) tryAdvanceAndAcquireMem(
 		} else {
 			// NOTE: it's not required to use `forceAcquire` even if splitTxn is false.
 			// It's because memory will finally be `refund` after redo-logs are written.
+			waitStart := time.Now()
 			if err := a.memQuota.BlockAcquire(requestMemSize); err != nil {
 				return errors.Trace(err)
 			}
+			a.task.tableSink.redoMemQuotaWait.add(time.Since(waitStart))
 			a.availableMem += requestMemSize
 			log.Debug("MemoryQuotaTracing: block acquire memory for redo log task",
 				zap.String("namespace", a.task.tableSink.changefeed.Namespace),
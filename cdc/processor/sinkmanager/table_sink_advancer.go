@@ -14,6 +14,8 @@
 package sinkmanager
 
 import (
+	"time"
+
 	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
 	"github.com/pingcap/tiflow/cdc/model"
@@ -219,9 +221,11 @@ func (a *tableSinkAdvancer) tryAdvanceAndAcquireMem(
 				// NOTE: if splitTxn is true it's not required to force acquire memory.
 				// We can wait for a while because we already flushed some data to
 				// the table sink.
+				waitStart := time.Now()
 				if err := a.sinkMemQuota.BlockAcquire(requestMemSize); err != nil {
 					return errors.Trace(err)
 				}
+				a.task.tableSink.sinkMemQuotaWait.add(time.Since(waitStart))
 				a.availableMem += requestMemSize
 				log.Debug("MemoryQuotaTracing: block acquire memory for table sink task",
 					zap.String("namespace", a.task.tableSink.changefeed.Namespace),
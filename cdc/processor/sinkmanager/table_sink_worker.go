@@ -61,6 +61,8 @@ type sinkWorker struct {
 	changefeedID  model.ChangeFeedID
 	sourceManager *sourcemanager.SourceManager
 	sinkMemQuota  *memquota.MemQuota
+	// rateLimiter is shared by all sink workers, it can be nil.
+	rateLimiter *rateLimiter
 	// splitTxn indicates whether to split the transaction into multiple batches.
 	splitTxn bool
 
@@ -73,12 +75,14 @@ func newSinkWorker(
 	changefeedID model.ChangeFeedID,
 	sourceManager *sourcemanager.SourceManager,
 	sinkQuota *memquota.MemQuota,
+	rateLimiter *rateLimiter,
 	splitTxn bool,
 ) *sinkWorker {
 	return &sinkWorker{
 		changefeedID:  changefeedID,
 		sourceManager: sourceManager,
 		sinkMemQuota:  sinkQuota,
+		rateLimiter:   rateLimiter,
 		splitTxn:      splitTxn,
 
 		metricOutputEventCountKV: outputEventCount.WithLabelValues(changefeedID.Namespace, changefeedID.ID, "kv"),
@@ -118,6 +122,11 @@ func (w *sinkWorker) handleTask(ctx context.Context, task *sinkTask) (finalErr e
 	advancer.lastPos = lowerBound.Prev()
 
 	allEventCount := 0
+	// The rows and the size of them taken by the task, they are used
+	// to yield the worker if the changefeed is rate limited.
+	takenRows := 0
+	takenBytes := uint64(0)
+	yield := false
 
 	callbackIsPerformed := false
 	performCallback := func(pos sorter.Position) {
@@ -179,7 +188,8 @@ func (w *sinkWorker) handleTask(ctx context.Context, task *sinkTask) (finalErr e
 
 	// 1. We have enough memory to collect events.
 	// 2. The task is not canceled.
-	for advancer.hasEnoughMem() && !task.isCanceled() {
+	// 3. The task hasn't taken its share of the rate limit.
+	for advancer.hasEnoughMem() && !task.isCanceled() && !yield {
 		e, pos, err := iter.Next(ctx)
 		if err != nil {
 			return errors.Trace(err)
@@ -207,11 +217,18 @@ func (w *sinkWorker) handleTask(ctx context.Context, task *sinkTask) (finalErr e
 			e.Row.ReplicatingTs = task.tableSink.GetReplicaTs()
 			x, size := handleRowChangedEvents(w.changefeedID, task.span, e)
 			advancer.appendEvents(x, size)
+			if err := w.rateLimiter.wait(ctx, len(x), size); err != nil {
+				return errors.Trace(err)
+			}
+			takenRows += len(x)
+			takenBytes += size
 		}
 
 		if err := advancer.tryAdvanceAndAcquireMem(false, pos.Valid()); err != nil {
 			return errors.Trace(err)
 		}
+		// Yield the worker to other tables at a transaction boundary.
+		yield = pos.Valid() && w.rateLimiter.taskQuotaExhausted(takenRows, takenBytes)
 	}
 
 	return advancer.lastTimeAdvance()
@@ -26,8 +26,10 @@ import (
 	"github.com/pingcap/tiflow/cdc/processor/sourcemanager/sorter"
 	"github.com/pingcap/tiflow/cdc/processor/sourcemanager/sorter/memory"
 	"github.com/pingcap/tiflow/cdc/processor/tablepb"
+	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/pingcap/tiflow/pkg/spanz"
 	"github.com/pingcap/tiflow/pkg/upstream"
+	"github.com/pingcap/tiflow/pkg/util"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
@@ -140,7 +142,7 @@ func (suite *tableSinkWorkerSuite) createWorker(
 	quota.ForceAcquire(uint64(testEventSize))
 	quota.AddTable(suite.testSpan)
 
-	return newSinkWorker(suite.testChangefeedID, sm, quota, splitTxn), sortEngine
+	return newSinkWorker(suite.testChangefeedID, sm, quota, nil, splitTxn), sortEngine
 }
 
 func (suite *tableSinkWorkerSuite) addEventsToSortEngine(
@@ -712,3 +714,53 @@ func (suite *tableSinkWorkerSuite) TestHandleTaskWithoutMemory() {
 	cancel()
 	wg.Wait()
 }
+
+// Test Scenario:
+// worker will yield at the txn boundary when the task takes its share of the rate limit.
+func (suite *tableSinkWorkerSuite) TestHandleTaskWithRateLimit() {
+	ctx, cancel := context.WithCancel(context.Background())
+	events := []*model.PolymorphicEvent{
+		genPolymorphicEvent(1, 2, suite.testSpan),
+		genPolymorphicEvent(1, 2, suite.testSpan),
+		genPolymorphicEvent(1, 3, suite.testSpan),
+		genPolymorphicEvent(2, 4, suite.testSpan),
+		genPolymorphicResolvedEvent(4),
+	}
+
+	w, e := suite.createWorker(ctx, uint64(testEventSize*10), true)
+	defer w.sinkMemQuota.Close()
+	// The share of a task is one row.
+	w.rateLimiter = newRateLimiter(suite.testChangefeedID, &config.RateLimitConfig{
+		RowsPerSecond: util.AddressOf(uint64(10)),
+	})
+	defer w.rateLimiter.close()
+	suite.addEventsToSortEngine(events, e)
+
+	taskChan := make(chan *sinkTask)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		err := w.handleTasks(ctx, taskChan)
+		require.Equal(suite.T(), context.Canceled, err)
+	}()
+
+	wrapper, sink := createTableSinkWrapper(suite.testChangefeedID, suite.testSpan)
+	callback := func(lastWritePos sorter.Position) {
+		require.Equal(suite.T(), sorter.Position{
+			StartTs:  1,
+			CommitTs: 2,
+		}, lastWritePos, "the task should yield after the first transaction")
+		cancel()
+	}
+	taskChan <- &sinkTask{
+		span:          suite.testSpan,
+		lowerBound:    genLowerBound(),
+		getUpperBound: genUpperBoundGetter(4),
+		tableSink:     wrapper,
+		callback:      callback,
+		isCanceled:    func() bool { return false },
+	}
+	wg.Wait()
+	require.Len(suite.T(), sink.GetEvents(), 2)
+}
@@ -29,9 +29,11 @@ import (
 	"github.com/pingcap/tiflow/cdc/sink/tablesink"
 	cerrors "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/retry"
+	"github.com/pingcap/tiflow/pkg/tracing"
 	"github.com/pingcap/tiflow/pkg/util"
 	"github.com/tikv/client-go/v2/oracle"
 	pd "github.com/tikv/pd/client"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 )
 
@@ -76,6 +78,17 @@ type tableSinkWrapper struct {
 	// receivedSorterResolvedTs is the resolved ts received from the sorter.
 	// We use this to advance the redo log.
 	receivedSorterResolvedTs atomic.Uint64
+	// receivedEventCount is the number of row changed events appended to
+	// the table sink. It is reported to the scheduler as the throughput signal.
+	receivedEventCount atomic.Uint64
+	// sinkMemQuotaWait and redoMemQuotaWait record how long the table waits
+	// for the memory quota of the sink and the redo log respectively.
+	sinkMemQuotaWait memQuotaWait
+	redoMemQuotaWait memQuotaWait
+
+	// tracedTxns are the sampled transactions which are appended to
+	// the table sink but not flushed yet.
+	tracedTxns tracing.PendingTxns
 
 	// replicateTs is the ts that the table sink has started to replicate.
 	replicateTs    atomic.Uint64
@@ -192,6 +205,10 @@ func (t *tableSinkWrapper) appendRowChangedEvents(events ...*model.RowChangedEve
 		return tablesink.NewSinkInternalError(errors.New("table sink cleared"))
 	}
 	t.tableSink.s.AppendRowChangedEvents(events...)
+	t.receivedEventCount.Add(uint64(len(events)))
+	for _, e := range events {
+		t.tracedTxns.Add(e.CommitTs)
+	}
 	return nil
 }
 
@@ -199,6 +216,16 @@ func (t *tableSinkWrapper) updateBarrierTs(ts model.Ts) {
 	util.MustCompareAndMonotonicIncrease(&t.barrierTs, ts)
 }
 
+func (t *tableSinkWrapper) getReceivedEventCount() uint64 {
+	return t.receivedEventCount.Load()
+}
+
+// getMemQuotaWaitDuration returns the total time the table has waited for
+// the memory quota of the sink and the redo log, including the ongoing wait.
+func (t *tableSinkWrapper) getMemQuotaWaitDuration() time.Duration {
+	return t.sinkMemQuotaWait.duration() + t.redoMemQuotaWait.duration()
+}
+
 func (t *tableSinkWrapper) updateReceivedSorterResolvedTs(ts model.Ts) {
 	increased := util.CompareAndMonotonicIncrease(&t.receivedSorterResolvedTs, ts)
 	if increased && t.state.Load() == tablepb.TableStatePreparing {
@@ -240,6 +267,8 @@ func (t *tableSinkWrapper) getCheckpointTs() model.ResolvedTs {
 		if t.tableSink.checkpointTs.Less(checkpointTs) {
 			t.tableSink.checkpointTs = checkpointTs
 			t.tableSink.advanced = time.Now()
+			t.tracedTxns.Flush(context.Background(), tracing.StageSinkFlush,
+				checkpointTs.ResolvedMark(), attribute.Int64("table-id", t.span.TableID))
 		} else if !checkpointTs.Less(t.tableSink.resolvedTs) {
 			t.tableSink.advanced = time.Now()
 		}
@@ -507,3 +536,49 @@ func genReplicateTs(ctx context.Context, pdClient pd.Client) (model.Ts, error) {
 	}
 	return replicateTs, nil
 }
+
+// memQuotaWait records how long a table waits for a memory quota.
+type memQuotaWait struct {
+	sync.Mutex
+	// since is the time the table starts to wait, it's zero if
+	// the table is not waiting.
+	since time.Time
+	total time.Duration
+}
+
+// start marks the table is waiting for the memory quota,
+// it's a no-op if the table is already waiting.
+func (w *memQuotaWait) start() {
+	w.Lock()
+	defer w.Unlock()
+	if w.since.IsZero() {
+		w.since = time.Now()
+	}
+}
+
+// stop marks the table is not waiting for the memory quota anymore.
+func (w *memQuotaWait) stop() {
+	w.Lock()
+	defer w.Unlock()
+	if !w.since.IsZero() {
+		w.total += time.Since(w.since)
+		w.since = time.Time{}
+	}
+}
+
+func (w *memQuotaWait) add(d time.Duration) {
+	w.Lock()
+	defer w.Unlock()
+	w.total += d
+}
+
+// duration returns the total waited time, including the ongoing wait.
+func (w *memQuotaWait) duration() time.Duration {
+	w.Lock()
+	defer w.Unlock()
+	total := w.total
+	if !w.since.IsZero() {
+		total += time.Since(w.since)
+	}
+	return total
+}
@@ -18,6 +18,7 @@ import (
 	"math"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/processor/tablepb"
@@ -313,3 +314,32 @@ func TestTableSinkWrapperSinkVersion(t *testing.T) {
 	require.Nil(t, wrapper.tableSink.s)
 	require.Equal(t, wrapper.tableSink.version, uint64(0))
 }
+
+func TestTableSinkWrapperMemQuotaWait(t *testing.T) {
+	t.Parallel()
+
+	wrapper, _ := createTableSinkWrapper(
+		model.DefaultChangeFeedID("1"), spanz.TableIDToComparableSpan(1))
+	require.Zero(t, wrapper.getMemQuotaWaitDuration())
+
+	wrapper.sinkMemQuotaWait.add(time.Second)
+	require.Equal(t, time.Second, wrapper.getMemQuotaWaitDuration())
+
+	// The ongoing wait is counted.
+	wrapper.sinkMemQuotaWait.start()
+	time.Sleep(10 * time.Millisecond)
+	wrapper.sinkMemQuotaWait.start()
+	waited := wrapper.getMemQuotaWaitDuration()
+	require.GreaterOrEqual(t, waited, time.Second+10*time.Millisecond)
+
+	// Stopping the redo wait doesn't stop the sink wait.
+	wrapper.redoMemQuotaWait.start()
+	wrapper.redoMemQuotaWait.stop()
+	require.False(t, wrapper.sinkMemQuotaWait.since.IsZero())
+
+	wrapper.sinkMemQuotaWait.stop()
+	require.GreaterOrEqual(t, wrapper.getMemQuotaWaitDuration(), waited)
+	stopped := wrapper.getMemQuotaWaitDuration()
+	time.Sleep(10 * time.Millisecond)
+	require.Equal(t, stopped, wrapper.getMemQuotaWaitDuration())
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/entry"
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/processor/memquota"
+	"github.com/pingcap/tiflow/pkg/tracing"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
@@ -33,6 +34,10 @@ type MountedEventIter struct {
 	rawEventBuffer rawEvent
 	nextToEmit     int
 	savedIterError error
+	// txnMountStart is the time when the first event of the current sampled
+	// transaction is added to the mounter group, it's used to record the
+	// mount span once all events of the transaction are mounted.
+	txnMountStart time.Time
 
 	mountWaitDuration prometheus.Observer
 }
@@ -79,6 +84,16 @@ func (i *MountedEventIter) Next(ctx context.Context) (event *model.PolymorphicEv
 		event = i.rawEvents[idx].event
 		txnFinished = i.rawEvents[idx].txnFinished
 		i.nextToEmit += 1
+
+		if i.txnMountStart.IsZero() {
+			i.txnMountStart = i.rawEvents[idx].mountStart
+		}
+		if txnFinished.Valid() {
+			if !i.txnMountStart.IsZero() {
+				tracing.RecordTxnSpan(ctx, tracing.StageMount, txnFinished.CommitTs, i.txnMountStart)
+			}
+			i.txnMountStart = time.Time{}
+		}
 	}
 	return
 }
@@ -97,6 +112,7 @@ func (i *MountedEventIter) readBatch(ctx context.Context) error {
 			i.mg = nil
 			return err
 		}
+		i.rawEventBuffer.mountStart = mountStartTime(i.rawEventBuffer.event)
 		i.rawEvents = append(i.rawEvents, i.rawEventBuffer)
 		i.rawEventBuffer.event = nil
 	}
@@ -136,7 +152,7 @@ func (i *MountedEventIter) readBatch(ctx context.Context) error {
 			return err
 		}
 		if mountStarted {
-			i.rawEvents = append(i.rawEvents, rawEvent{event, txnFinished, size})
+			i.rawEvents = append(i.rawEvents, rawEvent{event, txnFinished, size, mountStartTime(event)})
 		} else {
 			i.rawEventBuffer.event = event
 			i.rawEventBuffer.txnFinished = txnFinished
@@ -166,4 +182,13 @@ type rawEvent struct {
 	event       *model.PolymorphicEvent
 	txnFinished Position
 	size        int64
+	// mountStart is only set for events of sampled transactions.
+	mountStart time.Time
+}
+
+func mountStartTime(event *model.PolymorphicEvent) time.Time {
+	if !tracing.Sampled(event.CRTs) {
+		return time.Time{}
+	}
+	return time.Now()
 }
@@ -14,6 +14,7 @@
 package pebble
 
 import (
+	"context"
 	"math"
 	"strconv"
 	"sync"
@@ -28,7 +29,9 @@ import (
 	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/pkg/chann"
 	"github.com/pingcap/tiflow/pkg/spanz"
+	"github.com/pingcap/tiflow/pkg/tracing"
 	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 )
 
@@ -64,6 +67,9 @@ type EventIter struct {
 	iter         *pebble.Iterator
 	currentEvent *model.PolymorphicEvent
 	serde        encoding.MsgPackGenSerde
+	// txnStart is the time when the first event of the current transaction
+	// is fetched, it's used to record the sorter-read span.
+	txnStart time.Time
 
 	nextDuration prometheus.Observer
 }
@@ -122,7 +128,8 @@ func (s *EventSorter) AddTable(span tablepb.Span, startTs model.Ts) {
 // RemoveTable implements sorter.SortEngine.
 func (s *EventSorter) RemoveTable(span tablepb.Span) {
 	s.mu.Lock()
-	if _, exists := s.tables.Get(span); !exists {
+	state, exists := s.tables.Get(span)
+	if !exists {
 		s.mu.Unlock()
 		log.Warn("remove an unexist table",
 			zap.String("namespace", s.changefeedID.Namespace),
@@ -131,6 +138,10 @@ func (s *EventSorter) RemoveTable(span tablepb.Span) {
 		return
 	}
 	s.tables.Delete(span)
+	if !s.isClosed {
+		// Notify the write goroutine to release the states of the table.
+		state.ch.In() <- eventWithTableID{uniqueID: state.uniqueID, span: span}
+	}
 	s.mu.Unlock()
 }
 
@@ -332,9 +343,15 @@ func (s *EventIter) Next() (event *model.PolymorphicEvent, txnFinished sorter.Po
 		s.currentEvent, nextEvent = nextEvent, nil
 	}
 	if s.currentEvent != nil {
+		if s.txnStart.IsZero() {
+			s.txnStart = time.Now()
+		}
 		if nextEvent == nil || s.currentEvent.CRTs != nextEvent.CRTs || s.currentEvent.StartTs != nextEvent.StartTs {
 			txnFinished.CommitTs = s.currentEvent.CRTs
 			txnFinished.StartTs = s.currentEvent.StartTs
+			tracing.RecordTxnSpan(context.Background(), tracing.StageSorterRead,
+				s.currentEvent.CRTs, s.txnStart, attribute.Int64("table-id", s.tableID))
+			s.txnStart = time.Time{}
 		}
 		event = s.currentEvent
 		s.currentEvent = nextEvent
@@ -353,7 +370,8 @@ func (s *EventIter) Close() error {
 type eventWithTableID struct {
 	uniqueID uint32
 	span     tablepb.Span
-	event    *model.PolymorphicEvent
+	// event is nil if the table is removed.
+	event *model.PolymorphicEvent
 }
 
 type tableState struct {
@@ -445,11 +463,24 @@ func (s *EventSorter) handleEvents(
 	ticker := time.NewTicker(batchCommitInterval / 2)
 	defer ticker.Stop()
 
+	// writtenTxns tracks the sampled transactions of each table, the sorter-write
+	// span of a transaction is recorded once the table is resolved after it.
+	writtenTxns := spanz.NewHashMap[*tracing.TxnSpans]()
+
 	encodeItemAndBatch := func(batch *pebble.Batch, newResolved *spanz.HashMap[model.Ts], item eventWithTableID) {
+		if item.event == nil {
+			writtenTxns.Delete(item.span)
+			return
+		}
 		if item.event.IsResolved() {
 			newResolved.ReplaceOrInsert(item.span, item.event.CRTs)
+			if txns, ok := writtenTxns.Get(item.span); ok {
+				txns.Flush(context.Background(), tracing.StageSorterWrite,
+					item.event.CRTs, attribute.Int64("table-id", item.span.TableID))
+			}
 			return
 		}
+		start := time.Now()
 		key := encoding.EncodeKey(item.uniqueID, uint64(item.span.TableID), item.event)
 		value, err := s.serde.Marshal(item.event, []byte{})
 		if err != nil {
@@ -462,6 +493,14 @@ func (s *EventSorter) handleEvents(
 				zap.String("namespace", s.changefeedID.Namespace),
 				zap.String("changefeed", s.changefeedID.ID))
 		}
+		if tracing.Sampled(item.event.CRTs) {
+			txns, ok := writtenTxns.Get(item.span)
+			if !ok {
+				txns = &tracing.TxnSpans{}
+				writtenTxns.ReplaceOrInsert(item.span, txns)
+			}
+			txns.Add(item.event.CRTs, start, time.Now())
+		}
 	}
 
 	// Batch item and commit until batch size is larger than batchCommitSize,
@@ -184,6 +184,11 @@ type Stats struct {
 	StageCheckpoints map[string]Checkpoint `protobuf:"bytes,3,rep,name=stage_checkpoints,json=stageCheckpoints,proto3" json:"stage_checkpoints" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// The barrier timestamp of the table.
 	BarrierTs Ts `protobuf:"varint,4,opt,name=barrier_ts,json=barrierTs,proto3,casttype=Ts" json:"barrier_ts,omitempty"`
+	// Total number of row changed events received by the table sink,
+	// it is monotonically increasing while the table is replicating.
+	ReceivedEventCount uint64 `protobuf:"varint,5,opt,name=received_event_count,json=receivedEventCount,proto3" json:"received_event_count,omitempty"`
+	// Total time in milliseconds the table has waited for the memory quota.
+	MemoryQuotaWaitMs uint64 `protobuf:"varint,6,opt,name=memory_quota_wait_ms,json=memoryQuotaWaitMs,proto3" json:"memory_quota_wait_ms,omitempty"`
 }
 
 func (m *Stats) Reset()         { *m = Stats{} }
@@ -248,6 +253,20 @@ func (m *Stats) GetBarrierTs() Ts {
 	return 0
 }
 
+func (m *Stats) GetReceivedEventCount() uint64 {
+	if m != nil {
+		return m.ReceivedEventCount
+	}
+	return 0
+}
+
+func (m *Stats) GetMemoryQuotaWaitMs() uint64 {
+	if m != nil {
+		return m.MemoryQuotaWaitMs
+	}
+	return 0
+}
+
 // TableStatus is the running status of a table.
 // TODO rename to TableStatus.
 type TableStatus struct {
@@ -338,52 +357,56 @@ func init() {
 func init() { proto.RegisterFile("processor/tablepb/table.proto", fileDescriptor_ae83c9c6cf5ef75c) }
 
 var fileDescriptor_ae83c9c6cf5ef75c = []byte{
-	// 716 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xbf, 0x6f, 0xdb, 0x38,
-	0x18, 0x95, 0xe4, 0xdf, 0x9f, 0x7d, 0x81, 0xc2, 0x4b, 0x72, 0x3e, 0x03, 0x67, 0xeb, 0x8c, 0xdc,
-	0x25, 0x48, 0x0e, 0xf2, 0x5d, 0x6e, 0x29, 0xb2, 0xc5, 0x49, 0x5b, 0x04, 0x41, 0x81, 0x42, 0x76,
-	0x3b, 0x74, 0x31, 0x64, 0x89, 0x55, 0x84, 0xb8, 0x94, 0x20, 0xd2, 0x09, 0xbc, 0x75, 0x2c, 0xbc,
-	0x34, 0x53, 0xd1, 0xc5, 0x40, 0xfe, 0x9c, 0x8c, 0x19, 0x3b, 0x14, 0x46, 0xeb, 0x0c, 0x1d, 0xbb,
-	0x67, 0x2a, 0x48, 0x2a, 0x56, 0xec, 0x76, 0x70, 0xb3, 0xd8, 0x14, 0xdf, 0xfb, 0x1e, 0xde, 0x7b,
-	0x24, 0x08, 0x7f, 0x84, 0x51, 0xe0, 0x60, 0x4a, 0x83, 0xa8, 0xc1, 0xec, 0x6e, 0x0f, 0x87, 0x5d,
-	0xf9, 0x6f, 0x86, 0x51, 0xc0, 0x02, 0xb4, 0x1e, 0xfa, 0xc4, 0x73, 0xec, 0xd0, 0x64, 0xfe, 0xcb,
-	0x5e, 0x70, 0x66, 0x3a, 0xae, 0x63, 0x4e, 0x27, 0xcc, 0x78, 0xa2, 0xb2, 0xe2, 0x05, 0x5e, 0x20,
-	0x06, 0x1a, 0x7c, 0x25, 0x67, 0xeb, 0x6f, 0x55, 0x48, 0xb7, 0x42, 0x9b, 0xa0, 0xff, 0x20, 0x2f,
-	0x98, 0x1d, 0xdf, 0x2d, 0xab, 0x86, 0xba, 0x99, 0x6a, 0xae, 0x4d, 0xc6, 0xb5, 0x5c, 0x9b, 0xef,
-	0x1d, 0x1e, 0xdc, 0x24, 0x4b, 0x2b, 0x27, 0x78, 0x87, 0x2e, 0x5a, 0x87, 0x02, 0x65, 0x76, 0xc4,
-	0x3a, 0x27, 0x78, 0x50, 0xd6, 0x0c, 0x75, 0xb3, 0xd4, 0xcc, 0xdd, 0x8c, 0x6b, 0xa9, 0x23, 0x3c,
-	0xb0, 0xf2, 0x02, 0x39, 0xc2, 0x03, 0x64, 0x40, 0x0e, 0x13, 0x57, 0x70, 0x52, 0xb3, 0x9c, 0x2c,
-	0x26, 0xee, 0x11, 0x1e, 0xec, 0x96, 0xde, 0x5c, 0xd4, 0x94, 0xf7, 0x17, 0x35, 0xe5, 0xf5, 0x47,
-	0x43, 0xa9, 0x9f, 0xab, 0x00, 0xfb, 0xc7, 0xd8, 0x39, 0x09, 0x03, 0x9f, 0x30, 0xb4, 0x0d, 0xbf,
-	0x38, 0xd3, 0xaf, 0x0e, 0xa3, 0xc2, 0x5c, 0xba, 0x99, 0xbd, 0x19, 0xd7, 0xb4, 0x36, 0xb5, 0x4a,
-	0x09, 0xd8, 0xa6, 0x68, 0x03, 0x8a, 0x11, 0xa6, 0x41, 0xef, 0x14, 0xbb, 0x9c, 0xaa, 0xcd, 0x50,
-	0xe1, 0x16, 0x6a, 0x53, 0xf4, 0x0f, 0x2c, 0xf5, 0x6c, 0xca, 0x3a, 0x74, 0x40, 0x1c, 0xc9, 0x4d,
-	0xcd, 0xca, 0x72, 0xb4, 0x25, 0xc0, 0x36, 0xad, 0x7f, 0xd1, 0x20, 0xd3, 0x62, 0x36, 0xa3, 0xe8,
-	0x4f, 0x28, 0x45, 0xd8, 0xf3, 0x03, 0xd2, 0x71, 0x82, 0x3e, 0x61, 0xd2, 0x8c, 0x55, 0x94, 0x7b,
-	0xfb, 0x7c, 0x0b, 0x6d, 0x00, 0x38, 0xfd, 0x28, 0xc2, 0xd2, 0xad, 0xb4, 0x90, 0x97, 0xb2, 0x65,
-	0xd5, 0x2a, 0xc4, 0x58, 0x9b, 0x22, 0x06, 0xcb, 0x94, 0xd9, 0x1e, 0xee, 0x24, 0x11, 0xb8, 0x8d,
-	0xd4, 0x66, 0x71, 0x67, 0xcf, 0x5c, 0xe4, 0x48, 0x4d, 0xe1, 0x89, 0xff, 0x7a, 0x38, 0x69, 0x8c,
-	0x3e, 0x24, 0x2c, 0x1a, 0x34, 0xd3, 0x97, 0xe3, 0x9a, 0x62, 0xe9, 0x74, 0x0e, 0x44, 0x7f, 0x01,
-	0x74, 0xed, 0x28, 0xf2, 0x71, 0xc4, 0xed, 0xa5, 0x67, 0x52, 0x17, 0x62, 0xa4, 0x4d, 0x2b, 0x7d,
-	0x58, 0xfd, 0xa1, 0x2e, 0xd2, 0x21, 0xc5, 0x8f, 0x92, 0x07, 0x2f, 0x58, 0x7c, 0x89, 0x1e, 0x41,
-	0xe6, 0xd4, 0xee, 0xf5, 0xb1, 0xc8, 0x5a, 0xdc, 0xf9, 0x77, 0x31, 0xef, 0x89, 0xb0, 0x25, 0xc7,
-	0x77, 0xb5, 0x07, 0x6a, 0xfd, 0xab, 0x06, 0x45, 0x71, 0xcf, 0x78, 0xb4, 0x3e, 0xbd, 0xcf, 0xad,
-	0x3c, 0x80, 0x34, 0x0d, 0x6d, 0x52, 0xce, 0x08, 0x37, 0x5b, 0x0b, 0x36, 0x19, 0xda, 0x24, 0xae,
-	0x4c, 0x4c, 0xf3, 0x50, 0x94, 0xd9, 0x4c, 0x86, 0x5a, 0x5a, 0x34, 0xd4, 0xd4, 0x3a, 0xb6, 0xe4,
-	0x38, 0x7a, 0x0e, 0x90, 0x1c, 0xaf, 0xb8, 0x64, 0xf7, 0x68, 0x28, 0x76, 0x76, 0x47, 0x09, 0x3d,
-	0x96, 0xfe, 0xe4, 0x09, 0x16, 0x77, 0xb6, 0x7f, 0xe2, 0xc2, 0xc4, 0x6a, 0x72, 0x7e, 0xeb, 0x9d,
-	0x06, 0x90, 0xd8, 0x46, 0x75, 0xc8, 0x3d, 0x23, 0x27, 0x24, 0x38, 0x23, 0xba, 0x52, 0x59, 0x1d,
-	0x8e, 0x8c, 0xe5, 0x04, 0x8c, 0x01, 0x64, 0x40, 0x76, 0xaf, 0x4b, 0x31, 0x61, 0xba, 0x5a, 0x59,
-	0x19, 0x8e, 0x0c, 0x3d, 0xa1, 0xc8, 0x7d, 0xf4, 0x37, 0x14, 0x9e, 0x46, 0x38, 0xb4, 0x23, 0x9f,
-	0x78, 0xba, 0x56, 0xf9, 0x6d, 0x38, 0x32, 0x7e, 0x4d, 0x48, 0x53, 0x08, 0xad, 0x43, 0x5e, 0x7e,
-	0x60, 0x57, 0x4f, 0x55, 0xd6, 0x86, 0x23, 0x03, 0xcd, 0xd3, 0xb0, 0x8b, 0xb6, 0xa0, 0x68, 0xe1,
-	0xb0, 0xe7, 0x3b, 0x36, 0xe3, 0x7a, 0xe9, 0xca, 0xef, 0xc3, 0x91, 0xb1, 0x7a, 0xa7, 0xeb, 0x04,
-	0xe4, 0x8a, 0x2d, 0x16, 0x84, 0xbc, 0x0d, 0x3d, 0x33, 0xaf, 0x78, 0x8b, 0xf0, 0x94, 0x62, 0x8d,
-	0x5d, 0x3d, 0x3b, 0x9f, 0x32, 0x06, 0x9a, 0x4f, 0xae, 0x3e, 0x57, 0x95, 0xcb, 0x49, 0x55, 0xbd,
-	0x9a, 0x54, 0xd5, 0x4f, 0x93, 0xaa, 0x7a, 0x7e, 0x5d, 0x55, 0xae, 0xae, 0xab, 0xca, 0x87, 0xeb,
-	0xaa, 0xf2, 0xa2, 0xe1, 0xf9, 0xec, 0xb8, 0xdf, 0x35, 0x9d, 0xe0, 0x55, 0x23, 0xae, 0xbe, 0x21,
-	0xab, 0x6f, 0x38, 0xae, 0xd3, 0xf8, 0xee, 0xc1, 0xee, 0x66, 0xc5, 0x7b, 0xfb, 0xff, 0xb7, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x53, 0x4d, 0xc5, 0xfa, 0xcc, 0x05, 0x00, 0x00,
+	// 775 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x41, 0x6f, 0xe3, 0x44,
+	0x18, 0xb5, 0xe3, 0x34, 0x69, 0x3e, 0x97, 0x95, 0x3b, 0xb4, 0x4b, 0x89, 0x44, 0x62, 0xa2, 0xc2,
+	0x56, 0x5d, 0x64, 0x2f, 0xe5, 0x82, 0xf6, 0xb6, 0xd9, 0x5d, 0xd0, 0xaa, 0x5a, 0x09, 0xdc, 0x00,
+	0x12, 0x17, 0x6b, 0x62, 0x0f, 0x5e, 0xab, 0xe9, 0x8c, 0x99, 0x99, 0xb4, 0xca, 0x8d, 0x23, 0xca,
+	0x85, 0x3d, 0x21, 0x2e, 0x91, 0xf6, 0xe7, 0xac, 0x38, 0xf5, 0xc8, 0x01, 0x55, 0xd0, 0xfe, 0x00,
+	0xee, 0x3d, 0xa1, 0x99, 0x71, 0xe3, 0x4d, 0xe0, 0x90, 0xed, 0x25, 0x19, 0xcf, 0x7b, 0xef, 0xd3,
+	0x7b, 0xcf, 0x9f, 0x12, 0xf8, 0xa0, 0xe0, 0x2c, 0x21, 0x42, 0x30, 0x1e, 0x4a, 0x3c, 0x1c, 0x91,
+	0x62, 0x68, 0xbe, 0x83, 0x82, 0x33, 0xc9, 0xd0, 0x6e, 0x91, 0xd3, 0x2c, 0xc1, 0x45, 0x20, 0xf3,
+	0x1f, 0x46, 0xec, 0x2c, 0x48, 0xd2, 0x24, 0x98, 0x2b, 0x82, 0x52, 0xd1, 0xde, 0xca, 0x58, 0xc6,
+	0xb4, 0x20, 0x54, 0x27, 0xa3, 0xed, 0xfd, 0x62, 0x43, 0xfd, 0xa8, 0xc0, 0x14, 0x7d, 0x0a, 0xeb,
+	0x9a, 0x19, 0xe7, 0xe9, 0x8e, 0xed, 0xdb, 0x7b, 0x4e, 0xff, 0xee, 0xe5, 0x45, 0xb7, 0x39, 0x50,
+	0x77, 0xcf, 0x9e, 0x5c, 0x57, 0xc7, 0xa8, 0xa9, 0x79, 0xcf, 0x52, 0xb4, 0x0b, 0x2d, 0x21, 0x31,
+	0x97, 0xf1, 0x31, 0x99, 0xec, 0xd4, 0x7c, 0x7b, 0x6f, 0xa3, 0xdf, 0xbc, 0xbe, 0xe8, 0x3a, 0x87,
+	0x64, 0x12, 0xad, 0x6b, 0xe4, 0x90, 0x4c, 0x90, 0x0f, 0x4d, 0x42, 0x53, 0xcd, 0x71, 0x16, 0x39,
+	0x0d, 0x42, 0xd3, 0x43, 0x32, 0x79, 0xb8, 0xf1, 0xf3, 0xab, 0xae, 0xf5, 0xdb, 0xab, 0xae, 0xf5,
+	0xd3, 0x9f, 0xbe, 0xd5, 0x7b, 0x69, 0x03, 0x3c, 0x7e, 0x41, 0x92, 0xe3, 0x82, 0xe5, 0x54, 0xa2,
+	0xfb, 0xf0, 0x4e, 0x32, 0x7f, 0x8a, 0xa5, 0xd0, 0xe6, 0xea, 0xfd, 0xc6, 0xf5, 0x45, 0xb7, 0x36,
+	0x10, 0xd1, 0x46, 0x05, 0x0e, 0x04, 0xba, 0x07, 0x2e, 0x27, 0x82, 0x8d, 0x4e, 0x49, 0xaa, 0xa8,
+	0xb5, 0x05, 0x2a, 0xdc, 0x40, 0x03, 0x81, 0x3e, 0x81, 0x3b, 0x23, 0x2c, 0x64, 0x2c, 0x26, 0x34,
+	0x31, 0x5c, 0x67, 0x71, 0xac, 0x42, 0x8f, 0x34, 0x38, 0x10, 0xbd, 0xdf, 0x1d, 0x58, 0x3b, 0x92,
+	0x58, 0x0a, 0xf4, 0x21, 0x6c, 0x70, 0x92, 0xe5, 0x8c, 0xc6, 0x09, 0x1b, 0x53, 0x69, 0xcc, 0x44,
+	0xae, 0xb9, 0x7b, 0xac, 0xae, 0xd0, 0x3d, 0x80, 0x64, 0xcc, 0x39, 0x31, 0x6e, 0x8d, 0x85, 0x75,
+	0x33, 0x76, 0xc7, 0x8e, 0x5a, 0x25, 0x36, 0x10, 0x48, 0xc2, 0xa6, 0x90, 0x38, 0x23, 0x71, 0x15,
+	0x41, 0xd9, 0x70, 0xf6, 0xdc, 0x83, 0x47, 0xc1, 0x2a, 0xaf, 0x34, 0xd0, 0x9e, 0xd4, 0x67, 0x46,
+	0xaa, 0xc6, 0xc4, 0x53, 0x2a, 0xf9, 0xa4, 0x5f, 0x7f, 0x7d, 0xd1, 0xb5, 0x22, 0x4f, 0x2c, 0x81,
+	0xe8, 0x23, 0x80, 0x21, 0xe6, 0x3c, 0x27, 0x5c, 0xd9, 0xab, 0x2f, 0xa4, 0x6e, 0x95, 0xc8, 0x40,
+	0xa0, 0x07, 0xb0, 0xc5, 0x49, 0x42, 0x72, 0xd5, 0x24, 0x39, 0x55, 0x61, 0x4c, 0xe0, 0x35, 0x1d,
+	0x18, 0xdd, 0x60, 0x4f, 0x15, 0x64, 0x72, 0x87, 0xb0, 0x75, 0x42, 0x4e, 0x18, 0x9f, 0xc4, 0x3f,
+	0x8e, 0x99, 0xc4, 0xf1, 0x19, 0xce, 0x65, 0x7c, 0x22, 0x76, 0x1a, 0x5a, 0xb1, 0x69, 0xb0, 0xaf,
+	0x15, 0xf4, 0x1d, 0xce, 0xe5, 0x73, 0xd1, 0x1e, 0xc3, 0xf6, 0xff, 0x5a, 0x47, 0x1e, 0x38, 0x6a,
+	0x5b, 0x54, 0xb7, 0xad, 0x48, 0x1d, 0xd1, 0x17, 0xb0, 0x76, 0x8a, 0x47, 0x63, 0xa2, 0xeb, 0x74,
+	0x0f, 0x1e, 0xac, 0x56, 0x4f, 0x35, 0x38, 0x32, 0xf2, 0x87, 0xb5, 0xcf, 0xed, 0xde, 0x3f, 0x35,
+	0x70, 0xf5, 0x2a, 0xab, 0xf6, 0xc6, 0xe2, 0x36, 0x8b, 0xff, 0x04, 0xea, 0xa2, 0xc0, 0x54, 0x97,
+	0xe1, 0x1e, 0xec, 0xaf, 0xf8, 0xb2, 0x0a, 0x4c, 0xcb, 0xb7, 0xa2, 0xd5, 0x2a, 0x94, 0x90, 0x58,
+	0x9a, 0x50, 0x77, 0x56, 0x0d, 0x35, 0xb7, 0x4e, 0x22, 0x23, 0x47, 0xdf, 0x02, 0x54, 0x1b, 0xa4,
+	0xf7, 0xf8, 0x16, 0x0d, 0x95, 0xce, 0xde, 0x98, 0x84, 0xbe, 0x34, 0xfe, 0xcc, 0x92, 0xb8, 0x07,
+	0xf7, 0xdf, 0x62, 0x27, 0xcb, 0x69, 0x46, 0xbf, 0xff, 0x6b, 0x0d, 0xa0, 0xb2, 0x8d, 0x7a, 0xd0,
+	0xfc, 0x86, 0x1e, 0x53, 0x76, 0x46, 0x3d, 0xab, 0xbd, 0x3d, 0x9d, 0xf9, 0x9b, 0x15, 0x58, 0x02,
+	0xc8, 0x87, 0xc6, 0xa3, 0xa1, 0x20, 0x54, 0x7a, 0x76, 0x7b, 0x6b, 0x3a, 0xf3, 0xbd, 0x8a, 0x62,
+	0xee, 0xd1, 0xc7, 0xd0, 0xfa, 0x8a, 0x93, 0x02, 0xf3, 0x9c, 0x66, 0x5e, 0xad, 0xfd, 0xde, 0x74,
+	0xe6, 0xbf, 0x5b, 0x91, 0xe6, 0x10, 0xda, 0x85, 0x75, 0xf3, 0x40, 0x52, 0xcf, 0x69, 0xdf, 0x9d,
+	0xce, 0x7c, 0xb4, 0x4c, 0x23, 0x29, 0xda, 0x07, 0x37, 0x22, 0xc5, 0x28, 0x4f, 0xb0, 0x54, 0xf3,
+	0xea, 0xed, 0xf7, 0xa7, 0x33, 0x7f, 0xfb, 0x8d, 0xae, 0x2b, 0x50, 0x4d, 0x3c, 0x92, 0xac, 0x50,
+	0x6d, 0x78, 0x6b, 0xcb, 0x13, 0x6f, 0x10, 0x95, 0x52, 0x9f, 0x49, 0xea, 0x35, 0x96, 0x53, 0x96,
+	0x40, 0xff, 0xf9, 0xf9, 0xdf, 0x1d, 0xeb, 0xf5, 0x65, 0xc7, 0x3e, 0xbf, 0xec, 0xd8, 0x7f, 0x5d,
+	0x76, 0xec, 0x97, 0x57, 0x1d, 0xeb, 0xfc, 0xaa, 0x63, 0xfd, 0x71, 0xd5, 0xb1, 0xbe, 0x0f, 0xb3,
+	0x5c, 0xbe, 0x18, 0x0f, 0x83, 0x84, 0x9d, 0x84, 0x65, 0xf5, 0xa1, 0xa9, 0x3e, 0x4c, 0xd2, 0x24,
+	0xfc, 0xcf, 0x7f, 0xc2, 0xb0, 0xa1, 0x7f, 0xd2, 0x3f, 0xfb, 0x37, 0x00, 0x00, 0xff, 0xff, 0x3a,
+	0xdd, 0xa4, 0xf5, 0x2f, 0x06, 0x00, 0x00,
 }
 
 func (m *Span) Marshal() (dAtA []byte, err error) {
@@ -486,6 +509,16 @@ func (m *Stats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MemoryQuotaWaitMs != 0 {
+		i = encodeVarintTable(dAtA, i, uint64(m.MemoryQuotaWaitMs))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ReceivedEventCount != 0 {
+		i = encodeVarintTable(dAtA, i, uint64(m.ReceivedEventCount))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.BarrierTs != 0 {
 		i = encodeVarintTable(dAtA, i, uint64(m.BarrierTs))
 		i--
@@ -664,6 +697,12 @@ func (m *Stats) Size() (n int) {
 	if m.BarrierTs != 0 {
 		n += 1 + sovTable(uint64(m.BarrierTs))
 	}
+	if m.ReceivedEventCount != 0 {
+		n += 1 + sovTable(uint64(m.ReceivedEventCount))
+	}
+	if m.MemoryQuotaWaitMs != 0 {
+		n += 1 + sovTable(uint64(m.MemoryQuotaWaitMs))
+	}
 	return n
 }
 
@@ -1153,6 +1192,44 @@ func (m *Stats) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedEventCount", wireType)
+			}
+			m.ReceivedEventCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceivedEventCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryQuotaWaitMs", wireType)
+			}
+			m.MemoryQuotaWaitMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemoryQuotaWaitMs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTable(dAtA[iNdEx:])
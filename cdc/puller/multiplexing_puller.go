@@ -27,8 +27,10 @@ import (
 	"github.com/pingcap/tiflow/cdc/puller/frontier"
 	"github.com/pingcap/tiflow/pkg/pdutil"
 	"github.com/pingcap/tiflow/pkg/spanz"
+	"github.com/pingcap/tiflow/pkg/tracing"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/tikv/client-go/v2/oracle"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 )
@@ -62,6 +64,9 @@ type tableProgress struct {
 
 	resolvedEventsCache chan kv.MultiplexingEvent
 	tsTracker           frontier.Frontier
+	// receivedTxns covers the time from the sampled transactions are committed
+	// in TiKV to all their rows are received by the capture.
+	receivedTxns tracing.TxnSpans
 
 	consume struct {
 		// This lock is used to prevent the table progress from being
@@ -104,6 +109,8 @@ func (p *tableProgress) handleResolvedSpans(ctx context.Context, e *model.Resolv
 	if resolvedTs > p.resolvedTs.Load() {
 		p.resolvedTs.Store(resolvedTs)
 		p.resolvedTsUpdated.Store(time.Now().Unix())
+		p.receivedTxns.Flush(ctx, tracing.StageKVReceive, resolvedTs,
+			attribute.String("table", p.tableName))
 		raw := &model.RawKVEntry{CRTs: resolvedTs, OpType: model.OpTypeResolved}
 		err = p.consume.f(ctx, raw, p.spans)
 	}
@@ -393,6 +400,9 @@ func (p *MultiplexingPuller) runEventHandler(ctx context.Context, inputCh <-chan
 		if e.Val != nil {
 			p.queueKvDuration.Observe(float64(time.Since(e.Start).Milliseconds()))
 			p.CounterKv.Inc()
+			if e.Val.OpType != model.OpTypeResolved {
+				progress.receivedTxns.Add(e.Val.CRTs, oracle.GetTimeFromTS(e.Val.CRTs), e.Start)
+			}
 			if err := progress.consume.f(ctx, e.Val, progress.spans); err != nil {
 				return errors.Trace(err)
 			}
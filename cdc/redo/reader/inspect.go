@@ -0,0 +1,228 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reader
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"path/filepath"
+	"sort"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/model/codec"
+	"github.com/pingcap/tiflow/cdc/redo/common"
+	"github.com/pingcap/tiflow/pkg/compression"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/redo"
+)
+
+// LogFile describes a file in the redo log storage.
+type LogFile struct {
+	// Path is the path of the file relative to the storage.
+	Path string
+	// FileType is one of redo.RedoMetaFileType, redo.RedoRowLogFileType
+	// and redo.RedoDDLLogFileType.
+	FileType string
+	// CommitTs is the max commit ts of the events in the file,
+	// it is parsed from the file name and is zero for meta files.
+	CommitTs uint64
+	Size     int64
+}
+
+// LogFileReport is the result of validating a redo log file.
+type LogFileReport struct {
+	LogFile
+	// Events is the number of events decoded from the file.
+	Events      int
+	MinCommitTs uint64
+	MaxCommitTs uint64
+	// Torn is true if the last entry of the file is partially written,
+	// which is expected if the writer exited unexpectedly.
+	Torn bool
+	// Meta is the decoded meta if the file is a meta file.
+	Meta *common.LogMeta
+	// Err is the reason why the file is invalid, nil if the file is valid.
+	Err error
+}
+
+// ListLogFiles lists all meta and log files in the redo log storage,
+// sorted by file type and commit ts.
+func ListLogFiles(ctx context.Context, uri url.URL) ([]LogFile, error) {
+	extStorage, err := redo.InitExternalStorage(ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+	defer extStorage.Close()
+
+	files := make([]LogFile, 0, 64)
+	err = extStorage.WalkDir(ctx, &storage.WalkOption{}, func(path string, size int64) error {
+		commitTs, fileType, err := redo.ParseLogFileName(filepath.Base(path))
+		if err != nil || fileType == "" {
+			// skip files which are not written by redo log writers.
+			return nil
+		}
+		files = append(files, LogFile{
+			Path:     path,
+			FileType: fileType,
+			CommitTs: commitTs,
+			Size:     size,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrExternalStorageAPI, err)
+	}
+	sort.Slice(files, func(i, j int) bool {
+		if files[i].FileType != files[j].FileType {
+			return files[i].FileType < files[j].FileType
+		}
+		if files[i].CommitTs != files[j].CommitTs {
+			return files[i].CommitTs < files[j].CommitTs
+		}
+		return files[i].Path < files[j].Path
+	})
+	return files, nil
+}
+
+// ValidateLogFiles validates all the given files in the redo log storage.
+func ValidateLogFiles(
+	ctx context.Context, uri url.URL, files []LogFile,
+) ([]*LogFileReport, error) {
+	extStorage, err := redo.InitExternalStorage(ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+	defer extStorage.Close()
+
+	reports := make([]*LogFileReport, 0, len(files))
+	for _, file := range files {
+		select {
+		case <-ctx.Done():
+			return nil, errors.Trace(ctx.Err())
+		default:
+		}
+		data, err := extStorage.ReadFile(ctx, file.Path)
+		if err != nil {
+			return nil, cerror.WrapError(cerror.ErrExternalStorageAPI, err)
+		}
+		reports = append(reports, validateLogFile(file, data))
+	}
+	return reports, nil
+}
+
+func validateLogFile(file LogFile, data []byte) *LogFileReport {
+	report := &LogFileReport{LogFile: file}
+	if file.FileType == redo.RedoMetaFileType {
+		meta := &common.LogMeta{}
+		if _, err := meta.UnmarshalMsg(data); err != nil {
+			report.Err = errors.Annotate(err, "decode meta failed")
+			return report
+		}
+		report.Meta = meta
+		if meta.ResolvedTs < meta.CheckpointTs {
+			report.Err = fmt.Errorf("resolved ts %d is less than checkpoint ts %d",
+				meta.ResolvedTs, meta.CheckpointTs)
+		}
+		return report
+	}
+
+	if isLZ4Compressed(data) {
+		var err error
+		if data, err = compression.Decode(compression.LZ4, data); err != nil {
+			report.Err = errors.Annotate(err, "decompress failed")
+			return report
+		}
+	}
+	expectedType := model.RedoLogTypeRow
+	if file.FileType == redo.RedoDDLLogFileType {
+		expectedType = model.RedoLogTypeDDL
+	}
+
+	r := &reader{br: bytes.NewReader(data), fileName: file.Path}
+	for {
+		lenField, err := readInt64(r.br)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			report.Err = fmt.Errorf("truncated frame header at offset %d", r.lastValidOff)
+			break
+		}
+		recBytes, padBytes := decodeFrameSize(lenField)
+		if recBytes == 0 {
+			// the tail of a preallocated file is filled with zeros.
+			if isAllZero(data[r.lastValidOff:]) {
+				report.Torn = true
+			} else {
+				report.Err = fmt.Errorf("empty record at offset %d", r.lastValidOff)
+			}
+			break
+		}
+		if recBytes+padBytes > int64(len(data))-r.lastValidOff-frameSizeBytes {
+			report.Err = fmt.Errorf("truncated record at offset %d", r.lastValidOff)
+			break
+		}
+		rec := make([]byte, recBytes+padBytes)
+		if _, err := io.ReadFull(r.br, rec); err != nil {
+			report.Err = fmt.Errorf("truncated record at offset %d", r.lastValidOff)
+			break
+		}
+		redoLog, _, err := codec.UnmarshalRedoLog(rec[:recBytes])
+		if err != nil {
+			if r.isTornEntry(rec) {
+				report.Torn = true
+			} else {
+				report.Err = errors.Annotatef(err, "decode record at offset %d failed", r.lastValidOff)
+			}
+			break
+		}
+		if redoLog.Type != expectedType ||
+			(expectedType == model.RedoLogTypeRow && redoLog.RedoRow.Row == nil) ||
+			(expectedType == model.RedoLogTypeDDL && redoLog.RedoDDL.DDL == nil) {
+			report.Err = fmt.Errorf("unexpected event of type %d at offset %d in %s log file",
+				redoLog.Type, r.lastValidOff, file.FileType)
+			break
+		}
+		r.lastValidOff += frameSizeBytes + recBytes + padBytes
+
+		commitTs := redoLog.GetCommitTs()
+		if report.Events == 0 || commitTs < report.MinCommitTs {
+			report.MinCommitTs = commitTs
+		}
+		if commitTs > report.MaxCommitTs {
+			report.MaxCommitTs = commitTs
+		}
+		report.Events++
+	}
+	if report.Err == nil && filepath.Ext(file.Path) == redo.LogEXT &&
+		report.MaxCommitTs > file.CommitTs {
+		report.Err = fmt.Errorf("max commit ts %d is greater than %d in file name",
+			report.MaxCommitTs, file.CommitTs)
+	}
+	return report
+}
+
+func isAllZero(data []byte) bool {
+	for _, b := range data {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
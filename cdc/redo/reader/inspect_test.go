@@ -0,0 +1,85 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reader
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/pingcap/tiflow/cdc/redo/common"
+	"github.com/pingcap/tiflow/pkg/redo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListAndValidateLogFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	ctx := context.Background()
+	genMetaFile(t, dir, &common.LogMeta{CheckpointTs: 11, ResolvedTs: 100})
+	genLogFile(ctx, t, dir, redo.RedoRowLogFileType, 11, 20)
+	genLogFile(ctx, t, dir, redo.RedoDDLLogFileType, 15, 15)
+	// a file which is not written by redo log writers is ignored.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README"), []byte("x"), 0o644))
+
+	uri, err := url.Parse(fmt.Sprintf("file://%s", dir))
+	require.NoError(t, err)
+	files, err := ListLogFiles(ctx, *uri)
+	require.NoError(t, err)
+	require.Len(t, files, 3)
+	require.Equal(t, redo.RedoDDLLogFileType, files[0].FileType)
+	require.Equal(t, uint64(15), files[0].CommitTs)
+	require.Equal(t, redo.RedoMetaFileType, files[1].FileType)
+	require.Equal(t, redo.RedoRowLogFileType, files[2].FileType)
+	require.Equal(t, uint64(20), files[2].CommitTs)
+
+	reports, err := ValidateLogFiles(ctx, *uri, files)
+	require.NoError(t, err)
+	require.Len(t, reports, 3)
+	for _, report := range reports {
+		require.NoError(t, report.Err, report.Path)
+	}
+	require.Equal(t, 1, reports[0].Events)
+	require.Equal(t, uint64(100), reports[1].Meta.ResolvedTs)
+	require.Equal(t, 10, reports[2].Events)
+	require.Equal(t, uint64(11), reports[2].MinCommitTs)
+	require.Equal(t, uint64(20), reports[2].MaxCommitTs)
+
+	// a file with garbage appended is corrupted.
+	rowFile := filepath.Join(dir, files[2].Path)
+	data, err := os.ReadFile(rowFile)
+	require.NoError(t, err)
+	corrupted := append(append([]byte{}, data...), 0x10, 0, 0, 0, 0, 0, 0, 0, 1, 2, 3)
+	report := validateLogFile(files[2], corrupted)
+	require.Error(t, report.Err)
+	require.Equal(t, 10, report.Events)
+
+	// zeros at the tail are reported as a torn write.
+	report = validateLogFile(files[2], append(append([]byte{}, data...), make([]byte, 16)...))
+	require.NoError(t, report.Err)
+	require.True(t, report.Torn)
+
+	// the max commit ts of the events must not exceed the one in the file name.
+	name := fmt.Sprintf(redo.RedoLogFileFormatV2, "capture", "default",
+		"changefeed", redo.RedoRowLogFileType, 19, uuid.NewString(), redo.LogEXT)
+	report = validateLogFile(LogFile{
+		Path: name, FileType: redo.RedoRowLogFileType, CommitTs: 19,
+	}, data)
+	require.Error(t, report.Err)
+}
@@ -81,6 +81,12 @@ type LogReaderConfig struct {
 	// will load the file to memory first then write the sorted file to disk
 	// the memory used is WorkerNums * defaultMaxLogSize (64 * megabyte) total
 	WorkerNums int
+
+	// StartTs and EndTs override the range (checkpointTs, resolvedTs] recorded
+	// in meta if they are not zero, only logs with commit ts in (StartTs, EndTs]
+	// are read. It is used to inspect logs offline, not to apply them.
+	StartTs uint64
+	EndTs   uint64
 }
 
 // LogReader implement RedoLogReader interface
@@ -143,9 +149,10 @@ func (l *LogReader) Run(ctx context.Context) error {
 
 func (l *LogReader) runRowReader(egCtx context.Context) error {
 	defer close(l.rowCh)
+	startTs, endTs := l.tsRange(l.meta.CheckpointTs)
 	rowCfg := &readerConfig{
-		startTs:            l.meta.CheckpointTs,
-		endTs:              l.meta.ResolvedTs,
+		startTs:            startTs,
+		endTs:              endTs,
 		dir:                l.cfg.Dir,
 		fileType:           redo.RedoRowLogFileType,
 		uri:                l.cfg.URI,
@@ -157,9 +164,10 @@ func (l *LogReader) runRowReader(egCtx context.Context) error {
 
 func (l *LogReader) runDDLReader(egCtx context.Context) error {
 	defer close(l.ddlCh)
+	startTs, endTs := l.tsRange(l.meta.CheckpointTs - 1)
 	ddlCfg := &readerConfig{
-		startTs:            l.meta.CheckpointTs - 1,
-		endTs:              l.meta.ResolvedTs,
+		startTs:            startTs,
+		endTs:              endTs,
 		dir:                l.cfg.Dir,
 		fileType:           redo.RedoDDLLogFileType,
 		uri:                l.cfg.URI,
@@ -169,6 +177,19 @@ func (l *LogReader) runDDLReader(egCtx context.Context) error {
 	return l.runReader(egCtx, ddlCfg)
 }
 
+// tsRange returns the range of commit ts to read, startTs is used if
+// the start ts is not overridden by the config.
+func (l *LogReader) tsRange(startTs uint64) (uint64, uint64) {
+	endTs := l.meta.ResolvedTs
+	if l.cfg.StartTs != 0 {
+		startTs = l.cfg.StartTs
+	}
+	if l.cfg.EndTs != 0 {
+		endTs = l.cfg.EndTs
+	}
+	return startTs, endTs
+}
+
 func (l *LogReader) runReader(egCtx context.Context, cfg *readerConfig) error {
 	fileReaders, err := newReaders(egCtx, cfg)
 	if err != nil {
@@ -26,4 +26,8 @@ type InfoProvider interface {
 
 	// GetTaskStatuses returns the task statuses.
 	GetTaskStatuses() (map[model.CaptureID]*model.TaskStatus, error)
+
+	// GetSpanStatuses returns the replication statuses of all spans,
+	// sorted by span.
+	GetSpanStatuses() ([]*model.SpanReplicationStatus, error)
 }
@@ -143,8 +143,8 @@ func (c *coordinator) Tick(
 	return c.poll(ctx, checkpointTs, currentTables, aliveCaptures, barrier)
 }
 
-// MoveTable implement the scheduler interface
-// FIXME: tableID should be Span.
+// MoveTable implement the scheduler interface, all spans of the table are
+// moved to the target capture.
 func (c *coordinator) MoveTable(tableID model.TableID, target model.CaptureID) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -159,8 +159,21 @@ func (c *coordinator) MoveTable(tableID model.TableID, target model.CaptureID) {
 		return
 	}
 
-	span := spanz.TableIDToComparableSpan(tableID)
-	c.schedulerM.MoveTable(span, target)
+	spans := make([]tablepb.Span, 0, 1)
+	start, end := spanz.TableIDToComparableRange(tableID)
+	c.replicationM.ReplicationSets().AscendRange(start, end,
+		func(span tablepb.Span, _ *replication.ReplicationSet) bool {
+			spans = append(spans, span)
+			return true
+		})
+	if len(spans) == 0 {
+		// The table is not replicated yet, move table scheduler drops the
+		// task if the table can not be found.
+		spans = append(spans, spanz.TableIDToComparableSpan(tableID))
+	}
+	for _, span := range spans {
+		c.schedulerM.MoveTable(span, target)
+	}
 }
 
 // Rebalance implement the scheduler interface
@@ -215,6 +228,8 @@ func (c *coordinator) DrainCapture(target model.CaptureID) (int, error) {
 
 	// when draining the capture, tables need to be dispatched to other capture
 	// except the draining one, so there should be at least two live captures.
+	// If no other capture satisfies placement constraints, tables are
+	// dispatched to the captures which don't, see schedulableCaptures.
 	if len(c.captureM.Captures) <= 1 {
 		log.Warn("schedulerv3: drain capture request ignored, "+
 			"only one captures alive",
@@ -248,6 +263,24 @@ func (c *coordinator) DrainCapture(target model.CaptureID) (int, error) {
 	return count, nil
 }
 
+// schedulableCaptures returns the captures that tables can be scheduled to.
+// They are the captures that satisfy placement constraints, unless the only
+// one of them is being drained, in which case all captures are returned so
+// that the capture can still be drained, e.g. in a rolling upgrade.
+func (c *coordinator) schedulableCaptures() map[model.CaptureID]*member.CaptureStatus {
+	captures := c.captureM.SchedulableCaptures()
+	target := c.schedulerM.DrainingTarget()
+	if target == "" {
+		return captures
+	}
+	for id := range captures {
+		if id != target {
+			return captures
+		}
+	}
+	return c.captureM.Captures
+}
+
 func (c *coordinator) Close(ctx context.Context) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -347,10 +380,12 @@ func (c *coordinator) poll(
 	// Generate schedule tasks based on the current status.
 	replications := c.replicationM.ReplicationSets()
 	runningTasks := c.replicationM.RunningTasks()
+	// Tables are only scheduled to captures that satisfy placement constraints.
+	captures := c.schedulableCaptures()
 	currentSpans := c.reconciler.Reconcile(
-		ctx, &c.tableRanges, replications, c.captureM.Captures, c.compat)
+		ctx, &c.tableRanges, replications, captures, c.compat)
 	allTasks := c.schedulerM.Schedule(
-		checkpointTs, currentSpans, c.captureM.Captures, replications, runningTasks)
+		checkpointTs, currentSpans, captures, replications, runningTasks)
 
 	// Handle generated schedule tasks.
 	msgs, err = c.replicationM.HandleTasks(allTasks)
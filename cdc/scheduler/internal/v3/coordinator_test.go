@@ -439,6 +439,49 @@ func TestCoordinatorDrainCapture(t *testing.T) {
 	require.Equal(t, 1, count)
 }
 
+func TestCoordinatorMoveSplitTable(t *testing.T) {
+	t.Parallel()
+
+	coord := coordinator{
+		version:   "6.2.0",
+		revision:  schedulepb.OwnerRevision{Revision: 3},
+		captureID: "a",
+	}
+	cfg := config.NewDefaultSchedulerConfig()
+	coord.captureM = member.NewCaptureManager("", model.ChangeFeedID{}, coord.revision, cfg)
+	coord.captureM.SetInitializedForTests(true)
+	coord.captureM.Captures["a"] = &member.CaptureStatus{State: member.CaptureStateInitialized}
+	coord.captureM.Captures["b"] = &member.CaptureStatus{State: member.CaptureStateInitialized}
+	coord.replicationM = replication.NewReplicationManager(10, model.ChangeFeedID{})
+	coord.schedulerM = scheduler.NewSchedulerManager(model.ChangeFeedID{}, cfg)
+
+	// Table 1 is split into two spans.
+	span := spanz.TableIDToComparableSpan(1)
+	splitKey := append(append([]byte{}, span.StartKey...), 1)
+	spans := []tablepb.Span{
+		{TableID: 1, StartKey: span.StartKey, EndKey: splitKey},
+		{TableID: 1, StartKey: splitKey, EndKey: span.EndKey},
+		spanz.TableIDToComparableSpan(2),
+	}
+	for _, span := range spans {
+		coord.replicationM.SetReplicationSetForTests(&replication.ReplicationSet{
+			Span:     span,
+			State:    replication.ReplicationSetStateReplicating,
+			Primary:  "a",
+			Captures: map[model.CaptureID]replication.Role{"a": replication.RolePrimary},
+		})
+	}
+
+	coord.MoveTable(1, "b")
+	tasks := coord.schedulerM.Schedule(0, spans, coord.captureM.Captures,
+		coord.replicationM.ReplicationSets(), coord.replicationM.RunningTasks())
+	require.Len(t, tasks, 2)
+	for i, task := range tasks {
+		require.Equal(t, spans[i], task.MoveTable.Span)
+		require.Equal(t, "b", task.MoveTable.DestCapture)
+	}
+}
+
 func TestCoordinatorAdvanceCheckpoint(t *testing.T) {
 	t.Parallel()
 
@@ -636,3 +679,58 @@ func TestCoordinatorDropMsgIfChangefeedEpochMismatch(t *testing.T) {
 	require.EqualValues(t, "1", msgs[0].From)
 	require.EqualValues(t, "3", msgs[1].From)
 }
+
+func TestCoordinatorPlacementConstraints(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.GetDefaultReplicaConfig().Scheduler
+	cfg.PlacementConstraints = []*config.PlacementConstraint{
+		{Key: "pool", Op: config.PlacementOpNotIn, Values: []string{"payment"}},
+	}
+	coord, trans := newTestCoordinator(&config.SchedulerConfig{
+		HeartbeatTick:      math.MaxInt,
+		CollectStatsTick:   math.MaxInt,
+		MaxTaskConcurrency: 1,
+		AddTableBatchSize:  50,
+		ChangefeedSettings: cfg,
+	})
+
+	// Capture "a" serves the payment pool, table 3 on the removed capture
+	// "c" must be added to capture "b".
+	coord.captureM.Captures["a"] = &member.CaptureStatus{
+		State: member.CaptureStateInitialized, Labels: map[string]string{"pool": "payment"},
+	}
+	coord.captureM.Captures["b"] = &member.CaptureStatus{State: member.CaptureStateInitialized}
+	coord.captureM.Captures["c"] = &member.CaptureStatus{State: member.CaptureStateInitialized}
+	coord.captureM.SetInitializedForTests(true)
+	init := map[string][]tablepb.TableStatus{
+		"b": {{Span: spanz.TableIDToComparableSpan(2), State: tablepb.TableStateReplicating}},
+		"c": {{Span: spanz.TableIDToComparableSpan(3), State: tablepb.TableStateReplicating}},
+	}
+	_, err := coord.replicationM.HandleCaptureChanges(init, nil, 0)
+	require.Nil(t, err)
+
+	ctx := context.Background()
+	currentTables := []model.TableID{2, 3}
+	aliveCaptures := map[model.CaptureID]*model.CaptureInfo{
+		"a": {Labels: map[string]string{"pool": "payment"}}, "b": {},
+	}
+	_, err = coord.poll(ctx, 0, currentTables, aliveCaptures, schedulepb.NewBarrierWithMinTs(0))
+	require.Nil(t, err)
+	msgs := trans.SendBuffer
+	require.Len(t, msgs, 1)
+	require.Equal(t, "b", msgs[0].To)
+	require.EqualValues(t, 3, msgs[0].DispatchTableRequest.GetAddTable().Span.TableID)
+
+	require.Len(t, coord.schedulableCaptures(), 1)
+
+	// Capture "b" can still be drained even if no other capture satisfies
+	// placement constraints, tables are dispatched to the other captures.
+	count, err := coord.DrainCapture("b")
+	require.Nil(t, err)
+	require.Equal(t, 1, count)
+	require.Equal(t, "b", coord.schedulerM.DrainingTarget())
+	captures := coord.schedulableCaptures()
+	require.Len(t, captures, 2)
+	require.Contains(t, captures, "a")
+}
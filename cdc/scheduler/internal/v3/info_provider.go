@@ -14,8 +14,13 @@
 package v3
 
 import (
+	"time"
+
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal"
+	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/replication"
+	"github.com/tikv/client-go/v2/oracle"
 )
 
 var _ internal.InfoProvider = (*coordinator)(nil)
@@ -48,3 +53,37 @@ func (c *coordinator) GetTaskStatuses() (map[model.CaptureID]*model.TaskStatus,
 	}
 	return tasks, nil
 }
+
+// GetSpanStatuses returns the replication statuses of all spans.
+func (c *coordinator) GetSpanStatuses() ([]*model.SpanReplicationStatus, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	pdTime := time.Now()
+	// only nil in unit test
+	if c.pdClock != nil {
+		pdTime = c.pdClock.CurrentTime()
+	}
+	statuses := make([]*model.SpanReplicationStatus, 0, c.replicationM.ReplicationSets().Len())
+	c.replicationM.ReplicationSets().Ascend(
+		func(span tablepb.Span, rep *replication.ReplicationSet) bool {
+			stages := rep.Stats.StageCheckpoints
+			statuses = append(statuses, &model.SpanReplicationStatus{
+				Span:         span,
+				CaptureID:    rep.Primary,
+				State:        rep.State.String(),
+				CheckpointTs: rep.Checkpoint.CheckpointTs,
+				ResolvedTs:   rep.Checkpoint.ResolvedTs,
+				CheckpointLag: pdTime.Sub(
+					oracle.GetTimeFromTS(rep.Checkpoint.CheckpointTs)),
+				PullerResolvedTs: stages["puller-egress"].ResolvedTs,
+				SorterResolvedTs: stages["sorter-egress"].ResolvedTs,
+				SinkCheckpointTs: stages["sink"].CheckpointTs,
+				RedoResolvedTs:   stages["redo"].ResolvedTs,
+				MemoryQuotaWait: time.Duration(
+					rep.Stats.MemoryQuotaWaitMs) * time.Millisecond,
+			})
+			return true
+		})
+	return statuses, nil
+}
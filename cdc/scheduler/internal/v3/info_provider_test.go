@@ -16,6 +16,7 @@ package v3
 import (
 	"math"
 	"testing"
+	"time"
 
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/processor/tablepb"
@@ -23,6 +24,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/scheduler/internal"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/keyspan"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/member"
+	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/replication"
 	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/stretchr/testify/require"
 )
@@ -61,6 +63,43 @@ func TestInfoProvider(t *testing.T) {
 		}},
 		"b": {Tables: map[model.TableID]*model.TableReplicaInfo{}},
 	}, tasks)
+
+	coord.replicationM.SetReplicationSetForTests(&replication.ReplicationSet{
+		Span:       tablepb.Span{TableID: 2},
+		State:      replication.ReplicationSetStatePrepare,
+		Primary:    "b",
+		Checkpoint: tablepb.Checkpoint{CheckpointTs: 3, ResolvedTs: 4},
+	})
+	coord.replicationM.SetReplicationSetForTests(&replication.ReplicationSet{
+		Span:       tablepb.Span{TableID: 1},
+		State:      replication.ReplicationSetStateReplicating,
+		Primary:    "a",
+		Checkpoint: tablepb.Checkpoint{CheckpointTs: 1, ResolvedTs: 2},
+		Stats: tablepb.Stats{
+			StageCheckpoints: map[string]tablepb.Checkpoint{
+				"puller-egress": {CheckpointTs: 5, ResolvedTs: 5},
+				"sorter-egress": {CheckpointTs: 4, ResolvedTs: 4},
+				"sink":          {CheckpointTs: 1, ResolvedTs: 4},
+			},
+			MemoryQuotaWaitMs: 1500,
+		},
+	})
+	spans, err := ip.GetSpanStatuses()
+	require.NoError(t, err)
+	require.Len(t, spans, 2)
+	require.Equal(t, tablepb.Span{TableID: 1}, spans[0].Span)
+	require.Equal(t, "a", spans[0].CaptureID)
+	require.Equal(t, "Replicating", spans[0].State)
+	require.Equal(t, uint64(1), spans[0].CheckpointTs)
+	require.Equal(t, uint64(2), spans[0].ResolvedTs)
+	require.Positive(t, spans[0].CheckpointLag)
+	require.Equal(t, uint64(5), spans[0].PullerResolvedTs)
+	require.Equal(t, uint64(4), spans[0].SorterResolvedTs)
+	require.Equal(t, uint64(1), spans[0].SinkCheckpointTs)
+	require.Zero(t, spans[0].RedoResolvedTs)
+	require.Equal(t, 1500*time.Millisecond, spans[0].MemoryQuotaWait)
+	require.Equal(t, tablepb.Span{TableID: 2}, spans[1].Span)
+	require.Equal(t, "Prepare", spans[1].State)
 }
 
 func TestInfoProviderIsInitialized(t *testing.T) {
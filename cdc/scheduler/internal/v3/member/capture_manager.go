@@ -64,6 +64,7 @@ type CaptureStatus struct {
 	ID           model.CaptureID
 	Addr         string
 	IsOwner      bool
+	Labels       map[string]string
 	changefeedID model.ChangeFeedID
 }
 
@@ -127,6 +128,8 @@ type CaptureManager struct {
 	OwnerRev schedulepb.OwnerRevision
 	Captures map[model.CaptureID]*CaptureStatus
 
+	placementConstraints []*config.PlacementConstraint
+
 	initialized bool
 	changes     *CaptureChanges
 
@@ -145,11 +148,16 @@ func NewCaptureManager(
 	ownerID model.CaptureID, changefeedID model.ChangeFeedID,
 	rev schedulepb.OwnerRevision, cfg *config.SchedulerConfig,
 ) *CaptureManager {
+	var constraints []*config.PlacementConstraint
+	if cfg.ChangefeedSettings != nil {
+		constraints = cfg.ChangefeedSettings.PlacementConstraints
+	}
 	return &CaptureManager{
-		OwnerRev:         rev,
-		Captures:         make(map[model.CaptureID]*CaptureStatus),
-		heartbeatTick:    cfg.HeartbeatTick,
-		collectStatsTick: cfg.CollectStatsTick,
+		OwnerRev:             rev,
+		Captures:             make(map[model.CaptureID]*CaptureStatus),
+		placementConstraints: constraints,
+		heartbeatTick:        cfg.HeartbeatTick,
+		collectStatsTick:     cfg.CollectStatsTick,
 
 		changefeedID: changefeedID,
 		ownerID:      ownerID,
@@ -173,6 +181,21 @@ func (c *CaptureManager) checkAllCaptureInitialized() bool {
 	return len(c.Captures) != 0
 }
 
+// SchedulableCaptures returns captures that satisfy placement constraints of
+// the changefeed, tables can only be added or moved to them.
+func (c *CaptureManager) SchedulableCaptures() map[model.CaptureID]*CaptureStatus {
+	if len(c.placementConstraints) == 0 {
+		return c.Captures
+	}
+	captures := make(map[model.CaptureID]*CaptureStatus, len(c.Captures))
+	for id, capture := range c.Captures {
+		if config.MatchPlacementConstraints(c.placementConstraints, capture.Labels) {
+			captures[id] = capture
+		}
+	}
+	return captures
+}
+
 // Tick advances the logical clock of capture manager and produce heartbeat when
 // necessary.
 func (c *CaptureManager) Tick(
@@ -241,13 +264,18 @@ func (c *CaptureManager) HandleAliveCaptureUpdate(
 	for id, info := range aliveCaptures {
 		if _, ok := c.Captures[id]; !ok {
 			// A new capture.
-			c.Captures[id] = newCaptureStatus(
+			capture := newCaptureStatus(
 				c.OwnerRev, id, info.AdvertiseAddr, c.ownerID == id, c.changefeedID)
+			capture.Labels = info.Labels
+			c.Captures[id] = capture
 			log.Info("schedulerv3: find a new capture",
 				zap.String("namespace", c.changefeedID.Namespace),
 				zap.String("changefeed", c.changefeedID.ID),
 				zap.String("captureAddr", info.AdvertiseAddr),
-				zap.String("capture", id))
+				zap.String("capture", id),
+				zap.Any("labels", info.Labels),
+				zap.Bool("schedulable", config.MatchPlacementConstraints(
+					c.placementConstraints, info.Labels)))
 			msgs = append(msgs, &schedulepb.Message{
 				To:        id,
 				MsgType:   schedulepb.MsgHeartbeat,
@@ -127,6 +127,40 @@ func TestCaptureManagerHandleAliveCaptureUpdate(t *testing.T) {
 	require.False(t, cm.CheckAllCaptureInitialized())
 }
 
+func TestCaptureManagerPlacementConstraints(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.NewDefaultSchedulerConfig()
+	cfg.ChangefeedSettings = &config.ChangefeedSchedulerConfig{
+		PlacementConstraints: []*config.PlacementConstraint{
+			{Key: "pool", Op: config.PlacementOpIn, Values: []string{"payment"}},
+		},
+	}
+	cm := NewCaptureManager("1", model.ChangeFeedID{}, schedulepb.OwnerRevision{}, cfg)
+	ms := map[model.CaptureID]*model.CaptureInfo{
+		"1": {},
+		"2": {Labels: map[string]string{"pool": "payment"}},
+		"3": {Labels: map[string]string{"pool": "backfill"}},
+	}
+	cm.HandleAliveCaptureUpdate(ms)
+	require.Len(t, cm.Captures, 3)
+	require.Equal(t, map[string]string{"pool": "payment"}, cm.Captures["2"].Labels)
+	require.Len(t, cm.SchedulableCaptures(), 1)
+	require.Same(t, cm.Captures["2"], cm.SchedulableCaptures()["2"])
+
+	// Remove the schedulable capture.
+	delete(ms, "2")
+	cm.HandleAliveCaptureUpdate(ms)
+	require.Len(t, cm.Captures, 2)
+	require.Empty(t, cm.SchedulableCaptures())
+
+	// Without constraints, all captures are schedulable.
+	cm = NewCaptureManager("1", model.ChangeFeedID{}, schedulepb.OwnerRevision{},
+		config.NewDefaultSchedulerConfig())
+	cm.HandleAliveCaptureUpdate(ms)
+	require.Len(t, cm.SchedulableCaptures(), 2)
+}
+
 func TestCaptureManagerHandleMessages(t *testing.T) {
 	t.Parallel()
 
@@ -35,6 +35,9 @@ var _ scheduler = &basicScheduler{}
 type basicScheduler struct {
 	batchSize    int
 	changefeedID model.ChangeFeedID
+	// tracker is set when load balance is enabled, it is used to add new
+	// tables to the least loaded captures first.
+	tracker *loadTracker
 }
 
 func newBasicScheduler(batchSize int, changefeed model.ChangeFeedID) *basicScheduler {
@@ -101,6 +104,9 @@ func (b *basicScheduler) Schedule(
 				zap.Any("allCaptureStatus", captures))
 			return tasks
 		}
+		if b.tracker != nil {
+			b.tracker.sortCapturesByLoad(captureIDs, captures)
+		}
 		tasks = append(
 			tasks, newBurstAddTables(b.changefeedID, checkpointTs, newSpans, captureIDs))
 	}
@@ -148,7 +148,7 @@ func (d *drainCaptureScheduler) Schedule(
 		}
 
 		// only calculate workload of other captures not the drain target.
-		if rep.Primary != d.target {
+		if _, ok := captureWorkload[rep.Primary]; ok {
 			captureWorkload[rep.Primary]++
 		}
 		return true
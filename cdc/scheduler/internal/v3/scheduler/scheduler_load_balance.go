@@ -0,0 +1,335 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduler
+
+import (
+	"sort"
+	"time"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
+	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/member"
+	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/replication"
+	"github.com/pingcap/tiflow/pkg/spanz"
+	"github.com/tikv/client-go/v2/oracle"
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultLoadImbalanceThreshold is used when the changefeed does not
+	// specify `load-imbalance-threshold`.
+	defaultLoadImbalanceThreshold = 0.2
+	// loadRateSmoothing is the weight of the latest sample in the
+	// exponentially weighted moving average of span throughput.
+	loadRateSmoothing = 0.5
+	// maxLagFactor caps how much lag can amplify the load of a span, so that
+	// a span stuck for a long time does not dominate the whole capture.
+	maxLagFactor = 4
+	// moveCooldownIntervals is the number of balance intervals a span must
+	// stay on a capture after it has been moved by the load balancer.
+	moveCooldownIntervals = 5
+)
+
+// spanLoad is the load statistics of a span.
+type spanLoad struct {
+	primary model.CaptureID
+	// eventCount is the last seen value of the span's received event counter.
+	eventCount uint64
+	// rate is the smoothed number of events per second received by the span.
+	rate    float64
+	sampled bool
+	// lag is the distance between the resolved ts and the checkpoint ts.
+	lag       time.Duration
+	lastMoved time.Time
+}
+
+// loadTracker estimates the load of spans from the stats reported by
+// captures in heartbeat responses.
+type loadTracker struct {
+	spans      *spanz.BtreeMap[*spanLoad]
+	lastSample time.Time
+	// interval is the expected interval between two samples, a span lags
+	// behind by one interval doubles its load.
+	interval time.Duration
+	// samples is the number of times the tracker has been sampled.
+	samples int
+}
+
+func newLoadTracker(interval time.Duration) *loadTracker {
+	return &loadTracker{
+		spans:    spanz.NewBtreeMap[*spanLoad](),
+		interval: interval,
+	}
+}
+
+// sample updates span loads with the latest stats in replications.
+func (t *loadTracker) sample(
+	replications *spanz.BtreeMap[*replication.ReplicationSet], now time.Time,
+) {
+	elapsed := now.Sub(t.lastSample).Seconds()
+	spans := spanz.NewBtreeMap[*spanLoad]()
+	replications.Ascend(func(span tablepb.Span, rep *replication.ReplicationSet) bool {
+		load, ok := t.spans.Get(span)
+		if rep.State != replication.ReplicationSetStateReplicating {
+			if ok {
+				spans.ReplaceOrInsert(span, load)
+			}
+			return true
+		}
+		count := rep.Stats.ReceivedEventCount
+		switch {
+		case !ok:
+			load = &spanLoad{primary: rep.Primary, eventCount: count}
+		case load.primary != rep.Primary || count < load.eventCount:
+			// The span has been moved or its table sink has been restarted,
+			// the counter starts over. Keep the rate as the span's workload
+			// does not change with its capture.
+			load.primary = rep.Primary
+			load.eventCount = count
+		case elapsed > 0 && !t.lastSample.IsZero():
+			rate := float64(count-load.eventCount) / elapsed
+			if load.sampled {
+				rate = loadRateSmoothing*rate + (1-loadRateSmoothing)*load.rate
+			}
+			load.rate = rate
+			load.sampled = true
+			load.eventCount = count
+		}
+		load.lag = 0
+		if rep.Checkpoint.ResolvedTs > rep.Checkpoint.CheckpointTs {
+			load.lag = oracle.GetTimeFromTS(rep.Checkpoint.ResolvedTs).Sub(
+				oracle.GetTimeFromTS(rep.Checkpoint.CheckpointTs))
+		}
+		spans.ReplaceOrInsert(span, load)
+		return true
+	})
+	t.spans = spans
+	t.lastSample = now
+	t.samples++
+}
+
+// load returns the load of a span. A lagging span has accumulated a backlog
+// that must be drained on top of its current throughput, so its rate is
+// amplified by how many sample intervals it lags behind.
+func (t *loadTracker) load(l *spanLoad) float64 {
+	factor := 1.0
+	if t.interval > 0 {
+		factor += float64(l.lag) / float64(t.interval)
+	}
+	if factor > maxLagFactor {
+		factor = maxLagFactor
+	}
+	return l.rate * factor
+}
+
+// captureLoads returns the sum of span loads of each capture.
+func (t *loadTracker) captureLoads(
+	captures map[model.CaptureID]*member.CaptureStatus,
+) map[model.CaptureID]float64 {
+	loads := make(map[model.CaptureID]float64, len(captures))
+	for captureID := range captures {
+		loads[captureID] = 0
+	}
+	t.spans.Ascend(func(_ tablepb.Span, l *spanLoad) bool {
+		if _, ok := loads[l.primary]; ok {
+			loads[l.primary] += t.load(l)
+		}
+		return true
+	})
+	return loads
+}
+
+// sortCapturesByLoad sorts captures from the least loaded to the most loaded.
+func (t *loadTracker) sortCapturesByLoad(
+	captureIDs []model.CaptureID, captures map[model.CaptureID]*member.CaptureStatus,
+) {
+	loads := t.captureLoads(captures)
+	sort.Slice(captureIDs, func(i, j int) bool {
+		li, lj := loads[captureIDs[i]], loads[captureIDs[j]]
+		if li != lj {
+			return li < lj
+		}
+		return captureIDs[i] < captureIDs[j]
+	})
+}
+
+var _ scheduler = &loadBalanceScheduler{}
+
+// The scheduler for balancing spans among captures by their throughput and
+// lag. It replaces the balance scheduler when load balance is enabled.
+//
+// To avoid flapping, it only moves spans when the busiest capture exceeds
+// the average load by the imbalance threshold, never overloads the target
+// capture, skips spans too light to matter, and does not move a span again
+// until its cooldown expires.
+type loadBalanceScheduler struct {
+	tracker              *loadTracker
+	lastCheckTime        time.Time
+	checkBalanceInterval time.Duration
+	threshold            float64
+
+	maxTaskConcurrency int
+	changefeedID       model.ChangeFeedID
+}
+
+func newLoadBalanceScheduler(
+	interval time.Duration, concurrency int, threshold float64,
+	tracker *loadTracker, changefeedID model.ChangeFeedID,
+) *loadBalanceScheduler {
+	if threshold <= 0 {
+		threshold = defaultLoadImbalanceThreshold
+	}
+	return &loadBalanceScheduler{
+		tracker:              tracker,
+		checkBalanceInterval: interval,
+		threshold:            threshold,
+		maxTaskConcurrency:   concurrency,
+		changefeedID:         changefeedID,
+	}
+}
+
+func (b *loadBalanceScheduler) Name() string {
+	return "load-balance-scheduler"
+}
+
+func (b *loadBalanceScheduler) Schedule(
+	_ model.Ts,
+	_ []tablepb.Span,
+	captures map[model.CaptureID]*member.CaptureStatus,
+	replications *spanz.BtreeMap[*replication.ReplicationSet],
+) []*replication.ScheduleTask {
+	return b.schedule(time.Now(), captures, replications)
+}
+
+func (b *loadBalanceScheduler) schedule(
+	now time.Time,
+	captures map[model.CaptureID]*member.CaptureStatus,
+	replications *spanz.BtreeMap[*replication.ReplicationSet],
+) []*replication.ScheduleTask {
+	if now.Sub(b.lastCheckTime) < b.checkBalanceInterval {
+		// skip balance.
+		return nil
+	}
+	b.lastCheckTime = now
+	b.tracker.sample(replications, now)
+
+	for _, capture := range captures {
+		if capture.State == member.CaptureStateStopping {
+			log.Debug("schedulerv3: capture is stopping, premature to balance table",
+				zap.String("namespace", b.changefeedID.Namespace),
+				zap.String("changefeed", b.changefeedID.ID))
+			return nil
+		}
+	}
+	// Rates are known only after the second sample.
+	if b.tracker.samples < 2 || len(captures) < 2 {
+		return nil
+	}
+
+	moves := b.buildMoves(now, captures, replications)
+	tasks := make([]*replication.ScheduleTask, 0, len(moves))
+	for i := range moves {
+		tasks = append(tasks, &replication.ScheduleTask{MoveTable: &moves[i]})
+	}
+	return tasks
+}
+
+func (b *loadBalanceScheduler) buildMoves(
+	now time.Time,
+	captures map[model.CaptureID]*member.CaptureStatus,
+	replications *spanz.BtreeMap[*replication.ReplicationSet],
+) []replication.MoveTable {
+	loads := b.tracker.captureLoads(captures)
+	captureIDs := make([]model.CaptureID, 0, len(captures))
+	total := 0.0
+	for captureID, load := range loads {
+		captureIDs = append(captureIDs, captureID)
+		total += load
+	}
+	if total == 0 {
+		return nil
+	}
+	average := total / float64(len(captures))
+	upperLimit := average * (1 + b.threshold)
+	// Moving a span is not free, a move must take a meaningful part of the
+	// load off the source capture.
+	minMoveLoad := average * b.threshold
+	cooldown := b.checkBalanceInterval * moveCooldownIntervals
+
+	moves := make([]replication.MoveTable, 0)
+	moved := spanz.NewSet()
+	for len(moves) < b.maxTaskConcurrency {
+		sort.Slice(captureIDs, func(i, j int) bool {
+			li, lj := loads[captureIDs[i]], loads[captureIDs[j]]
+			if li != lj {
+				return li < lj
+			}
+			return captureIDs[i] < captureIDs[j]
+		})
+		src, dest := captureIDs[len(captureIDs)-1], captureIDs[0]
+		if loads[src] <= upperLimit {
+			break
+		}
+
+		// Move the span that brings both captures closest to the middle,
+		// without pushing the target capture over the upper limit.
+		target := (loads[src] - loads[dest]) / 2
+		var (
+			victim     tablepb.Span
+			victimLoad *spanLoad
+			bestDiff   float64
+		)
+		b.tracker.spans.Ascend(func(span tablepb.Span, l *spanLoad) bool {
+			if l.primary != src || moved.Contain(span) ||
+				(!l.lastMoved.IsZero() && now.Sub(l.lastMoved) < cooldown) {
+				return true
+			}
+			rep, ok := replications.Get(span)
+			if !ok || rep.State != replication.ReplicationSetStateReplicating {
+				return true
+			}
+			load := b.tracker.load(l)
+			if load < minMoveLoad || loads[dest]+load > upperLimit {
+				return true
+			}
+			diff := load - target
+			if diff < 0 {
+				diff = -diff
+			}
+			if victimLoad == nil || diff < bestDiff {
+				victim, victimLoad, bestDiff = span, l, diff
+			}
+			return true
+		})
+		if victimLoad == nil {
+			break
+		}
+
+		load := b.tracker.load(victimLoad)
+		loads[src] -= load
+		loads[dest] += load
+		victimLoad.lastMoved = now
+		moved.Add(victim)
+		moves = append(moves, replication.MoveTable{Span: victim, DestCapture: dest})
+		log.Info("schedulerv3: move span by load",
+			zap.String("namespace", b.changefeedID.Namespace),
+			zap.String("changefeed", b.changefeedID.ID),
+			zap.String("span", victim.String()),
+			zap.String("source", src),
+			zap.String("destination", dest),
+			zap.Float64("spanLoad", load))
+	}
+	return moves
+}
@@ -0,0 +1,216 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
+	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/member"
+	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/replication"
+	"github.com/pingcap/tiflow/pkg/spanz"
+	"github.com/stretchr/testify/require"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+func newLoadReplicationSet(
+	primary model.CaptureID, eventCount uint64,
+) *replication.ReplicationSet {
+	return &replication.ReplicationSet{
+		State:   replication.ReplicationSetStateReplicating,
+		Primary: primary,
+		Stats:   tablepb.Stats{ReceivedEventCount: eventCount},
+	}
+}
+
+func TestLoadTrackerSample(t *testing.T) {
+	t.Parallel()
+
+	tracker := newLoadTracker(time.Minute)
+	now := time.Now()
+	replications := mapToSpanMap(map[model.TableID]*replication.ReplicationSet{
+		1: newLoadReplicationSet("a", 100),
+		2: newLoadReplicationSet("b", 0),
+	})
+	tracker.sample(replications, now)
+	l1 := tracker.spans.GetV(tablepb.Span{TableID: 1})
+	require.False(t, l1.sampled)
+	require.Zero(t, l1.rate)
+
+	// 6000 events in a minute.
+	now = now.Add(time.Minute)
+	replications.GetV(tablepb.Span{TableID: 1}).Stats.ReceivedEventCount = 6100
+	tracker.sample(replications, now)
+	l1 = tracker.spans.GetV(tablepb.Span{TableID: 1})
+	require.InDelta(t, 100, l1.rate, 0.001)
+	require.Zero(t, tracker.spans.GetV(tablepb.Span{TableID: 2}).rate)
+
+	// The rate is smoothed.
+	now = now.Add(time.Minute)
+	replications.GetV(tablepb.Span{TableID: 1}).Stats.ReceivedEventCount = 6100 + 12000
+	tracker.sample(replications, now)
+	require.InDelta(t, 150, l1.rate, 0.001)
+
+	// Moving a span resets the counter but keeps the rate.
+	now = now.Add(time.Minute)
+	rep := replications.GetV(tablepb.Span{TableID: 1})
+	rep.Primary = "b"
+	rep.Stats.ReceivedEventCount = 10
+	tracker.sample(replications, now)
+	require.Equal(t, "b", l1.primary)
+	require.Equal(t, uint64(10), l1.eventCount)
+	require.InDelta(t, 150, l1.rate, 0.001)
+
+	// A lagging span has more load.
+	physical := time.Now()
+	rep.Checkpoint = tablepb.Checkpoint{
+		CheckpointTs: oracle.GoTimeToTS(physical),
+		ResolvedTs:   oracle.GoTimeToTS(physical.Add(30 * time.Second)),
+	}
+	now = now.Add(time.Minute)
+	rep.Stats.ReceivedEventCount = 10 + 9000
+	tracker.sample(replications, now)
+	require.InDelta(t, 150, l1.rate, 0.001)
+	require.InDelta(t, 225, tracker.load(l1), 0.001)
+	loads := tracker.captureLoads(map[model.CaptureID]*member.CaptureStatus{"a": {}, "b": {}})
+	require.InDelta(t, 0, loads["a"], 0.001)
+	require.InDelta(t, 225, loads["b"], 0.001)
+
+	// The lag factor is capped.
+	rep.Checkpoint.ResolvedTs = oracle.GoTimeToTS(physical.Add(time.Hour))
+	tracker.sample(replications, now.Add(time.Minute))
+	require.InDelta(t, maxLagFactor*l1.rate, tracker.load(l1), 0.001)
+
+	// Removed spans are dropped.
+	replications.Delete(tablepb.Span{TableID: 2})
+	tracker.sample(replications, now.Add(2*time.Minute))
+	require.False(t, tracker.spans.Has(tablepb.Span{TableID: 2}))
+}
+
+func TestSchedulerLoadBalance(t *testing.T) {
+	t.Parallel()
+
+	interval := time.Minute
+	sched := newLoadBalanceScheduler(
+		interval, 10, 0, newLoadTracker(interval), model.ChangeFeedID{})
+	require.Equal(t, defaultLoadImbalanceThreshold, sched.threshold)
+
+	// Two hot tables and many idle tables, the count is balanced but the
+	// load is not.
+	captures := map[model.CaptureID]*member.CaptureStatus{"a": {}, "b": {}}
+	replications := mapToSpanMap(map[model.TableID]*replication.ReplicationSet{
+		1: newLoadReplicationSet("a", 0),
+		2: newLoadReplicationSet("a", 0),
+		3: newLoadReplicationSet("b", 0),
+		4: newLoadReplicationSet("b", 0),
+	})
+	now := time.Now()
+	// The first sample only records counters.
+	require.Empty(t, sched.schedule(now, captures, replications))
+	// Skip before the check interval.
+	require.Empty(t, sched.schedule(now.Add(time.Second), captures, replications))
+
+	replications.GetV(tablepb.Span{TableID: 1}).Stats.ReceivedEventCount = 60000
+	replications.GetV(tablepb.Span{TableID: 2}).Stats.ReceivedEventCount = 54000
+	replications.GetV(tablepb.Span{TableID: 3}).Stats.ReceivedEventCount = 60
+	now = now.Add(interval)
+	tasks := sched.schedule(now, captures, replications)
+	require.Len(t, tasks, 1)
+	require.Equal(t, tablepb.Span{TableID: 2}, tasks[0].MoveTable.Span)
+	require.Equal(t, "b", tasks[0].MoveTable.DestCapture)
+
+	// The move is done, the load is balanced within the threshold.
+	rep := replications.GetV(tablepb.Span{TableID: 2})
+	rep.Primary = "b"
+	rep.Stats.ReceivedEventCount = 0
+	replications.GetV(tablepb.Span{TableID: 1}).Stats.ReceivedEventCount = 120000
+	replications.GetV(tablepb.Span{TableID: 3}).Stats.ReceivedEventCount = 120
+	now = now.Add(interval)
+	require.Empty(t, sched.schedule(now, captures, replications))
+
+	// Table 1 becomes idle and table 2 gets hotter, table 2 is in cooldown
+	// so it is not moved back, and table 3 is too light to be worth a move.
+	now = now.Add(interval)
+	rep.Stats.ReceivedEventCount = 120000
+	replications.GetV(tablepb.Span{TableID: 3}).Stats.ReceivedEventCount = 180
+	require.Empty(t, sched.schedule(now, captures, replications))
+
+	// A stopping capture pauses balance.
+	captures["a"].State = member.CaptureStateStopping
+	now = now.Add(interval * moveCooldownIntervals)
+	rep.Stats.ReceivedEventCount = 200000
+	require.Empty(t, sched.schedule(now, captures, replications))
+
+	// After the cooldown, the hot table can be moved again, while table 3
+	// is not moved as it would overload capture "a".
+	captures["a"].State = member.CaptureStateInitialized
+	now = now.Add(interval)
+	rep.Stats.ReceivedEventCount = 300000
+	replications.GetV(tablepb.Span{TableID: 3}).Stats.ReceivedEventCount = 300000
+	tasks = sched.schedule(now, captures, replications)
+	require.Len(t, tasks, 1)
+	require.Equal(t, tablepb.Span{TableID: 2}, tasks[0].MoveTable.Span)
+	require.Equal(t, "a", tasks[0].MoveTable.DestCapture)
+}
+
+func TestSchedulerLoadBalanceTaskLimit(t *testing.T) {
+	t.Parallel()
+
+	sched := newLoadBalanceScheduler(0, 2, 0.1, newLoadTracker(time.Minute), model.ChangeFeedID{})
+	captures := map[model.CaptureID]*member.CaptureStatus{"a": {}, "b": {}, "c": {}, "d": {}}
+	replications := spanz.NewBtreeMap[*replication.ReplicationSet]()
+	for i := 1; i <= 8; i++ {
+		replications.ReplaceOrInsert(
+			tablepb.Span{TableID: model.TableID(i)}, newLoadReplicationSet("a", 0))
+	}
+	now := time.Now()
+	require.Empty(t, sched.schedule(now, captures, replications))
+	replications.Ascend(func(_ tablepb.Span, rep *replication.ReplicationSet) bool {
+		rep.Stats.ReceivedEventCount = 6000
+		return true
+	})
+	tasks := sched.schedule(now.Add(time.Minute), captures, replications)
+	require.Len(t, tasks, 2)
+	require.NotEqual(t, tasks[0].MoveTable.Span, tasks[1].MoveTable.Span)
+	require.NotEqual(t, tasks[0].MoveTable.DestCapture, tasks[1].MoveTable.DestCapture)
+}
+
+func TestSchedulerBasicAddTableByLoad(t *testing.T) {
+	t.Parallel()
+
+	tracker := newLoadTracker(time.Minute)
+	sched := newBasicScheduler(2, model.ChangeFeedID{})
+	sched.tracker = tracker
+
+	captures := map[model.CaptureID]*member.CaptureStatus{"a": {}, "b": {}, "c": {}}
+	replications := mapToSpanMap(map[model.TableID]*replication.ReplicationSet{
+		1: newLoadReplicationSet("a", 0),
+		2: newLoadReplicationSet("b", 0),
+	})
+	now := time.Now()
+	tracker.sample(replications, now)
+	replications.GetV(tablepb.Span{TableID: 1}).Stats.ReceivedEventCount = 600
+	replications.GetV(tablepb.Span{TableID: 2}).Stats.ReceivedEventCount = 6000
+	tracker.sample(replications, now.Add(time.Minute))
+
+	currentTables := spanz.ArrayToSpan([]model.TableID{1, 2, 3, 4})
+	tasks := sched.Schedule(0, currentTables, captures, replications)
+	require.Len(t, tasks, 1)
+	addTables := tasks[0].BurstBalance.AddTables
+	require.Len(t, addTables, 2)
+	require.Equal(t, "c", addTables[0].CaptureID)
+	require.Equal(t, "a", addTables[1].CaptureID)
+}
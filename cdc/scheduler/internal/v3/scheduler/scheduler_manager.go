@@ -50,12 +50,22 @@ func NewSchedulerManager(
 		}]int),
 	}
 
-	sm.schedulers[schedulerPriorityBasic] = newBasicScheduler(
-		cfg.AddTableBatchSize, changefeedID)
+	basicScheduler := newBasicScheduler(cfg.AddTableBatchSize, changefeedID)
+	sm.schedulers[schedulerPriorityBasic] = basicScheduler
 	sm.schedulers[schedulerPriorityDrainCapture] = newDrainCaptureScheduler(
 		cfg.MaxTaskConcurrency, changefeedID)
-	sm.schedulers[schedulerPriorityBalance] = newBalanceScheduler(
-		time.Duration(cfg.CheckBalanceInterval), cfg.MaxTaskConcurrency, sm.changefeedID)
+	if cfg.ChangefeedSettings != nil && cfg.ChangefeedSettings.EnableLoadBalance {
+		interval := time.Duration(cfg.CheckBalanceInterval)
+		tracker := newLoadTracker(interval)
+		// New spans are placed on the least loaded captures first.
+		basicScheduler.tracker = tracker
+		sm.schedulers[schedulerPriorityBalance] = newLoadBalanceScheduler(
+			interval, cfg.MaxTaskConcurrency, cfg.ChangefeedSettings.LoadImbalanceThreshold,
+			tracker, changefeedID)
+	} else {
+		sm.schedulers[schedulerPriorityBalance] = newBalanceScheduler(
+			time.Duration(cfg.CheckBalanceInterval), cfg.MaxTaskConcurrency, sm.changefeedID)
+	}
 	sm.schedulers[schedulerPriorityMoveTable] = newMoveTableScheduler(changefeedID)
 	sm.schedulers[schedulerPriorityRebalance] = newRebalanceScheduler(changefeedID)
 
@@ -36,6 +36,15 @@ func TestNewSchedulerManager(t *testing.T) {
 	require.NotNil(t, m.schedulers[schedulerPriorityMoveTable])
 	require.NotNil(t, m.schedulers[schedulerPriorityRebalance])
 	require.NotNil(t, m.schedulers[schedulerPriorityDrainCapture])
+	require.IsType(t, &balanceScheduler{}, m.schedulers[schedulerPriorityBalance])
+
+	cfg := config.NewDefaultSchedulerConfig()
+	cfg.ChangefeedSettings = &config.ChangefeedSchedulerConfig{EnableLoadBalance: true}
+	m = NewSchedulerManager(model.DefaultChangeFeedID("test-changefeed"), cfg)
+	lb, ok := m.schedulers[schedulerPriorityBalance].(*loadBalanceScheduler)
+	require.True(t, ok)
+	require.Equal(t, defaultLoadImbalanceThreshold, lb.threshold)
+	require.Same(t, lb.tracker, m.schedulers[schedulerPriorityBasic].(*basicScheduler).tracker)
 }
 
 func TestSchedulerManagerScheduler(t *testing.T) {
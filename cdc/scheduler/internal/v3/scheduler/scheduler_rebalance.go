@@ -120,8 +120,12 @@ func newBalanceMoveTables(
 	}
 
 	replications.Ascend(func(span tablepb.Span, rep *replication.ReplicationSet) bool {
-		if rep.State == replication.ReplicationSetStateReplicating {
-			tablesPerCapture[rep.Primary].Add(span)
+		if rep.State != replication.ReplicationSetStateReplicating {
+			return true
+		}
+		// The primary may be excluded by placement constraints.
+		if spans, ok := tablesPerCapture[rep.Primary]; ok {
+			spans.Add(span)
 		}
 		return true
 	})
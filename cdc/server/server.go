@@ -40,6 +40,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/p2p"
 	"github.com/pingcap/tiflow/pkg/pdutil"
 	"github.com/pingcap/tiflow/pkg/tcpserver"
+	"github.com/pingcap/tiflow/pkg/tracing"
 	p2pProto "github.com/pingcap/tiflow/proto/p2p"
 	pd "github.com/tikv/pd/client"
 	pdopt "github.com/tikv/pd/client/opt"
@@ -89,6 +90,8 @@ type server struct {
 	pdAPIClient       pdutil.PDAPIClient
 	pdEndpoints       []string
 	sortEngineFactory *factory.SortEngineFactory
+	// shutdownTracing flushes the pending spans and stops tracing.
+	shutdownTracing func(context.Context) error
 }
 
 // New creates a server instance.
@@ -197,6 +200,11 @@ func (s *server) prepare(ctx context.Context) error {
 	s.createSortEngineFactory()
 	s.setMemoryLimit()
 
+	s.shutdownTracing, err = tracing.Init(ctx, conf.Tracing, conf.AdvertiseAddr)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
 	s.capture = capture.NewCapture(s.pdEndpoints, cdcEtcdClient,
 		s.grpcService, s.sortEngineFactory, s.pdClient)
 
@@ -402,6 +410,15 @@ func (s *server) Close() {
 		s.tcpServer = nil
 	}
 
+	if s.shutdownTracing != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		if err := s.shutdownTracing(ctx); err != nil {
+			log.Warn("shutdown tracing failed", zap.Error(err))
+		}
+		cancel()
+		s.shutdownTracing = nil
+	}
+
 	if s.pdClient != nil {
 		s.pdClient.Close()
 	}
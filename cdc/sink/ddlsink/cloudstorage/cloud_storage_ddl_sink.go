@@ -30,6 +30,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/sink"
 	"github.com/pingcap/tiflow/pkg/sink/cloudstorage"
+	"github.com/pingcap/tiflow/pkg/sink/iceberg"
 	"github.com/pingcap/tiflow/pkg/util"
 	"github.com/robfig/cron"
 	"go.uber.org/zap"
@@ -47,6 +48,9 @@ type DDLSink struct {
 	storage    storage.ExternalStorage
 	cfg        *cloudstorage.Config
 	cron       *cron.Cron
+	// catalog is used to apply the DDLs which change the rows or the names of
+	// the Iceberg tables, it is nil if the table format is not Iceberg.
+	catalog *iceberg.Catalog
 
 	lastCheckpointTs         atomic.Uint64
 	lastSendCheckpointTsTime time.Time
@@ -86,6 +90,10 @@ func newDDLSink(ctx context.Context,
 		cfg:                      cfg,
 		lastSendCheckpointTsTime: time.Now(),
 	}
+	if cfg.TableFormat == config.CloudStorageTableFormatIceberg {
+		d.catalog = iceberg.NewCatalog(storage,
+			cfg.IcebergSnapshotRetention, cfg.IcebergMaxMetadataFiles)
+	}
 
 	if err := d.initCron(ctx, sinkURI, cleanupJobs); err != nil {
 		return nil, errors.Trace(err)
@@ -131,7 +139,46 @@ func (d *DDLSink) WriteDDLEvent(ctx context.Context, ddl *model.DDLEvent) error
 		// For exchange partition, we need to write the schema of the source table.
 		var sourceTableDef cloudstorage.TableDefinition
 		sourceTableDef.FromTableInfo(ddl.PreTableInfo, ddl.TableInfo.Version, d.cfg.OutputColumnID)
-		return writeFile(sourceTableDef)
+		if err := writeFile(sourceTableDef); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	if d.catalog != nil {
+		return d.statistics.RecordDDLExecution(func() error {
+			return d.writeIcebergDDL(ctx, ddl)
+		})
+	}
+	return nil
+}
+
+// writeIcebergDDL applies the DDLs which remove the rows or change the names
+// of the tables to the Iceberg tables. The schemas of the Iceberg tables are
+// changed by the next commits, so other DDLs are skipped.
+func (d *DDLSink) writeIcebergDDL(ctx context.Context, ddl *model.DDLEvent) error {
+	table := ddl.TableInfo.TableName
+	switch ddl.Type {
+	case timodel.ActionTruncateTable:
+		// the rows of the new table are written after the DDL, so the
+		// Iceberg table is truncated at the commit-ts of the DDL.
+		return errors.Trace(d.catalog.TruncateTable(ctx, table.Schema, table.Table, ddl.CommitTs))
+	case timodel.ActionDropTable:
+		return errors.Trace(d.catalog.DropTable(ctx, table.Schema, table.Table))
+	case timodel.ActionDropSchema:
+		return errors.Trace(d.catalog.DropSchema(ctx, table.Schema))
+	case timodel.ActionRenameTable, timodel.ActionRenameTables:
+		// RENAME TABLES is split into a DDL event for each table.
+		old := ddl.PreTableInfo.TableName
+		return errors.Trace(d.catalog.RenameTable(ctx,
+			old.Schema, old.Table, table.Schema, table.Table))
+	case timodel.ActionTruncateTablePartition, timodel.ActionDropTablePartition,
+		timodel.ActionExchangeTablePartition, timodel.ActionReorganizePartition:
+		// all partitions of a table are written to the same iceberg table.
+		log.Warn("The partition DDL is not applied to the iceberg tables, "+
+			"the rows of the partitions are kept",
+			zap.String("namespace", d.id.Namespace),
+			zap.String("changefeed", d.id.ID),
+			zap.Uint64("commitTs", ddl.CommitTs),
+			zap.String("query", ddl.Query))
 	}
 	return nil
 }
@@ -15,10 +15,12 @@ package cloudstorage
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"os"
 	"path"
+	"strconv"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -27,8 +29,12 @@ import (
 	pmodel "github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/parser/types"
+	"github.com/pingcap/tiflow/cdc/entry"
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"github.com/pingcap/tiflow/pkg/sink/codec/parquet"
+	"github.com/pingcap/tiflow/pkg/sink/iceberg"
 	"github.com/pingcap/tiflow/pkg/util"
 	"github.com/stretchr/testify/require"
 )
@@ -100,6 +106,90 @@ func TestWriteDDLEvent(t *testing.T) {
 	}`, string(tableSchema))
 }
 
+func TestWriteIcebergDDLEvent(t *testing.T) {
+	helper := entry.NewSchemaTestHelper(t)
+	defer helper.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	parentDir := t.TempDir()
+	uri := fmt.Sprintf("file:///%s?protocol=parquet", parentDir)
+	sinkURI, err := url.Parse(uri)
+	require.Nil(t, err)
+	replicaConfig := config.GetDefaultReplicaConfig()
+	replicaConfig.Sink.CloudStorageConfig = &config.CloudStorageConfig{
+		TableFormat: util.AddressOf(config.CloudStorageTableFormatIceberg),
+	}
+	err = replicaConfig.ValidateAndAdjust(sinkURI)
+	require.Nil(t, err)
+	sink, err := NewDDLSink(ctx, model.DefaultChangeFeedID("test"), sinkURI, replicaConfig)
+	require.Nil(t, err)
+
+	storage, err := util.GetExternalStorageFromURI(ctx, uri)
+	require.Nil(t, err)
+	catalog := iceberg.NewCatalog(storage, time.Hour, 100)
+	commit := func(dml, schema, table string) {
+		event := helper.DML2Event(dml, schema, table)
+		encoder := parquet.NewTxnEventEncoderBuilder(common.NewConfig(config.ProtocolParquet)).Build()
+		err := encoder.AppendTxnEvent(&model.SingleTableTxn{
+			TableInfo: event.TableInfo, Rows: []*model.RowChangedEvent{event},
+		}, nil)
+		require.Nil(t, err)
+		rows, err := parquet.DecodeRows(event.TableInfo, encoder.Build())
+		require.Nil(t, err)
+		_, err = catalog.Table(schema, table).Commit(ctx, event.TableInfo, rows)
+		require.Nil(t, err)
+	}
+	readHint := func(dir string) string {
+		hint, err := os.ReadFile(path.Join(parentDir, dir, "metadata/version-hint.text"))
+		if os.IsNotExist(err) {
+			return ""
+		}
+		require.Nil(t, err)
+		return string(hint)
+	}
+
+	helper.DDL2Event(`create table test.t(id int primary key, name varchar(16))`)
+	commit(`insert into test.t values (1, 'a')`, "test", "t")
+	require.Equal(t, "1", readHint("test/t"))
+
+	// TRUNCATE TABLE commits a snapshot which removes all rows.
+	ddl := helper.DDL2Event(`truncate table test.t`)
+	require.Nil(t, sink.WriteDDLEvent(ctx, ddl))
+	require.Equal(t, "2", readHint("test/t"))
+	content, err := os.ReadFile(path.Join(parentDir, "test/t/metadata/v2.metadata.json"))
+	require.Nil(t, err)
+	meta := &iceberg.TableMetadata{}
+	require.Nil(t, json.Unmarshal(content, meta))
+	require.Len(t, meta.Snapshots, 2)
+	require.Equal(t, "delete", meta.Snapshots[1].Summary["operation"])
+	require.Equal(t, strconv.FormatUint(ddl.CommitTs, 10), meta.Snapshots[1].Summary["tidb-commit-ts"])
+
+	// RENAME TABLE moves the table to the new name.
+	commit(`insert into test.t values (2, 'b')`, "test", "t")
+	ddl = helper.DDL2Event(`rename table test.t to test.t2`)
+	require.Nil(t, sink.WriteDDLEvent(ctx, ddl))
+	require.Equal(t, "", readHint("test/t"))
+	require.Equal(t, "1", readHint("test/t2"))
+
+	// DROP TABLE deletes the files of the table.
+	ddl = helper.DDL2Event(`drop table test.t2`)
+	require.Nil(t, sink.WriteDDLEvent(ctx, ddl))
+	require.Equal(t, "", readHint("test/t2"))
+	dataFiles, err := os.ReadDir(path.Join(parentDir, "test/t/data"))
+	require.Nil(t, err)
+	require.Empty(t, dataFiles)
+
+	// DROP DATABASE drops all tables of the schema.
+	helper.DDL2Event(`create database test2`)
+	helper.DDL2Event(`create table test2.t(id int primary key)`)
+	commit(`insert into test2.t values (1)`, "test2", "t")
+	require.Equal(t, "1", readHint("test2/t"))
+	ddl = helper.DDL2Event(`drop database test2`)
+	require.Nil(t, sink.WriteDDLEvent(ctx, ddl))
+	require.Equal(t, "", readHint("test2/t"))
+}
+
 func TestWriteCheckpointTs(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -0,0 +1,117 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearch
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	timodel "github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/ddlsink"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink/mq/dispatcher/topic"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/sink/elasticsearch"
+	"go.uber.org/zap"
+)
+
+// Assert Sink implementation
+var _ ddlsink.Sink = (*DDLSink)(nil)
+
+// DDLSink is the DDL sink of elasticsearch. The documents are schemaless
+// and the indexes are created on demand by the bulk requests, so most DDL
+// events are only logged and skipped.
+//
+// TRUNCATE TABLE and DROP TABLE delete the index of the table if the index
+// expression contains {table}. Otherwise the index is shared by all tables
+// of the schema and the documents of the table can't be told apart, so they
+// are kept in the index.
+type DDLSink struct {
+	// id indicates which processor (changefeed) this sink belongs to.
+	id     model.ChangeFeedID
+	index  topic.Expression
+	client *elasticsearch.Client
+}
+
+// NewDDLSink creates an elasticsearch DDL sink.
+func NewDDLSink(
+	_ context.Context,
+	changefeedID model.ChangeFeedID,
+	sinkURI *url.URL,
+	replicaConfig *config.ReplicaConfig,
+) (*DDLSink, error) {
+	cfg := elasticsearch.NewConfig()
+	if err := cfg.Apply(sinkURI, replicaConfig); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	log.Info("elasticsearch ddl sink created",
+		zap.String("namespace", changefeedID.Namespace),
+		zap.String("changefeed", changefeedID.ID),
+		zap.String("endpoint", cfg.Endpoint))
+	return &DDLSink{
+		id:     changefeedID,
+		index:  cfg.Index,
+		client: elasticsearch.NewClient(changefeedID, cfg),
+	}, nil
+}
+
+// WriteDDLEvent deletes the index of the table for TRUNCATE TABLE and
+// DROP TABLE, and skips other DDL events.
+func (d *DDLSink) WriteDDLEvent(ctx context.Context, ddl *model.DDLEvent) error {
+	switch ddl.Type {
+	case timodel.ActionTruncateTable, timodel.ActionDropTable:
+		if !strings.Contains(strings.ToLower(string(d.index)), "{table}") {
+			log.Warn("The index is shared by the tables of the schema, "+
+				"the documents of the table are kept in elasticsearch",
+				zap.Uint64("commitTs", ddl.CommitTs),
+				zap.String("query", ddl.Query),
+				zap.String("index", string(d.index)),
+				zap.String("namespace", d.id.Namespace),
+				zap.String("changefeed", d.id.ID))
+			return nil
+		}
+		index := d.index.SubstituteForElasticsearch(
+			ddl.TableInfo.TableName.Schema, ddl.TableInfo.TableName.Table)
+		if err := d.client.DeleteIndex(ctx, index); err != nil {
+			return errors.Trace(err)
+		}
+		log.Info("Delete the index of the table for elasticsearch sink",
+			zap.Uint64("commitTs", ddl.CommitTs),
+			zap.String("query", ddl.Query),
+			zap.String("index", index),
+			zap.String("namespace", d.id.Namespace),
+			zap.String("changefeed", d.id.ID))
+		return nil
+	}
+	log.Info("Skip ddl event for elasticsearch sink",
+		zap.Uint64("commitTs", ddl.CommitTs),
+		zap.String("query", ddl.Query),
+		zap.String("namespace", d.id.Namespace),
+		zap.String("changefeed", d.id.ID))
+	return nil
+}
+
+// WriteCheckpointTs does nothing.
+func (d *DDLSink) WriteCheckpointTs(_ context.Context, _ uint64, _ []*model.TableInfo) error {
+	return nil
+}
+
+// Close closes the client.
+func (d *DDLSink) Close() {
+	d.client.Close()
+}
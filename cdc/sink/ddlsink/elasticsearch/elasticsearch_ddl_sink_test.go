@@ -0,0 +1,105 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearch
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	timodel "github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDDLSink(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sinkURI, err := url.Parse("elasticsearch://127.0.0.1:9200")
+	require.NoError(t, err)
+	replicaConfig := config.GetDefaultReplicaConfig()
+	require.NoError(t, replicaConfig.ValidateAndAdjust(sinkURI))
+
+	s, err := NewDDLSink(ctx, model.DefaultChangeFeedID("test"), sinkURI, replicaConfig)
+	require.NoError(t, err)
+	defer s.Close()
+	require.NoError(t, s.WriteDDLEvent(ctx, &model.DDLEvent{
+		CommitTs: 417318403368288260,
+		Query:    "create table person(id int, name varchar(32), primary key(id))",
+	}))
+	require.NoError(t, s.WriteCheckpointTs(ctx, 417318403368288261, nil))
+
+	sinkURI, err = url.Parse("elasticsearch://127.0.0.1:9200/?index=_{table}")
+	require.NoError(t, err)
+	_, err = NewDDLSink(ctx, model.DefaultChangeFeedID("test"), sinkURI, replicaConfig)
+	require.ErrorContains(t, err, "invalid index expression")
+}
+
+func TestDDLSinkDeleteIndex(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu    sync.Mutex
+		paths []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodDelete, r.Method)
+		mu.Lock()
+		paths = append(paths, r.URL.Path)
+		mu.Unlock()
+		_, _ = w.Write([]byte(`{"acknowledged":true}`))
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	newDDL := func(tp timodel.ActionType, table string) *model.DDLEvent {
+		return &model.DDLEvent{
+			CommitTs: 417318403368288260,
+			Type:     tp,
+			TableInfo: &model.TableInfo{
+				TableName: model.TableName{Schema: "Test", Table: table},
+			},
+		}
+	}
+	newSink := func(index string) *DDLSink {
+		sinkURI, err := url.Parse(server.URL + "?index=" + url.QueryEscape(index))
+		require.NoError(t, err)
+		sinkURI.Scheme = "elasticsearch"
+		replicaConfig := config.GetDefaultReplicaConfig()
+		require.NoError(t, replicaConfig.ValidateAndAdjust(sinkURI))
+		s, err := NewDDLSink(ctx, model.DefaultChangeFeedID("test"), sinkURI, replicaConfig)
+		require.NoError(t, err)
+		return s
+	}
+
+	s := newSink("{schema}_{table}")
+	defer s.Close()
+	require.NoError(t, s.WriteDDLEvent(ctx, newDDL(timodel.ActionTruncateTable, "t1")))
+	require.NoError(t, s.WriteDDLEvent(ctx, newDDL(timodel.ActionDropTable, "t2")))
+	require.NoError(t, s.WriteDDLEvent(ctx, newDDL(timodel.ActionAddColumn, "t3")))
+
+	// The index shared by the tables of the schema is kept.
+	shared := newSink("cdc-{schema}")
+	defer shared.Close()
+	require.NoError(t, shared.WriteDDLEvent(ctx, newDDL(timodel.ActionDropTable, "t4")))
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, []string{"/test_t1", "/test_t2"}, paths)
+}
@@ -21,9 +21,13 @@ import (
 	"github.com/pingcap/tiflow/cdc/sink/ddlsink"
 	"github.com/pingcap/tiflow/cdc/sink/ddlsink/blackhole"
 	"github.com/pingcap/tiflow/cdc/sink/ddlsink/cloudstorage"
+	"github.com/pingcap/tiflow/cdc/sink/ddlsink/elasticsearch"
 	"github.com/pingcap/tiflow/cdc/sink/ddlsink/mq"
 	"github.com/pingcap/tiflow/cdc/sink/ddlsink/mq/ddlproducer"
 	"github.com/pingcap/tiflow/cdc/sink/ddlsink/mysql"
+	"github.com/pingcap/tiflow/cdc/sink/ddlsink/postgres"
+	"github.com/pingcap/tiflow/cdc/sink/ddlsink/redis"
+	"github.com/pingcap/tiflow/cdc/sink/ddlsink/webhook"
 	"github.com/pingcap/tiflow/cdc/sink/dmlsink/mq/manager"
 	"github.com/pingcap/tiflow/pkg/config"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
@@ -58,11 +62,20 @@ func New(
 		return blackhole.NewDDLSink(), nil
 	case sink.MySQLSSLScheme, sink.MySQLScheme, sink.TiDBScheme, sink.TiDBSSLScheme:
 		return mysql.NewDDLSink(ctx, changefeedID, sinkURI, cfg)
+	case sink.PostgresScheme, sink.PostgreSQLScheme:
+		return postgres.NewDDLSink(ctx, changefeedID, sinkURI, cfg)
 	case sink.S3Scheme, sink.FileScheme, sink.GCSScheme, sink.GSScheme, sink.AzblobScheme, sink.AzureScheme, sink.CloudStorageNoopScheme:
 		return cloudstorage.NewDDLSink(ctx, changefeedID, sinkURI, cfg)
 	case sink.PulsarScheme, sink.PulsarSSLScheme, sink.PulsarHTTPScheme, sink.PulsarHTTPSScheme:
 		return mq.NewPulsarDDLSink(ctx, changefeedID, sinkURI, cfg, manager.NewPulsarTopicManager,
 			pulsarConfig.NewCreatorFactory, ddlproducer.NewPulsarProducer)
+	case sink.WebhookHTTPScheme, sink.WebhookHTTPSScheme:
+		return webhook.NewDDLSink(ctx, changefeedID, sinkURI, cfg)
+	case sink.ElasticsearchScheme, sink.ElasticsearchSSLScheme,
+		sink.OpenSearchScheme, sink.OpenSearchSSLScheme:
+		return elasticsearch.NewDDLSink(ctx, changefeedID, sinkURI, cfg)
+	case sink.RedisScheme, sink.RedisSSLScheme:
+		return redis.NewDDLSink(ctx, changefeedID, sinkURI, cfg)
 	default:
 		return nil,
 			cerror.ErrSinkURIInvalid.GenWithStack("the sink scheme (%s) is not supported", scheme)
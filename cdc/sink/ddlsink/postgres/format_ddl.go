@@ -0,0 +1,294 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pingcap/log"
+	timodel "github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/quotes"
+	ppostgres "github.com/pingcap/tiflow/pkg/sink/postgres"
+	"go.uber.org/zap"
+)
+
+// translateDDL translates a TiDB DDL to PostgreSQL statements. The statements
+// are generated from the table structures carried by the DDL event instead of
+// the original query, which is written in the MySQL dialect.
+// Nil is returned if the DDL is not supported, the caller should skip it.
+func translateDDL(ddl *model.DDLEvent) ([]string, error) {
+	switch ddl.Type {
+	case timodel.ActionCreateSchema:
+		return []string{
+			"CREATE SCHEMA IF NOT EXISTS " + quotes.QuotePostgresName(ddl.TableInfo.TableName.Schema),
+		}, nil
+	case timodel.ActionDropSchema:
+		return []string{
+			"DROP SCHEMA IF EXISTS " + quotes.QuotePostgresName(ddl.TableInfo.TableName.Schema) + " CASCADE",
+		}, nil
+	case timodel.ActionCreateTable, timodel.ActionCreateTables:
+		return genCreateTable(ddl.TableInfo)
+	case timodel.ActionDropTable:
+		return []string{"DROP TABLE IF EXISTS " + quoteTable(ddl.TableInfo)}, nil
+	case timodel.ActionTruncateTable:
+		return []string{"TRUNCATE TABLE " + quoteTable(ddl.TableInfo)}, nil
+	}
+
+	// The following DDLs are translated by comparing the table structures
+	// before and after the DDL.
+	if ddl.PreTableInfo == nil {
+		return nil, nil
+	}
+	switch ddl.Type {
+	case timodel.ActionAddColumn:
+		return genAddColumns(ddl.PreTableInfo, ddl.TableInfo)
+	case timodel.ActionDropColumn:
+		return genDropColumns(ddl.PreTableInfo, ddl.TableInfo), nil
+	case timodel.ActionAddIndex, timodel.ActionAddPrimaryKey:
+		return genAddIndexes(ddl.PreTableInfo, ddl.TableInfo), nil
+	case timodel.ActionDropIndex, timodel.ActionDropPrimaryKey:
+		return genDropIndexes(ddl.PreTableInfo, ddl.TableInfo), nil
+	}
+	return nil, nil
+}
+
+func genCreateTable(tableInfo *model.TableInfo) ([]string, error) {
+	var buf strings.Builder
+	buf.WriteString("CREATE TABLE IF NOT EXISTS ")
+	buf.WriteString(quoteTable(tableInfo))
+	buf.WriteString(" (")
+	written := 0
+	for _, col := range tableInfo.Columns {
+		if !isReplicatedColumn(col) {
+			continue
+		}
+		def, err := columnDefinition(col)
+		if err != nil {
+			return nil, err
+		}
+		if written > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(def)
+		if mysql.HasNotNullFlag(col.GetFlag()) {
+			buf.WriteString(" NOT NULL")
+		}
+		written++
+	}
+	if pk := primaryKeyColumns(tableInfo.TableInfo); len(pk) > 0 {
+		buf.WriteString(", PRIMARY KEY (")
+		buf.WriteString(strings.Join(pk, ", "))
+		buf.WriteString(")")
+	}
+	buf.WriteString(")")
+
+	stmts := []string{
+		"CREATE SCHEMA IF NOT EXISTS " + quotes.QuotePostgresName(tableInfo.TableName.Schema),
+		buf.String(),
+	}
+	for _, idx := range tableInfo.Indices {
+		if idx.Primary {
+			continue
+		}
+		if stmt := genCreateIndex(tableInfo, idx); stmt != "" {
+			stmts = append(stmts, stmt)
+		}
+	}
+	return stmts, nil
+}
+
+func genAddColumns(preTableInfo, tableInfo *model.TableInfo) ([]string, error) {
+	var stmts []string
+	for _, col := range tableInfo.Columns {
+		if !isReplicatedColumn(col) || findColumn(preTableInfo, col.ID) != nil {
+			continue
+		}
+		def, err := columnDefinition(col)
+		if err != nil {
+			return nil, err
+		}
+		// The existing rows get the origin default value in TiDB. The column
+		// can only be NOT NULL if the value can be filled in the same way.
+		if defaultValue, ok := defaultLiteral(col); ok {
+			def += " DEFAULT " + defaultValue
+			if mysql.HasNotNullFlag(col.GetFlag()) {
+				def += " NOT NULL"
+			}
+		}
+		stmts = append(stmts, fmt.Sprintf("ALTER TABLE %s ADD COLUMN IF NOT EXISTS %s",
+			quoteTable(tableInfo), def))
+	}
+	return stmts, nil
+}
+
+func genDropColumns(preTableInfo, tableInfo *model.TableInfo) []string {
+	var stmts []string
+	for _, col := range preTableInfo.Columns {
+		if !isReplicatedColumn(col) || findColumn(tableInfo, col.ID) != nil {
+			continue
+		}
+		stmts = append(stmts, fmt.Sprintf("ALTER TABLE %s DROP COLUMN IF EXISTS %s",
+			quoteTable(tableInfo), quotes.QuotePostgresName(col.Name.O)))
+	}
+	return stmts
+}
+
+func genAddIndexes(preTableInfo, tableInfo *model.TableInfo) []string {
+	var stmts []string
+	for _, idx := range tableInfo.Indices {
+		if findIndex(preTableInfo, idx.ID) != nil {
+			continue
+		}
+		if idx.Primary {
+			stmts = append(stmts, fmt.Sprintf("ALTER TABLE %s ADD PRIMARY KEY (%s)",
+				quoteTable(tableInfo), strings.Join(indexColumns(idx), ", ")))
+			continue
+		}
+		if stmt := genCreateIndex(tableInfo, idx); stmt != "" {
+			stmts = append(stmts, stmt)
+		}
+	}
+	return stmts
+}
+
+func genDropIndexes(preTableInfo, tableInfo *model.TableInfo) []string {
+	var stmts []string
+	for _, idx := range preTableInfo.Indices {
+		if findIndex(tableInfo, idx.ID) != nil {
+			continue
+		}
+		if idx.Primary {
+			// The default name of the primary key constraint in PostgreSQL.
+			stmts = append(stmts, fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT IF EXISTS %s",
+				quoteTable(tableInfo), quotes.QuotePostgresName(tableInfo.TableName.Table+"_pkey")))
+			continue
+		}
+		stmts = append(stmts, fmt.Sprintf("DROP INDEX IF EXISTS %s",
+			quotes.QuotePostgresSchema(tableInfo.TableName.Schema, indexName(tableInfo, idx))))
+	}
+	return stmts
+}
+
+// genCreateIndex returns an empty string if the index can not be created in
+// PostgreSQL, like prefix indexes and expression indexes.
+func genCreateIndex(tableInfo *model.TableInfo, idx *timodel.IndexInfo) string {
+	for _, idxCol := range idx.Columns {
+		col := tableInfo.Columns[idxCol.Offset]
+		if idxCol.Length != types.UnspecifiedLength || col.Hidden || col.IsGenerated() {
+			log.Warn("index is not supported by PostgreSQL, skip it",
+				zap.String("table", tableInfo.TableName.String()),
+				zap.String("index", idx.Name.O))
+			return ""
+		}
+	}
+	unique := ""
+	if idx.Unique {
+		unique = "UNIQUE "
+	}
+	return fmt.Sprintf("CREATE %sINDEX IF NOT EXISTS %s ON %s (%s)",
+		unique, quotes.QuotePostgresName(indexName(tableInfo, idx)),
+		quoteTable(tableInfo), strings.Join(indexColumns(idx), ", "))
+}
+
+func columnDefinition(col *timodel.ColumnInfo) (string, error) {
+	tp, err := ppostgres.ColumnType(col)
+	if err != nil {
+		return "", err
+	}
+	return quotes.QuotePostgresName(col.Name.O) + " " + tp, nil
+}
+
+// defaultLiteral returns the origin default value of the column as a string
+// literal, which is casted to the column type by PostgreSQL.
+func defaultLiteral(col *timodel.ColumnInfo) (string, bool) {
+	v := col.GetOriginDefaultValue()
+	if v == nil {
+		return "", false
+	}
+	switch col.GetType() {
+	case mysql.TypeBit, mysql.TypeTiDBVectorFloat32:
+		return "", false
+	}
+	s := fmt.Sprintf("%v", v)
+	if ppostgres.ConvertValue(col, s) == nil {
+		return "", false
+	}
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'", true
+}
+
+func primaryKeyColumns(tableInfo *timodel.TableInfo) []string {
+	if tableInfo.PKIsHandle {
+		if col := tableInfo.GetPkColInfo(); col != nil {
+			return []string{quotes.QuotePostgresName(col.Name.O)}
+		}
+	}
+	for _, idx := range tableInfo.Indices {
+		if idx.Primary {
+			return indexColumns(idx)
+		}
+	}
+	return nil
+}
+
+func indexColumns(idx *timodel.IndexInfo) []string {
+	cols := make([]string, 0, len(idx.Columns))
+	for _, col := range idx.Columns {
+		cols = append(cols, quotes.QuotePostgresName(col.Name.O))
+	}
+	return cols
+}
+
+// indexName returns the name of the index in PostgreSQL. Index names are
+// unique in a PostgreSQL schema, so the table name is used as a prefix.
+func indexName(tableInfo *model.TableInfo, idx *timodel.IndexInfo) string {
+	return tableInfo.TableName.Table + "_" + idx.Name.O
+}
+
+// isReplicatedColumn returns false for the columns which are not written by
+// the PostgreSQL DML sink.
+func isReplicatedColumn(col *timodel.ColumnInfo) bool {
+	return !col.Hidden && !col.IsGenerated()
+}
+
+func findColumn(tableInfo *model.TableInfo, id int64) *timodel.ColumnInfo {
+	if tableInfo == nil {
+		return nil
+	}
+	for _, col := range tableInfo.Columns {
+		if col.ID == id {
+			return col
+		}
+	}
+	return nil
+}
+
+func findIndex(tableInfo *model.TableInfo, id int64) *timodel.IndexInfo {
+	if tableInfo == nil {
+		return nil
+	}
+	for _, idx := range tableInfo.Indices {
+		if idx.ID == id {
+			return idx
+		}
+	}
+	return nil
+}
+
+func quoteTable(tableInfo *model.TableInfo) string {
+	return quotes.QuotePostgresSchema(tableInfo.TableName.Schema, tableInfo.TableName.Table)
+}
@@ -0,0 +1,93 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"testing"
+
+	"github.com/pingcap/tiflow/cdc/entry"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTranslateDDL(t *testing.T) {
+	helper := entry.NewSchemaTestHelper(t)
+	defer helper.Close()
+
+	cases := []struct {
+		ddl      string
+		expected []string
+	}{
+		{
+			ddl:      "create database test2",
+			expected: []string{`CREATE SCHEMA IF NOT EXISTS "test2"`},
+		},
+		{
+			ddl: "create table test.t(id bigint unsigned primary key, name varchar(32) not null, " +
+				"score decimal(10, 2), created datetime(3), c int as (id + 1), " +
+				"unique key uk(name), key idx_score(score), key idx_prefix(name(4)))",
+			expected: []string{
+				`CREATE SCHEMA IF NOT EXISTS "test"`,
+				`CREATE TABLE IF NOT EXISTS "test"."t" ("id" numeric(20) NOT NULL, ` +
+					`"name" varchar(32) NOT NULL, "score" numeric(10,2), "created" timestamp(3), ` +
+					`PRIMARY KEY ("id"))`,
+				`CREATE UNIQUE INDEX IF NOT EXISTS "t_uk" ON "test"."t" ("name")`,
+				`CREATE INDEX IF NOT EXISTS "t_idx_score" ON "test"."t" ("score")`,
+			},
+		},
+		{
+			ddl: "alter table test.t add column age int not null default 18",
+			expected: []string{
+				`ALTER TABLE "test"."t" ADD COLUMN IF NOT EXISTS "age" integer DEFAULT '18' NOT NULL`,
+			},
+		},
+		{
+			ddl:      "alter table test.t add column note text",
+			expected: []string{`ALTER TABLE "test"."t" ADD COLUMN IF NOT EXISTS "note" text`},
+		},
+		{
+			ddl:      "alter table test.t drop column note",
+			expected: []string{`ALTER TABLE "test"."t" DROP COLUMN IF EXISTS "note"`},
+		},
+		{
+			ddl:      "alter table test.t add index idx_age(age)",
+			expected: []string{`CREATE INDEX IF NOT EXISTS "t_idx_age" ON "test"."t" ("age")`},
+		},
+		{
+			ddl:      "alter table test.t drop index idx_age",
+			expected: []string{`DROP INDEX IF EXISTS "test"."t_idx_age"`},
+		},
+		{
+			ddl:      "truncate table test.t",
+			expected: []string{`TRUNCATE TABLE "test"."t"`},
+		},
+		{
+			ddl:      "alter table test.t rename to test.t1",
+			expected: nil,
+		},
+		{
+			ddl:      "drop table test.t1",
+			expected: []string{`DROP TABLE IF EXISTS "test"."t1"`},
+		},
+		{
+			ddl:      "drop database test2",
+			expected: []string{`DROP SCHEMA IF EXISTS "test2" CASCADE`},
+		},
+	}
+	for _, c := range cases {
+		ddl := helper.DDL2Event(c.ddl)
+		stmts, err := translateDDL(ddl)
+		require.NoError(t, err, c.ddl)
+		require.Equal(t, c.expected, stmts, c.ddl)
+	}
+}
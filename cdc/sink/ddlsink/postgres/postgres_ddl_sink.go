@@ -0,0 +1,188 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/ddlsink"
+	"github.com/pingcap/tiflow/cdc/sink/metrics"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/retry"
+	"github.com/pingcap/tiflow/pkg/sink"
+	ppostgres "github.com/pingcap/tiflow/pkg/sink/postgres"
+	"go.uber.org/zap"
+)
+
+const defaultDDLMaxRetry uint64 = 20
+
+// GetDBConnImpl is the implementation of ppostgres.ConnectionFactory.
+// Exported for testing.
+var GetDBConnImpl ppostgres.ConnectionFactory = ppostgres.CreatePostgresDBConn
+
+// Assert Sink implementation
+var _ ddlsink.Sink = (*DDLSink)(nil)
+
+// DDLSink is a sink that translates DDL events to PostgreSQL and executes them.
+type DDLSink struct {
+	// id indicates which processor (changefeed) this sink belongs to.
+	id model.ChangeFeedID
+	// db is the database connection.
+	db *sql.DB
+	// statistics is the statistics of this sink.
+	// We use it to record the DDL count.
+	statistics *metrics.Statistics
+}
+
+// NewDDLSink creates a new DDLSink.
+func NewDDLSink(
+	ctx context.Context,
+	changefeedID model.ChangeFeedID,
+	sinkURI *url.URL,
+	replicaConfig *config.ReplicaConfig,
+) (*DDLSink, error) {
+	cfg := ppostgres.NewConfig()
+	if err := cfg.Apply(sinkURI, replicaConfig); err != nil {
+		return nil, err
+	}
+
+	db, err := GetDBConnImpl(ctx, cfg.DSN)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &DDLSink{
+		id:         changefeedID,
+		db:         db,
+		statistics: metrics.NewStatistics(changefeedID, sink.TxnSink),
+	}
+
+	log.Info("PostgreSQL DDL sink is created",
+		zap.String("namespace", m.id.Namespace),
+		zap.String("changefeed", m.id.ID))
+	return m, nil
+}
+
+// WriteDDLEvent translates the DDL event and executes it in PostgreSQL.
+// The DDLs which can not be translated are skipped.
+func (m *DDLSink) WriteDDLEvent(ctx context.Context, ddl *model.DDLEvent) error {
+	stmts, err := translateDDL(ddl)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(stmts) == 0 {
+		log.Warn("DDL is not supported by PostgreSQL sink, skip it",
+			zap.String("namespace", m.id.Namespace),
+			zap.String("changefeed", m.id.ID),
+			zap.Uint64("commitTs", ddl.CommitTs),
+			zap.Stringer("type", ddl.Type),
+			zap.String("query", ddl.Query))
+		return nil
+	}
+
+	return retry.Do(ctx, func() error {
+		err := m.statistics.RecordDDLExecution(func() error { return m.execDDL(ctx, ddl, stmts) })
+		if err != nil {
+			log.Warn("Execute DDL with error, retry later",
+				zap.Uint64("startTs", ddl.StartTs), zap.String("ddl", ddl.Query),
+				zap.Strings("statements", stmts),
+				zap.String("namespace", m.id.Namespace),
+				zap.String("changefeed", m.id.ID),
+				zap.Error(err))
+			return err
+		}
+		return nil
+	}, retry.WithBackoffBaseDelay(ppostgres.BackoffBaseDelay.Milliseconds()),
+		retry.WithBackoffMaxDelay(ppostgres.BackoffMaxDelay.Milliseconds()),
+		retry.WithMaxTries(defaultDDLMaxRetry),
+		retry.WithIsRetryableErr(isRetryableDDLError))
+}
+
+// execDDL executes the statements in one transaction, PostgreSQL supports
+// transactional DDL, so a DDL is either fully applied or not applied.
+func (m *DDLSink) execDDL(ctx context.Context, ddl *model.DDLEvent, stmts []string) error {
+	start := time.Now()
+	log.Info("Start exec DDL", zap.String("namespace", m.id.Namespace), zap.String("changefeed", m.id.ID),
+		zap.Uint64("commitTs", ddl.CommitTs), zap.String("DDL", ddl.Query), zap.Strings("statements", stmts))
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.WrapError(errors.ErrPostgresTxnError, err)
+	}
+
+	for _, stmt := range stmts {
+		if _, err = tx.ExecContext(ctx, stmt); err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				log.Error("Failed to rollback",
+					zap.String("namespace", m.id.Namespace),
+					zap.String("changefeed", m.id.ID),
+					zap.String("sql", stmt),
+					zap.Error(err))
+			}
+			return errors.WrapError(errors.ErrPostgresTxnError,
+				errors.WithMessage(err, fmt.Sprintf("Query info: %s; ", stmt)))
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.WrapError(errors.ErrPostgresTxnError,
+			errors.WithMessage(err, fmt.Sprintf("Query info: %s; ", ddl.Query)))
+	}
+
+	log.Info("Exec DDL succeeded",
+		zap.String("namespace", m.id.Namespace), zap.String("changefeed", m.id.ID),
+		zap.Duration("duration", time.Since(start)), zap.Strings("statements", stmts))
+	return nil
+}
+
+// WriteCheckpointTs does nothing.
+func (m *DDLSink) WriteCheckpointTs(_ context.Context, _ uint64, _ []*model.TableInfo) error {
+	// Only for RowSink for now.
+	return nil
+}
+
+// Close closes the database connection.
+func (m *DDLSink) Close() {
+	if m.statistics != nil {
+		m.statistics.Close()
+	}
+	if m.db != nil {
+		if err := m.db.Close(); err != nil {
+			log.Warn("PostgreSQL ddl sink close db with error",
+				zap.String("namespace", m.id.Namespace),
+				zap.String("changefeed", m.id.ID),
+				zap.Error(err))
+		}
+	}
+}
+
+// isRetryableDDLError returns false for syntax errors and access rule
+// violations, retrying can not fix them.
+func isRetryableDDLError(err error) bool {
+	if !errors.IsRetryableError(err) {
+		return false
+	}
+	pqErr, ok := errors.Cause(err).(*pq.Error)
+	if !ok {
+		return true
+	}
+	return pqErr.Code.Class() != "42"
+}
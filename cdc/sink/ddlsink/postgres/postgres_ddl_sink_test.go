@@ -0,0 +1,88 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"net/url"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	timodel "github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteDDLEvent(t *testing.T) {
+	dbIndex := 0
+	backupGetDBConn := GetDBConnImpl
+	GetDBConnImpl = func(ctx context.Context, dsnStr string) (*sql.DB, error) {
+		defer func() { dbIndex++ }()
+		require.Equal(t, 0, dbIndex)
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		require.NoError(t, err)
+		mock.ExpectBegin()
+		mock.ExpectExec(`CREATE SCHEMA IF NOT EXISTS "test"`).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+		mock.ExpectBegin()
+		mock.ExpectExec(`DROP SCHEMA IF EXISTS "test" CASCADE`).
+			WillReturnError(&pq.Error{Code: "42501", Message: "permission denied"})
+		mock.ExpectRollback()
+		mock.ExpectClose()
+		return db, nil
+	}
+	defer func() { GetDBConnImpl = backupGetDBConn }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sinkURI, err := url.Parse("postgres://127.0.0.1:5432/db")
+	require.NoError(t, err)
+	sink, err := NewDDLSink(ctx, model.DefaultChangeFeedID("test"), sinkURI, config.GetDefaultReplicaConfig())
+	require.NoError(t, err)
+	defer sink.Close()
+
+	tableInfo := &model.TableInfo{TableName: model.TableName{Schema: "test"}}
+	err = sink.WriteDDLEvent(ctx, &model.DDLEvent{
+		CommitTs:  1,
+		Type:      timodel.ActionCreateSchema,
+		Query:     "CREATE DATABASE test",
+		TableInfo: tableInfo,
+	})
+	require.NoError(t, err)
+
+	// unsupported DDLs are skipped.
+	err = sink.WriteDDLEvent(ctx, &model.DDLEvent{
+		CommitTs:  2,
+		Type:      timodel.ActionRenameTable,
+		Query:     "RENAME TABLE test.t TO test.t1",
+		TableInfo: tableInfo,
+	})
+	require.NoError(t, err)
+
+	// access rule violations are not retried.
+	err = sink.WriteDDLEvent(ctx, &model.DDLEvent{
+		CommitTs:  3,
+		Type:      timodel.ActionDropSchema,
+		Query:     "DROP DATABASE test",
+		TableInfo: tableInfo,
+	})
+	require.ErrorContains(t, err, "permission denied")
+	_, ok := errors.Cause(err).(*pq.Error)
+	require.True(t, ok)
+}
@@ -0,0 +1,168 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	timodel "github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/ddlsink"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink/redis"
+	goredis "github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// scanCount is the number of keys scanned and deleted in one round
+// when the keys of a table are invalidated.
+const scanCount = 1000
+
+// Assert Sink implementation
+var _ ddlsink.Sink = (*DDLSink)(nil)
+
+// DDLSink is the DDL sink of redis. The keys are only derived from the
+// rows, so most DDL events are only logged and skipped.
+//
+// TRUNCATE TABLE and DROP TABLE delete the keys of the table if the key
+// template of its rule contains {table}. Otherwise the keys of the table
+// can't be told apart from the keys of other tables, so they are kept.
+type DDLSink struct {
+	// id indicates which processor (changefeed) this sink belongs to.
+	id     model.ChangeFeedID
+	cfg    *redis.Config
+	client *goredis.Client
+}
+
+// NewDDLSink creates a redis DDL sink.
+func NewDDLSink(
+	_ context.Context,
+	changefeedID model.ChangeFeedID,
+	sinkURI *url.URL,
+	replicaConfig *config.ReplicaConfig,
+) (*DDLSink, error) {
+	cfg := redis.NewConfig()
+	if err := cfg.Apply(sinkURI, replicaConfig); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	log.Info("redis ddl sink created",
+		zap.String("namespace", changefeedID.Namespace),
+		zap.String("changefeed", changefeedID.ID),
+		zap.String("addr", cfg.Addr))
+	return &DDLSink{
+		id:     changefeedID,
+		cfg:    cfg,
+		client: goredis.NewClient(cfg.ClientOptions()),
+	}, nil
+}
+
+// WriteDDLEvent deletes the keys of the table for TRUNCATE TABLE and
+// DROP TABLE, and skips other DDL events.
+func (d *DDLSink) WriteDDLEvent(ctx context.Context, ddl *model.DDLEvent) error {
+	switch ddl.Type {
+	case timodel.ActionTruncateTable, timodel.ActionDropTable:
+		return d.invalidateTable(ctx, ddl)
+	}
+	log.Info("Skip ddl event for redis sink",
+		zap.Uint64("commitTs", ddl.CommitTs),
+		zap.String("query", ddl.Query),
+		zap.String("namespace", d.id.Namespace),
+		zap.String("changefeed", d.id.ID))
+	return nil
+}
+
+// invalidateTable deletes the keys of all rows of the table.
+func (d *DDLSink) invalidateTable(ctx context.Context, ddl *model.DDLEvent) error {
+	schema, table := ddl.TableInfo.TableName.Schema, ddl.TableInfo.TableName.Table
+	rule := d.cfg.MatchRule(schema, table)
+	if rule == nil {
+		return nil
+	}
+	pattern, ok := rule.Key.Pattern(schema, table)
+	if !ok {
+		log.Warn("The key template doesn't contain {table}, "+
+			"the keys of the table are kept in redis",
+			zap.Uint64("commitTs", ddl.CommitTs),
+			zap.String("query", ddl.Query),
+			zap.String("key", rule.Key.String()),
+			zap.String("namespace", d.id.Namespace),
+			zap.String("changefeed", d.id.ID))
+		return nil
+	}
+
+	var (
+		keys    []string
+		deleted int
+	)
+	del := func() error {
+		if len(keys) == 0 {
+			return nil
+		}
+		if err := d.client.Del(ctx, keys...).Err(); err != nil {
+			return cerror.WrapError(cerror.ErrRedisWrite, err)
+		}
+		deleted += len(keys)
+		keys = keys[:0]
+		return nil
+	}
+	// Scan again until no key is found, since the servers compatible with
+	// redis may skip some keys if the keys are deleted during the scan.
+	for found := true; found; {
+		found = false
+		iter := d.client.Scan(ctx, 0, pattern, scanCount).Iterator()
+		for iter.Next(ctx) {
+			found = true
+			keys = append(keys, iter.Val())
+			if len(keys) >= scanCount {
+				if err := del(); err != nil {
+					return errors.Trace(err)
+				}
+			}
+		}
+		if err := iter.Err(); err != nil {
+			return cerror.WrapError(cerror.ErrRedisWrite, err)
+		}
+		if err := del(); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	log.Info("Delete the keys of the table for redis sink",
+		zap.Uint64("commitTs", ddl.CommitTs),
+		zap.String("query", ddl.Query),
+		zap.String("pattern", pattern),
+		zap.Int("deleted", deleted),
+		zap.String("namespace", d.id.Namespace),
+		zap.String("changefeed", d.id.ID))
+	return nil
+}
+
+// WriteCheckpointTs does nothing.
+func (d *DDLSink) WriteCheckpointTs(_ context.Context, _ uint64, _ []*model.TableInfo) error {
+	return nil
+}
+
+// Close closes the client.
+func (d *DDLSink) Close() {
+	if err := d.client.Close(); err != nil {
+		log.Warn("close redis client failed",
+			zap.String("namespace", d.id.Namespace),
+			zap.String("changefeed", d.id.ID),
+			zap.Error(err))
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	timodel "github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDDLSink(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sinkURI, err := url.Parse("redis://127.0.0.1:6379")
+	require.NoError(t, err)
+	replicaConfig := config.GetDefaultReplicaConfig()
+	require.NoError(t, replicaConfig.ValidateAndAdjust(sinkURI))
+	_, err = NewDDLSink(ctx, model.DefaultChangeFeedID("test"), sinkURI, replicaConfig)
+	require.ErrorContains(t, err, "no rule is configured")
+
+	replicaConfig.Sink.RedisConfig = &config.RedisConfig{
+		Rules: []*config.RedisRule{{Matcher: []string{"*.*"}}},
+	}
+	s, err := NewDDLSink(ctx, model.DefaultChangeFeedID("test"), sinkURI, replicaConfig)
+	require.NoError(t, err)
+	defer s.Close()
+	require.NoError(t, s.WriteDDLEvent(ctx, &model.DDLEvent{
+		CommitTs: 417318403368288260,
+		Query:    "create table person(id int, name varchar(32), primary key(id))",
+	}))
+	require.NoError(t, s.WriteCheckpointTs(ctx, 417318403368288261, nil))
+}
+
+func TestDDLSinkInvalidateTable(t *testing.T) {
+	t.Parallel()
+
+	server := miniredis.RunT(t)
+	ctx := context.Background()
+	sinkURI, err := url.Parse("redis://" + server.Addr() + "/0")
+	require.NoError(t, err)
+	replicaConfig := config.GetDefaultReplicaConfig()
+	replicaConfig.Sink.RedisConfig = &config.RedisConfig{
+		Rules: []*config.RedisRule{
+			{Matcher: []string{"test.user"}, Mode: config.RedisModeMaterialize, Key: "user:{id}"},
+			{Matcher: []string{"test.*"}, Mode: config.RedisModeInvalidate},
+		},
+	}
+	require.NoError(t, replicaConfig.ValidateAndAdjust(sinkURI))
+	s, err := NewDDLSink(ctx, model.DefaultChangeFeedID("test"), sinkURI, replicaConfig)
+	require.NoError(t, err)
+	defer s.Close()
+
+	for i := 0; i < 2*scanCount+1; i++ {
+		require.NoError(t, server.Set("test:t1:"+strconv.Itoa(i), "cached"))
+	}
+	for _, key := range []string{"test:t2:1", "test:t3:1", "test:t10:1", "user:1"} {
+		require.NoError(t, server.Set(key, "cached"))
+	}
+	newDDL := func(tp timodel.ActionType, table string) *model.DDLEvent {
+		return &model.DDLEvent{
+			CommitTs: 417318403368288260,
+			Type:     tp,
+			TableInfo: &model.TableInfo{
+				TableName: model.TableName{Schema: "test", Table: table},
+			},
+		}
+	}
+	require.NoError(t, s.WriteDDLEvent(ctx, newDDL(timodel.ActionTruncateTable, "t1")))
+	require.NoError(t, s.WriteDDLEvent(ctx, newDDL(timodel.ActionDropTable, "t2")))
+	require.NoError(t, s.WriteDDLEvent(ctx, newDDL(timodel.ActionAddColumn, "t3")))
+	// The key template of test.user doesn't contain {table}, the keys are kept.
+	require.NoError(t, s.WriteDDLEvent(ctx, newDDL(timodel.ActionDropTable, "user")))
+	require.ElementsMatch(t, []string{"test:t3:1", "test:t10:1", "user:1"}, server.Keys())
+}
@@ -0,0 +1,158 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/ddlsink"
+	"github.com/pingcap/tiflow/cdc/sink/metrics"
+	"github.com/pingcap/tiflow/cdc/sink/util"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink"
+	"github.com/pingcap/tiflow/pkg/sink/codec"
+	"github.com/pingcap/tiflow/pkg/sink/codec/builder"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"github.com/pingcap/tiflow/pkg/sink/webhook"
+	tiflowutil "github.com/pingcap/tiflow/pkg/util"
+	"go.uber.org/zap"
+)
+
+// Assert Sink implementation
+var _ ddlsink.Sink = (*DDLSink)(nil)
+
+// DDLSink is a sink that posts DDL events to a http endpoint.
+type DDLSink struct {
+	// id indicates which processor (changefeed) this sink belongs to.
+	id model.ChangeFeedID
+	// protocol indicates the protocol used by this sink.
+	protocol config.Protocol
+	encoder  codec.RowEventEncoder
+	client   *webhook.Client
+	// statistics is used to record DDL metrics.
+	statistics *metrics.Statistics
+}
+
+// NewDDLSink creates a webhook DDL sink.
+func NewDDLSink(
+	ctx context.Context,
+	changefeedID model.ChangeFeedID,
+	sinkURI *url.URL,
+	replicaConfig *config.ReplicaConfig,
+) (*DDLSink, error) {
+	cfg := webhook.NewConfig()
+	if err := cfg.Apply(sinkURI, replicaConfig); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	protocol, err := util.GetProtocol(tiflowutil.GetOrZero(replicaConfig.Sink.Protocol))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if !util.IsWebhookSupportedProtocols(protocol) {
+		return nil, cerror.ErrSinkURIInvalid.
+			GenWithStackByArgs("unsupported protocol, " +
+				"webhook sink currently only support these protocols: [canal-json, debezium, simple]")
+	}
+
+	encoderConfig, err := util.GetEncoderConfig(changefeedID, sinkURI, protocol,
+		replicaConfig, config.DefaultMaxMessageBytes)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if encoderConfig.EncodingFormat != common.EncodingFormatJSON {
+		return nil, cerror.ErrSinkURIInvalid.
+			GenWithStackByArgs("webhook sink only support the json encoding format")
+	}
+	encoderBuilder, err := builder.NewRowEventEncoderBuilder(ctx, encoderConfig)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrWebhookInvalidConfig, err)
+	}
+
+	log.Info("webhook ddl sink created",
+		zap.String("namespace", changefeedID.Namespace),
+		zap.String("changefeed", changefeedID.ID),
+		zap.String("protocol", protocol.String()))
+	return &DDLSink{
+		id:         changefeedID,
+		protocol:   protocol,
+		encoder:    encoderBuilder.Build(),
+		client:     webhook.NewClient(changefeedID, cfg),
+		statistics: metrics.NewStatistics(changefeedID, sink.TxnSink),
+	}, nil
+}
+
+// WriteDDLEvent encodes the DDL event and posts it to the endpoint.
+func (d *DDLSink) WriteDDLEvent(ctx context.Context, ddl *model.DDLEvent) error {
+	msg, err := d.encoder.EncodeDDLEvent(ddl)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if msg == nil {
+		log.Info("Skip ddl event", zap.Uint64("commitTs", ddl.CommitTs),
+			zap.String("query", ddl.Query),
+			zap.String("protocol", d.protocol.String()),
+			zap.String("namespace", d.id.Namespace),
+			zap.String("changefeed", d.id.ID))
+		return nil
+	}
+
+	req := &webhook.Request{
+		EventType: webhook.EventTypeDDL,
+		CommitTs:  ddl.CommitTs,
+		Messages:  []*common.Message{msg},
+	}
+	if ddl.TableInfo != nil {
+		req.Schema = ddl.TableInfo.TableName.Schema
+		req.Table = ddl.TableInfo.TableName.Table
+	}
+	log.Debug("Emit ddl event",
+		zap.Uint64("commitTs", ddl.CommitTs),
+		zap.String("query", ddl.Query),
+		zap.String("namespace", d.id.Namespace),
+		zap.String("changefeed", d.id.ID))
+	return d.statistics.RecordDDLExecution(func() error {
+		return d.client.Send(ctx, req)
+	})
+}
+
+// WriteCheckpointTs posts the checkpoint ts to the endpoint if the
+// protocol encodes checkpoint events.
+func (d *DDLSink) WriteCheckpointTs(ctx context.Context,
+	ts uint64, _ []*model.TableInfo,
+) error {
+	msg, err := d.encoder.EncodeCheckpointEvent(ts)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if msg == nil {
+		return nil
+	}
+	return d.client.Send(ctx, &webhook.Request{
+		EventType: webhook.EventTypeCheckpoint,
+		CommitTs:  ts,
+		Messages:  []*common.Message{msg},
+	})
+}
+
+// Close closes the sink.
+func (d *DDLSink) Close() {
+	d.client.Close()
+	d.statistics.Close()
+}
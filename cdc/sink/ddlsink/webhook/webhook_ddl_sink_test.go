@@ -0,0 +1,74 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteDDLEvent(t *testing.T) {
+	t.Parallel()
+
+	var (
+		body      string
+		eventType string
+		commitTs  string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		body = string(data)
+		eventType = r.Header.Get("X-Ticdc-Event-Type")
+		commitTs = r.Header.Get("X-Ticdc-Commit-Ts")
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sinkURI, err := url.Parse(server.URL + "/ddl?protocol=canal-json")
+	require.NoError(t, err)
+	replicaConfig := config.GetDefaultReplicaConfig()
+	require.NoError(t, replicaConfig.ValidateAndAdjust(sinkURI))
+
+	s, err := NewDDLSink(ctx, model.DefaultChangeFeedID("test"), sinkURI, replicaConfig)
+	require.NoError(t, err)
+	defer s.Close()
+
+	ddl := &model.DDLEvent{
+		CommitTs: 417318403368288260,
+		TableInfo: &model.TableInfo{
+			TableName: model.TableName{Schema: "cdc", Table: "person"},
+		},
+		Query: "create table person(id int, name varchar(32), primary key(id))",
+		Type:  1,
+	}
+	require.NoError(t, s.WriteDDLEvent(ctx, ddl))
+	require.Equal(t, "ddl", eventType)
+	require.Equal(t, "417318403368288260", commitTs)
+	require.Contains(t, body, "create table person")
+
+	// canal-json does not encode checkpoint events without tidb extension.
+	body = ""
+	require.NoError(t, s.WriteCheckpointTs(ctx, 417318403368288261, nil))
+	require.Empty(t, body)
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/sink/cloudstorage"
 	"github.com/pingcap/tiflow/pkg/sink/codec/builder"
 	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"github.com/pingcap/tiflow/pkg/sink/iceberg"
 	putil "github.com/pingcap/tiflow/pkg/util"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
@@ -165,11 +166,16 @@ func NewDMLSink(ctx context.Context,
 		s.encodingWorkers[i] = newEncodingWorker(i, s.changefeedID, encoder, s.alive.msgCh.Out(), encodedOutCh)
 	}
 
+	var catalog *iceberg.Catalog
+	if cfg.TableFormat == config.CloudStorageTableFormatIceberg {
+		catalog = iceberg.NewCatalog(storage,
+			cfg.IcebergSnapshotRetention, cfg.IcebergMaxMetadataFiles)
+	}
 	// create a group of dml workers.
 	for i := 0; i < cfg.WorkerCount; i++ {
 		inputCh := chann.NewAutoDrainChann[eventFragment]()
 		s.workers[i] = newDMLWorker(i, s.changefeedID, storage, cfg, ext,
-			inputCh, pdClock, s.statistics)
+			builder.NewFileEncoder(encoderConfig), catalog, inputCh, pdClock, s.statistics)
 		workerChannels[i] = inputCh
 	}
 
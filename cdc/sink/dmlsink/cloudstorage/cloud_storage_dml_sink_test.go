@@ -14,6 +14,7 @@ package cloudstorage
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"os"
@@ -33,6 +34,7 @@ import (
 	"github.com/pingcap/tiflow/engine/pkg/clock"
 	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/pingcap/tiflow/pkg/pdutil"
+	"github.com/pingcap/tiflow/pkg/sink/iceberg"
 	"github.com/pingcap/tiflow/pkg/util"
 	"github.com/stretchr/testify/require"
 )
@@ -187,6 +189,120 @@ func TestCloudStorageWriteEventsWithoutDateSeparator(t *testing.T) {
 	s.Close()
 }
 
+func TestCloudStorageWriteEventsWithParquet(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	parentDir := t.TempDir()
+	uri := fmt.Sprintf("file:///%s?flush-interval=2s", parentDir)
+	sinkURI, err := url.Parse(uri)
+	require.Nil(t, err)
+
+	replicaConfig := config.GetDefaultReplicaConfig()
+	replicaConfig.Sink.DateSeparator = util.AddressOf(config.DateSeparatorNone.String())
+	replicaConfig.Sink.Protocol = util.AddressOf(config.ProtocolParquet.String())
+	replicaConfig.Sink.FileIndexWidth = util.AddressOf(6)
+	errCh := make(chan error, 5)
+	s, err := NewDMLSink(ctx,
+		model.DefaultChangeFeedID("test"),
+		pdutil.NewMonotonicClock(clock.New()),
+		sinkURI, replicaConfig, errCh)
+	require.Nil(t, err)
+	var cnt uint64 = 0
+	batch := 100
+	tableStatus := state.TableSinkSinking
+
+	// all transactions are written to one parquet file.
+	txns := generateTxnEvents(&cnt, batch, &tableStatus)
+	for _, txn := range txns {
+		txn.Event.TableInfo = txn.Event.Rows[0].TableInfo
+	}
+	err = s.WriteEvents(txns...)
+	require.Nil(t, err)
+	time.Sleep(3 * time.Second)
+
+	tableDir := path.Join(parentDir, "test/table1/33")
+	fileNames := getTableFiles(t, tableDir)
+	require.ElementsMatch(t, []string{"CDC000001.parquet", "CDC.index"}, fileNames)
+	content, err := os.ReadFile(path.Join(tableDir, "CDC000001.parquet"))
+	require.Nil(t, err)
+	require.Equal(t, "PAR1", string(content[:4]))
+	require.Equal(t, "PAR1", string(content[len(content)-4:]))
+	require.Equal(t, uint64(1000), atomic.LoadUint64(&cnt))
+	require.Len(t, errCh, 0)
+
+	cancel()
+	s.Close()
+}
+
+func TestCloudStorageWriteEventsWithIceberg(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	parentDir := t.TempDir()
+	uri := fmt.Sprintf("file:///%s?flush-interval=2s&protocol=parquet", parentDir)
+	sinkURI, err := url.Parse(uri)
+	require.Nil(t, err)
+
+	replicaConfig := config.GetDefaultReplicaConfig()
+	replicaConfig.Sink.CloudStorageConfig = &config.CloudStorageConfig{
+		TableFormat: util.AddressOf(config.CloudStorageTableFormatIceberg),
+	}
+	require.NoError(t, replicaConfig.ValidateAndAdjust(sinkURI))
+	errCh := make(chan error, 5)
+	s, err := NewDMLSink(ctx,
+		model.DefaultChangeFeedID("test"),
+		pdutil.NewMonotonicClock(clock.New()),
+		sinkURI, replicaConfig, errCh)
+	require.Nil(t, err)
+	var cnt uint64 = 0
+	batch := 100
+	tableStatus := state.TableSinkSinking
+
+	// rows are identified by the primary key in Iceberg tables.
+	pkType := types.NewFieldType(mysql.TypeLong)
+	pkType.AddFlag(mysql.PriKeyFlag | mysql.NotNullFlag)
+	tableInfo := model.WrapTableInfo(100, "test", 33, &timodel.TableInfo{
+		Name:       pmodel.NewCIStr("table1"),
+		PKIsHandle: true,
+		Columns: []*timodel.ColumnInfo{
+			{ID: 1, Name: pmodel.NewCIStr("c1"), FieldType: *pkType},
+			{ID: 2, Name: pmodel.NewCIStr("c2"), FieldType: *types.NewFieldType(mysql.TypeVarchar)},
+		},
+	})
+	txns := generateTxnEvents(&cnt, batch, &tableStatus)
+	for _, txn := range txns {
+		txn.Event.TableInfo = tableInfo
+		for _, row := range txn.Event.Rows {
+			row.TableInfo = tableInfo
+		}
+	}
+	err = s.WriteEvents(txns...)
+	require.Nil(t, err)
+
+	tableDir := path.Join(parentDir, "test/table1")
+	require.Eventually(t, func() bool {
+		return atomic.LoadUint64(&cnt) == 1000
+	}, 10*time.Second, 100*time.Millisecond)
+	require.Len(t, errCh, 0)
+	hint, err := os.ReadFile(path.Join(tableDir, "metadata/version-hint.text"))
+	require.Nil(t, err)
+	require.Equal(t, "1", string(hint))
+	content, err := os.ReadFile(path.Join(tableDir, "metadata/v1.metadata.json"))
+	require.Nil(t, err)
+	meta := &iceberg.TableMetadata{}
+	require.NoError(t, json.Unmarshal(content, meta))
+	require.Len(t, meta.Snapshots, 1)
+	require.Equal(t, "1000", meta.Snapshots[0].Summary["added-records"])
+	require.Equal(t, "100", meta.Snapshots[0].Summary["tidb-commit-ts"])
+	dataFiles, err := os.ReadDir(path.Join(tableDir, "data"))
+	require.Nil(t, err)
+	require.Len(t, dataFiles, 1)
+
+	cancel()
+	s.Close()
+}
+
 func TestCloudStorageWriteEventsWithDateSeparator(t *testing.T) {
 	t.Parallel()
 
@@ -16,6 +16,7 @@ import (
 	"bytes"
 	"context"
 	"path"
+	"sort"
 	"strconv"
 	"sync/atomic"
 	"time"
@@ -30,7 +31,10 @@ import (
 	"github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/pdutil"
 	"github.com/pingcap/tiflow/pkg/sink/cloudstorage"
+	"github.com/pingcap/tiflow/pkg/sink/codec"
 	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"github.com/pingcap/tiflow/pkg/sink/codec/parquet"
+	"github.com/pingcap/tiflow/pkg/sink/iceberg"
 	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
@@ -43,6 +47,12 @@ type dmlWorker struct {
 	changeFeedID model.ChangeFeedID
 	storage      storage.ExternalStorage
 	config       *cloudstorage.Config
+	// fileEncoder is used to assemble messages into a data file for columnar
+	// protocols, it is nil if the messages can be written to the file directly.
+	fileEncoder codec.FileEncoder
+	// catalog is used to commit the messages to Iceberg tables, it is nil
+	// if the data files are not organized as tables.
+	catalog *iceberg.Catalog
 	// toBeFlushedCh contains a set of batchedTask waiting to be flushed to cloud storage.
 	toBeFlushedCh          chan batchedTask
 	inputCh                *chann.DrainableChann[eventFragment]
@@ -109,6 +119,8 @@ func newDMLWorker(
 	storage storage.ExternalStorage,
 	config *cloudstorage.Config,
 	extension string,
+	fileEncoder codec.FileEncoder,
+	catalog *iceberg.Catalog,
 	inputCh *chann.DrainableChann[eventFragment],
 	pdClock pdutil.Clock,
 	statistics *metrics.Statistics,
@@ -118,6 +130,8 @@ func newDMLWorker(
 		changeFeedID:      changefeedID,
 		storage:           storage,
 		config:            config,
+		fileEncoder:       fileEncoder,
+		catalog:           catalog,
 		inputCh:           inputCh,
 		toBeFlushedCh:     make(chan batchedTask, 64),
 		statistics:        statistics,
@@ -174,6 +188,13 @@ func (d *dmlWorker) flushMessages(ctx context.Context) error {
 				return nil
 			}
 			start := time.Now()
+			if d.catalog != nil {
+				if err := d.commitTables(ctx, batchedTask); err != nil {
+					return errors.Trace(err)
+				}
+				flushTimeSlice += time.Since(start)
+				continue
+			}
 			for table, task := range batchedTask.batch {
 				if len(task.msgs) == 0 {
 					continue
@@ -243,6 +264,65 @@ func (d *dmlWorker) flushMessages(ctx context.Context) error {
 	}
 }
 
+// commitTables commits the messages of each table as a snapshot of its
+// Iceberg table. The versions of a table are committed in order, and the
+// callbacks are called after the snapshot is committed.
+//
+// A snapshot is committed for each table in every flush rather than once for
+// each checkpoint, because the table sink can't advance its checkpoint until
+// the callbacks are called. So the snapshots and the metadata files pile up
+// with the flush interval, and they are expired by the commits according to
+// iceberg-snapshot-retention and iceberg-max-metadata-files.
+func (d *dmlWorker) commitTables(ctx context.Context, task batchedTask) error {
+	tables := make([]cloudstorage.VersionedTableName, 0, len(task.batch))
+	for table, t := range task.batch {
+		if len(t.msgs) > 0 {
+			tables = append(tables, table)
+		}
+	}
+	sort.Slice(tables, func(i, j int) bool {
+		return tables[i].TableInfoVersion < tables[j].TableInfoVersion
+	})
+
+	for _, table := range tables {
+		t := task.batch[table]
+		rows, err := parquet.DecodeRows(t.tableInfo, t.msgs)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		name := table.TableNameWithPhysicTableID
+		icebergTable := d.catalog.Table(name.Schema, name.Table)
+		var bytesCnt int64
+		err = d.statistics.RecordBatchExecution(func() (int, int64, error) {
+			start := time.Now()
+			n, err := icebergTable.Commit(ctx, t.tableInfo, rows)
+			if err != nil {
+				return 0, 0, err
+			}
+			d.metricFlushDuration.Observe(time.Since(start).Seconds())
+			bytesCnt = n
+			return len(rows), n, nil
+		})
+		if err != nil {
+			log.Error("failed to commit iceberg table",
+				zap.Int("workerID", d.id),
+				zap.String("namespace", d.changeFeedID.Namespace),
+				zap.String("changefeed", d.changeFeedID.ID),
+				zap.String("schema", name.Schema),
+				zap.String("table", name.Table),
+				zap.Error(err))
+			return err
+		}
+		d.metricWriteBytes.Add(float64(bytesCnt))
+		for _, msg := range t.msgs {
+			if msg.Callback != nil {
+				msg.Callback()
+			}
+		}
+	}
+	return nil
+}
+
 func (d *dmlWorker) writeIndexFile(ctx context.Context, path, content string) error {
 	start := time.Now()
 	err := d.storage.WriteFile(ctx, path, []byte(content))
@@ -252,19 +332,35 @@ func (d *dmlWorker) writeIndexFile(ctx context.Context, path, content string) er
 
 func (d *dmlWorker) writeDataFile(ctx context.Context, path string, task *singleTableTask) error {
 	var callbacks []func()
-	buf := bytes.NewBuffer(make([]byte, 0, task.size))
+	var buf *bytes.Buffer
 	rowsCnt := 0
 	bytesCnt := int64(0)
-	// There is always only one message here in task.msgs
-	for _, msg := range task.msgs {
-		if msg.Key != nil && rowsCnt == 0 {
-			buf.Write(msg.Key)
-			bytesCnt += int64(len(msg.Key))
+	if d.fileEncoder != nil {
+		// columnar formats can not be concatenated, so all messages are
+		// assembled into a single file by the file encoder.
+		data, err := d.fileEncoder.EncodeFile(task.tableInfo, task.msgs)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		buf = bytes.NewBuffer(data)
+		bytesCnt = int64(len(data))
+		for _, msg := range task.msgs {
+			rowsCnt += msg.GetRowsCount()
+			callbacks = append(callbacks, msg.Callback)
+		}
+	} else {
+		buf = bytes.NewBuffer(make([]byte, 0, task.size))
+		// There is always only one message here in task.msgs
+		for _, msg := range task.msgs {
+			if msg.Key != nil && rowsCnt == 0 {
+				buf.Write(msg.Key)
+				bytesCnt += int64(len(msg.Key))
+			}
+			bytesCnt += int64(len(msg.Value))
+			rowsCnt += msg.GetRowsCount()
+			buf.Write(msg.Value)
+			callbacks = append(callbacks, msg.Callback)
 		}
-		bytesCnt += int64(len(msg.Value))
-		rowsCnt += msg.GetRowsCount()
-		buf.Write(msg.Value)
-		callbacks = append(callbacks, msg.Callback)
 	}
 
 	if err := d.statistics.RecordBatchExecution(func() (int, int64, error) {
@@ -355,7 +451,14 @@ func (d *dmlWorker) genAndDispatchTask(ctx context.Context,
 			// as soon as possible.
 			table := frag.versionedTable
 			if batchedTask.batch[table].size >= uint64(d.config.FileSize) {
-				task := batchedTask.generateTaskByTable(table)
+				task := batchedTask
+				if d.catalog != nil {
+					// the versions of an Iceberg table must be committed in
+					// order, so all pending tables are flushed together.
+					batchedTask = newBatchedTask()
+				} else {
+					task = batchedTask.generateTaskByTable(table)
+				}
 				select {
 				case <-ctx.Done():
 					return errors.Trace(ctx.Err())
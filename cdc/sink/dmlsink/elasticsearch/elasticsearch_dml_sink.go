@@ -0,0 +1,184 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearch
+
+import (
+	"context"
+	"net/url"
+	"sync"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink"
+	"github.com/pingcap/tiflow/cdc/sink/metrics"
+	"github.com/pingcap/tiflow/cdc/sink/tablesink/state"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/sink"
+	"github.com/pingcap/tiflow/pkg/sink/elasticsearch"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+// Assert EventSink[E event.TableEvent] implementation
+var _ dmlsink.EventSink[*model.SingleTableTxn] = (*DMLSink)(nil)
+
+// DMLSink is the elasticsearch sink.
+// It indexes each row as a document keyed by the handle key of the row.
+type DMLSink struct {
+	// changefeedID indicates this sink belongs to which processor(changefeed).
+	changefeedID model.ChangeFeedID
+
+	alive struct {
+		sync.RWMutex
+		isDead bool
+	}
+
+	// workers are used to send the bulk requests, the events of one table
+	// are always sent by the same worker to keep them in order.
+	workers []*worker
+	client  *elasticsearch.Client
+	// statistics is used to record DML metrics.
+	statistics *metrics.Statistics
+
+	cancel context.CancelCauseFunc
+	wg     sync.WaitGroup
+	dead   chan struct{}
+
+	scheme string
+}
+
+// NewDMLSink creates an elasticsearch dmlSink.
+func NewDMLSink(
+	ctx context.Context,
+	changefeedID model.ChangeFeedID,
+	sinkURI *url.URL,
+	replicaConfig *config.ReplicaConfig,
+	errCh chan error,
+) (*DMLSink, error) {
+	cfg := elasticsearch.NewConfig()
+	if err := cfg.Apply(sinkURI, replicaConfig); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	s := &DMLSink{
+		changefeedID: changefeedID,
+		client:       elasticsearch.NewClient(changefeedID, cfg),
+		statistics:   metrics.NewStatistics(changefeedID, sink.TxnSink),
+		dead:         make(chan struct{}),
+		scheme:       sink.GetScheme(sinkURI),
+	}
+	s.workers = make([]*worker, 0, cfg.WorkerCount)
+	for i := 0; i < cfg.WorkerCount; i++ {
+		s.workers = append(s.workers, newWorker(changefeedID, i,
+			cfg.Index, s.client, cfg.MaxBatchRows, s.statistics))
+	}
+
+	ctx, s.cancel = context.WithCancelCause(ctx)
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		err := s.run(ctx)
+
+		s.alive.Lock()
+		s.alive.isDead = true
+		for _, w := range s.workers {
+			w.close()
+		}
+		s.alive.Unlock()
+		close(s.dead)
+
+		if err != nil && errors.Cause(err) != context.Canceled {
+			select {
+			case errCh <- err:
+				log.Warn("elasticsearch dml sink meet error",
+					zap.String("namespace", s.changefeedID.Namespace),
+					zap.String("changefeed", s.changefeedID.ID),
+					zap.Error(err))
+			default:
+				log.Info("elasticsearch dml sink meet error, ignored",
+					zap.String("namespace", s.changefeedID.Namespace),
+					zap.String("changefeed", s.changefeedID.ID),
+					zap.Error(err))
+			}
+		}
+	}()
+
+	log.Info("elasticsearch dml sink created",
+		zap.String("namespace", changefeedID.Namespace),
+		zap.String("changefeed", changefeedID.ID),
+		zap.String("endpoint", cfg.Endpoint),
+		zap.String("index", string(cfg.Index)),
+		zap.Int("workerCount", cfg.WorkerCount))
+	return s, nil
+}
+
+func (s *DMLSink) run(ctx context.Context) error {
+	g, ctx := errgroup.WithContext(ctx)
+	for _, w := range s.workers {
+		w := w
+		g.Go(func() error {
+			return w.run(ctx)
+		})
+	}
+	return g.Wait()
+}
+
+// WriteEvents writes events to the sink.
+// This is an asynchronously and thread-safe method.
+func (s *DMLSink) WriteEvents(txns ...*dmlsink.TxnCallbackableEvent) error {
+	s.alive.RLock()
+	defer s.alive.RUnlock()
+	if s.alive.isDead {
+		return errors.Trace(errors.New("dead dmlSink"))
+	}
+
+	for _, txn := range txns {
+		if txn.GetTableSinkState() != state.TableSinkSinking {
+			// The table where the event comes from is in stopping, so it's safe
+			// to drop the event directly.
+			txn.Callback()
+			continue
+		}
+		idx := uint64(txn.Event.PhysicalTableID) % uint64(len(s.workers))
+		// This never be blocked because this is an unbounded channel.
+		// We already limit the memory usage by MemoryQuota at SinkManager level.
+		s.workers[idx].inputCh.In() <- txn
+	}
+	return nil
+}
+
+// SchemeOption returns the scheme and the option.
+// The update events are kept as is, an update which changes the handle key
+// is converted to a delete of the old document and an index of the new one,
+// and the worker orders the deletes of a transaction before the indexes.
+func (s *DMLSink) SchemeOption() (string, bool) {
+	return s.scheme, true
+}
+
+// Close closes the sink.
+func (s *DMLSink) Close() {
+	if s.cancel != nil {
+		s.cancel(nil)
+	}
+	s.wg.Wait()
+
+	s.client.Close()
+	s.statistics.Close()
+}
+
+// Dead checks whether it's dead or not.
+func (s *DMLSink) Dead() <-chan struct{} {
+	return s.dead
+}
@@ -0,0 +1,245 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearch
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/cdc/entry"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink"
+	"github.com/pingcap/tiflow/cdc/sink/tablesink/state"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+)
+
+func newTestTxns(
+	t *testing.T, count int, tableStatus *state.TableSinkState, callback func(),
+) []*dmlsink.TxnCallbackableEvent {
+	helper := entry.NewSchemaTestHelper(t)
+	defer helper.Close()
+
+	sql := `create table test.t(a int primary key, b varchar(16))`
+	job := helper.DDL2Job(sql)
+	tableInfo := model.WrapTableInfo(0, "test", 1, job.BinlogInfo.TableInfo)
+
+	txns := make([]*dmlsink.TxnCallbackableEvent, 0, count)
+	for i := 0; i < count; i++ {
+		txns = append(txns, &dmlsink.TxnCallbackableEvent{
+			Event: &model.SingleTableTxn{
+				PhysicalTableID: tableInfo.ID,
+				TableInfo:       tableInfo,
+				CommitTs:        uint64(i + 1),
+				Rows: []*model.RowChangedEvent{{
+					CommitTs:  uint64(i + 1),
+					TableInfo: tableInfo,
+					Columns: model.Columns2ColumnDatas([]*model.Column{
+						{Name: "a", Value: int64(i)},
+						{Name: "b", Value: []byte("v" + strconv.Itoa(i))},
+					}, tableInfo),
+				}},
+			},
+			Callback:  callback,
+			SinkState: tableStatus,
+		})
+	}
+	return txns
+}
+
+func TestWriteEvents(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu    sync.Mutex
+		lines []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/_bulk", r.URL.Path)
+		scanner := bufio.NewScanner(r.Body)
+		mu.Lock()
+		for scanner.Scan() {
+			lines = append(lines, scanner.Text())
+		}
+		mu.Unlock()
+		_, _ = w.Write([]byte(`{"errors":false,"items":[]}`))
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sinkURI, err := url.Parse(server.URL + "?max-batch-rows=16")
+	require.NoError(t, err)
+	sinkURI.Scheme = "elasticsearch"
+	replicaConfig := config.GetDefaultReplicaConfig()
+	require.NoError(t, replicaConfig.ValidateAndAdjust(sinkURI))
+
+	errCh := make(chan error, 1)
+	s, err := NewDMLSink(ctx, model.DefaultChangeFeedID("test"), sinkURI, replicaConfig, errCh)
+	require.NoError(t, err)
+	defer s.Close()
+
+	var flushed atomic.Int64
+	tableStatus := state.TableSinkSinking
+	txns := newTestTxns(t, 100, &tableStatus, func() { flushed.Inc() })
+	require.NoError(t, s.WriteEvents(txns...))
+
+	require.Eventually(t, func() bool {
+		return flushed.Load() == 100
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Len(t, errCh, 0)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, lines, 200)
+	// The rows of the same table must be sent in order.
+	for i := 0; i < 100; i++ {
+		require.JSONEq(t, `{"index":{"_index":"test_t","_id":"`+strconv.Itoa(i)+
+			`","version":`+strconv.Itoa(i+1)+`,"version_type":"external_gte"}}`, lines[2*i])
+		require.JSONEq(t, `{"a":`+strconv.Itoa(i)+`,"b":"v`+strconv.Itoa(i)+`"}`, lines[2*i+1])
+	}
+}
+
+func TestWriteEventsFailed(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"errors":true,"items":[` +
+			`{"index":{"_index":"test_t","_id":"0","status":400,` +
+			`"error":{"type":"mapper_parsing_exception"}}}]}`))
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sinkURI, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	sinkURI.Scheme = "opensearch"
+	replicaConfig := config.GetDefaultReplicaConfig()
+	require.NoError(t, replicaConfig.ValidateAndAdjust(sinkURI))
+
+	errCh := make(chan error, 1)
+	s, err := NewDMLSink(ctx, model.DefaultChangeFeedID("test"), sinkURI, replicaConfig, errCh)
+	require.NoError(t, err)
+	defer s.Close()
+
+	var flushed atomic.Int64
+	tableStatus := state.TableSinkSinking
+	txns := newTestTxns(t, 1, &tableStatus, func() { flushed.Inc() })
+	require.NoError(t, s.WriteEvents(txns...))
+
+	select {
+	case err := <-errCh:
+		require.ErrorContains(t, err, "mapper_parsing_exception")
+	case <-time.After(5 * time.Second):
+		t.Fatal("elasticsearch dml sink should report the error")
+	}
+	<-s.Dead()
+	require.Equal(t, int64(0), flushed.Load())
+	require.Error(t, s.WriteEvents(txns...))
+}
+
+func TestWriteEventsSwapKeys(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu    sync.Mutex
+		lines []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		scanner := bufio.NewScanner(r.Body)
+		mu.Lock()
+		for scanner.Scan() {
+			lines = append(lines, scanner.Text())
+		}
+		mu.Unlock()
+		_, _ = w.Write([]byte(`{"errors":false,"items":[]}`))
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sinkURI, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	sinkURI.Scheme = "elasticsearch"
+	replicaConfig := config.GetDefaultReplicaConfig()
+	require.NoError(t, replicaConfig.ValidateAndAdjust(sinkURI))
+
+	errCh := make(chan error, 1)
+	s, err := NewDMLSink(ctx, model.DefaultChangeFeedID("test"), sinkURI, replicaConfig, errCh)
+	require.NoError(t, err)
+	defer s.Close()
+
+	helper := entry.NewSchemaTestHelper(t)
+	defer helper.Close()
+	job := helper.DDL2Job(`create table test.t(a int primary key, b varchar(16))`)
+	tableInfo := model.WrapTableInfo(0, "test", 1, job.BinlogInfo.TableInfo)
+	newColumns := func(a int64, b string) []*model.ColumnData {
+		return model.Columns2ColumnDatas([]*model.Column{
+			{Name: "a", Value: a},
+			{Name: "b", Value: []byte(b)},
+		}, tableInfo)
+	}
+
+	// update t set a = 3 - a where a in (1, 2)
+	var flushed atomic.Int64
+	tableStatus := state.TableSinkSinking
+	txn := &dmlsink.TxnCallbackableEvent{
+		Event: &model.SingleTableTxn{
+			PhysicalTableID: tableInfo.ID,
+			TableInfo:       tableInfo,
+			CommitTs:        10,
+			Rows: []*model.RowChangedEvent{
+				{
+					CommitTs:   10,
+					TableInfo:  tableInfo,
+					PreColumns: newColumns(1, "x"),
+					Columns:    newColumns(2, "x"),
+				},
+				{
+					CommitTs:   10,
+					TableInfo:  tableInfo,
+					PreColumns: newColumns(2, "y"),
+					Columns:    newColumns(1, "y"),
+				},
+			},
+		},
+		Callback:  func() { flushed.Inc() },
+		SinkState: &tableStatus,
+	}
+	require.NoError(t, s.WriteEvents(txn))
+	require.Eventually(t, func() bool {
+		return flushed.Load() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	// Both documents are deleted before they are indexed again,
+	// so none of the new documents is deleted.
+	require.Len(t, lines, 6)
+	require.JSONEq(t, `{"delete":{"_index":"test_t","_id":"1","version":10,"version_type":"external_gte"}}`, lines[0])
+	require.JSONEq(t, `{"delete":{"_index":"test_t","_id":"2","version":10,"version_type":"external_gte"}}`, lines[1])
+	require.JSONEq(t, `{"index":{"_index":"test_t","_id":"2","version":10,"version_type":"external_gte"}}`, lines[2])
+	require.JSONEq(t, `{"a":2,"b":"x"}`, lines[3])
+	require.JSONEq(t, `{"index":{"_index":"test_t","_id":"1","version":10,"version_type":"external_gte"}}`, lines[4])
+	require.JSONEq(t, `{"a":1,"b":"y"}`, lines[5])
+}
@@ -0,0 +1,185 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearch
+
+import (
+	"context"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink/mq/dispatcher/topic"
+	"github.com/pingcap/tiflow/cdc/sink/metrics"
+	"github.com/pingcap/tiflow/cdc/sink/tablesink/state"
+	"github.com/pingcap/tiflow/pkg/chann"
+	"github.com/pingcap/tiflow/pkg/sink/elasticsearch"
+	"go.uber.org/zap"
+)
+
+// worker converts the transactions to bulk actions and sends them.
+type worker struct {
+	changefeedID model.ChangeFeedID
+	id           int
+	// inputCh caches the transactions to be sent.
+	// It is an unbounded channel.
+	inputCh      *chann.DrainableChann[*dmlsink.TxnCallbackableEvent]
+	index        topic.Expression
+	client       *elasticsearch.Client
+	maxBatchRows int
+	statistics   *metrics.Statistics
+}
+
+func newWorker(
+	changefeedID model.ChangeFeedID,
+	id int,
+	index topic.Expression,
+	client *elasticsearch.Client,
+	maxBatchRows int,
+	statistics *metrics.Statistics,
+) *worker {
+	return &worker{
+		changefeedID: changefeedID,
+		id:           id,
+		inputCh:      chann.NewAutoDrainChann[*dmlsink.TxnCallbackableEvent](),
+		index:        index,
+		client:       client,
+		maxBatchRows: maxBatchRows,
+		statistics:   statistics,
+	}
+}
+
+// run keeps collecting and sending transactions until it
+// encounters an error or is interrupted.
+func (w *worker) run(ctx context.Context) (retErr error) {
+	defer func() {
+		log.Info("elasticsearch sink worker exited", zap.Error(retErr),
+			zap.String("namespace", w.changefeedID.Namespace),
+			zap.String("changefeed", w.changefeedID.ID),
+			zap.Int("workerID", w.id))
+	}()
+
+	for {
+		txns, err := w.batch(ctx)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if len(txns) == 0 {
+			return nil
+		}
+		if err := w.send(ctx, txns); err != nil {
+			return errors.Trace(err)
+		}
+	}
+}
+
+// batch blocks until at least one transaction is received, then collects
+// all ready transactions until the number of rows reaches maxBatchRows.
+// It returns an empty batch if the input channel is closed.
+func (w *worker) batch(ctx context.Context) ([]*dmlsink.TxnCallbackableEvent, error) {
+	var (
+		txns []*dmlsink.TxnCallbackableEvent
+		rows int
+	)
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case txn, ok := <-w.inputCh.Out():
+		if !ok {
+			return nil, nil
+		}
+		txns = append(txns, txn)
+		rows += len(txn.Event.Rows)
+	}
+	for rows < w.maxBatchRows {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case txn, ok := <-w.inputCh.Out():
+			if !ok {
+				return txns, nil
+			}
+			txns = append(txns, txn)
+			rows += len(txn.Event.Rows)
+		default:
+			return txns, nil
+		}
+	}
+	return txns, nil
+}
+
+// send converts the transactions to actions and sends them in one bulk
+// request. The callbacks are called after the request succeeds, so the
+// checkpoint of the table only advances after the documents are written.
+func (w *worker) send(ctx context.Context, txns []*dmlsink.TxnCallbackableEvent) error {
+	sinking := txns[:0]
+	for _, txn := range txns {
+		if txn.GetTableSinkState() != state.TableSinkSinking {
+			txn.Callback()
+			continue
+		}
+		sinking = append(sinking, txn)
+	}
+	if len(sinking) == 0 {
+		return nil
+	}
+
+	err := w.statistics.RecordBatchExecution(func() (int, int64, error) {
+		var (
+			rowCount int
+			bytes    int64
+			actions  []*elasticsearch.Action
+		)
+		for _, txn := range sinking {
+			w.statistics.ObserveRows(txn.Event.Rows...)
+			// The deletes of a transaction are sent before its index actions,
+			// otherwise a document indexed by one row may be deleted by another
+			// row which changes the handle key from it, e.g. swapping two keys.
+			var deletes, indexes []*elasticsearch.Action
+			for _, row := range txn.Event.Rows {
+				rowActions, err := elasticsearch.NewActions(w.index, row)
+				if err != nil {
+					return 0, 0, errors.Trace(err)
+				}
+				for _, action := range rowActions {
+					bytes += int64(len(action.Document))
+					if action.Op == elasticsearch.OpDelete {
+						deletes = append(deletes, action)
+					} else {
+						indexes = append(indexes, action)
+					}
+				}
+				rowCount++
+			}
+			actions = append(actions, deletes...)
+			actions = append(actions, indexes...)
+		}
+		if err := w.client.Bulk(ctx, actions); err != nil {
+			return 0, 0, errors.Trace(err)
+		}
+		return rowCount, bytes, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, txn := range sinking {
+		txn.Callback()
+	}
+	return nil
+}
+
+func (w *worker) close() {
+	w.inputCh.CloseAndDrain()
+}
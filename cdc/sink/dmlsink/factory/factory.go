@@ -23,10 +23,13 @@ import (
 	"github.com/pingcap/tiflow/cdc/sink/dmlsink"
 	"github.com/pingcap/tiflow/cdc/sink/dmlsink/blackhole"
 	"github.com/pingcap/tiflow/cdc/sink/dmlsink/cloudstorage"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink/elasticsearch"
 	"github.com/pingcap/tiflow/cdc/sink/dmlsink/mq"
 	"github.com/pingcap/tiflow/cdc/sink/dmlsink/mq/dmlproducer"
 	"github.com/pingcap/tiflow/cdc/sink/dmlsink/mq/manager"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink/redis"
 	"github.com/pingcap/tiflow/cdc/sink/dmlsink/txn"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink/webhook"
 	"github.com/pingcap/tiflow/cdc/sink/tablesink"
 	"github.com/pingcap/tiflow/pkg/config"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
@@ -51,6 +54,12 @@ const (
 	CategoryCloudStorage = 3
 	// CategoryBlackhole is for Blackhole sink.
 	CategoryBlackhole = 4
+	// CategoryWebhook is for Webhook sink.
+	CategoryWebhook = 5
+	// CategoryElasticsearch is for Elasticsearch sink.
+	CategoryElasticsearch = 6
+	// CategoryRedis is for Redis sink.
+	CategoryRedis = 7
 )
 
 // SinkFactory is the factory of sink.
@@ -89,6 +98,14 @@ func New(
 		}
 		s.txnSink = txnSink
 		s.category = CategoryTxn
+	case sink.PostgresScheme, sink.PostgreSQLScheme:
+		txnSink, err := txn.NewPostgresSink(ctx, changefeedID, sinkURI, cfg, errCh,
+			txn.DefaultConflictDetectorSlots)
+		if err != nil {
+			return nil, err
+		}
+		s.txnSink = txnSink
+		s.category = CategoryTxn
 	case sink.KafkaScheme, sink.KafkaSSLScheme:
 		factoryCreator := kafka.NewSaramaFactory
 		if util.GetOrZero(cfg.Sink.EnableKafkaSinkV2) {
@@ -121,6 +138,28 @@ func New(
 		}
 		s.txnSink = mqs
 		s.category = CategoryMQ
+	case sink.WebhookHTTPScheme, sink.WebhookHTTPSScheme:
+		webhookSink, err := webhook.NewDMLSink(ctx, changefeedID, sinkURI, cfg, errCh)
+		if err != nil {
+			return nil, err
+		}
+		s.txnSink = webhookSink
+		s.category = CategoryWebhook
+	case sink.ElasticsearchScheme, sink.ElasticsearchSSLScheme,
+		sink.OpenSearchScheme, sink.OpenSearchSSLScheme:
+		esSink, err := elasticsearch.NewDMLSink(ctx, changefeedID, sinkURI, cfg, errCh)
+		if err != nil {
+			return nil, err
+		}
+		s.txnSink = esSink
+		s.category = CategoryElasticsearch
+	case sink.RedisScheme, sink.RedisSSLScheme:
+		redisSink, err := redis.NewDMLSink(ctx, changefeedID, sinkURI, cfg, errCh)
+		if err != nil {
+			return nil, err
+		}
+		s.txnSink = redisSink
+		s.category = CategoryRedis
 	default:
 		return nil,
 			cerror.ErrSinkURIInvalid.GenWithStack("the sink scheme (%s) is not supported", scheme)
@@ -184,6 +223,21 @@ func (s *SinkFactory) Close() {
 	}
 }
 
+// DeadLetterCount returns the number of rows written to the dead-letter queue
+// by the underlying sink.
+func (s *SinkFactory) DeadLetterCount() uint64 {
+	type deadLetterCounter interface {
+		DeadLetterCount() uint64
+	}
+	if c, ok := s.rowSink.(deadLetterCounter); ok {
+		return c.DeadLetterCount()
+	}
+	if c, ok := s.txnSink.(deadLetterCounter); ok {
+		return c.DeadLetterCount()
+	}
+	return 0
+}
+
 // Category returns category of s.
 func (s *SinkFactory) Category() Category {
 	if s.category == 0 {
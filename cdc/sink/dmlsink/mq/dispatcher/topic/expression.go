@@ -48,12 +48,19 @@ var (
 	pulsarTopicNameREFull = regexp.MustCompile(
 		`(?:persistent|non-persistent)://.*`,
 	)
+	// elasticsearchForbidRE is used to reject the characters which are
+	// not allowed in an elasticsearch index name generated by TiCDC.
+	elasticsearchForbidRE = regexp.MustCompile(`[^a-z0-9\._\-]`)
 )
 
 // The max length of kafka topic name is 249.
 // See https://github.com/apache/kafka/blob/trunk/clients/src/main/java/org/apache/kafka/common/internals/Topic.java#L35
 const kafkaTopicNameMaxLength = 249
 
+// The max length of elasticsearch index name is 255 bytes.
+// See https://www.elastic.co/guide/en/elasticsearch/reference/current/indices-create-index.html
+const elasticsearchIndexNameMaxLength = 255
+
 // Expression represent a kafka topic expression.
 // The expression should be in form of: [prefix]{schema}[middle][{table}][suffix]
 // prefix/suffix/middle are optional and should match the regex of [A-Za-z0-9\._\-]*
@@ -134,6 +141,46 @@ func (e Expression) PulsarValidate() error {
 	return nil
 }
 
+// ValidateForElasticsearch checks whether an elasticsearch index expression is
+// valid or not. The expression uses the same syntax as the kafka topic
+// expression, and the index name must not start with '-', '_' or '+'.
+func (e Expression) ValidateForElasticsearch() error {
+	if len(e) == 0 {
+		return errors.ErrElasticsearchInvalidIndexExpression.GenWithStackByArgs(
+			e, "index expression is empty")
+	}
+	if !topicNameRE.MatchString(string(e)) {
+		return errors.ErrElasticsearchInvalidIndexExpression.GenWithStackByArgs(
+			e, "it should be in the format of [prefix]{schema}[middle][{table}][suffix]")
+	}
+	if strings.ContainsAny(string(e[:1]), "-_+") {
+		return errors.ErrElasticsearchInvalidIndexExpression.GenWithStackByArgs(
+			e, "index name must not start with '-', '_' or '+'")
+	}
+	return nil
+}
+
+// SubstituteForElasticsearch converts schema/table name in an index expression
+// to an elasticsearch index name. Elasticsearch only accepts lowercase index
+// names, so the result is lowercased, and the characters other than
+// [a-z0-9\._\-] in schema/table are substituted for underscore '_'.
+func (e Expression) SubstituteForElasticsearch(schema, table string) string {
+	replacedSchema := elasticsearchForbidRE.ReplaceAllString(strings.ToLower(schema), "_")
+	replacedTable := elasticsearchForbidRE.ReplaceAllString(strings.ToLower(table), "_")
+
+	indexName := schemaRE.ReplaceAllString(strings.ToLower(string(e)), replacedSchema)
+	indexName = tableRE.ReplaceAllString(indexName, replacedTable)
+
+	if len(indexName) > elasticsearchIndexNameMaxLength {
+		return indexName[:elasticsearchIndexNameMaxLength]
+	} else if indexName == "." {
+		return "_"
+	} else if indexName == ".." {
+		return "__"
+	}
+	return indexName
+}
+
 // IsHardCode checks whether a topic name is hard code or not.
 func IsHardCode(topicName string) bool {
 	return hardCodeTopicNameRe.MatchString(topicName)
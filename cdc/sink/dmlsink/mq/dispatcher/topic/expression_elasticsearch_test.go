@@ -0,0 +1,55 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topic
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateForElasticsearch(t *testing.T) {
+	t.Parallel()
+
+	for _, expr := range []string{"{schema}_{table}", "cdc-{schema}", "tidb", "es.{table}"} {
+		require.NoError(t, Expression(expr).ValidateForElasticsearch(), expr)
+	}
+	for _, expr := range []string{"", "_{schema}", "-{table}", "{schema}/{table}", "{schema} {table}"} {
+		require.ErrorContains(t, Expression(expr).ValidateForElasticsearch(),
+			"invalid index expression", expr)
+	}
+}
+
+func TestSubstituteForElasticsearch(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		expression string
+		schema     string
+		table      string
+		expected   string
+	}{
+		{"{schema}_{table}", "Test", "T1", "test_t1"},
+		{"CDC-{schema}", "db", "t", "cdc-db"},
+		{"{schema}_{table}", "d#b", "t*", "d_b_t_"},
+		{"{schema}", ".", "t", "_"},
+		{"{schema}", "..", "t", "__"},
+		{"{schema}", strings.Repeat("a", 300), "t", strings.Repeat("a", 255)},
+	}
+	for _, c := range cases {
+		require.Equal(t, c.expected,
+			Expression(c.expression).SubstituteForElasticsearch(c.schema, c.table))
+	}
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/sink/tablesink/state"
 	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/pingcap/tiflow/pkg/sink/codec"
+	"github.com/pingcap/tiflow/pkg/sink/deadletter"
 	"github.com/pingcap/tiflow/pkg/sink/kafka"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
@@ -70,6 +71,9 @@ type dmlSink struct {
 
 	scheme               string
 	outputRawChangeEvent bool
+
+	// deadLetter is nil if the dead-letter queue is not enabled.
+	deadLetter deadletter.Writer
 }
 
 func newDMLSink(
@@ -228,6 +232,18 @@ func (s *dmlSink) Close() {
 	if s.adminClient != nil {
 		s.adminClient.Close()
 	}
+
+	if s.deadLetter != nil {
+		s.deadLetter.Close()
+	}
+}
+
+// DeadLetterCount returns the number of rows written to the dead-letter queue.
+func (s *dmlSink) DeadLetterCount() uint64 {
+	if s.deadLetter == nil {
+		return 0
+	}
+	return s.deadLetter.Count()
 }
 
 // Dead checks whether it's dead or not.
@@ -31,6 +31,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/sink"
 	"github.com/pingcap/tiflow/pkg/sink/codec"
 	"github.com/pingcap/tiflow/pkg/sink/codec/builder"
+	"github.com/pingcap/tiflow/pkg/sink/deadletter"
 	pulsarConfig "github.com/pingcap/tiflow/pkg/sink/pulsar"
 	tiflowutil "github.com/pingcap/tiflow/pkg/util"
 	"go.uber.org/zap"
@@ -121,10 +122,16 @@ func NewPulsarDMLSink(
 		return nil, cerror.WrapError(cerror.ErrPulsarInvalidConfig, err)
 	}
 
-	encoderGroup := codec.NewEncoderGroup(replicaConfig.Sink, encoderBuilder, changefeedID)
+	deadLetter, err := deadletter.NewWriter(ctx, changefeedID, replicaConfig.Sink.DeadLetter)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	encoderGroup := codec.NewEncoderGroup(replicaConfig.Sink, encoderBuilder, changefeedID, deadLetter)
 
 	s := newDMLSink(ctx, changefeedID, p, nil, topicManager, eventRouter, trans, encoderGroup,
 		protocol, scheme, pConfig.GetOutputRawChangeEvent(), errCh)
+	s.deadLetter = deadLetter
 
 	return s, nil
 }
@@ -15,7 +15,9 @@ package mq
 
 import (
 	"context"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -29,6 +31,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/sink/codec"
 	"github.com/pingcap/tiflow/pkg/sink/codec/builder"
 	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"github.com/pingcap/tiflow/pkg/sink/deadletter"
 	"github.com/stretchr/testify/require"
 )
 
@@ -43,7 +46,7 @@ func newBatchEncodeWorker(t *testing.T) (*worker, dmlproducer.DMLProducer) {
 	encoderConcurrency := 4
 	cfg := config.GetDefaultReplicaConfig()
 	cfg.Sink.EncoderConcurrency = &encoderConcurrency
-	encoderGroup := codec.NewEncoderGroup(cfg.Sink, encoderBuilder, id)
+	encoderGroup := codec.NewEncoderGroup(cfg.Sink, encoderBuilder, id, nil)
 	return newWorker(id, config.ProtocolOpen, p, encoderGroup), p
 }
 
@@ -58,7 +61,7 @@ func newNonBatchEncodeWorker(t *testing.T) (*worker, dmlproducer.DMLProducer) {
 	encoderConcurrency := 4
 	cfg := config.GetDefaultReplicaConfig()
 	cfg.Sink.EncoderConcurrency = &encoderConcurrency
-	encoderGroup := codec.NewEncoderGroup(cfg.Sink, encoderBuilder, id)
+	encoderGroup := codec.NewEncoderGroup(cfg.Sink, encoderBuilder, id, nil)
 	return newWorker(id, config.ProtocolOpen, p, encoderGroup), p
 }
 
@@ -126,6 +129,88 @@ func TestNonBatchEncode_SendMessages(t *testing.T) {
 	wg.Wait()
 }
 
+type mockDeadLetterWriter struct {
+	sync.Mutex
+	records []*deadletter.Record
+}
+
+func (w *mockDeadLetterWriter) Write(_ context.Context, records ...*deadletter.Record) error {
+	w.Lock()
+	defer w.Unlock()
+	w.records = append(w.records, records...)
+	return nil
+}
+
+func (w *mockDeadLetterWriter) Count() uint64 {
+	w.Lock()
+	defer w.Unlock()
+	return uint64(len(w.records))
+}
+
+func (w *mockDeadLetterWriter) Close() {}
+
+func TestNonBatchEncode_DeadLetter(t *testing.T) {
+	helper := entry.NewSchemaTestHelper(t)
+	defer helper.Close()
+
+	sql := `create table test.t(a varchar(255) primary key)`
+	job := helper.DDL2Job(sql)
+	tableInfo := model.WrapTableInfo(0, "test", 1, job.BinlogInfo.TableInfo)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	id := model.DefaultChangeFeedID("test")
+	encoderConfig := common.NewConfig(config.ProtocolCanalJSON).WithMaxMessageBytes(300).WithChangefeedID(id)
+	encoderBuilder, err := builder.NewRowEventEncoderBuilder(ctx, encoderConfig)
+	require.NoError(t, err)
+	p := dmlproducer.NewDMLMockProducer(ctx, id, nil, nil, nil, nil)
+	deadLetter := &mockDeadLetterWriter{}
+	encoderGroup := codec.NewEncoderGroup(config.GetDefaultReplicaConfig().Sink, encoderBuilder, id, deadLetter)
+	worker := newWorker(id, config.ProtocolCanalJSON, p, encoderGroup)
+	defer worker.close()
+
+	key := model.TopicPartitionKey{
+		Topic:     "test",
+		Partition: 1,
+	}
+	tableStatus := state.TableSinkSinking
+	var callbacks atomic.Int64
+	for _, value := range []string{"aa", strings.Repeat("a", 255)} {
+		worker.msgChan.In() <- mqEvent{
+			key: key,
+			rowEvent: &dmlsink.RowChangeCallbackableEvent{
+				Event: &model.RowChangedEvent{
+					CommitTs:  1,
+					TableInfo: tableInfo,
+					Columns:   model.Columns2ColumnDatas([]*model.Column{{Name: "a", Value: value}}, tableInfo),
+				},
+				Callback:  func() { callbacks.Add(1) },
+				SinkState: &tableStatus,
+			},
+		}
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_ = worker.run(ctx)
+	}()
+
+	// The large row is routed to the dead-letter queue and the other one
+	// is sent to the downstream.
+	mp := p.(*dmlproducer.MockDMLProducer)
+	require.Eventually(t, func() bool {
+		return len(mp.GetAllEvents()) == 1 && callbacks.Load() == 2
+	}, 3*time.Second, 10*time.Millisecond)
+	require.Equal(t, uint64(1), deadLetter.Count())
+	require.Equal(t, "t", deadLetter.records[0].Table)
+	cancel()
+
+	wg.Wait()
+}
+
 func TestBatchEncode_Batch(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,271 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"hash/fnv"
+	"net/url"
+	"sync"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink"
+	"github.com/pingcap/tiflow/cdc/sink/metrics"
+	"github.com/pingcap/tiflow/cdc/sink/tablesink/state"
+	"github.com/pingcap/tiflow/cdc/sink/util"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink"
+	"github.com/pingcap/tiflow/pkg/sink/codec/builder"
+	"github.com/pingcap/tiflow/pkg/sink/redis"
+	goredis "github.com/redis/go-redis/v9"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+// Assert EventSink[E event.TableEvent] implementation
+var _ dmlsink.EventSink[*model.SingleTableTxn] = (*DMLSink)(nil)
+
+// DMLSink is the redis sink.
+// It invalidates or materializes the keys of the changed rows.
+type DMLSink struct {
+	// changefeedID indicates this sink belongs to which processor(changefeed).
+	changefeedID model.ChangeFeedID
+	cfg          *redis.Config
+
+	alive struct {
+		sync.RWMutex
+		isDead bool
+	}
+
+	// workers are used to send the commands, the commands of one key are
+	// always sent by the same worker to keep them in order.
+	workers []*worker
+	client  *goredis.Client
+	// statistics is used to record DML metrics.
+	statistics *metrics.Statistics
+
+	cancel context.CancelCauseFunc
+	wg     sync.WaitGroup
+	dead   chan struct{}
+
+	scheme string
+}
+
+// NewDMLSink creates a redis dmlSink.
+func NewDMLSink(
+	ctx context.Context,
+	changefeedID model.ChangeFeedID,
+	sinkURI *url.URL,
+	replicaConfig *config.ReplicaConfig,
+	errCh chan error,
+) (*DMLSink, error) {
+	cfg := redis.NewConfig()
+	if err := cfg.Apply(sinkURI, replicaConfig); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	// The rows are encoded by the simple protocol in materialize mode.
+	encoderConfig, err := util.GetEncoderConfig(changefeedID, sinkURI, config.ProtocolSimple,
+		replicaConfig, config.DefaultMaxMessageBytes)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	encoderBuilder, err := builder.NewRowEventEncoderBuilder(ctx, encoderConfig)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrRedisInvalidConfig, err)
+	}
+
+	client := goredis.NewClient(cfg.ClientOptions())
+	if err := client.Ping(ctx).Err(); err != nil {
+		_ = client.Close()
+		return nil, cerror.WrapError(cerror.ErrRedisWrite, err)
+	}
+
+	s := &DMLSink{
+		changefeedID: changefeedID,
+		cfg:          cfg,
+		client:       client,
+		statistics:   metrics.NewStatistics(changefeedID, sink.TxnSink),
+		dead:         make(chan struct{}),
+		scheme:       sink.GetScheme(sinkURI),
+	}
+	s.workers = make([]*worker, 0, cfg.WorkerCount)
+	for i := 0; i < cfg.WorkerCount; i++ {
+		s.workers = append(s.workers, newWorker(changefeedID, i,
+			encoderBuilder.Build(), s.client, cfg.MaxBatchRows, s.statistics))
+	}
+
+	ctx, s.cancel = context.WithCancelCause(ctx)
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		err := s.run(ctx)
+
+		s.alive.Lock()
+		s.alive.isDead = true
+		for _, w := range s.workers {
+			w.close()
+		}
+		s.alive.Unlock()
+		close(s.dead)
+
+		if err != nil && errors.Cause(err) != context.Canceled {
+			select {
+			case errCh <- err:
+				log.Warn("redis dml sink meet error",
+					zap.String("namespace", s.changefeedID.Namespace),
+					zap.String("changefeed", s.changefeedID.ID),
+					zap.Error(err))
+			default:
+				log.Info("redis dml sink meet error, ignored",
+					zap.String("namespace", s.changefeedID.Namespace),
+					zap.String("changefeed", s.changefeedID.ID),
+					zap.Error(err))
+			}
+		}
+	}()
+
+	log.Info("redis dml sink created",
+		zap.String("namespace", changefeedID.Namespace),
+		zap.String("changefeed", changefeedID.ID),
+		zap.String("addr", cfg.Addr),
+		zap.Int("db", cfg.DB),
+		zap.Int("rules", len(cfg.Rules)),
+		zap.Int("workerCount", cfg.WorkerCount))
+	return s, nil
+}
+
+func (s *DMLSink) run(ctx context.Context) error {
+	g, ctx := errgroup.WithContext(ctx)
+	for _, w := range s.workers {
+		w := w
+		g.Go(func() error {
+			return w.run(ctx)
+		})
+	}
+	return g.Wait()
+}
+
+// WriteEvents writes events to the sink.
+// This is an asynchronously and thread-safe method.
+func (s *DMLSink) WriteEvents(txns ...*dmlsink.TxnCallbackableEvent) error {
+	s.alive.RLock()
+	defer s.alive.RUnlock()
+	if s.alive.isDead {
+		return errors.Trace(errors.New("dead dmlSink"))
+	}
+
+	for _, txn := range txns {
+		if txn.GetTableSinkState() != state.TableSinkSinking {
+			// The table where the event comes from is in stopping, so it's safe
+			// to drop the event directly.
+			txn.Callback()
+			continue
+		}
+		tableInfo := txn.Event.TableInfo
+		rule := s.cfg.MatchRule(tableInfo.GetSchemaName(), tableInfo.GetTableName())
+		if rule == nil {
+			// The table is not chosen by any rule.
+			txn.Callback()
+			continue
+		}
+
+		// The deletes of a transaction are dispatched before its sets,
+		// otherwise a key written by one row may be deleted by another row
+		// which changes the key from it, e.g. swapping two keys.
+		var commands, sets []*redis.Command
+		for _, row := range txn.Event.Rows {
+			rowCommands, err := rule.NewCommands(row)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			for _, cmd := range rowCommands {
+				if cmd.Row == nil {
+					commands = append(commands, cmd)
+				} else {
+					sets = append(sets, cmd)
+				}
+			}
+		}
+		commands = append(commands, sets...)
+		if len(commands) == 0 {
+			txn.Callback()
+			continue
+		}
+		s.statistics.ObserveRows(txn.Event.Rows...)
+
+		callback := toCommandCallback(txn.Callback, uint64(len(commands)))
+		for _, cmd := range commands {
+			// This never be blocked because this is an unbounded channel.
+			// We already limit the memory usage by MemoryQuota at SinkManager level.
+			s.workers[s.workerIndex(cmd.Key)].inputCh.In() <- &command{
+				Command:  cmd,
+				callback: callback,
+				state:    txn.SinkState,
+			}
+		}
+	}
+	return nil
+}
+
+// workerIndex dispatches the commands by key,
+// so that the commands of one key are sent in order.
+func (s *DMLSink) workerIndex(key string) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	return int(h.Sum32() % uint32(len(s.workers)))
+}
+
+// toCommandCallback returns a callback which calls the callback of the
+// transaction after the callbacks of all its commands are called.
+func toCommandCallback(txnCallback func(), totalCount uint64) func() {
+	var calledCount atomic.Uint64
+	return func() {
+		if calledCount.Inc() == totalCount {
+			txnCallback()
+		}
+	}
+}
+
+// SchemeOption returns the scheme and the option.
+// The update events are kept as is, the old key of an update is deleted
+// if the key is changed, and the deletes are ordered by WriteEvents.
+func (s *DMLSink) SchemeOption() (string, bool) {
+	return s.scheme, true
+}
+
+// Close closes the sink.
+func (s *DMLSink) Close() {
+	if s.cancel != nil {
+		s.cancel(nil)
+	}
+	s.wg.Wait()
+
+	if err := s.client.Close(); err != nil {
+		log.Warn("close redis client failed",
+			zap.String("namespace", s.changefeedID.Namespace),
+			zap.String("changefeed", s.changefeedID.ID),
+			zap.Error(err))
+	}
+	s.statistics.Close()
+}
+
+// Dead checks whether it's dead or not.
+func (s *DMLSink) Dead() <-chan struct{} {
+	return s.dead
+}
@@ -0,0 +1,228 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/pingcap/tiflow/cdc/entry"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink"
+	"github.com/pingcap/tiflow/cdc/sink/tablesink/state"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+)
+
+func newTestSink(
+	t *testing.T, server *miniredis.Miniredis, errCh chan error, query string,
+) (*DMLSink, func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+	sinkURI, err := url.Parse("redis://" + server.Addr() + "/0?" + query)
+	require.NoError(t, err)
+	replicaConfig := config.GetDefaultReplicaConfig()
+	replicaConfig.Sink.RedisConfig = &config.RedisConfig{
+		Rules: []*config.RedisRule{
+			{Matcher: []string{"test.user"}, Mode: config.RedisModeMaterialize, Key: "user:{id}"},
+			{Matcher: []string{"test.order"}, Mode: config.RedisModeInvalidate},
+		},
+	}
+	require.NoError(t, replicaConfig.ValidateAndAdjust(sinkURI))
+
+	s, err := NewDMLSink(ctx, model.DefaultChangeFeedID("test"), sinkURI, replicaConfig, errCh)
+	require.NoError(t, err)
+	return s, func() {
+		s.Close()
+		cancel()
+	}
+}
+
+func newTestTableInfo(t *testing.T, sql string) *model.TableInfo {
+	helper := entry.NewSchemaTestHelper(t)
+	defer helper.Close()
+	job := helper.DDL2Job(sql)
+	return model.WrapTableInfo(0, "test", 1, job.BinlogInfo.TableInfo)
+}
+
+func newTestTxn(
+	tableInfo *model.TableInfo, commitTs uint64, rows []*model.RowChangedEvent,
+	tableStatus *state.TableSinkState, callback func(),
+) *dmlsink.TxnCallbackableEvent {
+	for _, row := range rows {
+		row.CommitTs = commitTs
+		row.TableInfo = tableInfo
+	}
+	return &dmlsink.TxnCallbackableEvent{
+		Event: &model.SingleTableTxn{
+			PhysicalTableID: tableInfo.ID,
+			TableInfo:       tableInfo,
+			CommitTs:        commitTs,
+			Rows:            rows,
+		},
+		Callback:  callback,
+		SinkState: tableStatus,
+	}
+}
+
+func TestWriteEvents(t *testing.T) {
+	t.Parallel()
+
+	server := miniredis.RunT(t)
+	errCh := make(chan error, 1)
+	s, closer := newTestSink(t, server, errCh, "max-batch-rows=16")
+	defer closer()
+
+	user := newTestTableInfo(t, `create table test.user(id int primary key, name varchar(16))`)
+	order := newTestTableInfo(t, `create table test.order(id int primary key, amount int)`)
+	other := newTestTableInfo(t, `create table test.other(id int primary key)`)
+	userRow := func(id int, name string) []*model.ColumnData {
+		return model.Columns2ColumnDatas([]*model.Column{
+			{Name: "id", Value: int64(id)},
+			{Name: "name", Value: []byte(name)},
+		}, user)
+	}
+	require.NoError(t, server.Set("test:order:1", "cached"))
+	require.NoError(t, server.Set("test:order:2", "cached"))
+	require.NoError(t, server.Set("test:other:1", "cached"))
+
+	var flushed atomic.Int64
+	callback := func() { flushed.Inc() }
+	tableStatus := state.TableSinkSinking
+	txns := []*dmlsink.TxnCallbackableEvent{
+		newTestTxn(user, 1, []*model.RowChangedEvent{
+			{Columns: userRow(1, "a")},
+			{Columns: userRow(2, "b")},
+			{Columns: userRow(3, "c")},
+		}, &tableStatus, callback),
+		// update the name, the key is not changed
+		newTestTxn(user, 2, []*model.RowChangedEvent{
+			{PreColumns: userRow(1, "a"), Columns: userRow(1, "aa")},
+		}, &tableStatus, callback),
+		// update the key, the old key is deleted
+		newTestTxn(user, 3, []*model.RowChangedEvent{
+			{PreColumns: userRow(2, "b"), Columns: userRow(4, "b")},
+		}, &tableStatus, callback),
+		newTestTxn(user, 4, []*model.RowChangedEvent{
+			{PreColumns: userRow(3, "c")},
+		}, &tableStatus, callback),
+		newTestTxn(order, 5, []*model.RowChangedEvent{{
+			Columns: model.Columns2ColumnDatas([]*model.Column{
+				{Name: "id", Value: int64(1)}, {Name: "amount", Value: int64(10)},
+			}, order),
+		}}, &tableStatus, callback),
+		// The table does not match any rule.
+		newTestTxn(other, 6, []*model.RowChangedEvent{{
+			PreColumns: model.Columns2ColumnDatas([]*model.Column{
+				{Name: "id", Value: int64(1)},
+			}, other),
+		}}, &tableStatus, callback),
+	}
+	require.NoError(t, s.WriteEvents(txns...))
+
+	require.Eventually(t, func() bool {
+		return flushed.Load() == int64(len(txns))
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Len(t, errCh, 0)
+
+	require.ElementsMatch(t,
+		[]string{"user:1", "user:4", "test:order:2", "test:other:1"}, server.Keys())
+	for id, name := range map[int]string{1: "aa", 4: "b"} {
+		value, err := server.Get("user:" + strconv.Itoa(id))
+		require.NoError(t, err)
+		var msg map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(value), &msg))
+		require.Equal(t, "test", msg["database"])
+		require.Equal(t, "user", msg["table"])
+		require.Equal(t, map[string]interface{}{
+			"id": strconv.Itoa(id), "name": name,
+		}, msg["data"])
+	}
+}
+
+func TestWriteEventsFailed(t *testing.T) {
+	t.Parallel()
+
+	server := miniredis.RunT(t)
+	errCh := make(chan error, 1)
+	s, closer := newTestSink(t, server, errCh, "max-retries=0")
+	defer closer()
+
+	server.SetError("READONLY You can't write against a read only replica.")
+	order := newTestTableInfo(t, `create table test.order(id int primary key, amount int)`)
+	var flushed atomic.Int64
+	tableStatus := state.TableSinkSinking
+	txn := newTestTxn(order, 1, []*model.RowChangedEvent{{
+		PreColumns: model.Columns2ColumnDatas([]*model.Column{
+			{Name: "id", Value: int64(1)}, {Name: "amount", Value: int64(10)},
+		}, order),
+	}}, &tableStatus, func() { flushed.Inc() })
+	require.NoError(t, s.WriteEvents(txn))
+
+	select {
+	case err := <-errCh:
+		require.ErrorContains(t, err, "READONLY")
+	case <-time.After(5 * time.Second):
+		t.Fatal("redis dml sink should report the error")
+	}
+	<-s.Dead()
+	require.Equal(t, int64(0), flushed.Load())
+	require.Error(t, s.WriteEvents(txn))
+}
+
+func TestWriteEventsSwapKeys(t *testing.T) {
+	t.Parallel()
+
+	server := miniredis.RunT(t)
+	errCh := make(chan error, 1)
+	s, closer := newTestSink(t, server, errCh, "worker-count=4")
+	defer closer()
+
+	user := newTestTableInfo(t, `create table test.user(id int primary key, name varchar(16))`)
+	userRow := func(id int, name string) []*model.ColumnData {
+		return model.Columns2ColumnDatas([]*model.Column{
+			{Name: "id", Value: int64(id)},
+			{Name: "name", Value: []byte(name)},
+		}, user)
+	}
+
+	var flushed atomic.Int64
+	tableStatus := state.TableSinkSinking
+	// update user set id = 3 - id where id in (1, 2)
+	txn := newTestTxn(user, 1, []*model.RowChangedEvent{
+		{PreColumns: userRow(1, "a"), Columns: userRow(2, "a")},
+		{PreColumns: userRow(2, "b"), Columns: userRow(1, "b")},
+	}, &tableStatus, func() { flushed.Inc() })
+	require.NoError(t, s.WriteEvents(txn))
+	require.Eventually(t, func() bool {
+		return flushed.Load() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Len(t, errCh, 0)
+
+	require.ElementsMatch(t, []string{"user:1", "user:2"}, server.Keys())
+	for id, name := range map[int]string{1: "b", 2: "a"} {
+		value, err := server.Get("user:" + strconv.Itoa(id))
+		require.NoError(t, err)
+		var msg map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(value), &msg))
+		require.Equal(t, map[string]interface{}{
+			"id": strconv.Itoa(id), "name": name,
+		}, msg["data"])
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/metrics"
+	"github.com/pingcap/tiflow/cdc/sink/tablesink/state"
+	"github.com/pingcap/tiflow/pkg/chann"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink/codec"
+	"github.com/pingcap/tiflow/pkg/sink/redis"
+	goredis "github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// command is a redis command with the callback of its row.
+type command struct {
+	*redis.Command
+	callback func()
+	state    *state.TableSinkState
+}
+
+// worker encodes the rows and sends the commands in pipelines.
+type worker struct {
+	changefeedID model.ChangeFeedID
+	id           int
+	// inputCh caches the commands to be sent.
+	// It is an unbounded channel.
+	inputCh      *chann.DrainableChann[*command]
+	encoder      codec.RowEventEncoder
+	client       *goredis.Client
+	maxBatchRows int
+	statistics   *metrics.Statistics
+}
+
+func newWorker(
+	changefeedID model.ChangeFeedID,
+	id int,
+	encoder codec.RowEventEncoder,
+	client *goredis.Client,
+	maxBatchRows int,
+	statistics *metrics.Statistics,
+) *worker {
+	return &worker{
+		changefeedID: changefeedID,
+		id:           id,
+		inputCh:      chann.NewAutoDrainChann[*command](),
+		encoder:      encoder,
+		client:       client,
+		maxBatchRows: maxBatchRows,
+		statistics:   statistics,
+	}
+}
+
+// run keeps collecting and sending commands until it
+// encounters an error or is interrupted.
+func (w *worker) run(ctx context.Context) (retErr error) {
+	defer func() {
+		log.Info("redis sink worker exited", zap.Error(retErr),
+			zap.String("namespace", w.changefeedID.Namespace),
+			zap.String("changefeed", w.changefeedID.ID),
+			zap.Int("workerID", w.id))
+	}()
+
+	for {
+		commands, err := w.batch(ctx)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if len(commands) == 0 {
+			return nil
+		}
+		if err := w.send(ctx, commands); err != nil {
+			return errors.Trace(err)
+		}
+	}
+}
+
+// batch blocks until at least one command is received, then collects
+// all ready commands until the number of commands reaches maxBatchRows.
+// It returns an empty batch if the input channel is closed.
+func (w *worker) batch(ctx context.Context) ([]*command, error) {
+	var commands []*command
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case cmd, ok := <-w.inputCh.Out():
+		if !ok {
+			return nil, nil
+		}
+		commands = append(commands, cmd)
+	}
+	for len(commands) < w.maxBatchRows {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case cmd, ok := <-w.inputCh.Out():
+			if !ok {
+				return commands, nil
+			}
+			commands = append(commands, cmd)
+		default:
+			return commands, nil
+		}
+	}
+	return commands, nil
+}
+
+// send sends the commands in one pipeline. The callbacks are called after
+// the pipeline succeeds, so the checkpoint of the table only advances after
+// the keys are written.
+func (w *worker) send(ctx context.Context, commands []*command) error {
+	sinking := commands[:0]
+	for _, cmd := range commands {
+		if cmd.state.Load() != state.TableSinkSinking {
+			cmd.callback()
+			continue
+		}
+		sinking = append(sinking, cmd)
+	}
+	if len(sinking) == 0 {
+		return nil
+	}
+
+	err := w.statistics.RecordBatchExecution(func() (int, int64, error) {
+		var bytes int64
+		pipe := w.client.Pipeline()
+		for _, cmd := range sinking {
+			if cmd.Row == nil {
+				pipe.Del(ctx, cmd.Key)
+				continue
+			}
+			if err := w.encoder.AppendRowChangedEvent(ctx, "", cmd.Row, nil); err != nil {
+				return 0, 0, errors.Trace(err)
+			}
+			value := w.encoder.Build()[0].Value
+			bytes += int64(len(value))
+			pipe.Set(ctx, cmd.Key, value, cmd.TTL)
+		}
+		if _, err := pipe.Exec(ctx); err != nil {
+			return 0, 0, cerror.WrapError(cerror.ErrRedisWrite, err)
+		}
+		return len(sinking), bytes, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, cmd := range sinking {
+		cmd.callback()
+	}
+	return nil
+}
+
+func (w *worker) close() {
+	w.inputCh.CloseAndDrain()
+}
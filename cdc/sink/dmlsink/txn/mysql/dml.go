@@ -22,6 +22,54 @@ import (
 	"github.com/pingcap/tiflow/pkg/quotes"
 )
 
+// PrepareRowDMLs converts a single row to query strings and args.
+// Inserts are translated into INSERT statements if translateToInsert is true,
+// otherwise into REPLACE statements, which makes them safe to be re-executed.
+func PrepareRowDMLs(
+	quoteTable string, row *model.RowChangedEvent, translateToInsert, forceReplicate bool,
+) ([]string, [][]interface{}) {
+	var sqls []string
+	var values [][]interface{}
+	var query string
+	var args []interface{}
+	// Update Event
+	if len(row.PreColumns) != 0 && len(row.Columns) != 0 {
+		query, args = prepareUpdate(
+			quoteTable,
+			row.PreColumns,
+			row.Columns,
+			row.TableInfo,
+			forceReplicate)
+		if query != "" {
+			sqls = append(sqls, query)
+			values = append(values, args)
+		}
+		return sqls, values
+	}
+
+	// Delete Event
+	if len(row.PreColumns) != 0 {
+		query, args = prepareDelete(quoteTable, row.PreColumns, row.TableInfo, forceReplicate)
+		if query != "" {
+			sqls = append(sqls, query)
+			values = append(values, args)
+		}
+	}
+
+	// Insert Event
+	// It will be translated directly into a
+	// INSERT(not in safe mode)
+	// or REPLACE(in safe mode) SQL.
+	if len(row.Columns) != 0 {
+		query, args = prepareReplace(quoteTable, row.Columns, row.TableInfo, true, translateToInsert)
+		if query != "" {
+			sqls = append(sqls, query)
+			values = append(values, args)
+		}
+	}
+	return sqls, values
+}
+
 // prepareUpdate builds a parametrics UPDATE statement as following
 // sql: `UPDATE `test`.`t` SET {} = ?, {} = ? WHERE {} = ?, {} = {} LIMIT 1`
 // `WHERE` conditions come from `preCols` and SET clause targets come from `cols`.
@@ -39,6 +39,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/config"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/retry"
+	"github.com/pingcap/tiflow/pkg/sink/deadletter"
 	pmysql "github.com/pingcap/tiflow/pkg/sink/mysql"
 	"github.com/pingcap/tiflow/pkg/sqlmodel"
 	"github.com/pingcap/tiflow/pkg/util"
@@ -60,11 +61,12 @@ const (
 )
 
 type mysqlBackend struct {
-	workerID    int
-	changefeed  string
-	db          *sql.DB
-	cfg         *pmysql.Config
-	dmlMaxRetry uint64
+	workerID     int
+	changefeedID model.ChangeFeedID
+	changefeed   string
+	db           *sql.DB
+	cfg          *pmysql.Config
+	dmlMaxRetry  uint64
 
 	events []*dmlsink.TxnCallbackableEvent
 	rows   int
@@ -79,6 +81,9 @@ type mysqlBackend struct {
 	// Indicate if the CachePrepStmts should be enabled or not
 	cachePrepStmts   bool
 	maxAllowedPacket int64
+
+	// deadLetter is nil if the dead-letter queue is not enabled.
+	deadLetter deadletter.Writer
 }
 
 // NewMySQLBackends creates a new MySQL sink using schema storage
@@ -89,6 +94,7 @@ func NewMySQLBackends(
 	replicaConfig *config.ReplicaConfig,
 	dbConnFactory pmysql.IDBConnectionFactory,
 	statistics *metrics.Statistics,
+	deadLetter deadletter.Writer,
 ) ([]*mysqlBackend, error) {
 	changefeed := fmt.Sprintf("%s.%s", changefeedID.Namespace, changefeedID.ID)
 
@@ -176,12 +182,13 @@ func NewMySQLBackends(
 	backends := make([]*mysqlBackend, 0, cfg.WorkerCount)
 	for i := 0; i < cfg.WorkerCount; i++ {
 		backends = append(backends, &mysqlBackend{
-			workerID:    i,
-			changefeed:  changefeed,
-			db:          db,
-			cfg:         cfg,
-			dmlMaxRetry: defaultDMLMaxRetry,
-			statistics:  statistics,
+			workerID:     i,
+			changefeedID: changefeedID,
+			changefeed:   changefeed,
+			db:           db,
+			cfg:          cfg,
+			dmlMaxRetry:  defaultDMLMaxRetry,
+			statistics:   statistics,
 
 			metricTxnSinkDMLBatchCommit:     txn.SinkDMLBatchCommit.WithLabelValues(changefeedID.Namespace, changefeedID.ID),
 			metricTxnSinkDMLBatchCallback:   txn.SinkDMLBatchCallback.WithLabelValues(changefeedID.Namespace, changefeedID.ID),
@@ -189,6 +196,7 @@ func NewMySQLBackends(
 			stmtCache:                       stmtCache,
 			cachePrepStmts:                  cachePrepStmts,
 			maxAllowedPacket:                maxAllowedPacket,
+			deadLetter:                      deadLetter,
 		})
 	}
 
@@ -230,10 +238,22 @@ func (s *mysqlBackend) Flush(ctx context.Context) (err error) {
 
 	start := time.Now()
 	if err := s.execDMLWithMaxRetries(ctx, dmls); err != nil {
-		if errors.Cause(err) != context.Canceled {
-			log.Error("execute DMLs failed", zap.String("changefeed", s.changefeed), zap.Error(err))
+		if s.deadLetter == nil || !isDeadLetterError(err) {
+			if errors.Cause(err) != context.Canceled {
+				log.Error("execute DMLs failed", zap.String("changefeed", s.changefeed), zap.Error(err))
+			}
+			return errors.Trace(err)
+		}
+		// Some rows are rejected by the downstream, execute the rows one by
+		// one to find them out and route them to the dead-letter queue.
+		log.Warn("execute DMLs failed, fallback to execute rows one by one",
+			zap.String("changefeed", s.changefeed), zap.Error(err))
+		if err := s.execRowsOneByOne(ctx); err != nil {
+			if errors.Cause(err) != context.Canceled {
+				log.Error("execute DMLs failed", zap.String("changefeed", s.changefeed), zap.Error(err))
+			}
+			return errors.Trace(err)
 		}
-		return errors.Trace(err)
 	}
 	startCallback := time.Now()
 	for _, callback := range dmls.callbacks {
@@ -568,46 +588,13 @@ func (s *mysqlBackend) prepareDMLs() *preparedDMLs {
 
 		quoteTable := firstRow.TableInfo.TableName.QuoteString()
 		for _, row := range event.Event.Rows {
-			var query string
-			var args []interface{}
-			// Update Event
-			if len(row.PreColumns) != 0 && len(row.Columns) != 0 {
-				query, args = prepareUpdate(
-					quoteTable,
-					row.PreColumns,
-					row.Columns,
-					row.TableInfo,
-					s.cfg.ForceReplicate)
-				if query != "" {
-					sqls = append(sqls, query)
-					values = append(values, args)
-				}
-				approximateSize += int64(len(query)) + row.ApproximateDataSize
-				continue
-			}
-
-			// Delete Event
-			if len(row.PreColumns) != 0 {
-				query, args = prepareDelete(quoteTable, row.PreColumns, row.TableInfo, s.cfg.ForceReplicate)
-				if query != "" {
-					sqls = append(sqls, query)
-					values = append(values, args)
-				}
+			rowSQLs, rowValues := s.prepareRowDMLs(quoteTable, row, translateToInsert)
+			sqls = append(sqls, rowSQLs...)
+			values = append(values, rowValues...)
+			for _, query := range rowSQLs {
+				approximateSize += int64(len(query))
 			}
-
-			// Insert Event
-			// It will be translated directly into a
-			// INSERT(not in safe mode)
-			// or REPLACE(in safe mode) SQL.
-			if len(row.Columns) != 0 {
-				query, args = prepareReplace(quoteTable, row.Columns, row.TableInfo, true, translateToInsert)
-				if query != "" {
-					sqls = append(sqls, query)
-					values = append(values, args)
-				}
-			}
-
-			approximateSize += int64(len(query)) + row.ApproximateDataSize
+			approximateSize += row.ApproximateDataSize
 		}
 	}
 
@@ -625,6 +612,53 @@ func (s *mysqlBackend) prepareDMLs() *preparedDMLs {
 	}
 }
 
+// prepareRowDMLs converts a single row to query strings and args.
+func (s *mysqlBackend) prepareRowDMLs(
+	quoteTable string, row *model.RowChangedEvent, translateToInsert bool,
+) ([]string, [][]interface{}) {
+	return PrepareRowDMLs(quoteTable, row, translateToInsert, s.cfg.ForceReplicate)
+}
+
+// execRowsOneByOne executes the buffered rows one by one, each in its own
+// transaction. Rows rejected by the downstream are written to the dead-letter
+// queue and skipped, other errors are returned directly.
+// Note that the atomicity of the upstream transactions is broken in this case.
+func (s *mysqlBackend) execRowsOneByOne(ctx context.Context) error {
+	translateToInsert := !s.cfg.SafeMode
+	for _, event := range s.events {
+		if len(event.Event.Rows) == 0 {
+			continue
+		}
+		firstRow := event.Event.Rows[0]
+		translateToInsert = translateToInsert && firstRow.CommitTs > firstRow.ReplicatingTs
+		quoteTable := firstRow.TableInfo.TableName.QuoteString()
+		for _, row := range event.Event.Rows {
+			sqls, values := s.prepareRowDMLs(quoteTable, row, translateToInsert)
+			if len(sqls) == 0 {
+				continue
+			}
+			dmls := &preparedDMLs{
+				startTs:         []model.Ts{row.StartTs},
+				sqls:            sqls,
+				values:          values,
+				rowCount:        1,
+				approximateSize: row.ApproximateDataSize,
+			}
+			err := s.execDMLWithMaxRetries(ctx, dmls)
+			if err == nil {
+				continue
+			}
+			if !isDeadLetterError(err) {
+				return err
+			}
+			if err := s.deadLetter.Write(ctx, deadletter.NewRecord(s.changefeedID, row, err)); err != nil {
+				return errors.Trace(err)
+			}
+		}
+	}
+	return nil
+}
+
 // execute SQLs in the multi statements way.
 func (s *mysqlBackend) multiStmtExecute(
 	ctx context.Context, dmls *preparedDMLs, tx *sql.Tx, writeTimeout time.Duration,
@@ -799,7 +833,15 @@ func (s *mysqlBackend) execDMLWithMaxRetries(pctx context.Context, dmls *prepare
 	}, retry.WithBackoffBaseDelay(pmysql.BackoffBaseDelay.Milliseconds()),
 		retry.WithBackoffMaxDelay(pmysql.BackoffMaxDelay.Milliseconds()),
 		retry.WithMaxTries(s.dmlMaxRetry),
-		retry.WithIsRetryableErr(isRetryableDMLError))
+		retry.WithIsRetryableErr(func(err error) bool {
+			// Retrying can not make the downstream accept the rejected rows,
+			// return the error as soon as possible to route them to the
+			// dead-letter queue.
+			if s.deadLetter != nil && isDeadLetterError(err) {
+				return false
+			}
+			return isRetryableDMLError(err)
+		}))
 }
 
 func wrapMysqlTxnError(err error) error {
@@ -854,6 +896,24 @@ func isRetryableDMLError(err error) bool {
 	return true
 }
 
+// isDeadLetterError returns whether the error means the row is permanently
+// rejected by the downstream because of its data, such rows can be routed
+// to the dead-letter queue.
+func isDeadLetterError(err error) bool {
+	errCode, ok := getSQLErrCode(err)
+	if !ok {
+		return false
+	}
+	switch errCode {
+	case mysql.ErrDataTooLong, mysql.ErrWarnDataOutOfRange,
+		mysql.ErrTruncatedWrongValue, mysql.ErrTruncatedWrongValueForField,
+		mysql.ErrBadNull, mysql.WarnDataTruncated, mysql.ErrInvalidJSONText,
+		mysql.ErrWrongValueForType:
+		return true
+	}
+	return false
+}
+
 func getSQLErrCode(err error) (errors.ErrCode, bool) {
 	mysqlErr, ok := errors.Cause(err).(*dmysql.MySQLError)
 	if !ok {
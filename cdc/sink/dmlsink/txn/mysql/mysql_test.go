@@ -41,6 +41,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/sink/metrics"
 	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/pingcap/tiflow/pkg/sink"
+	"github.com/pingcap/tiflow/pkg/sink/deadletter"
 	pmysql "github.com/pingcap/tiflow/pkg/sink/mysql"
 	"github.com/pingcap/tiflow/pkg/sqlmodel"
 	"github.com/pingcap/tiflow/pkg/util"
@@ -77,7 +78,7 @@ func newMySQLBackend(
 	sinkURI.RawQuery = raw.Encode()
 
 	backends, err := NewMySQLBackends(ctx, changefeedID,
-		sinkURI, replicaConfig, dbConnFactory, statistics)
+		sinkURI, replicaConfig, dbConnFactory, statistics, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -676,6 +677,116 @@ func TestMysqlSinkNotRetryErrDupEntry(t *testing.T) {
 	require.Nil(t, sink.Close())
 }
 
+type mockDeadLetterWriter struct {
+	records []*deadletter.Record
+}
+
+func (w *mockDeadLetterWriter) Write(_ context.Context, records ...*deadletter.Record) error {
+	w.records = append(w.records, records...)
+	return nil
+}
+
+func (w *mockDeadLetterWriter) Count() uint64 {
+	return uint64(len(w.records))
+}
+
+func (w *mockDeadLetterWriter) Close() {}
+
+func TestExecDMLDeadLetter(t *testing.T) {
+	tableInfo := model.BuildTableInfo("s1", "t1", []*model.Column{
+		{
+			Name: "a",
+			Type: mysql.TypeLong,
+			Flag: model.HandleKeyFlag | model.PrimaryKeyFlag,
+		},
+	}, [][]int{{0}})
+	rows := []*model.RowChangedEvent{
+		{
+			StartTs:         1,
+			CommitTs:        2,
+			TableInfo:       tableInfo,
+			PhysicalTableID: 1,
+			Columns: model.Columns2ColumnDatas([]*model.Column{
+				{
+					Name:  "a",
+					Value: 1,
+				},
+			}, tableInfo),
+		},
+		{
+			StartTs:         1,
+			CommitTs:        2,
+			TableInfo:       tableInfo,
+			PhysicalTableID: 1,
+			Columns: model.Columns2ColumnDatas([]*model.Column{
+				{
+					Name:  "a",
+					Value: 2,
+				},
+			}, tableInfo),
+		},
+	}
+
+	errDataTooLong := &dmysql.MySQLError{
+		Number:  mysql.ErrDataTooLong,
+		Message: "Data too long for column 'a'",
+	}
+
+	dbConnFactory := pmysql.NewDBConnectionFactoryForTest()
+	dbConnFactory.SetStandardConnectionFactory(func(ctx context.Context, dsnStr string) (*sql.DB, error) {
+		db, mock := newTestMockDB(t)
+		// The batch is rejected without retry.
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO `s1`.`t1` (`a`) VALUES (?),(?)").
+			WithArgs(1, 2).
+			WillReturnError(errDataTooLong)
+		mock.ExpectRollback()
+		// Then the rows are executed one by one.
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO `s1`.`t1` (`a`) VALUES (?)").
+			WithArgs(1).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO `s1`.`t1` (`a`) VALUES (?)").
+			WithArgs(2).
+			WillReturnError(errDataTooLong)
+		mock.ExpectRollback()
+		mock.ExpectClose()
+		return db, nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	changefeed := "test-changefeed"
+	sinkURI, err := url.Parse(
+		"mysql://127.0.0.1:4000/?time-zone=UTC&worker-count=1&cache-prep-stmts=false")
+	require.Nil(t, err)
+	sink, err := newMySQLBackend(ctx, model.DefaultChangeFeedID(changefeed), sinkURI,
+		config.GetDefaultReplicaConfig(), dbConnFactory)
+	require.Nil(t, err)
+	writer := &mockDeadLetterWriter{}
+	sink.deadLetter = writer
+
+	callbackCalled := false
+	_ = sink.OnTxnEvent(&dmlsink.TxnCallbackableEvent{
+		Event:    &model.SingleTableTxn{Rows: rows},
+		Callback: func() { callbackCalled = true },
+	})
+	require.Nil(t, sink.Flush(context.Background()))
+	require.True(t, callbackCalled)
+
+	require.Len(t, writer.records, 1)
+	record := writer.records[0]
+	require.Equal(t, "s1", record.Schema)
+	require.Equal(t, "t1", record.Table)
+	require.Equal(t, uint64(2), record.CommitTs)
+	require.Equal(t, map[string]interface{}{"a": "2"}, record.Columns)
+	require.Contains(t, record.Error, "Data too long")
+
+	require.Nil(t, sink.Close())
+}
+
 func TestNewMySQLBackendExecDDL(t *testing.T) {
 	// TODO: fill it.
 }
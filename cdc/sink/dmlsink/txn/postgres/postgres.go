@@ -0,0 +1,452 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink"
+	"github.com/pingcap/tiflow/cdc/sink/metrics"
+	"github.com/pingcap/tiflow/cdc/sink/metrics/txn"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/retry"
+	ppostgres "github.com/pingcap/tiflow/pkg/sink/postgres"
+	"github.com/pingcap/tiflow/pkg/sqlmodel"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+)
+
+const (
+	// Max interval for flushing transactions to the downstream.
+	maxFlushInterval = 10 * time.Millisecond
+
+	defaultDMLMaxRetry uint64 = 8
+)
+
+type postgresBackend struct {
+	workerID    int
+	changefeed  string
+	db          *sql.DB
+	cfg         *ppostgres.Config
+	dmlMaxRetry uint64
+
+	events []*dmlsink.TxnCallbackableEvent
+	rows   int
+
+	statistics                    *metrics.Statistics
+	metricTxnSinkDMLBatchCommit   prometheus.Observer
+	metricTxnSinkDMLBatchCallback prometheus.Observer
+}
+
+// NewPostgresBackends creates PostgreSQL backends which share one connection pool.
+func NewPostgresBackends(
+	ctx context.Context,
+	changefeedID model.ChangeFeedID,
+	sinkURI *url.URL,
+	replicaConfig *config.ReplicaConfig,
+	dbConnFactory ppostgres.ConnectionFactory,
+	statistics *metrics.Statistics,
+) ([]*postgresBackend, error) {
+	changefeed := fmt.Sprintf("%s.%s", changefeedID.Namespace, changefeedID.ID)
+
+	cfg := ppostgres.NewConfig()
+	if err := cfg.Apply(sinkURI, replicaConfig); err != nil {
+		return nil, err
+	}
+
+	db, err := dbConnFactory(ctx, cfg.DSN)
+	if err != nil {
+		return nil, err
+	}
+	db.SetMaxIdleConns(cfg.WorkerCount)
+	db.SetMaxOpenConns(cfg.WorkerCount)
+
+	backends := make([]*postgresBackend, 0, cfg.WorkerCount)
+	for i := 0; i < cfg.WorkerCount; i++ {
+		backends = append(backends, &postgresBackend{
+			workerID:    i,
+			changefeed:  changefeed,
+			db:          db,
+			cfg:         cfg,
+			dmlMaxRetry: defaultDMLMaxRetry,
+			statistics:  statistics,
+
+			metricTxnSinkDMLBatchCommit:   txn.SinkDMLBatchCommit.WithLabelValues(changefeedID.Namespace, changefeedID.ID),
+			metricTxnSinkDMLBatchCallback: txn.SinkDMLBatchCallback.WithLabelValues(changefeedID.Namespace, changefeedID.ID),
+		})
+	}
+
+	log.Info("PostgreSQL backends is created",
+		zap.String("changefeed", changefeed),
+		zap.Int("workerCount", cfg.WorkerCount),
+		zap.Bool("safeMode", cfg.SafeMode))
+	return backends, nil
+}
+
+// OnTxnEvent implements interface backend.
+// It adds the event to the buffer, and return true if it needs flush immediately.
+func (s *postgresBackend) OnTxnEvent(event *dmlsink.TxnCallbackableEvent) (needFlush bool) {
+	s.events = append(s.events, event)
+	s.rows += len(event.Event.Rows)
+	return s.rows >= s.cfg.MaxTxnRow
+}
+
+// Flush implements interface backend.
+func (s *postgresBackend) Flush(ctx context.Context) (err error) {
+	if s.rows == 0 {
+		return
+	}
+
+	for _, event := range s.events {
+		s.statistics.ObserveRows(event.Event.Rows...)
+	}
+
+	dmls := s.prepareDMLs()
+	log.Debug("prepare DMLs", zap.String("changefeed", s.changefeed), zap.Any("rows", s.rows),
+		zap.Strings("sqls", dmls.sqls), zap.Any("values", dmls.values))
+
+	start := time.Now()
+	if err := s.execDMLWithMaxRetries(ctx, dmls); err != nil {
+		if errors.Cause(err) != context.Canceled {
+			log.Error("execute DMLs failed", zap.String("changefeed", s.changefeed), zap.Error(err))
+		}
+		return errors.Trace(err)
+	}
+	startCallback := time.Now()
+	for _, callback := range dmls.callbacks {
+		callback()
+	}
+	s.metricTxnSinkDMLBatchCommit.Observe(startCallback.Sub(start).Seconds())
+	s.metricTxnSinkDMLBatchCallback.Observe(time.Since(startCallback).Seconds())
+
+	// Be friently to GC.
+	for i := 0; i < len(s.events); i++ {
+		s.events[i] = nil
+	}
+	if cap(s.events) > 1024 {
+		s.events = make([]*dmlsink.TxnCallbackableEvent, 0)
+	}
+	s.events = s.events[:0]
+	s.rows = 0
+	return
+}
+
+// Close implements interface backend.
+func (s *postgresBackend) Close() (err error) {
+	if s.db != nil {
+		err = s.db.Close()
+		s.db = nil
+	}
+	return
+}
+
+// MaxFlushInterval implements interface backend.
+func (s *postgresBackend) MaxFlushInterval() time.Duration {
+	return maxFlushInterval
+}
+
+type preparedDMLs struct {
+	startTs         []model.Ts
+	sqls            []string
+	values          [][]interface{}
+	callbacks       []dmlsink.CallbackFunc
+	rowCount        int
+	approximateSize int64
+}
+
+// prepareDMLs converts the buffered transactions to PostgreSQL statements.
+// In each transaction, rows are deleted before they are updated or inserted,
+// so a row whose key is moved to another row in the same transaction is not
+// lost.
+func (s *postgresBackend) prepareDMLs() *preparedDMLs {
+	startTs := make([]uint64, 0, len(s.events))
+	sqls := make([]string, 0, s.rows)
+	values := make([][]interface{}, 0, s.rows)
+	callbacks := make([]dmlsink.CallbackFunc, 0, len(s.events))
+
+	rowCount := 0
+	approximateSize := int64(0)
+	for _, event := range s.events {
+		if len(event.Event.Rows) == 0 {
+			continue
+		}
+		rowCount += len(event.Event.Rows)
+
+		firstRow := event.Event.Rows[0]
+		if len(startTs) == 0 || startTs[len(startTs)-1] != firstRow.StartTs {
+			startTs = append(startTs, firstRow.StartTs)
+		}
+		if event.Callback != nil {
+			callbacks = append(callbacks, event.Callback)
+		}
+
+		// translateToInsert controls whether the rows are inserted and updated
+		// directly, like the MySQL sink it's only safe when the rows have never
+		// been replicated before.
+		translateToInsert := !s.cfg.SafeMode && firstRow.CommitTs > firstRow.ReplicatingTs
+		genInsertSQL := sqlmodel.GenPostgresUpsertSQL
+		if translateToInsert {
+			genInsertSQL = sqlmodel.GenPostgresInsertSQL
+		}
+
+		deletes, updates, inserts := s.groupRows(event.Event.Rows, translateToInsert)
+		appendSQL := func(sql string, args []interface{}) {
+			sqls = append(sqls, sql)
+			values = append(values, args)
+			approximateSize += int64(len(sql))
+		}
+		for _, rows := range deletes {
+			appendSQL(sqlmodel.GenPostgresDeleteSQL(rows...))
+		}
+		for _, row := range updates {
+			appendSQL(sqlmodel.GenPostgresUpdateSQL(row))
+		}
+		for _, rows := range inserts {
+			appendSQL(genInsertSQL(rows...))
+		}
+		for _, row := range event.Event.Rows {
+			approximateSize += row.ApproximateDataSize
+		}
+	}
+
+	if len(callbacks) == 0 {
+		callbacks = nil
+	}
+
+	return &preparedDMLs{
+		startTs:         startTs,
+		sqls:            sqls,
+		values:          values,
+		callbacks:       callbacks,
+		rowCount:        rowCount,
+		approximateSize: approximateSize,
+	}
+}
+
+// groupRows splits the rows of a transaction into batches of deletes and
+// inserts, and the updates which are executed one by one. An update which
+// changes the identity of the row is translated to a delete of the old row and
+// an insert of the new row. If translateToInsert is false, the updates are
+// executed as upserts, and the rows of a table without a PK or NOT NULL UK are
+// deleted before they are inserted, so they are not duplicated when they are
+// replicated again.
+func (s *postgresBackend) groupRows(
+	rows []*model.RowChangedEvent, translateToInsert bool,
+) (deletes [][]*sqlmodel.RowChange, updates []*sqlmodel.RowChange, inserts [][]*sqlmodel.RowChange) {
+	var deleteBatch, insertBatch []*sqlmodel.RowChange
+	appendDelete := func(change *sqlmodel.RowChange) {
+		// PostgreSQL doesn't support DELETE ... LIMIT, so rows without a
+		// unique identity are deleted one by one.
+		if !change.HasNotNullUniqueIdx() {
+			deletes = append(deletes, []*sqlmodel.RowChange{change})
+			return
+		}
+		deleteBatch = append(deleteBatch, change)
+		if len(deleteBatch) >= s.cfg.MaxTxnRow {
+			deletes = append(deletes, deleteBatch)
+			deleteBatch = nil
+		}
+	}
+	appendInsert := func(row *model.RowChangedEvent, change *sqlmodel.RowChange) {
+		if !translateToInsert && !change.HasNotNullUniqueIdx() {
+			appendDelete(convert2RowChange(postImageAsDelete(row)))
+		}
+		insertBatch = append(insertBatch, change)
+		if len(insertBatch) >= s.cfg.MaxTxnRow {
+			inserts = append(inserts, insertBatch)
+			insertBatch = nil
+		}
+	}
+
+	for _, row := range rows {
+		change := convert2RowChange(row)
+		switch change.Type() {
+		case sqlmodel.RowChangeInsert:
+			appendInsert(row, change)
+		case sqlmodel.RowChangeDelete:
+			appendDelete(change)
+		case sqlmodel.RowChangeUpdate:
+			if change.IsIdentityUpdated() {
+				pre, post := change.SplitUpdate()
+				appendDelete(pre)
+				appendInsert(row, post)
+			} else if translateToInsert {
+				updates = append(updates, change)
+			} else {
+				appendInsert(row, change)
+			}
+		}
+	}
+	if len(deleteBatch) > 0 {
+		deletes = append(deletes, deleteBatch)
+	}
+	if len(insertBatch) > 0 {
+		inserts = append(inserts, insertBatch)
+	}
+	return
+}
+
+// postImageAsDelete returns a delete event of the row after it's changed.
+func postImageAsDelete(row *model.RowChangedEvent) *model.RowChangedEvent {
+	deleted := *row
+	deleted.PreColumns, deleted.Columns = row.Columns, nil
+	return &deleted
+}
+
+// convert2RowChange converts the row to a sqlmodel.RowChange, the column
+// values are converted to the representation accepted by PostgreSQL.
+func convert2RowChange(row *model.RowChangedEvent) *sqlmodel.RowChange {
+	tableInfo := row.TableInfo
+	tidbTableInfo := tableInfo.TableInfo
+	// RowChangedEvent doesn't contain data for virtual columns,
+	// so we need to create a new table info without virtual columns before pass it to NewRowChange.
+	if tableInfo.HasVirtualColumns() {
+		tidbTableInfo = model.BuildTiDBTableInfoWithoutVirtualColumns(tidbTableInfo)
+	}
+
+	convert := func(cols []*model.ColumnData) []interface{} {
+		if len(cols) == 0 {
+			return nil
+		}
+		values := make([]interface{}, 0, len(cols))
+		for _, col := range cols {
+			if col == nil {
+				values = append(values, nil)
+				continue
+			}
+			colInfo := tableInfo.ForceGetColumnInfo(col.ColumnID)
+			values = append(values, ppostgres.ConvertValue(colInfo, col.Value))
+		}
+		return values
+	}
+
+	res := sqlmodel.NewRowChange(
+		&tableInfo.TableName,
+		nil,
+		convert(row.PreColumns),
+		convert(row.Columns),
+		tidbTableInfo,
+		nil, nil)
+	res.SetApproximateDataSize(row.ApproximateDataSize)
+	return res
+}
+
+func (s *postgresBackend) execDMLWithMaxRetries(pctx context.Context, dmls *preparedDMLs) error {
+	if len(dmls.sqls) != len(dmls.values) {
+		log.Error("unexpected number of sqls and values",
+			zap.String("changefeed", s.changefeed),
+			zap.Strings("sqls", dmls.sqls),
+			zap.Any("values", dmls.values))
+		return cerror.ErrUnexpected.FastGenByArgs("unexpected number of sqls and values")
+	}
+
+	start := time.Now()
+	return retry.Do(pctx, func() error {
+		err := s.statistics.RecordBatchExecution(func() (int, int64, error) {
+			tx, err := s.db.BeginTx(pctx, nil)
+			if err != nil {
+				return 0, 0, logDMLTxnErr(
+					cerror.WrapError(cerror.ErrPostgresTxnError, err),
+					start, s.changefeed, "BEGIN", dmls.rowCount, dmls.startTs)
+			}
+
+			for i, query := range dmls.sqls {
+				if _, err := tx.ExecContext(pctx, query, dmls.values[i]...); err != nil {
+					err := logDMLTxnErr(
+						cerror.WrapError(cerror.ErrPostgresTxnError, err),
+						start, s.changefeed, query, dmls.rowCount, dmls.startTs)
+					if rbErr := tx.Rollback(); rbErr != nil {
+						if errors.Cause(rbErr) != context.Canceled {
+							log.Warn("failed to rollback txn", zap.String("changefeed", s.changefeed), zap.Error(rbErr))
+						}
+					}
+					return 0, 0, err
+				}
+			}
+
+			if err = tx.Commit(); err != nil {
+				return 0, 0, logDMLTxnErr(
+					cerror.WrapError(cerror.ErrPostgresTxnError, err),
+					start, s.changefeed, "COMMIT", dmls.rowCount, dmls.startTs)
+			}
+			return dmls.rowCount, dmls.approximateSize, nil
+		})
+		if err != nil {
+			return errors.Trace(err)
+		}
+		log.Debug("Exec Rows succeeded",
+			zap.String("changefeed", s.changefeed),
+			zap.Int("workerID", s.workerID),
+			zap.Int("numOfRows", dmls.rowCount))
+		return nil
+	}, retry.WithBackoffBaseDelay(ppostgres.BackoffBaseDelay.Milliseconds()),
+		retry.WithBackoffMaxDelay(ppostgres.BackoffMaxDelay.Milliseconds()),
+		retry.WithMaxTries(s.dmlMaxRetry),
+		retry.WithIsRetryableErr(isRetryableDMLError))
+}
+
+func logDMLTxnErr(
+	err error, start time.Time, changefeed string,
+	query string, count int, startTs []model.Ts,
+) error {
+	if len(query) > 1024 {
+		query = query[:1024]
+	}
+	if isRetryableDMLError(err) {
+		log.Warn("execute DMLs with error, retry later",
+			zap.Error(err), zap.Duration("duration", time.Since(start)),
+			zap.String("query", query), zap.Int("count", count),
+			zap.Uint64s("startTs", startTs),
+			zap.String("changefeed", changefeed))
+	} else {
+		log.Error("execute DMLs with error, can not retry",
+			zap.Error(err), zap.Duration("duration", time.Since(start)),
+			zap.String("query", query), zap.Int("count", count),
+			zap.String("changefeed", changefeed))
+	}
+	return errors.WithMessage(err, fmt.Sprintf("Failed query info: %s; ", query))
+}
+
+// isRetryableDMLError returns false for the errors which can not be fixed by
+// retrying, like data exceptions, integrity constraint violations and
+// undefined tables.
+func isRetryableDMLError(err error) bool {
+	if !cerror.IsRetryableError(err) {
+		return false
+	}
+
+	pqErr, ok := errors.Cause(err).(*pq.Error)
+	if !ok {
+		return true
+	}
+	switch pqErr.Code.Class() {
+	case "22", "23", "42":
+		return false
+	}
+	return true
+}
+
+// Only for testing.
+func (s *postgresBackend) setDMLMaxRetry(maxRetry uint64) {
+	s.dmlMaxRetry = maxRetry
+}
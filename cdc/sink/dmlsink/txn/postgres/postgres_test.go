@@ -0,0 +1,228 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"net/url"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink"
+	"github.com/pingcap/tiflow/cdc/sink/metrics"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/sink"
+	ppostgres "github.com/pingcap/tiflow/pkg/sink/postgres"
+	"github.com/pingcap/tiflow/pkg/util"
+	"github.com/stretchr/testify/require"
+)
+
+func newPostgresBackend(
+	t *testing.T,
+	safeMode bool,
+	dbConnFactory ppostgres.ConnectionFactory,
+) *postgresBackend {
+	changefeedID := model.DefaultChangeFeedID("test-changefeed")
+	sinkURI, err := url.Parse("postgres://127.0.0.1:5432/test?worker-count=1&sslmode=disable")
+	require.NoError(t, err)
+	replicaConfig := config.GetDefaultReplicaConfig()
+	replicaConfig.Sink.SafeMode = util.AddressOf(safeMode)
+	backends, err := NewPostgresBackends(context.Background(), changefeedID, sinkURI, replicaConfig,
+		dbConnFactory, metrics.NewStatistics(changefeedID, sink.TxnSink))
+	require.NoError(t, err)
+	require.Len(t, backends, 1)
+	return backends[0]
+}
+
+func newTestMockDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	return db, mock
+}
+
+func newRow(tableInfo *model.TableInfo, pre, post []*model.Column) *model.RowChangedEvent {
+	row := &model.RowChangedEvent{
+		StartTs:   1,
+		CommitTs:  2,
+		TableInfo: tableInfo,
+	}
+	if pre != nil {
+		row.PreColumns = model.Columns2ColumnDatas(pre, tableInfo)
+	}
+	if post != nil {
+		row.Columns = model.Columns2ColumnDatas(post, tableInfo)
+	}
+	return row
+}
+
+func TestPostgresBackendFlush(t *testing.T) {
+	t.Parallel()
+
+	tableInfo := model.BuildTableInfo("s1", "t1", []*model.Column{
+		{Name: "a", Type: mysql.TypeLong, Flag: model.HandleKeyFlag | model.PrimaryKeyFlag},
+		{Name: "b", Type: mysql.TypeVarchar, Charset: "utf8mb4"},
+	}, [][]int{{0}})
+	columns := func(a int, b string) []*model.Column {
+		return []*model.Column{{Name: "a", Value: a}, {Name: "b", Value: b}}
+	}
+	rows := []*model.RowChangedEvent{
+		newRow(tableInfo, nil, columns(1, "x")),
+		newRow(tableInfo, columns(2, "x"), columns(2, "y")),
+		// the key is updated.
+		newRow(tableInfo, columns(3, "x"), columns(4, "z")),
+		newRow(tableInfo, columns(5, "x"), nil),
+	}
+
+	backend := newPostgresBackend(t, true, func(ctx context.Context, dsnStr string) (*sql.DB, error) {
+		require.Equal(t, "postgres://127.0.0.1:5432/test?sslmode=disable", dsnStr)
+		db, mock := newTestMockDB(t)
+		mock.ExpectBegin()
+		mock.ExpectExec(`DELETE FROM "s1"."t1" WHERE ("a" = $1) OR ("a" = $2)`).
+			WithArgs(3, 5).
+			WillReturnResult(sqlmock.NewResult(2, 2))
+		mock.ExpectExec(`INSERT INTO "s1"."t1" ("a","b") VALUES ($1,$2),($3,$4),($5,$6) `+
+			`ON CONFLICT ("a") DO UPDATE SET "b"=EXCLUDED."b"`).
+			WithArgs(1, "x", 2, "y", 4, "z").
+			WillReturnResult(sqlmock.NewResult(3, 3))
+		mock.ExpectCommit()
+		mock.ExpectClose()
+		return db, nil
+	})
+
+	flushed := false
+	needFlush := backend.OnTxnEvent(&dmlsink.TxnCallbackableEvent{
+		Event:    &model.SingleTableTxn{Rows: rows},
+		Callback: func() { flushed = true },
+	})
+	require.False(t, needFlush)
+	require.NoError(t, backend.Flush(context.Background()))
+	require.True(t, flushed)
+	require.NoError(t, backend.Close())
+}
+
+func TestPrepareDMLsWithoutKey(t *testing.T) {
+	t.Parallel()
+
+	tableInfo := model.BuildTableInfo("s1", "t1", []*model.Column{
+		{Name: "a", Type: mysql.TypeLong},
+		{Name: "b", Type: mysql.TypeLonglong, Flag: model.UnsignedFlag},
+	}, nil)
+	backend := &postgresBackend{cfg: ppostgres.NewConfig()}
+	backend.OnTxnEvent(&dmlsink.TxnCallbackableEvent{
+		Event: &model.SingleTableTxn{Rows: []*model.RowChangedEvent{
+			newRow(tableInfo, []*model.Column{{Name: "a", Value: 1}, {Name: "b", Value: uint64(1)}}, nil),
+			newRow(tableInfo, []*model.Column{{Name: "a", Value: nil}, {Name: "b", Value: uint64(1 << 63)}}, nil),
+			newRow(tableInfo, nil, []*model.Column{{Name: "a", Value: 2}, {Name: "b", Value: uint64(2)}}),
+		}},
+	})
+
+	dmls := backend.prepareDMLs()
+	require.Equal(t, []string{
+		`DELETE FROM "s1"."t1" WHERE (ctid = (SELECT ctid FROM "s1"."t1" WHERE "a" = $1 AND "b" = $2 LIMIT 1))`,
+		`DELETE FROM "s1"."t1" WHERE (ctid = (SELECT ctid FROM "s1"."t1" WHERE "a" IS NULL AND "b" = $1 LIMIT 1))`,
+		`INSERT INTO "s1"."t1" ("a","b") VALUES ($1,$2)`,
+	}, dmls.sqls)
+	require.Equal(t, [][]interface{}{
+		{1, int64(1)},
+		{"9223372036854775808"},
+		{2, int64(2)},
+	}, dmls.values)
+	require.Equal(t, 3, dmls.rowCount)
+
+	// in safe mode, the inserted row is deleted first.
+	backend.cfg.SafeMode = true
+	dmls = backend.prepareDMLs()
+	require.Equal(t, []string{
+		`DELETE FROM "s1"."t1" WHERE (ctid = (SELECT ctid FROM "s1"."t1" WHERE "a" = $1 AND "b" = $2 LIMIT 1))`,
+		`DELETE FROM "s1"."t1" WHERE (ctid = (SELECT ctid FROM "s1"."t1" WHERE "a" IS NULL AND "b" = $1 LIMIT 1))`,
+		`DELETE FROM "s1"."t1" WHERE (ctid = (SELECT ctid FROM "s1"."t1" WHERE "a" = $1 AND "b" = $2 LIMIT 1))`,
+		`INSERT INTO "s1"."t1" ("a","b") VALUES ($1,$2)`,
+	}, dmls.sqls)
+	require.Equal(t, [][]interface{}{
+		{1, int64(1)},
+		{"9223372036854775808"},
+		{2, int64(2)},
+		{2, int64(2)},
+	}, dmls.values)
+}
+
+func TestPrepareDMLsSafeMode(t *testing.T) {
+	t.Parallel()
+
+	tableInfo := model.BuildTableInfo("s1", "t1", []*model.Column{
+		{Name: "a", Type: mysql.TypeLong, Flag: model.HandleKeyFlag | model.PrimaryKeyFlag},
+		{Name: "b", Type: mysql.TypeLong},
+	}, [][]int{{0}})
+	columns := func(a, b int) []*model.Column {
+		return []*model.Column{{Name: "a", Value: a}, {Name: "b", Value: b}}
+	}
+	rows := []*model.RowChangedEvent{
+		newRow(tableInfo, nil, columns(1, 1)),
+		newRow(tableInfo, columns(2, 1), columns(2, 2)),
+	}
+	backend := &postgresBackend{cfg: ppostgres.NewConfig()}
+	backend.OnTxnEvent(&dmlsink.TxnCallbackableEvent{Event: &model.SingleTableTxn{Rows: rows}})
+
+	// the rows are replicated for the first time.
+	dmls := backend.prepareDMLs()
+	require.Equal(t, []string{
+		`UPDATE "s1"."t1" SET "a"=$1,"b"=$2 WHERE "a" = $3`,
+		`INSERT INTO "s1"."t1" ("a","b") VALUES ($1,$2)`,
+	}, dmls.sqls)
+	require.Equal(t, [][]interface{}{{2, 2, 2}, {1, 1}}, dmls.values)
+
+	// the rows may have been replicated before.
+	for _, row := range rows {
+		row.ReplicatingTs = row.CommitTs
+	}
+	dmls = backend.prepareDMLs()
+	require.Equal(t, []string{
+		`INSERT INTO "s1"."t1" ("a","b") VALUES ($1,$2),($3,$4) ON CONFLICT ("a") DO UPDATE SET "b"=EXCLUDED."b"`,
+	}, dmls.sqls)
+	require.Equal(t, [][]interface{}{{1, 1, 2, 2}}, dmls.values)
+}
+
+func TestPostgresBackendNotRetryIntegrityError(t *testing.T) {
+	t.Parallel()
+
+	errDup := &pq.Error{Code: "23505", Message: "duplicate key value violates unique constraint"}
+	tableInfo := model.BuildTableInfo("s1", "t1", []*model.Column{
+		{Name: "a", Type: mysql.TypeLong, Flag: model.HandleKeyFlag | model.PrimaryKeyFlag},
+	}, [][]int{{0}})
+
+	backend := newPostgresBackend(t, true, func(ctx context.Context, dsnStr string) (*sql.DB, error) {
+		db, mock := newTestMockDB(t)
+		mock.ExpectBegin()
+		mock.ExpectExec(`INSERT INTO "s1"."t1" ("a") VALUES ($1) ON CONFLICT ("a") DO NOTHING`).
+			WithArgs(1).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit().WillReturnError(errDup)
+		mock.ExpectClose()
+		return db, nil
+	})
+	backend.setDMLMaxRetry(3)
+	backend.OnTxnEvent(&dmlsink.TxnCallbackableEvent{
+		Event: &model.SingleTableTxn{Rows: []*model.RowChangedEvent{
+			newRow(tableInfo, nil, []*model.Column{{Name: "a", Value: 1}}),
+		}},
+	})
+	err := backend.Flush(context.Background())
+	require.Equal(t, errDup, errors.Cause(err))
+	require.NoError(t, backend.Close())
+}
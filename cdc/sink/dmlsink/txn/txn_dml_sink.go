@@ -22,12 +22,15 @@ import (
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/sink/dmlsink"
 	"github.com/pingcap/tiflow/cdc/sink/dmlsink/txn/mysql"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink/txn/postgres"
 	"github.com/pingcap/tiflow/cdc/sink/metrics"
 	"github.com/pingcap/tiflow/cdc/sink/tablesink/state"
 	"github.com/pingcap/tiflow/pkg/causality"
 	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/pingcap/tiflow/pkg/sink"
+	"github.com/pingcap/tiflow/pkg/sink/deadletter"
 	pmysql "github.com/pingcap/tiflow/pkg/sink/mysql"
+	ppostgres "github.com/pingcap/tiflow/pkg/sink/postgres"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -54,6 +57,8 @@ type dmlSink struct {
 	dead chan struct{}
 
 	statistics *metrics.Statistics
+	// deadLetter is nil if the dead-letter queue is not enabled.
+	deadLetter deadletter.Writer
 
 	scheme string
 }
@@ -62,6 +67,10 @@ type dmlSink struct {
 // Exported for testing.
 var GetDBConnImpl pmysql.IDBConnectionFactory = &pmysql.DBConnectionFactory{}
 
+// GetPostgresDBConnImpl is the implementation of ppostgres.ConnectionFactory.
+// Exported for testing.
+var GetPostgresDBConnImpl ppostgres.ConnectionFactory = ppostgres.CreatePostgresDBConn
+
 // NewMySQLSink creates a mysql dmlSink with given parameters.
 func NewMySQLSink(
 	ctx context.Context,
@@ -74,7 +83,49 @@ func NewMySQLSink(
 	ctx, cancel := context.WithCancel(ctx)
 	statistics := metrics.NewStatistics(changefeedID, sink.TxnSink)
 
-	backendImpls, err := mysql.NewMySQLBackends(ctx, changefeedID, sinkURI, replicaConfig, GetDBConnImpl, statistics)
+	deadLetter, err := deadletter.NewWriter(ctx, changefeedID, replicaConfig.Sink.DeadLetter)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	backendImpls, err := mysql.NewMySQLBackends(ctx, changefeedID, sinkURI, replicaConfig, GetDBConnImpl, statistics, deadLetter)
+	if err != nil {
+		if deadLetter != nil {
+			deadLetter.Close()
+		}
+		cancel()
+		return nil, err
+	}
+
+	backends := make([]backend, 0, len(backendImpls))
+	for _, impl := range backendImpls {
+		backends = append(backends, impl)
+	}
+
+	s := newSink(ctx, changefeedID, backends, errCh, conflictDetectorSlots)
+	s.statistics = statistics
+	s.deadLetter = deadLetter
+	s.cancel = cancel
+	s.scheme = sink.GetScheme(sinkURI)
+
+	return s, nil
+}
+
+// NewPostgresSink creates a PostgreSQL dmlSink with given parameters.
+func NewPostgresSink(
+	ctx context.Context,
+	changefeedID model.ChangeFeedID,
+	sinkURI *url.URL,
+	replicaConfig *config.ReplicaConfig,
+	errCh chan<- error,
+	conflictDetectorSlots uint64,
+) (*dmlSink, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	statistics := metrics.NewStatistics(changefeedID, sink.TxnSink)
+
+	backendImpls, err := postgres.NewPostgresBackends(ctx, changefeedID, sinkURI, replicaConfig,
+		GetPostgresDBConnImpl, statistics)
 	if err != nil {
 		cancel()
 		return nil, err
@@ -171,6 +222,17 @@ func (s *dmlSink) Close() {
 	if s.statistics != nil {
 		s.statistics.Close()
 	}
+	if s.deadLetter != nil {
+		s.deadLetter.Close()
+	}
+}
+
+// DeadLetterCount returns the number of rows written to the dead-letter queue.
+func (s *dmlSink) DeadLetterCount() uint64 {
+	if s.deadLetter == nil {
+		return 0
+	}
+	return s.deadLetter.Count()
 }
 
 // Dead checks whether it's dead or not.
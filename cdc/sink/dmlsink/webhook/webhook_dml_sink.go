@@ -0,0 +1,213 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"net/url"
+	"sync"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink"
+	"github.com/pingcap/tiflow/cdc/sink/metrics"
+	"github.com/pingcap/tiflow/cdc/sink/tablesink/state"
+	"github.com/pingcap/tiflow/cdc/sink/util"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink"
+	"github.com/pingcap/tiflow/pkg/sink/codec/builder"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"github.com/pingcap/tiflow/pkg/sink/webhook"
+	tiflowutil "github.com/pingcap/tiflow/pkg/util"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+// Assert EventSink[E event.TableEvent] implementation
+var _ dmlsink.EventSink[*model.SingleTableTxn] = (*DMLSink)(nil)
+
+// DMLSink is the webhook sink.
+// It posts the encoded events to a http endpoint.
+type DMLSink struct {
+	// changefeedID indicates this sink belongs to which processor(changefeed).
+	changefeedID model.ChangeFeedID
+
+	alive struct {
+		sync.RWMutex
+		isDead bool
+	}
+
+	// workers are used to send the events, the events of one table are
+	// always sent by the same worker to keep them in order.
+	workers []*worker
+	client  *webhook.Client
+	// statistics is used to record DML metrics.
+	statistics *metrics.Statistics
+
+	cancel context.CancelCauseFunc
+	wg     sync.WaitGroup
+	dead   chan struct{}
+
+	scheme               string
+	outputRawChangeEvent bool
+}
+
+// NewDMLSink creates a webhook dmlSink.
+func NewDMLSink(
+	ctx context.Context,
+	changefeedID model.ChangeFeedID,
+	sinkURI *url.URL,
+	replicaConfig *config.ReplicaConfig,
+	errCh chan error,
+) (*DMLSink, error) {
+	cfg := webhook.NewConfig()
+	if err := cfg.Apply(sinkURI, replicaConfig); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	protocol, err := util.GetProtocol(tiflowutil.GetOrZero(replicaConfig.Sink.Protocol))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if !util.IsWebhookSupportedProtocols(protocol) {
+		return nil, cerror.ErrSinkURIInvalid.
+			GenWithStackByArgs("unsupported protocol, " +
+				"webhook sink currently only support these protocols: [canal-json, debezium, simple]")
+	}
+
+	encoderConfig, err := util.GetEncoderConfig(changefeedID, sinkURI, protocol,
+		replicaConfig, config.DefaultMaxMessageBytes)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if encoderConfig.EncodingFormat != common.EncodingFormatJSON {
+		return nil, cerror.ErrSinkURIInvalid.
+			GenWithStackByArgs("webhook sink only support the json encoding format")
+	}
+	encoderBuilder, err := builder.NewRowEventEncoderBuilder(ctx, encoderConfig)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrWebhookInvalidConfig, err)
+	}
+
+	s := &DMLSink{
+		changefeedID:         changefeedID,
+		client:               webhook.NewClient(changefeedID, cfg),
+		statistics:           metrics.NewStatistics(changefeedID, sink.TxnSink),
+		dead:                 make(chan struct{}),
+		scheme:               sink.GetScheme(sinkURI),
+		outputRawChangeEvent: cfg.OutputRawChangeEvent,
+	}
+	s.workers = make([]*worker, 0, cfg.WorkerCount)
+	for i := 0; i < cfg.WorkerCount; i++ {
+		s.workers = append(s.workers, newWorker(changefeedID, i,
+			encoderBuilder.Build(), s.client, cfg.MaxBatchRows, s.statistics))
+	}
+
+	ctx, s.cancel = context.WithCancelCause(ctx)
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		err := s.run(ctx)
+
+		s.alive.Lock()
+		s.alive.isDead = true
+		for _, w := range s.workers {
+			w.close()
+		}
+		s.alive.Unlock()
+		close(s.dead)
+
+		if err != nil && errors.Cause(err) != context.Canceled {
+			select {
+			case errCh <- err:
+				log.Warn("webhook dml sink meet error",
+					zap.String("namespace", s.changefeedID.Namespace),
+					zap.String("changefeed", s.changefeedID.ID),
+					zap.Error(err))
+			default:
+				log.Info("webhook dml sink meet error, ignored",
+					zap.String("namespace", s.changefeedID.Namespace),
+					zap.String("changefeed", s.changefeedID.ID),
+					zap.Error(err))
+			}
+		}
+	}()
+
+	log.Info("webhook dml sink created",
+		zap.String("namespace", changefeedID.Namespace),
+		zap.String("changefeed", changefeedID.ID),
+		zap.String("protocol", protocol.String()),
+		zap.Int("workerCount", cfg.WorkerCount))
+	return s, nil
+}
+
+func (s *DMLSink) run(ctx context.Context) error {
+	g, ctx := errgroup.WithContext(ctx)
+	for _, w := range s.workers {
+		w := w
+		g.Go(func() error {
+			return w.run(ctx)
+		})
+	}
+	return g.Wait()
+}
+
+// WriteEvents writes events to the sink.
+// This is an asynchronously and thread-safe method.
+func (s *DMLSink) WriteEvents(txns ...*dmlsink.TxnCallbackableEvent) error {
+	s.alive.RLock()
+	defer s.alive.RUnlock()
+	if s.alive.isDead {
+		return errors.Trace(errors.New("dead dmlSink"))
+	}
+
+	for _, txn := range txns {
+		if txn.GetTableSinkState() != state.TableSinkSinking {
+			// The table where the event comes from is in stopping, so it's safe
+			// to drop the event directly.
+			txn.Callback()
+			continue
+		}
+		// Dispatch the events by table, so that the events of one table
+		// are always posted in order.
+		idx := uint64(txn.Event.PhysicalTableID) % uint64(len(s.workers))
+		// This never be blocked because this is an unbounded channel.
+		// We already limit the memory usage by MemoryQuota at SinkManager level.
+		s.workers[idx].inputCh.In() <- txn
+	}
+	return nil
+}
+
+// SchemeOption returns the scheme and the option.
+func (s *DMLSink) SchemeOption() (string, bool) {
+	return s.scheme, s.outputRawChangeEvent
+}
+
+// Close closes the sink.
+func (s *DMLSink) Close() {
+	if s.cancel != nil {
+		s.cancel(nil)
+	}
+	s.wg.Wait()
+
+	s.client.Close()
+	s.statistics.Close()
+}
+
+// Dead checks whether it's dead or not.
+func (s *DMLSink) Dead() <-chan struct{} {
+	return s.dead
+}
@@ -0,0 +1,165 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/cdc/entry"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink"
+	"github.com/pingcap/tiflow/cdc/sink/tablesink/state"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+)
+
+func newTestTxns(
+	t *testing.T, count int, tableStatus *state.TableSinkState, callback func(),
+) []*dmlsink.TxnCallbackableEvent {
+	helper := entry.NewSchemaTestHelper(t)
+	defer helper.Close()
+
+	sql := `create table test.t(a int primary key)`
+	job := helper.DDL2Job(sql)
+	tableInfo := model.WrapTableInfo(0, "test", 1, job.BinlogInfo.TableInfo)
+
+	txns := make([]*dmlsink.TxnCallbackableEvent, 0, count)
+	for i := 0; i < count; i++ {
+		txns = append(txns, &dmlsink.TxnCallbackableEvent{
+			Event: &model.SingleTableTxn{
+				PhysicalTableID: tableInfo.ID,
+				TableInfo:       tableInfo,
+				CommitTs:        uint64(i + 1),
+				Rows: []*model.RowChangedEvent{{
+					CommitTs:  uint64(i + 1),
+					TableInfo: tableInfo,
+					Columns: model.Columns2ColumnDatas(
+						[]*model.Column{{Name: "a", Value: i}}, tableInfo),
+				}},
+			},
+			Callback:  callback,
+			SinkState: tableStatus,
+		})
+	}
+	return txns
+}
+
+func TestWriteEvents(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu   sync.Mutex
+		rows []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.Equal(t, "row", r.Header.Get("X-Ticdc-Event-Type"))
+		require.Equal(t, "test", r.Header.Get("X-Ticdc-Schema"))
+		require.Equal(t, "t", r.Header.Get("X-Ticdc-Table"))
+		mu.Lock()
+		rows = append(rows, strings.Split(string(data), "\n")...)
+		mu.Unlock()
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sinkURI, err := url.Parse(server.URL + "/events?protocol=canal-json&max-batch-rows=16")
+	require.NoError(t, err)
+	replicaConfig := config.GetDefaultReplicaConfig()
+	require.NoError(t, replicaConfig.ValidateAndAdjust(sinkURI))
+
+	errCh := make(chan error, 1)
+	s, err := NewDMLSink(ctx, model.DefaultChangeFeedID("test"), sinkURI, replicaConfig, errCh)
+	require.NoError(t, err)
+	defer s.Close()
+
+	var flushed atomic.Int64
+	tableStatus := state.TableSinkSinking
+	txns := newTestTxns(t, 100, &tableStatus, func() { flushed.Inc() })
+	require.NoError(t, s.WriteEvents(txns...))
+
+	require.Eventually(t, func() bool {
+		return flushed.Load() == 100
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Len(t, errCh, 0)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, rows, 100)
+	// The rows of the same table must be posted in order.
+	for i, row := range rows {
+		require.Contains(t, row, `"a":"`+strconv.Itoa(i)+`"`)
+	}
+}
+
+func TestWriteEventsFailed(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sinkURI, err := url.Parse(server.URL + "/events?protocol=canal-json")
+	require.NoError(t, err)
+	replicaConfig := config.GetDefaultReplicaConfig()
+	require.NoError(t, replicaConfig.ValidateAndAdjust(sinkURI))
+
+	errCh := make(chan error, 1)
+	s, err := NewDMLSink(ctx, model.DefaultChangeFeedID("test"), sinkURI, replicaConfig, errCh)
+	require.NoError(t, err)
+	defer s.Close()
+
+	var flushed atomic.Int64
+	tableStatus := state.TableSinkSinking
+	txns := newTestTxns(t, 1, &tableStatus, func() { flushed.Inc() })
+	require.NoError(t, s.WriteEvents(txns...))
+
+	select {
+	case err := <-errCh:
+		require.ErrorContains(t, err, "status code 422")
+	case <-time.After(5 * time.Second):
+		t.Fatal("webhook dml sink should report the error")
+	}
+	<-s.Dead()
+	require.Equal(t, int64(0), flushed.Load())
+	require.Error(t, s.WriteEvents(txns...))
+}
+
+func TestNewDMLSinkUnsupportedProtocol(t *testing.T) {
+	t.Parallel()
+
+	sinkURI, err := url.Parse("http://127.0.0.1:8080/events?protocol=open-protocol")
+	require.NoError(t, err)
+	replicaConfig := config.GetDefaultReplicaConfig()
+	require.NoError(t, replicaConfig.ValidateAndAdjust(sinkURI))
+	s, err := NewDMLSink(context.Background(), model.DefaultChangeFeedID("test"),
+		sinkURI, replicaConfig, make(chan error, 1))
+	require.ErrorContains(t, err, "unsupported protocol")
+	require.Nil(t, s)
+}
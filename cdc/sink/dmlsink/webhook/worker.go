@@ -0,0 +1,199 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink"
+	"github.com/pingcap/tiflow/cdc/sink/metrics"
+	"github.com/pingcap/tiflow/cdc/sink/tablesink/state"
+	"github.com/pingcap/tiflow/pkg/chann"
+	"github.com/pingcap/tiflow/pkg/sink/codec"
+	"github.com/pingcap/tiflow/pkg/sink/webhook"
+	"go.uber.org/zap"
+)
+
+// worker encodes the transactions and posts them to the endpoint.
+type worker struct {
+	changefeedID model.ChangeFeedID
+	id           int
+	// inputCh caches the transactions to be sent.
+	// It is an unbounded channel.
+	inputCh      *chann.DrainableChann[*dmlsink.TxnCallbackableEvent]
+	encoder      codec.RowEventEncoder
+	client       *webhook.Client
+	maxBatchRows int
+	statistics   *metrics.Statistics
+}
+
+func newWorker(
+	changefeedID model.ChangeFeedID,
+	id int,
+	encoder codec.RowEventEncoder,
+	client *webhook.Client,
+	maxBatchRows int,
+	statistics *metrics.Statistics,
+) *worker {
+	return &worker{
+		changefeedID: changefeedID,
+		id:           id,
+		inputCh:      chann.NewAutoDrainChann[*dmlsink.TxnCallbackableEvent](),
+		encoder:      encoder,
+		client:       client,
+		maxBatchRows: maxBatchRows,
+		statistics:   statistics,
+	}
+}
+
+// run keeps collecting and sending transactions until it
+// encounters an error or is interrupted.
+func (w *worker) run(ctx context.Context) (retErr error) {
+	defer func() {
+		log.Info("webhook sink worker exited", zap.Error(retErr),
+			zap.String("namespace", w.changefeedID.Namespace),
+			zap.String("changefeed", w.changefeedID.ID),
+			zap.Int("workerID", w.id))
+	}()
+
+	for {
+		txns, err := w.batch(ctx)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if len(txns) == 0 {
+			return nil
+		}
+		for _, group := range groupByTable(txns) {
+			if err := w.send(ctx, group); err != nil {
+				return errors.Trace(err)
+			}
+		}
+	}
+}
+
+// batch blocks until at least one transaction is received, then collects
+// all ready transactions until the number of rows reaches maxBatchRows.
+// It returns an empty batch if the input channel is closed.
+func (w *worker) batch(ctx context.Context) ([]*dmlsink.TxnCallbackableEvent, error) {
+	var (
+		txns []*dmlsink.TxnCallbackableEvent
+		rows int
+	)
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case txn, ok := <-w.inputCh.Out():
+		if !ok {
+			return nil, nil
+		}
+		txns = append(txns, txn)
+		rows += len(txn.Event.Rows)
+	}
+	for rows < w.maxBatchRows {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case txn, ok := <-w.inputCh.Out():
+			if !ok {
+				return txns, nil
+			}
+			txns = append(txns, txn)
+			rows += len(txn.Event.Rows)
+		default:
+			return txns, nil
+		}
+	}
+	return txns, nil
+}
+
+// groupByTable groups the transactions by table, the order of
+// transactions in each group is kept.
+func groupByTable(txns []*dmlsink.TxnCallbackableEvent) [][]*dmlsink.TxnCallbackableEvent {
+	var groups [][]*dmlsink.TxnCallbackableEvent
+	indexes := make(map[int64]int)
+	for _, txn := range txns {
+		idx, ok := indexes[txn.Event.PhysicalTableID]
+		if !ok {
+			idx = len(groups)
+			indexes[txn.Event.PhysicalTableID] = idx
+			groups = append(groups, nil)
+		}
+		groups[idx] = append(groups[idx], txn)
+	}
+	return groups
+}
+
+// send encodes the transactions of one table and posts them in one request.
+// The callbacks are called after the request succeeds, so the checkpoint of
+// the table only advances after the events are accepted by the endpoint.
+func (w *worker) send(ctx context.Context, txns []*dmlsink.TxnCallbackableEvent) error {
+	sinking := txns[:0]
+	for _, txn := range txns {
+		if txn.GetTableSinkState() != state.TableSinkSinking {
+			txn.Callback()
+			continue
+		}
+		sinking = append(sinking, txn)
+	}
+	if len(sinking) == 0 {
+		return nil
+	}
+
+	last := sinking[len(sinking)-1].Event
+	req := &webhook.Request{
+		EventType: webhook.EventTypeRow,
+		Schema:    last.TableInfo.GetSchemaName(),
+		Table:     last.TableInfo.GetTableName(),
+		CommitTs:  last.CommitTs,
+	}
+	err := w.statistics.RecordBatchExecution(func() (int, int64, error) {
+		var (
+			rowCount int
+			bytes    int64
+		)
+		for _, txn := range sinking {
+			w.statistics.ObserveRows(txn.Event.Rows...)
+			for _, row := range txn.Event.Rows {
+				if err := w.encoder.AppendRowChangedEvent(ctx, "", row, nil); err != nil {
+					return 0, 0, errors.Trace(err)
+				}
+				rowCount++
+			}
+		}
+		req.Messages = w.encoder.Build()
+		for _, msg := range req.Messages {
+			bytes += int64(len(msg.Value))
+		}
+		if err := w.client.Send(ctx, req); err != nil {
+			return 0, 0, errors.Trace(err)
+		}
+		return rowCount, bytes, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, txn := range sinking {
+		txn.Callback()
+	}
+	return nil
+}
+
+func (w *worker) close() {
+	w.inputCh.CloseAndDrain()
+}
@@ -61,6 +61,8 @@ func GetFileExtension(protocol config.Protocol) string {
 		return ".canal"
 	case config.ProtocolCsv:
 		return ".csv"
+	case config.ProtocolParquet:
+		return ".parquet"
 	default:
 		return ".unknown"
 	}
@@ -122,3 +124,8 @@ func GetTopicManagerAndTryCreateTopic(
 func IsPulsarSupportedProtocols(p config.Protocol) bool {
 	return p == config.ProtocolCanalJSON
 }
+
+// IsWebhookSupportedProtocols returns whether the protocol is supported by webhook.
+func IsWebhookSupportedProtocols(p config.Protocol) bool {
+	return p == config.ProtocolCanalJSON || p == config.ProtocolDebezium || p == config.ProtocolSimple
+}
@@ -0,0 +1,314 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink/txn/mysql"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/filter"
+	"github.com/pingcap/tiflow/pkg/retry"
+	pmysql "github.com/pingcap/tiflow/pkg/sink/mysql"
+	"go.uber.org/zap"
+)
+
+const (
+	// checkpointTable records the consume progress of each partition,
+	// it's stored in the downstream database.
+	checkpointTable = "kafka_consumer_checkpoint"
+
+	checkpointMaxRetries = 10
+	// checkpointBatchSize is the number of statements executed in one transaction
+	// when flushing events. A batch is only cut at the boundary of upstream
+	// transactions, so it may exceed the size if a transaction is large.
+	checkpointBatchSize = 1024
+)
+
+// checkpointer writes the resolved row changed events and the consume progress
+// of all partitions to the downstream in the same transaction, so that the
+// consumer can resume from the checkpoint without losing or duplicating
+// any event after it is restarted.
+//
+// The checkpoint contains the following information:
+//   - offset: the offset from which the partition should be consumed after restart,
+//     all messages before it are already applied to the downstream.
+//   - watermark: all row changed events with commitTs less than or equal to it are
+//     applied to the downstream.
+//   - ddl_commit_ts: the commitTs of the last executed DDL.
+//   - ddl_offset: the offset of the last executed DDL in partition 0, a DDL job
+//     may be split into multiple DDLs with the same commitTs, such as RENAME TABLES,
+//     so the offset is required to tell which of them are executed.
+//
+// Note that DDLs cannot be executed in a transaction, so the checkpoint is saved
+// right after the DDL is executed, if the consumer crashes between the two steps,
+// the DDL will be executed again after restart.
+//
+// Events are flushed in batches to bound the size of transactions. Batches before
+// the last one only advance the watermark, so that the events in them are ignored
+// when replayed after restart, the offsets are advanced by the last batch.
+type checkpointer struct {
+	db             *sql.DB
+	topic          string
+	forceReplicate bool
+
+	// offsets is loaded from the downstream, partitions which are not in the map
+	// are consumed from the committed offset of the consumer group.
+	offsets     map[int32]kafka.Offset
+	watermark   uint64
+	ddlCommitTs uint64
+	ddlOffset   kafka.Offset
+	// resumed is set after the partitions are seeked to the checkpoint.
+	// Later assignments start from the committed offsets of the consumer
+	// group, since the events buffered in memory are not discarded.
+	resumed bool
+}
+
+func newCheckpointer(ctx context.Context, o *option) (*checkpointer, error) {
+	sinkURI, err := url.Parse(o.downstreamURI)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrSinkURIInvalid, err)
+	}
+	changefeedID := model.DefaultChangeFeedID("kafka-consumer")
+	cfg := pmysql.NewConfig()
+	if err = cfg.Apply(o.timezone, changefeedID, sinkURI, o.replicaConfig); err != nil {
+		return nil, errors.Trace(err)
+	}
+	dsnStr, err := pmysql.GenerateDSN(ctx, sinkURI, cfg, pmysql.CreateMySQLDBConn)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	db, err := pmysql.CreateMySQLDBConn(ctx, dsnStr)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	c := &checkpointer{
+		db:             db,
+		topic:          o.topic,
+		forceReplicate: o.replicaConfig.ForceReplicate,
+		offsets:        make(map[int32]kafka.Offset),
+		ddlOffset:      kafka.OffsetInvalid,
+	}
+	if err = c.createTable(ctx); err != nil {
+		_ = db.Close()
+		return nil, errors.Trace(err)
+	}
+	if err = c.load(ctx); err != nil {
+		_ = db.Close()
+		return nil, errors.Trace(err)
+	}
+	log.Info("checkpoint loaded from the downstream",
+		zap.String("topic", c.topic), zap.Any("offsets", c.offsets),
+		zap.Uint64("watermark", c.watermark), zap.Uint64("ddlCommitTs", c.ddlCommitTs),
+		zap.Any("ddlOffset", c.ddlOffset))
+	return c, nil
+}
+
+func (c *checkpointer) createTable(ctx context.Context) error {
+	_, err := c.db.ExecContext(ctx, "CREATE DATABASE IF NOT EXISTS "+filter.TiCDCSystemSchema)
+	if err != nil {
+		return cerror.WrapError(cerror.ErrMySQLTxnError,
+			errors.WithMessage(err, "failed to create checkpoint table;"))
+	}
+	query := `CREATE TABLE IF NOT EXISTS %s.%s
+	(
+		topic varchar(255) NOT NULL,
+		partition_id int NOT NULL,
+		kafka_offset bigint NOT NULL,
+		watermark bigint unsigned NOT NULL,
+		ddl_commit_ts bigint unsigned NOT NULL,
+		ddl_offset bigint NOT NULL,
+		updated_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+		PRIMARY KEY (topic, partition_id)
+	);`
+	_, err = c.db.ExecContext(ctx, fmt.Sprintf(query, filter.TiCDCSystemSchema, checkpointTable))
+	if err != nil {
+		return cerror.WrapError(cerror.ErrMySQLTxnError,
+			errors.WithMessage(err, "failed to create checkpoint table;"))
+	}
+	return nil
+}
+
+// load reads the checkpoint of the topic. All partitions are saved in the same
+// transaction, so they share the same watermark, ddl_commit_ts and ddl_offset.
+func (c *checkpointer) load(ctx context.Context) error {
+	query := fmt.Sprintf(
+		"SELECT partition_id, kafka_offset, watermark, ddl_commit_ts, ddl_offset FROM %s.%s WHERE topic = ?",
+		filter.TiCDCSystemSchema, checkpointTable)
+	rows, err := c.db.QueryContext(ctx, query, c.topic)
+	if err != nil {
+		return cerror.WrapError(cerror.ErrMySQLQueryError, err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			partition   int32
+			offset      int64
+			watermark   uint64
+			ddlCommitTs uint64
+			ddlOffset   int64
+		)
+		if err = rows.Scan(&partition, &offset, &watermark, &ddlCommitTs, &ddlOffset); err != nil {
+			return cerror.WrapError(cerror.ErrMySQLQueryError, err)
+		}
+		c.offsets[partition] = kafka.Offset(offset)
+		c.watermark = watermark
+		c.ddlCommitTs = ddlCommitTs
+		c.ddlOffset = kafka.Offset(ddlOffset)
+	}
+	return cerror.WrapError(cerror.ErrMySQLQueryError, rows.Err())
+}
+
+// seek sets the offset of the assigned partitions to the checkpoint.
+func (c *checkpointer) seek(partitions []kafka.TopicPartition) []kafka.TopicPartition {
+	if c.resumed {
+		return partitions
+	}
+	c.resumed = true
+	for i, tp := range partitions {
+		offset, ok := c.offsets[tp.Partition]
+		if !ok {
+			continue
+		}
+		partitions[i].Offset = offset
+		log.Info("seek partition to the checkpoint",
+			zap.String("topic", *tp.Topic), zap.Int32("partition", tp.Partition),
+			zap.Any("offset", offset))
+	}
+	return partitions
+}
+
+// isDDLExecuted returns whether the DDL at the offset of partition 0 is already
+// executed according to the checkpoint.
+func (c *checkpointer) isDDLExecuted(ddl *model.DDLEvent, offset kafka.Offset) bool {
+	if ddl.CommitTs != c.ddlCommitTs {
+		return ddl.CommitTs < c.ddlCommitTs
+	}
+	return offset <= c.ddlOffset
+}
+
+// flush writes the events and the checkpoint to the downstream in batches,
+// the offsets are saved along with the last batch.
+func (c *checkpointer) flush(
+	ctx context.Context, events []*model.RowChangedEvent,
+	offsets map[int32]kafka.Offset, watermark uint64,
+) error {
+	// events from different partitions are ordered by commitTs, so that
+	// the downstream sees the same order as the upstream.
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].CommitTs < events[j].CommitTs
+	})
+	var (
+		sqls   []string
+		values [][]interface{}
+	)
+	for i, row := range events {
+		// events of the same upstream transaction are never split into different
+		// batches, since the watermark saved with the batch is the commitTs.
+		if len(sqls) >= checkpointBatchSize && row.CommitTs != events[i-1].CommitTs {
+			err := c.exec(ctx, sqls, values, c.offsets, events[i-1].CommitTs, c.ddlCommitTs, c.ddlOffset)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			sqls, values = nil, nil
+		}
+		quoteTable := row.TableInfo.TableName.QuoteString()
+		rowSQLs, rowValues := mysql.PrepareRowDMLs(quoteTable, row, false, c.forceReplicate)
+		sqls = append(sqls, rowSQLs...)
+		values = append(values, rowValues...)
+	}
+	return c.exec(ctx, sqls, values, offsets, watermark, c.ddlCommitTs, c.ddlOffset)
+}
+
+// saveDDL records the DDL at the offset of partition 0 is executed.
+func (c *checkpointer) saveDDL(
+	ctx context.Context, offsets map[int32]kafka.Offset, ddlCommitTs uint64, ddlOffset kafka.Offset,
+) error {
+	return c.exec(ctx, nil, nil, offsets, c.watermark, ddlCommitTs, ddlOffset)
+}
+
+func (c *checkpointer) exec(
+	ctx context.Context, sqls []string, values [][]interface{},
+	offsets map[int32]kafka.Offset, watermark, ddlCommitTs uint64, ddlOffset kafka.Offset,
+) error {
+	partitions := make([]int32, 0, len(offsets))
+	for partition := range offsets {
+		partitions = append(partitions, partition)
+	}
+	sort.Slice(partitions, func(i, j int) bool { return partitions[i] < partitions[j] })
+
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("INSERT INTO %s.%s "+
+		"(topic, partition_id, kafka_offset, watermark, ddl_commit_ts, ddl_offset) VALUES ",
+		filter.TiCDCSystemSchema, checkpointTable))
+	args := make([]interface{}, 0, 6*len(partitions))
+	for i, partition := range partitions {
+		if i > 0 {
+			builder.WriteString(",")
+		}
+		builder.WriteString("(?,?,?,?,?,?)")
+		args = append(args, c.topic, partition, int64(offsets[partition]), watermark, ddlCommitTs, int64(ddlOffset))
+	}
+	builder.WriteString(" ON DUPLICATE KEY UPDATE kafka_offset = VALUES(kafka_offset), " +
+		"watermark = VALUES(watermark), ddl_commit_ts = VALUES(ddl_commit_ts), ddl_offset = VALUES(ddl_offset)")
+	checkpointSQL := builder.String()
+
+	err := retry.Do(ctx, func() error {
+		tx, err := c.db.BeginTx(ctx, nil)
+		if err != nil {
+			return cerror.WrapError(cerror.ErrMySQLTxnError, err)
+		}
+		for i, query := range sqls {
+			if _, err = tx.ExecContext(ctx, query, values[i]...); err != nil {
+				_ = tx.Rollback()
+				return cerror.WrapError(cerror.ErrMySQLTxnError,
+					errors.WithMessage(err, fmt.Sprintf("failed to execute %s;", query)))
+			}
+		}
+		if len(partitions) != 0 {
+			if _, err = tx.ExecContext(ctx, checkpointSQL, args...); err != nil {
+				_ = tx.Rollback()
+				return cerror.WrapError(cerror.ErrMySQLTxnError,
+					errors.WithMessage(err, "failed to write checkpoint table;"))
+			}
+		}
+		return cerror.WrapError(cerror.ErrMySQLTxnError, tx.Commit())
+	}, retry.WithBackoffBaseDelay(pmysql.BackoffBaseDelay.Milliseconds()),
+		retry.WithBackoffMaxDelay(pmysql.BackoffMaxDelay.Milliseconds()),
+		retry.WithMaxTries(checkpointMaxRetries))
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	for partition, offset := range offsets {
+		c.offsets[partition] = offset
+	}
+	c.watermark = watermark
+	c.ddlCommitTs = ddlCommitTs
+	c.ddlOffset = ddlOffset
+	log.Debug("checkpoint saved", zap.Int("sqlCount", len(sqls)),
+		zap.Any("offsets", offsets), zap.Uint64("watermark", watermark),
+		zap.Uint64("ddlCommitTs", ddlCommitTs), zap.Any("ddlOffset", ddlOffset))
+	return nil
+}
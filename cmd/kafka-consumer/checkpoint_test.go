@@ -0,0 +1,206 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testTopic = "test-topic"
+
+	testCheckpointSQL = "INSERT INTO tidb_cdc.kafka_consumer_checkpoint"
+)
+
+func newTestCheckpointer(t *testing.T) (*checkpointer, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, mock.ExpectationsWereMet())
+		_ = db.Close()
+	})
+	return &checkpointer{
+		db:        db,
+		topic:     testTopic,
+		offsets:   make(map[int32]kafka.Offset),
+		ddlOffset: kafka.OffsetInvalid,
+	}, mock
+}
+
+// newTestInsertRow returns an insert event of table `s1`.`t1`.
+func newTestInsertRow(commitTs uint64, a int) *model.RowChangedEvent {
+	tableInfo := model.BuildTableInfo("s1", "t1", []*model.Column{
+		{Name: "a", Type: mysql.TypeLong, Flag: model.HandleKeyFlag | model.PrimaryKeyFlag},
+		{Name: "b", Type: mysql.TypeVarchar},
+	}, [][]int{{0}})
+	return &model.RowChangedEvent{
+		StartTs:         commitTs - 1,
+		CommitTs:        commitTs,
+		TableInfo:       tableInfo,
+		PhysicalTableID: 1,
+		Columns: model.Columns2ColumnDatas([]*model.Column{
+			{Name: "a", Value: a},
+			{Name: "b", Value: "test"},
+		}, tableInfo),
+	}
+}
+
+func TestCheckpointerLoadAndSeek(t *testing.T) {
+	t.Parallel()
+
+	c, mock := newTestCheckpointer(t)
+	mock.ExpectQuery("SELECT partition_id, kafka_offset, watermark, ddl_commit_ts, ddl_offset").
+		WithArgs(testTopic).
+		WillReturnRows(sqlmock.NewRows(
+			[]string{"partition_id", "kafka_offset", "watermark", "ddl_commit_ts", "ddl_offset"}).
+			AddRow(0, 10, 100, 50, 8).
+			AddRow(1, 20, 100, 50, 8))
+	require.NoError(t, c.load(context.Background()))
+	require.Equal(t, map[int32]kafka.Offset{0: 10, 1: 20}, c.offsets)
+	require.Equal(t, uint64(100), c.watermark)
+	require.Equal(t, uint64(50), c.ddlCommitTs)
+	require.Equal(t, kafka.Offset(8), c.ddlOffset)
+
+	topic := testTopic
+	newPartitions := func() []kafka.TopicPartition {
+		return []kafka.TopicPartition{
+			{Topic: &topic, Partition: 0, Offset: kafka.OffsetStored},
+			{Topic: &topic, Partition: 1, Offset: kafka.OffsetStored},
+			{Topic: &topic, Partition: 2, Offset: kafka.OffsetStored},
+		}
+	}
+	// partitions in the checkpoint are resumed from the stored offset.
+	partitions := c.seek(newPartitions())
+	require.Equal(t, kafka.Offset(10), partitions[0].Offset)
+	require.Equal(t, kafka.Offset(20), partitions[1].Offset)
+	require.Equal(t, kafka.OffsetStored, partitions[2].Offset)
+
+	// later assignments start from the committed offsets.
+	partitions = c.seek(newPartitions())
+	for _, tp := range partitions {
+		require.Equal(t, kafka.OffsetStored, tp.Offset)
+	}
+}
+
+func TestCheckpointerFlush(t *testing.T) {
+	t.Parallel()
+
+	c, mock := newTestCheckpointer(t)
+	mock.ExpectBegin()
+	// events are written in the commitTs order.
+	mock.ExpectExec("`s1`.`t1`").WithArgs(1, "test").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("`s1`.`t1`").WithArgs(2, "test").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(testCheckpointSQL).
+		WithArgs(testTopic, 0, 8, 20, 0, int64(kafka.OffsetInvalid),
+			testTopic, 1, 3, 20, 0, int64(kafka.OffsetInvalid)).
+		WillReturnResult(sqlmock.NewResult(2, 2))
+	mock.ExpectCommit()
+
+	events := []*model.RowChangedEvent{newTestInsertRow(20, 2), newTestInsertRow(10, 1)}
+	offsets := map[int32]kafka.Offset{1: 3, 0: 8}
+	require.NoError(t, c.flush(context.Background(), events, offsets, 20))
+	require.Equal(t, offsets, c.offsets)
+	require.Equal(t, uint64(20), c.watermark)
+
+	// saving a DDL keeps the watermark.
+	mock.ExpectBegin()
+	mock.ExpectExec(testCheckpointSQL).
+		WithArgs(testTopic, 0, 9, 20, 30, 7).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	require.NoError(t, c.saveDDL(context.Background(), map[int32]kafka.Offset{0: 9}, 30, 7))
+	require.Equal(t, map[int32]kafka.Offset{0: 9, 1: 3}, c.offsets)
+	require.Equal(t, uint64(20), c.watermark)
+	require.Equal(t, uint64(30), c.ddlCommitTs)
+	require.Equal(t, kafka.Offset(7), c.ddlOffset)
+}
+
+func TestCheckpointerFlushInBatches(t *testing.T) {
+	t.Parallel()
+
+	c, mock := newTestCheckpointer(t)
+	c.offsets[0] = 5
+	c.watermark = 5
+
+	// the events of the first transaction exceed the batch size, they are
+	// written in one batch with the watermark, but the offset is not advanced.
+	var events []*model.RowChangedEvent
+	mock.ExpectBegin()
+	for i := 0; i < checkpointBatchSize+1; i++ {
+		events = append(events, newTestInsertRow(10, i))
+		mock.ExpectExec("`s1`.`t1`").WithArgs(i, "test").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+	}
+	mock.ExpectExec(testCheckpointSQL).
+		WithArgs(testTopic, 0, 5, 10, 0, int64(kafka.OffsetInvalid)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	// the last batch advances the offset.
+	events = append(events, newTestInsertRow(20, -1))
+	mock.ExpectBegin()
+	mock.ExpectExec("`s1`.`t1`").WithArgs(-1, "test").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(testCheckpointSQL).
+		WithArgs(testTopic, 0, 8, 20, 0, int64(kafka.OffsetInvalid)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	require.NoError(t, c.flush(context.Background(), events, map[int32]kafka.Offset{0: 8}, 20))
+	require.Equal(t, map[int32]kafka.Offset{0: 8}, c.offsets)
+	require.Equal(t, uint64(20), c.watermark)
+}
+
+func TestCheckpointerFlushRollback(t *testing.T) {
+	t.Parallel()
+
+	c, mock := newTestCheckpointer(t)
+	c.offsets[0] = 5
+	c.watermark = 5
+	events := []*model.RowChangedEvent{newTestInsertRow(10, 1)}
+
+	// the failed transaction is rolled back, and the retry is stopped by the
+	// timeout, so the checkpoint is not updated.
+	mock.ExpectBegin()
+	mock.ExpectExec("`s1`.`t1`").WillReturnError(errors.New("mock error"))
+	mock.ExpectRollback()
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	require.Error(t, c.flush(ctx, events, map[int32]kafka.Offset{0: 8}, 10))
+	require.Equal(t, map[int32]kafka.Offset{0: 5}, c.offsets)
+	require.Equal(t, uint64(5), c.watermark)
+
+	// the events and the checkpoint are written again after the rollback.
+	mock.ExpectBegin()
+	mock.ExpectExec("`s1`.`t1`").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(testCheckpointSQL).WillReturnError(errors.New("mock error"))
+	mock.ExpectRollback()
+	mock.ExpectBegin()
+	mock.ExpectExec("`s1`.`t1`").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(testCheckpointSQL).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	require.NoError(t, c.flush(context.Background(), events, map[int32]kafka.Offset{0: 8}, 10))
+	require.Equal(t, map[int32]kafka.Offset{0: 8}, c.offsets)
+	require.Equal(t, uint64(10), c.watermark)
+}
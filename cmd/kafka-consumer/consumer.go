@@ -107,14 +107,30 @@ func newConsumer(ctx context.Context, o *option) *consumer {
 	if err != nil {
 		log.Panic("create kafka consumer failed", zap.Error(err))
 	}
-	err = client.SubscribeTopics(topics, nil)
+	c := &consumer{
+		writer: newWriter(ctx, o),
+		client: client,
+	}
+	var rebalanceCb kafka.RebalanceCb
+	if c.writer.checkpoint != nil {
+		rebalanceCb = c.rebalance
+	}
+	err = client.SubscribeTopics(topics, rebalanceCb)
 	if err != nil {
 		log.Panic("subscribe topics failed", zap.Error(err))
 	}
-	return &consumer{
-		writer: newWriter(ctx, o),
-		client: client,
+	return c
+}
+
+// rebalance seeks the assigned partitions to the checkpoint saved in the downstream.
+func (c *consumer) rebalance(client *kafka.Consumer, event kafka.Event) error {
+	switch e := event.(type) {
+	case kafka.AssignedPartitions:
+		return client.Assign(c.writer.checkpoint.seek(e.Partitions))
+	case kafka.RevokedPartitions:
+		return client.Unassign()
 	}
+	return nil
 }
 
 // Consume will read message from Kafka.
@@ -53,6 +53,8 @@ func main() {
 	flag.StringVar(&consumerOption.cert, "cert", "", "Certificate path for Kafka SSL connection")
 	flag.StringVar(&consumerOption.key, "key", "", "Private key path for Kafka SSL connection")
 	flag.BoolVar(&consumerOption.enableProfiling, "enable-profiling", false, "enable pprof profiling")
+	flag.BoolVar(&consumerOption.enableCheckpoint, "enable-checkpoint", false,
+		"write the consume progress to the downstream in the same transaction as the data, and resume from it after restart")
 	flag.Parse()
 
 	err := logutil.InitLogger(&logutil.Config{
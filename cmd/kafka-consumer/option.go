@@ -26,6 +26,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/config"
 	cerrors "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/filter"
+	"github.com/pingcap/tiflow/pkg/sink"
 	"github.com/pingcap/tiflow/pkg/sink/codec/common"
 	"github.com/pingcap/tiflow/pkg/util"
 	"go.uber.org/zap"
@@ -60,7 +61,7 @@ type option struct {
 
 	downstreamURI string
 
-	// avro schema registry uri should be set if the encoding protocol is avro
+	// schema registry uri should be set if the encoding protocol is avro or protobuf
 	schemaRegistryURI string
 
 	// upstreamTiDBDSN is the dsn of the upstream TiDB cluster
@@ -68,6 +69,10 @@ type option struct {
 
 	enableProfiling bool
 
+	// enableCheckpoint indicates whether to write the consume progress to the
+	// downstream in the same transaction as the data, and resume from it.
+	enableCheckpoint bool
+
 	// connect kafka retry times, default 30
 	retryTime int
 	// connect kafka  timeout, default 10s
@@ -157,10 +162,21 @@ func (o *option) Adjust(upstreamURI *url.URL, configFile string) error {
 	}
 	o.codecConfig.TimeZone = tz
 
-	if protocol == config.ProtocolAvro {
+	if protocol == config.ProtocolAvro || protocol == config.ProtocolProtobuf {
 		o.codecConfig.AvroEnableWatermark = true
 	}
 
+	if o.enableCheckpoint {
+		downstreamURI, err := url.Parse(o.downstreamURI)
+		if err != nil {
+			return cerrors.WrapError(cerrors.ErrSinkURIInvalid, err)
+		}
+		if !sink.IsMySQLCompatibleScheme(strings.ToLower(downstreamURI.Scheme)) {
+			return cerrors.ErrSinkURIInvalid.GenWithStack(
+				"checkpoint is not supported by the downstream scheme %s", downstreamURI.Scheme)
+		}
+	}
+
 	log.Info("consumer option adjusted",
 		zap.String("configFile", configFile),
 		zap.String("address", strings.Join(o.address, ",")),
@@ -171,6 +187,7 @@ func (o *option) Adjust(upstreamURI *url.URL, configFile string) error {
 		zap.Int("maxMessageBytes", o.maxMessageBytes),
 		zap.Int("maxBatchSize", o.maxBatchSize),
 		zap.String("upstreamURI", upstreamURI.String()),
-		zap.String("downstreamURI", o.downstreamURI))
+		zap.String("downstreamURI", o.downstreamURI),
+		zap.Bool("enableCheckpoint", o.enableCheckpoint))
 	return nil
 }
@@ -29,6 +29,7 @@ import (
 	eventsinkfactory "github.com/pingcap/tiflow/cdc/sink/dmlsink/factory"
 	"github.com/pingcap/tiflow/cdc/sink/dmlsink/mq/dispatcher"
 	"github.com/pingcap/tiflow/cdc/sink/tablesink"
+	"github.com/pingcap/tiflow/pkg/cmd/eventgroup"
 	"github.com/pingcap/tiflow/pkg/config"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/sink/codec"
@@ -36,6 +37,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/sink/codec/canal"
 	"github.com/pingcap/tiflow/pkg/sink/codec/debezium"
 	"github.com/pingcap/tiflow/pkg/sink/codec/open"
+	"github.com/pingcap/tiflow/pkg/sink/codec/protobuf"
 	"github.com/pingcap/tiflow/pkg/sink/codec/simple"
 	"github.com/pingcap/tiflow/pkg/spanz"
 	"go.uber.org/zap"
@@ -58,6 +60,12 @@ func NewDecoder(ctx context.Context, option *option, upstreamTiDB *sql.DB) (code
 			return decoder, cerror.Trace(err)
 		}
 		decoder = avro.NewDecoder(option.codecConfig, schemaM, option.topic, upstreamTiDB)
+	case config.ProtocolProtobuf:
+		schemaM, err := protobuf.NewSchemaManager(ctx, option.schemaRegistryURI, nil)
+		if err != nil {
+			return decoder, cerror.Trace(err)
+		}
+		decoder = protobuf.NewDecoder(option.codecConfig, schemaM, option.topic, upstreamTiDB)
 	case config.ProtocolSimple:
 		decoder, err = simple.NewDecoder(ctx, option.codecConfig, upstreamTiDB)
 	case config.ProtocolDebezium:
@@ -76,15 +84,24 @@ type partitionProgress struct {
 	watermark       uint64
 	watermarkOffset kafka.Offset
 
+	// nextOffset is the offset of the next message to be consumed.
+	nextOffset kafka.Offset
+
 	tableSinkMap map[model.TableID]tablesink.TableSink
-	eventGroups  map[model.TableID]*eventsGroup
+	eventGroups  map[model.TableID]*eventgroup.Group[kafka.Offset]
 	decoder      codec.RowEventDecoder
+
+	// pendingEvents are resolved but not flushed to the downstream yet,
+	// only used if the checkpoint is enabled.
+	pendingEvents  []*model.RowChangedEvent
+	pendingOffsets []kafka.Offset
 }
 
 func newPartitionProgress(partition int32, decoder codec.RowEventDecoder) *partitionProgress {
 	return &partitionProgress{
 		partition:    partition,
-		eventGroups:  make(map[model.TableID]*eventsGroup),
+		nextOffset:   kafka.OffsetInvalid,
+		eventGroups:  make(map[model.TableID]*eventgroup.Group[kafka.Offset]),
 		tableSinkMap: make(map[model.TableID]tablesink.TableSink),
 		decoder:      decoder,
 	}
@@ -119,9 +136,14 @@ type writer struct {
 	option *option
 
 	ddlList            []*model.DDLEvent
+	ddlOffsets         []kafka.Offset
 	ddlWithMaxCommitTs *model.DDLEvent
 	ddlSink            ddlsink.Sink
 
+	// checkpoint is used to write events and the consume progress to
+	// the downstream atomically, it's nil if the checkpoint is not enabled.
+	checkpoint *checkpointer
+
 	// sinkFactory is used to create table sink for each table.
 	sinkFactory *eventsinkfactory.SinkFactory
 	progresses  []*partitionProgress
@@ -186,6 +208,13 @@ func newWriter(ctx context.Context, o *option) *writer {
 		log.Panic("cannot create the ddl sink factory", zap.Error(err))
 	}
 	w.ddlSink = ddlSink
+
+	if o.enableCheckpoint {
+		w.checkpoint, err = newCheckpointer(ctx, o)
+		if err != nil {
+			log.Panic("cannot create the checkpointer", zap.Error(err))
+		}
+	}
 	return w
 }
 
@@ -210,7 +239,17 @@ func (w *writer) appendDDL(ddl *model.DDLEvent, offset kafka.Offset) {
 		return
 	}
 
+	// the DDL is already executed before the consumer restarted.
+	if w.checkpoint != nil && w.checkpoint.isDDLExecuted(ddl, offset) {
+		log.Info("ignore DDL since it's already executed according to the checkpoint",
+			zap.Uint64("commitTs", ddl.CommitTs), zap.Uint64("checkpointDDLCommitTs", w.checkpoint.ddlCommitTs),
+			zap.Any("offset", offset), zap.Any("checkpointDDLOffset", w.checkpoint.ddlOffset),
+			zap.String("DDL", ddl.Query))
+		return
+	}
+
 	w.ddlList = append(w.ddlList, ddl)
+	w.ddlOffsets = append(w.ddlOffsets, offset)
 	w.ddlWithMaxCommitTs = ddl
 	log.Info("DDL message received", zap.Any("offset", offset), zap.Uint64("commitTs", ddl.CommitTs), zap.String("DDL", ddl.Query))
 }
@@ -225,6 +264,7 @@ func (w *writer) getFrontDDL() *model.DDLEvent {
 func (w *writer) popDDL() {
 	if len(w.ddlList) > 0 {
 		w.ddlList = w.ddlList[1:]
+		w.ddlOffsets = w.ddlOffsets[1:]
 	}
 }
 
@@ -266,21 +306,24 @@ func (w *writer) Write(ctx context.Context, messageType model.MessageType) bool
 			break
 		}
 		// flush DMLs
-		w.forEachPartition(func(sink *partitionProgress) {
-			syncFlushRowChangedEvents(ctx, sink, todoDDL.CommitTs)
-		})
+		w.flushRowChangedEvents(ctx, todoDDL.CommitTs)
 		// DDL can be executed, do it first.
 		if err := w.ddlSink.WriteDDLEvent(ctx, todoDDL); err != nil {
 			log.Panic("write DDL event failed", zap.Error(err),
 				zap.String("DDL", todoDDL.Query), zap.Uint64("commitTs", todoDDL.CommitTs))
 		}
+		ddlOffset := w.ddlOffsets[0]
 		w.popDDL()
+		if w.checkpoint != nil {
+			if err := w.checkpoint.saveDDL(ctx, w.checkpointOffsets(), todoDDL.CommitTs, ddlOffset); err != nil {
+				log.Panic("save checkpoint failed", zap.Error(err),
+					zap.String("DDL", todoDDL.Query), zap.Uint64("commitTs", todoDDL.CommitTs))
+			}
+		}
 	}
 
 	if messageType == model.MessageTypeResolved {
-		w.forEachPartition(func(sink *partitionProgress) {
-			syncFlushRowChangedEvents(ctx, sink, watermark)
-		})
+		w.flushRowChangedEvents(ctx, watermark)
 	}
 
 	// The DDL events will only execute in partition0
@@ -398,6 +441,8 @@ func (w *writer) WriteMessage(ctx context.Context, message *kafka.Message) bool
 		}
 	}
 
+	progress.nextOffset = offset + 1
+
 	if counter > w.option.maxBatchSize {
 		log.Panic("Open Protocol max-batch-size exceeded",
 			zap.Int("max-batch-size", w.option.maxBatchSize), zap.Int("actual-batch-size", counter),
@@ -413,10 +458,15 @@ func (w *writer) WriteMessage(ctx context.Context, message *kafka.Message) bool
 
 func (w *writer) resolveRowChangedEvents(progress *partitionProgress, newWatermark uint64) {
 	for tableID, group := range progress.eventGroups {
-		events := group.Resolve(newWatermark)
+		events, offsets := group.Resolve(newWatermark)
 		if len(events) == 0 {
 			continue
 		}
+		if w.checkpoint != nil {
+			progress.pendingEvents = append(progress.pendingEvents, events...)
+			progress.pendingOffsets = append(progress.pendingOffsets, offsets...)
+			continue
+		}
 		tableSink, ok := progress.tableSinkMap[tableID]
 		if !ok {
 			tableSink = w.sinkFactory.CreateTableSinkForConsumer(
@@ -454,9 +504,16 @@ func (w *writer) appendRow2Group(row *model.RowChangedEvent, progress *partition
 	partition := progress.partition
 
 	tableID := row.GetTableID()
+	if w.checkpoint != nil && row.CommitTs <= w.checkpoint.watermark {
+		log.Debug("RowChangedEvent already flushed according to the checkpoint, ignore it",
+			zap.Int64("tableID", tableID), zap.Int32("partition", partition),
+			zap.Uint64("commitTs", row.CommitTs), zap.Any("offset", offset),
+			zap.Uint64("checkpointWatermark", w.checkpoint.watermark))
+		return
+	}
 	group := progress.eventGroups[tableID]
 	if group == nil {
-		group = NewEventsGroup(partition, tableID)
+		group = eventgroup.New[kafka.Offset](partition, tableID)
 		progress.eventGroups[tableID] = group
 	}
 	if row.CommitTs < watermark {
@@ -469,7 +526,7 @@ func (w *writer) appendRow2Group(row *model.RowChangedEvent, progress *partition
 			zap.String("protocol", w.option.protocol.String()), zap.Bool("IsPartition", row.TableInfo.TableName.IsPartition))
 		return
 	}
-	if row.CommitTs >= group.highWatermark {
+	if row.CommitTs >= group.HighWatermark() {
 		group.Append(row, offset)
 		return
 	}
@@ -483,7 +540,7 @@ func (w *writer) appendRow2Group(row *model.RowChangedEvent, progress *partition
 		log.Warn("RowChangedEvent fallback row, since less than the group high watermark, ignore it",
 			zap.Int64("tableID", tableID), zap.Int32("partition", partition),
 			zap.Uint64("commitTs", row.CommitTs), zap.Any("offset", offset),
-			zap.Uint64("highWatermark", group.highWatermark),
+			zap.Uint64("highWatermark", group.HighWatermark()),
 			zap.Any("partitionWatermark", watermark), zap.Any("watermarkOffset", progress.watermarkOffset),
 			zap.String("schema", row.TableInfo.GetSchemaName()), zap.String("table", row.TableInfo.GetTableName()),
 			zap.Any("columns", row.Columns), zap.Any("preColumns", row.PreColumns),
@@ -494,7 +551,7 @@ func (w *writer) appendRow2Group(row *model.RowChangedEvent, progress *partition
 	log.Warn("RowChangedEvent fallback row, since less than the group high watermark, do not ignore it",
 		zap.Int64("tableID", tableID), zap.Int32("partition", partition),
 		zap.Uint64("commitTs", row.CommitTs), zap.Any("offset", offset),
-		zap.Uint64("highWatermark", group.highWatermark),
+		zap.Uint64("highWatermark", group.HighWatermark()),
 		zap.Any("partitionWatermark", watermark), zap.Any("watermarkOffset", progress.watermarkOffset),
 		zap.String("schema", row.TableInfo.GetSchemaName()), zap.String("table", row.TableInfo.GetTableName()),
 		zap.Any("columns", row.Columns), zap.Any("preColumns", row.PreColumns),
@@ -502,6 +559,77 @@ func (w *writer) appendRow2Group(row *model.RowChangedEvent, progress *partition
 	group.Append(row, offset)
 }
 
+// flushRowChangedEvents flushes all events with commitTs less than or equal to the watermark.
+func (w *writer) flushRowChangedEvents(ctx context.Context, watermark uint64) {
+	if w.checkpoint == nil {
+		w.forEachPartition(func(sink *partitionProgress) {
+			syncFlushRowChangedEvents(ctx, sink, watermark)
+		})
+		return
+	}
+	if watermark <= w.checkpoint.watermark {
+		return
+	}
+
+	var events []*model.RowChangedEvent
+	for _, p := range w.progresses {
+		var (
+			remainEvents  []*model.RowChangedEvent
+			remainOffsets []kafka.Offset
+		)
+		for i, row := range p.pendingEvents {
+			if row.CommitTs <= watermark {
+				events = append(events, row)
+				continue
+			}
+			remainEvents = append(remainEvents, row)
+			remainOffsets = append(remainOffsets, p.pendingOffsets[i])
+		}
+		p.pendingEvents = remainEvents
+		p.pendingOffsets = remainOffsets
+	}
+	if err := w.checkpoint.flush(ctx, events, w.checkpointOffsets(), watermark); err != nil {
+		log.Panic("flush events with checkpoint failed", zap.Error(err),
+			zap.Int("eventCount", len(events)), zap.Uint64("watermark", watermark))
+	}
+}
+
+// checkpointOffsets returns the offset from which each partition should be
+// consumed after restart, that is the smallest offset of messages which
+// contain events not applied to the downstream yet, or the next offset
+// to be consumed if all consumed events are applied.
+// Partitions which have not consumed any message are not included.
+func (w *writer) checkpointOffsets() map[int32]kafka.Offset {
+	result := make(map[int32]kafka.Offset, len(w.progresses))
+	for _, p := range w.progresses {
+		if p.nextOffset == kafka.OffsetInvalid {
+			continue
+		}
+		offset := p.nextOffset
+		for _, group := range p.eventGroups {
+			for _, o := range group.Positions() {
+				if o < offset {
+					offset = o
+				}
+			}
+		}
+		for _, o := range p.pendingOffsets {
+			if o < offset {
+				offset = o
+			}
+		}
+		if p.partition == 0 {
+			for _, o := range w.ddlOffsets {
+				if o < offset {
+					offset = o
+				}
+			}
+		}
+		result[p.partition] = offset
+	}
+	return result
+}
+
 func syncFlushRowChangedEvents(ctx context.Context, progress *partitionProgress, watermark uint64) {
 	resolvedTs := model.NewResolvedTs(watermark)
 	for {
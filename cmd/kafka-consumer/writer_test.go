@@ -0,0 +1,143 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/cmd/eventgroup"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestWriter(partitionNum int32, checkpoint *checkpointer) *writer {
+	w := &writer{
+		option: &option{
+			partitionNum: partitionNum,
+			protocol:     config.ProtocolCanalJSON,
+		},
+		progresses: make([]*partitionProgress, partitionNum),
+		checkpoint: checkpoint,
+	}
+	for i := range w.progresses {
+		w.progresses[i] = newPartitionProgress(int32(i), nil)
+	}
+	return w
+}
+
+func TestCheckpointOffsets(t *testing.T) {
+	t.Parallel()
+
+	w := newTestWriter(3, nil)
+	// partition 0 has events not resolved, resolved but not flushed, and DDLs.
+	p0 := w.progresses[0]
+	p0.nextOffset = 100
+	group := eventgroup.New[kafka.Offset](0, 1)
+	group.Append(eventgroup.NewTestRow(30), kafka.Offset(60))
+	group.Append(eventgroup.NewTestRow(40), kafka.Offset(50))
+	p0.eventGroups[1] = group
+	p0.pendingEvents = []*model.RowChangedEvent{eventgroup.NewTestRow(20)}
+	p0.pendingOffsets = []kafka.Offset{40}
+	// partition 1 has applied all consumed events.
+	w.progresses[1].nextOffset = 70
+	// partition 2 has not consumed any message.
+
+	require.Equal(t, map[int32]kafka.Offset{0: 40, 1: 70}, w.checkpointOffsets())
+
+	// DDLs are only sent to partition 0.
+	w.ddlOffsets = []kafka.Offset{30}
+	require.Equal(t, map[int32]kafka.Offset{0: 30, 1: 70}, w.checkpointOffsets())
+
+	w.ddlOffsets = nil
+	p0.pendingEvents, p0.pendingOffsets = nil, nil
+	require.Equal(t, map[int32]kafka.Offset{0: 50, 1: 70}, w.checkpointOffsets())
+}
+
+func TestFlushRowChangedEventsWithCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	c, mock := newTestCheckpointer(t)
+	w := newTestWriter(2, c)
+	p0, p1 := w.progresses[0], w.progresses[1]
+	p0.nextOffset, p1.nextOffset = 10, 20
+	p0.pendingEvents = []*model.RowChangedEvent{newTestInsertRow(10, 1), newTestInsertRow(30, 3)}
+	p0.pendingOffsets = []kafka.Offset{5, 6}
+	p1.pendingEvents = []*model.RowChangedEvent{newTestInsertRow(20, 2)}
+	p1.pendingOffsets = []kafka.Offset{15}
+
+	// the events with commitTs not greater than the watermark are flushed, and
+	// partition 0 resumes from the message of the event not flushed.
+	mock.ExpectBegin()
+	mock.ExpectExec("`s1`.`t1`").WithArgs(1, "test").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("`s1`.`t1`").WithArgs(2, "test").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(testCheckpointSQL).
+		WithArgs(testTopic, 0, 6, 25, 0, int64(kafka.OffsetInvalid),
+			testTopic, 1, 20, 25, 0, int64(kafka.OffsetInvalid)).
+		WillReturnResult(sqlmock.NewResult(2, 2))
+	mock.ExpectCommit()
+	w.flushRowChangedEvents(context.Background(), 25)
+	require.Len(t, p0.pendingEvents, 1)
+	require.Equal(t, []kafka.Offset{6}, p0.pendingOffsets)
+	require.Empty(t, p1.pendingEvents)
+	require.Empty(t, p1.pendingOffsets)
+	require.Equal(t, uint64(25), c.watermark)
+
+	// the watermark is already flushed.
+	w.flushRowChangedEvents(context.Background(), 25)
+	require.Len(t, p0.pendingEvents, 1)
+}
+
+func TestResumeFromCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	c, _ := newTestCheckpointer(t)
+	c.watermark = 20
+	c.ddlCommitTs = 15
+	c.ddlOffset = 2
+	w := newTestWriter(1, c)
+	progress := w.progresses[0]
+
+	// the events and DDLs applied before the restart are ignored.
+	w.appendRow2Group(eventgroup.NewTestRow(20), progress, kafka.Offset(1))
+	require.Empty(t, progress.eventGroups)
+	w.appendDDL(&model.DDLEvent{CommitTs: 15, Query: "create table t(a int)"}, kafka.Offset(2))
+	require.Empty(t, w.ddlList)
+
+	w.appendRow2Group(eventgroup.NewTestRow(21), progress, kafka.Offset(3))
+	require.Len(t, progress.eventGroups[1].Positions(), 1)
+	w.appendDDL(&model.DDLEvent{CommitTs: 16, Query: "alter table t add column b int"}, kafka.Offset(4))
+	require.Len(t, w.ddlList, 1)
+	require.Equal(t, []kafka.Offset{4}, w.ddlOffsets)
+}
+
+func TestResumeFromCheckpointWithMultiDDLs(t *testing.T) {
+	t.Parallel()
+
+	// the first DDL of a RENAME TABLES job is executed before the restart.
+	c, _ := newTestCheckpointer(t)
+	c.ddlCommitTs = 15
+	c.ddlOffset = 2
+	w := newTestWriter(1, c)
+
+	w.appendDDL(&model.DDLEvent{CommitTs: 15, Query: "rename table t1 to t3"}, kafka.Offset(2))
+	require.Empty(t, w.ddlList)
+	// the remaining DDLs with the same commitTs are not ignored.
+	w.appendDDL(&model.DDLEvent{CommitTs: 15, Query: "rename table t2 to t4"}, kafka.Offset(3))
+	require.Len(t, w.ddlList, 1)
+	require.Equal(t, []kafka.Offset{3}, w.ddlOffsets)
+}
@@ -0,0 +1,92 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	tpulsar "github.com/pingcap/tiflow/pkg/sink/pulsar"
+	"go.uber.org/zap"
+)
+
+type consumer struct {
+	client   pulsar.Client
+	consumer pulsar.Consumer
+	writer   *writer
+}
+
+// newConsumer will create a consumer client.
+func newConsumer(ctx context.Context, o *ConsumerOption) (*consumer, error) {
+	clientOption, err := tpulsar.NewClientOptions(o.pulsarConfig, o.replicaConfig.Sink)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	client, err := pulsar.NewClient(clientOption)
+	if err != nil {
+		return nil, errors.Annotate(err, "can't create pulsar client")
+	}
+	partitions, err := client.TopicPartitions(o.topic)
+	if err != nil {
+		client.Close()
+		return nil, errors.Annotate(err, "can't get the partitions of the topic")
+	}
+	log.Info("get partition number of topic",
+		zap.String("topic", o.topic), zap.Int("partitionNum", len(partitions)))
+
+	// Messages are acknowledged after they are flushed to the downstream,
+	// the unacknowledged messages are redelivered after the consumer restarts.
+	pulsarConsumer, err := client.Subscribe(pulsar.ConsumerOptions{
+		Topic:                       o.topic,
+		SubscriptionName:            o.subscriptionName,
+		Type:                        pulsar.Exclusive,
+		SubscriptionInitialPosition: pulsar.SubscriptionPositionEarliest,
+	})
+	if err != nil {
+		client.Close()
+		return nil, errors.Annotate(err, "can't create pulsar consumer")
+	}
+	return &consumer{
+		client:   client,
+		consumer: pulsarConsumer,
+		writer:   newWriter(ctx, o, len(partitions)),
+	}, nil
+}
+
+// Consume will read message from Pulsar.
+func (c *consumer) Consume(ctx context.Context) {
+	defer func() {
+		c.consumer.Close()
+		c.client.Close()
+	}()
+	msgChan := c.consumer.Chan()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("consumer exist: context cancelled")
+			return
+		case msg := <-msgChan:
+			log.Debug("message received", zap.Stringer("msgID", msg.ID()),
+				zap.String("key", msg.Key()), zap.ByteString("payload", msg.Payload()))
+			for _, msgID := range c.writer.WriteMessage(ctx, msg.Message) {
+				if err := c.consumer.AckID(msgID); err != nil {
+					log.Error("ack message failed, just continue",
+						zap.Stringer("msgID", msgID), zap.Error(err))
+				}
+			}
+		}
+	}
+}
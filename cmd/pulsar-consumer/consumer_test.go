@@ -0,0 +1,103 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/pingcap/tiflow/pkg/cmd/eventgroup"
+	"github.com/stretchr/testify/require"
+)
+
+type mockPulsarClient struct {
+	pulsar.Client
+	closed bool
+}
+
+func (c *mockPulsarClient) Close() {
+	c.closed = true
+}
+
+type mockPulsarConsumer struct {
+	pulsar.Consumer
+	ch chan pulsar.ConsumerMessage
+
+	mu     sync.Mutex
+	acked  []pulsar.MessageID
+	closed bool
+}
+
+func (c *mockPulsarConsumer) Chan() <-chan pulsar.ConsumerMessage {
+	return c.ch
+}
+
+func (c *mockPulsarConsumer) AckID(msgID pulsar.MessageID) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.acked = append(c.acked, msgID)
+	return nil
+}
+
+func (c *mockPulsarConsumer) ackedIDs() []pulsar.MessageID {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]pulsar.MessageID(nil), c.acked...)
+}
+
+func (c *mockPulsarConsumer) Close() {
+	c.closed = true
+}
+
+func TestConsume(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	w, decoders := newTestWriter(ctx, t, 1, "blackhole://")
+	client := &mockPulsarClient{}
+	pulsarConsumer := &mockPulsarConsumer{ch: make(chan pulsar.ConsumerMessage)}
+	c := &consumer{client: client, consumer: pulsarConsumer, writer: w}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		c.Consume(ctx)
+	}()
+
+	send := func(entryID int64, event interface{}) pulsar.MessageID {
+		msgID := pulsar.NewMessageID(1, entryID, 0, 0)
+		decoders[0].push(event)
+		pulsarConsumer.ch <- pulsar.ConsumerMessage{
+			Consumer: pulsarConsumer,
+			Message:  &mockMessage{id: msgID},
+		}
+		return msgID
+	}
+	row := send(1, eventgroup.NewTestRow(10))
+	resolved := send(2, uint64(10))
+	// the messages are acknowledged after the events are flushed.
+	require.Eventually(t, func() bool {
+		return len(pulsarConsumer.ackedIDs()) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	require.ElementsMatch(t, []pulsar.MessageID{row, resolved}, pulsarConsumer.ackedIDs())
+
+	// the consumer and the client are closed after the context is canceled.
+	cancel()
+	<-done
+	require.True(t, pulsarConsumer.closed)
+	require.True(t, client.closed)
+}
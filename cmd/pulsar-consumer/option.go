@@ -0,0 +1,201 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	sutil "github.com/pingcap/tiflow/cdc/sink/util"
+	cmdUtil "github.com/pingcap/tiflow/pkg/cmd/util"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/filter"
+	"github.com/pingcap/tiflow/pkg/sink"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	tpulsar "github.com/pingcap/tiflow/pkg/sink/pulsar"
+	"github.com/pingcap/tiflow/pkg/util"
+	"go.uber.org/zap"
+)
+
+const defaultSubscriptionName = "pulsar-test-subscription"
+
+// ConsumerOption represents the options of the pulsar consumer
+type ConsumerOption struct {
+	address          []string
+	topic            string
+	subscriptionName string
+
+	protocol    config.Protocol
+	codecConfig *common.Config
+	// pulsarConfig is built from the upstream uri and the config file,
+	// it contains the TLS and authentication settings of the pulsar client.
+	pulsarConfig *config.PulsarConfig
+
+	// the replicaConfig of the changefeed which produce data to the pulsar topic
+	replicaConfig *config.ReplicaConfig
+
+	logPath       string
+	logLevel      string
+	timezone      string
+	ca, cert, key string
+
+	oauth2PrivateKey string
+	oauth2IssuerURL  string
+	oauth2ClientID   string
+	oauth2Scope      string
+	oauth2Audience   string
+
+	mtlsAuthTLSCertificatePath string
+	mtlsAuthTLSPrivateKeyPath  string
+
+	downstreamURI string
+
+	// upstreamTiDBDSN is the dsn of the upstream TiDB cluster
+	upstreamTiDBDSN string
+}
+
+func newConsumerOption() *ConsumerOption {
+	return &ConsumerOption{
+		protocol:         config.ProtocolCanalJSON,
+		subscriptionName: defaultSubscriptionName,
+	}
+}
+
+// Adjust the consumer option by the upstream uri passed in parameters.
+func (o *ConsumerOption) Adjust(upstreamURI *url.URL, configFile string) error {
+	o.topic = strings.TrimFunc(upstreamURI.Path, func(r rune) bool {
+		return r == '/'
+	})
+	o.address = strings.Split(upstreamURI.Host, ",")
+
+	s := upstreamURI.Query().Get("protocol")
+	if s != "" {
+		protocol, err := config.ParseSinkProtocolFromString(s)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		o.protocol = protocol
+	}
+	if !sutil.IsPulsarSupportedProtocols(o.protocol) {
+		return cerror.ErrSinkUnknownProtocol.GenWithStackByArgs(o.protocol.String())
+	}
+
+	replicaConfig := config.GetDefaultReplicaConfig()
+	// the TiDB source ID should never be set to 0
+	replicaConfig.Sink.TiDBSourceID = 1
+	replicaConfig.Sink.Protocol = util.AddressOf(o.protocol.String())
+	if configFile != "" {
+		err := cmdUtil.StrictDecodeFile(configFile, "pulsar consumer", replicaConfig)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if _, err = filter.VerifyTableRules(replicaConfig.Filter); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	o.replicaConfig = replicaConfig
+
+	o.codecConfig = common.NewConfig(o.protocol)
+	if err := o.codecConfig.Apply(upstreamURI, o.replicaConfig); err != nil {
+		return errors.Trace(err)
+	}
+	tz, err := util.GetTimezone(o.timezone)
+	if err != nil {
+		return errors.Annotate(err, "can not load timezone")
+	}
+	o.codecConfig.TimeZone = tz
+
+	if err = o.adjustPulsarConfig(upstreamURI); err != nil {
+		return errors.Trace(err)
+	}
+	if err = o.adjustDownstreamURI(); err != nil {
+		return errors.Trace(err)
+	}
+
+	log.Info("consumer option adjusted",
+		zap.String("configFile", configFile),
+		zap.String("address", strings.Join(o.address, ",")),
+		zap.String("topic", o.topic),
+		zap.String("subscriptionName", o.subscriptionName),
+		zap.Any("protocol", o.protocol),
+		zap.Bool("enableTiDBExtension", o.codecConfig.EnableTiDBExtension),
+		zap.String("brokerURL", o.pulsarConfig.BrokerURL))
+	return nil
+}
+
+// adjustDownstreamURI enables the safe mode of the database downstream.
+// Messages are acknowledged after the events in them are flushed, so the flushed
+// but not acknowledged messages are redelivered after the consumer restarts.
+// The decoded rows don't carry the replicating ts, without the safe mode the
+// replayed transactions are translated to INSERT statements, which fail with
+// duplicate key errors.
+func (o *ConsumerOption) adjustDownstreamURI() error {
+	downstreamURI, err := url.Parse(o.downstreamURI)
+	if err != nil {
+		return cerror.WrapError(cerror.ErrSinkURIInvalid, err)
+	}
+	scheme := strings.ToLower(downstreamURI.Scheme)
+	if !sink.IsMySQLCompatibleScheme(scheme) && !sink.IsPostgresScheme(scheme) {
+		return nil
+	}
+	query := downstreamURI.Query()
+	if query.Get("safe-mode") == "false" {
+		log.Warn("safe mode is always enabled for the database downstream, " +
+			"since the unacknowledged messages are redelivered after the consumer restarts")
+	}
+	query.Set("safe-mode", "true")
+	downstreamURI.RawQuery = query.Encode()
+	o.downstreamURI = downstreamURI.String()
+	return nil
+}
+
+// adjustPulsarConfig merges the pulsar config in the config file with the
+// upstream uri, the TLS and authentication settings passed by the command
+// line flags take precedence over the config file.
+func (o *ConsumerOption) adjustPulsarConfig(upstreamURI *url.URL) error {
+	pulsarConfig, err := tpulsar.NewPulsarConfig(upstreamURI, o.replicaConfig.Sink.PulsarConfig)
+	if err != nil {
+		return cerror.WrapError(cerror.ErrPulsarInvalidConfig, err)
+	}
+	if len(o.ca) != 0 {
+		pulsarConfig.TLSTrustCertsFilePath = util.AddressOf(o.ca)
+		if len(o.cert) != 0 && len(o.key) != 0 {
+			pulsarConfig.TLSCertificateFile = util.AddressOf(o.cert)
+			pulsarConfig.TLSKeyFilePath = util.AddressOf(o.key)
+		}
+		// keep compatible with the uri using the `pulsar` scheme with the ca specified.
+		if strings.ToLower(upstreamURI.Scheme) == sink.PulsarScheme {
+			pulsarConfig.BrokerURL = sink.PulsarSSLScheme + "://" + upstreamURI.Host
+		}
+	}
+	if len(o.oauth2PrivateKey) != 0 {
+		pulsarConfig.OAuth2 = &config.OAuth2{
+			OAuth2IssuerURL:  o.oauth2IssuerURL,
+			OAuth2Audience:   o.oauth2Audience,
+			OAuth2PrivateKey: o.oauth2PrivateKey,
+			OAuth2ClientID:   o.oauth2ClientID,
+			OAuth2Scope:      o.oauth2Scope,
+		}
+	}
+	if len(o.mtlsAuthTLSCertificatePath) != 0 {
+		pulsarConfig.AuthTLSCertificatePath = util.AddressOf(o.mtlsAuthTLSCertificatePath)
+		pulsarConfig.AuthTLSPrivateKeyPath = util.AddressOf(o.mtlsAuthTLSPrivateKeyPath)
+	}
+	o.pulsarConfig = pulsarConfig
+	o.replicaConfig.Sink.PulsarConfig = pulsarConfig
+	return nil
+}
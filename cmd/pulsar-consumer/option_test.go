@@ -0,0 +1,148 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestConsumerOption(t *testing.T, uri string, configFile string) (*ConsumerOption, error) {
+	upstreamURI, err := url.Parse(uri)
+	require.NoError(t, err)
+	o := newConsumerOption()
+	o.timezone = "UTC"
+	return o, o.Adjust(upstreamURI, configFile)
+}
+
+func TestAdjust(t *testing.T) {
+	t.Parallel()
+
+	o, err := newTestConsumerOption(t,
+		"pulsar://127.0.0.1:6650,127.0.0.2:6650/persistent://public/default/test?enable-tidb-extension=true", "")
+	require.NoError(t, err)
+	require.Equal(t, "persistent://public/default/test", o.topic)
+	require.Equal(t, []string{"127.0.0.1:6650", "127.0.0.2:6650"}, o.address)
+	require.Equal(t, defaultSubscriptionName, o.subscriptionName)
+	require.Equal(t, config.ProtocolCanalJSON, o.protocol)
+	require.Equal(t, "canal-json", *o.replicaConfig.Sink.Protocol)
+	require.Equal(t, uint64(1), o.replicaConfig.Sink.TiDBSourceID)
+	require.True(t, o.codecConfig.EnableTiDBExtension)
+	require.Equal(t, "UTC", o.codecConfig.TimeZone.String())
+	require.Equal(t, "pulsar://127.0.0.1:6650,127.0.0.2:6650", o.pulsarConfig.BrokerURL)
+	require.Same(t, o.pulsarConfig, o.replicaConfig.Sink.PulsarConfig)
+
+	// only canal-json is supported.
+	_, err = newTestConsumerOption(t, "pulsar://127.0.0.1:6650/test?protocol=avro", "")
+	require.ErrorIs(t, err, cerror.ErrSinkUnknownProtocol)
+	_, err = newTestConsumerOption(t, "pulsar://127.0.0.1:6650/test?protocol=unknown", "")
+	require.Error(t, err)
+}
+
+func TestAdjustWithConfigFile(t *testing.T) {
+	t.Parallel()
+
+	configFile := filepath.Join(t.TempDir(), "changefeed.toml")
+	content := `
+[filter]
+rules = ['test.*']
+
+[sink.pulsar-config]
+pulsar-producer-cache-size = 100
+`
+	require.NoError(t, os.WriteFile(configFile, []byte(content), 0o644))
+	o, err := newTestConsumerOption(t, "pulsar://127.0.0.1:6650/test?protocol=canal-json", configFile)
+	require.NoError(t, err)
+	require.Equal(t, []string{"test.*"}, o.replicaConfig.Filter.Rules)
+	require.Equal(t, int32(100), *o.pulsarConfig.PulsarProducerCacheSize)
+	require.Equal(t, "pulsar://127.0.0.1:6650", o.pulsarConfig.BrokerURL)
+
+	// unknown config items are rejected.
+	require.NoError(t, os.WriteFile(configFile, []byte("unknown-item = 1"), 0o644))
+	_, err = newTestConsumerOption(t, "pulsar://127.0.0.1:6650/test", configFile)
+	require.Error(t, err)
+
+	// invalid filter rules are rejected.
+	require.NoError(t, os.WriteFile(configFile, []byte("[filter]\nrules = ['a.b.c']"), 0o644))
+	_, err = newTestConsumerOption(t, "pulsar://127.0.0.1:6650/test", configFile)
+	require.Error(t, err)
+}
+
+func TestAdjustPulsarConfig(t *testing.T) {
+	t.Parallel()
+
+	upstreamURI, err := url.Parse("pulsar://127.0.0.1:6650/test")
+	require.NoError(t, err)
+
+	o := newConsumerOption()
+	o.timezone = "UTC"
+	o.ca, o.cert, o.key = "ca.pem", "cert.pem", "key.pem"
+	o.oauth2PrivateKey = "private.key"
+	o.oauth2IssuerURL = "https://issuer"
+	o.oauth2ClientID = "client"
+	o.mtlsAuthTLSCertificatePath = "auth-cert.pem"
+	o.mtlsAuthTLSPrivateKeyPath = "auth-key.pem"
+	require.NoError(t, o.Adjust(upstreamURI, ""))
+
+	// the ca switches the `pulsar` scheme to `pulsar+ssl`.
+	require.Equal(t, "pulsar+ssl://127.0.0.1:6650", o.pulsarConfig.BrokerURL)
+	require.Equal(t, "ca.pem", *o.pulsarConfig.TLSTrustCertsFilePath)
+	require.Equal(t, "cert.pem", *o.pulsarConfig.TLSCertificateFile)
+	require.Equal(t, "key.pem", *o.pulsarConfig.TLSKeyFilePath)
+	require.Equal(t, "private.key", o.pulsarConfig.OAuth2.OAuth2PrivateKey)
+	require.Equal(t, "https://issuer", o.pulsarConfig.OAuth2.OAuth2IssuerURL)
+	require.Equal(t, "client", o.pulsarConfig.OAuth2.OAuth2ClientID)
+	require.Equal(t, "auth-cert.pem", *o.pulsarConfig.AuthTLSCertificatePath)
+	require.Equal(t, "auth-key.pem", *o.pulsarConfig.AuthTLSPrivateKeyPath)
+
+	// the cert and key are ignored without the ca.
+	o = newConsumerOption()
+	o.timezone = "UTC"
+	o.cert, o.key = "cert.pem", "key.pem"
+	require.NoError(t, o.Adjust(upstreamURI, ""))
+	require.Equal(t, "pulsar://127.0.0.1:6650", o.pulsarConfig.BrokerURL)
+	require.Nil(t, o.pulsarConfig.TLSCertificateFile)
+	require.Nil(t, o.pulsarConfig.OAuth2)
+}
+
+func TestAdjustDownstreamURI(t *testing.T) {
+	t.Parallel()
+
+	upstreamURI, err := url.Parse("pulsar://127.0.0.1:6650/test")
+	require.NoError(t, err)
+	for _, tc := range []struct {
+		downstreamURI string
+		expected      string
+	}{
+		{"mysql://127.0.0.1:3306/", "mysql://127.0.0.1:3306/?safe-mode=true"},
+		{"tidb://127.0.0.1:4000/?worker-count=1", "tidb://127.0.0.1:4000/?safe-mode=true&worker-count=1"},
+		// the safe mode is enabled even if it's disabled explicitly.
+		{"mysql://127.0.0.1:3306/?safe-mode=false", "mysql://127.0.0.1:3306/?safe-mode=true"},
+		{"postgres://127.0.0.1:5432/", "postgres://127.0.0.1:5432/?safe-mode=true"},
+		{"blackhole://", "blackhole://"},
+		{"file:///tmp/test", "file:///tmp/test"},
+	} {
+		o := newConsumerOption()
+		o.timezone = "UTC"
+		o.downstreamURI = tc.downstreamURI
+		require.NoError(t, o.Adjust(upstreamURI, ""))
+		require.Equal(t, tc.expected, o.downstreamURI)
+	}
+}
@@ -0,0 +1,488 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/ddlsink"
+	ddlsinkfactory "github.com/pingcap/tiflow/cdc/sink/ddlsink/factory"
+	eventsinkfactory "github.com/pingcap/tiflow/cdc/sink/dmlsink/factory"
+	"github.com/pingcap/tiflow/cdc/sink/tablesink"
+	"github.com/pingcap/tiflow/pkg/cmd/eventgroup"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink/codec"
+	"github.com/pingcap/tiflow/pkg/sink/codec/canal"
+	"github.com/pingcap/tiflow/pkg/spanz"
+	"go.uber.org/zap"
+)
+
+// NewDecoder will create a new event decoder
+func NewDecoder(ctx context.Context, option *ConsumerOption, upstreamTiDB *sql.DB) (codec.RowEventDecoder, error) {
+	var (
+		decoder codec.RowEventDecoder
+		err     error
+	)
+	switch option.protocol {
+	case config.ProtocolCanalJSON:
+		decoder, err = canal.NewBatchDecoder(ctx, option.codecConfig, upstreamTiDB)
+	default:
+		log.Panic("Protocol not supported", zap.Any("Protocol", option.protocol))
+	}
+	if err != nil {
+		return nil, cerror.Trace(err)
+	}
+	return decoder, err
+}
+
+// pendingMessage is a message which is not acknowledged yet,
+// it can be acknowledged after all events in it are flushed.
+type pendingMessage struct {
+	msgID pulsar.MessageID
+	// commitTs is the max commitTs of events in the message.
+	commitTs uint64
+}
+
+type partitionProgress struct {
+	partition       int32
+	watermark       uint64
+	watermarkMsgID  pulsar.MessageID
+	pendingMessages []pendingMessage
+
+	tableSinkMap map[model.TableID]tablesink.TableSink
+	eventGroups  map[model.TableID]*eventgroup.Group[pulsar.MessageID]
+	decoder      codec.RowEventDecoder
+}
+
+func newPartitionProgress(partition int32, decoder codec.RowEventDecoder) *partitionProgress {
+	return &partitionProgress{
+		partition:    partition,
+		eventGroups:  make(map[model.TableID]*eventgroup.Group[pulsar.MessageID]),
+		tableSinkMap: make(map[model.TableID]tablesink.TableSink),
+		decoder:      decoder,
+	}
+}
+
+func (p *partitionProgress) updateWatermark(newWatermark uint64, msgID pulsar.MessageID) {
+	if newWatermark >= p.watermark {
+		p.watermark = newWatermark
+		p.watermarkMsgID = msgID
+		log.Info("watermark received", zap.Int32("partition", p.partition),
+			zap.Stringer("msgID", msgID), zap.Uint64("watermark", newWatermark))
+		return
+	}
+	// the message may be redelivered since it's not acknowledged in time.
+	log.Warn("partition resolved ts fall back, ignore it, since consumer read old message",
+		zap.Int32("partition", p.partition),
+		zap.Uint64("newWatermark", newWatermark), zap.Stringer("msgID", msgID),
+		zap.Uint64("watermark", p.watermark), zap.Stringer("watermarkMsgID", p.watermarkMsgID))
+}
+
+func (p *partitionProgress) loadWatermark() uint64 {
+	return p.watermark
+}
+
+type writer struct {
+	option *ConsumerOption
+
+	ddlList            []*model.DDLEvent
+	ddlWithMaxCommitTs *model.DDLEvent
+	ddlSink            ddlsink.Sink
+
+	// sinkFactory is used to create table sink for each table.
+	sinkFactory *eventsinkfactory.SinkFactory
+	progresses  []*partitionProgress
+}
+
+func newWriter(ctx context.Context, o *ConsumerOption, partitionNum int) *writer {
+	w := &writer{
+		option:     o,
+		progresses: make([]*partitionProgress, partitionNum),
+	}
+	var (
+		db  *sql.DB
+		err error
+	)
+	if o.upstreamTiDBDSN != "" {
+		db, err = openDB(ctx, o.upstreamTiDBDSN)
+		if err != nil {
+			log.Panic("cannot open the upstream TiDB, handle key only enabled",
+				zap.String("dsn", o.upstreamTiDBDSN))
+		}
+	}
+	for i := 0; i < partitionNum; i++ {
+		decoder, err := NewDecoder(ctx, o, db)
+		if err != nil {
+			log.Panic("cannot create the decoder", zap.Error(err))
+		}
+		w.progresses[i] = newPartitionProgress(int32(i), decoder)
+	}
+
+	config.GetGlobalServerConfig().TZ = o.timezone
+	errChan := make(chan error, 1)
+	changefeed := model.DefaultChangeFeedID("pulsar-consumer")
+	f, err := eventsinkfactory.New(ctx, changefeed, o.downstreamURI, o.replicaConfig, errChan, nil)
+	if err != nil {
+		log.Panic("cannot create the event sink factory", zap.Error(err))
+	}
+	w.sinkFactory = f
+
+	go func() {
+		err := <-errChan
+		if !errors.Is(cerror.Cause(err), context.Canceled) {
+			log.Error("error on running consumer", zap.Error(err))
+		} else {
+			log.Info("consumer exited")
+		}
+	}()
+
+	ddlSink, err := ddlsinkfactory.New(ctx, changefeed, o.downstreamURI, o.replicaConfig)
+	if err != nil {
+		log.Panic("cannot create the ddl sink factory", zap.Error(err))
+	}
+	w.ddlSink = ddlSink
+	return w
+}
+
+// append DDL wait to be handled, only consider the constraint among DDLs.
+// for DDL a / b received in the order, a.CommitTs < b.CommitTs should be true.
+func (w *writer) appendDDL(ddl *model.DDLEvent, msgID pulsar.MessageID) {
+	// DDL CommitTs fallback, the message may be redelivered, just ignore it.
+	if w.ddlWithMaxCommitTs != nil && ddl.CommitTs < w.ddlWithMaxCommitTs.CommitTs {
+		log.Warn("DDL CommitTs < maxCommitTsDDL.CommitTs",
+			zap.Uint64("commitTs", ddl.CommitTs),
+			zap.Uint64("maxCommitTs", w.ddlWithMaxCommitTs.CommitTs),
+			zap.String("DDL", ddl.Query))
+		return
+	}
+
+	// A rename tables DDL job contains multiple DDL events with same CommitTs.
+	// So to tell if a DDL is redundant or not, we must check the equivalence of
+	// the current DDL and the DDL with max CommitTs.
+	if ddl == w.ddlWithMaxCommitTs {
+		log.Warn("ignore redundant DDL, the DDL is equal to ddlWithMaxCommitTs",
+			zap.Uint64("commitTs", ddl.CommitTs), zap.String("DDL", ddl.Query))
+		return
+	}
+
+	w.ddlList = append(w.ddlList, ddl)
+	w.ddlWithMaxCommitTs = ddl
+	log.Info("DDL message received", zap.Stringer("msgID", msgID),
+		zap.Uint64("commitTs", ddl.CommitTs), zap.String("DDL", ddl.Query))
+}
+
+func (w *writer) getFrontDDL() *model.DDLEvent {
+	if len(w.ddlList) > 0 {
+		return w.ddlList[0]
+	}
+	return nil
+}
+
+func (w *writer) popDDL() {
+	if len(w.ddlList) > 0 {
+		w.ddlList = w.ddlList[1:]
+	}
+}
+
+func (w *writer) getMinWatermark() uint64 {
+	result := uint64(math.MaxUint64)
+	for _, p := range w.progresses {
+		watermark := p.loadWatermark()
+		if watermark < result {
+			result = watermark
+		}
+	}
+	return result
+}
+
+// partition progress could be executed at the same time
+func (w *writer) forEachPartition(fn func(p *partitionProgress)) {
+	var wg sync.WaitGroup
+	for _, p := range w.progresses {
+		wg.Add(1)
+		go func(p *partitionProgress) {
+			defer wg.Done()
+			fn(p)
+		}(p)
+	}
+	wg.Wait()
+}
+
+// Write will synchronously write data downstream,
+// and returns messages which can be acknowledged.
+func (w *writer) Write(ctx context.Context, messageType model.MessageType) []pulsar.MessageID {
+	watermark := w.getMinWatermark()
+	var (
+		todoDDL   *model.DDLEvent
+		flushedTs uint64
+	)
+	for {
+		todoDDL = w.getFrontDDL()
+		// watermark is the min value for all partitions,
+		// the DDL only executed by the first partition, other partitions may be slow
+		// so that the watermark can be smaller than the DDL's commitTs,
+		// which means some DML events may not be consumed yet, so cannot execute the DDL right now.
+		if todoDDL == nil || todoDDL.CommitTs > watermark {
+			break
+		}
+		// flush DMLs
+		w.forEachPartition(func(sink *partitionProgress) {
+			syncFlushRowChangedEvents(ctx, sink, todoDDL.CommitTs)
+		})
+		// DDL can be executed, do it first.
+		if err := w.ddlSink.WriteDDLEvent(ctx, todoDDL); err != nil {
+			log.Panic("write DDL event failed", zap.Error(err),
+				zap.String("DDL", todoDDL.Query), zap.Uint64("commitTs", todoDDL.CommitTs))
+		}
+		w.popDDL()
+		flushedTs = todoDDL.CommitTs
+	}
+
+	if messageType == model.MessageTypeResolved {
+		w.forEachPartition(func(sink *partitionProgress) {
+			syncFlushRowChangedEvents(ctx, sink, watermark)
+		})
+		flushedTs = watermark
+	}
+
+	// The DDL events will only execute in partition0
+	if messageType == model.MessageTypeDDL && todoDDL != nil {
+		log.Info("DDL event will be flushed in the future",
+			zap.Uint64("watermark", watermark),
+			zap.Uint64("CommitTs", todoDDL.CommitTs),
+			zap.String("Query", todoDDL.Query))
+	}
+	return w.popFlushedMessages(flushedTs)
+}
+
+// popFlushedMessages returns messages which all events in it are flushed
+// to the downstream, the returned messages are removed from the pending list.
+func (w *writer) popFlushedMessages(flushedTs uint64) []pulsar.MessageID {
+	var result []pulsar.MessageID
+	for _, p := range w.progresses {
+		remain := p.pendingMessages[:0]
+		for _, m := range p.pendingMessages {
+			if m.commitTs <= flushedTs {
+				result = append(result, m.msgID)
+				continue
+			}
+			remain = append(remain, m)
+		}
+		p.pendingMessages = remain
+	}
+	return result
+}
+
+// WriteMessage is to decode pulsar message to event,
+// and returns messages which can be acknowledged.
+func (w *writer) WriteMessage(ctx context.Context, message pulsar.Message) []pulsar.MessageID {
+	var (
+		key       = message.Key()
+		value     = message.Payload()
+		msgID     = message.ID()
+		partition = msgID.PartitionIdx()
+	)
+	// the partition index of the non-partitioned topic is -1.
+	if partition < 0 {
+		partition = 0
+	}
+
+	progress := w.progresses[partition]
+	if err := progress.decoder.AddKeyValue([]byte(key), value); err != nil {
+		log.Panic("add key value to the decoder failed",
+			zap.Int32("partition", partition), zap.Stringer("msgID", msgID), zap.Error(err))
+	}
+	var (
+		needFlush   bool
+		messageType model.MessageType
+		// maxCommitTs is the max commitTs of events in the message
+		// which are not flushed to the downstream yet.
+		maxCommitTs uint64
+	)
+	for {
+		ty, hasNext, err := progress.decoder.HasNext()
+		if err != nil {
+			log.Panic("decode message key failed",
+				zap.Int32("partition", partition), zap.Stringer("msgID", msgID), zap.Error(err))
+		}
+		if !hasNext {
+			break
+		}
+		messageType = ty
+		switch messageType {
+		case model.MessageTypeDDL:
+			// for some protocol, DDL would be dispatched to all partitions,
+			// so we only handle DDL received from partition-0 should be enough.
+			// but all DDL event messages should be consumed.
+			ddl, err := progress.decoder.NextDDLEvent()
+			if err != nil {
+				log.Panic("decode message value failed",
+					zap.Int32("partition", partition), zap.Stringer("msgID", msgID),
+					zap.ByteString("value", value), zap.Error(err))
+			}
+			if partition == 0 {
+				w.appendDDL(ddl, msgID)
+				if ddl.CommitTs > maxCommitTs {
+					maxCommitTs = ddl.CommitTs
+				}
+			}
+			needFlush = true
+		case model.MessageTypeRow:
+			row, err := progress.decoder.NextRowChangedEvent()
+			if err != nil {
+				log.Panic("decode message value failed",
+					zap.Int32("partition", partition), zap.Stringer("msgID", msgID),
+					zap.ByteString("value", value), zap.Error(err))
+			}
+			if w.appendRow2Group(row, progress, msgID) && row.CommitTs > maxCommitTs {
+				maxCommitTs = row.CommitTs
+			}
+		case model.MessageTypeResolved:
+			newWatermark, err := progress.decoder.NextResolvedEvent()
+			if err != nil {
+				log.Panic("decode message value failed",
+					zap.Int32("partition", partition), zap.Stringer("msgID", msgID),
+					zap.ByteString("value", value), zap.Error(err))
+			}
+
+			progress.updateWatermark(newWatermark, msgID)
+			w.resolveRowChangedEvents(progress, newWatermark)
+			needFlush = true
+		default:
+			log.Panic("unknown message type", zap.Any("messageType", messageType),
+				zap.Int32("partition", partition), zap.Stringer("msgID", msgID))
+		}
+	}
+	progress.pendingMessages = append(progress.pendingMessages, pendingMessage{
+		msgID:    msgID,
+		commitTs: maxCommitTs,
+	})
+
+	if !needFlush {
+		return nil
+	}
+	// flush when received DDL event or resolvedTs
+	return w.Write(ctx, messageType)
+}
+
+func (w *writer) resolveRowChangedEvents(progress *partitionProgress, newWatermark uint64) {
+	for tableID, group := range progress.eventGroups {
+		events, _ := group.Resolve(newWatermark)
+		if len(events) == 0 {
+			continue
+		}
+		tableSink, ok := progress.tableSinkMap[tableID]
+		if !ok {
+			tableSink = w.sinkFactory.CreateTableSinkForConsumer(
+				model.DefaultChangeFeedID("pulsar-consumer"),
+				spanz.TableIDToComparableSpan(tableID),
+				events[0].CommitTs,
+			)
+			progress.tableSinkMap[tableID] = tableSink
+		}
+		tableSink.AppendRowChangedEvents(events...)
+	}
+}
+
+// appendRow2Group appends the row to the event group,
+// returns false if the row is ignored.
+func (w *writer) appendRow2Group(row *model.RowChangedEvent, progress *partitionProgress, msgID pulsar.MessageID) bool {
+	// if the message is redelivered, the row may be less than the watermark, ignore it.
+	watermark := progress.loadWatermark()
+	partition := progress.partition
+
+	tableID := row.GetTableID()
+	group := progress.eventGroups[tableID]
+	if group == nil {
+		group = eventgroup.New[pulsar.MessageID](partition, tableID)
+		progress.eventGroups[tableID] = group
+	}
+	if row.CommitTs < watermark {
+		log.Warn("RowChanged Event fallback row, since less than the partition watermark, ignore it",
+			zap.Int64("tableID", tableID), zap.Int32("partition", partition),
+			zap.Uint64("commitTs", row.CommitTs), zap.Stringer("msgID", msgID),
+			zap.Uint64("watermark", watermark), zap.Stringer("watermarkMsgID", progress.watermarkMsgID),
+			zap.String("schema", row.TableInfo.GetSchemaName()), zap.String("table", row.TableInfo.GetTableName()),
+			zap.Any("columns", row.Columns), zap.Any("preColumns", row.PreColumns))
+		return false
+	}
+	if row.CommitTs >= group.HighWatermark() {
+		group.Append(row, msgID)
+		return true
+	}
+	// canal-json protocol set the table id by the fake table id generator, one event group
+	// for one table, so replayed messages can be ignored.
+	log.Warn("RowChangedEvent fallback row, since less than the group high watermark, ignore it",
+		zap.Int64("tableID", tableID), zap.Int32("partition", partition),
+		zap.Uint64("commitTs", row.CommitTs), zap.Stringer("msgID", msgID),
+		zap.Uint64("highWatermark", group.HighWatermark()),
+		zap.Any("partitionWatermark", watermark), zap.Stringer("watermarkMsgID", progress.watermarkMsgID),
+		zap.String("schema", row.TableInfo.GetSchemaName()), zap.String("table", row.TableInfo.GetTableName()),
+		zap.Any("columns", row.Columns), zap.Any("preColumns", row.PreColumns))
+	return false
+}
+
+func syncFlushRowChangedEvents(ctx context.Context, progress *partitionProgress, watermark uint64) {
+	resolvedTs := model.NewResolvedTs(watermark)
+	for {
+		select {
+		case <-ctx.Done():
+			log.Warn("sync flush row changed event canceled", zap.Error(ctx.Err()))
+			return
+		default:
+		}
+		flushedResolvedTs := true
+		for _, tableSink := range progress.tableSinkMap {
+			if err := tableSink.UpdateResolvedTs(resolvedTs); err != nil {
+				log.Panic("Failed to update resolved ts", zap.Error(err))
+			}
+			if tableSink.GetCheckpointTs().Less(resolvedTs) {
+				flushedResolvedTs = false
+			}
+		}
+		if flushedResolvedTs {
+			return
+		}
+	}
+}
+
+func openDB(ctx context.Context, dsn string) (*sql.DB, error) {
+	db, err := sql.Open("mysql", dsn)
+	if err != nil {
+		log.Error("open db failed", zap.Error(err))
+		return nil, cerror.Trace(err)
+	}
+
+	db.SetMaxOpenConns(10)
+	db.SetMaxIdleConns(10)
+	db.SetConnMaxLifetime(10 * time.Minute)
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	if err = db.PingContext(ctx); err != nil {
+		log.Error("ping db failed", zap.String("dsn", dsn), zap.Error(err))
+		return nil, cerror.Trace(err)
+	}
+	log.Info("open db success", zap.String("dsn", dsn))
+	return db, nil
+}
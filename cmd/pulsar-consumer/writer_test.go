@@ -0,0 +1,308 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/apache/pulsar-client-go/pulsar"
+	dmysql "github.com/go-sql-driver/mysql"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tiflow/cdc/model"
+	mysqlddl "github.com/pingcap/tiflow/cdc/sink/ddlsink/mysql"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink/txn"
+	"github.com/pingcap/tiflow/pkg/cmd/eventgroup"
+	pmysql "github.com/pingcap/tiflow/pkg/sink/mysql"
+	"github.com/stretchr/testify/require"
+)
+
+// mockDecoder returns the events pushed to it in order.
+type mockDecoder struct {
+	mu     sync.Mutex
+	events []interface{}
+}
+
+func (d *mockDecoder) push(events ...interface{}) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.events = append(d.events, events...)
+}
+
+func (d *mockDecoder) AddKeyValue(_, _ []byte) error {
+	return nil
+}
+
+func (d *mockDecoder) HasNext() (model.MessageType, bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if len(d.events) == 0 {
+		return model.MessageTypeUnknown, false, nil
+	}
+	switch d.events[0].(type) {
+	case *model.RowChangedEvent:
+		return model.MessageTypeRow, true, nil
+	case *model.DDLEvent:
+		return model.MessageTypeDDL, true, nil
+	default:
+		return model.MessageTypeResolved, true, nil
+	}
+}
+
+func (d *mockDecoder) next() interface{} {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	event := d.events[0]
+	d.events = d.events[1:]
+	return event
+}
+
+func (d *mockDecoder) NextResolvedEvent() (uint64, error) {
+	return d.next().(uint64), nil
+}
+
+func (d *mockDecoder) NextRowChangedEvent() (*model.RowChangedEvent, error) {
+	return d.next().(*model.RowChangedEvent), nil
+}
+
+func (d *mockDecoder) NextDDLEvent() (*model.DDLEvent, error) {
+	return d.next().(*model.DDLEvent), nil
+}
+
+type mockMessage struct {
+	pulsar.Message
+	id pulsar.MessageID
+}
+
+func (m *mockMessage) Key() string {
+	return ""
+}
+
+func (m *mockMessage) Payload() []byte {
+	return nil
+}
+
+func (m *mockMessage) ID() pulsar.MessageID {
+	return m.id
+}
+
+// newTestWriter creates a writer which writes to the downstream,
+// the decoders of all partitions are replaced with mock decoders.
+func newTestWriter(
+	ctx context.Context, t *testing.T, partitionNum int, downstreamURI string,
+) (*writer, []*mockDecoder) {
+	upstreamURI, err := url.Parse("pulsar://127.0.0.1:6650/test?protocol=canal-json")
+	require.NoError(t, err)
+	o := newConsumerOption()
+	o.timezone = "UTC"
+	o.downstreamURI = downstreamURI
+	require.NoError(t, o.Adjust(upstreamURI, ""))
+
+	w := newWriter(ctx, o, partitionNum)
+	decoders := make([]*mockDecoder, partitionNum)
+	for i, p := range w.progresses {
+		decoders[i] = &mockDecoder{}
+		p.decoder = decoders[i]
+	}
+	return w, decoders
+}
+
+func TestWriteMessage(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	w, decoders := newTestWriter(ctx, t, 2, "blackhole://")
+	var entryID int64
+	write := func(partition int32, events ...interface{}) (pulsar.MessageID, []pulsar.MessageID) {
+		entryID++
+		msgID := pulsar.NewMessageID(1, entryID, 0, partition)
+		decoders[partition].push(events...)
+		return msgID, w.WriteMessage(ctx, &mockMessage{id: msgID})
+	}
+
+	// messages are not acknowledged before the events in them are flushed.
+	row1, acked := write(0, eventgroup.NewTestRow(10))
+	require.Empty(t, acked)
+	row2, acked := write(1, eventgroup.NewTestRow(20))
+	require.Empty(t, acked)
+
+	// the watermark of partition 1 is still 0, so nothing is flushed,
+	// but the resolved message can be acknowledged.
+	resolved1, acked := write(0, uint64(15))
+	require.Equal(t, []pulsar.MessageID{resolved1}, acked)
+	require.Equal(t, uint64(15), w.progresses[0].loadWatermark())
+
+	// the events with commitTs not greater than 15 are flushed.
+	resolved2, acked := write(1, uint64(25))
+	require.ElementsMatch(t, []pulsar.MessageID{row1, resolved2}, acked)
+	require.Len(t, w.progresses[0].tableSinkMap, 1)
+	require.Len(t, w.progresses[1].pendingMessages, 1)
+
+	// the row less than the watermark is ignored.
+	fallback, acked := write(0, eventgroup.NewTestRow(5))
+	require.Empty(t, acked)
+	require.Len(t, w.progresses[0].eventGroups[1].Positions(), 0)
+
+	// the DDL is not executed until all partitions reach its commitTs.
+	ddl, acked := write(0, &model.DDLEvent{CommitTs: 30, Query: "create table t(a int)"})
+	require.Equal(t, []pulsar.MessageID{fallback}, acked)
+	require.Len(t, w.ddlList, 1)
+	// the DDL is dispatched to all partitions, only the one from partition 0 is handled.
+	ddlOfPartition1, acked := write(1, &model.DDLEvent{CommitTs: 30, Query: "create table t(a int)"})
+	require.Equal(t, []pulsar.MessageID{ddlOfPartition1}, acked)
+	require.Len(t, w.ddlList, 1)
+
+	// the min watermark is 25, so the DDL is still pending.
+	resolved3, acked := write(0, uint64(40))
+	require.ElementsMatch(t, []pulsar.MessageID{row2, resolved3}, acked)
+	require.Len(t, w.ddlList, 1)
+	resolved4, acked := write(1, uint64(40))
+	require.ElementsMatch(t, []pulsar.MessageID{ddl, resolved4}, acked)
+	require.Empty(t, w.ddlList)
+	for _, p := range w.progresses {
+		require.Empty(t, p.pendingMessages)
+	}
+}
+
+// newTestMockDB creates a mock connection of the MySQL downstream which is not TiDB.
+func newTestMockDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	mock.ExpectQuery("select tidb_version()").WillReturnError(&dmysql.MySQLError{
+		Number:  1305,
+		Message: "FUNCTION test.tidb_version does not exist",
+	})
+	mock.ExpectQuery("select tidb_version()").WillReturnError(&dmysql.MySQLError{
+		Number:  1305,
+		Message: "FUNCTION test.tidb_version does not exist",
+	})
+	return db, mock
+}
+
+func TestWriteRedeliveredMessages(t *testing.T) {
+	// the flush never finishes if the row fails to be written.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// every time the consumer starts, the row is written to the downstream once.
+	var (
+		mu    sync.Mutex
+		mocks []sqlmock.Sqlmock
+	)
+	dmlConnFactory := pmysql.NewDBConnectionFactoryForTest()
+	dmlConnFactory.SetStandardConnectionFactory(func(ctx context.Context, dsnStr string) (*sql.DB, error) {
+		db, mock := newTestMockDB(t)
+		mock.ExpectBegin()
+		mock.ExpectExec("REPLACE INTO `test`.`t` (`a`) VALUES (?)").
+			WithArgs(1).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+		mu.Lock()
+		mocks = append(mocks, mock)
+		mu.Unlock()
+		return db, nil
+	})
+	ddlConnFactory := pmysql.NewDBConnectionFactoryForTest()
+	ddlConnFactory.SetStandardConnectionFactory(func(ctx context.Context, dsnStr string) (*sql.DB, error) {
+		db, _ := newTestMockDB(t)
+		return db, nil
+	})
+	backupDMLConnImpl, backupDDLConnImpl := txn.GetDBConnImpl, mysqlddl.GetDBConnImpl
+	txn.GetDBConnImpl, mysqlddl.GetDBConnImpl = dmlConnFactory, ddlConnFactory
+	defer func() {
+		txn.GetDBConnImpl, mysqlddl.GetDBConnImpl = backupDMLConnImpl, backupDDLConnImpl
+	}()
+
+	tableInfo := model.BuildTableInfo("test", "t", []*model.Column{{
+		Name: "a",
+		Type: mysql.TypeLong,
+		Flag: model.HandleKeyFlag | model.PrimaryKeyFlag,
+	}}, [][]int{{0}})
+	newRow := func() *model.RowChangedEvent {
+		return &model.RowChangedEvent{
+			CommitTs:        10,
+			PhysicalTableID: 1,
+			TableInfo:       tableInfo,
+			Columns: model.Columns2ColumnDatas([]*model.Column{
+				{Name: "a", Value: 1},
+			}, tableInfo),
+		}
+	}
+	downstreamURI := "mysql://127.0.0.1:4000/?time-zone=UTC&worker-count=1&cache-prep-stmts=false"
+	row := pulsar.NewMessageID(1, 1, 0, 0)
+	resolved := pulsar.NewMessageID(1, 2, 0, 0)
+
+	// the consumer exits after the row is flushed, but before the messages are acknowledged.
+	w, decoders := newTestWriter(ctx, t, 1, downstreamURI)
+	decoders[0].push(newRow())
+	require.Empty(t, w.WriteMessage(ctx, &mockMessage{id: row}))
+	decoders[0].push(uint64(10))
+	require.ElementsMatch(t, []pulsar.MessageID{row, resolved},
+		w.WriteMessage(ctx, &mockMessage{id: resolved}))
+
+	// the messages are redelivered to the restarted consumer, the row is written
+	// in the safe mode, so it doesn't conflict with the existing one.
+	w, decoders = newTestWriter(ctx, t, 1, downstreamURI)
+	decoders[0].push(newRow())
+	require.Empty(t, w.WriteMessage(ctx, &mockMessage{id: row}))
+	decoders[0].push(uint64(10))
+	require.ElementsMatch(t, []pulsar.MessageID{row, resolved},
+		w.WriteMessage(ctx, &mockMessage{id: resolved}))
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, mocks, 2)
+	for _, mock := range mocks {
+		require.NoError(t, mock.ExpectationsWereMet())
+	}
+}
+
+func TestPartitionProgressUpdateWatermark(t *testing.T) {
+	t.Parallel()
+
+	p := newPartitionProgress(0, nil)
+	msgID1 := pulsar.NewMessageID(1, 1, 0, 0)
+	p.updateWatermark(10, msgID1)
+	require.Equal(t, uint64(10), p.loadWatermark())
+	require.Equal(t, msgID1, p.watermarkMsgID)
+
+	// the fallback watermark of a redelivered message is ignored.
+	p.updateWatermark(5, pulsar.NewMessageID(1, 2, 0, 0))
+	require.Equal(t, uint64(10), p.loadWatermark())
+	require.Equal(t, msgID1, p.watermarkMsgID)
+}
+
+func TestPopFlushedMessages(t *testing.T) {
+	t.Parallel()
+
+	w := &writer{progresses: []*partitionProgress{newPartitionProgress(0, nil), newPartitionProgress(1, nil)}}
+	msgIDs := make([]pulsar.MessageID, 4)
+	for i := range msgIDs {
+		msgIDs[i] = pulsar.NewMessageID(1, int64(i), 0, int32(i%2))
+	}
+	w.progresses[0].pendingMessages = []pendingMessage{{msgIDs[0], 10}, {msgIDs[2], 30}}
+	w.progresses[1].pendingMessages = []pendingMessage{{msgIDs[1], 20}, {msgIDs[3], 0}}
+
+	require.Equal(t, []pulsar.MessageID{msgIDs[0], msgIDs[3]}, w.popFlushedMessages(15))
+	require.Empty(t, w.popFlushedMessages(15))
+	require.Equal(t, []pulsar.MessageID{msgIDs[2], msgIDs[1]}, w.popFlushedMessages(30))
+	for _, p := range w.progresses {
+		require.Empty(t, p.pendingMessages)
+	}
+}
@@ -74,6 +74,9 @@ var (
 	// ShardDDLPessimismOperationKeyAdapter is used to store shard DDL operation in pessimistic model.
 	// k/v: Encode(task-name, source-id) -> shard DDL operation.
 	ShardDDLPessimismOperationKeyAdapter KeyAdapter = keyHexEncoderDecoder("/dm-master/shardddl-pessimism/operation/")
+	// ShardDDLPessimismAuditKeyAdapter is used to store the audit records of shard DDL locks unlocked by the lock policy in pessimistic model.
+	// k/v: Encode(task-name, lock-id, unlock-time) -> audit record.
+	ShardDDLPessimismAuditKeyAdapter KeyAdapter = keyHexEncoderDecoder("/dm-master/shardddl-pessimism/audit/")
 
 	// ShardDDLOptimismSourceTablesKeyAdapter is used to store INITIAL upstream schema & table names when starting the subtask.
 	// In other words, if any Info for this subtask exists, we should obey source tables in the Info.
@@ -124,6 +127,8 @@ func keyAdapterKeysLen(s KeyAdapter) int {
 		ShardDDLOptimismSourceTablesKeyAdapter, LoadTaskKeyAdapter, TaskCliArgsKeyAdapter,
 		LightningCoordinationKeyAdapter:
 		return 2
+	case ShardDDLPessimismAuditKeyAdapter:
+		return 3
 	case ShardDDLOptimismInfoKeyAdapter, ShardDDLOptimismOperationKeyAdapter:
 		return 4
 	case ShardDDLOptimismDroppedColumnsKeyAdapter:
@@ -0,0 +1,100 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"regexp"
+
+	selector "github.com/pingcap/tidb/pkg/util/table-rule-selector"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+// actions of BinlogErrorRule, they work like `binlog skip/replace/inject` in dmctl.
+const (
+	BinlogErrorSkip    = "skip"
+	BinlogErrorReplace = "replace"
+	BinlogErrorInject  = "inject"
+)
+
+// BinlogErrorRule is a rule to handle the error of a DDL binlog event automatically.
+// the rules are matched in order, and the first matched rule is applied.
+type BinlogErrorRule struct {
+	Name string `yaml:"name" toml:"name" json:"name"`
+	// ErrorCode is the DM error code or the error code of the downstream database, 0 matches all errors.
+	ErrorCode int `yaml:"error-code" toml:"error-code" json:"error-code"`
+	// SchemaPattern and TablePattern match the upstream table of the DDL, empty SchemaPattern matches all schemas.
+	SchemaPattern string `yaml:"schema-pattern" toml:"schema-pattern" json:"schema-pattern"`
+	TablePattern  string `yaml:"table-pattern" toml:"table-pattern" json:"table-pattern"`
+	// SQLPattern is a regular expression to match the upstream DDL, empty SQLPattern matches all DDLs.
+	SQLPattern string `yaml:"sql-pattern" toml:"sql-pattern" json:"sql-pattern"`
+	// Action is one of `skip`, `replace` and `inject`.
+	Action string `yaml:"action" toml:"action" json:"action"`
+	// SQLs are the statements to replace or inject, only used by `replace` and `inject`.
+	SQLs []string `yaml:"sqls" toml:"sqls" json:"sqls"`
+}
+
+// SchemaPatternOrDefault returns the schema pattern of the rule, which is `*` if not set.
+func (r *BinlogErrorRule) SchemaPatternOrDefault() string {
+	if r.SchemaPattern == "" {
+		return "*"
+	}
+	return r.SchemaPattern
+}
+
+func (r *BinlogErrorRule) validate() error {
+	if r.Name == "" {
+		return terror.ErrConfigInvalidBinlogErrorRule.Generate("`name` should not be empty")
+	}
+	switch r.Action {
+	case BinlogErrorSkip:
+		if len(r.SQLs) > 0 {
+			return terror.ErrConfigInvalidBinlogErrorRule.Generatef("rule %s: `sqls` should be empty for action `%s`", r.Name, r.Action)
+		}
+	case BinlogErrorReplace, BinlogErrorInject:
+		if len(r.SQLs) == 0 {
+			return terror.ErrConfigInvalidBinlogErrorRule.Generatef("rule %s: `sqls` should not be empty for action `%s`", r.Name, r.Action)
+		}
+	default:
+		return terror.ErrConfigInvalidBinlogErrorRule.Generatef("rule %s: unsupported action %q, only `%s`, `%s` and `%s` are supported",
+			r.Name, r.Action, BinlogErrorSkip, BinlogErrorReplace, BinlogErrorInject)
+	}
+	if r.ErrorCode < 0 {
+		return terror.ErrConfigInvalidBinlogErrorRule.Generatef("rule %s: `error-code` %d should not be negative", r.Name, r.ErrorCode)
+	}
+	if err := selector.NewTrieSelector().Insert(r.SchemaPatternOrDefault(), r.TablePattern, r, selector.Insert); err != nil {
+		return terror.ErrConfigInvalidBinlogErrorRule.Generatef("rule %s: invalid `schema-pattern` or `table-pattern`: %v", r.Name, err)
+	}
+	if _, err := regexp.Compile(r.SQLPattern); err != nil {
+		return terror.ErrConfigInvalidBinlogErrorRule.Generatef("rule %s: `sql-pattern` %s can't be compiled: %v", r.Name, r.SQLPattern, err)
+	}
+	return nil
+}
+
+// validateBinlogErrorRules checks the binlog error rules and their names are unique.
+func validateBinlogErrorRules(rules []*BinlogErrorRule) error {
+	names := make(map[string]struct{}, len(rules))
+	for _, rule := range rules {
+		if rule == nil {
+			return terror.ErrConfigInvalidBinlogErrorRule.Generate("rule should not be empty")
+		}
+		if err := rule.validate(); err != nil {
+			return err
+		}
+		if _, ok := names[rule.Name]; ok {
+			return terror.ErrConfigInvalidBinlogErrorRule.Generatef("rule %s is duplicated", rule.Name)
+		}
+		names[rule.Name] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"time"
+
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+// default values of DMLThrottleConfig.
+const (
+	DefaultDMLThrottleLatencyThreshold = time.Second
+	DefaultDMLThrottleErrorThreshold   = 1
+	DefaultDMLThrottleMemoryThreshold  = 0.8
+	DefaultDMLThrottleCheckInterval    = 10 * time.Second
+	DefaultDMLThrottleMinWorkerCount   = 1
+	DefaultDMLThrottleMinBatch         = 1
+)
+
+// DMLThrottleConfig is the config of adaptive throttling of DML workers. When the
+// downstream is unhealthy, the syncer reduces the DML concurrency and batch size,
+// and recovers them gradually after the downstream becomes healthy again.
+type DMLThrottleConfig struct {
+	Enable bool `yaml:"enable" toml:"enable" json:"enable"`
+	// LatencyThreshold is the threshold of the average latency of executing a DML batch.
+	LatencyThreshold Duration `yaml:"latency-threshold" toml:"latency-threshold" json:"latency-threshold"`
+	// ErrorThreshold is the threshold of failed DML batches in one CheckInterval.
+	ErrorThreshold int `yaml:"error-threshold" toml:"error-threshold" json:"error-threshold"`
+	// MemoryThreshold is the threshold of the ratio of the used memory to the
+	// memory limit of the downstream TiDB, it only works when the downstream is TiDB.
+	MemoryThreshold float64 `yaml:"memory-threshold" toml:"memory-threshold" json:"memory-threshold"`
+	// CheckInterval is the interval to check the health of the downstream and adjust the limits.
+	CheckInterval  Duration `yaml:"check-interval" toml:"check-interval" json:"check-interval"`
+	MinWorkerCount int      `yaml:"min-worker-count" toml:"min-worker-count" json:"min-worker-count"`
+	MinBatch       int      `yaml:"min-batch" toml:"min-batch" json:"min-batch"`
+}
+
+func (c *DMLThrottleConfig) adjust() error {
+	if !c.Enable {
+		return nil
+	}
+	if c.LatencyThreshold.Duration == 0 {
+		c.LatencyThreshold.Duration = DefaultDMLThrottleLatencyThreshold
+	}
+	if c.ErrorThreshold == 0 {
+		c.ErrorThreshold = DefaultDMLThrottleErrorThreshold
+	}
+	if c.MemoryThreshold == 0 {
+		c.MemoryThreshold = DefaultDMLThrottleMemoryThreshold
+	}
+	if c.CheckInterval.Duration == 0 {
+		c.CheckInterval.Duration = DefaultDMLThrottleCheckInterval
+	}
+	if c.MinWorkerCount == 0 {
+		c.MinWorkerCount = DefaultDMLThrottleMinWorkerCount
+	}
+	if c.MinBatch == 0 {
+		c.MinBatch = DefaultDMLThrottleMinBatch
+	}
+
+	switch {
+	case c.LatencyThreshold.Duration < 0:
+		return terror.ErrConfigInvalidDMLThrottle.Generate("`latency-threshold` should not be negative")
+	case c.ErrorThreshold < 0:
+		return terror.ErrConfigInvalidDMLThrottle.Generate("`error-threshold` should not be negative")
+	case c.MemoryThreshold < 0 || c.MemoryThreshold > 1:
+		return terror.ErrConfigInvalidDMLThrottle.Generate("`memory-threshold` should be in (0, 1]")
+	case c.CheckInterval.Duration < 0:
+		return terror.ErrConfigInvalidDMLThrottle.Generate("`check-interval` should not be negative")
+	case c.MinWorkerCount < 0:
+		return terror.ErrConfigInvalidDMLThrottle.Generate("`min-worker-count` should not be negative")
+	case c.MinBatch < 0:
+		return terror.ErrConfigInvalidDMLThrottle.Generate("`min-batch` should not be negative")
+	}
+	return nil
+}
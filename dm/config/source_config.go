@@ -27,6 +27,7 @@ import (
 
 	"github.com/BurntSushi/toml"
 	"github.com/go-mysql-org/go-mysql/mysql"
+	extstorage "github.com/pingcap/tidb/br/pkg/storage"
 	"github.com/pingcap/tiflow/dm/config/dbconfig"
 	"github.com/pingcap/tiflow/dm/pkg/conn"
 	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
@@ -65,6 +66,15 @@ type PurgeConfig struct {
 	RemainSpace int64 `yaml:"remain-space" toml:"remain-space" json:"remain-space"` // if remain space in @RelayBaseDir less than @RemainSpace (GB), then it can be purged
 }
 
+// RelayStorageConfig is the configuration for keeping sealed relay log files in external storage.
+// After a relay log file is uploaded, the local file is replaced by an empty placeholder.
+type RelayStorageConfig struct {
+	// URL of the external storage, like s3://bucket/prefix. empty means keeping all relay log files in relay-dir
+	URL            string `yaml:"url" toml:"url" json:"url"`
+	Interval       int64  `yaml:"interval" toml:"interval" json:"interval"`                         // check whether need to upload at this @Interval (seconds)
+	KeepLocalFiles int    `yaml:"keep-local-files" toml:"keep-local-files" json:"keep-local-files"` // how many latest relay log files in each sub directory are not uploaded
+}
+
 // SourceConfig is the configuration for source.
 type SourceConfig struct {
 	Enable     bool `yaml:"enable" toml:"enable" json:"enable"`
@@ -91,6 +101,9 @@ type SourceConfig struct {
 	// config items for purger
 	Purge PurgeConfig `yaml:"purge" toml:"purge" json:"purge"`
 
+	// config items for relay log external storage
+	RelayStorage RelayStorageConfig `yaml:"relay-storage" toml:"relay-storage" json:"relay-storage"`
+
 	// config items for task status checker
 	Checker CheckerConfig `yaml:"checker" toml:"checker" json:"checker"`
 
@@ -120,6 +133,10 @@ func newSourceConfig() *SourceConfig {
 			Expires:     0,
 			RemainSpace: 15,
 		},
+		RelayStorage: RelayStorageConfig{
+			Interval:       60,
+			KeepLocalFiles: 1,
+		},
 		Checker: CheckerConfig{
 			CheckEnable:     true,
 			BackoffRollback: Duration{DefaultBackoffRollback},
@@ -258,6 +275,22 @@ func (c *SourceConfig) Verify() error {
 		return terror.ErrConfigCheckerMaxTooSmall.Generate(c.Checker.BackoffMax.Duration, c.Checker.BackoffMin.Duration)
 	}
 
+	return c.RelayStorage.verify()
+}
+
+func (c *RelayStorageConfig) verify() error {
+	if c.URL == "" {
+		return nil
+	}
+	if _, err := extstorage.ParseBackend(c.URL, nil); err != nil {
+		return terror.ErrConfigInvalidRelayStorage.Delegate(err, "`url` can't be parsed")
+	}
+	if c.Interval <= 0 {
+		return terror.ErrConfigInvalidRelayStorage.Generatef("`interval` %d should be greater than 0", c.Interval)
+	}
+	if c.KeepLocalFiles < 1 {
+		return terror.ErrConfigInvalidRelayStorage.Generatef("`keep-local-files` %d should be at least 1", c.KeepLocalFiles)
+	}
 	return nil
 }
 
@@ -421,6 +454,7 @@ type SourceConfigForDowngrade struct {
 	// any new config item, we mark it omitempty
 	CaseSensitive bool                  `yaml:"case-sensitive,omitempty"`
 	Filters       []*bf.BinlogEventRule `yaml:"filters,omitempty"`
+	RelayStorage  RelayStorageConfig    `yaml:"relay-storage,omitempty"`
 }
 
 // NewSourceConfigForDowngrade creates a new base config for downgrade.
@@ -443,6 +477,7 @@ func NewSourceConfigForDowngrade(sourceCfg *SourceConfig) *SourceConfigForDowngr
 		Tracer:          sourceCfg.Tracer,
 		CaseSensitive:   sourceCfg.CaseSensitive,
 		Filters:         sourceCfg.Filters,
+		RelayStorage:    sourceCfg.RelayStorage,
 	}
 }
 
@@ -451,6 +486,9 @@ func NewSourceConfigForDowngrade(sourceCfg *SourceConfig) *SourceConfigForDowngr
 // we should change it to empty.
 func (c *SourceConfigForDowngrade) omitDefaultVals() {
 	c.Enable = false
+	if c.RelayStorage.URL == "" {
+		c.RelayStorage = RelayStorageConfig{}
+	}
 }
 
 // Yaml returns YAML format representation of the config.
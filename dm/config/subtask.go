@@ -92,6 +92,8 @@ type SubTaskConfig struct {
 	ShardMode                 string `toml:"shard-mode" json:"shard-mode"`
 	StrictOptimisticShardMode bool   `toml:"strict-optimistic-shard-mode" json:"strict-optimistic-shard-mode"`
 	OnlineDDL                 bool   `toml:"online-ddl" json:"online-ddl"`
+	// only used by DM-master to handle the pessimistic shard DDL lock.
+	ShardLockPolicy *ShardLockPolicyConfig `toml:"shard-lock-policy" json:"shard-lock-policy"`
 
 	// pt/gh-ost name rule, support regex
 	ShadowTableRules []string `yaml:"shadow-table-rules" toml:"shadow-table-rules" json:"shadow-table-rules"`
@@ -134,12 +136,15 @@ type SubTaskConfig struct {
 	UseRelay bool              `toml:"use-relay" json:"use-relay"`
 	From     dbconfig.DBConfig `toml:"from" json:"from"`
 	To       dbconfig.DBConfig `toml:"to" json:"to"`
+	// when TargetKafka is set, the syncer sends changes to Kafka and To only stores the meta data.
+	TargetKafka *TargetKafkaConfig `toml:"target-kafka" json:"target-kafka"`
 
 	RouteRules  []*router.TableRule   `toml:"route-rules" json:"route-rules"`
 	FilterRules []*bf.BinlogEventRule `toml:"filter-rules" json:"filter-rules"`
 	// deprecated
 	ColumnMappingRules []*column.Rule      `toml:"mapping-rule" json:"mapping-rule"`
 	ExprFilter         []*ExpressionFilter `yaml:"expression-filter" toml:"expression-filter" json:"expression-filter"`
+	BinlogErrorRules   []*BinlogErrorRule  `yaml:"binlog-error-rules" toml:"binlog-error-rules" json:"binlog-error-rules"`
 
 	// black-white-list is deprecated, use block-allow-list instead
 	BWList *filter.Rules `toml:"black-white-list" json:"black-white-list"`
@@ -313,6 +318,17 @@ func (c *SubTaskConfig) Adjust(verifyDecryptPassword bool) error {
 	if c.StrictOptimisticShardMode && c.ShardMode != ShardOptimistic {
 		return terror.ErrConfigStrictOptimisticShardMode.Generate()
 	}
+	if c.ShardLockPolicy != nil {
+		if c.ShardMode != ShardPessimistic {
+			return terror.ErrConfigInvalidShardLockPolicy.Generate("`shard-mode` should be `pessimistic`")
+		}
+		if err := c.ShardLockPolicy.adjust(); err != nil {
+			return err
+		}
+	}
+	if err := validateBinlogErrorRules(c.BinlogErrorRules); err != nil {
+		return err
+	}
 
 	if len(c.ColumnMappingRules) > 0 {
 		return terror.ErrConfigColumnMappingDeprecated.Generate()
@@ -399,6 +415,9 @@ func (c *SubTaskConfig) Adjust(verifyDecryptPassword bool) error {
 	} else if c.SyncerConfig.SafeMode && duration == 0 {
 		return terror.ErrConfigConfictSafeModeDurationAndSafeMode.Generate()
 	}
+	if err := c.SyncerConfig.Throttle.adjust(); err != nil {
+		return err
+	}
 
 	c.From.AdjustWithTimeZone(c.Timezone)
 	c.To.AdjustWithTimeZone(c.Timezone)
@@ -438,6 +457,11 @@ func (c *SubTaskConfig) Adjust(verifyDecryptPassword bool) error {
 	if err := c.ValidatorCfg.Adjust(); err != nil {
 		return err
 	}
+	if c.TargetKafka != nil {
+		if err := c.TargetKafka.adjust(); err != nil {
+			return err
+		}
+	}
 
 	// TODO: check every member
 	// TODO: since we checked here, we could remove other terror like ErrSyncerUnitGenBAList
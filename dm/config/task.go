@@ -18,6 +18,7 @@ import (
 	"flag"
 	"fmt"
 	"math"
+	"net/url"
 	"os"
 	"sort"
 	"strconv"
@@ -39,6 +40,7 @@ import (
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 	bf "github.com/pingcap/tiflow/pkg/binlog-filter"
 	"github.com/pingcap/tiflow/pkg/column-mapping"
+	"github.com/pingcap/tiflow/pkg/sink"
 	"go.uber.org/zap"
 	"gopkg.in/yaml.v2"
 )
@@ -57,6 +59,18 @@ const (
 	tidbTxnOptimistic = "optimistic"
 )
 
+// actions of the shard DDL lock policy.
+const (
+	// ShardLockAlert only alerts when the lock waits too long.
+	ShardLockAlert = "alert"
+	// ShardLockSkipIdle unlocks the lock when all un-synced sources are idle, otherwise alerts.
+	ShardLockSkipIdle = "skip-idle"
+	// ShardLockForceUnlock unlocks the lock even if some un-synced sources are not idle.
+	ShardLockForceUnlock = "force-unlock"
+
+	defaultShardLockPolicyTimeout = "30m"
+)
+
 // collation_compatible.
 const (
 	LooseCollationCompatible  = "loose"
@@ -416,6 +430,8 @@ type SyncerConfig struct {
 	SafeModeDuration string `yaml:"safe-mode-duration" toml:"safe-mode-duration" json:"safe-mode-duration"`
 	// deprecated, use `ansi-quotes` in top level config instead
 	EnableANSIQuotes bool `yaml:"enable-ansi-quotes" toml:"enable-ansi-quotes" json:"enable-ansi-quotes"`
+
+	Throttle DMLThrottleConfig `yaml:"throttle" toml:"throttle" json:"throttle"`
 }
 
 // DefaultSyncerConfig return default syncer config for task.
@@ -500,6 +516,72 @@ func defaultValidatorConfig() ValidatorConfig {
 	}
 }
 
+// TargetKafkaConfig is the configuration of sending binlog changes to Kafka.
+// When it is set, the row changes and DDLs are encoded by the TiCDC codecs and
+// sent to Kafka, and `target-database` is only used to store DM's meta data
+// such as checkpoints.
+type TargetKafkaConfig struct {
+	// SinkURI uses the same format as the Kafka sink-uri of TiCDC, for example
+	// kafka://127.0.0.1:9092/topic?protocol=canal-json&partition-num=3
+	SinkURI string `yaml:"sink-uri" toml:"sink-uri" json:"sink-uri"`
+}
+
+func (k *TargetKafkaConfig) adjust() error {
+	if k.SinkURI == "" {
+		return terror.ErrConfigInvalidTargetKafka.Generate("`sink-uri` is empty")
+	}
+	uri, err := url.Parse(k.SinkURI)
+	if err != nil {
+		return terror.ErrConfigInvalidTargetKafka.Generatef("`sink-uri` %s can't be parsed: %v", k.SinkURI, err)
+	}
+	scheme := strings.ToLower(uri.Scheme)
+	if scheme != sink.KafkaScheme && scheme != sink.KafkaSSLScheme {
+		return terror.ErrConfigInvalidTargetKafka.Generatef("unsupported scheme %s in `sink-uri`", uri.Scheme)
+	}
+	if strings.Trim(uri.Path, "/") == "" {
+		return terror.ErrConfigInvalidTargetKafka.Generate("topic is not specified in `sink-uri`")
+	}
+	if uri.Query().Get("protocol") == "" {
+		return terror.ErrConfigInvalidTargetKafka.Generate("protocol is not specified in `sink-uri`")
+	}
+	return nil
+}
+
+// ShardLockPolicyConfig is the policy to handle a pessimistic shard DDL lock which
+// has waited for the un-synced sources longer than Timeout.
+type ShardLockPolicyConfig struct {
+	// Action is one of `alert`, `skip-idle` and `force-unlock`.
+	Action string `yaml:"action" toml:"action" json:"action"`
+	// Timeout is a duration string like "30m", starting from the lock is created in DM-master.
+	Timeout string `yaml:"timeout" toml:"timeout" json:"timeout"`
+}
+
+func (p *ShardLockPolicyConfig) adjust() error {
+	switch p.Action {
+	case ShardLockAlert, ShardLockSkipIdle, ShardLockForceUnlock:
+	default:
+		return terror.ErrConfigInvalidShardLockPolicy.Generatef("unsupported action %q, only `%s`, `%s` and `%s` are supported",
+			p.Action, ShardLockAlert, ShardLockSkipIdle, ShardLockForceUnlock)
+	}
+	if p.Timeout == "" {
+		p.Timeout = defaultShardLockPolicyTimeout
+	}
+	timeout, err := time.ParseDuration(p.Timeout)
+	if err != nil {
+		return terror.ErrConfigInvalidShardLockPolicy.Generatef("`timeout` %s can't be parsed: %v", p.Timeout, err)
+	}
+	if timeout <= 0 {
+		return terror.ErrConfigInvalidShardLockPolicy.Generatef("`timeout` %s should be positive", p.Timeout)
+	}
+	return nil
+}
+
+// TimeoutDuration returns Timeout as time.Duration, it should be called after adjusted.
+func (p *ShardLockPolicyConfig) TimeoutDuration() time.Duration {
+	timeout, _ := time.ParseDuration(p.Timeout)
+	return timeout
+}
+
 // TaskConfig is the configuration for Task.
 type TaskConfig struct {
 	*flag.FlagSet `yaml:"-" toml:"-" json:"-"`
@@ -509,6 +591,8 @@ type TaskConfig struct {
 	IsSharding                bool   `yaml:"is-sharding" toml:"is-sharding" json:"is-sharding"`
 	ShardMode                 string `yaml:"shard-mode" toml:"shard-mode" json:"shard-mode"` // when `shard-mode` set, we always enable sharding support.
 	StrictOptimisticShardMode bool   `yaml:"strict-optimistic-shard-mode" toml:"strict-optimistic-shard-mode" json:"strict-optimistic-shard-mode"`
+	// only used in pessimistic shard mode, nil means waiting for the lock forever.
+	ShardLockPolicy *ShardLockPolicyConfig `yaml:"shard-lock-policy" toml:"shard-lock-policy" json:"shard-lock-policy"`
 	// treat it as hidden configuration
 	IgnoreCheckingItems []string `yaml:"ignore-checking-items" toml:"ignore-checking-items" json:"ignore-checking-items"`
 	// we store detail status in meta
@@ -531,6 +615,8 @@ type TaskConfig struct {
 	CollationCompatible string `yaml:"collation_compatible" toml:"collation_compatible" json:"collation_compatible"`
 
 	TargetDB *dbconfig.DBConfig `yaml:"target-database" toml:"target-database" json:"target-database"`
+	// when TargetKafka is set, the changes are sent to Kafka and TargetDB only stores the meta data.
+	TargetKafka *TargetKafkaConfig `yaml:"target-kafka" toml:"target-kafka" json:"target-kafka"`
 
 	MySQLInstances []*MySQLInstance `yaml:"mysql-instances" toml:"mysql-instances" json:"mysql-instances"`
 
@@ -547,6 +633,8 @@ type TaskConfig struct {
 	// deprecated
 	ColumnMappings map[string]*column.Rule      `yaml:"column-mappings" toml:"column-mappings" json:"column-mappings"`
 	ExprFilter     map[string]*ExpressionFilter `yaml:"expression-filter" toml:"expression-filter" json:"expression-filter"`
+	// BinlogErrorRules are applied to the DDL errors of all sources in order
+	BinlogErrorRules []*BinlogErrorRule `yaml:"binlog-error-rules" toml:"binlog-error-rules" json:"binlog-error-rules"`
 
 	// black-white-list is deprecated, use block-allow-list instead
 	BWList map[string]*filter.Rules `yaml:"black-white-list" toml:"black-white-list" json:"black-white-list"`
@@ -689,6 +777,17 @@ func (c *TaskConfig) adjust() error {
 	if c.StrictOptimisticShardMode && c.ShardMode != ShardOptimistic {
 		return terror.ErrConfigStrictOptimisticShardMode.Generate()
 	}
+	if c.ShardLockPolicy != nil {
+		if c.ShardMode != ShardPessimistic {
+			return terror.ErrConfigInvalidShardLockPolicy.Generate("`shard-mode` should be `pessimistic`")
+		}
+		if err := c.ShardLockPolicy.adjust(); err != nil {
+			return err
+		}
+	}
+	if err := validateBinlogErrorRules(c.BinlogErrorRules); err != nil {
+		return err
+	}
 
 	if len(c.ColumnMappings) > 0 {
 		return terror.ErrConfigColumnMappingDeprecated.Generate()
@@ -717,6 +816,15 @@ func (c *TaskConfig) adjust() error {
 		return terror.ErrConfigNeedTargetDB.Generate()
 	}
 
+	if c.TargetKafka != nil {
+		if err := c.TargetKafka.adjust(); err != nil {
+			return err
+		}
+		if c.TaskMode != ModeIncrement {
+			return terror.ErrConfigInvalidTargetKafka.Generatef("task-mode %s is not supported, only %s is supported", c.TaskMode, ModeIncrement)
+		}
+	}
+
 	if len(c.MySQLInstances) == 0 {
 		return terror.ErrConfigMySQLInstsAtLeastOne.Generate()
 	}
@@ -913,6 +1021,9 @@ func (c *TaskConfig) adjust() error {
 				inst.ContinuousValidator = *rule
 			}
 		}
+		if c.TargetKafka != nil && inst.ContinuousValidator.Mode != ValidationNone {
+			return terror.ErrConfigInvalidTargetKafka.Generatef("continuous validator of mysql-instance %d can't be enabled", i)
+		}
 
 		// for backward compatible, set global config `ansi-quotes: true` if any syncer is true
 		if inst.Syncer.EnableANSIQuotes {
@@ -973,7 +1084,12 @@ func (c *TaskConfig) adjust() error {
 			unusedConfigs = append(unusedConfigs, loader)
 		}
 	}
-	for syncer := range c.Syncers {
+	for syncer, cfg := range c.Syncers {
+		if cfg != nil {
+			if err1 := cfg.Throttle.adjust(); err1 != nil {
+				return err1
+			}
+		}
 		if globalConfigReferCount[configRefPrefixes[syncerIdx]+syncer] == 0 {
 			unusedConfigs = append(unusedConfigs, syncer)
 		}
@@ -1172,9 +1288,10 @@ type SyncerConfigForDowngrade struct {
 	SafeMode                bool   `yaml:"safe-mode"`
 	EnableANSIQuotes        bool   `yaml:"enable-ansi-quotes"`
 
-	SafeModeDuration string `yaml:"safe-mode-duration,omitempty"`
-	Compact          bool   `yaml:"compact,omitempty"`
-	MultipleRows     bool   `yaml:"multipleRows,omitempty"`
+	SafeModeDuration string            `yaml:"safe-mode-duration,omitempty"`
+	Compact          bool              `yaml:"compact,omitempty"`
+	MultipleRows     bool              `yaml:"multipleRows,omitempty"`
+	Throttle         DMLThrottleConfig `yaml:"throttle,omitempty"`
 }
 
 // NewSyncerConfigsForDowngrade converts SyncerConfig to SyncerConfigForDowngrade.
@@ -1195,6 +1312,7 @@ func NewSyncerConfigsForDowngrade(syncerConfigs map[string]*SyncerConfig) map[st
 			EnableANSIQuotes:        syncerConfig.EnableANSIQuotes,
 			Compact:                 syncerConfig.Compact,
 			MultipleRows:            syncerConfig.MultipleRows,
+			Throttle:                syncerConfig.Throttle,
 		}
 		syncerConfigsForDowngrade[configName] = newSyncerConfig
 	}
@@ -1245,6 +1363,9 @@ type TaskConfigForDowngrade struct {
 	ShadowTableRules          []string                     `yaml:"shadow-table-rules,omitempty"`
 	TrashTableRules           []string                     `yaml:"trash-table-rules,omitempty"`
 	StrictOptimisticShardMode bool                         `yaml:"strict-optimistic-shard-mode,omitempty"`
+	TargetKafka               *TargetKafkaConfig           `yaml:"target-kafka,omitempty"`
+	ShardLockPolicy           *ShardLockPolicyConfig       `yaml:"shard-lock-policy,omitempty"`
+	BinlogErrorRules          []*BinlogErrorRule           `yaml:"binlog-error-rules,omitempty"`
 }
 
 // NewTaskConfigForDowngrade create new TaskConfigForDowngrade.
@@ -1281,6 +1402,9 @@ func NewTaskConfigForDowngrade(taskConfig *TaskConfig) *TaskConfigForDowngrade {
 		OnlineDDL:                 taskConfig.OnlineDDL,
 		ShadowTableRules:          taskConfig.ShadowTableRules,
 		TrashTableRules:           taskConfig.TrashTableRules,
+		TargetKafka:               taskConfig.TargetKafka,
+		ShardLockPolicy:           taskConfig.ShardLockPolicy,
+		BinlogErrorRules:          taskConfig.BinlogErrorRules,
 	}
 }
 
@@ -43,6 +43,10 @@ func TaskConfigToSubTaskConfigs(c *TaskConfig, sources map[string]dbconfig.DBCon
 		cfg.IsSharding = c.IsSharding
 		cfg.ShardMode = c.ShardMode
 		cfg.StrictOptimisticShardMode = c.StrictOptimisticShardMode
+		if c.ShardLockPolicy != nil {
+			shardLockPolicy := *c.ShardLockPolicy
+			cfg.ShardLockPolicy = &shardLockPolicy
+		}
 		cfg.OnlineDDL = c.OnlineDDL
 		cfg.TrashTableRules = c.TrashTableRules
 		cfg.ShadowTableRules = c.ShadowTableRules
@@ -69,6 +73,10 @@ func TaskConfigToSubTaskConfigs(c *TaskConfig, sources map[string]dbconfig.DBCon
 			return nil, terror.ErrConfigNeedTargetDB
 		}
 		cfg.To = *toClone
+		if c.TargetKafka != nil {
+			targetKafka := *c.TargetKafka
+			cfg.TargetKafka = &targetKafka
+		}
 
 		cfg.SourceID = inst.SourceID
 
@@ -91,6 +99,7 @@ func TaskConfigToSubTaskConfigs(c *TaskConfig, sources map[string]dbconfig.DBCon
 		for j, name := range inst.ExpressionFilters {
 			cfg.ExprFilter[j] = c.ExprFilter[name]
 		}
+		cfg.BinlogErrorRules = c.BinlogErrorRules
 
 		cfg.BAList = c.BAList[inst.BAListName]
 
@@ -369,6 +378,8 @@ func SubTaskConfigsToTaskConfig(stCfgs ...*SubTaskConfig) *TaskConfig {
 	c.IsSharding = stCfg0.IsSharding
 	c.ShardMode = stCfg0.ShardMode
 	c.StrictOptimisticShardMode = stCfg0.StrictOptimisticShardMode
+	c.ShardLockPolicy = stCfg0.ShardLockPolicy
+	c.BinlogErrorRules = stCfg0.BinlogErrorRules
 	c.IgnoreCheckingItems = stCfg0.IgnoreCheckingItems
 	c.MetaSchema = stCfg0.MetaSchema
 	c.EnableHeartbeat = stCfg0.EnableHeartbeat
@@ -377,6 +388,7 @@ func SubTaskConfigsToTaskConfig(stCfgs ...*SubTaskConfig) *TaskConfig {
 	c.Timezone = stCfg0.Timezone
 	c.CaseSensitive = stCfg0.CaseSensitive
 	c.TargetDB = &stCfg0.To // just ref
+	c.TargetKafka = stCfg0.TargetKafka
 	c.OnlineDDL = stCfg0.OnlineDDL
 	c.OnlineDDLScheme = stCfg0.OnlineDDLScheme
 	c.CleanDumpFile = stCfg0.CleanDumpFile
@@ -21,6 +21,7 @@ import (
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/coreos/go-semver/semver"
 	"github.com/pingcap/tidb/pkg/util/filter"
@@ -33,6 +34,7 @@ import (
 	bf "github.com/pingcap/tiflow/pkg/binlog-filter"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/atomic"
+	"gopkg.in/yaml.v2"
 )
 
 var correctTaskConfig = `---
@@ -1196,3 +1198,262 @@ func TestTaskYamlForDowngrade(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, originCfg.TargetDB.Password, decryptedPass)
 }
+
+func TestTargetKafkaConfig(t *testing.T) {
+	t.Parallel()
+
+	newCfg := func() *TaskConfig {
+		cfg := NewTaskConfig()
+		cfg.Name = "test"
+		cfg.TaskMode = ModeIncrement
+		cfg.TargetDB = &dbconfig.DBConfig{}
+		cfg.MySQLInstances = append(cfg.MySQLInstances, &MySQLInstance{SourceID: "source1"})
+		cfg.TargetKafka = &TargetKafkaConfig{SinkURI: "kafka://127.0.0.1:9092/topic?protocol=canal-json"}
+		return cfg
+	}
+
+	cfg := newCfg()
+	require.NoError(t, cfg.adjust())
+	stCfgs, err := TaskConfigToSubTaskConfigs(cfg, map[string]dbconfig.DBConfig{"source1": {}})
+	require.NoError(t, err)
+	require.Len(t, stCfgs, 1)
+	require.Equal(t, cfg.TargetKafka, stCfgs[0].TargetKafka)
+	require.Equal(t, cfg.TargetKafka, NewTaskConfigForDowngrade(cfg).TargetKafka)
+
+	cases := []struct {
+		sinkURI string
+		errMsg  string
+	}{
+		{"", "`sink-uri` is empty"},
+		{"mysql://127.0.0.1:3306/?protocol=canal-json", "unsupported scheme mysql"},
+		{"kafka://127.0.0.1:9092/?protocol=canal-json", "topic is not specified"},
+		{"kafka+ssl://127.0.0.1:9092/topic", "protocol is not specified"},
+	}
+	for _, cs := range cases {
+		cfg = newCfg()
+		cfg.TargetKafka.SinkURI = cs.sinkURI
+		err = cfg.adjust()
+		require.True(t, terror.ErrConfigInvalidTargetKafka.Equal(err), cs.sinkURI)
+		require.ErrorContains(t, err, cs.errMsg)
+	}
+
+	cfg = newCfg()
+	cfg.TaskMode = ModeAll
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidTargetKafka.Equal(err))
+	require.ErrorContains(t, err, "task-mode all is not supported")
+
+	cfg = newCfg()
+	cfg.Validators = map[string]*ValidatorConfig{"validator": {Mode: ValidationFull}}
+	cfg.MySQLInstances[0].ContinuousValidatorConfigName = "validator"
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidTargetKafka.Equal(err))
+	require.ErrorContains(t, err, "continuous validator")
+}
+
+func TestShardLockPolicyConfig(t *testing.T) {
+	t.Parallel()
+
+	newCfg := func() *TaskConfig {
+		cfg := NewTaskConfig()
+		cfg.Name = "test"
+		cfg.TaskMode = ModeAll
+		cfg.ShardMode = ShardPessimistic
+		cfg.TargetDB = &dbconfig.DBConfig{}
+		cfg.MySQLInstances = append(cfg.MySQLInstances, &MySQLInstance{SourceID: "source1"})
+		cfg.ShardLockPolicy = &ShardLockPolicyConfig{Action: ShardLockSkipIdle}
+		return cfg
+	}
+
+	cfg := newCfg()
+	require.NoError(t, cfg.adjust())
+	require.Equal(t, "30m", cfg.ShardLockPolicy.Timeout)
+	require.Equal(t, 30*time.Minute, cfg.ShardLockPolicy.TimeoutDuration())
+	stCfgs, err := TaskConfigToSubTaskConfigs(cfg, map[string]dbconfig.DBConfig{"source1": {}})
+	require.NoError(t, err)
+	require.Len(t, stCfgs, 1)
+	require.Equal(t, cfg.ShardLockPolicy, stCfgs[0].ShardLockPolicy)
+	require.Equal(t, cfg.ShardLockPolicy, SubTaskConfigsToTaskConfig(stCfgs...).ShardLockPolicy)
+	require.Equal(t, cfg.ShardLockPolicy, NewTaskConfigForDowngrade(cfg).ShardLockPolicy)
+
+	cases := []struct {
+		shardMode string
+		action    string
+		timeout   string
+		errMsg    string
+	}{
+		{ShardOptimistic, ShardLockAlert, "", "`shard-mode` should be `pessimistic`"},
+		{ShardPessimistic, "", "", "unsupported action \"\""},
+		{ShardPessimistic, "skip", "", "unsupported action \"skip\""},
+		{ShardPessimistic, ShardLockForceUnlock, "1day", "`timeout` 1day can't be parsed"},
+		{ShardPessimistic, ShardLockAlert, "-1m", "`timeout` -1m should be positive"},
+	}
+	for _, cs := range cases {
+		cfg = newCfg()
+		cfg.ShardMode = cs.shardMode
+		cfg.ShardLockPolicy.Action = cs.action
+		cfg.ShardLockPolicy.Timeout = cs.timeout
+		err = cfg.adjust()
+		require.True(t, terror.ErrConfigInvalidShardLockPolicy.Equal(err), cs.errMsg)
+		require.ErrorContains(t, err, cs.errMsg)
+	}
+}
+
+func TestBinlogErrorRules(t *testing.T) {
+	t.Parallel()
+
+	taskYaml := `
+name: test
+task-mode: all
+target-database:
+  host: "127.0.0.1"
+  port: 4000
+  user: "root"
+  password: ""
+binlog-error-rules:
+  - name: skip-algorithm
+    error-code: 8200
+    schema-pattern: "shard_*"
+    table-pattern: "t_*"
+    sql-pattern: "(?i)ALGORITHM\\s*=\\s*INSTANT"
+    action: skip
+  - name: replace-index
+    sql-pattern: "^ALTER TABLE .* ADD FULLTEXT INDEX"
+    action: replace
+    sqls: ["ALTER TABLE shard_1.t_1 ADD INDEX idx(c1)"]
+mysql-instances:
+  - source-id: "source1"
+`
+	cfg := NewTaskConfig()
+	require.NoError(t, cfg.RawDecode(taskYaml))
+	require.NoError(t, cfg.adjust())
+	require.Len(t, cfg.BinlogErrorRules, 2)
+	require.Equal(t, &BinlogErrorRule{
+		Name:          "skip-algorithm",
+		ErrorCode:     8200,
+		SchemaPattern: "shard_*",
+		TablePattern:  "t_*",
+		SQLPattern:    `(?i)ALGORITHM\s*=\s*INSTANT`,
+		Action:        BinlogErrorSkip,
+	}, cfg.BinlogErrorRules[0])
+	require.Equal(t, "shard_*", cfg.BinlogErrorRules[0].SchemaPatternOrDefault())
+	require.Equal(t, "*", cfg.BinlogErrorRules[1].SchemaPatternOrDefault())
+
+	stCfgs, err := TaskConfigToSubTaskConfigs(cfg, map[string]dbconfig.DBConfig{"source1": {}})
+	require.NoError(t, err)
+	require.Len(t, stCfgs, 1)
+	require.Equal(t, cfg.BinlogErrorRules, stCfgs[0].BinlogErrorRules)
+	require.Equal(t, cfg.BinlogErrorRules, SubTaskConfigsToTaskConfig(stCfgs...).BinlogErrorRules)
+	require.Equal(t, cfg.BinlogErrorRules, NewTaskConfigForDowngrade(cfg).BinlogErrorRules)
+
+	// the rules can be encoded and decoded in subtask config.
+	stCfgStr, err := stCfgs[0].Toml()
+	require.NoError(t, err)
+	stCfg := NewSubTaskConfig()
+	require.NoError(t, stCfg.Decode(stCfgStr, false))
+	require.Equal(t, cfg.BinlogErrorRules, stCfg.BinlogErrorRules)
+
+	cases := []struct {
+		rules  []*BinlogErrorRule
+		errMsg string
+	}{
+		{[]*BinlogErrorRule{{Action: BinlogErrorSkip}}, "`name` should not be empty"},
+		{[]*BinlogErrorRule{{Name: "r1", Action: "ignore"}}, "unsupported action \"ignore\""},
+		{[]*BinlogErrorRule{{Name: "r1", Action: BinlogErrorSkip, SQLs: []string{"DROP TABLE t"}}}, "`sqls` should be empty for action `skip`"},
+		{[]*BinlogErrorRule{{Name: "r1", Action: BinlogErrorInject}}, "`sqls` should not be empty for action `inject`"},
+		{[]*BinlogErrorRule{{Name: "r1", Action: BinlogErrorSkip, ErrorCode: -1}}, "`error-code` -1 should not be negative"},
+		{[]*BinlogErrorRule{{Name: "r1", Action: BinlogErrorSkip, SchemaPattern: "a*b"}}, "invalid `schema-pattern` or `table-pattern`"},
+		{[]*BinlogErrorRule{{Name: "r1", Action: BinlogErrorSkip, SQLPattern: "("}}, "`sql-pattern` ( can't be compiled"},
+		{[]*BinlogErrorRule{{Name: "r1", Action: BinlogErrorSkip}, {Name: "r1", Action: BinlogErrorSkip}}, "rule r1 is duplicated"},
+	}
+	for _, cs := range cases {
+		cfg = NewTaskConfig()
+		require.NoError(t, cfg.RawDecode(taskYaml))
+		cfg.BinlogErrorRules = cs.rules
+		err = cfg.adjust()
+		require.True(t, terror.ErrConfigInvalidBinlogErrorRule.Equal(err), cs.errMsg)
+		require.ErrorContains(t, err, cs.errMsg)
+	}
+}
+
+func TestDMLThrottleConfig(t *testing.T) {
+	t.Parallel()
+
+	taskYaml := `
+name: test
+task-mode: all
+target-database:
+  host: "127.0.0.1"
+  port: 4000
+  user: "root"
+  password: ""
+mysql-instances:
+  - source-id: "source1"
+    syncer-config-name: "global"
+syncers:
+  global:
+    worker-count: 32
+    batch: 200
+    throttle:
+      enable: true
+      latency-threshold: 500ms
+      min-worker-count: 4
+`
+	cfg := NewTaskConfig()
+	require.NoError(t, cfg.RawDecode(taskYaml))
+	require.NoError(t, cfg.adjust())
+	require.Equal(t, DMLThrottleConfig{
+		Enable:           true,
+		LatencyThreshold: Duration{500 * time.Millisecond},
+		ErrorThreshold:   DefaultDMLThrottleErrorThreshold,
+		MemoryThreshold:  DefaultDMLThrottleMemoryThreshold,
+		CheckInterval:    Duration{DefaultDMLThrottleCheckInterval},
+		MinWorkerCount:   4,
+		MinBatch:         DefaultDMLThrottleMinBatch,
+	}, cfg.Syncers["global"].Throttle)
+
+	stCfgs, err := TaskConfigToSubTaskConfigs(cfg, map[string]dbconfig.DBConfig{"source1": {}})
+	require.NoError(t, err)
+	require.Len(t, stCfgs, 1)
+	require.Equal(t, cfg.Syncers["global"].Throttle, stCfgs[0].Throttle)
+	downgradeCfg := NewTaskConfigForDowngrade(cfg)
+	require.Equal(t, cfg.Syncers["global"].Throttle, downgradeCfg.Syncers["global"].Throttle)
+	downgradeYaml, err := yaml.Marshal(downgradeCfg.Syncers)
+	require.NoError(t, err)
+	require.Contains(t, string(downgradeYaml), "throttle:")
+
+	// the throttle can be encoded and decoded in subtask config.
+	stCfgStr, err := stCfgs[0].Toml()
+	require.NoError(t, err)
+	stCfg := NewSubTaskConfig()
+	require.NoError(t, stCfg.Decode(stCfgStr, false))
+	require.Equal(t, cfg.Syncers["global"].Throttle, stCfg.Throttle)
+
+	// disabled throttle is not adjusted and omitted in downgrade config.
+	cfg = NewTaskConfig()
+	require.NoError(t, cfg.RawDecode(taskYaml))
+	cfg.Syncers["global"].Throttle = DMLThrottleConfig{}
+	require.NoError(t, cfg.adjust())
+	require.Equal(t, DMLThrottleConfig{}, cfg.Syncers["global"].Throttle)
+	downgradeYaml, err = yaml.Marshal(NewTaskConfigForDowngrade(cfg).Syncers)
+	require.NoError(t, err)
+	require.NotContains(t, string(downgradeYaml), "throttle:")
+
+	cases := []struct {
+		throttle DMLThrottleConfig
+		errMsg   string
+	}{
+		{DMLThrottleConfig{Enable: true, ErrorThreshold: -1}, "`error-threshold` should not be negative"},
+		{DMLThrottleConfig{Enable: true, MemoryThreshold: 1.5}, "`memory-threshold` should be in (0, 1]"},
+		{DMLThrottleConfig{Enable: true, CheckInterval: Duration{-time.Second}}, "`check-interval` should not be negative"},
+		{DMLThrottleConfig{Enable: true, MinBatch: -1}, "`min-batch` should not be negative"},
+	}
+	for _, cs := range cases {
+		cfg = NewTaskConfig()
+		require.NoError(t, cfg.RawDecode(taskYaml))
+		cfg.Syncers["global"].Throttle = cs.throttle
+		err = cfg.adjust()
+		require.True(t, terror.ErrConfigInvalidDMLThrottle.Equal(err), cs.errMsg)
+		require.ErrorContains(t, err, cs.errMsg)
+	}
+}
@@ -32,11 +32,13 @@ const (
 
 // used to show error type when handle DDLs.
 const (
-	InfoErrSyncLock    = "InfoPut - SyncLockError"
-	InfoErrHandleLock  = "InfoPut - HandleLockError"
-	OpErrRemoveLock    = "OperationPut - RemoveLockError"
-	OpErrLockUnSynced  = "OperationPut - LockUnSyncedError"
-	OpErrPutNonOwnerOp = "OperationPut - PutNonOwnerOpError"
+	InfoErrSyncLock     = "InfoPut - SyncLockError"
+	InfoErrHandleLock   = "InfoPut - HandleLockError"
+	OpErrRemoveLock     = "OperationPut - RemoveLockError"
+	OpErrLockUnSynced   = "OperationPut - LockUnSyncedError"
+	OpErrPutNonOwnerOp  = "OperationPut - PutNonOwnerOpError"
+	LockPolicyTimeout   = "LockPolicy - Timeout"
+	LockPolicyErrUnlock = "LockPolicy - UnlockError"
 )
 
 // used to represent worker event error type.
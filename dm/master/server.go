@@ -146,7 +146,7 @@ func NewServer(cfg *Config) *Server {
 		scheduler: scheduler.NewScheduler(&logger, cfg.Security),
 		ap:        NewAgentPool(&RateLimitConfig{rate: cfg.RPCRateLimit, burst: cfg.RPCRateBurst}),
 	}
-	server.pessimist = shardddl.NewPessimist(&logger, server.getTaskSourceNameList)
+	server.pessimist = shardddl.NewPessimist(&logger, server.getTaskSourceNameList, server.getTaskShardLockPolicy, server.isSourceIdleForLock)
 	server.optimist = shardddl.NewOptimist(&logger, server.scheduler.GetDownstreamMetaByTask)
 	server.closed.Store(true)
 	setUseTLS(&cfg.Security)
@@ -869,11 +869,12 @@ func (s *Server) QueryStatus(ctx context.Context, req *pb.QueryStatusListRequest
 
 // adjust unsynced field in sync status by looking at DDL locks.
 // because if a DM-worker doesn't receive any shard DDL, it doesn't even know it's unsynced for itself.
+// the state of the shard DDL lock policy is also filled into the unresolved groups.
 func (s *Server) fillUnsyncedStatus(resps []*pb.QueryStatusResponse) {
 	for _, resp := range resps {
 		for _, subtaskStatus := range resp.SubTaskStatus {
 			syncStatus := subtaskStatus.GetSync()
-			if syncStatus == nil || len(syncStatus.UnresolvedGroups) != 0 {
+			if syncStatus == nil {
 				continue
 			}
 			// TODO: look at s.optimist when `query-status` support show `UnresolvedGroups` in optimistic mode.
@@ -882,11 +883,22 @@ func (s *Server) fillUnsyncedStatus(resps []*pb.QueryStatusResponse) {
 				continue
 			}
 
+			workerUnresolved := len(syncStatus.UnresolvedGroups) != 0
 			for _, l := range locks {
 				db, table := utils.ExtractDBAndTableFromLockID(l.ID)
+				target := dbutil.TableName(db, table)
+				if workerUnresolved {
+					for _, group := range syncStatus.UnresolvedGroups {
+						if group.Target == target {
+							group.LockPolicyState = l.LockPolicyState
+						}
+					}
+					continue
+				}
 				syncStatus.UnresolvedGroups = append(syncStatus.UnresolvedGroups, &pb.ShardingGroup{
-					Target:   dbutil.TableName(db, table),
-					Unsynced: []string{"this DM-worker doesn't receive any shard DDL of this group"},
+					Target:          target,
+					Unsynced:        []string{"this DM-worker doesn't receive any shard DDL of this group"},
+					LockPolicyState: l.LockPolicyState,
 				})
 			}
 		}
@@ -1122,6 +1134,55 @@ func (s *Server) getTaskSourceNameList(taskName string) []string {
 	return ret
 }
 
+// getTaskShardLockPolicy gets the shard DDL lock policy of the task.
+func (s *Server) getTaskShardLockPolicy(taskName string) *config.ShardLockPolicyConfig {
+	// subtasks should have the same ShardLockPolicy.
+	for _, cfg := range s.scheduler.GetSubTaskCfgsByTask(taskName) {
+		return cfg.ShardLockPolicy
+	}
+	return nil
+}
+
+// isSourceIdleForLock checks whether the source has no DML for the target table of the shard DDL lock since the given binlog timestamp.
+// the source is treated as not idle if its sync unit has not caught up with the upstream,
+// or has not recorded the DML activity since that time (e.g. restarted).
+// all the timestamps are in binlog time, so the clock of DM-master is not involved.
+func (s *Server) isSourceIdleForLock(ctx context.Context, taskName, source, lockID string, sinceTs int64) (bool, error) {
+	worker := s.scheduler.GetWorkerBySource(source)
+	if worker == nil {
+		return false, terror.ErrMasterWorkerArgsExtractor.Generatef("%s relevant worker-client not found", source)
+	}
+	workerReq := &workerrpc.Request{
+		Type:        workerrpc.CmdQueryStatus,
+		QueryStatus: &pb.QueryStatusRequest{Name: taskName},
+	}
+	resp, err := worker.SendRequest(ctx, workerReq, s.cfg.RPCTimeout)
+	if err != nil {
+		return false, err
+	}
+	if !resp.QueryStatus.Result {
+		return false, terror.ErrMasterOperRespNotSuccess.Generate(resp.QueryStatus.Msg)
+	}
+
+	var syncStatus *pb.SyncStatus
+	for _, subtaskStatus := range resp.QueryStatus.SubTaskStatus {
+		if subtaskStatus.Name == taskName {
+			syncStatus = subtaskStatus.GetSync()
+		}
+	}
+	if syncStatus == nil || !syncStatus.Synced {
+		return false, nil
+	}
+	db, table := utils.ExtractDBAndTableFromLockID(lockID)
+	target := dbutil.TableName(db, table)
+	for _, activity := range syncStatus.ShardDMLActivities {
+		if activity.Target == target {
+			return activity.SinceTs > 0 && activity.SinceTs <= sinceTs && activity.LastDMLTs < sinceTs, nil
+		}
+	}
+	return false, nil
+}
+
 // getStatusFromWorkers does RPC request to get status from dm-workers.
 func (s *Server) getStatusFromWorkers(
 	ctx context.Context, sources []string, taskName string, specifiedSource bool,
@@ -1816,6 +1877,8 @@ func (s *Server) removeMetaData(ctx context.Context, taskName, metaSchema string
 		dbutil.TableName(metaSchema, cputil.SyncerCheckpoint(taskName))))
 	sqls = append(sqls, fmt.Sprintf("DROP TABLE IF EXISTS %s",
 		dbutil.TableName(metaSchema, cputil.SyncerShardMeta(taskName))))
+	sqls = append(sqls, fmt.Sprintf("DROP TABLE IF EXISTS %s",
+		dbutil.TableName(metaSchema, cputil.SyncerKafkaCommitTs(taskName))))
 	sqls = append(sqls, fmt.Sprintf("DROP TABLE IF EXISTS %s",
 		dbutil.TableName(metaSchema, cputil.SyncerOnlineDDL(taskName))))
 	sqls = append(sqls, fmt.Sprintf("DROP TABLE IF EXISTS %s",
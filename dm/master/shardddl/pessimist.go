@@ -15,6 +15,7 @@ package shardddl
 
 import (
 	"context"
+	"fmt"
 	"sort"
 	"sync"
 	"time"
@@ -35,6 +36,9 @@ var (
 	// variables to control the behavior of waiting for the operation to be done for `UnlockLock`.
 	unlockWaitInterval = time.Second
 	unlockWaitNum      = 10
+
+	// lockPolicyCheckInterval is the interval to apply the shard DDL lock policies.
+	lockPolicyCheckInterval = 10 * time.Second
 )
 
 // Pessimist used to coordinate the shard DDL migration in pessimism mode.
@@ -52,17 +56,28 @@ type Pessimist struct {
 
 	// taskSources used to get all sources relative to the given task.
 	taskSources func(task string) []string
+	// lockPolicy used to get the shard DDL lock policy of the given task, nil if no policy is set.
+	lockPolicy func(task string) *config.ShardLockPolicyConfig
+	// sourceIdle used to check whether the source has no DML for the target table of the lock since the given binlog timestamp.
+	sourceIdle func(ctx context.Context, task, source, lockID string, sinceTs int64) (bool, error)
 
 	infoOpMu sync.Mutex
+	// unlockMu used to serialize the unlocking by `unlock-ddl-lock`, the shard DDL lock policies and removing the meta data.
+	unlockMu sync.Mutex
 }
 
 // NewPessimist creates a new Pessimist instance.
-func NewPessimist(pLogger *log.Logger, taskSources func(task string) []string) *Pessimist {
+func NewPessimist(pLogger *log.Logger, taskSources func(task string) []string,
+	lockPolicy func(task string) *config.ShardLockPolicyConfig,
+	sourceIdle func(ctx context.Context, task, source, lockID string, sinceTs int64) (bool, error),
+) *Pessimist {
 	return &Pessimist{
 		logger:      pLogger.WithFields(zap.String("component", "shard DDL pessimist")),
 		closed:      true, // mark as closed before started.
 		lk:          pessimism.NewLockKeeper(),
 		taskSources: taskSources,
+		lockPolicy:  lockPolicy,
+		sourceIdle:  sourceIdle,
 	}
 }
 
@@ -87,6 +102,13 @@ func (p *Pessimist) Start(pCtx context.Context, etcdCli *clientv3.Client) error
 		//nolint:errcheck
 		p.run(ctx, etcdCli, rev1, rev2)
 	}()
+	if p.lockPolicy != nil {
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			p.runLockPolicy(ctx)
+		}()
+	}
 
 	p.closed = false // started now.
 	p.cancel = cancel
@@ -252,6 +274,8 @@ func (p *Pessimist) ShowLocks(task string, sources []string) []*pb.DDLLock {
 			DDLs:     lock.DDLs,
 			Synced:   make([]string, 0, len(ready)),
 			Unsynced: make([]string, 0, len(ready)),
+
+			LockPolicyState: lock.PolicyState(),
 		}
 		for worker, synced := range ready {
 			if synced {
@@ -285,6 +309,26 @@ func (p *Pessimist) UnlockLock(ctx context.Context, id, replaceOwner string, for
 	if p.closed {
 		return terror.ErrMasterPessimistNotStarted.Generate()
 	}
+	p.unlockMu.Lock()
+	defer p.unlockMu.Unlock()
+	return p.unlockLock(ctx, id, replaceOwner, forceRemove)
+}
+
+// unlockLockByPolicy unlocks a shard DDL lock by the shard DDL lock policies.
+// it can't take `p.mu` because `Close` holds it while waiting for the policy goroutine to exit,
+// instead `Close` cancels ctx before waiting, and `RemoveMetaData` takes `p.unlockMu` too.
+func (p *Pessimist) unlockLockByPolicy(ctx context.Context, id string) error {
+	p.unlockMu.Lock()
+	defer p.unlockMu.Unlock()
+	if ctx.Err() != nil {
+		return terror.ErrMasterPessimistNotStarted.Generate()
+	}
+	return p.unlockLock(ctx, id, "", false)
+}
+
+// unlockLock unlocks a shard DDL lock, see `UnlockLock` for the details.
+// the caller should hold `p.unlockMu`.
+func (p *Pessimist) unlockLock(ctx context.Context, id, replaceOwner string, forceRemove bool) error {
 	// 1. find the lock.
 	lock := p.lk.FindLock(id)
 	if lock == nil {
@@ -388,6 +432,9 @@ func (p *Pessimist) RemoveMetaData(task string) error {
 	if p.closed {
 		return terror.ErrMasterPessimistNotStarted.Generate()
 	}
+	// wait for the unlocking by the shard DDL lock policies.
+	p.unlockMu.Lock()
+	defer p.unlockMu.Unlock()
 
 	infos, ops, _, err := pessimism.GetInfosOperationsByTask(p.cli, task)
 	if err != nil {
@@ -812,3 +859,105 @@ func (p *Pessimist) waitNonOwnerToBeDone(ctx context.Context, lock *pessimism.Lo
 
 	return allDone(), nil
 }
+
+// runLockPolicy applies the shard DDL lock policies to the un-synced locks periodically.
+func (p *Pessimist) runLockPolicy(ctx context.Context) {
+	ticker := time.NewTicker(lockPolicyCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, lock := range p.lk.Locks() {
+				p.applyLockPolicy(ctx, lock)
+			}
+		}
+	}
+}
+
+// applyLockPolicy applies the shard DDL lock policy of the task if the lock has waited longer than the timeout.
+func (p *Pessimist) applyLockPolicy(ctx context.Context, lock *pessimism.Lock) {
+	policy := p.lockPolicy(lock.Task)
+	if policy == nil {
+		return
+	}
+	if synced, _ := lock.IsSynced(); synced {
+		return // the lock is resolving automatically.
+	}
+	waited := time.Since(lock.CreatedAt())
+	if waited < policy.TimeoutDuration() {
+		return
+	}
+	waitedStr := waited.Round(time.Second).String()
+
+	unsynced := make([]string, 0)
+	for source, synced := range lock.Ready() {
+		if !synced {
+			unsynced = append(unsynced, source)
+		}
+	}
+	sort.Strings(unsynced)
+
+	// only alert once for a lock.
+	if lock.PolicyState() == "" {
+		p.logger.Warn("the shard DDL lock has waited for un-synced sources too long", zap.String("lock", lock.ID),
+			zap.String("action", policy.Action), zap.String("waited", waitedStr), zap.Strings("un-synced", unsynced))
+		metrics.ReportDDLError(lock.Task, metrics.LockPolicyTimeout)
+	}
+
+	switch policy.Action {
+	case config.ShardLockAlert:
+		lock.SetPolicyState(fmt.Sprintf("%s: waited for %s, un-synced sources %v", policy.Action, waitedStr, unsynced))
+		return
+	case config.ShardLockSkipIdle:
+		// compare the binlog time of the DML activities with the binlog time of the DDLs,
+		// so the clock of DM-master is not involved.
+		startTs := lock.StartTs()
+		if startTs == 0 {
+			lock.SetPolicyState(fmt.Sprintf("%s: waited for %s, unknown binlog time of the DDLs, un-synced sources %v are not checked",
+				policy.Action, waitedStr, unsynced))
+			return
+		}
+		busy := make([]string, 0, len(unsynced))
+		for _, source := range unsynced {
+			idle, err := p.sourceIdle(ctx, lock.Task, source, lock.ID, startTs)
+			if err != nil {
+				p.logger.Warn("fail to check whether the un-synced source is idle", zap.String("lock", lock.ID),
+					zap.String("source", source), log.ShortError(err))
+				lock.SetPolicyState(fmt.Sprintf("%s: waited for %s, fail to check whether source %s is idle: %v",
+					policy.Action, waitedStr, source, err))
+				return
+			}
+			if !idle {
+				busy = append(busy, source)
+			}
+		}
+		if len(busy) > 0 {
+			lock.SetPolicyState(fmt.Sprintf("%s: waited for %s, un-synced sources %v are not idle", policy.Action, waitedStr, busy))
+			return
+		}
+	}
+
+	// record the lock before unlocking, because unlocking changes the synced stage of the sources.
+	record := pessimism.NewAuditRecord(lock, policy.Action, time.Now())
+	p.logger.Warn("unlock the shard DDL lock by the lock policy", zap.Stringer("record", record))
+	err := p.unlockLockByPolicy(ctx, lock.ID)
+	if terror.ErrMasterPessimistNotStarted.Equal(err) || terror.ErrMasterLockNotFound.Equal(err) {
+		// the pessimist is closing, or the lock has been removed with the meta data of the task.
+		p.logger.Info("skip unlocking the shard DDL lock by the lock policy", zap.String("lock", lock.ID), log.ShortError(err))
+		return
+	}
+	if err != nil {
+		p.logger.Error("fail to unlock the shard DDL lock by the lock policy", zap.String("lock", lock.ID), log.ShortError(err))
+		metrics.ReportDDLError(lock.Task, metrics.LockPolicyErrUnlock)
+		if p.lk.FindLock(lock.ID) != nil {
+			lock.SetPolicyState(fmt.Sprintf("%s: waited for %s, fail to unlock: %v", policy.Action, waitedStr, err))
+			return
+		}
+		// the lock has been removed even if some non-owner sources fail to skip the DDLs.
+	}
+	if _, err = pessimism.PutAuditRecord(p.cli, record); err != nil {
+		p.logger.Error("fail to put the audit record of the shard DDL lock", zap.Stringer("record", record), log.ShortError(err))
+	}
+}
@@ -74,7 +74,8 @@ func (t *testPessimistSuite) clearTestInfoOperation() {
 
 	clearInfo := clientv3.OpDelete(common.ShardDDLPessimismInfoKeyAdapter.Path(), clientv3.WithPrefix())
 	clearOp := clientv3.OpDelete(common.ShardDDLPessimismOperationKeyAdapter.Path(), clientv3.WithPrefix())
-	_, err := t.etcdTestCli.Txn(context.Background()).Then(clearInfo, clearOp).Commit()
+	clearAudit := clientv3.OpDelete(common.ShardDDLPessimismAuditKeyAdapter.Path(), clientv3.WithPrefix())
+	_, err := t.etcdTestCli.Txn(context.Background()).Then(clearInfo, clearOp, clearAudit).Commit()
 	require.NoError(t.T(), err)
 }
 
@@ -116,7 +117,7 @@ func (t *testPessimistSuite) testPessimistProgress(restart int) {
 			return []string{}
 		}
 		logger = log.L()
-		p      = NewPessimist(&logger, sources)
+		p      = NewPessimist(&logger, sources, nil, nil)
 
 		rebuildPessimist = func(ctx context.Context) {
 			switch restart {
@@ -125,7 +126,7 @@ func (t *testPessimistSuite) testPessimistProgress(restart int) {
 				require.NoError(t.T(), p.Start(ctx, t.etcdTestCli))
 			case restartNewInstance:
 				p.Close()
-				p = NewPessimist(&logger, sources)
+				p = NewPessimist(&logger, sources, nil, nil)
 				require.NoError(t.T(), p.Start(ctx, t.etcdTestCli))
 			}
 		}
@@ -369,7 +370,7 @@ func (t *testPessimistSuite) TestSourceReEntrant() {
 			return []string{}
 		}
 		logger = log.L()
-		p      = NewPessimist(&logger, sources)
+		p      = NewPessimist(&logger, sources, nil, nil)
 	)
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -564,7 +565,7 @@ func (t *testPessimistSuite) TestUnlockSourceMissBeforeSynced() {
 			return []string{}
 		}
 		logger = log.L()
-		p      = NewPessimist(&logger, sources)
+		p      = NewPessimist(&logger, sources, nil, nil)
 	)
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -625,6 +626,138 @@ func (t *testPessimistSuite) TestUnlockSourceMissBeforeSynced() {
 	t.noLockExist(p)
 }
 
+func (t *testPessimistSuite) TestLockPolicy() {
+	oriUnlockWaitOwnerInterval := unlockWaitInterval
+	unlockWaitInterval = 100 * time.Millisecond
+	defer func() {
+		unlockWaitInterval = oriUnlockWaitOwnerInterval
+	}()
+
+	var (
+		watchTimeout  = 3 * time.Second
+		task          = "task-lock-policy"
+		source1       = "mysql-replica-1"
+		source2       = "mysql-replica-2"
+		source3       = "mysql-replica-3"
+		schema, table = "foo", "bar"
+		DDLs          = []string{"ALTER TABLE bar ADD COLUMN c1 INT"}
+		ID            = fmt.Sprintf("%s-`%s`.`%s`", task, schema, table)
+		i11           = pessimism.NewInfo(task, source1, schema, table, DDLs)
+		i12           = pessimism.NewInfo(task, source2, schema, table, DDLs)
+
+		sources = func(task string) []string {
+			return []string{source1, source2, source3}
+		}
+		policy     = &config.ShardLockPolicyConfig{Action: config.ShardLockAlert, Timeout: "1h"}
+		lockPolicy = func(string) *config.ShardLockPolicyConfig {
+			return policy
+		}
+		idle        bool
+		idleErr     error
+		idleSource  string
+		idleSinceTs int64
+		sourceIdle  = func(_ context.Context, _, source, _ string, sinceTs int64) (bool, error) {
+			idleSource = source
+			idleSinceTs = sinceTs
+			return idle, idleErr
+		}
+		logger = log.L()
+		p      = NewPessimist(&logger, sources, lockPolicy, sourceIdle)
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	require.NoError(t.T(), p.Start(ctx, t.etcdTestCli))
+	defer p.Close()
+
+	// 1. PUT i11 & i12, will create a lock waiting for source3.
+	i11.DDLTs = 1700000200
+	i12.DDLTs = 1700000100
+	_, err := pessimism.PutInfo(t.etcdTestCli, i11)
+	require.NoError(t.T(), err)
+	rev1, err := pessimism.PutInfo(t.etcdTestCli, i12)
+	require.NoError(t.T(), err)
+	require.Eventually(t.T(), func() bool {
+		if len(p.Locks()) != 1 {
+			return false
+		}
+		_, remain := p.Locks()[ID].IsSynced()
+		return remain == 1
+	}, 3*time.Second, 100*time.Millisecond)
+	lock := p.Locks()[ID]
+
+	// 2. the lock has not waited long enough, nothing happens.
+	p.applyLockPolicy(ctx, lock)
+	require.Equal(t.T(), "", lock.PolicyState())
+
+	// 3. `alert` only records the state.
+	policy.Timeout = "1ns"
+	p.applyLockPolicy(ctx, lock)
+	require.Regexp(t.T(), `^alert: waited for .*, un-synced sources \[mysql-replica-3\]$`, lock.PolicyState())
+	locks := p.ShowLocks(task, nil)
+	require.Len(t.T(), locks, 1)
+	require.Equal(t.T(), lock.PolicyState(), locks[0].LockPolicyState)
+	require.Contains(t.T(), p.Locks(), ID)
+
+	// 4. `skip-idle` fails to check the un-synced source.
+	policy.Action = config.ShardLockSkipIdle
+	idleErr = errors.New("mock check idle error")
+	p.applyLockPolicy(ctx, lock)
+	require.Equal(t.T(), source3, idleSource)
+	require.Equal(t.T(), int64(1700000100), idleSinceTs) // the earliest DDL of the lock.
+	require.Regexp(t.T(), "fail to check whether source mysql-replica-3 is idle: mock check idle error$", lock.PolicyState())
+	require.Contains(t.T(), p.Locks(), ID)
+
+	// 5. `skip-idle` with the un-synced source is not idle.
+	idleErr = nil
+	p.applyLockPolicy(ctx, lock)
+	require.Regexp(t.T(), `^skip-idle: .*un-synced sources \[mysql-replica-3\] are not idle$`, lock.PolicyState())
+	require.Contains(t.T(), p.Locks(), ID)
+
+	// the binlog time of the DDLs is unknown for the infos put by older DM-workers, skip checking.
+	idleSource = ""
+	lockWithoutTs := pessimism.NewLock(ID, task, source1, DDLs, []string{source1, source2, source3})
+	p.applyLockPolicy(ctx, lockWithoutTs)
+	require.Equal(t.T(), "", idleSource)
+	require.Regexp(t.T(), "unknown binlog time of the DDLs", lockWithoutTs.PolicyState())
+
+	// the pessimist is closing, the lock policy should not unlock the lock anymore.
+	idle = true
+	canceledCtx, cancel2 := context.WithCancel(ctx)
+	cancel2()
+	p.applyLockPolicy(canceledCtx, lock)
+	require.Contains(t.T(), p.Locks(), ID)
+	records, _, err := pessimism.GetAuditRecordsByTask(t.etcdTestCli, task)
+	require.NoError(t.T(), err)
+	require.Len(t.T(), records, 0)
+
+	// 6. `skip-idle` with the un-synced source is idle, the lock should be unlocked.
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		t.putDoneForSource(ctx, task, source1, i11, true, rev1+1, watchTimeout)
+	}()
+	go func() {
+		defer wg.Done()
+		t.putDoneForSource(ctx, task, source2, i12, false, rev1+1, watchTimeout)
+	}()
+	p.applyLockPolicy(ctx, lock)
+	wg.Wait()
+	t.noLockExist(p)
+
+	// 7. an audit record should be put.
+	records, _, err = pessimism.GetAuditRecordsByTask(t.etcdTestCli, task)
+	require.NoError(t.T(), err)
+	require.Len(t.T(), records, 1)
+	require.Equal(t.T(), ID, records[0].ID)
+	require.Equal(t.T(), config.ShardLockSkipIdle, records[0].Action)
+	require.Equal(t.T(), source1, records[0].Owner)
+	require.Equal(t.T(), []string{source1, source2}, records[0].Synced)
+	require.Equal(t.T(), []string{source3}, records[0].Unsynced)
+}
+
 func (t *testPessimistSuite) TestUnlockSourceInterrupt() {
 	// operations may be done but not be deleted, and then interrupted.
 
@@ -655,7 +788,7 @@ func (t *testPessimistSuite) TestUnlockSourceInterrupt() {
 			return []string{}
 		}
 		logger = log.L()
-		p      = NewPessimist(&logger, sources)
+		p      = NewPessimist(&logger, sources, nil, nil)
 	)
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -771,7 +904,7 @@ func (t *testPessimistSuite) TestUnlockSourceOwnerRemoved() {
 			return []string{}
 		}
 		logger = log.L()
-		p      = NewPessimist(&logger, sources)
+		p      = NewPessimist(&logger, sources, nil, nil)
 	)
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -860,7 +993,7 @@ func (t *testPessimistSuite) TestMeetEtcdCompactError() {
 			return []string{}
 		}
 		logger = log.L()
-		p      = NewPessimist(&logger, sources)
+		p      = NewPessimist(&logger, sources, nil, nil)
 	)
 
 	ctx, cancel := context.WithCancel(context.Background())
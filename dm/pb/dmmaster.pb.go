@@ -755,13 +755,14 @@ func (m *ShowDDLLocksRequest) GetSources() []string {
 // synced: already synced dm-workers
 // unsynced: pending to sync dm-workers
 type DDLLock struct {
-	ID       string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Task     string   `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
-	Mode     string   `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
-	Owner    string   `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
-	DDLs     []string `protobuf:"bytes,5,rep,name=DDLs,proto3" json:"DDLs,omitempty"`
-	Synced   []string `protobuf:"bytes,6,rep,name=synced,proto3" json:"synced,omitempty"`
-	Unsynced []string `protobuf:"bytes,7,rep,name=unsynced,proto3" json:"unsynced,omitempty"`
+	ID              string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Task            string   `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
+	Mode            string   `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	Owner           string   `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	DDLs            []string `protobuf:"bytes,5,rep,name=DDLs,proto3" json:"DDLs,omitempty"`
+	Synced          []string `protobuf:"bytes,6,rep,name=synced,proto3" json:"synced,omitempty"`
+	Unsynced        []string `protobuf:"bytes,7,rep,name=unsynced,proto3" json:"unsynced,omitempty"`
+	LockPolicyState string   `protobuf:"bytes,8,opt,name=lockPolicyState,proto3" json:"lockPolicyState,omitempty"`
 }
 
 func (m *DDLLock) Reset()         { *m = DDLLock{} }
@@ -846,6 +847,13 @@ func (m *DDLLock) GetUnsynced() []string {
 	return nil
 }
 
+func (m *DDLLock) GetLockPolicyState() string {
+	if m != nil {
+		return m.LockPolicyState
+	}
+	return ""
+}
+
 type ShowDDLLocksResponse struct {
 	Result bool       `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
 	Msg    string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
@@ -3960,174 +3968,175 @@ func init() {
 func init() { proto.RegisterFile("dmmaster.proto", fileDescriptor_f9bef11f2a341f03) }
 
 var fileDescriptor_f9bef11f2a341f03 = []byte{
-	// 2658 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x3a, 0xcd, 0x6f, 0x1b, 0xc7,
-	0xf5, 0x5c, 0x52, 0x1f, 0xd4, 0x93, 0x44, 0x51, 0x23, 0x89, 0xa2, 0xd7, 0x32, 0x2d, 0x4f, 0x3e,
-	0x20, 0x08, 0x81, 0xf4, 0xb3, 0x7e, 0x39, 0x14, 0x06, 0x12, 0x24, 0x16, 0x15, 0x5b, 0x88, 0x6c,
-	0xa7, 0x2b, 0xd9, 0x6d, 0x10, 0xa0, 0xc9, 0x92, 0x1c, 0x52, 0x84, 0x96, 0xbb, 0xeb, 0xdd, 0xa5,
-	0x64, 0xc2, 0x48, 0x0f, 0x3d, 0xf5, 0xd2, 0xb4, 0x45, 0x8a, 0xe6, 0xd8, 0x43, 0xff, 0x81, 0xfe,
-	0x19, 0x3d, 0x06, 0xc8, 0xa5, 0x97, 0xa2, 0x85, 0xdd, 0x3f, 0xa4, 0x98, 0x37, 0xb3, 0xbb, 0xb3,
-	0x1f, 0x64, 0x4a, 0x03, 0x15, 0x7a, 0x9b, 0xf7, 0xde, 0xf0, 0x7d, 0xcd, 0x9b, 0x79, 0x1f, 0x4b,
-	0xa8, 0x74, 0x06, 0x03, 0xd3, 0x0f, 0x98, 0xb7, 0xe7, 0x7a, 0x4e, 0xe0, 0x90, 0xa2, 0xdb, 0xd2,
-	0x2b, 0x9d, 0xc1, 0x95, 0xe3, 0x5d, 0x84, 0x38, 0x7d, 0xab, 0xe7, 0x38, 0x3d, 0x8b, 0xed, 0x9b,
-	0x6e, 0x7f, 0xdf, 0xb4, 0x6d, 0x27, 0x30, 0x83, 0xbe, 0x63, 0xfb, 0x92, 0x7a, 0x53, 0x52, 0x11,
-	0x6a, 0x0d, 0xbb, 0xfb, 0x6c, 0xe0, 0x06, 0x23, 0x41, 0xa4, 0xbf, 0x84, 0xea, 0x69, 0x60, 0x7a,
-	0xc1, 0x99, 0xe9, 0x5f, 0x18, 0xec, 0xf9, 0x90, 0xf9, 0x01, 0x21, 0x30, 0x13, 0x98, 0xfe, 0x45,
-	0x5d, 0xdb, 0xd6, 0x76, 0x16, 0x0c, 0x5c, 0x93, 0x3a, 0xcc, 0xfb, 0xce, 0xd0, 0x6b, 0x33, 0xbf,
-	0x5e, 0xdc, 0x2e, 0xed, 0x2c, 0x18, 0x21, 0x48, 0x1a, 0x00, 0x1e, 0x1b, 0x38, 0x97, 0xec, 0x11,
-	0x0b, 0xcc, 0x7a, 0x69, 0x5b, 0xdb, 0x29, 0x1b, 0x0a, 0x86, 0x6c, 0xc1, 0x82, 0x8f, 0x12, 0xfa,
-	0x03, 0x56, 0x9f, 0x41, 0x96, 0x31, 0x82, 0x7e, 0xab, 0xc1, 0xaa, 0xa2, 0x80, 0xef, 0x3a, 0xb6,
-	0xcf, 0x48, 0x0d, 0xe6, 0x3c, 0xe6, 0x0f, 0xad, 0x00, 0x75, 0x28, 0x1b, 0x12, 0x22, 0x55, 0x28,
-	0x0d, 0xfc, 0x5e, 0xbd, 0x88, 0x5c, 0xf8, 0x92, 0x1c, 0xc4, 0x7a, 0x95, 0xb6, 0x4b, 0x3b, 0x8b,
-	0x07, 0xf5, 0x3d, 0xb7, 0xb5, 0x77, 0xe8, 0x0c, 0x06, 0x8e, 0xfd, 0x33, 0xf4, 0x51, 0xc8, 0x34,
-	0xd6, 0x78, 0x1b, 0x16, 0xdb, 0xe7, 0xac, 0xcd, 0xc5, 0x71, 0x11, 0x42, 0x27, 0x15, 0x45, 0x7f,
-	0x01, 0xe4, 0x89, 0xcb, 0x3c, 0x33, 0x60, 0xaa, 0x5f, 0x74, 0x28, 0x3a, 0x2e, 0x6a, 0x54, 0x39,
-	0x00, 0x2e, 0x86, 0x13, 0x9f, 0xb8, 0x46, 0xd1, 0x71, 0xb9, 0xcf, 0x6c, 0x73, 0xc0, 0xa4, 0x6a,
-	0xb8, 0x56, 0x7d, 0x56, 0x4a, 0xf8, 0x8c, 0xfe, 0x56, 0x83, 0xb5, 0x84, 0x00, 0x69, 0xf7, 0x24,
-	0x09, 0xb1, 0x4f, 0x8a, 0x79, 0x3e, 0x29, 0xe5, 0xfa, 0x64, 0xe6, 0x3f, 0xf4, 0x09, 0xfd, 0x18,
-	0x56, 0x9f, 0xba, 0x9d, 0x94, 0xc1, 0x53, 0x05, 0x02, 0xfd, 0x83, 0x06, 0x44, 0xe5, 0xf1, 0x3f,
-	0x72, 0x96, 0x9f, 0x40, 0xed, 0xa7, 0x43, 0xe6, 0x8d, 0x4e, 0x03, 0x33, 0x18, 0xfa, 0x27, 0x7d,
-	0x3f, 0x50, 0xcc, 0xc3, 0x33, 0xd3, 0xf2, 0xcf, 0x2c, 0x65, 0xde, 0x25, 0x6c, 0x66, 0xf8, 0x4c,
-	0x6d, 0xe2, 0xdd, 0xb4, 0x89, 0x9b, 0xdc, 0x44, 0x85, 0x6f, 0xf6, 0x64, 0x0e, 0x61, 0xed, 0xf4,
-	0xdc, 0xb9, 0x6a, 0x36, 0x4f, 0x4e, 0x9c, 0xf6, 0x85, 0xff, 0x66, 0x67, 0xf3, 0x27, 0x0d, 0xe6,
-	0x25, 0x07, 0x52, 0x81, 0xe2, 0x71, 0x53, 0xfe, 0xae, 0x78, 0xdc, 0x8c, 0x38, 0x15, 0x15, 0x4e,
-	0x04, 0x66, 0x06, 0x4e, 0x87, 0xc9, 0xa8, 0xc2, 0x35, 0x59, 0x87, 0x59, 0xe7, 0xca, 0x66, 0x9e,
-	0x74, 0xb2, 0x00, 0xf8, 0xce, 0x66, 0xf3, 0xc4, 0xaf, 0xcf, 0xa2, 0x40, 0x5c, 0x73, 0x7f, 0xf8,
-	0x23, 0xbb, 0xcd, 0x3a, 0xf5, 0x39, 0xc4, 0x4a, 0x88, 0xe8, 0x50, 0x1e, 0xda, 0x92, 0x32, 0x8f,
-	0x94, 0x08, 0xa6, 0x6d, 0x58, 0x4f, 0x9a, 0x39, 0xb5, 0x6f, 0xef, 0xc0, 0xac, 0xc5, 0x7f, 0x2a,
-	0x3d, 0xbb, 0xc8, 0x3d, 0x2b, 0xd9, 0x19, 0x82, 0x42, 0xff, 0xae, 0xc1, 0xfa, 0x53, 0x9b, 0xaf,
-	0x43, 0x82, 0xf4, 0x66, 0xda, 0x27, 0x14, 0x96, 0x3c, 0xe6, 0x5a, 0x66, 0x9b, 0x3d, 0x41, 0x93,
-	0x85, 0x98, 0x04, 0x8e, 0x87, 0x5e, 0xd7, 0xf1, 0xda, 0xcc, 0xc0, 0xb7, 0x4e, 0xbe, 0x7c, 0x2a,
-	0x8a, 0xbc, 0x85, 0xd7, 0x79, 0x06, 0xaf, 0xf3, 0x1a, 0x57, 0x27, 0x21, 0x5b, 0xde, 0x6b, 0xe5,
-	0xd0, 0x66, 0x93, 0x2f, 0xab, 0x0e, 0xe5, 0x8e, 0x19, 0x98, 0x2d, 0xd3, 0x67, 0xf5, 0x39, 0x54,
-	0x20, 0x82, 0xf9, 0x61, 0x04, 0x66, 0xcb, 0x62, 0xf5, 0x79, 0x71, 0x18, 0x08, 0xd0, 0x8f, 0x61,
-	0x23, 0x65, 0xde, 0xb4, 0x5e, 0xa4, 0x06, 0xdc, 0x90, 0x2f, 0x53, 0x78, 0xe5, 0x2c, 0x73, 0x14,
-	0xba, 0xe9, 0xa6, 0xf2, 0x3e, 0xa1, 0x7f, 0x91, 0x9a, 0x35, 0x24, 0x15, 0x7d, 0xdf, 0x69, 0xa0,
-	0xe7, 0x31, 0x95, 0xca, 0x4d, 0xe4, 0xfa, 0xdf, 0x7d, 0xf6, 0xbe, 0xd3, 0x60, 0xf3, 0xb3, 0xa1,
-	0xd7, 0xcb, 0x33, 0x56, 0xb1, 0x47, 0xcb, 0x1c, 0x4c, 0xdf, 0x36, 0xdb, 0x41, 0xff, 0x92, 0x49,
-	0xad, 0x22, 0x18, 0x6f, 0x13, 0xcf, 0x74, 0x5c, 0xb1, 0x92, 0x81, 0x6b, 0xbe, 0xbf, 0xdb, 0xb7,
-	0x18, 0x3e, 0x36, 0xe2, 0xf2, 0x44, 0x30, 0xde, 0x95, 0x61, 0xab, 0xd9, 0xf7, 0xea, 0xb3, 0x48,
-	0x91, 0x10, 0x7d, 0x01, 0xf5, 0xac, 0x62, 0xd7, 0xf1, 0xa4, 0xd2, 0x4b, 0xa8, 0x1e, 0xf2, 0xf7,
-	0xf3, 0xc7, 0x32, 0x41, 0x0d, 0xe6, 0x98, 0xe7, 0x1d, 0xda, 0xe2, 0x64, 0x4a, 0x86, 0x84, 0xb8,
-	0xdf, 0xae, 0x4c, 0xcf, 0xe6, 0x04, 0xe1, 0x84, 0x10, 0xfc, 0x91, 0x52, 0xe0, 0x03, 0x58, 0x55,
-	0xe4, 0x4e, 0x1d, 0xb8, 0xbf, 0xd6, 0x60, 0x5d, 0x06, 0xd9, 0x29, 0x5a, 0x12, 0xea, 0xbe, 0xa5,
-	0x84, 0xd7, 0x12, 0x37, 0x5f, 0x90, 0xe3, 0xf8, 0x6a, 0x3b, 0x76, 0xb7, 0xdf, 0x93, 0x41, 0x2b,
-	0x21, 0x7e, 0x66, 0xc2, 0x21, 0xc7, 0x4d, 0x99, 0xbd, 0x23, 0x98, 0x97, 0x3c, 0xa2, 0xfe, 0x7a,
-	0x1c, 0x9f, 0xa8, 0x82, 0xa1, 0x43, 0xd8, 0x48, 0x69, 0x72, 0x2d, 0x07, 0x77, 0x04, 0x1b, 0x06,
-	0xeb, 0xf5, 0x79, 0xb1, 0x18, 0x6e, 0x99, 0x98, 0xe8, 0xcc, 0x4e, 0xc7, 0x63, 0xbe, 0x2f, 0xc5,
-	0x86, 0x20, 0xfd, 0x0a, 0x6a, 0x69, 0x36, 0x53, 0xab, 0xcf, 0x4f, 0x9a, 0xb5, 0x3d, 0x16, 0x7c,
-	0xca, 0x46, 0x18, 0x05, 0x4b, 0x46, 0x8c, 0xa0, 0x1f, 0xc2, 0xfa, 0x93, 0x6e, 0xd7, 0xea, 0xdb,
-	0xec, 0x11, 0x1b, 0xb4, 0x12, 0x7a, 0x06, 0x23, 0x37, 0xd2, 0x93, 0xaf, 0xf3, 0x0a, 0x2b, 0xfe,
-	0xcc, 0xa5, 0x7e, 0x3f, 0x75, 0xb4, 0xbc, 0x1f, 0x05, 0xcb, 0x09, 0x33, 0x3b, 0xb1, 0x0a, 0x99,
-	0x60, 0x11, 0x64, 0x11, 0x2c, 0x28, 0x38, 0xf9, 0xab, 0xa9, 0x05, 0x7f, 0xa3, 0x01, 0x3c, 0xc2,
-	0x82, 0xfe, 0xd8, 0xee, 0x3a, 0xb9, 0x47, 0xa3, 0x43, 0x79, 0x80, 0x76, 0x1d, 0x37, 0xf1, 0x97,
-	0x33, 0x46, 0x04, 0xf3, 0x77, 0xdf, 0xb4, 0xfa, 0x51, 0xba, 0x11, 0x00, 0xff, 0x85, 0xcb, 0x98,
-	0xf7, 0xd4, 0x38, 0x11, 0x6f, 0xdf, 0x82, 0x11, 0xc1, 0x3c, 0x58, 0xdb, 0x56, 0x9f, 0xd9, 0x01,
-	0x52, 0x45, 0x8a, 0x51, 0x30, 0xb4, 0x05, 0x20, 0x8e, 0x79, 0xac, 0x3e, 0x04, 0x66, 0x78, 0x6c,
-	0x84, 0x47, 0xc0, 0xd7, 0x5c, 0x0f, 0x3f, 0x30, 0x7b, 0x61, 0x85, 0x20, 0x00, 0x7c, 0xcc, 0x30,
-	0x18, 0xe5, 0xa5, 0x90, 0x10, 0x3d, 0x81, 0x2a, 0x2f, 0x98, 0x84, 0xd3, 0xc4, 0x99, 0x85, 0xae,
-	0xd1, 0xe2, 0xa0, 0xc9, 0xab, 0xa1, 0x43, 0xd9, 0xa5, 0x58, 0x36, 0x7d, 0x2c, 0xb8, 0x09, 0x2f,
-	0x8e, 0xe5, 0xb6, 0x03, 0xf3, 0xa2, 0x71, 0x12, 0xe9, 0x68, 0xf1, 0xa0, 0xc2, 0x8f, 0x33, 0x76,
-	0xbd, 0x11, 0x92, 0x43, 0x7e, 0xc2, 0x0b, 0x93, 0xf8, 0x89, 0x2b, 0x9e, 0xe0, 0x17, 0xbb, 0xce,
-	0x08, 0xc9, 0xf4, 0xcf, 0x1a, 0xcc, 0x0b, 0x36, 0x3e, 0xd9, 0x83, 0x39, 0x0b, 0xad, 0x46, 0x56,
-	0x8b, 0x07, 0xeb, 0x18, 0x53, 0x29, 0x5f, 0x3c, 0x2c, 0x18, 0x72, 0x17, 0xdf, 0x2f, 0xd4, 0x42,
-	0x2f, 0x28, 0xfb, 0x55, 0x6b, 0xf9, 0x7e, 0xb1, 0x8b, 0xef, 0x17, 0x62, 0xd1, 0x43, 0xca, 0x7e,
-	0xd5, 0x1a, 0xbe, 0x5f, 0xec, 0xba, 0x5f, 0x86, 0x39, 0x11, 0x4b, 0xf4, 0x39, 0xac, 0x22, 0xdf,
-	0xc4, 0x0d, 0xac, 0x25, 0xd4, 0x2d, 0x47, 0x6a, 0xd5, 0x12, 0x6a, 0x95, 0x23, 0xf1, 0xb5, 0x84,
-	0xf8, 0x72, 0x28, 0x86, 0x87, 0x07, 0x3f, 0xbe, 0x30, 0x1a, 0x05, 0x40, 0x19, 0x10, 0x55, 0xe4,
-	0xd4, 0xaf, 0xca, 0x3b, 0x30, 0x2f, 0x94, 0x4f, 0xd4, 0x78, 0xd2, 0xd5, 0x46, 0x48, 0xa3, 0x7f,
-	0x2c, 0xc6, 0x99, 0xa0, 0x7d, 0xce, 0x06, 0xe6, 0xf8, 0x4c, 0x80, 0xe4, 0xb8, 0x85, 0xcb, 0xd4,
-	0xc1, 0x63, 0x5b, 0xb8, 0x44, 0x71, 0x36, 0x33, 0xae, 0x38, 0x9b, 0x55, 0x8a, 0x33, 0xbc, 0x1c,
-	0x28, 0x4f, 0x16, 0x73, 0x12, 0xe2, 0xbb, 0xbb, 0xd6, 0xd0, 0x3f, 0xc7, 0x52, 0xae, 0x6c, 0x08,
-	0x80, 0x6b, 0xc3, 0x2b, 0xe3, 0x7a, 0x19, 0x91, 0xb8, 0xe6, 0x57, 0xb9, 0xeb, 0x39, 0x03, 0x91,
-	0x54, 0xea, 0x0b, 0xa2, 0xd5, 0x8e, 0x31, 0x21, 0xfd, 0xcc, 0xf4, 0x7a, 0x2c, 0xa8, 0x43, 0x4c,
-	0x17, 0x18, 0x35, 0x2f, 0x49, 0xbf, 0x5c, 0x4b, 0x5e, 0xda, 0x85, 0xf5, 0x07, 0x2c, 0x38, 0x1d,
-	0xb6, 0x78, 0x66, 0x3f, 0xec, 0xf6, 0x26, 0xa4, 0x25, 0xfa, 0x14, 0x36, 0x52, 0x7b, 0xa7, 0x56,
-	0x91, 0xc0, 0x4c, 0xbb, 0xdb, 0x0b, 0x0f, 0x0c, 0xd7, 0xb4, 0x09, 0xcb, 0x0f, 0x58, 0xa0, 0xc8,
-	0xbe, 0xad, 0xa4, 0x1a, 0x59, 0x75, 0x1e, 0x76, 0x7b, 0x67, 0x23, 0x97, 0x4d, 0xc8, 0x3b, 0x27,
-	0x50, 0x09, 0xb9, 0x4c, 0xad, 0x55, 0x15, 0x4a, 0xed, 0x6e, 0x54, 0xaf, 0xb6, 0xbb, 0x3d, 0xba,
-	0x01, 0x6b, 0x0f, 0x98, 0xbc, 0xd7, 0xb1, 0x66, 0x74, 0x07, 0xbd, 0xa5, 0xa0, 0xa5, 0x28, 0xc9,
-	0x40, 0x8b, 0x19, 0xfc, 0x5e, 0x03, 0xf2, 0xd0, 0xb4, 0x3b, 0x16, 0x3b, 0xf2, 0x3c, 0xc7, 0x1b,
-	0x5b, 0xa4, 0x23, 0xf5, 0x8d, 0x82, 0x7c, 0x0b, 0x16, 0x5a, 0x7d, 0xdb, 0x72, 0x7a, 0x9f, 0x39,
-	0x7e, 0x58, 0xb0, 0x45, 0x08, 0x0c, 0xd1, 0xe7, 0x56, 0xd4, 0xfa, 0xf1, 0x35, 0xf5, 0x61, 0x2d,
-	0xa1, 0xd2, 0xb5, 0x04, 0xd8, 0x03, 0xd8, 0x38, 0xf3, 0x4c, 0xdb, 0xef, 0x32, 0x2f, 0x59, 0xfa,
-	0xc5, 0xf9, 0x48, 0x53, 0xf3, 0x91, 0xf2, 0x6c, 0x09, 0xc9, 0x12, 0xa2, 0xf7, 0xa1, 0x96, 0x66,
-	0x34, 0x75, 0x82, 0xef, 0x44, 0xa3, 0x9d, 0x44, 0x37, 0x71, 0x4b, 0x39, 0x95, 0x65, 0xa5, 0xc9,
-	0x79, 0x76, 0x10, 0x96, 0xa1, 0x52, 0xd3, 0xe2, 0x18, 0x4d, 0xc5, 0xd1, 0x84, 0x9a, 0x06, 0xd1,
-	0x13, 0x77, 0x9d, 0xad, 0xc1, 0x5f, 0x34, 0xa8, 0xe1, 0xb4, 0xee, 0x99, 0x69, 0xf5, 0x3b, 0x38,
-	0x65, 0x8c, 0x2f, 0x14, 0x0c, 0x9c, 0x0e, 0xfb, 0xf2, 0xd2, 0xb4, 0x86, 0xd2, 0xdd, 0x0f, 0x0b,
-	0xc6, 0x02, 0xc7, 0x3d, 0xe3, 0x28, 0xb2, 0x0b, 0x55, 0xac, 0xf5, 0xbf, 0xe4, 0x2d, 0x91, 0xdc,
-	0x86, 0xea, 0x3c, 0xd4, 0x8c, 0x4a, 0xd4, 0x05, 0x88, 0xbd, 0x13, 0x9f, 0x5d, 0x1e, 0xb3, 0x4a,
-	0xe1, 0x1d, 0xc1, 0xf7, 0xe7, 0xc4, 0xd0, 0xe2, 0xfe, 0xa2, 0xd2, 0x66, 0xd0, 0x2b, 0xd8, 0xcc,
-	0x68, 0x7c, 0x2d, 0xbe, 0x7a, 0x04, 0x1b, 0xa7, 0x81, 0xe3, 0x66, 0x3d, 0x35, 0xb1, 0xaf, 0x8c,
-	0x8c, 0x2b, 0x26, 0x8d, 0xa3, 0x97, 0xdc, 0xf3, 0x49, 0x76, 0xd7, 0x62, 0xc6, 0x6f, 0x34, 0xd8,
-	0x14, 0x53, 0xbd, 0xac, 0x25, 0xaa, 0xbe, 0x5a, 0x52, 0xdf, 0x09, 0x03, 0xe3, 0xc4, 0xa3, 0x52,
-	0x4a, 0x3f, 0x2a, 0x0d, 0x00, 0x01, 0x3c, 0x38, 0x3b, 0x6e, 0x86, 0xbd, 0x55, 0x8c, 0xe1, 0x7d,
-	0x71, 0x56, 0x9d, 0x6b, 0xf1, 0xc4, 0x1e, 0x54, 0x8e, 0xec, 0xb6, 0x37, 0x72, 0x83, 0xb8, 0x9e,
-	0x58, 0x70, 0x2d, 0xb3, 0x6f, 0x07, 0xec, 0x45, 0x20, 0x1d, 0x10, 0x23, 0xe8, 0x17, 0xb0, 0x12,
-	0xed, 0x9f, 0x5a, 0x41, 0x5e, 0xb5, 0xf7, 0xdd, 0x73, 0xe6, 0x21, 0x6f, 0xe1, 0x25, 0x05, 0x43,
-	0x7f, 0xd0, 0x60, 0x93, 0xd7, 0x52, 0x98, 0x26, 0xb1, 0x63, 0x7d, 0x93, 0x91, 0xd9, 0x63, 0x58,
-	0x0c, 0x62, 0x06, 0xd2, 0x15, 0xef, 0x85, 0x25, 0x64, 0x0e, 0xef, 0x3d, 0x05, 0x77, 0x64, 0x07,
-	0xde, 0xc8, 0x50, 0x19, 0xe8, 0x1f, 0x42, 0x35, 0xbd, 0x81, 0x4b, 0xbd, 0x60, 0xa3, 0x30, 0x6f,
-	0x5d, 0xb0, 0x11, 0x2f, 0x78, 0x94, 0xeb, 0x6f, 0x08, 0xe0, 0x5e, 0xf1, 0x27, 0x1a, 0xfd, 0x87,
-	0x06, 0x37, 0xb8, 0x64, 0xf1, 0xf8, 0xbe, 0xb9, 0x5d, 0xcf, 0x60, 0xd9, 0x57, 0x59, 0x48, 0xcb,
-	0xfe, 0x2f, 0xb4, 0x2c, 0x97, 0xff, 0x5e, 0x02, 0x2b, 0xac, 0x4b, 0xb2, 0xd1, 0x3f, 0x02, 0x92,
-	0xdd, 0x34, 0x8d, 0x85, 0xbb, 0x1f, 0xc1, 0x4a, 0x6a, 0x08, 0x48, 0x56, 0x61, 0xf9, 0xd8, 0xbe,
-	0xe4, 0xd1, 0x2c, 0x10, 0xd5, 0x02, 0x59, 0x82, 0xf2, 0xe9, 0x45, 0xdf, 0xe5, 0x70, 0x55, 0xe3,
-	0xd0, 0xd1, 0x0b, 0xd6, 0x46, 0xa8, 0xb8, 0xdb, 0x82, 0x72, 0x38, 0xc0, 0x20, 0x6b, 0xb0, 0x22,
-	0x7f, 0x1a, 0xa2, 0xaa, 0x05, 0xb2, 0x02, 0x8b, 0xf8, 0xe2, 0x09, 0x54, 0x55, 0x23, 0x55, 0x58,
-	0x12, 0x57, 0x46, 0x62, 0x8a, 0xa4, 0x02, 0xc0, 0x1f, 0x13, 0x09, 0x97, 0x10, 0x3e, 0x77, 0xae,
-	0x24, 0x3c, 0xb3, 0xfb, 0x29, 0x94, 0xc3, 0xbe, 0x57, 0x91, 0x11, 0xa2, 0xaa, 0x05, 0xae, 0xf3,
-	0xd1, 0x65, 0xbf, 0x1d, 0x44, 0x28, 0x8d, 0x6c, 0xc2, 0xda, 0xa1, 0x69, 0xb7, 0x99, 0x95, 0x24,
-	0x14, 0x77, 0x6d, 0x98, 0x97, 0xa5, 0x15, 0x57, 0x4d, 0xf2, 0xe2, 0xa0, 0x30, 0x94, 0x07, 0x0c,
-	0x42, 0x1a, 0x57, 0x43, 0xd4, 0x3d, 0x08, 0xa3, 0x9a, 0xe2, 0x32, 0x22, 0x2c, 0xd4, 0x44, 0x15,
-	0x11, 0x9e, 0x21, 0xeb, 0x22, 0xdc, 0xce, 0xd8, 0xc0, 0xb5, 0xcc, 0x40, 0x60, 0x67, 0x77, 0x9b,
-	0xb0, 0x10, 0xe5, 0x56, 0xbe, 0x45, 0x4a, 0x8c, 0x70, 0xd5, 0x02, 0xf7, 0x08, 0xba, 0x08, 0x71,
-	0xcf, 0x0e, 0xaa, 0x9a, 0x70, 0x9a, 0xe3, 0x86, 0x88, 0xe2, 0xc1, 0x37, 0xeb, 0x30, 0x27, 0x94,
-	0x21, 0x9f, 0xc3, 0x42, 0xf4, 0x89, 0x8a, 0x60, 0x83, 0x95, 0xfe, 0x64, 0xa6, 0x6f, 0xa4, 0xb0,
-	0x22, 0xa2, 0xe8, 0xed, 0x5f, 0xfd, 0xf0, 0xaf, 0x6f, 0x8b, 0x37, 0xe8, 0xfa, 0xbe, 0xe9, 0xf6,
-	0xfd, 0xfd, 0xcb, 0xbb, 0xa6, 0xe5, 0x9e, 0x9b, 0x77, 0xf7, 0xf9, 0x9d, 0xf1, 0xef, 0x69, 0xbb,
-	0xa4, 0x0b, 0x8b, 0xca, 0x77, 0x20, 0x52, 0xe3, 0x6c, 0xb2, 0x5f, 0x9e, 0xf4, 0xcd, 0x0c, 0x5e,
-	0x0a, 0x78, 0x17, 0x05, 0x6c, 0xeb, 0x37, 0xf3, 0x04, 0xec, 0xbf, 0xe4, 0x55, 0xeb, 0xd7, 0x5c,
-	0xce, 0x07, 0x00, 0xf1, 0xa7, 0x19, 0x82, 0xda, 0x66, 0x3e, 0xf7, 0xe8, 0xb5, 0x34, 0x5a, 0x0a,
-	0x29, 0x10, 0x0b, 0x16, 0x95, 0x6f, 0x14, 0x44, 0x4f, 0x7d, 0xb4, 0x50, 0x3e, 0xaa, 0xe8, 0x37,
-	0x73, 0x69, 0x92, 0xd3, 0xdb, 0xa8, 0x6e, 0x83, 0x6c, 0xa5, 0xd4, 0xf5, 0x71, 0xab, 0xd4, 0x97,
-	0x1c, 0xc2, 0x92, 0xfa, 0x29, 0x80, 0xa0, 0xf5, 0x39, 0xdf, 0x40, 0xf4, 0x7a, 0x96, 0x10, 0xa9,
-	0xfc, 0x09, 0x2c, 0x27, 0x2e, 0x1a, 0xa9, 0x67, 0x06, 0xf0, 0x21, 0x9b, 0x1b, 0x39, 0x94, 0x88,
-	0xcf, 0xe7, 0x50, 0xcb, 0x8e, 0xae, 0xd1, 0x8b, 0xb7, 0x94, 0x43, 0xc9, 0x8e, 0x8f, 0xf5, 0xc6,
-	0x38, 0x72, 0xc4, 0xfa, 0x09, 0x54, 0xd3, 0x23, 0x5e, 0x82, 0xee, 0x1b, 0x33, 0x91, 0xd6, 0xb7,
-	0xf2, 0x89, 0x11, 0xc3, 0x7b, 0xb0, 0x10, 0x4d, 0x50, 0x45, 0xa0, 0xa6, 0x07, 0xb9, 0x22, 0x50,
-	0x33, 0x63, 0x56, 0x5a, 0x20, 0x3d, 0x58, 0x4e, 0xcc, 0x2c, 0x85, 0xbf, 0xf2, 0x06, 0xaa, 0xc2,
-	0x5f, 0xb9, 0x03, 0x4e, 0x7a, 0x07, 0x0f, 0xf8, 0xa6, 0x5e, 0x4b, 0x1f, 0xb0, 0xc8, 0xa1, 0x3c,
-	0x14, 0x8f, 0xa1, 0x92, 0x1c, 0x2f, 0x92, 0x1b, 0xa2, 0x1c, 0xce, 0x99, 0x5c, 0xea, 0x7a, 0x1e,
-	0x29, 0xd2, 0xd9, 0x83, 0xe5, 0xc4, 0x1c, 0x50, 0xea, 0x9c, 0x33, 0x5a, 0x94, 0x3a, 0xe7, 0x0d,
-	0x0d, 0xe9, 0x7b, 0xa8, 0xf3, 0xbb, 0xbb, 0x6f, 0xa7, 0x74, 0x96, 0xe3, 0x84, 0xfd, 0x97, 0xbc,
-	0x1f, 0xfc, 0x3a, 0x0c, 0xce, 0x8b, 0xc8, 0x4f, 0xe2, 0x89, 0x4b, 0xf8, 0x29, 0x31, 0x4b, 0x4c,
-	0xf8, 0x29, 0x39, 0x2f, 0xa4, 0xef, 0xa0, 0xcc, 0xdb, 0xba, 0x9e, 0x92, 0x29, 0xc6, 0x2d, 0xfb,
-	0x2f, 0x1d, 0x17, 0xaf, 0xed, 0x17, 0x00, 0xf1, 0xc0, 0x44, 0x5c, 0xdb, 0xcc, 0xcc, 0x46, 0x5c,
-	0xdb, 0xec, 0x5c, 0x85, 0x36, 0x50, 0x46, 0x9d, 0xd4, 0xf2, 0xed, 0x22, 0xdd, 0xf8, 0xc4, 0xc5,
-	0x20, 0x22, 0x71, 0xe2, 0xea, 0xe0, 0x24, 0x79, 0xe2, 0x89, 0xd1, 0x01, 0xdd, 0x46, 0x29, 0xba,
-	0xbe, 0x91, 0x3e, 0x71, 0xdc, 0xc6, 0x8d, 0xb0, 0xb0, 0xf7, 0x8e, 0x5b, 0x7a, 0x21, 0x27, 0x6f,
-	0x22, 0x20, 0xe4, 0xe4, 0xf6, 0xff, 0xe1, 0x4b, 0x47, 0x1a, 0x69, 0x39, 0xc3, 0x96, 0xfa, 0xd8,
-	0x91, 0x33, 0x98, 0x13, 0x3d, 0x3a, 0x59, 0x95, 0xcc, 0x14, 0xfe, 0x44, 0x45, 0x49, 0xc6, 0x6f,
-	0x21, 0xe3, 0x5b, 0x64, 0xd2, 0x13, 0x4a, 0xbe, 0x82, 0x45, 0xa5, 0xad, 0x15, 0xef, 0x74, 0xb6,
-	0xf5, 0x16, 0xef, 0x74, 0x4e, 0xff, 0x3b, 0xd6, 0x4b, 0x8c, 0xef, 0xc2, 0x6b, 0x71, 0x08, 0x4b,
-	0x6a, 0xdb, 0x2f, 0x1e, 0xbd, 0x9c, 0xf9, 0x80, 0x5e, 0xcf, 0x12, 0xa2, 0x0b, 0x71, 0x0c, 0x95,
-	0x64, 0xff, 0x2a, 0xee, 0x56, 0x6e, 0x73, 0x2c, 0xee, 0x56, 0x7e, 0xbb, 0x4b, 0x0b, 0x5c, 0x1f,
-	0xb5, 0xc1, 0x24, 0x6a, 0x0a, 0x4a, 0x3c, 0x4a, 0xf5, 0x2c, 0x21, 0x62, 0x72, 0x02, 0x2b, 0xa9,
-	0xe6, 0x4b, 0xe4, 0x8e, 0xfc, 0x1e, 0x52, 0xe4, 0x8e, 0x31, 0xdd, 0x9a, 0xb0, 0x2e, 0xd9, 0x02,
-	0x09, 0xeb, 0x72, 0xbb, 0x2c, 0x5d, 0xcf, 0x23, 0x45, 0xac, 0x7e, 0x8e, 0xb3, 0x97, 0x98, 0x24,
-	0x13, 0x5b, 0x43, 0xfa, 0x36, 0x4d, 0x08, 0x99, 0xde, 0x1e, 0x4b, 0x8f, 0x38, 0x3f, 0x05, 0x92,
-	0xd8, 0x20, 0x02, 0xe6, 0x56, 0xe6, 0x87, 0x89, 0xb8, 0x69, 0x8c, 0x23, 0x47, 0x6c, 0xcd, 0x28,
-	0x0d, 0xa5, 0x59, 0xdf, 0x51, 0xfc, 0x3f, 0x86, 0x3d, 0x9d, 0xb4, 0x45, 0x4d, 0x47, 0xe9, 0xce,
-	0x4a, 0xa4, 0xa3, 0x31, 0xed, 0x9f, 0x48, 0x47, 0xe3, 0x9a, 0x31, 0x5a, 0x20, 0xef, 0xc3, 0xbc,
-	0x6c, 0x80, 0x08, 0x5e, 0xbc, 0x64, 0xf7, 0xa4, 0xaf, 0x25, 0x70, 0xd1, 0xaf, 0x1e, 0xc2, 0x4a,
-	0xaa, 0xf9, 0x20, 0xb5, 0x3d, 0xf1, 0x17, 0xa6, 0xbd, 0xf0, 0x2f, 0x4c, 0x7b, 0x47, 0x03, 0x37,
-	0x18, 0x89, 0x78, 0x19, 0xd3, 0xa9, 0x60, 0xf4, 0xad, 0x66, 0x8a, 0xfd, 0xb1, 0xbc, 0x6e, 0x4d,
-	0xec, 0x0d, 0x68, 0xe1, 0x7e, 0xfd, 0xaf, 0xaf, 0x1a, 0xda, 0xf7, 0xaf, 0x1a, 0xda, 0x3f, 0x5f,
-	0x35, 0xb4, 0xdf, 0xbd, 0x6e, 0x14, 0xbe, 0x7f, 0xdd, 0x28, 0xfc, 0xed, 0x75, 0xa3, 0xd0, 0x9a,
-	0x43, 0x56, 0xff, 0xff, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x32, 0x57, 0xbf, 0xa1, 0xab, 0x25,
-	0x00, 0x00,
+	// 2676 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x3a, 0x5f, 0x6f, 0x1b, 0xc7,
+	0xf1, 0x3c, 0x52, 0x7f, 0xa8, 0x91, 0x44, 0x51, 0x2b, 0x89, 0xa2, 0xcf, 0x32, 0xad, 0x6c, 0xfe,
+	0x40, 0x10, 0x02, 0xe9, 0x17, 0xfd, 0xf2, 0x50, 0x18, 0x48, 0x90, 0x58, 0x54, 0x6c, 0x21, 0xf2,
+	0x9f, 0x9e, 0x64, 0xb7, 0x41, 0x80, 0x26, 0x47, 0x72, 0x49, 0x11, 0x3a, 0xde, 0x9d, 0xef, 0x8e,
+	0x92, 0x09, 0xc3, 0x7d, 0xe8, 0x53, 0x5f, 0x9a, 0xb6, 0x48, 0xd1, 0x7c, 0x80, 0x7e, 0x81, 0x7e,
+	0x8c, 0xf6, 0x2d, 0x40, 0x5e, 0xfa, 0x52, 0xb4, 0xb0, 0xfb, 0x41, 0x8a, 0x9d, 0xdd, 0xbb, 0xdb,
+	0xfb, 0x43, 0xa6, 0x34, 0x50, 0xa1, 0x6f, 0x3b, 0x33, 0xcb, 0x99, 0xd9, 0x99, 0xd9, 0xd9, 0x99,
+	0x39, 0x42, 0xa5, 0x33, 0x18, 0x98, 0x7e, 0xc0, 0xbc, 0x3d, 0xd7, 0x73, 0x02, 0x87, 0x14, 0xdd,
+	0x96, 0x5e, 0xe9, 0x0c, 0xae, 0x1c, 0xef, 0x22, 0xc4, 0xe9, 0x5b, 0x3d, 0xc7, 0xe9, 0x59, 0x6c,
+	0xdf, 0x74, 0xfb, 0xfb, 0xa6, 0x6d, 0x3b, 0x81, 0x19, 0xf4, 0x1d, 0xdb, 0x97, 0xd4, 0x9b, 0x92,
+	0x8a, 0x50, 0x6b, 0xd8, 0xdd, 0x67, 0x03, 0x37, 0x18, 0x09, 0x22, 0xfd, 0x25, 0x54, 0x4f, 0x03,
+	0xd3, 0x0b, 0xce, 0x4c, 0xff, 0xc2, 0x60, 0xcf, 0x86, 0xcc, 0x0f, 0x08, 0x81, 0x99, 0xc0, 0xf4,
+	0x2f, 0xea, 0xda, 0xb6, 0xb6, 0xb3, 0x60, 0xe0, 0x9a, 0xd4, 0x61, 0xde, 0x77, 0x86, 0x5e, 0x9b,
+	0xf9, 0xf5, 0xe2, 0x76, 0x69, 0x67, 0xc1, 0x08, 0x41, 0xd2, 0x00, 0xf0, 0xd8, 0xc0, 0xb9, 0x64,
+	0x0f, 0x58, 0x60, 0xd6, 0x4b, 0xdb, 0xda, 0x4e, 0xd9, 0x50, 0x30, 0x64, 0x0b, 0x16, 0x7c, 0x94,
+	0xd0, 0x1f, 0xb0, 0xfa, 0x0c, 0xb2, 0x8c, 0x11, 0xf4, 0x5b, 0x0d, 0x56, 0x15, 0x05, 0x7c, 0xd7,
+	0xb1, 0x7d, 0x46, 0x6a, 0x30, 0xe7, 0x31, 0x7f, 0x68, 0x05, 0xa8, 0x43, 0xd9, 0x90, 0x10, 0xa9,
+	0x42, 0x69, 0xe0, 0xf7, 0xea, 0x45, 0xe4, 0xc2, 0x97, 0xe4, 0x20, 0xd6, 0xab, 0xb4, 0x5d, 0xda,
+	0x59, 0x3c, 0xa8, 0xef, 0xb9, 0xad, 0xbd, 0x43, 0x67, 0x30, 0x70, 0xec, 0x9f, 0xa1, 0x8d, 0x42,
+	0xa6, 0xb1, 0xc6, 0xdb, 0xb0, 0xd8, 0x3e, 0x67, 0x6d, 0x2e, 0x8e, 0x8b, 0x10, 0x3a, 0xa9, 0x28,
+	0xfa, 0x0b, 0x20, 0x8f, 0x5c, 0xe6, 0x99, 0x01, 0x53, 0xed, 0xa2, 0x43, 0xd1, 0x71, 0x51, 0xa3,
+	0xca, 0x01, 0x70, 0x31, 0x9c, 0xf8, 0xc8, 0x35, 0x8a, 0x8e, 0xcb, 0x6d, 0x66, 0x9b, 0x03, 0x26,
+	0x55, 0xc3, 0xb5, 0x6a, 0xb3, 0x52, 0xc2, 0x66, 0xf4, 0xb7, 0x1a, 0xac, 0x25, 0x04, 0xc8, 0x73,
+	0x4f, 0x92, 0x10, 0xdb, 0xa4, 0x98, 0x67, 0x93, 0x52, 0xae, 0x4d, 0x66, 0xfe, 0x43, 0x9b, 0xd0,
+	0x4f, 0x61, 0xf5, 0x89, 0xdb, 0x49, 0x1d, 0x78, 0xaa, 0x40, 0xa0, 0x7f, 0xd0, 0x80, 0xa8, 0x3c,
+	0xfe, 0x47, 0x7c, 0xf9, 0x19, 0xd4, 0x7e, 0x3a, 0x64, 0xde, 0xe8, 0x34, 0x30, 0x83, 0xa1, 0x7f,
+	0xd2, 0xf7, 0x03, 0xe5, 0x78, 0xe8, 0x33, 0x2d, 0xdf, 0x67, 0xa9, 0xe3, 0x5d, 0xc2, 0x66, 0x86,
+	0xcf, 0xd4, 0x47, 0xfc, 0x20, 0x7d, 0xc4, 0x4d, 0x7e, 0x44, 0x85, 0x6f, 0xd6, 0x33, 0x87, 0xb0,
+	0x76, 0x7a, 0xee, 0x5c, 0x35, 0x9b, 0x27, 0x27, 0x4e, 0xfb, 0xc2, 0x7f, 0x33, 0xdf, 0xfc, 0x55,
+	0x83, 0x79, 0xc9, 0x81, 0x54, 0xa0, 0x78, 0xdc, 0x94, 0xbf, 0x2b, 0x1e, 0x37, 0x23, 0x4e, 0x45,
+	0x85, 0x13, 0x81, 0x99, 0x81, 0xd3, 0x61, 0x32, 0xaa, 0x70, 0x4d, 0xd6, 0x61, 0xd6, 0xb9, 0xb2,
+	0x99, 0x27, 0x8d, 0x2c, 0x00, 0xbe, 0xb3, 0xd9, 0x3c, 0xf1, 0xeb, 0xb3, 0x28, 0x10, 0xd7, 0xdc,
+	0x1e, 0xfe, 0xc8, 0x6e, 0xb3, 0x4e, 0x7d, 0x0e, 0xb1, 0x12, 0x22, 0x3a, 0x94, 0x87, 0xb6, 0xa4,
+	0xcc, 0x23, 0x25, 0x82, 0xc9, 0x0e, 0xac, 0x58, 0x4e, 0xfb, 0xe2, 0xb1, 0x63, 0xf5, 0xdb, 0x68,
+	0x0b, 0x56, 0x2f, 0xa3, 0x9c, 0x34, 0x9a, 0xb6, 0x61, 0x3d, 0x69, 0x90, 0xa9, 0xbd, 0xf0, 0x16,
+	0xcc, 0x72, 0xa6, 0xa1, 0x0f, 0x16, 0xb9, 0x0f, 0x24, 0x3b, 0x43, 0x50, 0xe8, 0xdf, 0x35, 0x58,
+	0x7f, 0x62, 0xf3, 0x75, 0x48, 0x90, 0x76, 0x4f, 0x5b, 0x8f, 0xc2, 0x92, 0xc7, 0x5c, 0xcb, 0x6c,
+	0xb3, 0x47, 0x68, 0x1c, 0x21, 0x26, 0x81, 0xe3, 0x41, 0xda, 0x75, 0xbc, 0x36, 0x33, 0x30, 0x2b,
+	0xca, 0x1c, 0xa9, 0xa2, 0xc8, 0xdb, 0x78, 0xf1, 0x67, 0xf0, 0xe2, 0xaf, 0x71, 0x75, 0x12, 0xb2,
+	0x65, 0x06, 0x50, 0xdc, 0x3b, 0x9b, 0xcc, 0xc1, 0x3a, 0x94, 0x3b, 0x66, 0x60, 0xb6, 0x4c, 0x9f,
+	0xd5, 0xe7, 0x50, 0x81, 0x08, 0xe6, 0x6e, 0x0b, 0xcc, 0x96, 0xc5, 0xea, 0xf3, 0xc2, 0x6d, 0x08,
+	0xd0, 0x4f, 0x61, 0x23, 0x75, 0xbc, 0x69, 0xad, 0x48, 0x0d, 0xb8, 0x21, 0x73, 0x58, 0x78, 0x39,
+	0x2d, 0x73, 0x14, 0x9a, 0xe9, 0xa6, 0x92, 0xc9, 0xd0, 0xbe, 0x48, 0xcd, 0x1e, 0x24, 0x15, 0xa7,
+	0xdf, 0x69, 0xa0, 0xe7, 0x31, 0x95, 0xca, 0x4d, 0xe4, 0xfa, 0xdf, 0x4d, 0x90, 0xdf, 0x69, 0xb0,
+	0xf9, 0x78, 0xe8, 0xf5, 0xf2, 0x0e, 0xab, 0x9c, 0x47, 0xcb, 0x38, 0xa6, 0x6f, 0x9b, 0xed, 0xa0,
+	0x7f, 0xc9, 0xa4, 0x56, 0x11, 0x8c, 0xf7, 0x8e, 0xbf, 0x89, 0x5c, 0xb1, 0x92, 0x81, 0x6b, 0xbe,
+	0xbf, 0xdb, 0xb7, 0x18, 0xa6, 0x25, 0x71, 0xcd, 0x22, 0x18, 0x6f, 0xd5, 0xb0, 0xd5, 0xec, 0x7b,
+	0xf5, 0x59, 0xa4, 0x48, 0x88, 0x3e, 0x87, 0x7a, 0x56, 0xb1, 0xeb, 0x48, 0xbe, 0xf4, 0x12, 0xaa,
+	0x87, 0x3c, 0xd3, 0xfe, 0xd8, 0x9b, 0x51, 0x83, 0x39, 0xe6, 0x79, 0x87, 0xb6, 0xf0, 0x4c, 0xc9,
+	0x90, 0x10, 0xb7, 0xdb, 0x95, 0xe9, 0xd9, 0x9c, 0x20, 0x8c, 0x10, 0x82, 0x3f, 0x52, 0x34, 0x7c,
+	0x04, 0xab, 0x8a, 0xdc, 0xa9, 0x03, 0xf7, 0xd7, 0x1a, 0xac, 0xcb, 0x20, 0x3b, 0xc5, 0x93, 0x84,
+	0xba, 0x6f, 0x29, 0xe1, 0xb5, 0xc4, 0x8f, 0x2f, 0xc8, 0x71, 0x7c, 0xb5, 0x1d, 0xbb, 0xdb, 0xef,
+	0xc9, 0xa0, 0x95, 0x10, 0xf7, 0x99, 0x30, 0xc8, 0x71, 0x53, 0xbe, 0xf3, 0x11, 0xcc, 0x8b, 0x23,
+	0x51, 0xa9, 0x3d, 0x8c, 0x3d, 0xaa, 0x60, 0xe8, 0x10, 0x36, 0x52, 0x9a, 0x5c, 0x8b, 0xe3, 0x8e,
+	0x60, 0xc3, 0x60, 0xbd, 0x3e, 0x2f, 0x2b, 0xc3, 0x2d, 0x13, 0x9f, 0x44, 0xb3, 0xd3, 0xf1, 0x98,
+	0xef, 0x4b, 0xb1, 0x21, 0x48, 0xbf, 0x86, 0x5a, 0x9a, 0xcd, 0xd4, 0xea, 0x73, 0x4f, 0xb3, 0xb6,
+	0xc7, 0x82, 0xcf, 0xd9, 0x08, 0xa3, 0x60, 0xc9, 0x88, 0x11, 0xf4, 0x63, 0x58, 0x7f, 0xd4, 0xed,
+	0x5a, 0x7d, 0x9b, 0x3d, 0x60, 0x83, 0x56, 0x42, 0xcf, 0x60, 0xe4, 0x46, 0x7a, 0xf2, 0x75, 0x5e,
+	0x09, 0xc6, 0xd3, 0x5c, 0xea, 0xf7, 0x53, 0x47, 0xcb, 0x87, 0x51, 0xb0, 0x9c, 0x30, 0xb3, 0x13,
+	0xab, 0x90, 0x09, 0x16, 0x41, 0x16, 0xc1, 0x82, 0x82, 0x93, 0xbf, 0x9a, 0x5a, 0xf0, 0x37, 0x1a,
+	0xc0, 0x03, 0x2c, 0xfd, 0x8f, 0xed, 0xae, 0x93, 0xeb, 0x1a, 0x1d, 0xca, 0x03, 0x3c, 0xd7, 0x71,
+	0x13, 0x7f, 0x39, 0x63, 0x44, 0x30, 0xcf, 0xfb, 0xa6, 0xd5, 0x8f, 0x9e, 0x1b, 0x01, 0xf0, 0x5f,
+	0xb8, 0x8c, 0x79, 0x4f, 0x8c, 0x13, 0x91, 0xfb, 0x16, 0x8c, 0x08, 0xe6, 0xc1, 0xda, 0xb6, 0xfa,
+	0xcc, 0x0e, 0x90, 0x2a, 0x9e, 0x18, 0x05, 0x43, 0x5b, 0x00, 0xc2, 0xcd, 0x63, 0xf5, 0x21, 0x30,
+	0xc3, 0x63, 0x23, 0x74, 0x01, 0x5f, 0x73, 0x3d, 0xfc, 0xc0, 0xec, 0x85, 0xb5, 0x84, 0x00, 0x30,
+	0x99, 0x61, 0x30, 0xca, 0x4b, 0x21, 0x21, 0x7a, 0x02, 0x55, 0x5e, 0x5a, 0x09, 0xa3, 0x09, 0x9f,
+	0x85, 0xa6, 0xd1, 0xe2, 0xa0, 0xc9, 0xab, 0xb6, 0x43, 0xd9, 0xa5, 0x58, 0x36, 0x7d, 0x28, 0xb8,
+	0x09, 0x2b, 0x8e, 0xe5, 0xb6, 0x03, 0xf3, 0xa2, 0xc5, 0x12, 0xcf, 0xd1, 0xe2, 0x41, 0x85, 0xbb,
+	0x33, 0x36, 0xbd, 0x11, 0x92, 0x43, 0x7e, 0xc2, 0x0a, 0x93, 0xf8, 0x89, 0x2b, 0x9e, 0xe0, 0x17,
+	0x9b, 0xce, 0x08, 0xc9, 0xf4, 0x4f, 0x1a, 0xcc, 0x0b, 0x36, 0x3e, 0xd9, 0x83, 0x39, 0x0b, 0x4f,
+	0x8d, 0xac, 0x16, 0x0f, 0xd6, 0x31, 0xa6, 0x52, 0xb6, 0xb8, 0x5f, 0x30, 0xe4, 0x2e, 0xbe, 0x5f,
+	0xa8, 0x85, 0x56, 0x50, 0xf6, 0xab, 0xa7, 0xe5, 0xfb, 0xc5, 0x2e, 0xbe, 0x5f, 0x88, 0x45, 0x0b,
+	0x29, 0xfb, 0xd5, 0xd3, 0xf0, 0xfd, 0x62, 0xd7, 0xdd, 0x32, 0xcc, 0x89, 0x58, 0xa2, 0xcf, 0x60,
+	0x15, 0xf9, 0x26, 0x6e, 0x60, 0x2d, 0xa1, 0x6e, 0x39, 0x52, 0xab, 0x96, 0x50, 0xab, 0x1c, 0x89,
+	0xaf, 0x25, 0xc4, 0x97, 0x43, 0x31, 0x3c, 0x3c, 0xb8, 0xfb, 0xc2, 0x68, 0x14, 0x00, 0x65, 0x40,
+	0x54, 0x91, 0x53, 0x67, 0x95, 0x77, 0x61, 0x5e, 0x28, 0x9f, 0xa8, 0xf1, 0xa4, 0xa9, 0x8d, 0x90,
+	0x46, 0xff, 0x58, 0x8c, 0x5f, 0x82, 0xf6, 0x39, 0x1b, 0x98, 0xe3, 0x5f, 0x02, 0x24, 0xc7, 0xcd,
+	0x5e, 0xa6, 0x62, 0x1e, 0xdb, 0xec, 0x25, 0x8a, 0xb3, 0x99, 0x71, 0xc5, 0xd9, 0xac, 0x52, 0x9c,
+	0xe1, 0xe5, 0x40, 0x79, 0xb2, 0x98, 0x93, 0x10, 0xdf, 0xdd, 0xb5, 0x86, 0xfe, 0x39, 0x96, 0x72,
+	0x65, 0x43, 0x00, 0x5c, 0x1b, 0x5e, 0x43, 0x63, 0xb9, 0x5c, 0x36, 0x70, 0xcd, 0xaf, 0x72, 0xd7,
+	0x73, 0x06, 0xe2, 0x51, 0xa9, 0x2f, 0x88, 0xa6, 0x3c, 0xc6, 0x84, 0xf4, 0x33, 0xd3, 0xeb, 0xb1,
+	0xa0, 0x0e, 0x31, 0x5d, 0x60, 0xd4, 0x77, 0x49, 0xda, 0xe5, 0x5a, 0xde, 0xa5, 0x5d, 0x58, 0xbf,
+	0xc7, 0x82, 0xd3, 0x61, 0x8b, 0xbf, 0xec, 0x87, 0xdd, 0xde, 0x84, 0x67, 0x89, 0x3e, 0x81, 0x8d,
+	0xd4, 0xde, 0xa9, 0x55, 0x24, 0x30, 0xd3, 0xee, 0xf6, 0x42, 0x87, 0xe1, 0x9a, 0x36, 0x61, 0xf9,
+	0x1e, 0x0b, 0x14, 0xd9, 0xb7, 0x95, 0xa7, 0x46, 0x56, 0x9d, 0x87, 0xdd, 0xde, 0xd9, 0xc8, 0x65,
+	0x13, 0xde, 0x9d, 0x13, 0xa8, 0x84, 0x5c, 0xa6, 0xd6, 0xaa, 0x0a, 0xa5, 0x76, 0x37, 0xaa, 0x57,
+	0xdb, 0xdd, 0x1e, 0xdd, 0x80, 0xb5, 0x7b, 0x4c, 0xde, 0xeb, 0x58, 0x33, 0xba, 0x83, 0xd6, 0x52,
+	0xd0, 0x52, 0x94, 0x64, 0xa0, 0xc5, 0x0c, 0x7e, 0xaf, 0x01, 0xb9, 0x6f, 0xda, 0x1d, 0x8b, 0x1d,
+	0x79, 0x9e, 0xe3, 0x8d, 0x2d, 0xd2, 0x91, 0xfa, 0x46, 0x41, 0xbe, 0x05, 0x0b, 0xad, 0xbe, 0x6d,
+	0x39, 0xbd, 0xc7, 0x8e, 0x1f, 0x16, 0x6c, 0x11, 0x02, 0x43, 0xf4, 0x99, 0x15, 0x35, 0x89, 0x7c,
+	0x4d, 0x7d, 0x58, 0x4b, 0xa8, 0x74, 0x2d, 0x01, 0x76, 0x0f, 0x36, 0xce, 0x3c, 0xd3, 0xf6, 0xbb,
+	0xcc, 0x4b, 0x96, 0x7e, 0xf1, 0x7b, 0xa4, 0xa9, 0xef, 0x91, 0x92, 0xb6, 0x84, 0x64, 0x09, 0xd1,
+	0xbb, 0x50, 0x4b, 0x33, 0x9a, 0xfa, 0x81, 0xef, 0x44, 0x43, 0xa0, 0x44, 0x37, 0x71, 0x4b, 0xf1,
+	0xca, 0xb2, 0xd2, 0xe4, 0x3c, 0x3d, 0x08, 0xcb, 0x50, 0xa9, 0x69, 0x71, 0x8c, 0xa6, 0xc2, 0x35,
+	0xa1, 0xa6, 0x41, 0x94, 0xe2, 0xae, 0xb3, 0x35, 0xf8, 0xb3, 0x06, 0x35, 0x9c, 0xeb, 0x3d, 0x35,
+	0xad, 0x7e, 0x07, 0xe7, 0x91, 0xf1, 0x85, 0x82, 0x81, 0xd3, 0x61, 0x5f, 0x5d, 0x9a, 0xd6, 0x50,
+	0x9a, 0xfb, 0x7e, 0xc1, 0x58, 0xe0, 0xb8, 0xa7, 0x1c, 0x45, 0x76, 0xa1, 0x8a, 0xb5, 0xfe, 0x57,
+	0xbc, 0x25, 0x92, 0xdb, 0x50, 0x9d, 0xfb, 0x9a, 0x51, 0x89, 0xba, 0x00, 0xb1, 0x77, 0x62, 0xda,
+	0xe5, 0x31, 0xab, 0x14, 0xde, 0x11, 0x7c, 0x77, 0x4e, 0x8c, 0x37, 0xee, 0x2e, 0x2a, 0x6d, 0x06,
+	0xbd, 0x82, 0xcd, 0x8c, 0xc6, 0xd7, 0x62, 0xab, 0x07, 0xb0, 0x71, 0x1a, 0x38, 0x6e, 0xd6, 0x52,
+	0x13, 0xfb, 0xca, 0xe8, 0x70, 0xc5, 0xe4, 0xe1, 0xe8, 0x25, 0xb7, 0x7c, 0x92, 0xdd, 0xb5, 0x1c,
+	0xe3, 0x37, 0x1a, 0x6c, 0x8a, 0xf9, 0x5f, 0xf6, 0x24, 0xaa, 0xbe, 0x5a, 0x52, 0xdf, 0x09, 0xa3,
+	0xe5, 0x44, 0x52, 0x29, 0xa5, 0x93, 0x4a, 0x03, 0x40, 0x00, 0xf7, 0xce, 0x8e, 0x9b, 0x61, 0x6f,
+	0x15, 0x63, 0x78, 0x5f, 0x9c, 0x55, 0xe7, 0x5a, 0x2c, 0xb1, 0x07, 0x95, 0x23, 0xbb, 0xed, 0x8d,
+	0xdc, 0x20, 0xae, 0x27, 0x16, 0x5c, 0xcb, 0xec, 0xdb, 0x01, 0x7b, 0x1e, 0x48, 0x03, 0xc4, 0x08,
+	0xfa, 0x25, 0xac, 0x44, 0xfb, 0xa7, 0x56, 0x90, 0x57, 0xed, 0x7d, 0xf7, 0x9c, 0x79, 0xc8, 0x5b,
+	0x58, 0x49, 0xc1, 0xd0, 0x1f, 0x34, 0xd8, 0xe4, 0xb5, 0x14, 0x3e, 0x93, 0xd8, 0xb1, 0xbe, 0xc9,
+	0xc8, 0xec, 0x21, 0x2c, 0x06, 0x31, 0x03, 0x69, 0x8a, 0xf7, 0xc3, 0x12, 0x32, 0x87, 0xf7, 0x9e,
+	0x82, 0x3b, 0xb2, 0x03, 0x6f, 0x64, 0xa8, 0x0c, 0xf4, 0x8f, 0xa1, 0x9a, 0xde, 0xc0, 0xa5, 0x5e,
+	0xb0, 0x51, 0xf8, 0x6e, 0x5d, 0xb0, 0x11, 0x2f, 0x78, 0x94, 0xeb, 0x6f, 0x08, 0xe0, 0x4e, 0xf1,
+	0x27, 0x1a, 0xfd, 0x87, 0x06, 0x37, 0xb8, 0x64, 0x91, 0x7c, 0xdf, 0xfc, 0x5c, 0x4f, 0x61, 0xd9,
+	0x57, 0x59, 0xc8, 0x93, 0xfd, 0x5f, 0x78, 0xb2, 0x5c, 0xfe, 0x7b, 0x09, 0xac, 0x38, 0x5d, 0x92,
+	0x8d, 0xfe, 0x09, 0x90, 0xec, 0xa6, 0x69, 0x4e, 0xb8, 0xfb, 0x09, 0xac, 0xa4, 0x86, 0x80, 0x64,
+	0x15, 0x96, 0x8f, 0xed, 0x4b, 0x1e, 0xcd, 0x02, 0x51, 0x2d, 0x90, 0x25, 0x28, 0x9f, 0x5e, 0xf4,
+	0x5d, 0x0e, 0x57, 0x35, 0x0e, 0x1d, 0x3d, 0x67, 0x6d, 0x84, 0x8a, 0xbb, 0x2d, 0x28, 0x87, 0x03,
+	0x0c, 0xb2, 0x06, 0x2b, 0xf2, 0xa7, 0x21, 0xaa, 0x5a, 0x20, 0x2b, 0xb0, 0x88, 0x19, 0x4f, 0xa0,
+	0xaa, 0x1a, 0xa9, 0xc2, 0x92, 0xb8, 0x32, 0x12, 0x53, 0x24, 0x15, 0x00, 0x9e, 0x4c, 0x24, 0x5c,
+	0x42, 0xf8, 0xdc, 0xb9, 0x92, 0xf0, 0xcc, 0xee, 0xe7, 0x50, 0x0e, 0xfb, 0x5e, 0x45, 0x46, 0x88,
+	0xaa, 0x16, 0xb8, 0xce, 0x47, 0x97, 0xfd, 0x76, 0x10, 0xa1, 0x34, 0xb2, 0x09, 0x6b, 0x87, 0xa6,
+	0xdd, 0x66, 0x56, 0x92, 0x50, 0xdc, 0xb5, 0x61, 0x5e, 0x96, 0x56, 0x5c, 0x35, 0xc9, 0x8b, 0x83,
+	0xe2, 0xa0, 0x3c, 0x60, 0x10, 0xd2, 0xb8, 0x1a, 0xa2, 0xee, 0x41, 0x18, 0xd5, 0x14, 0x97, 0x11,
+	0x61, 0xa1, 0x26, 0xaa, 0x88, 0xf0, 0x0c, 0x59, 0x17, 0xe1, 0x76, 0xc6, 0x06, 0xae, 0x65, 0x06,
+	0x02, 0x3b, 0xbb, 0xdb, 0x84, 0x85, 0xe8, 0x6d, 0xe5, 0x5b, 0xa4, 0xc4, 0x08, 0x57, 0x2d, 0x70,
+	0x8b, 0xa0, 0x89, 0x10, 0xf7, 0xf4, 0xa0, 0xaa, 0x09, 0xa3, 0x39, 0x6e, 0x88, 0x28, 0x1e, 0x7c,
+	0xb3, 0x0e, 0x73, 0x42, 0x19, 0xf2, 0x05, 0x2c, 0x44, 0x1f, 0xb3, 0x08, 0x36, 0x58, 0xe9, 0x8f,
+	0x6b, 0xfa, 0x46, 0x0a, 0x2b, 0x22, 0x8a, 0xde, 0xfe, 0xd5, 0x0f, 0xff, 0xfa, 0xb6, 0x78, 0xe3,
+	0x8e, 0xb6, 0x4b, 0xd7, 0xf7, 0x4d, 0xb7, 0xef, 0xef, 0x5f, 0x7e, 0x60, 0x5a, 0xee, 0xb9, 0xf9,
+	0xc1, 0x3e, 0xbf, 0x36, 0x3e, 0xe9, 0xc2, 0xa2, 0xf2, 0xc5, 0x88, 0xd4, 0x38, 0x9b, 0xec, 0x37,
+	0x2a, 0x7d, 0x33, 0x83, 0x97, 0x02, 0xde, 0x43, 0x01, 0xdb, 0x77, 0xb4, 0x5d, 0xfd, 0x66, 0x9e,
+	0x80, 0xfd, 0x17, 0xbc, 0x70, 0x7d, 0x49, 0x3e, 0x02, 0x88, 0x3f, 0xe2, 0x10, 0xd4, 0x36, 0xf3,
+	0x61, 0x48, 0xaf, 0xa5, 0xd1, 0x52, 0x48, 0x81, 0x58, 0xb0, 0xa8, 0x7c, 0xcd, 0x20, 0x7a, 0xea,
+	0xf3, 0x86, 0xf2, 0xf9, 0x45, 0xbf, 0x99, 0x4b, 0x93, 0x9c, 0xde, 0x41, 0x75, 0x1b, 0x64, 0x2b,
+	0xa5, 0xab, 0x8f, 0x5b, 0x43, 0x65, 0x0f, 0x61, 0x49, 0xfd, 0x14, 0x40, 0xf0, 0xf4, 0x39, 0x5f,
+	0x4b, 0xf4, 0x7a, 0x96, 0x10, 0xa9, 0xfc, 0x19, 0x2c, 0x27, 0x2e, 0x1a, 0xa9, 0x67, 0x06, 0xf0,
+	0x21, 0x9b, 0x1b, 0x39, 0x94, 0x88, 0xcf, 0x17, 0x50, 0xcb, 0x8e, 0xae, 0xd1, 0x8a, 0xb7, 0x14,
+	0xa7, 0x64, 0xc7, 0xc7, 0x7a, 0x63, 0x1c, 0x39, 0x62, 0xfd, 0x08, 0xaa, 0xe9, 0x11, 0x2f, 0x41,
+	0xf3, 0x8d, 0x99, 0x48, 0xeb, 0x5b, 0xf9, 0xc4, 0x88, 0xe1, 0x1d, 0x58, 0x88, 0x26, 0xa8, 0x22,
+	0x50, 0xd3, 0x83, 0x5c, 0x11, 0xa8, 0x99, 0x31, 0x2b, 0x2d, 0x90, 0x1e, 0x2c, 0x27, 0x66, 0x96,
+	0xc2, 0x5e, 0x79, 0x03, 0x55, 0x61, 0xaf, 0xdc, 0x01, 0x27, 0x7d, 0x0b, 0x1d, 0x7c, 0x93, 0xc7,
+	0x63, 0x2d, 0xed, 0x63, 0xf9, 0xfc, 0x1f, 0x43, 0x25, 0x39, 0x5e, 0x24, 0x37, 0x44, 0x39, 0x9c,
+	0x33, 0xb9, 0xd4, 0xf5, 0x3c, 0x52, 0xa4, 0xb3, 0x07, 0xcb, 0x89, 0x39, 0xa0, 0xd4, 0x39, 0x67,
+	0xb4, 0x28, 0x75, 0xce, 0x1b, 0x1a, 0xd2, 0xf7, 0x51, 0xe7, 0xf7, 0x76, 0xdf, 0x49, 0x29, 0x2c,
+	0xc7, 0x09, 0xfb, 0x2f, 0x78, 0x3f, 0xf8, 0x32, 0x0c, 0xce, 0x8b, 0xc8, 0x4e, 0x22, 0xc5, 0x25,
+	0xec, 0x94, 0x98, 0x25, 0x26, 0xec, 0x94, 0x9c, 0x17, 0xd2, 0x77, 0x51, 0xe6, 0x6d, 0x6e, 0x27,
+	0x3d, 0x25, 0x56, 0x4c, 0x5c, 0xf6, 0x5f, 0x38, 0xee, 0x4b, 0xf2, 0x25, 0x40, 0x3c, 0x30, 0x11,
+	0xd7, 0x36, 0x33, 0xb3, 0x11, 0xd7, 0x36, 0x3b, 0x57, 0xa1, 0x0d, 0x94, 0x51, 0x27, 0xb5, 0xfc,
+	0x73, 0x91, 0x6e, 0xec, 0x71, 0x31, 0x88, 0x48, 0x78, 0x5c, 0x1d, 0x9c, 0x24, 0x3d, 0x9e, 0x18,
+	0x1d, 0xd0, 0x6d, 0x94, 0xa2, 0xf3, 0x93, 0x6c, 0xa4, 0x3d, 0x2e, 0xd8, 0x5a, 0xd8, 0x7b, 0xc7,
+	0x2d, 0xbd, 0x90, 0x93, 0x37, 0x11, 0x10, 0x72, 0x72, 0xfb, 0xff, 0x30, 0xd3, 0x91, 0x46, 0x5a,
+	0xc8, 0xb0, 0x95, 0xc8, 0x74, 0x67, 0x30, 0x27, 0x7a, 0x74, 0xb2, 0x2a, 0x99, 0x29, 0xfc, 0x89,
+	0x8a, 0x92, 0x8c, 0xdf, 0x46, 0xc6, 0xb7, 0xc8, 0xc4, 0xfc, 0xf9, 0x35, 0x2c, 0x2a, 0x6d, 0xad,
+	0xc8, 0xd3, 0xd9, 0xd6, 0x5b, 0xe4, 0xe9, 0x9c, 0xfe, 0x77, 0x92, 0x95, 0x18, 0xdf, 0xe8, 0xf3,
+	0xa4, 0xa7, 0xb6, 0xfd, 0x22, 0xe9, 0xe5, 0xcc, 0x07, 0xf4, 0x7a, 0x96, 0x10, 0x5d, 0x88, 0x63,
+	0xa8, 0x24, 0xfb, 0x57, 0x71, 0xb7, 0x72, 0x9b, 0x63, 0x71, 0xb7, 0xf2, 0xdb, 0x5d, 0x5a, 0xe0,
+	0xfa, 0xa8, 0x0d, 0x26, 0x51, 0x9f, 0xa0, 0x44, 0x52, 0xaa, 0x67, 0x09, 0x11, 0x93, 0x13, 0x58,
+	0x49, 0x35, 0x5f, 0xe2, 0xed, 0xc8, 0xef, 0x21, 0xc5, 0xdb, 0x31, 0xa6, 0x5b, 0x13, 0xa7, 0x4b,
+	0xb6, 0x40, 0xe2, 0x74, 0xb9, 0x5d, 0x96, 0xae, 0xe7, 0x91, 0x22, 0x56, 0x3f, 0xc7, 0xd9, 0x4b,
+	0x4c, 0x92, 0x0f, 0x5b, 0x43, 0xda, 0x36, 0x4d, 0x08, 0x99, 0xde, 0x1e, 0x4b, 0x8f, 0x38, 0x3f,
+	0x01, 0x92, 0xd8, 0x20, 0x02, 0xe6, 0x56, 0xe6, 0x87, 0x89, 0xb8, 0x69, 0x8c, 0x23, 0x47, 0x6c,
+	0xcd, 0xe8, 0x19, 0x4a, 0xb3, 0x7e, 0x4b, 0xb1, 0xff, 0x18, 0xf6, 0x74, 0xd2, 0x16, 0xf5, 0x39,
+	0x4a, 0x77, 0x56, 0xe2, 0x39, 0x1a, 0xd3, 0xfe, 0x89, 0xe7, 0x68, 0x5c, 0x33, 0x46, 0x0b, 0xe4,
+	0x43, 0x98, 0x97, 0x0d, 0x10, 0xc1, 0x8b, 0x97, 0xec, 0x9e, 0xf4, 0xb5, 0x04, 0x2e, 0xfa, 0xd5,
+	0x7d, 0x58, 0x49, 0x35, 0x1f, 0xa4, 0xb6, 0x27, 0xfe, 0xec, 0xb4, 0x17, 0xfe, 0xd9, 0x69, 0xef,
+	0x68, 0xe0, 0x06, 0x23, 0x11, 0x2f, 0x63, 0x3a, 0x15, 0x8c, 0xbe, 0xd5, 0x4c, 0xb1, 0x3f, 0x96,
+	0xd7, 0xad, 0x89, 0xbd, 0x01, 0x2d, 0xdc, 0xad, 0xff, 0xe5, 0x55, 0x43, 0xfb, 0xfe, 0x55, 0x43,
+	0xfb, 0xe7, 0xab, 0x86, 0xf6, 0xbb, 0xd7, 0x8d, 0xc2, 0xf7, 0xaf, 0x1b, 0x85, 0xbf, 0xbd, 0x6e,
+	0x14, 0x5a, 0x73, 0xc8, 0xea, 0xff, 0xff, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xf0, 0x63, 0x7a, 0x08,
+	0xd5, 0x25, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -5754,6 +5763,13 @@ func (m *DDLLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LockPolicyState) > 0 {
+		i -= len(m.LockPolicyState)
+		copy(dAtA[i:], m.LockPolicyState)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.LockPolicyState)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.Unsynced) > 0 {
 		for iNdEx := len(m.Unsynced) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Unsynced[iNdEx])
@@ -8523,6 +8539,10 @@ func (m *DDLLock) Size() (n int) {
 			n += 1 + l + sovDmmaster(uint64(l))
 		}
 	}
+	l = len(m.LockPolicyState)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
 	return n
 }
 
@@ -11148,6 +11168,38 @@ func (m *DDLLock) Unmarshal(dAtA []byte) error {
 			}
 			m.Unsynced = append(m.Unsynced, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockPolicyState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockPolicyState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmmaster(dAtA[iNdEx:])
@@ -866,11 +866,12 @@ func (m *LoadStatus) GetBps() int64 {
 // synced: synced source tables
 // unsynced: unsynced source tables
 type ShardingGroup struct {
-	Target        string   `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
-	DDLs          []string `protobuf:"bytes,2,rep,name=DDLs,proto3" json:"DDLs,omitempty"`
-	FirstLocation string   `protobuf:"bytes,3,opt,name=firstLocation,proto3" json:"firstLocation,omitempty"`
-	Synced        []string `protobuf:"bytes,4,rep,name=synced,proto3" json:"synced,omitempty"`
-	Unsynced      []string `protobuf:"bytes,5,rep,name=unsynced,proto3" json:"unsynced,omitempty"`
+	Target          string   `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	DDLs            []string `protobuf:"bytes,2,rep,name=DDLs,proto3" json:"DDLs,omitempty"`
+	FirstLocation   string   `protobuf:"bytes,3,opt,name=firstLocation,proto3" json:"firstLocation,omitempty"`
+	Synced          []string `protobuf:"bytes,4,rep,name=synced,proto3" json:"synced,omitempty"`
+	Unsynced        []string `protobuf:"bytes,5,rep,name=unsynced,proto3" json:"unsynced,omitempty"`
+	LockPolicyState string   `protobuf:"bytes,6,opt,name=lockPolicyState,proto3" json:"lockPolicyState,omitempty"`
 }
 
 func (m *ShardingGroup) Reset()         { *m = ShardingGroup{} }
@@ -941,6 +942,159 @@ func (m *ShardingGroup) GetUnsynced() []string {
 	return nil
 }
 
+func (m *ShardingGroup) GetLockPolicyState() string {
+	if m != nil {
+		return m.LockPolicyState
+	}
+	return ""
+}
+
+// ShardDMLActivity represents the DML activity of a sharding group in pessimistic shard mode
+type ShardDMLActivity struct {
+	Target    string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	LastDMLTs int64  `protobuf:"varint,2,opt,name=lastDMLTs,proto3" json:"lastDMLTs,omitempty"`
+	SinceTs   int64  `protobuf:"varint,3,opt,name=sinceTs,proto3" json:"sinceTs,omitempty"`
+}
+
+func (m *ShardDMLActivity) Reset()         { *m = ShardDMLActivity{} }
+func (m *ShardDMLActivity) String() string { return proto.CompactTextString(m) }
+func (*ShardDMLActivity) ProtoMessage()    {}
+func (*ShardDMLActivity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{7}
+}
+func (m *ShardDMLActivity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShardDMLActivity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShardDMLActivity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ShardDMLActivity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardDMLActivity.Merge(m, src)
+}
+func (m *ShardDMLActivity) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShardDMLActivity) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardDMLActivity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardDMLActivity proto.InternalMessageInfo
+
+func (m *ShardDMLActivity) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *ShardDMLActivity) GetLastDMLTs() int64 {
+	if m != nil {
+		return m.LastDMLTs
+	}
+	return 0
+}
+
+func (m *ShardDMLActivity) GetSinceTs() int64 {
+	if m != nil {
+		return m.SinceTs
+	}
+	return 0
+}
+
+// BinlogErrorRuleRecord represents a binlog error rule which is applied automatically
+type BinlogErrorRuleRecord struct {
+	Name      string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Op        ErrorOp `protobuf:"varint,2,opt,name=op,proto3,enum=pb.ErrorOp" json:"op,omitempty"`
+	BinlogPos string  `protobuf:"bytes,3,opt,name=binlogPos,proto3" json:"binlogPos,omitempty"`
+	OriginSQL string  `protobuf:"bytes,4,opt,name=originSQL,proto3" json:"originSQL,omitempty"`
+	Error     string  `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	Time      int64   `protobuf:"varint,6,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (m *BinlogErrorRuleRecord) Reset()         { *m = BinlogErrorRuleRecord{} }
+func (m *BinlogErrorRuleRecord) String() string { return proto.CompactTextString(m) }
+func (*BinlogErrorRuleRecord) ProtoMessage()    {}
+func (*BinlogErrorRuleRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{8}
+}
+func (m *BinlogErrorRuleRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BinlogErrorRuleRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BinlogErrorRuleRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BinlogErrorRuleRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BinlogErrorRuleRecord.Merge(m, src)
+}
+func (m *BinlogErrorRuleRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *BinlogErrorRuleRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_BinlogErrorRuleRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BinlogErrorRuleRecord proto.InternalMessageInfo
+
+func (m *BinlogErrorRuleRecord) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *BinlogErrorRuleRecord) GetOp() ErrorOp {
+	if m != nil {
+		return m.Op
+	}
+	return ErrorOp_InvalidErrorOp
+}
+
+func (m *BinlogErrorRuleRecord) GetBinlogPos() string {
+	if m != nil {
+		return m.BinlogPos
+	}
+	return ""
+}
+
+func (m *BinlogErrorRuleRecord) GetOriginSQL() string {
+	if m != nil {
+		return m.OriginSQL
+	}
+	return ""
+}
+
+func (m *BinlogErrorRuleRecord) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *BinlogErrorRuleRecord) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
 // SyncStatus represents status for sync unit
 type SyncStatus struct {
 	// totalEvents/totalTps/recentTps has been deprecated now
@@ -964,14 +1118,16 @@ type SyncStatus struct {
 	// meter TCP io to downstream of the subtask
 	IoTotalBytes uint64 `protobuf:"varint,18,opt,name=ioTotalBytes,proto3" json:"ioTotalBytes,omitempty"`
 	// meter TCP io from upstream of the subtask
-	DumpIOTotalBytes uint64 `protobuf:"varint,19,opt,name=dumpIOTotalBytes,proto3" json:"dumpIOTotalBytes,omitempty"`
+	DumpIOTotalBytes       uint64                   `protobuf:"varint,19,opt,name=dumpIOTotalBytes,proto3" json:"dumpIOTotalBytes,omitempty"`
+	ShardDMLActivities     []*ShardDMLActivity      `protobuf:"bytes,20,rep,name=shardDMLActivities,proto3" json:"shardDMLActivities,omitempty"`
+	BinlogErrorRuleRecords []*BinlogErrorRuleRecord `protobuf:"bytes,21,rep,name=binlogErrorRuleRecords,proto3" json:"binlogErrorRuleRecords,omitempty"`
 }
 
 func (m *SyncStatus) Reset()         { *m = SyncStatus{} }
 func (m *SyncStatus) String() string { return proto.CompactTextString(m) }
 func (*SyncStatus) ProtoMessage()    {}
 func (*SyncStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{7}
+	return fileDescriptor_51a1b9e17fd67b10, []int{9}
 }
 func (m *SyncStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1133,6 +1289,20 @@ func (m *SyncStatus) GetDumpIOTotalBytes() uint64 {
 	return 0
 }
 
+func (m *SyncStatus) GetShardDMLActivities() []*ShardDMLActivity {
+	if m != nil {
+		return m.ShardDMLActivities
+	}
+	return nil
+}
+
+func (m *SyncStatus) GetBinlogErrorRuleRecords() []*BinlogErrorRuleRecord {
+	if m != nil {
+		return m.BinlogErrorRuleRecords
+	}
+	return nil
+}
+
 // SourceStatus represents status for source runing on dm-worker
 type SourceStatus struct {
 	Source      string         `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
@@ -1145,7 +1315,7 @@ func (m *SourceStatus) Reset()         { *m = SourceStatus{} }
 func (m *SourceStatus) String() string { return proto.CompactTextString(m) }
 func (*SourceStatus) ProtoMessage()    {}
 func (*SourceStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{8}
+	return fileDescriptor_51a1b9e17fd67b10, []int{10}
 }
 func (m *SourceStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1218,7 +1388,7 @@ func (m *RelayStatus) Reset()         { *m = RelayStatus{} }
 func (m *RelayStatus) String() string { return proto.CompactTextString(m) }
 func (*RelayStatus) ProtoMessage()    {}
 func (*RelayStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{9}
+	return fileDescriptor_51a1b9e17fd67b10, []int{11}
 }
 func (m *RelayStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1337,7 +1507,7 @@ func (m *SubTaskStatus) Reset()         { *m = SubTaskStatus{} }
 func (m *SubTaskStatus) String() string { return proto.CompactTextString(m) }
 func (*SubTaskStatus) ProtoMessage()    {}
 func (*SubTaskStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{10}
+	return fileDescriptor_51a1b9e17fd67b10, []int{12}
 }
 func (m *SubTaskStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1498,7 +1668,7 @@ func (m *SubTaskStatusList) Reset()         { *m = SubTaskStatusList{} }
 func (m *SubTaskStatusList) String() string { return proto.CompactTextString(m) }
 func (*SubTaskStatusList) ProtoMessage()    {}
 func (*SubTaskStatusList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{11}
+	return fileDescriptor_51a1b9e17fd67b10, []int{13}
 }
 func (m *SubTaskStatusList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1544,7 +1714,7 @@ func (m *CheckError) Reset()         { *m = CheckError{} }
 func (m *CheckError) String() string { return proto.CompactTextString(m) }
 func (*CheckError) ProtoMessage()    {}
 func (*CheckError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{12}
+	return fileDescriptor_51a1b9e17fd67b10, []int{14}
 }
 func (m *CheckError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1590,7 +1760,7 @@ func (m *DumpError) Reset()         { *m = DumpError{} }
 func (m *DumpError) String() string { return proto.CompactTextString(m) }
 func (*DumpError) ProtoMessage()    {}
 func (*DumpError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{13}
+	return fileDescriptor_51a1b9e17fd67b10, []int{15}
 }
 func (m *DumpError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1635,7 +1805,7 @@ func (m *LoadError) Reset()         { *m = LoadError{} }
 func (m *LoadError) String() string { return proto.CompactTextString(m) }
 func (*LoadError) ProtoMessage()    {}
 func (*LoadError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{14}
+	return fileDescriptor_51a1b9e17fd67b10, []int{16}
 }
 func (m *LoadError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1682,7 +1852,7 @@ func (m *SyncSQLError) Reset()         { *m = SyncSQLError{} }
 func (m *SyncSQLError) String() string { return proto.CompactTextString(m) }
 func (*SyncSQLError) ProtoMessage()    {}
 func (*SyncSQLError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{15}
+	return fileDescriptor_51a1b9e17fd67b10, []int{17}
 }
 func (m *SyncSQLError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1741,7 +1911,7 @@ func (m *SyncError) Reset()         { *m = SyncError{} }
 func (m *SyncError) String() string { return proto.CompactTextString(m) }
 func (*SyncError) ProtoMessage()    {}
 func (*SyncError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{16}
+	return fileDescriptor_51a1b9e17fd67b10, []int{18}
 }
 func (m *SyncError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1789,7 +1959,7 @@ func (m *SourceError) Reset()         { *m = SourceError{} }
 func (m *SourceError) String() string { return proto.CompactTextString(m) }
 func (*SourceError) ProtoMessage()    {}
 func (*SourceError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{17}
+	return fileDescriptor_51a1b9e17fd67b10, []int{19}
 }
 func (m *SourceError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1855,7 +2025,7 @@ func (m *RelayError) Reset()         { *m = RelayError{} }
 func (m *RelayError) String() string { return proto.CompactTextString(m) }
 func (*RelayError) ProtoMessage()    {}
 func (*RelayError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{18}
+	return fileDescriptor_51a1b9e17fd67b10, []int{20}
 }
 func (m *RelayError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1916,7 +2086,7 @@ func (m *SubTaskError) Reset()         { *m = SubTaskError{} }
 func (m *SubTaskError) String() string { return proto.CompactTextString(m) }
 func (*SubTaskError) ProtoMessage()    {}
 func (*SubTaskError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{19}
+	return fileDescriptor_51a1b9e17fd67b10, []int{21}
 }
 func (m *SubTaskError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2056,7 +2226,7 @@ func (m *SubTaskErrorList) Reset()         { *m = SubTaskErrorList{} }
 func (m *SubTaskErrorList) String() string { return proto.CompactTextString(m) }
 func (*SubTaskErrorList) ProtoMessage()    {}
 func (*SubTaskErrorList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{20}
+	return fileDescriptor_51a1b9e17fd67b10, []int{22}
 }
 func (m *SubTaskErrorList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2108,7 +2278,7 @@ func (m *ProcessResult) Reset()         { *m = ProcessResult{} }
 func (m *ProcessResult) String() string { return proto.CompactTextString(m) }
 func (*ProcessResult) ProtoMessage()    {}
 func (*ProcessResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{21}
+	return fileDescriptor_51a1b9e17fd67b10, []int{23}
 }
 func (m *ProcessResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2175,7 +2345,7 @@ func (m *ProcessError) Reset()         { *m = ProcessError{} }
 func (m *ProcessError) String() string { return proto.CompactTextString(m) }
 func (*ProcessError) ProtoMessage()    {}
 func (*ProcessError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{22}
+	return fileDescriptor_51a1b9e17fd67b10, []int{24}
 }
 func (m *ProcessError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2269,7 +2439,7 @@ func (m *PurgeRelayRequest) Reset()         { *m = PurgeRelayRequest{} }
 func (m *PurgeRelayRequest) String() string { return proto.CompactTextString(m) }
 func (*PurgeRelayRequest) ProtoMessage()    {}
 func (*PurgeRelayRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{23}
+	return fileDescriptor_51a1b9e17fd67b10, []int{25}
 }
 func (m *PurgeRelayRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2343,7 +2513,7 @@ func (m *OperateWorkerSchemaRequest) Reset()         { *m = OperateWorkerSchemaR
 func (m *OperateWorkerSchemaRequest) String() string { return proto.CompactTextString(m) }
 func (*OperateWorkerSchemaRequest) ProtoMessage()    {}
 func (*OperateWorkerSchemaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{24}
+	return fileDescriptor_51a1b9e17fd67b10, []int{26}
 }
 func (m *OperateWorkerSchemaRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2454,7 +2624,7 @@ func (m *V1SubTaskMeta) Reset()         { *m = V1SubTaskMeta{} }
 func (m *V1SubTaskMeta) String() string { return proto.CompactTextString(m) }
 func (*V1SubTaskMeta) ProtoMessage()    {}
 func (*V1SubTaskMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{25}
+	return fileDescriptor_51a1b9e17fd67b10, []int{27}
 }
 func (m *V1SubTaskMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2519,7 +2689,7 @@ func (m *OperateV1MetaRequest) Reset()         { *m = OperateV1MetaRequest{} }
 func (m *OperateV1MetaRequest) String() string { return proto.CompactTextString(m) }
 func (*OperateV1MetaRequest) ProtoMessage()    {}
 func (*OperateV1MetaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{26}
+	return fileDescriptor_51a1b9e17fd67b10, []int{28}
 }
 func (m *OperateV1MetaRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2565,7 +2735,7 @@ func (m *OperateV1MetaResponse) Reset()         { *m = OperateV1MetaResponse{} }
 func (m *OperateV1MetaResponse) String() string { return proto.CompactTextString(m) }
 func (*OperateV1MetaResponse) ProtoMessage()    {}
 func (*OperateV1MetaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{27}
+	return fileDescriptor_51a1b9e17fd67b10, []int{29}
 }
 func (m *OperateV1MetaResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2626,7 +2796,7 @@ func (m *HandleWorkerErrorRequest) Reset()         { *m = HandleWorkerErrorReque
 func (m *HandleWorkerErrorRequest) String() string { return proto.CompactTextString(m) }
 func (*HandleWorkerErrorRequest) ProtoMessage()    {}
 func (*HandleWorkerErrorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{28}
+	return fileDescriptor_51a1b9e17fd67b10, []int{30}
 }
 func (m *HandleWorkerErrorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2690,7 +2860,7 @@ func (m *GetWorkerCfgRequest) Reset()         { *m = GetWorkerCfgRequest{} }
 func (m *GetWorkerCfgRequest) String() string { return proto.CompactTextString(m) }
 func (*GetWorkerCfgRequest) ProtoMessage()    {}
 func (*GetWorkerCfgRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{29}
+	return fileDescriptor_51a1b9e17fd67b10, []int{31}
 }
 func (m *GetWorkerCfgRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2727,7 +2897,7 @@ func (m *GetWorkerCfgResponse) Reset()         { *m = GetWorkerCfgResponse{} }
 func (m *GetWorkerCfgResponse) String() string { return proto.CompactTextString(m) }
 func (*GetWorkerCfgResponse) ProtoMessage()    {}
 func (*GetWorkerCfgResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{30}
+	return fileDescriptor_51a1b9e17fd67b10, []int{32}
 }
 func (m *GetWorkerCfgResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2771,7 +2941,7 @@ func (m *CheckSubtasksCanUpdateRequest) Reset()         { *m = CheckSubtasksCanU
 func (m *CheckSubtasksCanUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*CheckSubtasksCanUpdateRequest) ProtoMessage()    {}
 func (*CheckSubtasksCanUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{31}
+	return fileDescriptor_51a1b9e17fd67b10, []int{33}
 }
 func (m *CheckSubtasksCanUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2816,7 +2986,7 @@ func (m *CheckSubtasksCanUpdateResponse) Reset()         { *m = CheckSubtasksCan
 func (m *CheckSubtasksCanUpdateResponse) String() string { return proto.CompactTextString(m) }
 func (*CheckSubtasksCanUpdateResponse) ProtoMessage()    {}
 func (*CheckSubtasksCanUpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{32}
+	return fileDescriptor_51a1b9e17fd67b10, []int{34}
 }
 func (m *CheckSubtasksCanUpdateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2868,7 +3038,7 @@ func (m *GetValidationStatusRequest) Reset()         { *m = GetValidationStatusR
 func (m *GetValidationStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*GetValidationStatusRequest) ProtoMessage()    {}
 func (*GetValidationStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{33}
+	return fileDescriptor_51a1b9e17fd67b10, []int{35}
 }
 func (m *GetValidationStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2930,7 +3100,7 @@ func (m *ValidationStatus) Reset()         { *m = ValidationStatus{} }
 func (m *ValidationStatus) String() string { return proto.CompactTextString(m) }
 func (*ValidationStatus) ProtoMessage()    {}
 func (*ValidationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{34}
+	return fileDescriptor_51a1b9e17fd67b10, []int{36}
 }
 func (m *ValidationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3055,7 +3225,7 @@ func (m *ValidationTableStatus) Reset()         { *m = ValidationTableStatus{} }
 func (m *ValidationTableStatus) String() string { return proto.CompactTextString(m) }
 func (*ValidationTableStatus) ProtoMessage()    {}
 func (*ValidationTableStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{35}
+	return fileDescriptor_51a1b9e17fd67b10, []int{37}
 }
 func (m *ValidationTableStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3130,7 +3300,7 @@ func (m *GetValidationStatusResponse) Reset()         { *m = GetValidationStatus
 func (m *GetValidationStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*GetValidationStatusResponse) ProtoMessage()    {}
 func (*GetValidationStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{36}
+	return fileDescriptor_51a1b9e17fd67b10, []int{38}
 }
 func (m *GetValidationStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3196,7 +3366,7 @@ func (m *GetValidationErrorRequest) Reset()         { *m = GetValidationErrorReq
 func (m *GetValidationErrorRequest) String() string { return proto.CompactTextString(m) }
 func (*GetValidationErrorRequest) ProtoMessage()    {}
 func (*GetValidationErrorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{37}
+	return fileDescriptor_51a1b9e17fd67b10, []int{39}
 }
 func (m *GetValidationErrorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3256,7 +3426,7 @@ func (m *ValidationError) Reset()         { *m = ValidationError{} }
 func (m *ValidationError) String() string { return proto.CompactTextString(m) }
 func (*ValidationError) ProtoMessage()    {}
 func (*ValidationError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{38}
+	return fileDescriptor_51a1b9e17fd67b10, []int{40}
 }
 func (m *ValidationError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3365,7 +3535,7 @@ func (m *GetValidationErrorResponse) Reset()         { *m = GetValidationErrorRe
 func (m *GetValidationErrorResponse) String() string { return proto.CompactTextString(m) }
 func (*GetValidationErrorResponse) ProtoMessage()    {}
 func (*GetValidationErrorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{39}
+	return fileDescriptor_51a1b9e17fd67b10, []int{41}
 }
 func (m *GetValidationErrorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3426,7 +3596,7 @@ func (m *OperateValidationErrorRequest) Reset()         { *m = OperateValidation
 func (m *OperateValidationErrorRequest) String() string { return proto.CompactTextString(m) }
 func (*OperateValidationErrorRequest) ProtoMessage()    {}
 func (*OperateValidationErrorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{40}
+	return fileDescriptor_51a1b9e17fd67b10, []int{42}
 }
 func (m *OperateValidationErrorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3492,7 +3662,7 @@ func (m *OperateValidationErrorResponse) Reset()         { *m = OperateValidatio
 func (m *OperateValidationErrorResponse) String() string { return proto.CompactTextString(m) }
 func (*OperateValidationErrorResponse) ProtoMessage()    {}
 func (*OperateValidationErrorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{41}
+	return fileDescriptor_51a1b9e17fd67b10, []int{43}
 }
 func (m *OperateValidationErrorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3545,7 +3715,7 @@ func (m *UpdateValidationWorkerRequest) Reset()         { *m = UpdateValidationW
 func (m *UpdateValidationWorkerRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateValidationWorkerRequest) ProtoMessage()    {}
 func (*UpdateValidationWorkerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{42}
+	return fileDescriptor_51a1b9e17fd67b10, []int{44}
 }
 func (m *UpdateValidationWorkerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3613,6 +3783,8 @@ func init() {
 	proto.RegisterType((*DumpStatus)(nil), "pb.DumpStatus")
 	proto.RegisterType((*LoadStatus)(nil), "pb.LoadStatus")
 	proto.RegisterType((*ShardingGroup)(nil), "pb.ShardingGroup")
+	proto.RegisterType((*ShardDMLActivity)(nil), "pb.ShardDMLActivity")
+	proto.RegisterType((*BinlogErrorRuleRecord)(nil), "pb.BinlogErrorRuleRecord")
 	proto.RegisterType((*SyncStatus)(nil), "pb.SyncStatus")
 	proto.RegisterType((*SourceStatus)(nil), "pb.SourceStatus")
 	proto.RegisterType((*RelayStatus)(nil), "pb.RelayStatus")
@@ -3655,193 +3827,203 @@ func init() {
 func init() { proto.RegisterFile("dmworker.proto", fileDescriptor_51a1b9e17fd67b10) }
 
 var fileDescriptor_51a1b9e17fd67b10 = []byte{
-	// 2972 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x3a, 0x4d, 0x6f, 0xdc, 0xd6,
-	0xb5, 0x43, 0x72, 0x3e, 0xcf, 0x8c, 0x24, 0xea, 0x4a, 0xf6, 0x9b, 0x28, 0xf6, 0x44, 0xa1, 0x83,
-	0x3c, 0x45, 0xc8, 0x13, 0x12, 0xbd, 0x3c, 0xe4, 0x21, 0x40, 0x9b, 0xc4, 0x92, 0x23, 0x3b, 0x95,
-	0x23, 0x9b, 0x52, 0xdc, 0x55, 0x81, 0x52, 0x9c, 0xab, 0x11, 0x2b, 0x0e, 0x49, 0x93, 0x1c, 0x09,
-	0x5a, 0x14, 0xdd, 0x75, 0xdb, 0x6e, 0x5a, 0xa0, 0x45, 0x37, 0x2d, 0xd0, 0x6d, 0x17, 0xfd, 0x01,
-	0xdd, 0xb5, 0xcd, 0x32, 0xe8, 0xaa, 0xab, 0xa2, 0x48, 0xfe, 0x45, 0x17, 0x45, 0x71, 0xce, 0xbd,
-	0x97, 0xbc, 0x9c, 0x0f, 0x39, 0x2e, 0xd0, 0x1d, 0xcf, 0xc7, 0x3d, 0xf7, 0xf0, 0x7c, 0x1f, 0xce,
-	0xc0, 0xf2, 0x70, 0x7c, 0x15, 0xa7, 0x17, 0x3c, 0xdd, 0x49, 0xd2, 0x38, 0x8f, 0x99, 0x99, 0x9c,
-	0x3a, 0x5b, 0xc0, 0x9e, 0x4e, 0x78, 0x7a, 0x7d, 0x9c, 0x7b, 0xf9, 0x24, 0x73, 0xf9, 0xf3, 0x09,
-	0xcf, 0x72, 0xc6, 0xa0, 0x1e, 0x79, 0x63, 0xde, 0x37, 0x36, 0x8d, 0xad, 0x8e, 0x4b, 0xcf, 0x4e,
-	0x02, 0xeb, 0x7b, 0xf1, 0x78, 0x1c, 0x47, 0xdf, 0x25, 0x19, 0x2e, 0xcf, 0x92, 0x38, 0xca, 0x38,
-	0xbb, 0x0d, 0xcd, 0x94, 0x67, 0x93, 0x30, 0x27, 0xee, 0xb6, 0x2b, 0x21, 0x66, 0x83, 0x35, 0xce,
-	0x46, 0x7d, 0x93, 0x44, 0xe0, 0x23, 0x72, 0x66, 0xf1, 0x24, 0xf5, 0x79, 0xdf, 0x22, 0xa4, 0x84,
-	0x10, 0x2f, 0xf4, 0xea, 0xd7, 0x05, 0x5e, 0x40, 0xce, 0xef, 0x0c, 0x58, 0xab, 0x28, 0xf7, 0xd2,
-	0x37, 0xbe, 0x07, 0x3d, 0x71, 0x87, 0x90, 0x40, 0xf7, 0x76, 0x77, 0xed, 0x9d, 0xe4, 0x74, 0xe7,
-	0x58, 0xc3, 0xbb, 0x15, 0x2e, 0xf6, 0x3e, 0x2c, 0x65, 0x93, 0xd3, 0x13, 0x2f, 0xbb, 0x90, 0xc7,
-	0xea, 0x9b, 0xd6, 0x56, 0x77, 0x77, 0x95, 0x8e, 0xe9, 0x04, 0xb7, 0xca, 0xe7, 0xfc, 0xd6, 0x80,
-	0xee, 0xde, 0x39, 0xf7, 0x25, 0x8c, 0x8a, 0x26, 0x5e, 0x96, 0xf1, 0xa1, 0x52, 0x54, 0x40, 0x6c,
-	0x1d, 0x1a, 0x79, 0x9c, 0x7b, 0x21, 0xa9, 0xda, 0x70, 0x05, 0xc0, 0x06, 0x00, 0xd9, 0xc4, 0xf7,
-	0x79, 0x96, 0x9d, 0x4d, 0x42, 0x52, 0xb5, 0xe1, 0x6a, 0x18, 0x94, 0x76, 0xe6, 0x05, 0x21, 0x1f,
-	0x92, 0x99, 0x1a, 0xae, 0x84, 0x58, 0x1f, 0x5a, 0x57, 0x5e, 0x1a, 0x05, 0xd1, 0xa8, 0xdf, 0x20,
-	0x82, 0x02, 0xf1, 0xc4, 0x90, 0xe7, 0x5e, 0x10, 0xf6, 0x9b, 0x9b, 0xc6, 0x56, 0xcf, 0x95, 0x90,
-	0xf3, 0x4f, 0x03, 0x60, 0x7f, 0x32, 0x4e, 0xa4, 0x9a, 0x9b, 0xd0, 0x25, 0x0d, 0x4e, 0xbc, 0xd3,
-	0x90, 0x67, 0xa4, 0xab, 0xe5, 0xea, 0x28, 0xb6, 0x05, 0x2b, 0x7e, 0x3c, 0x4e, 0x42, 0x9e, 0xf3,
-	0xa1, 0xe4, 0x42, 0xd5, 0x0d, 0x77, 0x1a, 0xcd, 0xde, 0x80, 0xa5, 0xb3, 0x20, 0x0a, 0xb2, 0x73,
-	0x3e, 0xbc, 0x7f, 0x9d, 0x73, 0x61, 0x72, 0xc3, 0xad, 0x22, 0x99, 0x03, 0x3d, 0x85, 0x70, 0xe3,
-	0xab, 0x8c, 0x5e, 0xc8, 0x70, 0x2b, 0x38, 0xf6, 0x36, 0xac, 0xf2, 0x2c, 0x0f, 0xc6, 0x5e, 0xce,
-	0x4f, 0x50, 0x15, 0x62, 0x6c, 0x10, 0xe3, 0x2c, 0x01, 0x7d, 0x7f, 0x9a, 0x64, 0xf4, 0x9e, 0x96,
-	0x8b, 0x8f, 0x6c, 0x03, 0xda, 0x49, 0x1a, 0x8f, 0x52, 0x9e, 0x65, 0xfd, 0x16, 0x85, 0x44, 0x01,
-	0x3b, 0x5f, 0x18, 0x00, 0x87, 0xb1, 0x37, 0x94, 0x06, 0x98, 0x51, 0x5a, 0x98, 0x60, 0x4a, 0xe9,
-	0x01, 0x00, 0xd9, 0x44, 0xb0, 0x98, 0xc4, 0xa2, 0x61, 0x2a, 0x17, 0x5a, 0xd5, 0x0b, 0xf1, 0xec,
-	0x98, 0xe7, 0xde, 0xfd, 0x20, 0x0a, 0xe3, 0x91, 0x0c, 0x73, 0x0d, 0xc3, 0xde, 0x84, 0xe5, 0x12,
-	0x3a, 0x38, 0x79, 0xb4, 0x4f, 0x6f, 0xda, 0x71, 0xa7, 0xb0, 0xb3, 0xaf, 0xe9, 0xfc, 0xcc, 0x80,
-	0xa5, 0xe3, 0x73, 0x2f, 0x1d, 0x06, 0xd1, 0xe8, 0x20, 0x8d, 0x27, 0x09, 0x7a, 0x3d, 0xf7, 0xd2,
-	0x11, 0xcf, 0x65, 0xfa, 0x4a, 0x08, 0x93, 0x7a, 0x7f, 0xff, 0x10, 0x35, 0xb7, 0x30, 0xa9, 0xf1,
-	0x59, 0xbc, 0x79, 0x9a, 0xe5, 0x87, 0xb1, 0xef, 0xe5, 0x41, 0x1c, 0x49, 0xc5, 0xab, 0x48, 0x4a,
-	0xdc, 0xeb, 0xc8, 0xa7, 0xc8, 0xb3, 0x28, 0x71, 0x09, 0xc2, 0x37, 0x9e, 0x44, 0x92, 0xd2, 0x20,
-	0x4a, 0x01, 0x3b, 0x7f, 0x6c, 0x00, 0x1c, 0x5f, 0x47, 0xfe, 0x54, 0x8c, 0x3d, 0xb8, 0xe4, 0x51,
-	0x5e, 0x8d, 0x31, 0x81, 0x42, 0x61, 0x22, 0xe4, 0x12, 0x65, 0xdc, 0x02, 0x66, 0x77, 0xa0, 0x93,
-	0x72, 0x9f, 0x47, 0x39, 0x12, 0x2d, 0x22, 0x96, 0x08, 0x8c, 0xa6, 0xb1, 0x97, 0xe5, 0x3c, 0xad,
-	0x98, 0xb7, 0x82, 0x63, 0xdb, 0x60, 0xeb, 0xf0, 0x41, 0x1e, 0x0c, 0xa5, 0x89, 0x67, 0xf0, 0x28,
-	0x8f, 0x5e, 0x42, 0xc9, 0x6b, 0x0a, 0x79, 0x3a, 0x0e, 0xe5, 0xe9, 0x30, 0xc9, 0x13, 0x51, 0x36,
-	0x83, 0x47, 0x79, 0xa7, 0x61, 0xec, 0x5f, 0x04, 0xd1, 0x88, 0x1c, 0xd0, 0x26, 0x53, 0x55, 0x70,
-	0xec, 0x5b, 0x60, 0x4f, 0xa2, 0x94, 0x67, 0x71, 0x78, 0xc9, 0x87, 0xe4, 0xc7, 0xac, 0xdf, 0xd1,
-	0xca, 0x8e, 0xee, 0x61, 0x77, 0x86, 0x55, 0xf3, 0x10, 0x88, 0x4a, 0x23, 0x3d, 0x34, 0x00, 0x38,
-	0x25, 0x45, 0x4e, 0xae, 0x13, 0xde, 0xef, 0x8a, 0xb8, 0x2b, 0x31, 0xec, 0x1d, 0x58, 0xcb, 0xb8,
-	0x1f, 0x47, 0xc3, 0xec, 0x3e, 0x3f, 0x0f, 0xa2, 0xe1, 0x63, 0xb2, 0x45, 0xbf, 0x47, 0x26, 0x9e,
-	0x47, 0xc2, 0x88, 0x21, 0xc5, 0xf7, 0xf7, 0x0f, 0x8f, 0xae, 0x22, 0x9e, 0xf6, 0x97, 0x44, 0xc4,
-	0x54, 0x90, 0xe8, 0x6e, 0x3f, 0x8e, 0xce, 0xc2, 0xc0, 0xcf, 0x1f, 0x67, 0xa3, 0xfe, 0x32, 0xf1,
-	0xe8, 0x28, 0x74, 0x69, 0x5e, 0xa4, 0xf5, 0x8a, 0x70, 0x69, 0x81, 0x28, 0x82, 0xc1, 0x4d, 0xb2,
-	0xbe, 0xad, 0x05, 0x83, 0xab, 0x07, 0x03, 0x12, 0x57, 0xf5, 0x60, 0x70, 0x45, 0x30, 0x04, 0xf1,
-	0x49, 0x99, 0xa7, 0x6c, 0xd3, 0xd8, 0xaa, 0xbb, 0x15, 0x1c, 0x3a, 0x6f, 0x38, 0x19, 0x27, 0x8f,
-	0x8e, 0x34, 0xbe, 0x35, 0xe2, 0x9b, 0xc1, 0x3b, 0xbf, 0x32, 0xa0, 0xa7, 0xf7, 0x0a, 0xad, 0x8b,
-	0x19, 0x0b, 0xba, 0x98, 0xa9, 0x77, 0x31, 0xf6, 0x56, 0xd1, 0xad, 0x44, 0xf7, 0x21, 0x7f, 0x3e,
-	0x49, 0x63, 0x2c, 0xeb, 0x2e, 0x11, 0x8a, 0x06, 0xf6, 0x2e, 0x74, 0x53, 0x1e, 0x7a, 0xd7, 0x45,
-	0xdb, 0x41, 0xfe, 0x15, 0xe4, 0x77, 0x4b, 0xb4, 0xab, 0xf3, 0x38, 0x7f, 0x36, 0xa1, 0xab, 0x11,
-	0x67, 0x72, 0xc1, 0xf8, 0x86, 0xb9, 0x60, 0x2e, 0xc8, 0x85, 0x4d, 0xa5, 0xd2, 0xe4, 0x74, 0x3f,
-	0x48, 0x65, 0x79, 0xd0, 0x51, 0x05, 0x47, 0x25, 0xf9, 0x74, 0x14, 0x76, 0x0f, 0x0d, 0xd4, 0x52,
-	0x6f, 0x1a, 0xcd, 0x76, 0x80, 0x11, 0x6a, 0xcf, 0xcb, 0xfd, 0xf3, 0xcf, 0x13, 0x19, 0x8d, 0x4d,
-	0x0a, 0xe9, 0x39, 0x14, 0xf6, 0x1a, 0x34, 0xb2, 0xdc, 0x1b, 0x71, 0x4a, 0xbd, 0xe5, 0xdd, 0x0e,
-	0xa5, 0x0a, 0x22, 0x5c, 0x81, 0xd7, 0x8c, 0xdf, 0x7e, 0x81, 0xf1, 0x9d, 0xdf, 0x5b, 0xb0, 0x54,
-	0xe9, 0xee, 0xf3, 0xa6, 0xa0, 0xf2, 0x46, 0x73, 0xc1, 0x8d, 0x9b, 0x50, 0x9f, 0x44, 0x81, 0x70,
-	0xf6, 0xf2, 0x6e, 0x0f, 0xe9, 0x9f, 0x47, 0x41, 0x8e, 0xd9, 0xe6, 0x12, 0x45, 0xd3, 0xa9, 0xfe,
-	0xa2, 0x80, 0x78, 0x07, 0xd6, 0xca, 0x54, 0xdf, 0xdf, 0x3f, 0x3c, 0x8c, 0xfd, 0x8b, 0xa2, 0x37,
-	0xcc, 0x23, 0x31, 0x26, 0x66, 0x20, 0x2a, 0x59, 0x0f, 0x6b, 0x62, 0x0a, 0xfa, 0x6f, 0x68, 0xf8,
-	0x38, 0x95, 0x90, 0x95, 0x64, 0x40, 0x69, 0x63, 0xca, 0xc3, 0x9a, 0x2b, 0xe8, 0xec, 0x0d, 0xa8,
-	0x63, 0xfc, 0x4b, 0x5b, 0x2d, 0x23, 0x5f, 0x39, 0x26, 0x3c, 0xac, 0xb9, 0x44, 0x45, 0xae, 0x30,
-	0xf6, 0x86, 0xfd, 0x4e, 0xc9, 0x55, 0xf6, 0x52, 0xe4, 0x42, 0x2a, 0x72, 0x61, 0x0d, 0xa2, 0x7a,
-	0x24, 0xb9, 0xca, 0x76, 0x80, 0x5c, 0x48, 0x65, 0xef, 0x01, 0x5c, 0x7a, 0x61, 0x30, 0x14, 0xcd,
-	0xa7, 0x4b, 0xbc, 0xeb, 0xc8, 0xfb, 0xac, 0xc0, 0xca, 0xa8, 0xd7, 0xf8, 0xee, 0xb7, 0xa1, 0x99,
-	0x89, 0xf0, 0xff, 0x36, 0xac, 0x56, 0x7c, 0x76, 0x18, 0x64, 0x64, 0x60, 0x41, 0xee, 0x1b, 0x8b,
-	0x06, 0x37, 0x75, 0x7e, 0x00, 0x40, 0x96, 0x78, 0x90, 0xa6, 0x71, 0xaa, 0x06, 0x48, 0xa3, 0x18,
-	0x20, 0x9d, 0xbb, 0xd0, 0x41, 0x0b, 0xdc, 0x40, 0xc6, 0x57, 0x5f, 0x44, 0x4e, 0xa0, 0x47, 0xef,
-	0xfc, 0xf4, 0x70, 0x01, 0x07, 0xdb, 0x85, 0x75, 0x31, 0xc5, 0x89, 0x24, 0x78, 0x12, 0x67, 0x01,
-	0x59, 0x42, 0xa4, 0xe3, 0x5c, 0x1a, 0xd6, 0x46, 0x8e, 0xe2, 0x8e, 0x9f, 0x1e, 0xaa, 0x39, 0x43,
-	0xc1, 0xce, 0xff, 0x41, 0x07, 0x6f, 0x14, 0xd7, 0x6d, 0x41, 0x93, 0x08, 0xca, 0x0e, 0x76, 0xe1,
-	0x04, 0xa9, 0x90, 0x2b, 0xe9, 0xce, 0x4f, 0x0c, 0xe8, 0x8a, 0x22, 0x27, 0x4e, 0xbe, 0x6c, 0x8d,
-	0xdb, 0xac, 0x1c, 0x57, 0x55, 0x42, 0x97, 0xb8, 0x03, 0x40, 0x65, 0x4a, 0x30, 0xd4, 0xcb, 0xa0,
-	0x28, 0xb1, 0xae, 0xc6, 0x81, 0x8e, 0x29, 0xa1, 0x39, 0xa6, 0xfd, 0x85, 0x09, 0x3d, 0xe9, 0x52,
-	0xc1, 0xf2, 0x1f, 0x4a, 0x56, 0x99, 0x4f, 0x75, 0x3d, 0x9f, 0xde, 0x54, 0xf9, 0xd4, 0x28, 0x5f,
-	0xa3, 0x8c, 0xa2, 0x32, 0x9d, 0xee, 0xc9, 0x74, 0x6a, 0x12, 0xdb, 0x92, 0x4a, 0x27, 0xc5, 0x25,
-	0xb2, 0xe9, 0x9e, 0xcc, 0xa6, 0x56, 0xc9, 0x54, 0x84, 0x54, 0x91, 0x4c, 0xf7, 0x64, 0x32, 0xb5,
-	0x4b, 0xa6, 0xc2, 0xcd, 0x2a, 0x97, 0xee, 0xb7, 0xa0, 0x41, 0xee, 0x74, 0x3e, 0x00, 0x5b, 0x37,
-	0x0d, 0xe5, 0xc4, 0x9b, 0x92, 0x58, 0x09, 0x05, 0x8d, 0xc9, 0x95, 0x67, 0x9f, 0xc3, 0x52, 0xa5,
-	0x14, 0xe1, 0x04, 0x11, 0x64, 0x7b, 0x5e, 0xe4, 0xf3, 0xb0, 0xd8, 0x63, 0x34, 0x8c, 0x16, 0x64,
-	0x66, 0x29, 0x59, 0x8a, 0xa8, 0x04, 0x99, 0xb6, 0x8d, 0x58, 0x95, 0x6d, 0xe4, 0x2f, 0x06, 0xf4,
-	0xf4, 0x03, 0xb8, 0xd0, 0x3c, 0x48, 0xd3, 0xbd, 0x78, 0x28, 0xbc, 0xd9, 0x70, 0x15, 0x88, 0xa1,
-	0x8f, 0x8f, 0xa1, 0x97, 0x65, 0x32, 0x02, 0x0b, 0x58, 0xd2, 0x8e, 0xfd, 0x38, 0x51, 0xfb, 0x65,
-	0x01, 0x4b, 0xda, 0x21, 0xbf, 0xe4, 0xa1, 0x6c, 0x50, 0x05, 0x8c, 0xb7, 0x3d, 0xe6, 0x59, 0x86,
-	0x61, 0x22, 0xea, 0xaa, 0x02, 0xf1, 0x94, 0xeb, 0x5d, 0xed, 0x79, 0x93, 0x8c, 0xcb, 0x19, 0xb0,
-	0x80, 0xd1, 0x2c, 0xb8, 0x07, 0x7b, 0x69, 0x3c, 0x89, 0xd4, 0xe4, 0xa7, 0x61, 0x9c, 0x2b, 0x58,
-	0x7d, 0x32, 0x49, 0x47, 0x9c, 0x82, 0x58, 0xad, 0xd5, 0x1b, 0xd0, 0x0e, 0x22, 0xcf, 0xcf, 0x83,
-	0x4b, 0x2e, 0x2d, 0x59, 0xc0, 0x18, 0xbf, 0x79, 0x30, 0xe6, 0x72, 0xf4, 0xa5, 0x67, 0xe4, 0x3f,
-	0x0b, 0x42, 0x4e, 0x71, 0x2d, 0x5f, 0x49, 0xc1, 0x94, 0xa2, 0xa2, 0x27, 0xcb, 0xa5, 0x59, 0x40,
-	0xce, 0x2f, 0x4d, 0xd8, 0x38, 0x4a, 0x78, 0xea, 0xe5, 0x5c, 0x2c, 0xea, 0xc7, 0xfe, 0x39, 0x1f,
-	0x7b, 0x4a, 0x85, 0x3b, 0x60, 0xc6, 0x09, 0x5d, 0x2e, 0xe3, 0x5d, 0x90, 0x8f, 0x12, 0xd7, 0x8c,
-	0x13, 0x52, 0xc2, 0xcb, 0x2e, 0xa4, 0x6d, 0xe9, 0x79, 0xe1, 0xd6, 0xbe, 0x01, 0xed, 0xa1, 0x97,
-	0x7b, 0xa7, 0x5e, 0xc6, 0x95, 0x4d, 0x15, 0x4c, 0x0b, 0x2e, 0xee, 0x83, 0xd2, 0xa2, 0x02, 0x20,
-	0x49, 0x74, 0x9b, 0xb4, 0xa6, 0x84, 0x90, 0xfb, 0x2c, 0x9c, 0x64, 0xe7, 0x64, 0xc6, 0xb6, 0x2b,
-	0x00, 0xd4, 0xa5, 0x88, 0xf9, 0xb6, 0x6c, 0x17, 0x03, 0x80, 0xb3, 0x34, 0x1e, 0x8b, 0xc2, 0x42,
-	0x0d, 0xa8, 0xed, 0x6a, 0x18, 0x45, 0x3f, 0x11, 0xeb, 0x0f, 0x94, 0x74, 0x81, 0x71, 0x72, 0x58,
-	0x7a, 0xf6, 0xae, 0x0c, 0xfb, 0xc7, 0x3c, 0xf7, 0xd8, 0x86, 0x66, 0x0e, 0x40, 0x73, 0x20, 0x45,
-	0x1a, 0xe3, 0x85, 0xd5, 0x43, 0x95, 0x1c, 0x4b, 0x2b, 0x39, 0xca, 0x82, 0x75, 0x0a, 0x71, 0x7a,
-	0x76, 0xde, 0x83, 0x75, 0xe9, 0x91, 0x67, 0xef, 0xe2, 0xad, 0x0b, 0x7d, 0x21, 0xc8, 0xe2, 0x7a,
-	0xe7, 0x4f, 0x06, 0xdc, 0x9a, 0x3a, 0xf6, 0xd2, 0xdf, 0x3f, 0xde, 0x87, 0x3a, 0x2e, 0x90, 0x7d,
-	0x8b, 0x52, 0xf3, 0x1e, 0xde, 0x31, 0x57, 0xe4, 0x0e, 0x02, 0x0f, 0xa2, 0x3c, 0xbd, 0x76, 0xe9,
-	0xc0, 0xc6, 0xa7, 0xd0, 0x29, 0x50, 0x28, 0xf7, 0x82, 0x5f, 0xab, 0xea, 0x7b, 0xc1, 0xaf, 0x71,
-	0xa2, 0xb8, 0xf4, 0xc2, 0x89, 0x30, 0x8d, 0x6c, 0xb0, 0x15, 0xc3, 0xba, 0x82, 0xfe, 0x81, 0xf9,
-	0xff, 0x86, 0xf3, 0x43, 0xe8, 0x3f, 0xf4, 0xa2, 0x61, 0x28, 0xe3, 0x51, 0x14, 0x05, 0x69, 0x82,
-	0x57, 0x35, 0x13, 0x74, 0x51, 0x0a, 0x51, 0x6f, 0x88, 0xc6, 0x3b, 0xd0, 0x39, 0x55, 0xed, 0x50,
-	0x1a, 0xbe, 0x44, 0x50, 0xcc, 0x3c, 0x0f, 0x33, 0xb9, 0xa6, 0xd2, 0xb3, 0x73, 0x0b, 0xd6, 0x0e,
-	0x78, 0x2e, 0xee, 0xde, 0x3b, 0x1b, 0xc9, 0x9b, 0x9d, 0x2d, 0x58, 0xaf, 0xa2, 0xa5, 0x71, 0x6d,
-	0xb0, 0xfc, 0xb3, 0xa2, 0xd5, 0xf8, 0x67, 0x23, 0xe7, 0x18, 0xee, 0x8a, 0x69, 0x69, 0x72, 0x8a,
-	0x2a, 0x60, 0xe9, 0xfb, 0x3c, 0x19, 0x7a, 0x39, 0x57, 0x2f, 0xb1, 0x0b, 0xeb, 0x99, 0xa0, 0xed,
-	0x9d, 0x8d, 0x4e, 0xe2, 0x71, 0x78, 0x9c, 0xa7, 0x41, 0xa4, 0x64, 0xcc, 0xa5, 0x39, 0x87, 0x30,
-	0x58, 0x24, 0x54, 0x2a, 0xd2, 0x87, 0x96, 0xfc, 0xf8, 0x23, 0xdd, 0xac, 0xc0, 0x59, 0x3f, 0x3b,
-	0x23, 0xd8, 0x38, 0xe0, 0xf9, 0xcc, 0xcc, 0x54, 0x96, 0x1d, 0xbc, 0xe3, 0xb3, 0xb2, 0x3d, 0x16,
-	0x30, 0xfb, 0x1f, 0xe8, 0x9d, 0x05, 0x61, 0xce, 0x53, 0xb9, 0x73, 0xcc, 0xc4, 0x7a, 0x85, 0xec,
-	0xfc, 0xcd, 0x02, 0x7b, 0xfa, 0x9a, 0xc2, 0x4f, 0xc6, 0xdc, 0xaa, 0x61, 0x56, 0xaa, 0x06, 0x83,
-	0xfa, 0x18, 0x0b, 0xbb, 0xcc, 0x19, 0x7c, 0x2e, 0x13, 0xad, 0xbe, 0x20, 0xd1, 0xb6, 0x60, 0x45,
-	0x4e, 0x7f, 0xb1, 0xda, 0x6b, 0xe4, 0x02, 0x31, 0x85, 0xc6, 0x81, 0x79, 0x0a, 0x45, 0xeb, 0x86,
-	0xa8, 0x37, 0xf3, 0x48, 0xda, 0x34, 0xde, 0xfa, 0x06, 0xd3, 0x78, 0x22, 0x08, 0xe2, 0x13, 0x95,
-	0x34, 0x59, 0x5b, 0x08, 0x9f, 0x43, 0x62, 0x6f, 0xc3, 0x6a, 0xc2, 0x23, 0x5c, 0xdc, 0x35, 0xfe,
-	0x0e, 0xf1, 0xcf, 0x12, 0xf0, 0x35, 0xa9, 0x55, 0x6a, 0xbc, 0x20, 0x5e, 0x73, 0x0a, 0x8d, 0x1b,
-	0x9c, 0x3f, 0xc9, 0xe3, 0x4b, 0xb5, 0xaa, 0x61, 0x32, 0x88, 0xe5, 0x7e, 0x06, 0x8f, 0x3a, 0x54,
-	0x70, 0x64, 0x90, 0x9e, 0xd0, 0x61, 0x86, 0xe0, 0xfc, 0xc6, 0x80, 0x5b, 0xa5, 0x83, 0xe9, 0xa3,
-	0xde, 0x0b, 0xf6, 0xde, 0x0d, 0x68, 0x67, 0xa9, 0x4f, 0x9c, 0xaa, 0x27, 0x2b, 0x98, 0x7a, 0x44,
-	0x96, 0x0b, 0x9a, 0x6c, 0x60, 0x0a, 0x7e, 0xb1, 0xd7, 0xfb, 0xd0, 0x1a, 0x57, 0x1b, 0xb3, 0x04,
-	0x9d, 0x3f, 0x18, 0xf0, 0xea, 0xdc, 0x78, 0xff, 0x37, 0x3e, 0x10, 0x43, 0x11, 0x14, 0x99, 0x2c,
-	0x93, 0x37, 0xef, 0x1f, 0x38, 0xc9, 0x7c, 0x08, 0x4b, 0x79, 0x69, 0x19, 0xae, 0x3e, 0x10, 0xbf,
-	0x52, 0x3d, 0xa8, 0x19, 0xcf, 0xad, 0xf2, 0x3b, 0x17, 0xf0, 0x4a, 0x45, 0xff, 0x4a, 0x4d, 0xdc,
-	0xa5, 0xf9, 0x1e, 0x79, 0xb9, 0xac, 0x8c, 0xb7, 0x35, 0xc1, 0x62, 0x9e, 0x26, 0xaa, 0x5b, 0xf0,
-	0x55, 0x52, 0xdc, 0xac, 0xa6, 0xb8, 0xf3, 0x6b, 0x13, 0x56, 0xa6, 0xae, 0x62, 0xcb, 0x60, 0x06,
-	0x43, 0xe9, 0x48, 0x33, 0x18, 0x2e, 0x4c, 0x57, 0xdd, 0xb9, 0xd6, 0x94, 0x73, 0xb1, 0x40, 0xa5,
-	0xfe, 0xbe, 0x97, 0x7b, 0xb2, 0xff, 0x2b, 0xb0, 0xe2, 0xf6, 0xc6, 0x94, 0xdb, 0xfb, 0xd0, 0x1a,
-	0x66, 0x39, 0x9d, 0x12, 0x59, 0xa9, 0x40, 0x2c, 0xed, 0x14, 0xe7, 0xf4, 0xa9, 0x4a, 0x4c, 0x54,
-	0x25, 0x82, 0xed, 0x14, 0x4b, 0x5d, 0xfb, 0x46, 0x9b, 0x48, 0xae, 0x62, 0x9e, 0xea, 0xc8, 0xa2,
-	0x84, 0xf3, 0x94, 0x16, 0x51, 0x50, 0x8d, 0xa8, 0xe7, 0x53, 0x05, 0x54, 0x3a, 0xe4, 0xa5, 0xe3,
-	0xe9, 0x2d, 0x35, 0x66, 0x8b, 0x50, 0x5a, 0xab, 0x46, 0x44, 0x65, 0xd2, 0xfe, 0xb9, 0x01, 0x77,
-	0x55, 0x33, 0x9e, 0x1f, 0x08, 0xf7, 0xb4, 0xe6, 0x38, 0x2b, 0x49, 0x36, 0x49, 0x9a, 0xcf, 0x3f,
-	0x0e, 0x43, 0xb1, 0x58, 0x99, 0x6a, 0x3e, 0x57, 0x98, 0x4a, 0x64, 0x58, 0x53, 0xc5, 0x7f, 0x9d,
-	0xb4, 0x7d, 0x24, 0x7e, 0x50, 0xa8, 0xbb, 0x02, 0x70, 0x3e, 0x85, 0xc1, 0x22, 0xbd, 0x5e, 0xd6,
-	0x1e, 0xce, 0x35, 0xdc, 0x15, 0x6d, 0xad, 0x14, 0xa5, 0x7e, 0x3e, 0x7a, 0x71, 0x6f, 0xaa, 0xf4,
-	0x7a, 0x73, 0xba, 0xd7, 0x17, 0x9f, 0x36, 0xe9, 0x73, 0xb9, 0xa5, 0x7f, 0xda, 0x44, 0xcc, 0xf6,
-	0x05, 0x34, 0xc5, 0x30, 0xc7, 0x96, 0xa0, 0xf3, 0x28, 0xa2, 0xf4, 0x3d, 0x4a, 0xec, 0x1a, 0x6b,
-	0x43, 0xfd, 0x38, 0x8f, 0x13, 0xdb, 0x60, 0x1d, 0x68, 0x3c, 0xc1, 0x69, 0xde, 0x36, 0x19, 0x40,
-	0x13, 0xab, 0xfd, 0x98, 0xdb, 0x16, 0xa2, 0x8f, 0x73, 0x2f, 0xcd, 0xed, 0x3a, 0xa2, 0x85, 0xfe,
-	0x76, 0x83, 0x2d, 0x03, 0x7c, 0x3c, 0xc9, 0x63, 0xc9, 0xd6, 0x44, 0xda, 0x3e, 0x0f, 0x79, 0xce,
-	0xed, 0xd6, 0xf6, 0x8f, 0xe8, 0xc8, 0x08, 0xc7, 0x87, 0x9e, 0xbc, 0x8b, 0x60, 0xbb, 0xc6, 0x5a,
-	0x60, 0x7d, 0xc6, 0xaf, 0x6c, 0x83, 0x75, 0xa1, 0xe5, 0x4e, 0xa2, 0x28, 0x88, 0x46, 0xe2, 0x3e,
-	0xba, 0x7a, 0x68, 0x5b, 0x48, 0x40, 0x85, 0x12, 0x3e, 0xb4, 0xeb, 0xac, 0x07, 0xed, 0x4f, 0xe4,
-	0xcf, 0x0e, 0x76, 0x03, 0x49, 0xc8, 0x86, 0x67, 0x9a, 0x48, 0xa2, 0xcb, 0x11, 0x6a, 0x21, 0x44,
-	0xa7, 0x10, 0x6a, 0x6f, 0x1f, 0x41, 0x5b, 0x6d, 0xae, 0x6c, 0x05, 0xba, 0x52, 0x07, 0x44, 0xd9,
-	0x35, 0x7c, 0x21, 0x1a, 0x36, 0x6c, 0x03, 0x5f, 0x1e, 0x77, 0x50, 0xdb, 0xc4, 0x27, 0x5c, 0x34,
-	0x6d, 0x8b, 0x0c, 0x72, 0x1d, 0xf9, 0x76, 0x1d, 0x19, 0x69, 0x61, 0xb1, 0x87, 0xdb, 0x8f, 0xa1,
-	0x45, 0x8f, 0x47, 0x38, 0x87, 0x2d, 0x4b, 0x79, 0x12, 0x63, 0xd7, 0xd0, 0xa6, 0x78, 0xbb, 0xe0,
-	0x36, 0xd0, 0x36, 0xf4, 0x3a, 0x02, 0x36, 0x51, 0x05, 0x61, 0x27, 0x81, 0xb0, 0xb6, 0x7f, 0x6c,
-	0x40, 0x5b, 0xad, 0x1a, 0x6c, 0x0d, 0x56, 0x94, 0x91, 0x24, 0x4a, 0x48, 0x3c, 0xe0, 0xb9, 0x40,
-	0xd8, 0x06, 0x5d, 0x50, 0x80, 0x26, 0xda, 0xd5, 0xe5, 0xe3, 0xf8, 0x92, 0x4b, 0x8c, 0x85, 0x57,
-	0xe2, 0x66, 0x2b, 0xe1, 0x3a, 0x1e, 0x40, 0x98, 0xaa, 0x8c, 0xdd, 0x60, 0xb7, 0x81, 0x21, 0xf8,
-	0x38, 0x18, 0x61, 0x24, 0x8b, 0xf9, 0x3f, 0xb3, 0x9b, 0xdb, 0x1f, 0x41, 0x5b, 0x8d, 0xd9, 0x9a,
-	0x1e, 0x0a, 0x55, 0xe8, 0x21, 0x10, 0xb6, 0x51, 0x5e, 0x2c, 0x31, 0xe6, 0xf6, 0x33, 0x5a, 0x4f,
-	0x71, 0x4a, 0xd5, 0x2c, 0x23, 0x31, 0x32, 0xbc, 0x2e, 0x82, 0x44, 0x3a, 0x9c, 0x27, 0xa1, 0xe7,
-	0x17, 0x01, 0x76, 0xc9, 0xd3, 0xdc, 0xb6, 0xf0, 0xf9, 0x51, 0xf4, 0x03, 0xee, 0x63, 0x84, 0xa1,
-	0x1b, 0x82, 0x2c, 0xb7, 0x1b, 0xdb, 0x87, 0xd0, 0x7d, 0xa6, 0x7a, 0xcc, 0x51, 0x82, 0x2f, 0xa0,
-	0x94, 0x2b, 0xb1, 0x76, 0x0d, 0xef, 0xa4, 0xe8, 0x2c, 0xb0, 0xb6, 0xc1, 0x56, 0x61, 0x09, 0xbd,
-	0x51, 0xa2, 0xcc, 0xed, 0xa7, 0xc0, 0x66, 0xab, 0x23, 0x1a, 0xad, 0x54, 0xd8, 0xae, 0xa1, 0x26,
-	0x9f, 0xf1, 0x2b, 0x7c, 0x26, 0x1f, 0x3e, 0x1a, 0x45, 0x71, 0xca, 0x89, 0xa6, 0x7c, 0x48, 0xdf,
-	0x17, 0x11, 0x61, 0x6d, 0x3f, 0x9b, 0xea, 0x23, 0x47, 0x89, 0x16, 0xee, 0x04, 0xdb, 0x35, 0x0a,
-	0x3e, 0x92, 0x22, 0x10, 0xd2, 0x80, 0x24, 0x46, 0x60, 0x4c, 0xbc, 0x68, 0x2f, 0xe4, 0x5e, 0x2a,
-	0x60, 0x6b, 0xf7, 0x1f, 0x4d, 0x68, 0x8a, 0xaa, 0xc0, 0x3e, 0x82, 0xae, 0xf6, 0x8b, 0x2f, 0xa3,
-	0x22, 0x3f, 0xfb, 0xfb, 0xf4, 0xc6, 0x7f, 0xcd, 0xe0, 0x45, 0x65, 0x72, 0x6a, 0xec, 0x43, 0x80,
-	0x72, 0xf1, 0x66, 0xb7, 0x68, 0x9a, 0x9b, 0x5e, 0xc4, 0x37, 0xfa, 0xf4, 0xc9, 0x66, 0xce, 0xaf,
-	0xd9, 0x4e, 0x8d, 0x7d, 0x07, 0x96, 0x64, 0xf9, 0x13, 0xa1, 0xc5, 0x06, 0xda, 0xda, 0x34, 0x67,
-	0xa5, 0xbe, 0x51, 0xd8, 0x27, 0x85, 0x30, 0x11, 0x3e, 0xac, 0x3f, 0x67, 0x07, 0x13, 0x62, 0x5e,
-	0x59, 0xb8, 0x9d, 0x39, 0x35, 0x76, 0x00, 0x5d, 0xb1, 0x43, 0x89, 0xa2, 0x7e, 0x07, 0x79, 0x17,
-	0x2d, 0x55, 0x37, 0x2a, 0xb4, 0x07, 0x3d, 0x7d, 0xed, 0x61, 0x64, 0xc9, 0x39, 0xfb, 0x91, 0x10,
-	0x32, 0x6f, 0x43, 0x72, 0x6a, 0xcc, 0x83, 0xdb, 0xf3, 0x97, 0x17, 0xf6, 0x7a, 0xf9, 0x6d, 0x79,
-	0xc1, 0xb6, 0xb4, 0xe1, 0xdc, 0xc4, 0x52, 0x5c, 0xf1, 0x3d, 0xe8, 0x17, 0x97, 0x17, 0x61, 0x2d,
-	0xa3, 0x62, 0x20, 0x55, 0x5b, 0xb0, 0xef, 0x6c, 0xbc, 0xb6, 0x90, 0x5e, 0x88, 0x3f, 0x81, 0xd5,
-	0x92, 0x21, 0x16, 0xe6, 0x63, 0x77, 0x67, 0xce, 0x55, 0xcc, 0x3a, 0x58, 0x44, 0x2e, 0xa4, 0x7e,
-	0xbf, 0xdc, 0xd8, 0xab, 0x92, 0x5f, 0xd7, 0x7d, 0x3b, 0x5f, 0xba, 0x73, 0x13, 0x4b, 0x71, 0xc3,
-	0x13, 0x58, 0xa9, 0xf4, 0x53, 0x25, 0xfb, 0xc6, 0x26, 0x7b, 0x53, 0x40, 0xdc, 0xef, 0x7f, 0xf1,
-	0xd5, 0xc0, 0xf8, 0xf2, 0xab, 0x81, 0xf1, 0xf7, 0xaf, 0x06, 0xc6, 0x4f, 0xbf, 0x1e, 0xd4, 0xbe,
-	0xfc, 0x7a, 0x50, 0xfb, 0xeb, 0xd7, 0x83, 0xda, 0x69, 0x93, 0xfe, 0x25, 0xf2, 0xbf, 0xff, 0x0a,
-	0x00, 0x00, 0xff, 0xff, 0x1d, 0x07, 0x2d, 0x0f, 0x37, 0x22, 0x00, 0x00,
+	// 3132 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x1a, 0x4d, 0x6f, 0x24, 0x47,
+	0x75, 0xba, 0xe7, 0xc3, 0x33, 0x6f, 0xc6, 0x76, 0xbb, 0xd6, 0xbb, 0xcc, 0x3a, 0xd9, 0x89, 0xd3,
+	0x1b, 0x05, 0xc7, 0x0a, 0x56, 0x62, 0x82, 0x82, 0x22, 0x41, 0x12, 0x7b, 0x36, 0xde, 0x0d, 0xe3,
+	0x78, 0xb7, 0xed, 0x6c, 0x4e, 0x48, 0xb4, 0x7b, 0xca, 0xe3, 0xc6, 0x3d, 0xdd, 0xbd, 0xdd, 0x3d,
+	0xb6, 0x7c, 0x40, 0xdc, 0xb8, 0xc2, 0x09, 0x09, 0xc4, 0x05, 0x24, 0xae, 0x1c, 0xf8, 0x01, 0x70,
+	0x83, 0x1c, 0x23, 0x4e, 0x9c, 0x22, 0x94, 0xfc, 0x0b, 0x0e, 0x08, 0xbd, 0x57, 0x55, 0xdd, 0xd5,
+	0xf3, 0xe1, 0xcd, 0x22, 0x71, 0xeb, 0xf7, 0x51, 0xaf, 0x5e, 0xbd, 0xaf, 0x7a, 0xaf, 0x66, 0x60,
+	0x65, 0x38, 0xbe, 0x8a, 0x92, 0x0b, 0x9e, 0xec, 0xc4, 0x49, 0x94, 0x45, 0xcc, 0x8c, 0x4f, 0xed,
+	0x2d, 0x60, 0x4f, 0x26, 0x3c, 0xb9, 0x3e, 0xce, 0xdc, 0x6c, 0x92, 0x3a, 0xfc, 0xd9, 0x84, 0xa7,
+	0x19, 0x63, 0x50, 0x0b, 0xdd, 0x31, 0xef, 0x1a, 0x9b, 0xc6, 0x56, 0xcb, 0xa1, 0x6f, 0x3b, 0x86,
+	0xf5, 0xfd, 0x68, 0x3c, 0x8e, 0xc2, 0xcf, 0x48, 0x86, 0xc3, 0xd3, 0x38, 0x0a, 0x53, 0xce, 0xee,
+	0x40, 0x23, 0xe1, 0xe9, 0x24, 0xc8, 0x88, 0xbb, 0xe9, 0x48, 0x88, 0x59, 0x50, 0x1d, 0xa7, 0xa3,
+	0xae, 0x49, 0x22, 0xf0, 0x13, 0x39, 0xd3, 0x68, 0x92, 0x78, 0xbc, 0x5b, 0x25, 0xa4, 0x84, 0x10,
+	0x2f, 0xf4, 0xea, 0xd6, 0x04, 0x5e, 0x40, 0xf6, 0x9f, 0x0c, 0xb8, 0x55, 0x52, 0xee, 0x85, 0x77,
+	0x7c, 0x07, 0x3a, 0x62, 0x0f, 0x21, 0x81, 0xf6, 0x6d, 0xef, 0x5a, 0x3b, 0xf1, 0xe9, 0xce, 0xb1,
+	0x86, 0x77, 0x4a, 0x5c, 0xec, 0x5d, 0x58, 0x4e, 0x27, 0xa7, 0x27, 0x6e, 0x7a, 0x21, 0x97, 0xd5,
+	0x36, 0xab, 0x5b, 0xed, 0xdd, 0x35, 0x5a, 0xa6, 0x13, 0x9c, 0x32, 0x9f, 0xfd, 0x47, 0x03, 0xda,
+	0xfb, 0xe7, 0xdc, 0x93, 0x30, 0x2a, 0x1a, 0xbb, 0x69, 0xca, 0x87, 0x4a, 0x51, 0x01, 0xb1, 0x75,
+	0xa8, 0x67, 0x51, 0xe6, 0x06, 0xa4, 0x6a, 0xdd, 0x11, 0x00, 0xeb, 0x01, 0xa4, 0x13, 0xcf, 0xe3,
+	0x69, 0x7a, 0x36, 0x09, 0x48, 0xd5, 0xba, 0xa3, 0x61, 0x50, 0xda, 0x99, 0xeb, 0x07, 0x7c, 0x48,
+	0x66, 0xaa, 0x3b, 0x12, 0x62, 0x5d, 0x58, 0xba, 0x72, 0x93, 0xd0, 0x0f, 0x47, 0xdd, 0x3a, 0x11,
+	0x14, 0x88, 0x2b, 0x86, 0x3c, 0x73, 0xfd, 0xa0, 0xdb, 0xd8, 0x34, 0xb6, 0x3a, 0x8e, 0x84, 0xec,
+	0xff, 0x18, 0x00, 0xfd, 0xc9, 0x38, 0x96, 0x6a, 0x6e, 0x42, 0x9b, 0x34, 0x38, 0x71, 0x4f, 0x03,
+	0x9e, 0x92, 0xae, 0x55, 0x47, 0x47, 0xb1, 0x2d, 0x58, 0xf5, 0xa2, 0x71, 0x1c, 0xf0, 0x8c, 0x0f,
+	0x25, 0x17, 0xaa, 0x6e, 0x38, 0xd3, 0x68, 0xf6, 0x1a, 0x2c, 0x9f, 0xf9, 0xa1, 0x9f, 0x9e, 0xf3,
+	0xe1, 0xde, 0x75, 0xc6, 0x85, 0xc9, 0x0d, 0xa7, 0x8c, 0x64, 0x36, 0x74, 0x14, 0xc2, 0x89, 0xae,
+	0x52, 0x3a, 0x90, 0xe1, 0x94, 0x70, 0xec, 0x4d, 0x58, 0xe3, 0x69, 0xe6, 0x8f, 0xdd, 0x8c, 0x9f,
+	0xa0, 0x2a, 0xc4, 0x58, 0x27, 0xc6, 0x59, 0x02, 0xfa, 0xfe, 0x34, 0x4e, 0xe9, 0x9c, 0x55, 0x07,
+	0x3f, 0xd9, 0x06, 0x34, 0xe3, 0x24, 0x1a, 0x25, 0x3c, 0x4d, 0xbb, 0x4b, 0x14, 0x12, 0x39, 0x6c,
+	0x7f, 0x6e, 0x00, 0x0c, 0x22, 0x77, 0x28, 0x0d, 0x30, 0xa3, 0xb4, 0x30, 0xc1, 0x94, 0xd2, 0x3d,
+	0x00, 0xb2, 0x89, 0x60, 0x31, 0x89, 0x45, 0xc3, 0x94, 0x36, 0xac, 0x96, 0x37, 0xc4, 0xb5, 0x63,
+	0x9e, 0xb9, 0x7b, 0x7e, 0x18, 0x44, 0x23, 0x19, 0xe6, 0x1a, 0x86, 0xbd, 0x0e, 0x2b, 0x05, 0x74,
+	0x70, 0xf2, 0xa8, 0x4f, 0x27, 0x6d, 0x39, 0x53, 0xd8, 0xd9, 0x63, 0xda, 0x7f, 0x35, 0x60, 0xf9,
+	0xf8, 0xdc, 0x4d, 0x86, 0x7e, 0x38, 0x3a, 0x48, 0xa2, 0x49, 0x8c, 0x5e, 0xcf, 0xdc, 0x64, 0xc4,
+	0x33, 0x99, 0xbe, 0x12, 0xc2, 0xa4, 0xee, 0xf7, 0x07, 0xa8, 0x79, 0x15, 0x93, 0x1a, 0xbf, 0xc5,
+	0xc9, 0x93, 0x34, 0x1b, 0x44, 0x9e, 0x9b, 0xf9, 0x51, 0x28, 0x15, 0x2f, 0x23, 0x29, 0x71, 0xaf,
+	0x43, 0x8f, 0x22, 0xaf, 0x4a, 0x89, 0x4b, 0x10, 0x9e, 0x78, 0x12, 0x4a, 0x4a, 0x9d, 0x28, 0x39,
+	0x8c, 0x21, 0x13, 0x44, 0xde, 0xc5, 0xe3, 0x28, 0xf0, 0x3d, 0x4a, 0x60, 0x4e, 0x5a, 0xb7, 0x9c,
+	0x69, 0xb4, 0x7d, 0x0a, 0x16, 0x1d, 0xa0, 0x7f, 0x38, 0xf8, 0xd0, 0xcb, 0xfc, 0x4b, 0x3f, 0xbb,
+	0x5e, 0x78, 0x86, 0x97, 0xa1, 0x15, 0xb8, 0x69, 0xd6, 0x3f, 0x1c, 0x9c, 0x28, 0x17, 0x14, 0x08,
+	0xcc, 0x84, 0xd4, 0x0f, 0x3d, 0x7e, 0x22, 0x1c, 0x50, 0x75, 0x14, 0x88, 0xa5, 0xe4, 0xb6, 0x30,
+	0xe3, 0x83, 0x24, 0x89, 0x12, 0x67, 0x12, 0x70, 0x87, 0x7b, 0x51, 0x32, 0x9c, 0x57, 0xea, 0xd8,
+	0x4b, 0x60, 0x46, 0x31, 0x89, 0x5f, 0xd9, 0x6d, 0x63, 0xd6, 0xd3, 0xa2, 0xa3, 0xd8, 0x31, 0xa3,
+	0x18, 0x55, 0x38, 0x25, 0x49, 0x8f, 0x23, 0xe5, 0xe7, 0x02, 0x81, 0xd4, 0x28, 0xf1, 0x47, 0x7e,
+	0x78, 0xfc, 0x64, 0x20, 0xfd, 0x5c, 0x20, 0x30, 0xf1, 0x39, 0x8a, 0x92, 0xde, 0x15, 0x00, 0xaa,
+	0x90, 0xf9, 0x63, 0x2e, 0xbd, 0x4a, 0xdf, 0xf6, 0x97, 0x0d, 0x80, 0xe3, 0xeb, 0xd0, 0x9b, 0x4a,
+	0xd1, 0x07, 0x97, 0x3c, 0xcc, 0xca, 0x29, 0x2a, 0x50, 0xe8, 0x0b, 0x91, 0xb1, 0xb1, 0x32, 0x4c,
+	0x0e, 0xa3, 0x52, 0x09, 0xf7, 0x78, 0x98, 0x21, 0x51, 0x58, 0xa6, 0x40, 0x60, 0x32, 0x8e, 0xdd,
+	0x34, 0xe3, 0x49, 0x29, 0x3a, 0x4b, 0x38, 0xb6, 0x0d, 0x96, 0x0e, 0x1f, 0x64, 0xfe, 0x50, 0x9e,
+	0x61, 0x06, 0x8f, 0xf2, 0x28, 0x06, 0x94, 0x3c, 0xe1, 0xf6, 0x12, 0x0e, 0xe5, 0xe9, 0x30, 0xc9,
+	0x13, 0x49, 0x3a, 0x83, 0x47, 0x79, 0xa7, 0x18, 0x33, 0x7e, 0x38, 0xa2, 0xf8, 0x6d, 0x52, 0xa4,
+	0x95, 0x70, 0xec, 0x07, 0x60, 0x4d, 0xc2, 0x84, 0xa7, 0x51, 0x70, 0xc9, 0x87, 0x94, 0x06, 0x69,
+	0xb7, 0xa5, 0x55, 0x6d, 0x3d, 0x41, 0x9c, 0x19, 0x56, 0x2d, 0xc0, 0x41, 0x14, 0x6a, 0x19, 0xc4,
+	0x3d, 0x00, 0xe1, 0xda, 0x93, 0xeb, 0x98, 0x77, 0xdb, 0x22, 0x6d, 0x0b, 0x0c, 0x7b, 0x0b, 0x6e,
+	0xa5, 0xdc, 0x8b, 0xc2, 0x61, 0xba, 0xc7, 0xcf, 0xfd, 0x70, 0x78, 0x48, 0xb6, 0xe8, 0x76, 0xc8,
+	0xc4, 0xf3, 0x48, 0x98, 0x70, 0xa4, 0x78, 0xbf, 0x3f, 0x38, 0xba, 0x0a, 0x79, 0xd2, 0x5d, 0x16,
+	0x09, 0x57, 0x42, 0xa2, 0xbb, 0xbd, 0x28, 0x3c, 0x0b, 0x7c, 0x2f, 0x3b, 0x4c, 0x47, 0xdd, 0x15,
+	0xe2, 0xd1, 0x51, 0xe8, 0xd2, 0x2c, 0xaf, 0x8a, 0xab, 0xc2, 0xa5, 0x39, 0x22, 0x0f, 0x06, 0x27,
+	0x4e, 0xbb, 0x96, 0x16, 0x0c, 0x8e, 0x1e, 0x0c, 0x48, 0x5c, 0xd3, 0x83, 0xc1, 0x11, 0xc1, 0xe0,
+	0x47, 0x27, 0x45, 0x99, 0x63, 0x9b, 0xc6, 0x56, 0xcd, 0x29, 0xe1, 0xd0, 0x79, 0xc3, 0xc9, 0x38,
+	0x7e, 0x74, 0xa4, 0xf1, 0xdd, 0x22, 0xbe, 0x19, 0x3c, 0xeb, 0x03, 0x4b, 0xcb, 0xc9, 0xed, 0xf3,
+	0xb4, 0xbb, 0x4e, 0xae, 0x59, 0xcf, 0x5d, 0xa3, 0xa5, 0xbe, 0x33, 0x87, 0x9f, 0x3d, 0x81, 0x3b,
+	0xa7, 0xf3, 0xb2, 0x37, 0xed, 0xde, 0x26, 0x49, 0x77, 0x51, 0xd2, 0xdc, 0xfc, 0x76, 0x16, 0x2c,
+	0xb4, 0x7f, 0x67, 0x40, 0x47, 0xef, 0x01, 0xb4, 0xee, 0xc4, 0x58, 0xd0, 0x9d, 0x98, 0x7a, 0x77,
+	0xc2, 0xde, 0xc8, 0xbb, 0x10, 0xd1, 0x55, 0x50, 0xa0, 0x3d, 0x4e, 0x22, 0xbc, 0xae, 0x1d, 0x22,
+	0xe4, 0x8d, 0xc9, 0xdb, 0xd0, 0x4e, 0x78, 0xe0, 0x5e, 0xe7, 0xed, 0x04, 0xf2, 0xaf, 0x22, 0xbf,
+	0x53, 0xa0, 0x1d, 0x9d, 0xc7, 0xfe, 0xbb, 0x09, 0x6d, 0x8d, 0x38, 0x93, 0xa4, 0xc6, 0x37, 0x4c,
+	0x52, 0x73, 0x41, 0x92, 0x6e, 0x2a, 0x95, 0x26, 0xa7, 0x7d, 0x3f, 0x91, 0x75, 0x4c, 0x47, 0xe5,
+	0x1c, 0xa5, 0xaa, 0xa0, 0xa3, 0xb0, 0xc4, 0x6b, 0xa0, 0x56, 0x13, 0xa6, 0xd1, 0x6c, 0x07, 0x18,
+	0xa1, 0xf6, 0xdd, 0xcc, 0x3b, 0xff, 0x34, 0x96, 0x69, 0xd2, 0xa0, 0x5c, 0x9b, 0x43, 0x61, 0xaf,
+	0x40, 0x3d, 0xcd, 0xdc, 0x11, 0xa7, 0x9a, 0xb0, 0xb2, 0xdb, 0xa2, 0x40, 0x41, 0x84, 0x23, 0xf0,
+	0x9a, 0xf1, 0x9b, 0xcf, 0x31, 0xbe, 0xfd, 0xe7, 0x2a, 0x2c, 0x97, 0xba, 0xb6, 0xb9, 0x25, 0x3f,
+	0xdf, 0xd1, 0x5c, 0xb0, 0xe3, 0x26, 0xd4, 0x26, 0xa1, 0x2f, 0x9c, 0xbd, 0xb2, 0xdb, 0x41, 0xfa,
+	0xa7, 0xa1, 0x9f, 0x61, 0x19, 0x70, 0x88, 0xa2, 0xe9, 0x54, 0x7b, 0x5e, 0x40, 0xbc, 0x05, 0xb7,
+	0x8a, 0x1a, 0xd4, 0xef, 0x0f, 0x06, 0x91, 0x77, 0x91, 0xdf, 0xf9, 0xf3, 0x48, 0x8c, 0x89, 0xde,
+	0x96, 0x6a, 0xe9, 0xc3, 0x8a, 0xe8, 0x6e, 0xbf, 0x0d, 0x75, 0x0f, 0xbb, 0x4d, 0xb2, 0x92, 0x0c,
+	0x28, 0xad, 0xfd, 0x7c, 0x58, 0x71, 0x04, 0x9d, 0xbd, 0x06, 0x35, 0x4c, 0x4c, 0x69, 0xab, 0x15,
+	0xe4, 0x2b, 0xda, 0xbf, 0x87, 0x15, 0x87, 0xa8, 0xc8, 0x15, 0x44, 0xee, 0xb0, 0xdb, 0x2a, 0xb8,
+	0x8a, 0x1e, 0x09, 0xb9, 0x90, 0x8a, 0x5c, 0x58, 0x1c, 0xa9, 0x50, 0x4a, 0xae, 0xe2, 0x9e, 0x42,
+	0x2e, 0xa4, 0xb2, 0x77, 0x00, 0x2e, 0xdd, 0xc0, 0x1f, 0x8a, 0xa6, 0xa2, 0x4d, 0xbc, 0x94, 0xee,
+	0x4f, 0x73, 0xac, 0x8c, 0x7a, 0x8d, 0x6f, 0xaf, 0x09, 0x8d, 0x54, 0x84, 0xff, 0x0f, 0x61, 0xad,
+	0xe4, 0xb3, 0x81, 0x9f, 0x92, 0x81, 0x05, 0xb9, 0x6b, 0x2c, 0x6a, 0xc8, 0xd5, 0xfa, 0x1e, 0x00,
+	0x59, 0x82, 0xd2, 0x5e, 0x0d, 0x06, 0x46, 0x3e, 0x18, 0xd8, 0xf7, 0xa0, 0x85, 0x16, 0xb8, 0x81,
+	0x8c, 0x47, 0x5f, 0x44, 0x8e, 0xa1, 0x43, 0x67, 0x7e, 0x32, 0x58, 0xc0, 0xc1, 0x76, 0x61, 0x5d,
+	0x74, 0xe7, 0x7b, 0xaa, 0x33, 0xf0, 0xc9, 0x12, 0x22, 0x1d, 0xe7, 0xd2, 0xb0, 0x68, 0x53, 0x3f,
+	0x80, 0x9d, 0x83, 0xec, 0x1f, 0x15, 0x6c, 0x7f, 0x0f, 0x5a, 0xb8, 0xa3, 0xd8, 0x6e, 0x0b, 0x1a,
+	0x44, 0x50, 0x76, 0xb0, 0x72, 0x27, 0x48, 0x85, 0x1c, 0x49, 0xb7, 0x7f, 0x69, 0x40, 0x5b, 0x14,
+	0x39, 0xb1, 0xf2, 0x45, 0x6b, 0xdc, 0x66, 0x69, 0xb9, 0xaa, 0x12, 0xba, 0xc4, 0x1d, 0x00, 0x2a,
+	0x53, 0x82, 0xa1, 0x56, 0x04, 0x45, 0x81, 0x75, 0x34, 0x0e, 0x74, 0x4c, 0x01, 0xcd, 0x31, 0xed,
+	0x6f, 0x4c, 0xe8, 0x48, 0x97, 0x3e, 0x50, 0xcd, 0xd1, 0xff, 0x23, 0x59, 0x65, 0x3e, 0xd5, 0xf4,
+	0x7c, 0x7a, 0x5d, 0xe5, 0x53, 0xbd, 0x38, 0x46, 0x11, 0x45, 0x45, 0x3a, 0xdd, 0x97, 0xe9, 0xd4,
+	0x20, 0xb6, 0x65, 0x95, 0x4e, 0x8a, 0x4b, 0x64, 0xd3, 0x7d, 0x99, 0x4d, 0x4b, 0x05, 0x53, 0x1e,
+	0x52, 0x79, 0x32, 0xdd, 0x97, 0xc9, 0xd4, 0x2c, 0x98, 0x72, 0x37, 0xab, 0x5c, 0xda, 0x5b, 0x92,
+	0x4d, 0xa3, 0xfd, 0x1e, 0x58, 0xba, 0x69, 0x28, 0x27, 0x5e, 0x57, 0x1d, 0xa5, 0x1e, 0x0a, 0x1a,
+	0x93, 0xec, 0x31, 0xed, 0x67, 0xb0, 0x5c, 0x2a, 0x45, 0xd8, 0xda, 0xf8, 0xe9, 0xbe, 0x1b, 0x7a,
+	0x3c, 0xc8, 0xe7, 0x53, 0x0d, 0xa3, 0x05, 0x99, 0x59, 0x48, 0x96, 0x22, 0x4a, 0x41, 0xa6, 0x4d,
+	0x99, 0xd5, 0xd2, 0x94, 0xf9, 0x0f, 0x03, 0x3a, 0xfa, 0x02, 0x6c, 0xcf, 0x1f, 0x24, 0xc9, 0x7e,
+	0x34, 0x14, 0xde, 0xac, 0x3b, 0x0a, 0xc4, 0xd0, 0xc7, 0xcf, 0xc0, 0x4d, 0x53, 0x19, 0x81, 0x39,
+	0x2c, 0x69, 0xc7, 0x5e, 0x14, 0xab, 0x77, 0x83, 0x1c, 0x96, 0xb4, 0x01, 0xbf, 0xe4, 0x81, 0xbc,
+	0xa0, 0x72, 0x18, 0x77, 0x3b, 0xe4, 0x69, 0x8a, 0x61, 0x22, 0xea, 0xaa, 0x02, 0x71, 0x95, 0xe3,
+	0x5e, 0xed, 0xbb, 0x93, 0x54, 0xcd, 0x24, 0x39, 0x8c, 0x66, 0xf9, 0x2c, 0x4a, 0x2e, 0xdc, 0x24,
+	0x9a, 0x84, 0xaa, 0x25, 0xd5, 0x30, 0xf6, 0x15, 0xac, 0x3d, 0x9e, 0x24, 0x23, 0x4e, 0x41, 0xac,
+	0x9e, 0x4b, 0x36, 0xa0, 0xe9, 0x87, 0x2e, 0xb6, 0x2b, 0x5c, 0x5a, 0x32, 0x87, 0xf3, 0xe6, 0xde,
+	0x2c, 0x9a, 0x7b, 0xe4, 0x3f, 0xf3, 0x03, 0x4e, 0x71, 0x2d, 0x8f, 0xa4, 0x60, 0x4a, 0x51, 0x71,
+	0x27, 0xcb, 0xc7, 0x10, 0x01, 0xd9, 0xbf, 0x35, 0x61, 0xe3, 0x28, 0xe6, 0x89, 0x9b, 0x71, 0xf1,
+	0x00, 0x73, 0xec, 0x9d, 0xf3, 0xb1, 0xab, 0x54, 0x78, 0x99, 0x46, 0x16, 0xa3, 0x88, 0x77, 0x41,
+	0x96, 0x33, 0x0b, 0x2a, 0xe1, 0xa6, 0x17, 0xd2, 0xb6, 0xf4, 0xbd, 0xf0, 0x35, 0x66, 0x03, 0x9a,
+	0x43, 0x37, 0x73, 0x4f, 0xdd, 0x94, 0x2b, 0x9b, 0x2a, 0x98, 0x1e, 0x2e, 0x70, 0xce, 0x57, 0xf3,
+	0x0b, 0x01, 0x24, 0x89, 0x76, 0x93, 0xd6, 0x94, 0x10, 0x72, 0x9f, 0x05, 0x93, 0xf4, 0x9c, 0xcc,
+	0xd8, 0x74, 0x04, 0x80, 0xba, 0xe4, 0x31, 0xdf, 0x94, 0xd7, 0x45, 0x0f, 0xe0, 0x2c, 0x89, 0xc6,
+	0xa2, 0xb0, 0xd0, 0x05, 0xd4, 0x74, 0x34, 0x8c, 0xa2, 0x9f, 0x88, 0x91, 0x10, 0x0a, 0xba, 0xc0,
+	0xd8, 0x19, 0x2c, 0x3f, 0x7d, 0x5b, 0x86, 0xfd, 0x21, 0xcf, 0x5c, 0xb6, 0xa1, 0x99, 0x03, 0xd0,
+	0x1c, 0x48, 0x91, 0xc6, 0x78, 0x6e, 0xf5, 0x50, 0x25, 0xa7, 0xaa, 0x95, 0x1c, 0x65, 0xc1, 0x1a,
+	0x85, 0x38, 0x7d, 0xdb, 0xef, 0xc0, 0xba, 0xf4, 0xc8, 0xd3, 0xb7, 0x71, 0xd7, 0x85, 0xbe, 0x10,
+	0x64, 0xb1, 0xbd, 0xfd, 0x37, 0x03, 0x6e, 0x4f, 0x2d, 0x7b, 0xe1, 0x77, 0xad, 0x77, 0xa1, 0x36,
+	0xe6, 0x99, 0xdb, 0xad, 0x52, 0x6a, 0xde, 0xc7, 0x3d, 0xe6, 0x8a, 0xdc, 0x41, 0xe0, 0x41, 0x98,
+	0x25, 0xd7, 0x0e, 0x2d, 0xd8, 0xf8, 0x18, 0x5a, 0x39, 0x0a, 0xe5, 0x5e, 0xf0, 0x6b, 0x55, 0x7d,
+	0x2f, 0xf8, 0x35, 0x76, 0x14, 0x97, 0x6e, 0x30, 0x11, 0xa6, 0x91, 0x17, 0x6c, 0xc9, 0xb0, 0x8e,
+	0xa0, 0xbf, 0x67, 0x7e, 0xdf, 0xb0, 0x7f, 0x06, 0xdd, 0x87, 0x6e, 0x38, 0x0c, 0x64, 0x3c, 0x8a,
+	0xa2, 0x20, 0x4d, 0xf0, 0x92, 0x66, 0x82, 0x99, 0x09, 0x7a, 0x5e, 0x34, 0xde, 0x3c, 0x55, 0x63,
+	0xcc, 0x3c, 0x0b, 0x52, 0xf9, 0xfc, 0x40, 0xdf, 0xf6, 0x6d, 0xb8, 0x75, 0xc0, 0x33, 0xb1, 0xf7,
+	0xfe, 0xd9, 0x48, 0xee, 0x6c, 0x6f, 0xc1, 0x7a, 0x19, 0x2d, 0x8d, 0x6b, 0x41, 0xd5, 0x3b, 0xcb,
+	0xaf, 0x1a, 0xef, 0x6c, 0x64, 0x1f, 0xc3, 0x3d, 0xd1, 0x2d, 0x4d, 0x4e, 0x51, 0x05, 0x2c, 0x7d,
+	0x9f, 0xc6, 0x43, 0x37, 0xe3, 0xea, 0x10, 0xbb, 0xb0, 0x9e, 0x0a, 0xda, 0xfe, 0xd9, 0xe8, 0x24,
+	0x1a, 0x07, 0xc7, 0x59, 0xe2, 0x87, 0x4a, 0xc6, 0x5c, 0x9a, 0x3d, 0x80, 0xde, 0x22, 0xa1, 0x52,
+	0x91, 0x2e, 0x2c, 0xc9, 0x47, 0x3d, 0xe9, 0x66, 0x05, 0xce, 0xfa, 0xd9, 0x1e, 0xc1, 0xc6, 0x01,
+	0xcf, 0x66, 0x7a, 0xa6, 0xa2, 0xec, 0xe0, 0x1e, 0x9f, 0x14, 0xd7, 0x63, 0x0e, 0xb3, 0xef, 0x40,
+	0xe7, 0xcc, 0x0f, 0x32, 0x9e, 0xc8, 0x99, 0x63, 0x26, 0xd6, 0x4b, 0x64, 0xfb, 0xcb, 0x2a, 0x58,
+	0xd3, 0xdb, 0xe4, 0x7e, 0x32, 0xe6, 0x56, 0x0d, 0xb3, 0x54, 0x35, 0x18, 0xd4, 0xc6, 0x58, 0xd8,
+	0x65, 0xce, 0xe0, 0x77, 0x91, 0x68, 0xb5, 0x05, 0x89, 0xb6, 0x05, 0xab, 0xb2, 0xfb, 0x8b, 0xd4,
+	0x5c, 0x23, 0x07, 0x88, 0x29, 0x34, 0x36, 0xcc, 0x53, 0x28, 0x1a, 0x37, 0x44, 0xbd, 0x99, 0x47,
+	0xd2, 0xba, 0xf1, 0xa5, 0x6f, 0xd0, 0x8d, 0xc7, 0x82, 0x20, 0x9e, 0x1e, 0xa5, 0xc9, 0x9a, 0x42,
+	0xf8, 0x1c, 0x12, 0x7b, 0x13, 0xd6, 0x62, 0x1e, 0x0e, 0xfd, 0x70, 0xa4, 0xf1, 0xb7, 0x88, 0x7f,
+	0x96, 0x80, 0xc7, 0xa4, 0xab, 0x52, 0xe3, 0x05, 0x71, 0xcc, 0x29, 0x34, 0x4e, 0x70, 0xde, 0x24,
+	0x8b, 0x2e, 0xd5, 0xa8, 0x86, 0xc9, 0x20, 0x5e, 0x1d, 0x66, 0xf0, 0xa8, 0x43, 0x09, 0x47, 0x06,
+	0xe9, 0x08, 0x1d, 0x66, 0x08, 0xf6, 0x1f, 0x0c, 0xb8, 0x5d, 0x38, 0x98, 0x1e, 0x6b, 0x9f, 0x33,
+	0xf7, 0x6e, 0x40, 0x33, 0x4d, 0x3c, 0xe2, 0x54, 0x77, 0xb2, 0x82, 0xe9, 0x8e, 0x48, 0x33, 0x41,
+	0x93, 0x17, 0x98, 0x82, 0x9f, 0xef, 0xf5, 0x2e, 0x2c, 0x8d, 0xcb, 0x17, 0xb3, 0x04, 0xed, 0xbf,
+	0x18, 0xf0, 0xd2, 0xdc, 0x78, 0xff, 0x1f, 0x1e, 0xfe, 0x21, 0x0f, 0x8a, 0x54, 0x96, 0xc9, 0x9b,
+	0xe7, 0x0f, 0xec, 0x64, 0xde, 0x87, 0xe5, 0xac, 0xb0, 0x0c, 0x57, 0x0f, 0xff, 0x77, 0xcb, 0x0b,
+	0x35, 0xe3, 0x39, 0x65, 0x7e, 0xfb, 0x02, 0xee, 0x96, 0xf4, 0x2f, 0xd5, 0xc4, 0x5d, 0xea, 0xef,
+	0xc5, 0x53, 0xa8, 0xa8, 0x8c, 0x77, 0x34, 0xc1, 0xa2, 0x9f, 0x26, 0xaa, 0x93, 0xf3, 0x95, 0x52,
+	0xdc, 0x2c, 0xa7, 0xb8, 0xfd, 0x7b, 0x13, 0x56, 0xa7, 0xb6, 0x62, 0x2b, 0x60, 0xfa, 0x43, 0xe9,
+	0x48, 0xd3, 0x1f, 0x2e, 0x4c, 0x57, 0xdd, 0xb9, 0xd5, 0x29, 0xe7, 0x62, 0x81, 0x4a, 0xbc, 0xbe,
+	0x9b, 0xb9, 0xf2, 0xfe, 0x57, 0x60, 0xc9, 0xed, 0xf5, 0x29, 0xb7, 0x77, 0x61, 0x69, 0x98, 0x66,
+	0xb4, 0x4a, 0x64, 0xa5, 0x02, 0xb1, 0xb4, 0x53, 0x9c, 0xd3, 0x1b, 0x9a, 0xe8, 0xa8, 0x0a, 0x04,
+	0xdb, 0xc9, 0x87, 0xba, 0xe6, 0x8d, 0x36, 0x91, 0x5c, 0x79, 0x3f, 0xd5, 0x92, 0x45, 0x09, 0xfb,
+	0x29, 0x2d, 0xa2, 0xa0, 0x1c, 0x51, 0xcf, 0xa6, 0x0a, 0xa8, 0x74, 0xc8, 0x0b, 0xc7, 0xd3, 0x1b,
+	0xaa, 0xcd, 0x16, 0xa1, 0x74, 0xab, 0x1c, 0x11, 0xa5, 0x4e, 0xfb, 0xd7, 0x06, 0xdc, 0x53, 0x97,
+	0xf1, 0xfc, 0x40, 0xb8, 0xaf, 0x5d, 0x8e, 0xb3, 0x92, 0xe4, 0x25, 0x49, 0xfd, 0xf9, 0x87, 0x41,
+	0x20, 0x06, 0x2b, 0x53, 0xf5, 0xe7, 0x0a, 0x53, 0x8a, 0x8c, 0xea, 0x54, 0xf1, 0x17, 0xcf, 0xcc,
+	0x8f, 0xc4, 0x0f, 0x45, 0x35, 0x47, 0x00, 0xf6, 0xc7, 0xd0, 0x5b, 0xa4, 0xd7, 0x8b, 0xda, 0xc3,
+	0xbe, 0x86, 0x7b, 0xe2, 0x5a, 0x2b, 0x44, 0xa9, 0x9f, 0x05, 0x9f, 0x7f, 0x37, 0x95, 0xee, 0x7a,
+	0x73, 0xfa, 0xae, 0xcf, 0xdf, 0x5c, 0xe9, 0x67, 0x90, 0xaa, 0xfe, 0xe6, 0x8a, 0x98, 0xed, 0x0b,
+	0x68, 0x88, 0x66, 0x8e, 0x2d, 0x43, 0xeb, 0x51, 0x48, 0xe9, 0x7b, 0x14, 0x5b, 0x15, 0xd6, 0x84,
+	0xda, 0x71, 0x16, 0xc5, 0x96, 0xc1, 0x5a, 0x50, 0x7f, 0x8c, 0xdd, 0xbc, 0x65, 0x32, 0x80, 0x06,
+	0x56, 0xfb, 0x31, 0xb7, 0xaa, 0x88, 0x3e, 0xce, 0xdc, 0x24, 0xb3, 0x6a, 0x88, 0x16, 0xfa, 0x5b,
+	0x75, 0xb6, 0x02, 0xf0, 0xe1, 0x24, 0x8b, 0x24, 0x5b, 0x03, 0x69, 0x7d, 0x1e, 0xf0, 0x8c, 0x5b,
+	0x4b, 0xdb, 0x3f, 0xa7, 0x25, 0x23, 0x6c, 0x1f, 0x3a, 0x72, 0x2f, 0x82, 0xad, 0x0a, 0x5b, 0x82,
+	0xea, 0x27, 0xfc, 0xca, 0x32, 0x58, 0x1b, 0x96, 0x9c, 0x49, 0x18, 0xfa, 0xe1, 0x48, 0xec, 0x47,
+	0x5b, 0x0f, 0xad, 0x2a, 0x12, 0x50, 0xa1, 0x98, 0x0f, 0xad, 0x1a, 0xeb, 0x40, 0xf3, 0x23, 0xf9,
+	0x73, 0x92, 0x55, 0x47, 0x12, 0xb2, 0xe1, 0x9a, 0x06, 0x92, 0x68, 0x73, 0x84, 0x96, 0x10, 0xa2,
+	0x55, 0x08, 0x35, 0xb7, 0x8f, 0xa0, 0xa9, 0x26, 0x57, 0xb6, 0x0a, 0x6d, 0xa9, 0x03, 0xa2, 0xac,
+	0x0a, 0x1e, 0x88, 0x9a, 0x0d, 0xcb, 0xc0, 0xc3, 0xe3, 0x0c, 0x6a, 0x99, 0xf8, 0x85, 0x83, 0xa6,
+	0x55, 0x25, 0x83, 0x5c, 0x87, 0x9e, 0x55, 0x43, 0x46, 0x1a, 0x58, 0xac, 0xe1, 0xf6, 0x21, 0x2c,
+	0xd1, 0xe7, 0x11, 0xf6, 0x61, 0x2b, 0x52, 0x9e, 0xc4, 0x58, 0x15, 0xb4, 0x29, 0xee, 0x2e, 0xb8,
+	0x0d, 0xb4, 0x0d, 0x1d, 0x47, 0xc0, 0x26, 0xaa, 0x20, 0xec, 0x24, 0x10, 0xd5, 0xed, 0x5f, 0x18,
+	0xd0, 0x54, 0xa3, 0x06, 0xbb, 0x05, 0xab, 0xca, 0x48, 0x12, 0x25, 0x24, 0x1e, 0xf0, 0x4c, 0x20,
+	0x2c, 0x83, 0x36, 0xc8, 0x41, 0x13, 0xed, 0xea, 0xf0, 0x71, 0x74, 0xc9, 0x25, 0xa6, 0x8a, 0x5b,
+	0xe2, 0x64, 0x2b, 0xe1, 0x1a, 0x2e, 0x40, 0x98, 0xaa, 0x8c, 0x55, 0x67, 0x77, 0x80, 0x21, 0x78,
+	0xe8, 0x8f, 0x30, 0x92, 0x45, 0xff, 0x9f, 0x5a, 0x8d, 0xed, 0x0f, 0xa0, 0xa9, 0xda, 0x6c, 0x4d,
+	0x0f, 0x85, 0xca, 0xf5, 0x10, 0x08, 0xcb, 0x28, 0x36, 0x96, 0x18, 0x73, 0xfb, 0x29, 0x8d, 0xa7,
+	0xd8, 0xa5, 0x6a, 0x96, 0x91, 0x18, 0x19, 0x5e, 0x17, 0x7e, 0x2c, 0x1d, 0xce, 0xe3, 0xc0, 0xf5,
+	0xf2, 0x00, 0xbb, 0xe4, 0x49, 0x66, 0x55, 0xf1, 0xfb, 0x51, 0xf8, 0x53, 0xee, 0x61, 0x84, 0xa1,
+	0x1b, 0xfc, 0x34, 0xb3, 0xea, 0xdb, 0x03, 0x68, 0x3f, 0x55, 0x77, 0xcc, 0x51, 0x8c, 0x07, 0x50,
+	0xca, 0x15, 0x58, 0xab, 0x82, 0x7b, 0x52, 0x74, 0xe6, 0x58, 0xcb, 0x60, 0x6b, 0xb0, 0x8c, 0xde,
+	0x28, 0x50, 0xe6, 0xf6, 0x13, 0x60, 0xb3, 0xd5, 0x11, 0x8d, 0x56, 0x28, 0x6c, 0x55, 0x50, 0x93,
+	0x4f, 0xf8, 0x15, 0x7e, 0x93, 0x0f, 0x1f, 0x8d, 0xc2, 0x28, 0xe1, 0x44, 0x53, 0x3e, 0xa4, 0xf7,
+	0x45, 0x44, 0x54, 0xb7, 0x9f, 0x4e, 0xdd, 0x23, 0x47, 0xb1, 0x16, 0xee, 0x04, 0x5b, 0x15, 0x0a,
+	0x3e, 0x92, 0x22, 0x10, 0xd2, 0x80, 0x24, 0x46, 0x60, 0x4c, 0xdc, 0x68, 0x3f, 0xe0, 0x6e, 0x22,
+	0xe0, 0xea, 0xee, 0xbf, 0x1b, 0xd0, 0x10, 0x55, 0x81, 0x7d, 0x00, 0x6d, 0xed, 0x97, 0x7c, 0x46,
+	0x45, 0x7e, 0xf6, 0x7f, 0x07, 0x1b, 0xdf, 0x9a, 0xc1, 0x8b, 0xca, 0x64, 0x57, 0xd8, 0xfb, 0x00,
+	0xc5, 0xe0, 0xcd, 0x6e, 0x53, 0x37, 0x37, 0x3d, 0x88, 0x6f, 0x74, 0xe9, 0xc9, 0x66, 0xce, 0xbf,
+	0x14, 0xec, 0x0a, 0xfb, 0x11, 0x2c, 0xcb, 0xf2, 0x27, 0x42, 0x8b, 0xf5, 0xb4, 0xb1, 0x69, 0xce,
+	0x48, 0x7d, 0xa3, 0xb0, 0x8f, 0x72, 0x61, 0x22, 0x7c, 0x58, 0x77, 0xce, 0x0c, 0x26, 0xc4, 0xdc,
+	0x5d, 0x38, 0x9d, 0xd9, 0x15, 0x76, 0x00, 0x6d, 0x31, 0x43, 0x89, 0xa2, 0xfe, 0x32, 0xf2, 0x2e,
+	0x1a, 0xaa, 0x6e, 0x54, 0x68, 0x1f, 0x3a, 0xfa, 0xd8, 0xc3, 0xc8, 0x92, 0x73, 0xe6, 0x23, 0x21,
+	0x64, 0xde, 0x84, 0x64, 0x57, 0x98, 0x0b, 0x77, 0xe6, 0x0f, 0x2f, 0xec, 0xd5, 0xe2, 0x6d, 0x79,
+	0xc1, 0xb4, 0xb4, 0x61, 0xdf, 0xc4, 0x92, 0x6f, 0xf1, 0x63, 0xe8, 0xe6, 0x9b, 0xe7, 0x61, 0x2d,
+	0xa3, 0xa2, 0x27, 0x55, 0x5b, 0x30, 0xef, 0x6c, 0xbc, 0xb2, 0x90, 0x9e, 0x8b, 0x3f, 0x81, 0xb5,
+	0x82, 0x21, 0x12, 0xe6, 0x63, 0xf7, 0x66, 0xd6, 0x95, 0xcc, 0xda, 0x5b, 0x44, 0xce, 0xa5, 0xfe,
+	0xa4, 0x98, 0xd8, 0xcb, 0x92, 0x5f, 0xd5, 0x7d, 0x3b, 0x5f, 0xba, 0x7d, 0x13, 0x4b, 0xbe, 0xc3,
+	0x63, 0x58, 0x2d, 0xdd, 0xa7, 0x4a, 0xf6, 0x8d, 0x97, 0xec, 0x4d, 0x01, 0xb1, 0xd7, 0xfd, 0xfc,
+	0xab, 0x9e, 0xf1, 0xc5, 0x57, 0x3d, 0xe3, 0x5f, 0x5f, 0xf5, 0x8c, 0x5f, 0x7d, 0xdd, 0xab, 0x7c,
+	0xf1, 0x75, 0xaf, 0xf2, 0xcf, 0xaf, 0x7b, 0x95, 0xd3, 0x06, 0xfd, 0xfb, 0xe7, 0xbb, 0xff, 0x0d,
+	0x00, 0x00, 0xff, 0xff, 0x87, 0xb2, 0xe0, 0xb4, 0x0f, 0x24, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -4647,6 +4829,13 @@ func (m *ShardingGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LockPolicyState) > 0 {
+		i -= len(m.LockPolicyState)
+		copy(dAtA[i:], m.LockPolicyState)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.LockPolicyState)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Unsynced) > 0 {
 		for iNdEx := len(m.Unsynced) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Unsynced[iNdEx])
@@ -4691,7 +4880,7 @@ func (m *ShardingGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SyncStatus) Marshal() (dAtA []byte, err error) {
+func (m *ShardDMLActivity) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4701,57 +4890,190 @@ func (m *SyncStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SyncStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *ShardDMLActivity) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SyncStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ShardDMLActivity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DumpIOTotalBytes != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.DumpIOTotalBytes))
+	if m.SinceTs != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.SinceTs))
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x98
+		dAtA[i] = 0x18
 	}
-	if m.IoTotalBytes != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.IoTotalBytes))
-		i--
-		dAtA[i] = 0x1
+	if m.LastDMLTs != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.LastDMLTs))
 		i--
-		dAtA[i] = 0x90
+		dAtA[i] = 0x10
 	}
-	if m.RecentRps != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.RecentRps))
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Target)))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BinlogErrorRuleRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BinlogErrorRuleRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BinlogErrorRuleRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Time != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Time))
 		i--
-		dAtA[i] = 0x88
+		dAtA[i] = 0x30
 	}
-	if m.TotalRps != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.TotalRps))
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Error)))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x2a
+	}
+	if len(m.OriginSQL) > 0 {
+		i -= len(m.OriginSQL)
+		copy(dAtA[i:], m.OriginSQL)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.OriginSQL)))
 		i--
-		dAtA[i] = 0x80
+		dAtA[i] = 0x22
 	}
-	if m.TotalRows != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.TotalRows))
+	if len(m.BinlogPos) > 0 {
+		i -= len(m.BinlogPos)
+		copy(dAtA[i:], m.BinlogPos)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.BinlogPos)))
 		i--
-		dAtA[i] = 0x78
+		dAtA[i] = 0x1a
 	}
-	if len(m.ConflictMsg) > 0 {
-		i -= len(m.ConflictMsg)
-		copy(dAtA[i:], m.ConflictMsg)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.ConflictMsg)))
+	if m.Op != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Op))
 		i--
-		dAtA[i] = 0x72
+		dAtA[i] = 0x10
 	}
-	if len(m.BlockDDLOwner) > 0 {
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BinlogErrorRuleRecords) > 0 {
+		for iNdEx := len(m.BinlogErrorRuleRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BinlogErrorRuleRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmworker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.ShardDMLActivities) > 0 {
+		for iNdEx := len(m.ShardDMLActivities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ShardDMLActivities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmworker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.DumpIOTotalBytes != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.DumpIOTotalBytes))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.IoTotalBytes != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.IoTotalBytes))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.RecentRps != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.RecentRps))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.TotalRps != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.TotalRps))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.TotalRows != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.TotalRows))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.ConflictMsg) > 0 {
+		i -= len(m.ConflictMsg)
+		copy(dAtA[i:], m.ConflictMsg)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.ConflictMsg)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.BlockDDLOwner) > 0 {
 		i -= len(m.BlockDDLOwner)
 		copy(dAtA[i:], m.BlockDDLOwner)
 		i = encodeVarintDmworker(dAtA, i, uint64(len(m.BlockDDLOwner)))
@@ -7023,6 +7345,60 @@ func (m *ShardingGroup) Size() (n int) {
 			n += 1 + l + sovDmworker(uint64(l))
 		}
 	}
+	l = len(m.LockPolicyState)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *ShardDMLActivity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.LastDMLTs != 0 {
+		n += 1 + sovDmworker(uint64(m.LastDMLTs))
+	}
+	if m.SinceTs != 0 {
+		n += 1 + sovDmworker(uint64(m.SinceTs))
+	}
+	return n
+}
+
+func (m *BinlogErrorRuleRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.Op != 0 {
+		n += 1 + sovDmworker(uint64(m.Op))
+	}
+	l = len(m.BinlogPos)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.OriginSQL)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.Time != 0 {
+		n += 1 + sovDmworker(uint64(m.Time))
+	}
 	return n
 }
 
@@ -7102,6 +7478,18 @@ func (m *SyncStatus) Size() (n int) {
 	if m.DumpIOTotalBytes != 0 {
 		n += 2 + sovDmworker(uint64(m.DumpIOTotalBytes))
 	}
+	if len(m.ShardDMLActivities) > 0 {
+		for _, e := range m.ShardDMLActivities {
+			l = e.Size()
+			n += 2 + l + sovDmworker(uint64(l))
+		}
+	}
+	if len(m.BinlogErrorRuleRecords) > 0 {
+		for _, e := range m.BinlogErrorRuleRecords {
+			l = e.Size()
+			n += 2 + l + sovDmworker(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -9185,6 +9573,38 @@ func (m *ShardingGroup) Unmarshal(dAtA []byte) error {
 			}
 			m.Unsynced = append(m.Unsynced, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockPolicyState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockPolicyState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmworker(dAtA[iNdEx:])
@@ -9206,7 +9626,7 @@ func (m *ShardingGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncStatus) Unmarshal(dAtA []byte) error {
+func (m *ShardDMLActivity) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9229,17 +9649,17 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShardDMLActivity: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShardDMLActivity: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalEvents", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
-			m.TotalEvents = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -9249,16 +9669,29 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalEvents |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalTps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDMLTs", wireType)
 			}
-			m.TotalTps = 0
+			m.LastDMLTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -9268,16 +9701,16 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalTps |= int64(b&0x7F) << shift
+				m.LastDMLTs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecentTps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceTs", wireType)
 			}
-			m.RecentTps = 0
+			m.SinceTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -9287,14 +9720,64 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RecentTps |= int64(b&0x7F) << shift
+				m.SinceTs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BinlogErrorRuleRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BinlogErrorRuleRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BinlogErrorRuleRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MasterBinlog", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9322,7 +9805,280 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MasterBinlog = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			}
+			m.Op = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Op |= ErrorOp(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinlogPos", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BinlogPos = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginSQL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginSQL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalEvents", wireType)
+			}
+			m.TotalEvents = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalEvents |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalTps", wireType)
+			}
+			m.TotalTps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalTps |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentTps", wireType)
+			}
+			m.RecentTps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecentTps |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterBinlog", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MasterBinlog = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
@@ -9716,6 +10472,74 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardDMLActivities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShardDMLActivities = append(m.ShardDMLActivities, &ShardDMLActivity{})
+			if err := m.ShardDMLActivities[len(m.ShardDMLActivities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinlogErrorRuleRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BinlogErrorRuleRecords = append(m.BinlogErrorRuleRecords, &BinlogErrorRuleRecord{})
+			if err := m.BinlogErrorRuleRecords[len(m.BinlogErrorRuleRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmworker(dAtA[iNdEx:])
@@ -14,6 +14,8 @@
 package binlog
 
 import (
+	"context"
+	"io"
 	"path"
 
 	"github.com/go-mysql-org/go-mysql/mysql"
@@ -32,6 +34,15 @@ import (
 // FakeBinlogName is used to bypass the checking of meta in task config when start-task with --start-time.
 const FakeBinlogName = "start-task with --start-time"
 
+// RelayLogFileOpener opens the relay log files, it's used to read the relay
+// log files whose content has been moved to external storage.
+type RelayLogFileOpener interface {
+	// Open opens the relay log file.
+	Open(ctx context.Context, fullPath string) (io.ReadSeekCloser, error)
+	// Size returns the size of the relay log file.
+	Size(ctx context.Context, fullPath string) (int64, error)
+}
+
 type binlogPosFinder struct {
 	remote     bool
 	tctx       *tcontext.Context
@@ -45,6 +56,8 @@ type binlogPosFinder struct {
 
 	// fields used for local relay
 	relayDir string // should be a directory with current UUID
+	// opener is used to read the relay log files if it's not nil
+	opener RelayLogFileOpener
 
 	// fields used inside FindByTimestamp
 	targetBinlog        binlogSize // target binlog file the timestamp may reside
@@ -78,7 +91,11 @@ const (
 	AboveUpperBoundBinlogPos
 )
 
-func NewLocalBinlogPosFinder(tctx *tcontext.Context, enableGTID bool, flavor string, relayDir string) *binlogPosFinder {
+// NewLocalBinlogPosFinder creates a finder which scans the relay log files in relayDir,
+// the files are read by opener if it's not nil.
+func NewLocalBinlogPosFinder(
+	tctx *tcontext.Context, enableGTID bool, flavor string, relayDir string, opener RelayLogFileOpener,
+) *binlogPosFinder {
 	parser := replication.NewBinlogParser()
 	parser.SetFlavor(flavor)
 	parser.SetVerifyChecksum(true)
@@ -91,6 +108,7 @@ func NewLocalBinlogPosFinder(tctx *tcontext.Context, enableGTID bool, flavor str
 		flavor:     flavor,
 
 		relayDir: relayDir,
+		opener:   opener,
 	}
 }
 
@@ -121,7 +139,22 @@ func (r *binlogPosFinder) getBinlogFiles() (FileSizes, error) {
 	if r.remote {
 		return GetBinaryLogs(r.tctx, r.db)
 	}
-	return GetLocalBinaryLogs(r.relayDir)
+	if r.opener == nil {
+		return GetLocalBinaryLogs(r.relayDir)
+	}
+	fileNames, err := ReadSortedBinlogFromDir(r.relayDir)
+	if err != nil {
+		return nil, err
+	}
+	files := make([]binlogSize, 0, len(fileNames))
+	for _, fileName := range fileNames {
+		size, err := r.opener.Size(r.tctx.Ctx, path.Join(r.relayDir, fileName))
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, binlogSize{name: fileName, size: size})
+	}
+	return files, nil
 }
 
 func (r *binlogPosFinder) startSync(position mysql.Position) (reader.Reader, error) {
@@ -129,7 +162,13 @@ func (r *binlogPosFinder) startSync(position mysql.Position) (reader.Reader, err
 		binlogReader := reader.NewTCPReader(r.syncCfg)
 		return binlogReader, binlogReader.StartSyncByPos(position)
 	}
-	binlogReader := reader.NewFileReader(&reader.FileReaderConfig{EnableRawMode: true})
+	cfg := &reader.FileReaderConfig{EnableRawMode: true}
+	if r.opener != nil {
+		cfg.OpenFile = func(name string) (io.ReadSeekCloser, error) {
+			return r.opener.Open(r.tctx.Ctx, name)
+		}
+	}
+	binlogReader := reader.NewFileReader(cfg)
 	position.Name = path.Join(r.relayDir, position.Name)
 	return binlogReader, binlogReader.StartSyncByPos(position)
 }
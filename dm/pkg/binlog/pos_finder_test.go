@@ -17,6 +17,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"path"
 	"strconv"
@@ -117,7 +118,7 @@ func TestTransBoundary(t *testing.T) {
 
 	{
 		tcctx := tcontext.NewContext(context.Background(), log.L())
-		finder := NewLocalBinlogPosFinder(tcctx, false, flavor, relayDir)
+		finder := NewLocalBinlogPosFinder(tcctx, false, flavor, relayDir, nil)
 		location, posType, err := finder.FindByTimestamp(ts)
 		require.Nil(t, err)
 		// start of second transaction
@@ -158,7 +159,7 @@ func TestMySQL56NoGTID(t *testing.T) {
 			}
 			targetEventStart = ev.Header.LogPos
 		}
-		finder := NewLocalBinlogPosFinder(tcctx, false, flavor, relayDir)
+		finder := NewLocalBinlogPosFinder(tcctx, false, flavor, relayDir, nil)
 		location, posType, err := finder.FindByTimestamp(int64(targetEvent.Header.Timestamp))
 		require.Nil(t, err)
 		require.Equal(t, mysql.Position{Name: "mysql-bin.000001", Pos: targetEventStart}, location.Position)
@@ -167,7 +168,7 @@ func TestMySQL56NoGTID(t *testing.T) {
 	}
 	{
 		targetEventStart := file2Events[len(file2Events)-1].Header.LogPos
-		finder := NewLocalBinlogPosFinder(tcctx, false, flavor, relayDir)
+		finder := NewLocalBinlogPosFinder(tcctx, false, flavor, relayDir, nil)
 		location, posType, err := finder.FindByTimestamp(int64(file3Events[0].Header.Timestamp))
 		require.Nil(t, err)
 		require.Equal(t, mysql.Position{Name: "mysql-bin.000002", Pos: targetEventStart}, location.Position)
@@ -184,7 +185,7 @@ func TestMySQL56NoGTID(t *testing.T) {
 			}
 			targetEventStart = ev.Header.LogPos
 		}
-		finder := NewLocalBinlogPosFinder(tcctx, false, flavor, relayDir)
+		finder := NewLocalBinlogPosFinder(tcctx, false, flavor, relayDir, nil)
 		location, posType, err := finder.FindByTimestamp(int64(targetEvent.Header.Timestamp))
 		require.Nil(t, err)
 		require.Equal(t, mysql.Position{Name: "mysql-bin.000003", Pos: targetEventStart}, location.Position)
@@ -228,7 +229,7 @@ func TestMySQL57NoGTID(t *testing.T) {
 			}
 			targetEventStart = ev.Header.LogPos
 		}
-		finder := NewLocalBinlogPosFinder(tcctx, false, flavor, relayDir)
+		finder := NewLocalBinlogPosFinder(tcctx, false, flavor, relayDir, nil)
 		location, posType, err := finder.FindByTimestamp(int64(targetEvent.Header.Timestamp))
 		require.Nil(t, err)
 		require.Equal(t, mysql.Position{Name: "mysql-bin.000003", Pos: targetEventStart}, location.Position)
@@ -244,12 +245,12 @@ func TestErrorCase(t *testing.T) {
 	beforeTime := time.Now()
 	tcctx := tcontext.NewContext(context.Background(), log.L())
 	{
-		finder := NewLocalBinlogPosFinder(tcctx, true, flavor, relayDir+"not-exist")
+		finder := NewLocalBinlogPosFinder(tcctx, true, flavor, relayDir+"not-exist", nil)
 		_, _, err := finder.FindByTimestamp(beforeTime.Add(-time.Minute).Unix())
 		require.Regexp(t, ".*no such file or directory.*", err.Error())
 	}
 	{
-		finder := NewLocalBinlogPosFinder(tcctx, true, flavor, t.TempDir())
+		finder := NewLocalBinlogPosFinder(tcctx, true, flavor, t.TempDir(), nil)
 		_, _, err := finder.FindByTimestamp(beforeTime.Add(-time.Minute).Unix())
 		require.Regexp(t, ".*cannot find binlog files.*", err.Error())
 	}
@@ -257,7 +258,7 @@ func TestErrorCase(t *testing.T) {
 		file, err := os.Create(path.Join(relayDir, "mysql-bin.000001"))
 		require.Nil(t, err)
 		file.Close()
-		finder := NewLocalBinlogPosFinder(tcctx, true, flavor, relayDir)
+		finder := NewLocalBinlogPosFinder(tcctx, true, flavor, relayDir, nil)
 		_, _, err = finder.FindByTimestamp(beforeTime.Add(-time.Minute).Unix())
 		require.Equal(t, "EOF", err.Error())
 	}
@@ -285,7 +286,7 @@ func TestMySQL57GTID(t *testing.T) {
 	tcctx := tcontext.NewContext(context.Background(), log.L())
 
 	{
-		finder := NewLocalBinlogPosFinder(tcctx, true, flavor, relayDir)
+		finder := NewLocalBinlogPosFinder(tcctx, true, flavor, relayDir, nil)
 		location, posType, err := finder.FindByTimestamp(beforeTime.Add(-time.Minute).Unix())
 		require.Nil(t, err)
 		require.Equal(t, mysql.Position{Name: "mysql-bin.000001", Pos: 4}, location.Position)
@@ -305,7 +306,7 @@ func TestMySQL57GTID(t *testing.T) {
 			if ev.Header.EventType == replication.GTID_EVENT {
 				targetEvent = ev
 
-				finder := NewLocalBinlogPosFinder(tcctx, true, flavor, relayDir)
+				finder := NewLocalBinlogPosFinder(tcctx, true, flavor, relayDir, nil)
 				location, posType, err := finder.FindByTimestamp(int64(targetEvent.Header.Timestamp))
 				require.Nil(t, err)
 				require.Equal(t, mysql.Position{Name: "mysql-bin.000001", Pos: targetEventStart}, location.Position)
@@ -319,7 +320,7 @@ func TestMySQL57GTID(t *testing.T) {
 	}
 	{
 		targetEventStart := file2Events[len(file2Events)-1].Header.LogPos
-		finder := NewLocalBinlogPosFinder(tcctx, true, flavor, relayDir)
+		finder := NewLocalBinlogPosFinder(tcctx, true, flavor, relayDir, nil)
 		location, posType, err := finder.FindByTimestamp(int64(file3Events[0].Header.Timestamp))
 		require.Nil(t, err)
 		require.Equal(t, mysql.Position{Name: "mysql-bin.000002", Pos: targetEventStart}, location.Position)
@@ -341,7 +342,7 @@ func TestMySQL57GTID(t *testing.T) {
 			}
 			targetEventStart = ev.Header.LogPos
 		}
-		finder := NewLocalBinlogPosFinder(tcctx, true, flavor, relayDir)
+		finder := NewLocalBinlogPosFinder(tcctx, true, flavor, relayDir, nil)
 		location, posType, err := finder.FindByTimestamp(int64(targetEvent.Header.Timestamp))
 		require.Nil(t, err)
 		require.Equal(t, mysql.Position{Name: "mysql-bin.000003", Pos: targetEventStart}, location.Position)
@@ -349,7 +350,7 @@ func TestMySQL57GTID(t *testing.T) {
 		require.Equal(t, InRangeBinlogPos, posType)
 	}
 	{
-		finder := NewLocalBinlogPosFinder(tcctx, true, flavor, relayDir)
+		finder := NewLocalBinlogPosFinder(tcctx, true, flavor, relayDir, nil)
 		location, posType, err := finder.FindByTimestamp(beforeTime.Add(+time.Minute).Unix())
 
 		require.Nil(t, err)
@@ -380,7 +381,7 @@ func TestMariadbGTID(t *testing.T) {
 	tcctx := tcontext.NewContext(context.Background(), log.L())
 
 	{
-		finder := NewLocalBinlogPosFinder(tcctx, true, flavor, relayDir)
+		finder := NewLocalBinlogPosFinder(tcctx, true, flavor, relayDir, nil)
 		location, posType, err := finder.FindByTimestamp(beforeTime.Add(-time.Minute).Unix())
 		require.Nil(t, err)
 		require.Equal(t, mysql.Position{Name: "mysql-bin.000001", Pos: 4}, location.Position)
@@ -402,7 +403,7 @@ func TestMariadbGTID(t *testing.T) {
 			}
 			targetEventStart = ev.Header.LogPos
 		}
-		finder := NewLocalBinlogPosFinder(tcctx, true, flavor, relayDir)
+		finder := NewLocalBinlogPosFinder(tcctx, true, flavor, relayDir, nil)
 		location, posType, err := finder.FindByTimestamp(int64(targetEvent.Header.Timestamp))
 		require.Nil(t, err)
 		require.Equal(t, mysql.Position{Name: "mysql-bin.000001", Pos: targetEventStart}, location.Position)
@@ -411,7 +412,7 @@ func TestMariadbGTID(t *testing.T) {
 	}
 	{
 		targetEventStart := file2Events[len(file2Events)-1].Header.LogPos
-		finder := NewLocalBinlogPosFinder(tcctx, true, flavor, relayDir)
+		finder := NewLocalBinlogPosFinder(tcctx, true, flavor, relayDir, nil)
 		location, posType, err := finder.FindByTimestamp(int64(file3Events[0].Header.Timestamp))
 		require.Nil(t, err)
 		require.Equal(t, mysql.Position{Name: "mysql-bin.000002", Pos: targetEventStart}, location.Position)
@@ -433,7 +434,7 @@ func TestMariadbGTID(t *testing.T) {
 			}
 			targetEventStart = ev.Header.LogPos
 		}
-		finder := NewLocalBinlogPosFinder(tcctx, true, flavor, relayDir)
+		finder := NewLocalBinlogPosFinder(tcctx, true, flavor, relayDir, nil)
 		location, posType, err := finder.FindByTimestamp(int64(targetEvent.Header.Timestamp))
 		require.Nil(t, err)
 		require.Equal(t, mysql.Position{Name: "mysql-bin.000003", Pos: targetEventStart}, location.Position)
@@ -441,10 +442,87 @@ func TestMariadbGTID(t *testing.T) {
 		require.Equal(t, InRangeBinlogPos, posType)
 	}
 	{
-		finder := NewLocalBinlogPosFinder(tcctx, true, flavor, relayDir)
+		finder := NewLocalBinlogPosFinder(tcctx, true, flavor, relayDir, nil)
 		location, posType, err := finder.FindByTimestamp(beforeTime.Add(+time.Minute).Unix())
 		require.Nil(t, err)
 		require.Nil(t, location)
 		require.Equal(t, AboveUpperBoundBinlogPos, posType)
 	}
 }
+
+// mockRelayLogFileOpener keeps the content of the offloaded relay log files,
+// whose local files are empty placeholders.
+type mockRelayLogFileOpener struct {
+	offloaded map[string][]byte
+}
+
+type bytesReadSeekCloser struct {
+	*bytes.Reader
+}
+
+func (bytesReadSeekCloser) Close() error { return nil }
+
+func (o *mockRelayLogFileOpener) Open(_ context.Context, fullPath string) (io.ReadSeekCloser, error) {
+	if data, ok := o.offloaded[fullPath]; ok {
+		return bytesReadSeekCloser{bytes.NewReader(data)}, nil
+	}
+	return os.Open(fullPath)
+}
+
+func (o *mockRelayLogFileOpener) Size(_ context.Context, fullPath string) (int64, error) {
+	if data, ok := o.offloaded[fullPath]; ok {
+		return int64(len(data)), nil
+	}
+	fi, err := os.Stat(fullPath)
+	if err != nil {
+		return 0, err
+	}
+	return fi.Size(), nil
+}
+
+func TestOffloadedRelayLogFiles(t *testing.T) {
+	t.Parallel()
+	flavor := "mysql"
+	relayDir := t.TempDir()
+	beforeTime := time.Now()
+	latestGTIDStr := "ffffffff-ffff-ffff-ffff-ffffffffffff:1"
+
+	generator, _ := event.NewGeneratorV2(flavor, "5.6.0", latestGTIDStr, false)
+	opener := &mockRelayLogFileOpener{offloaded: make(map[string][]byte)}
+
+	// the first two files are offloaded to external storage.
+	file1Events, data := genBinlogFile(generator, beforeTime, "mysql-bin.000002")
+	opener.offloaded[path.Join(relayDir, "mysql-bin.000001")] = data
+	require.NoError(t, os.WriteFile(path.Join(relayDir, "mysql-bin.000001"), nil, 0o644))
+	file2Events, data := genBinlogFile(generator, beforeTime.Add(5*time.Second), "mysql-bin.000003")
+	opener.offloaded[path.Join(relayDir, "mysql-bin.000002")] = data
+	require.NoError(t, os.WriteFile(path.Join(relayDir, "mysql-bin.000002"), nil, 0o644))
+	file3Events, data := genBinlogFile(generator, beforeTime.Add(10*time.Second), "mysql-bin.000004")
+	require.NoError(t, os.WriteFile(path.Join(relayDir, "mysql-bin.000003"), data, 0o644))
+
+	tcctx := tcontext.NewContext(context.Background(), log.L())
+	{
+		var targetEventStart uint32
+		var targetEvent *replication.BinlogEvent
+		for _, ev := range file1Events {
+			if e, ok := ev.Event.(*replication.QueryEvent); ok && string(e.Query) == "BEGIN" {
+				targetEvent = ev
+				break
+			}
+			targetEventStart = ev.Header.LogPos
+		}
+		finder := NewLocalBinlogPosFinder(tcctx, false, flavor, relayDir, opener)
+		location, posType, err := finder.FindByTimestamp(int64(targetEvent.Header.Timestamp))
+		require.NoError(t, err)
+		require.Equal(t, mysql.Position{Name: "mysql-bin.000001", Pos: targetEventStart}, location.Position)
+		require.Equal(t, InRangeBinlogPos, posType)
+	}
+	{
+		targetEventStart := file2Events[len(file2Events)-1].Header.LogPos
+		finder := NewLocalBinlogPosFinder(tcctx, false, flavor, relayDir, opener)
+		location, posType, err := finder.FindByTimestamp(int64(file3Events[0].Header.Timestamp))
+		require.NoError(t, err)
+		require.Equal(t, mysql.Position{Name: "mysql-bin.000002", Pos: targetEventStart}, location.Position)
+		require.Equal(t, InRangeBinlogPos, posType)
+	}
+}
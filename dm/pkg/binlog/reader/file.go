@@ -17,8 +17,10 @@
 package reader
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"io"
 	"sync"
 	"time"
 
@@ -41,10 +43,11 @@ type FileReader struct {
 	readOffset atomic.Uint32
 	sendOffset atomic.Uint32
 
-	parser *replication.BinlogParser
-	ch     chan *replication.BinlogEvent
-	ech    chan error
-	endCh  chan struct{}
+	parser   *replication.BinlogParser
+	openFile func(name string) (io.ReadSeekCloser, error)
+	ch       chan *replication.BinlogEvent
+	ech      chan error
+	endCh    chan struct{}
 
 	logger log.Logger
 
@@ -58,6 +61,8 @@ type FileReaderConfig struct {
 	Timezone      *time.Location
 	ChBufferSize  int // event channel's buffer size
 	EchBufferSize int // error channel's buffer size
+	// OpenFile opens the binlog file to read, the file is opened by os.Open if it's nil.
+	OpenFile func(name string) (io.ReadSeekCloser, error)
 }
 
 // FileReaderStatus represents the status of a FileReader.
@@ -86,11 +91,12 @@ func NewFileReader(cfg *FileReaderConfig) Reader {
 		parser.SetTimestampStringLocation(cfg.Timezone)
 	}
 	return &FileReader{
-		parser: parser,
-		ch:     make(chan *replication.BinlogEvent, cfg.ChBufferSize),
-		ech:    make(chan error, cfg.EchBufferSize),
-		endCh:  make(chan struct{}),
-		logger: log.With(zap.String("component", "binlog file reader")),
+		parser:   parser,
+		openFile: cfg.OpenFile,
+		ch:       make(chan *replication.BinlogEvent, cfg.ChBufferSize),
+		ech:      make(chan error, cfg.EchBufferSize),
+		endCh:    make(chan struct{}),
+		logger:   log.With(zap.String("component", "binlog file reader")),
 	}
 }
 
@@ -109,7 +115,7 @@ func (r *FileReader) StartSyncByPos(pos gmysql.Position) error {
 	r.wg.Add(1)
 	go func() {
 		defer r.wg.Done()
-		err := r.parser.ParseFile(pos.Name, int64(pos.Pos), r.onEvent)
+		err := r.parseFile(pos.Name, int64(pos.Pos))
 		if err != nil {
 			if errors.Cause(err) != context.Canceled {
 				r.logger.Error("fail to parse binlog file", zap.Error(err))
@@ -128,6 +134,40 @@ func (r *FileReader) StartSyncByPos(pos gmysql.Position) error {
 	return nil
 }
 
+// parseFile is like BinlogParser.ParseFile, but it opens the file by openFile if it's set.
+func (r *FileReader) parseFile(name string, offset int64) error {
+	if r.openFile == nil {
+		return r.parser.ParseFile(name, offset, r.onEvent)
+	}
+
+	f, err := r.openFile(name)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer f.Close()
+
+	b := make([]byte, len(replication.BinLogFileHeader))
+	if _, err = io.ReadFull(f, b); err != nil {
+		return errors.Trace(err)
+	} else if !bytes.Equal(b, replication.BinLogFileHeader) {
+		return errors.Errorf("%s is not a valid binlog file, head 4 bytes must fe'bin' ", name)
+	}
+
+	headerLen := int64(len(replication.BinLogFileHeader))
+	if offset < headerLen {
+		offset = headerLen
+	} else if offset > headerLen {
+		// FORMAT_DESCRIPTION event should always be read by default (despite that fact passed offset may be higher than 4)
+		if _, err = r.parser.ParseSingleEvent(f, r.onEvent); err != nil {
+			return errors.Annotatef(err, "parse FormatDescriptionEvent")
+		}
+	}
+	if _, err = f.Seek(offset, io.SeekStart); err != nil {
+		return errors.Errorf("seek %s to %d error %v", name, offset, err)
+	}
+	return r.parser.ParseReader(f, r.onEvent)
+}
+
 // StartSyncByGTID implements Reader.StartSyncByGTID.
 func (r *FileReader) StartSyncByGTID(gSet gmysql.GTIDSet) error {
 	// NOTE: may be supported later.
@@ -14,6 +14,7 @@
 package reader
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -208,6 +209,63 @@ func TestGetEvent(t *testing.T) {
 	require.Nil(t, e)
 }
 
+type nopReadSeekCloser struct {
+	io.ReadSeeker
+}
+
+func (nopReadSeekCloser) Close() error { return nil }
+
+func TestOpenFile(t *testing.T) {
+	t.Parallel()
+	timeoutCtx, timeoutCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer timeoutCancel()
+
+	// the binlog file only exists in memory.
+	var buf bytes.Buffer
+	buf.Write(replication.BinLogFileHeader)
+	header := &replication.EventHeader{
+		Timestamp: uint32(time.Now().Unix()),
+		ServerID:  uint32(101),
+	}
+	formatDescEv, err := event.GenFormatDescriptionEvent(header, uint32(buf.Len()))
+	require.NoError(t, err)
+	buf.Write(formatDescEv.RawData)
+	queryEv, err := event.GenQueryEvent(header, formatDescEv.Header.LogPos, 0, 0, 0, nil, []byte("schema"), []byte("query"))
+	require.NoError(t, err)
+	buf.Write(queryEv.RawData)
+
+	filename := "mysql-bin-test.000001"
+	cfg := &FileReaderConfig{
+		OpenFile: func(name string) (io.ReadSeekCloser, error) {
+			if name != filename {
+				return nil, os.ErrNotExist
+			}
+			return nopReadSeekCloser{bytes.NewReader(buf.Bytes())}, nil
+		},
+	}
+
+	// read from the middle
+	r := NewFileReader(cfg)
+	require.NoError(t, r.StartSyncByPos(gmysql.Position{Name: filename, Pos: formatDescEv.Header.LogPos}))
+	e, err := r.GetEvent(timeoutCtx)
+	require.NoError(t, err)
+	require.Equal(t, formatDescEv.RawData, e.RawData) // always got a FormatDescriptionEvent first
+	e, err = r.GetEvent(timeoutCtx)
+	require.NoError(t, err)
+	require.Equal(t, queryEv.RawData, e.RawData)
+	e, err = r.GetEvent(timeoutCtx)
+	require.True(t, terror.ErrReaderReachEndOfFile.Equal(err))
+	require.Nil(t, e)
+	require.NoError(t, r.Close())
+
+	// the file can't be opened
+	r = NewFileReader(cfg)
+	require.NoError(t, r.StartSyncByPos(gmysql.Position{Name: "not-exist"}))
+	_, err = r.GetEvent(timeoutCtx)
+	require.ErrorIs(t, err, os.ErrNotExist)
+	require.NoError(t, r.Close())
+}
+
 func TestWithChannelBuffer(t *testing.T) {
 	t.Parallel()
 	var (
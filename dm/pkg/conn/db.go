@@ -215,6 +215,21 @@ func GetServerUnixTS(ctx context.Context, db *BaseDB) (int64, error) {
 	return ts, err
 }
 
+// GetTiDBMemoryUsage gets the used memory and the memory limit of the TiDB server from
+// `INFORMATION_SCHEMA.MEMORY_USAGE`, the limit falls back to the total memory when
+// `tidb_server_memory_limit` is not set.
+func GetTiDBMemoryUsage(ctx context.Context, db *BaseDB) (used int64, limit int64, err error) {
+	var total int64
+	row := db.DB.QueryRowContext(ctx, "SELECT MEMORY_CURRENT, MEMORY_LIMIT, MEMORY_TOTAL FROM INFORMATION_SCHEMA.MEMORY_USAGE")
+	if err = row.Scan(&used, &limit, &total); err != nil {
+		return 0, 0, terror.DBErrorAdapt(err, db.Scope, terror.ErrDBDriverError)
+	}
+	if limit <= 0 {
+		limit = total
+	}
+	return used, limit, nil
+}
+
 // GetMariaDBUUID gets equivalent `server_uuid` for MariaDB
 // `gtid_domain_id` joined `server_id` with domainServerIDSeparator.
 func GetMariaDBUUID(ctx *tcontext.Context, db *BaseDB) (string, error) {
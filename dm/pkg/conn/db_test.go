@@ -146,6 +146,38 @@ func TestGetServerUnixTS(t *testing.T) {
 	require.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestGetTiDBMemoryUsage(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	baseDB := NewBaseDBForTest(db)
+
+	query := "SELECT MEMORY_CURRENT, MEMORY_LIMIT, MEMORY_TOTAL FROM INFORMATION_SCHEMA.MEMORY_USAGE"
+	mock.ExpectQuery(query).WillReturnRows(
+		sqlmock.NewRows([]string{"MEMORY_CURRENT", "MEMORY_LIMIT", "MEMORY_TOTAL"}).AddRow(300, 800, 1000))
+	used, limit, err := GetTiDBMemoryUsage(ctx, baseDB)
+	require.NoError(t, err)
+	require.Equal(t, int64(300), used)
+	require.Equal(t, int64(800), limit)
+
+	// no memory limit, use the total memory
+	mock.ExpectQuery(query).WillReturnRows(
+		sqlmock.NewRows([]string{"MEMORY_CURRENT", "MEMORY_LIMIT", "MEMORY_TOTAL"}).AddRow(300, 0, 1000))
+	used, limit, err = GetTiDBMemoryUsage(ctx, baseDB)
+	require.NoError(t, err)
+	require.Equal(t, int64(300), used)
+	require.Equal(t, int64(1000), limit)
+
+	// not TiDB
+	mock.ExpectQuery(query).WillReturnError(newMysqlErr(tmysql.ErrUnknownTable, "Unknown table 'MEMORY_USAGE' in information_schema"))
+	_, _, err = GetTiDBMemoryUsage(ctx, baseDB)
+	require.True(t, IsMySQLError(err, tmysql.ErrUnknownTable))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestGetParser(t *testing.T) {
 	t.Parallel()
 
@@ -51,6 +51,11 @@ func SyncerShardMeta(task string) string {
 	return task + "_syncer_sharding_meta"
 }
 
+// SyncerKafkaCommitTs returns syncer's table name of the last commit ts sent to Kafka.
+func SyncerKafkaCommitTs(task string) string {
+	return task + "_syncer_kafka_commit_ts"
+}
+
 // SyncerOnlineDDL returns syncer's onlineddl checkpoint table name.
 func SyncerOnlineDDL(task string) string {
 	return task + "_onlineddl"
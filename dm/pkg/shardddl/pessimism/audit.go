@@ -0,0 +1,123 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pessimism
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/pingcap/tiflow/dm/common"
+	"github.com/pingcap/tiflow/dm/pkg/etcdutil"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// AuditRecord records a shard DDL lock which is unlocked by the shard DDL lock policy automatically.
+// This information should be persistent in etcd so that the user can find out what happened after that.
+type AuditRecord struct {
+	ID       string    `json:"id"`       // the corresponding DDL lock ID
+	Task     string    `json:"task"`     // data migration task name
+	Action   string    `json:"action"`   // the action of the shard DDL lock policy
+	Owner    string    `json:"owner"`    // the source which executed the DDL statements
+	DDLs     []string  `json:"ddls"`     // DDL statements
+	Synced   []string  `json:"synced"`   // sources which have received the DDL statements
+	Unsynced []string  `json:"unsynced"` // sources which have not received the DDL statements
+	Waited   string    `json:"waited"`   // how long the lock has waited before unlocked
+	Time     time.Time `json:"time"`     // when the lock is unlocked
+}
+
+// NewAuditRecord creates a new AuditRecord instance for the lock.
+func NewAuditRecord(lock *Lock, action string, t time.Time) AuditRecord {
+	r := AuditRecord{
+		ID:       lock.ID,
+		Task:     lock.Task,
+		Action:   action,
+		Owner:    lock.Owner,
+		DDLs:     lock.DDLs,
+		Synced:   make([]string, 0),
+		Unsynced: make([]string, 0),
+		Waited:   t.Sub(lock.CreatedAt()).Round(time.Second).String(),
+		Time:     t,
+	}
+	for source, synced := range lock.Ready() {
+		if synced {
+			r.Synced = append(r.Synced, source)
+		} else {
+			r.Unsynced = append(r.Unsynced, source)
+		}
+	}
+	sort.Strings(r.Synced)
+	sort.Strings(r.Unsynced)
+	return r
+}
+
+// String implements Stringer interface.
+func (r AuditRecord) String() string {
+	s, _ := r.toJSON()
+	return s
+}
+
+// toJSON returns the string of JSON represent.
+func (r AuditRecord) toJSON() (string, error) {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// auditRecordFromJSON constructs AuditRecord from its JSON represent.
+func auditRecordFromJSON(s string) (r AuditRecord, err error) {
+	err = json.Unmarshal([]byte(s), &r)
+	return
+}
+
+// PutAuditRecord puts the audit record into etcd.
+// This function should often be called by DM-master.
+func PutAuditRecord(cli *clientv3.Client, r AuditRecord) (int64, error) {
+	value, err := r.toJSON()
+	if err != nil {
+		return 0, err
+	}
+	key := common.ShardDDLPessimismAuditKeyAdapter.Encode(r.Task, r.ID, strconv.FormatInt(r.Time.UnixNano(), 10))
+
+	_, rev, err := etcdutil.DoTxnWithRepeatable(cli, etcdutil.ThenOpFunc(clientv3.OpPut(key, value)))
+	return rev, err
+}
+
+// GetAuditRecordsByTask gets all audit records of the task in etcd currently, sorted by time.
+func GetAuditRecordsByTask(cli *clientv3.Client, task string) ([]AuditRecord, int64, error) {
+	ctx, cancel := context.WithTimeout(cli.Ctx(), etcdutil.DefaultRequestTimeout)
+	defer cancel()
+
+	resp, err := cli.Get(ctx, common.ShardDDLPessimismAuditKeyAdapter.Encode(task), clientv3.WithPrefix())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	records := make([]AuditRecord, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		r, err2 := auditRecordFromJSON(string(kv.Value))
+		if err2 != nil {
+			return nil, 0, err2
+		}
+		records = append(records, r)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].Time.Before(records[j].Time)
+	})
+	return records, resp.Header.Revision, nil
+}
@@ -0,0 +1,97 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pessimism
+
+import (
+	"time"
+
+	"github.com/pingcap/check"
+)
+
+func (t *testForEtcd) TestAuditRecordJSON(c *check.C) {
+	var (
+		source1 = "mysql-replica-1"
+		source2 = "mysql-replica-2"
+		source3 = "mysql-replica-3"
+		l       = NewLock("test-`foo`.`bar`", "test", source1, []string{"ALTER TABLE bar ADD COLUMN c1 INT"},
+			[]string{source1, source2, source3})
+		now = time.Unix(1700000000, 0).UTC()
+	)
+	_, _, err := l.TrySync(source1, l.DDLs, nil)
+	c.Assert(err, check.IsNil)
+	l.createdAt = now.Add(-31 * time.Minute)
+
+	r1 := NewAuditRecord(l, "force-unlock", now)
+	c.Assert(r1.Synced, check.DeepEquals, []string{source1})
+	c.Assert(r1.Unsynced, check.DeepEquals, []string{source2, source3})
+
+	j, err := r1.toJSON()
+	c.Assert(err, check.IsNil)
+	c.Assert(j, check.Equals, `{"id":"test-`+"`foo`.`bar`"+`","task":"test","action":"force-unlock","owner":"mysql-replica-1","ddls":["ALTER TABLE bar ADD COLUMN c1 INT"],"synced":["mysql-replica-1"],"unsynced":["mysql-replica-2","mysql-replica-3"],"waited":"31m0s","time":"2023-11-14T22:13:20Z"}`)
+	c.Assert(j, check.Equals, r1.String())
+
+	r2, err := auditRecordFromJSON(j)
+	c.Assert(err, check.IsNil)
+	c.Assert(r2, check.DeepEquals, r1)
+}
+
+func (t *testForEtcd) TestAuditRecordEtcd(c *check.C) {
+	defer clearTestInfoOperation(c)
+
+	var (
+		source1 = "mysql-replica-1"
+		source2 = "mysql-replica-2"
+		task1   = "task-1"
+		task2   = "task-2"
+		DDLs    = []string{"ALTER TABLE bar ADD COLUMN c1 INT"}
+		l1      = NewLock("task-1-`foo`.`bar`", task1, source1, DDLs, []string{source1, source2})
+		l2      = NewLock("task-2-`foo`.`bar`", task2, source1, DDLs, []string{source1, source2})
+		now     = time.Unix(1700000000, 0).UTC()
+		r11     = NewAuditRecord(l1, "skip-idle", now)
+		r12     = NewAuditRecord(l1, "force-unlock", now.Add(time.Hour))
+		r21     = NewAuditRecord(l2, "force-unlock", now)
+	)
+
+	// no record exist.
+	records, rev0, err := GetAuditRecordsByTask(etcdTestCli, task1)
+	c.Assert(err, check.IsNil)
+	c.Assert(records, check.HasLen, 0)
+
+	// put records.
+	rev1, err := PutAuditRecord(etcdTestCli, r12)
+	c.Assert(err, check.IsNil)
+	c.Assert(rev1, check.Greater, rev0)
+	_, err = PutAuditRecord(etcdTestCli, r11)
+	c.Assert(err, check.IsNil)
+	_, err = PutAuditRecord(etcdTestCli, r21)
+	c.Assert(err, check.IsNil)
+
+	// get records sorted by time.
+	records, _, err = GetAuditRecordsByTask(etcdTestCli, task1)
+	c.Assert(err, check.IsNil)
+	c.Assert(records, check.DeepEquals, []AuditRecord{r11, r12})
+	records, _, err = GetAuditRecordsByTask(etcdTestCli, task2)
+	c.Assert(err, check.IsNil)
+	c.Assert(records, check.DeepEquals, []AuditRecord{r21})
+
+	// delete records with other meta data of the task.
+	_, err = DeleteInfosOperationsByTask(etcdTestCli, task1)
+	c.Assert(err, check.IsNil)
+	records, _, err = GetAuditRecordsByTask(etcdTestCli, task1)
+	c.Assert(err, check.IsNil)
+	c.Assert(records, check.HasLen, 0)
+	records, _, err = GetAuditRecordsByTask(etcdTestCli, task2)
+	c.Assert(err, check.IsNil)
+	c.Assert(records, check.DeepEquals, []AuditRecord{r21})
+}
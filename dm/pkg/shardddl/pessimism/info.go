@@ -34,6 +34,10 @@ type Info struct {
 	Schema string   `json:"schema"` // schema name of the DDL
 	Table  string   `json:"table"`  // table name of the DDL
 	DDLs   []string `json:"ddls"`   // DDL statements
+
+	// binlog timestamp of the DDL in the upstream, 0 if it's put by an older DM-worker.
+	// it's used to decide when the shard DDL lock started in the binlog time, even after DM-master restarted.
+	DDLTs int64 `json:"ddl-ts,omitempty"`
 }
 
 // NewInfo creates a new Info instance.
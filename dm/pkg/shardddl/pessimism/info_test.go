@@ -44,7 +44,8 @@ func TestInfo(t *testing.T) {
 func clearTestInfoOperation(c *check.C) {
 	clearInfo := clientv3.OpDelete(common.ShardDDLPessimismInfoKeyAdapter.Path(), clientv3.WithPrefix())
 	clearOp := clientv3.OpDelete(common.ShardDDLPessimismOperationKeyAdapter.Path(), clientv3.WithPrefix())
-	_, err := etcdTestCli.Txn(context.Background()).Then(clearInfo, clearOp).Commit()
+	clearAudit := clientv3.OpDelete(common.ShardDDLPessimismAuditKeyAdapter.Path(), clientv3.WithPrefix())
+	_, err := etcdTestCli.Txn(context.Background()).Then(clearInfo, clearOp, clearAudit).Commit()
 	c.Assert(err, check.IsNil)
 }
 
@@ -66,6 +67,14 @@ func (t *testForEtcd) TestInfoJSON(c *check.C) {
 	i2, err := infoFromJSON(j)
 	c.Assert(err, check.IsNil)
 	c.Assert(i2, check.DeepEquals, i1)
+
+	i1.DDLTs = 1700000000
+	j, err = i1.toJSON()
+	c.Assert(err, check.IsNil)
+	c.Assert(j, check.Equals, `{"task":"test","source":"mysql-replica-1","schema":"foo","table":"bar","ddls":["ALTER TABLE bar ADD COLUMN c1 INT","ALTER TABLE bar ADD COLUMN c2 INT"],"ddl-ts":1700000000}`)
+	i2, err = infoFromJSON(j)
+	c.Assert(err, check.IsNil)
+	c.Assert(i2, check.DeepEquals, i1)
 }
 
 func (t *testForEtcd) TestInfoEtcd(c *check.C) {
@@ -51,6 +51,9 @@ func (lk *LockKeeper) TrySync(info Info, sources []string) (string, bool, int, e
 	}
 
 	synced, remain, err := l.TrySync(info.Source, info.DDLs, sources)
+	if err == nil {
+		l.RecordDDLTs(info.DDLTs)
+	}
 	return lockID, synced, remain, err
 }
 
@@ -82,3 +82,34 @@ func (t *testLockKeeper) TestLockKeeper(c *check.C) {
 	// no locks exist.
 	c.Assert(lk.Locks(), check.HasLen, 0)
 }
+
+func (t *testLockKeeper) TestLockStartTs(c *check.C) {
+	var (
+		lk      = NewLockKeeper()
+		DDLs    = []string{"ALTER TABLE bar ADD COLUMN c1 INT"}
+		sources = []string{"mysql-replica-1", "mysql-replica-2", "mysql-replica-3"}
+		info1   = NewInfo("task", sources[0], "foo", "bar", DDLs)
+		info2   = NewInfo("task", sources[1], "foo", "bar", DDLs)
+		info3   = NewInfo("task", sources[2], "foo", "bar", DDLs)
+	)
+	info1.DDLTs = 200
+	info2.DDLTs = 100
+
+	// the info put by an older DM-worker has no DDL timestamp.
+	lockID, _, _, err := lk.TrySync(info3, sources)
+	c.Assert(err, check.IsNil)
+	lock := lk.FindLock(lockID)
+	c.Assert(lock.StartTs(), check.Equals, int64(0))
+
+	// the lock starts at the earliest DDL, no matter in which order the infos are received,
+	// e.g. when the locks are rebuilt from etcd after DM-master restarted.
+	_, _, _, err = lk.TrySync(info1, sources)
+	c.Assert(err, check.IsNil)
+	c.Assert(lock.StartTs(), check.Equals, int64(200))
+	_, _, _, err = lk.TrySync(info2, sources)
+	c.Assert(err, check.IsNil)
+	c.Assert(lock.StartTs(), check.Equals, int64(100))
+	_, _, _, err = lk.TrySync(info1, sources)
+	c.Assert(err, check.IsNil)
+	c.Assert(lock.StartTs(), check.Equals, int64(100))
+}
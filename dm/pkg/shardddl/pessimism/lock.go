@@ -15,6 +15,7 @@ package pessimism
 
 import (
 	"sync"
+	"time"
 
 	"github.com/pingcap/tiflow/dm/master/metrics"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
@@ -39,18 +40,28 @@ type Lock struct {
 	// whether the operations have done (exec/skip the shard DDL).
 	// if all of them have done, then we call the lock `resolved`.
 	done map[string]bool
+
+	// when the lock is created in DM-master, this is reset after the DM-master leader changed.
+	// it's only used to decide how long the lock has waited.
+	createdAt time.Time
+	// the earliest binlog timestamp of the DDLs received from the sources, 0 if not known.
+	// it's restored from the shard DDL info in etcd after the DM-master leader changed.
+	startTs int64
+	// the state of the shard DDL lock policy, empty if the policy has not been triggered.
+	policyState string
 }
 
 // NewLock creates a new Lock instance.
 func NewLock(id, task, owner string, ddls, sources []string) *Lock {
 	l := &Lock{
-		ID:     id,
-		Task:   task,
-		Owner:  owner,
-		DDLs:   ddls,
-		remain: len(sources),
-		ready:  make(map[string]bool),
-		done:   make(map[string]bool),
+		ID:        id,
+		Task:      task,
+		Owner:     owner,
+		DDLs:      ddls,
+		remain:    len(sources),
+		ready:     make(map[string]bool),
+		done:      make(map[string]bool),
+		createdAt: time.Now(),
 	}
 	for _, s := range sources {
 		l.ready[s] = false
@@ -175,3 +186,38 @@ func (l *Lock) IsResolved() bool {
 	}
 	return true
 }
+
+// CreatedAt returns when the lock is created.
+func (l *Lock) CreatedAt() time.Time {
+	return l.createdAt
+}
+
+// RecordDDLTs records the binlog timestamp of the DDL received from a source.
+func (l *Lock) RecordDDLTs(ts int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if ts > 0 && (l.startTs == 0 || ts < l.startTs) {
+		l.startTs = ts
+	}
+}
+
+// StartTs returns the binlog timestamp when the lock started, 0 if not known.
+func (l *Lock) StartTs() int64 {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.startTs
+}
+
+// SetPolicyState sets the state of the shard DDL lock policy.
+func (l *Lock) SetPolicyState(state string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.policyState = state
+}
+
+// PolicyState returns the state of the shard DDL lock policy.
+func (l *Lock) PolicyState() string {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.policyState
+}
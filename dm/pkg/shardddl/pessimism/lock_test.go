@@ -138,3 +138,11 @@ func (t *testLock) TestLock(c *check.C) {
 	c.Assert(ready[source1], check.IsTrue)
 	c.Assert(ready[source2], check.IsFalse)
 }
+
+func (t *testLock) TestLockPolicyState(c *check.C) {
+	l := NewLock("test-`foo`.`bar`", "test", "mysql-replica-1", []string{"ALTER TABLE bar ADD COLUMN c1 INT"}, []string{"mysql-replica-1"})
+	c.Assert(l.CreatedAt().IsZero(), check.IsFalse)
+	c.Assert(l.PolicyState(), check.Equals, "")
+	l.SetPolicyState("alert: waited for 30m0s, un-synced sources [mysql-replica-2]")
+	c.Assert(l.PolicyState(), check.Equals, "alert: waited for 30m0s, un-synced sources [mysql-replica-2]")
+}
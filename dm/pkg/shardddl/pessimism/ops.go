@@ -72,12 +72,13 @@ func DeleteInfosOperations(cli *clientv3.Client, infos []Info, ops []Operation)
 	return rev, err
 }
 
-// DeleteInfosOperationsByTask deletes the shard DDL infos and operations of a specified task in etcd.
+// DeleteInfosOperationsByTask deletes the shard DDL infos, operations and audit records of a specified task in etcd.
 // This function should often be called by DM-master when deleting ddl meta data.
 func DeleteInfosOperationsByTask(cli *clientv3.Client, task string) (int64, error) {
-	opsDel := make([]clientv3.Op, 0, 2)
+	opsDel := make([]clientv3.Op, 0, 3)
 	opsDel = append(opsDel, clientv3.OpDelete(common.ShardDDLPessimismInfoKeyAdapter.Encode(task), clientv3.WithPrefix()))
 	opsDel = append(opsDel, clientv3.OpDelete(common.ShardDDLPessimismOperationKeyAdapter.Encode(task), clientv3.WithPrefix()))
+	opsDel = append(opsDel, clientv3.OpDelete(common.ShardDDLPessimismAuditKeyAdapter.Encode(task), clientv3.WithPrefix()))
 	_, rev, err := etcdutil.DoTxnWithRepeatable(cli, etcdutil.ThenOpFunc(opsDel...))
 	return rev, err
 }
@@ -212,6 +212,11 @@ func _() {
 	_ = x[codeConfigInvalidLoadAnalyze-20065]
 	_ = x[codeConfigStrictOptimisticShardMode-20066]
 	_ = x[codeConfigSecretKeyPath-20067]
+	_ = x[codeConfigInvalidTargetKafka-20068]
+	_ = x[codeConfigInvalidRelayStorage-20069]
+	_ = x[codeConfigInvalidShardLockPolicy-20070]
+	_ = x[codeConfigInvalidBinlogErrorRule-20071]
+	_ = x[codeConfigInvalidDMLThrottle-20072]
 	_ = x[codeBinlogExtractPosition-22001]
 	_ = x[codeBinlogInvalidFilename-22002]
 	_ = x[codeBinlogParsePosFromStr-22003]
@@ -277,6 +282,7 @@ func _() {
 	_ = x[codeRelayPurgeArgsNotValid-30042]
 	_ = x[codePreviousGTIDsNotValid-30043]
 	_ = x[codeRotateEventWithDifferentServerID-30044]
+	_ = x[codeRelayExternalStorage-30045]
 	_ = x[codeDumpUnitRuntime-32001]
 	_ = x[codeDumpUnitGenTableRouter-32002]
 	_ = x[codeDumpUnitGenBAList-32003]
@@ -373,6 +379,8 @@ func _() {
 	_ = x[codeSyncerGetEvent-36069]
 	_ = x[codeSyncerDownstreamTableNotFound-36070]
 	_ = x[codeSyncerReprocessWithSafeModeFail-36071]
+	_ = x[codeSyncerWriteKafka-36072]
+	_ = x[codeSyncerBinlogErrorRuleApplied-36073]
 	_ = x[codeMasterSQLOpNilRequest-38001]
 	_ = x[codeMasterSQLOpNotSupport-38002]
 	_ = x[codeMasterSQLOpWithoutSharding-38003]
@@ -600,7 +608,7 @@ func _() {
 	_ = x[codeNotSet-50000]
 }
 
-const _ErrCode_name = "DBDriverErrorDBBadConnDBInvalidConnDBUnExpectDBQueryFailedDBExecuteFailedDBExecuteFailedBeginParseMydumperMetaGetFileSizeDropMultipleTablesRenameMultipleTablesAlterMultipleTablesParseSQLUnknownTypeDDLRestoreASTNodeParseGTIDNotSupportedFlavorNotMySQLGTIDNotMariaDBGTIDNotUUIDStringMariaDBDomainIDInvalidServerIDGetSQLModeFromStrVerifySQLOperateArgsStatFileSizeReaderAlreadyRunningReaderAlreadyStartedReaderStateCannotCloseReaderShouldStartSyncEmptyRelayDirReadDirBaseFileNotFoundBinFileCmpCondNotSupportBinlogFileNotValidBinlogFilesNotFoundGetRelayLogStatAddWatchForRelayLogDirWatcherStartWatcherChanClosedWatcherChanRecvErrorRelayLogFileSizeSmallerBinlogFileNotSpecifiedNoRelayLogMatchPosFirstRelayLogNotMatchPosParserParseRelayLogNoSubdirToSwitchNeedSyncAgainSyncClosedSchemaTableNameNotValidGenTableRouterEncryptSecretKeyNotValidEncryptGenCipherEncryptGenIVCiphertextLenNotValidCiphertextContextNotValidInvalidBinlogPosStrEncCipherTextBase64DecodeBinlogWriteBinaryDataBinlogWriteDataToBufferBinlogHeaderLengthNotValidBinlogEventDecodeBinlogEmptyNextBinNameBinlogParseSIDBinlogEmptyGTIDBinlogGTIDSetNotValidBinlogGTIDMySQLNotValidBinlogGTIDMariaDBNotValidBinlogMariaDBServerIDMismatchBinlogOnlyOneGTIDSupportBinlogOnlyOneIntervalInUUIDBinlogIntervalValueNotValidBinlogEmptyQueryBinlogTableMapEvNotValidBinlogExpectFormatDescEvBinlogExpectTableMapEvBinlogExpectRowsEvBinlogUnexpectedEvBinlogParseSingleEvBinlogEventTypeNotValidBinlogEventNoRowsBinlogEventNoColumnsBinlogEventRowLengthNotEqBinlogColumnTypeNotSupportBinlogGoMySQLTypeNotSupportBinlogColumnTypeMisMatchBinlogDummyEvSizeTooSmallBinlogFlavorNotSupportBinlogDMLEmptyDataBinlogLatestGTIDNotInPrevBinlogReadFileByGTIDBinlogWriterNotStateNewBinlogWriterStateCannotCloseBinlogWriterNeedStartBinlogWriterOpenFileBinlogWriterGetFileStatBinlogWriterWriteDataLenBinlogWriterFileNotOpenedBinlogWriterFileSyncBinlogPrevGTIDEvNotValidBinlogDecodeMySQLGTIDSetBinlogNeedMariaDBGTIDSetBinlogParseMariaDBGTIDSetBinlogMariaDBAddGTIDSetTracingEventDataNotValidTracingUploadDataTracingEventTypeNotValidTracingGetTraceCodeTracingDataChecksumTracingGetTSOBackoffArgsNotValidInitLoggerFailGTIDTruncateInvalidRelayLogGivenPosTooBigElectionCampaignFailElectionGetLeaderIDFailBinlogInvalidFilenameWithUUIDSuffixDecodeEtcdKeyFailShardDDLOptimismTrySyncFailConnInvalidTLSConfigConnRegistryTLSConfigUpgradeVersionEtcdFailInvalidV1WorkerMetaPathFailUpdateV1DBSchemaBinlogStatusVarsParseVerifyHandleErrorArgsRewriteSQLNoUUIDDirMatchGTIDNoRelayPosMatchGTIDReaderReachEndOfFileMetadataNoBinlogLocPreviousGTIDNotExistNoMasterStatusBinlogNotLogColumnShardDDLOptimismNeedSkipAndRedirectShardDDLOptimismAddNotFullyDroppedColumnSyncerCancelledDDLIncorrectReturnColumnsNumConfigCheckItemNotSupportConfigTomlTransformConfigYamlTransformConfigTaskNameEmptyConfigEmptySourceIDConfigTooLongSourceIDConfigOnlineSchemeNotSupportConfigInvalidTimezoneConfigParseFlagSetConfigDecryptDBPasswordConfigMetaInvalidConfigMySQLInstNotFoundConfigMySQLInstsAtLeastOneConfigMySQLInstSameSourceIDConfigMydumperCfgConflictConfigLoaderCfgConflictConfigSyncerCfgConflictConfigReadCfgFromFileConfigNeedUniqueTaskNameConfigInvalidTaskModeConfigNeedTargetDBConfigMetadataNotSetConfigRouteRuleNotFoundConfigFilterRuleNotFoundConfigColumnMappingNotFoundConfigBAListNotFoundConfigMydumperCfgNotFoundConfigMydumperPathNotValidConfigLoaderCfgNotFoundConfigSyncerCfgNotFoundConfigSourceIDNotFoundConfigDuplicateCfgItemConfigShardModeNotSupportConfigMoreThanOneConfigEtcdParseConfigMissingForBoundConfigBinlogEventFilterConfigGlobalConfigsUnusedConfigExprFilterManyExprConfigExprFilterNotFoundConfigExprFilterWrongGrammarConfigExprFilterEmptyNameConfigCheckerMaxTooSmallConfigGenBAListConfigGenTableRouterConfigGenColumnMappingConfigInvalidChunkFileSizeConfigOnlineDDLInvalidRegexConfigOnlineDDLMistakeRegexConfigOpenAPITaskConfigExistConfigOpenAPITaskConfigNotExistCollationCompatibleNotSupportConfigInvalidLoadModeConfigInvalidLoadDuplicateResolutionConfigValidationModeContinuousValidatorCfgNotFoundConfigStartTimeTooLateConfigLoaderDirInvalidConfigLoaderS3NotSupportConfigInvalidSafeModeDurationConfigConfictSafeModeDurationAndSafeModeConfigInvalidLoadPhysicalDuplicateResolutionConfigInvalidLoadPhysicalChecksumConfigColumnMappingDeprecatedConfigInvalidLoadAnalyzeConfigStrictOptimisticShardModeConfigSecretKeyPathBinlogExtractPositionBinlogInvalidFilenameBinlogParsePosFromStrCheckpointInvalidTaskModeCheckpointSaveInvalidPosCheckpointInvalidTableFileCheckpointDBNotExistInFileCheckpointTableNotExistInFileCheckpointRestoreCountGreaterTaskCheckSameTableNameTaskCheckFailedOpenDBTaskCheckGenTableRouterTaskCheckGenColumnMappingTaskCheckSyncConfigErrorTaskCheckGenBAListSourceCheckGTIDRelayParseUUIDIndexRelayParseUUIDSuffixRelayUUIDWithSuffixNotFoundRelayGenFakeRotateEventRelayNoValidRelaySubDirRelayUUIDSuffixNotValidRelayUUIDSuffixLessThanPrevRelayLoadMetaDataRelayBinlogNameNotValidRelayNoCurrentUUIDRelayFlushLocalMetaRelayUpdateIndexFileRelayLogDirpathEmptyRelayReaderNotStateNewRelayReaderStateCannotCloseRelayReaderNeedStartRelayTCPReaderStartSyncRelayTCPReaderNilGTIDRelayTCPReaderStartSyncGTIDRelayTCPReaderGetEventRelayWriterNotStateNewRelayWriterStateCannotCloseRelayWriterNeedStartRelayWriterNotOpenedRelayWriterExpectRotateEvRelayWriterRotateEvWithNoWriterRelayWriterStatusNotValidRelayWriterGetFileStatRelayWriterLatestPosGTFileSizeRelayWriterFileOperateRelayCheckBinlogFileHeaderExistRelayCheckFormatDescEventExistRelayCheckFormatDescEventParseEvRelayCheckIsDuplicateEventRelayUpdateGTIDRelayNeedPrevGTIDEvBeforeGTIDEvRelayNeedMaGTIDListEvBeforeGTIDEvRelayMkdirRelaySwitchMasterNeedGTIDRelayThisStrategyIsPurgingRelayOtherStrategyIsPurgingRelayPurgeIsForbiddenRelayNoActiveRelayLogRelayPurgeRequestNotValidRelayTrimUUIDNotFoundRelayRemoveFileFailRelayPurgeArgsNotValidPreviousGTIDsNotValidRotateEventWithDifferentServerIDDumpUnitRuntimeDumpUnitGenTableRouterDumpUnitGenBAListDumpUnitGlobalLockLoadUnitCreateSchemaFileLoadUnitInvalidFileEndingLoadUnitParseQuoteValuesLoadUnitDoColumnMappingLoadUnitReadSchemaFileLoadUnitParseStatementLoadUnitNotCreateTableLoadUnitDispatchSQLFromFileLoadUnitInvalidInsertSQLLoadUnitGenTableRouterLoadUnitGenColumnMappingLoadUnitNoDBFileLoadUnitNoTableFileLoadUnitDumpDirNotFoundLoadUnitDuplicateTableFileLoadUnitGenBAListLoadTaskWorkerNotMatchLoadCheckPointNotMatchLoadLightningRuntimeLoadLightningHasDupLoadLightningChecksumSyncerUnitPanicSyncUnitInvalidTableNameSyncUnitTableNameQuerySyncUnitNotSupportedDMLSyncUnitAddTableInShardingSyncUnitDropSchemaTableInShardingSyncUnitInvalidShardMetaSyncUnitDDLWrongSequenceSyncUnitDDLActiveIndexLargerSyncUnitDupTableGroupSyncUnitShardingGroupNotFoundSyncUnitSafeModeSetCountSyncUnitCausalityConflictSyncUnitDMLStatementFoundSyncerUnitBinlogEventFilterSyncerUnitInvalidReplicaEventSyncerUnitParseStmtSyncerUnitUUIDNotLatestSyncerUnitDDLExecChanCloseOrBusySyncerUnitDDLChanDoneSyncerUnitDDLChanCanceledSyncerUnitDDLOnMultipleTableSyncerUnitInjectDDLOnlySyncerUnitInjectDDLWithoutSchemaSyncerUnitNotSupportedOperateSyncerUnitNilOperatorReqSyncerUnitDMLColumnNotMatchSyncerUnitDMLOldNewValueMismatchSyncerUnitDMLPruneColumnMismatchSyncerUnitGenBinlogEventFilterSyncerUnitGenTableRouterSyncerUnitGenColumnMappingSyncerUnitDoColumnMappingSyncerUnitCacheKeyNotFoundSyncerUnitHeartbeatCheckConfigSyncerUnitHeartbeatRecordExistsSyncerUnitHeartbeatRecordNotFoundSyncerUnitHeartbeatRecordNotValidSyncerUnitOnlineDDLInvalidMetaSyncerUnitOnlineDDLSchemeNotSupportSyncerUnitOnlineDDLOnMultipleTableSyncerUnitGhostApplyEmptyTableSyncerUnitGhostRenameTableNotValidSyncerUnitGhostRenameToGhostTableSyncerUnitGhostRenameGhostTblToOtherSyncerUnitGhostOnlineDDLOnGhostTblSyncerUnitPTApplyEmptyTableSyncerUnitPTRenameTableNotValidSyncerUnitPTRenameToPTTableSyncerUnitPTRenamePTTblToOtherSyncerUnitPTOnlineDDLOnPTTblSyncerUnitRemoteSteamerWithGTIDSyncerUnitRemoteSteamerStartSyncSyncerUnitGetTableFromDBSyncerUnitFirstEndPosNotFoundSyncerUnitResolveCasualityFailSyncerUnitReopenStreamNotSupportSyncerUnitUpdateConfigInShardingSyncerUnitExecWithNoBlockingDDLSyncerUnitGenBAListSyncerUnitHandleDDLFailedSyncerShardDDLConflictSyncerFailpointSyncerEventSyncerOperatorNotExistSyncerEventNotExistSyncerParseDDLSyncerUnsupportedStmtSyncerGetEventSyncerDownstreamTableNotFoundSyncerReprocessWithSafeModeFailMasterSQLOpNilRequestMasterSQLOpNotSupportMasterSQLOpWithoutShardingMasterGRPCCreateConnMasterGRPCSendOnCloseConnMasterGRPCClientCloseMasterGRPCInvalidReqTypeMasterGRPCRequestErrorMasterDeployMapperVerifyMasterConfigParseFlagSetMasterConfigUnknownItemMasterConfigInvalidFlagMasterConfigTomlTransformMasterConfigTimeoutParseMasterConfigUpdateCfgFileMasterShardingDDLDiffMasterStartServiceMasterNoEmitTokenMasterLockNotFoundMasterLockIsResolvingMasterWorkerCliNotFoundMasterWorkerNotWaitLockMasterHandleSQLReqFailMasterOwnerExecDDLMasterPartWorkerExecDDLFailMasterWorkerExistDDLLockMasterGetWorkerCfgExtractorMasterTaskConfigExtractorMasterWorkerArgsExtractorMasterQueryWorkerConfigMasterOperNotFoundMasterOperRespNotSuccessMasterOperRequestTimeoutMasterHandleHTTPApisMasterHostPortNotValidMasterGetHostnameFailMasterGenEmbedEtcdConfigFailMasterStartEmbedEtcdFailMasterParseURLFailMasterJoinEmbedEtcdFailMasterInvalidOperateOpMasterAdvertiseAddrNotValidMasterRequestIsNotForwardToLeaderMasterIsNotAsyncRequestMasterFailToGetExpectResultMasterPessimistNotStartedMasterOptimistNotStartedMasterMasterNameNotExistMasterInvalidOfflineTypeMasterAdvertisePeerURLsNotValidMasterTLSConfigNotValidMasterBoundChangingMasterFailToImportFromV10xMasterInconsistentOptimistDDLsAndInfoMasterOptimisticTableInfobeforeNotExistMasterOptimisticDownstreamMetaNotFoundMasterInvalidClusterIDMasterStartTaskWorkerParseFlagSetWorkerInvalidFlagWorkerDecodeConfigFromFileWorkerUndecodedItemFromFileWorkerNeedSourceIDWorkerTooLongSourceIDWorkerRelayBinlogNameWorkerWriteConfigFileWorkerLogInvalidHandlerWorkerLogPointerInvalidWorkerLogFetchPointerWorkerLogUnmarshalPointerWorkerLogClearPointerWorkerLogTaskKeyNotValidWorkerLogUnmarshalTaskKeyWorkerLogFetchLogIterWorkerLogGetTaskLogWorkerLogUnmarshalBinaryWorkerLogForwardPointerWorkerLogMarshalTaskWorkerLogSaveTaskWorkerLogDeleteKVWorkerLogDeleteKVIterWorkerLogUnmarshalTaskMetaWorkerLogFetchTaskFromMetaWorkerLogVerifyTaskMetaWorkerLogSaveTaskMetaWorkerLogGetTaskMetaWorkerLogDeleteTaskMetaWorkerMetaTomlTransformWorkerMetaOldFileStatWorkerMetaOldReadFileWorkerMetaEncodeTaskWorkerMetaRemoveOldDirWorkerMetaTaskLogNotFoundWorkerMetaHandleTaskOrderWorkerMetaOpenTxnWorkerMetaCommitTxnWorkerRelayStageNotValidWorkerRelayOperNotSupportWorkerOpenKVDBFileWorkerUpgradeCheckKVDirWorkerMarshalVerBinaryWorkerUnmarshalVerBinaryWorkerGetVersionFromKVWorkerSaveVersionToKVWorkerVerAutoDowngradeWorkerStartServiceWorkerAlreadyClosedWorkerNotRunningStageWorkerNotPausedStageWorkerUpdateTaskStageWorkerMigrateStopRelayWorkerSubTaskNotFoundWorkerSubTaskExistsWorkerOperSyncUnitOnlyWorkerRelayUnitStageWorkerNoSyncerRunningWorkerCannotUpdateSourceIDWorkerNoAvailUnitsWorkerDDLLockInfoNotFoundWorkerDDLLockInfoExistsWorkerCacheDDLInfoExistsWorkerExecSkipDDLConflictWorkerExecDDLSyncerOnlyWorkerExecDDLTimeoutWorkerWaitRelayCatchupTimeoutWorkerRelayIsPurgingWorkerHostPortNotValidWorkerNoStartWorkerAlreadyStartedWorkerSourceNotMatchWorkerFailToGetSubtaskConfigFromEtcdWorkerFailToGetSourceConfigFromEtcdWorkerDDLLockOpNotFoundWorkerTLSConfigNotValidWorkerFailConnectMasterWorkerWaitRelayCatchupGTIDWorkerRelayConfigChangingWorkerRouteTableDupMatchWorkerUpdateSubTaskConfigWorkerValidatorNotPausedWorkerServerClosedTracerParseFlagSetTracerConfigTomlTransformTracerConfigInvalidFlagTracerTraceEventNotFoundTracerTraceIDNotProvidedTracerParamNotValidTracerPostMethodOnlyTracerEventAssertionFailTracerEventTypeNotValidTracerStartServiceHAFailTxnOperationHAInvalidItemHAFailWatchEtcdHAFailLeaseOperationHAFailKeepaliveValidatorLoadPersistedDataValidatorPersistDataValidatorGetEventValidatorProcessRowEventValidatorValidateChangeValidatorNotFoundValidatorPanicValidatorTooMuchPendingSchemaTrackerInvalidJSONSchemaTrackerCannotCreateSchemaSchemaTrackerCannotCreateTableSchemaTrackerCannotSerializeSchemaTrackerCannotGetTableSchemaTrackerCannotExecDDLSchemaTrackerCannotFetchDownstreamTableSchemaTrackerCannotParseDownstreamTableSchemaTrackerInvalidCreateTableStmtSchemaTrackerRestoreStmtFailSchemaTrackerCannotDropTableSchemaTrackerInitSchemaTrackerMarshalJSONSchemaTrackerUnMarshalJSONSchemaTrackerUnSchemaNotExistSchemaTrackerCannotSetDownstreamSQLModeSchemaTrackerCannotInitDownstreamParserSchemaTrackerCannotMockDownstreamTableSchemaTrackerCannotFetchDownstreamCreateTableStmtSchemaTrackerIsClosedSchedulerNotStartedSchedulerStartedSchedulerWorkerExistSchedulerWorkerNotExistSchedulerWorkerOnlineSchedulerWorkerInvalidTransSchedulerSourceCfgExistSchedulerSourceCfgNotExistSchedulerSourcesUnboundSchedulerSourceOpTaskExistSchedulerRelayStageInvalidUpdateSchedulerRelayStageSourceNotExistSchedulerMultiTaskSchedulerSubTaskExistSchedulerSubTaskStageInvalidUpdateSchedulerSubTaskOpTaskNotExistSchedulerSubTaskOpSourceNotExistSchedulerTaskNotExistSchedulerRequireRunningTaskInSyncUnitSchedulerRelayWorkersBusySchedulerRelayWorkersBoundSchedulerRelayWorkersWrongRelaySchedulerSourceOpRelayExistSchedulerLatchInUseSchedulerSourceCfgUpdateSchedulerWrongWorkerInputSchedulerCantTransferToRelayWorkerSchedulerStartRelayOnSpecifiedSchedulerStopRelayOnSpecifiedSchedulerStartRelayOnBoundSchedulerStopRelayOnBoundSchedulerPauseTaskForTransferSourceSchedulerWorkerNotFreeSchedulerSubTaskNotExistSchedulerSubTaskCfgUpdateCtlGRPCCreateConnCtlInvalidTLSCfgCtlLoadTLSCfgOpenAPICommonOpenAPITaskSourceNotFoundNotSet"
+const _ErrCode_name = "DBDriverErrorDBBadConnDBInvalidConnDBUnExpectDBQueryFailedDBExecuteFailedDBExecuteFailedBeginParseMydumperMetaGetFileSizeDropMultipleTablesRenameMultipleTablesAlterMultipleTablesParseSQLUnknownTypeDDLRestoreASTNodeParseGTIDNotSupportedFlavorNotMySQLGTIDNotMariaDBGTIDNotUUIDStringMariaDBDomainIDInvalidServerIDGetSQLModeFromStrVerifySQLOperateArgsStatFileSizeReaderAlreadyRunningReaderAlreadyStartedReaderStateCannotCloseReaderShouldStartSyncEmptyRelayDirReadDirBaseFileNotFoundBinFileCmpCondNotSupportBinlogFileNotValidBinlogFilesNotFoundGetRelayLogStatAddWatchForRelayLogDirWatcherStartWatcherChanClosedWatcherChanRecvErrorRelayLogFileSizeSmallerBinlogFileNotSpecifiedNoRelayLogMatchPosFirstRelayLogNotMatchPosParserParseRelayLogNoSubdirToSwitchNeedSyncAgainSyncClosedSchemaTableNameNotValidGenTableRouterEncryptSecretKeyNotValidEncryptGenCipherEncryptGenIVCiphertextLenNotValidCiphertextContextNotValidInvalidBinlogPosStrEncCipherTextBase64DecodeBinlogWriteBinaryDataBinlogWriteDataToBufferBinlogHeaderLengthNotValidBinlogEventDecodeBinlogEmptyNextBinNameBinlogParseSIDBinlogEmptyGTIDBinlogGTIDSetNotValidBinlogGTIDMySQLNotValidBinlogGTIDMariaDBNotValidBinlogMariaDBServerIDMismatchBinlogOnlyOneGTIDSupportBinlogOnlyOneIntervalInUUIDBinlogIntervalValueNotValidBinlogEmptyQueryBinlogTableMapEvNotValidBinlogExpectFormatDescEvBinlogExpectTableMapEvBinlogExpectRowsEvBinlogUnexpectedEvBinlogParseSingleEvBinlogEventTypeNotValidBinlogEventNoRowsBinlogEventNoColumnsBinlogEventRowLengthNotEqBinlogColumnTypeNotSupportBinlogGoMySQLTypeNotSupportBinlogColumnTypeMisMatchBinlogDummyEvSizeTooSmallBinlogFlavorNotSupportBinlogDMLEmptyDataBinlogLatestGTIDNotInPrevBinlogReadFileByGTIDBinlogWriterNotStateNewBinlogWriterStateCannotCloseBinlogWriterNeedStartBinlogWriterOpenFileBinlogWriterGetFileStatBinlogWriterWriteDataLenBinlogWriterFileNotOpenedBinlogWriterFileSyncBinlogPrevGTIDEvNotValidBinlogDecodeMySQLGTIDSetBinlogNeedMariaDBGTIDSetBinlogParseMariaDBGTIDSetBinlogMariaDBAddGTIDSetTracingEventDataNotValidTracingUploadDataTracingEventTypeNotValidTracingGetTraceCodeTracingDataChecksumTracingGetTSOBackoffArgsNotValidInitLoggerFailGTIDTruncateInvalidRelayLogGivenPosTooBigElectionCampaignFailElectionGetLeaderIDFailBinlogInvalidFilenameWithUUIDSuffixDecodeEtcdKeyFailShardDDLOptimismTrySyncFailConnInvalidTLSConfigConnRegistryTLSConfigUpgradeVersionEtcdFailInvalidV1WorkerMetaPathFailUpdateV1DBSchemaBinlogStatusVarsParseVerifyHandleErrorArgsRewriteSQLNoUUIDDirMatchGTIDNoRelayPosMatchGTIDReaderReachEndOfFileMetadataNoBinlogLocPreviousGTIDNotExistNoMasterStatusBinlogNotLogColumnShardDDLOptimismNeedSkipAndRedirectShardDDLOptimismAddNotFullyDroppedColumnSyncerCancelledDDLIncorrectReturnColumnsNumConfigCheckItemNotSupportConfigTomlTransformConfigYamlTransformConfigTaskNameEmptyConfigEmptySourceIDConfigTooLongSourceIDConfigOnlineSchemeNotSupportConfigInvalidTimezoneConfigParseFlagSetConfigDecryptDBPasswordConfigMetaInvalidConfigMySQLInstNotFoundConfigMySQLInstsAtLeastOneConfigMySQLInstSameSourceIDConfigMydumperCfgConflictConfigLoaderCfgConflictConfigSyncerCfgConflictConfigReadCfgFromFileConfigNeedUniqueTaskNameConfigInvalidTaskModeConfigNeedTargetDBConfigMetadataNotSetConfigRouteRuleNotFoundConfigFilterRuleNotFoundConfigColumnMappingNotFoundConfigBAListNotFoundConfigMydumperCfgNotFoundConfigMydumperPathNotValidConfigLoaderCfgNotFoundConfigSyncerCfgNotFoundConfigSourceIDNotFoundConfigDuplicateCfgItemConfigShardModeNotSupportConfigMoreThanOneConfigEtcdParseConfigMissingForBoundConfigBinlogEventFilterConfigGlobalConfigsUnusedConfigExprFilterManyExprConfigExprFilterNotFoundConfigExprFilterWrongGrammarConfigExprFilterEmptyNameConfigCheckerMaxTooSmallConfigGenBAListConfigGenTableRouterConfigGenColumnMappingConfigInvalidChunkFileSizeConfigOnlineDDLInvalidRegexConfigOnlineDDLMistakeRegexConfigOpenAPITaskConfigExistConfigOpenAPITaskConfigNotExistCollationCompatibleNotSupportConfigInvalidLoadModeConfigInvalidLoadDuplicateResolutionConfigValidationModeContinuousValidatorCfgNotFoundConfigStartTimeTooLateConfigLoaderDirInvalidConfigLoaderS3NotSupportConfigInvalidSafeModeDurationConfigConfictSafeModeDurationAndSafeModeConfigInvalidLoadPhysicalDuplicateResolutionConfigInvalidLoadPhysicalChecksumConfigColumnMappingDeprecatedConfigInvalidLoadAnalyzeConfigStrictOptimisticShardModeConfigSecretKeyPathConfigInvalidTargetKafkaConfigInvalidRelayStorageConfigInvalidShardLockPolicyConfigInvalidBinlogErrorRuleConfigInvalidDMLThrottleBinlogExtractPositionBinlogInvalidFilenameBinlogParsePosFromStrCheckpointInvalidTaskModeCheckpointSaveInvalidPosCheckpointInvalidTableFileCheckpointDBNotExistInFileCheckpointTableNotExistInFileCheckpointRestoreCountGreaterTaskCheckSameTableNameTaskCheckFailedOpenDBTaskCheckGenTableRouterTaskCheckGenColumnMappingTaskCheckSyncConfigErrorTaskCheckGenBAListSourceCheckGTIDRelayParseUUIDIndexRelayParseUUIDSuffixRelayUUIDWithSuffixNotFoundRelayGenFakeRotateEventRelayNoValidRelaySubDirRelayUUIDSuffixNotValidRelayUUIDSuffixLessThanPrevRelayLoadMetaDataRelayBinlogNameNotValidRelayNoCurrentUUIDRelayFlushLocalMetaRelayUpdateIndexFileRelayLogDirpathEmptyRelayReaderNotStateNewRelayReaderStateCannotCloseRelayReaderNeedStartRelayTCPReaderStartSyncRelayTCPReaderNilGTIDRelayTCPReaderStartSyncGTIDRelayTCPReaderGetEventRelayWriterNotStateNewRelayWriterStateCannotCloseRelayWriterNeedStartRelayWriterNotOpenedRelayWriterExpectRotateEvRelayWriterRotateEvWithNoWriterRelayWriterStatusNotValidRelayWriterGetFileStatRelayWriterLatestPosGTFileSizeRelayWriterFileOperateRelayCheckBinlogFileHeaderExistRelayCheckFormatDescEventExistRelayCheckFormatDescEventParseEvRelayCheckIsDuplicateEventRelayUpdateGTIDRelayNeedPrevGTIDEvBeforeGTIDEvRelayNeedMaGTIDListEvBeforeGTIDEvRelayMkdirRelaySwitchMasterNeedGTIDRelayThisStrategyIsPurgingRelayOtherStrategyIsPurgingRelayPurgeIsForbiddenRelayNoActiveRelayLogRelayPurgeRequestNotValidRelayTrimUUIDNotFoundRelayRemoveFileFailRelayPurgeArgsNotValidPreviousGTIDsNotValidRotateEventWithDifferentServerIDRelayExternalStorageDumpUnitRuntimeDumpUnitGenTableRouterDumpUnitGenBAListDumpUnitGlobalLockLoadUnitCreateSchemaFileLoadUnitInvalidFileEndingLoadUnitParseQuoteValuesLoadUnitDoColumnMappingLoadUnitReadSchemaFileLoadUnitParseStatementLoadUnitNotCreateTableLoadUnitDispatchSQLFromFileLoadUnitInvalidInsertSQLLoadUnitGenTableRouterLoadUnitGenColumnMappingLoadUnitNoDBFileLoadUnitNoTableFileLoadUnitDumpDirNotFoundLoadUnitDuplicateTableFileLoadUnitGenBAListLoadTaskWorkerNotMatchLoadCheckPointNotMatchLoadLightningRuntimeLoadLightningHasDupLoadLightningChecksumSyncerUnitPanicSyncUnitInvalidTableNameSyncUnitTableNameQuerySyncUnitNotSupportedDMLSyncUnitAddTableInShardingSyncUnitDropSchemaTableInShardingSyncUnitInvalidShardMetaSyncUnitDDLWrongSequenceSyncUnitDDLActiveIndexLargerSyncUnitDupTableGroupSyncUnitShardingGroupNotFoundSyncUnitSafeModeSetCountSyncUnitCausalityConflictSyncUnitDMLStatementFoundSyncerUnitBinlogEventFilterSyncerUnitInvalidReplicaEventSyncerUnitParseStmtSyncerUnitUUIDNotLatestSyncerUnitDDLExecChanCloseOrBusySyncerUnitDDLChanDoneSyncerUnitDDLChanCanceledSyncerUnitDDLOnMultipleTableSyncerUnitInjectDDLOnlySyncerUnitInjectDDLWithoutSchemaSyncerUnitNotSupportedOperateSyncerUnitNilOperatorReqSyncerUnitDMLColumnNotMatchSyncerUnitDMLOldNewValueMismatchSyncerUnitDMLPruneColumnMismatchSyncerUnitGenBinlogEventFilterSyncerUnitGenTableRouterSyncerUnitGenColumnMappingSyncerUnitDoColumnMappingSyncerUnitCacheKeyNotFoundSyncerUnitHeartbeatCheckConfigSyncerUnitHeartbeatRecordExistsSyncerUnitHeartbeatRecordNotFoundSyncerUnitHeartbeatRecordNotValidSyncerUnitOnlineDDLInvalidMetaSyncerUnitOnlineDDLSchemeNotSupportSyncerUnitOnlineDDLOnMultipleTableSyncerUnitGhostApplyEmptyTableSyncerUnitGhostRenameTableNotValidSyncerUnitGhostRenameToGhostTableSyncerUnitGhostRenameGhostTblToOtherSyncerUnitGhostOnlineDDLOnGhostTblSyncerUnitPTApplyEmptyTableSyncerUnitPTRenameTableNotValidSyncerUnitPTRenameToPTTableSyncerUnitPTRenamePTTblToOtherSyncerUnitPTOnlineDDLOnPTTblSyncerUnitRemoteSteamerWithGTIDSyncerUnitRemoteSteamerStartSyncSyncerUnitGetTableFromDBSyncerUnitFirstEndPosNotFoundSyncerUnitResolveCasualityFailSyncerUnitReopenStreamNotSupportSyncerUnitUpdateConfigInShardingSyncerUnitExecWithNoBlockingDDLSyncerUnitGenBAListSyncerUnitHandleDDLFailedSyncerShardDDLConflictSyncerFailpointSyncerEventSyncerOperatorNotExistSyncerEventNotExistSyncerParseDDLSyncerUnsupportedStmtSyncerGetEventSyncerDownstreamTableNotFoundSyncerReprocessWithSafeModeFailSyncerWriteKafkaSyncerBinlogErrorRuleAppliedMasterSQLOpNilRequestMasterSQLOpNotSupportMasterSQLOpWithoutShardingMasterGRPCCreateConnMasterGRPCSendOnCloseConnMasterGRPCClientCloseMasterGRPCInvalidReqTypeMasterGRPCRequestErrorMasterDeployMapperVerifyMasterConfigParseFlagSetMasterConfigUnknownItemMasterConfigInvalidFlagMasterConfigTomlTransformMasterConfigTimeoutParseMasterConfigUpdateCfgFileMasterShardingDDLDiffMasterStartServiceMasterNoEmitTokenMasterLockNotFoundMasterLockIsResolvingMasterWorkerCliNotFoundMasterWorkerNotWaitLockMasterHandleSQLReqFailMasterOwnerExecDDLMasterPartWorkerExecDDLFailMasterWorkerExistDDLLockMasterGetWorkerCfgExtractorMasterTaskConfigExtractorMasterWorkerArgsExtractorMasterQueryWorkerConfigMasterOperNotFoundMasterOperRespNotSuccessMasterOperRequestTimeoutMasterHandleHTTPApisMasterHostPortNotValidMasterGetHostnameFailMasterGenEmbedEtcdConfigFailMasterStartEmbedEtcdFailMasterParseURLFailMasterJoinEmbedEtcdFailMasterInvalidOperateOpMasterAdvertiseAddrNotValidMasterRequestIsNotForwardToLeaderMasterIsNotAsyncRequestMasterFailToGetExpectResultMasterPessimistNotStartedMasterOptimistNotStartedMasterMasterNameNotExistMasterInvalidOfflineTypeMasterAdvertisePeerURLsNotValidMasterTLSConfigNotValidMasterBoundChangingMasterFailToImportFromV10xMasterInconsistentOptimistDDLsAndInfoMasterOptimisticTableInfobeforeNotExistMasterOptimisticDownstreamMetaNotFoundMasterInvalidClusterIDMasterStartTaskWorkerParseFlagSetWorkerInvalidFlagWorkerDecodeConfigFromFileWorkerUndecodedItemFromFileWorkerNeedSourceIDWorkerTooLongSourceIDWorkerRelayBinlogNameWorkerWriteConfigFileWorkerLogInvalidHandlerWorkerLogPointerInvalidWorkerLogFetchPointerWorkerLogUnmarshalPointerWorkerLogClearPointerWorkerLogTaskKeyNotValidWorkerLogUnmarshalTaskKeyWorkerLogFetchLogIterWorkerLogGetTaskLogWorkerLogUnmarshalBinaryWorkerLogForwardPointerWorkerLogMarshalTaskWorkerLogSaveTaskWorkerLogDeleteKVWorkerLogDeleteKVIterWorkerLogUnmarshalTaskMetaWorkerLogFetchTaskFromMetaWorkerLogVerifyTaskMetaWorkerLogSaveTaskMetaWorkerLogGetTaskMetaWorkerLogDeleteTaskMetaWorkerMetaTomlTransformWorkerMetaOldFileStatWorkerMetaOldReadFileWorkerMetaEncodeTaskWorkerMetaRemoveOldDirWorkerMetaTaskLogNotFoundWorkerMetaHandleTaskOrderWorkerMetaOpenTxnWorkerMetaCommitTxnWorkerRelayStageNotValidWorkerRelayOperNotSupportWorkerOpenKVDBFileWorkerUpgradeCheckKVDirWorkerMarshalVerBinaryWorkerUnmarshalVerBinaryWorkerGetVersionFromKVWorkerSaveVersionToKVWorkerVerAutoDowngradeWorkerStartServiceWorkerAlreadyClosedWorkerNotRunningStageWorkerNotPausedStageWorkerUpdateTaskStageWorkerMigrateStopRelayWorkerSubTaskNotFoundWorkerSubTaskExistsWorkerOperSyncUnitOnlyWorkerRelayUnitStageWorkerNoSyncerRunningWorkerCannotUpdateSourceIDWorkerNoAvailUnitsWorkerDDLLockInfoNotFoundWorkerDDLLockInfoExistsWorkerCacheDDLInfoExistsWorkerExecSkipDDLConflictWorkerExecDDLSyncerOnlyWorkerExecDDLTimeoutWorkerWaitRelayCatchupTimeoutWorkerRelayIsPurgingWorkerHostPortNotValidWorkerNoStartWorkerAlreadyStartedWorkerSourceNotMatchWorkerFailToGetSubtaskConfigFromEtcdWorkerFailToGetSourceConfigFromEtcdWorkerDDLLockOpNotFoundWorkerTLSConfigNotValidWorkerFailConnectMasterWorkerWaitRelayCatchupGTIDWorkerRelayConfigChangingWorkerRouteTableDupMatchWorkerUpdateSubTaskConfigWorkerValidatorNotPausedWorkerServerClosedTracerParseFlagSetTracerConfigTomlTransformTracerConfigInvalidFlagTracerTraceEventNotFoundTracerTraceIDNotProvidedTracerParamNotValidTracerPostMethodOnlyTracerEventAssertionFailTracerEventTypeNotValidTracerStartServiceHAFailTxnOperationHAInvalidItemHAFailWatchEtcdHAFailLeaseOperationHAFailKeepaliveValidatorLoadPersistedDataValidatorPersistDataValidatorGetEventValidatorProcessRowEventValidatorValidateChangeValidatorNotFoundValidatorPanicValidatorTooMuchPendingSchemaTrackerInvalidJSONSchemaTrackerCannotCreateSchemaSchemaTrackerCannotCreateTableSchemaTrackerCannotSerializeSchemaTrackerCannotGetTableSchemaTrackerCannotExecDDLSchemaTrackerCannotFetchDownstreamTableSchemaTrackerCannotParseDownstreamTableSchemaTrackerInvalidCreateTableStmtSchemaTrackerRestoreStmtFailSchemaTrackerCannotDropTableSchemaTrackerInitSchemaTrackerMarshalJSONSchemaTrackerUnMarshalJSONSchemaTrackerUnSchemaNotExistSchemaTrackerCannotSetDownstreamSQLModeSchemaTrackerCannotInitDownstreamParserSchemaTrackerCannotMockDownstreamTableSchemaTrackerCannotFetchDownstreamCreateTableStmtSchemaTrackerIsClosedSchedulerNotStartedSchedulerStartedSchedulerWorkerExistSchedulerWorkerNotExistSchedulerWorkerOnlineSchedulerWorkerInvalidTransSchedulerSourceCfgExistSchedulerSourceCfgNotExistSchedulerSourcesUnboundSchedulerSourceOpTaskExistSchedulerRelayStageInvalidUpdateSchedulerRelayStageSourceNotExistSchedulerMultiTaskSchedulerSubTaskExistSchedulerSubTaskStageInvalidUpdateSchedulerSubTaskOpTaskNotExistSchedulerSubTaskOpSourceNotExistSchedulerTaskNotExistSchedulerRequireRunningTaskInSyncUnitSchedulerRelayWorkersBusySchedulerRelayWorkersBoundSchedulerRelayWorkersWrongRelaySchedulerSourceOpRelayExistSchedulerLatchInUseSchedulerSourceCfgUpdateSchedulerWrongWorkerInputSchedulerCantTransferToRelayWorkerSchedulerStartRelayOnSpecifiedSchedulerStopRelayOnSpecifiedSchedulerStartRelayOnBoundSchedulerStopRelayOnBoundSchedulerPauseTaskForTransferSourceSchedulerWorkerNotFreeSchedulerSubTaskNotExistSchedulerSubTaskCfgUpdateCtlGRPCCreateConnCtlInvalidTLSCfgCtlLoadTLSCfgOpenAPICommonOpenAPITaskSourceNotFoundNotSet"
 
 var _ErrCode_map = map[ErrCode]string{
 	10001: _ErrCode_name[0:13],
@@ -807,392 +815,400 @@ var _ErrCode_map = map[ErrCode]string{
 	20065: _ErrCode_name[4237:4261],
 	20066: _ErrCode_name[4261:4292],
 	20067: _ErrCode_name[4292:4311],
-	22001: _ErrCode_name[4311:4332],
-	22002: _ErrCode_name[4332:4353],
-	22003: _ErrCode_name[4353:4374],
-	24001: _ErrCode_name[4374:4399],
-	24002: _ErrCode_name[4399:4423],
-	24003: _ErrCode_name[4423:4449],
-	24004: _ErrCode_name[4449:4475],
-	24005: _ErrCode_name[4475:4504],
-	24006: _ErrCode_name[4504:4533],
-	26001: _ErrCode_name[4533:4555],
-	26002: _ErrCode_name[4555:4576],
-	26003: _ErrCode_name[4576:4599],
-	26004: _ErrCode_name[4599:4624],
-	26005: _ErrCode_name[4624:4648],
-	26006: _ErrCode_name[4648:4666],
-	26007: _ErrCode_name[4666:4681],
-	28001: _ErrCode_name[4681:4700],
-	28002: _ErrCode_name[4700:4720],
-	28003: _ErrCode_name[4720:4747],
-	28004: _ErrCode_name[4747:4770],
-	28005: _ErrCode_name[4770:4793],
-	30001: _ErrCode_name[4793:4816],
-	30002: _ErrCode_name[4816:4843],
-	30003: _ErrCode_name[4843:4860],
-	30004: _ErrCode_name[4860:4883],
-	30005: _ErrCode_name[4883:4901],
-	30006: _ErrCode_name[4901:4920],
-	30007: _ErrCode_name[4920:4940],
-	30008: _ErrCode_name[4940:4960],
-	30009: _ErrCode_name[4960:4982],
-	30010: _ErrCode_name[4982:5009],
-	30011: _ErrCode_name[5009:5029],
-	30012: _ErrCode_name[5029:5052],
-	30013: _ErrCode_name[5052:5073],
-	30014: _ErrCode_name[5073:5100],
-	30015: _ErrCode_name[5100:5122],
-	30016: _ErrCode_name[5122:5144],
-	30017: _ErrCode_name[5144:5171],
-	30018: _ErrCode_name[5171:5191],
-	30019: _ErrCode_name[5191:5211],
-	30020: _ErrCode_name[5211:5236],
-	30021: _ErrCode_name[5236:5267],
-	30022: _ErrCode_name[5267:5292],
-	30023: _ErrCode_name[5292:5314],
-	30024: _ErrCode_name[5314:5344],
-	30025: _ErrCode_name[5344:5366],
-	30026: _ErrCode_name[5366:5397],
-	30027: _ErrCode_name[5397:5427],
-	30028: _ErrCode_name[5427:5459],
-	30029: _ErrCode_name[5459:5485],
-	30030: _ErrCode_name[5485:5500],
-	30031: _ErrCode_name[5500:5531],
-	30032: _ErrCode_name[5531:5564],
-	30033: _ErrCode_name[5564:5574],
-	30034: _ErrCode_name[5574:5599],
-	30035: _ErrCode_name[5599:5625],
-	30036: _ErrCode_name[5625:5652],
-	30037: _ErrCode_name[5652:5673],
-	30038: _ErrCode_name[5673:5694],
-	30039: _ErrCode_name[5694:5719],
-	30040: _ErrCode_name[5719:5740],
-	30041: _ErrCode_name[5740:5759],
-	30042: _ErrCode_name[5759:5781],
-	30043: _ErrCode_name[5781:5802],
-	30044: _ErrCode_name[5802:5834],
-	32001: _ErrCode_name[5834:5849],
-	32002: _ErrCode_name[5849:5871],
-	32003: _ErrCode_name[5871:5888],
-	32004: _ErrCode_name[5888:5906],
-	34001: _ErrCode_name[5906:5930],
-	34002: _ErrCode_name[5930:5955],
-	34003: _ErrCode_name[5955:5979],
-	34004: _ErrCode_name[5979:6002],
-	34005: _ErrCode_name[6002:6024],
-	34006: _ErrCode_name[6024:6046],
-	34007: _ErrCode_name[6046:6068],
-	34008: _ErrCode_name[6068:6095],
-	34009: _ErrCode_name[6095:6119],
-	34010: _ErrCode_name[6119:6141],
-	34011: _ErrCode_name[6141:6165],
-	34012: _ErrCode_name[6165:6181],
-	34013: _ErrCode_name[6181:6200],
-	34014: _ErrCode_name[6200:6223],
-	34015: _ErrCode_name[6223:6249],
-	34016: _ErrCode_name[6249:6266],
-	34017: _ErrCode_name[6266:6288],
-	34018: _ErrCode_name[6288:6310],
-	34019: _ErrCode_name[6310:6330],
-	34020: _ErrCode_name[6330:6349],
-	34021: _ErrCode_name[6349:6370],
-	36001: _ErrCode_name[6370:6385],
-	36002: _ErrCode_name[6385:6409],
-	36003: _ErrCode_name[6409:6431],
-	36004: _ErrCode_name[6431:6454],
-	36005: _ErrCode_name[6454:6480],
-	36006: _ErrCode_name[6480:6513],
-	36007: _ErrCode_name[6513:6537],
-	36008: _ErrCode_name[6537:6561],
-	36009: _ErrCode_name[6561:6589],
-	36010: _ErrCode_name[6589:6610],
-	36011: _ErrCode_name[6610:6639],
-	36012: _ErrCode_name[6639:6663],
-	36013: _ErrCode_name[6663:6688],
-	36014: _ErrCode_name[6688:6713],
-	36015: _ErrCode_name[6713:6740],
-	36016: _ErrCode_name[6740:6769],
-	36017: _ErrCode_name[6769:6788],
-	36018: _ErrCode_name[6788:6811],
-	36019: _ErrCode_name[6811:6843],
-	36020: _ErrCode_name[6843:6864],
-	36021: _ErrCode_name[6864:6889],
-	36022: _ErrCode_name[6889:6917],
-	36023: _ErrCode_name[6917:6940],
-	36024: _ErrCode_name[6940:6972],
-	36025: _ErrCode_name[6972:7001],
-	36026: _ErrCode_name[7001:7025],
-	36027: _ErrCode_name[7025:7052],
-	36028: _ErrCode_name[7052:7084],
-	36029: _ErrCode_name[7084:7116],
-	36030: _ErrCode_name[7116:7146],
-	36031: _ErrCode_name[7146:7170],
-	36032: _ErrCode_name[7170:7196],
-	36033: _ErrCode_name[7196:7221],
-	36034: _ErrCode_name[7221:7247],
-	36035: _ErrCode_name[7247:7277],
-	36036: _ErrCode_name[7277:7308],
-	36037: _ErrCode_name[7308:7341],
-	36038: _ErrCode_name[7341:7374],
-	36039: _ErrCode_name[7374:7404],
-	36040: _ErrCode_name[7404:7439],
-	36041: _ErrCode_name[7439:7473],
-	36042: _ErrCode_name[7473:7503],
-	36043: _ErrCode_name[7503:7537],
-	36044: _ErrCode_name[7537:7570],
-	36045: _ErrCode_name[7570:7606],
-	36046: _ErrCode_name[7606:7640],
-	36047: _ErrCode_name[7640:7667],
-	36048: _ErrCode_name[7667:7698],
-	36049: _ErrCode_name[7698:7725],
-	36050: _ErrCode_name[7725:7755],
-	36051: _ErrCode_name[7755:7783],
-	36052: _ErrCode_name[7783:7814],
-	36053: _ErrCode_name[7814:7846],
-	36054: _ErrCode_name[7846:7870],
-	36055: _ErrCode_name[7870:7899],
-	36056: _ErrCode_name[7899:7929],
-	36057: _ErrCode_name[7929:7961],
-	36058: _ErrCode_name[7961:7993],
-	36059: _ErrCode_name[7993:8024],
-	36060: _ErrCode_name[8024:8043],
-	36061: _ErrCode_name[8043:8068],
-	36062: _ErrCode_name[8068:8090],
-	36063: _ErrCode_name[8090:8105],
-	36064: _ErrCode_name[8105:8116],
-	36065: _ErrCode_name[8116:8138],
-	36066: _ErrCode_name[8138:8157],
-	36067: _ErrCode_name[8157:8171],
-	36068: _ErrCode_name[8171:8192],
-	36069: _ErrCode_name[8192:8206],
-	36070: _ErrCode_name[8206:8235],
-	36071: _ErrCode_name[8235:8266],
-	38001: _ErrCode_name[8266:8287],
-	38002: _ErrCode_name[8287:8308],
-	38003: _ErrCode_name[8308:8334],
-	38004: _ErrCode_name[8334:8354],
-	38005: _ErrCode_name[8354:8379],
-	38006: _ErrCode_name[8379:8400],
-	38007: _ErrCode_name[8400:8424],
-	38008: _ErrCode_name[8424:8446],
-	38009: _ErrCode_name[8446:8470],
-	38010: _ErrCode_name[8470:8494],
-	38011: _ErrCode_name[8494:8517],
-	38012: _ErrCode_name[8517:8540],
-	38013: _ErrCode_name[8540:8565],
-	38014: _ErrCode_name[8565:8589],
-	38015: _ErrCode_name[8589:8614],
-	38016: _ErrCode_name[8614:8635],
-	38017: _ErrCode_name[8635:8653],
-	38018: _ErrCode_name[8653:8670],
-	38019: _ErrCode_name[8670:8688],
-	38020: _ErrCode_name[8688:8709],
-	38021: _ErrCode_name[8709:8732],
-	38022: _ErrCode_name[8732:8755],
-	38023: _ErrCode_name[8755:8777],
-	38024: _ErrCode_name[8777:8795],
-	38025: _ErrCode_name[8795:8822],
-	38026: _ErrCode_name[8822:8846],
-	38027: _ErrCode_name[8846:8873],
-	38028: _ErrCode_name[8873:8898],
-	38029: _ErrCode_name[8898:8923],
-	38030: _ErrCode_name[8923:8946],
-	38031: _ErrCode_name[8946:8964],
-	38032: _ErrCode_name[8964:8988],
-	38033: _ErrCode_name[8988:9012],
-	38034: _ErrCode_name[9012:9032],
-	38035: _ErrCode_name[9032:9054],
-	38036: _ErrCode_name[9054:9075],
-	38037: _ErrCode_name[9075:9103],
-	38038: _ErrCode_name[9103:9127],
-	38039: _ErrCode_name[9127:9145],
-	38040: _ErrCode_name[9145:9168],
-	38041: _ErrCode_name[9168:9190],
-	38042: _ErrCode_name[9190:9217],
-	38043: _ErrCode_name[9217:9250],
-	38044: _ErrCode_name[9250:9273],
-	38045: _ErrCode_name[9273:9300],
-	38046: _ErrCode_name[9300:9325],
-	38047: _ErrCode_name[9325:9349],
-	38048: _ErrCode_name[9349:9373],
-	38049: _ErrCode_name[9373:9397],
-	38050: _ErrCode_name[9397:9428],
-	38051: _ErrCode_name[9428:9451],
-	38052: _ErrCode_name[9451:9470],
-	38053: _ErrCode_name[9470:9496],
-	38054: _ErrCode_name[9496:9533],
-	38055: _ErrCode_name[9533:9572],
-	38056: _ErrCode_name[9572:9610],
-	38057: _ErrCode_name[9610:9632],
-	38058: _ErrCode_name[9632:9647],
-	40001: _ErrCode_name[9647:9665],
-	40002: _ErrCode_name[9665:9682],
-	40003: _ErrCode_name[9682:9708],
-	40004: _ErrCode_name[9708:9735],
-	40005: _ErrCode_name[9735:9753],
-	40006: _ErrCode_name[9753:9774],
-	40007: _ErrCode_name[9774:9795],
-	40008: _ErrCode_name[9795:9816],
-	40009: _ErrCode_name[9816:9839],
-	40010: _ErrCode_name[9839:9862],
-	40011: _ErrCode_name[9862:9883],
-	40012: _ErrCode_name[9883:9908],
-	40013: _ErrCode_name[9908:9929],
-	40014: _ErrCode_name[9929:9953],
-	40015: _ErrCode_name[9953:9978],
-	40016: _ErrCode_name[9978:9999],
-	40017: _ErrCode_name[9999:10018],
-	40018: _ErrCode_name[10018:10042],
-	40019: _ErrCode_name[10042:10065],
-	40020: _ErrCode_name[10065:10085],
-	40021: _ErrCode_name[10085:10102],
-	40022: _ErrCode_name[10102:10119],
-	40023: _ErrCode_name[10119:10140],
-	40024: _ErrCode_name[10140:10166],
-	40025: _ErrCode_name[10166:10192],
-	40026: _ErrCode_name[10192:10215],
-	40027: _ErrCode_name[10215:10236],
-	40028: _ErrCode_name[10236:10256],
-	40029: _ErrCode_name[10256:10279],
-	40030: _ErrCode_name[10279:10302],
-	40031: _ErrCode_name[10302:10323],
-	40032: _ErrCode_name[10323:10344],
-	40033: _ErrCode_name[10344:10364],
-	40034: _ErrCode_name[10364:10386],
-	40035: _ErrCode_name[10386:10411],
-	40036: _ErrCode_name[10411:10436],
-	40037: _ErrCode_name[10436:10453],
-	40038: _ErrCode_name[10453:10472],
-	40039: _ErrCode_name[10472:10496],
-	40040: _ErrCode_name[10496:10521],
-	40041: _ErrCode_name[10521:10539],
-	40042: _ErrCode_name[10539:10562],
-	40043: _ErrCode_name[10562:10584],
-	40044: _ErrCode_name[10584:10608],
-	40045: _ErrCode_name[10608:10630],
-	40046: _ErrCode_name[10630:10651],
-	40047: _ErrCode_name[10651:10673],
-	40048: _ErrCode_name[10673:10691],
-	40049: _ErrCode_name[10691:10710],
-	40050: _ErrCode_name[10710:10731],
-	40051: _ErrCode_name[10731:10751],
-	40052: _ErrCode_name[10751:10772],
-	40053: _ErrCode_name[10772:10794],
-	40054: _ErrCode_name[10794:10815],
-	40055: _ErrCode_name[10815:10834],
-	40056: _ErrCode_name[10834:10856],
-	40057: _ErrCode_name[10856:10876],
-	40058: _ErrCode_name[10876:10897],
-	40059: _ErrCode_name[10897:10923],
-	40060: _ErrCode_name[10923:10941],
-	40061: _ErrCode_name[10941:10966],
-	40062: _ErrCode_name[10966:10989],
-	40063: _ErrCode_name[10989:11013],
-	40064: _ErrCode_name[11013:11038],
-	40065: _ErrCode_name[11038:11061],
-	40066: _ErrCode_name[11061:11081],
-	40067: _ErrCode_name[11081:11110],
-	40068: _ErrCode_name[11110:11130],
-	40069: _ErrCode_name[11130:11152],
-	40070: _ErrCode_name[11152:11165],
-	40071: _ErrCode_name[11165:11185],
-	40072: _ErrCode_name[11185:11205],
-	40073: _ErrCode_name[11205:11241],
-	40074: _ErrCode_name[11241:11276],
-	40075: _ErrCode_name[11276:11299],
-	40076: _ErrCode_name[11299:11322],
-	40077: _ErrCode_name[11322:11345],
-	40078: _ErrCode_name[11345:11371],
-	40079: _ErrCode_name[11371:11396],
-	40080: _ErrCode_name[11396:11420],
-	40081: _ErrCode_name[11420:11445],
-	40082: _ErrCode_name[11445:11469],
-	40083: _ErrCode_name[11469:11487],
-	42001: _ErrCode_name[11487:11505],
-	42002: _ErrCode_name[11505:11530],
-	42003: _ErrCode_name[11530:11553],
-	42004: _ErrCode_name[11553:11577],
-	42005: _ErrCode_name[11577:11601],
-	42006: _ErrCode_name[11601:11620],
-	42007: _ErrCode_name[11620:11640],
-	42008: _ErrCode_name[11640:11664],
-	42009: _ErrCode_name[11664:11687],
-	42010: _ErrCode_name[11687:11705],
-	42501: _ErrCode_name[11705:11723],
-	42502: _ErrCode_name[11723:11736],
-	42503: _ErrCode_name[11736:11751],
-	42504: _ErrCode_name[11751:11771],
-	42505: _ErrCode_name[11771:11786],
-	43001: _ErrCode_name[11786:11812],
-	43002: _ErrCode_name[11812:11832],
-	43003: _ErrCode_name[11832:11849],
-	43004: _ErrCode_name[11849:11873],
-	43005: _ErrCode_name[11873:11896],
-	43006: _ErrCode_name[11896:11913],
-	43007: _ErrCode_name[11913:11927],
-	43008: _ErrCode_name[11927:11950],
-	44001: _ErrCode_name[11950:11974],
-	44002: _ErrCode_name[11974:12005],
-	44003: _ErrCode_name[12005:12035],
-	44004: _ErrCode_name[12035:12063],
-	44005: _ErrCode_name[12063:12090],
-	44006: _ErrCode_name[12090:12116],
-	44007: _ErrCode_name[12116:12155],
-	44008: _ErrCode_name[12155:12194],
-	44009: _ErrCode_name[12194:12229],
-	44010: _ErrCode_name[12229:12257],
-	44011: _ErrCode_name[12257:12285],
-	44012: _ErrCode_name[12285:12302],
-	44013: _ErrCode_name[12302:12326],
-	44014: _ErrCode_name[12326:12352],
-	44015: _ErrCode_name[12352:12381],
-	44016: _ErrCode_name[12381:12420],
-	44017: _ErrCode_name[12420:12459],
-	44018: _ErrCode_name[12459:12497],
-	44019: _ErrCode_name[12497:12546],
-	44020: _ErrCode_name[12546:12567],
-	46001: _ErrCode_name[12567:12586],
-	46002: _ErrCode_name[12586:12602],
-	46003: _ErrCode_name[12602:12622],
-	46004: _ErrCode_name[12622:12645],
-	46005: _ErrCode_name[12645:12666],
-	46006: _ErrCode_name[12666:12693],
-	46007: _ErrCode_name[12693:12716],
-	46008: _ErrCode_name[12716:12742],
-	46009: _ErrCode_name[12742:12765],
-	46010: _ErrCode_name[12765:12791],
-	46011: _ErrCode_name[12791:12823],
-	46012: _ErrCode_name[12823:12856],
-	46013: _ErrCode_name[12856:12874],
-	46014: _ErrCode_name[12874:12895],
-	46015: _ErrCode_name[12895:12929],
-	46016: _ErrCode_name[12929:12959],
-	46017: _ErrCode_name[12959:12991],
-	46018: _ErrCode_name[12991:13012],
-	46019: _ErrCode_name[13012:13049],
-	46020: _ErrCode_name[13049:13074],
-	46021: _ErrCode_name[13074:13100],
-	46022: _ErrCode_name[13100:13131],
-	46023: _ErrCode_name[13131:13158],
-	46024: _ErrCode_name[13158:13177],
-	46025: _ErrCode_name[13177:13201],
-	46026: _ErrCode_name[13201:13226],
-	46027: _ErrCode_name[13226:13260],
-	46028: _ErrCode_name[13260:13290],
-	46029: _ErrCode_name[13290:13319],
-	46030: _ErrCode_name[13319:13345],
-	46031: _ErrCode_name[13345:13370],
-	46032: _ErrCode_name[13370:13405],
-	46033: _ErrCode_name[13405:13427],
-	46034: _ErrCode_name[13427:13451],
-	46035: _ErrCode_name[13451:13476],
-	48001: _ErrCode_name[13476:13493],
-	48002: _ErrCode_name[13493:13509],
-	48003: _ErrCode_name[13509:13522],
-	49001: _ErrCode_name[13522:13535],
-	49002: _ErrCode_name[13535:13560],
-	50000: _ErrCode_name[13560:13566],
+	20068: _ErrCode_name[4311:4335],
+	20069: _ErrCode_name[4335:4360],
+	20070: _ErrCode_name[4360:4388],
+	20071: _ErrCode_name[4388:4416],
+	20072: _ErrCode_name[4416:4440],
+	22001: _ErrCode_name[4440:4461],
+	22002: _ErrCode_name[4461:4482],
+	22003: _ErrCode_name[4482:4503],
+	24001: _ErrCode_name[4503:4528],
+	24002: _ErrCode_name[4528:4552],
+	24003: _ErrCode_name[4552:4578],
+	24004: _ErrCode_name[4578:4604],
+	24005: _ErrCode_name[4604:4633],
+	24006: _ErrCode_name[4633:4662],
+	26001: _ErrCode_name[4662:4684],
+	26002: _ErrCode_name[4684:4705],
+	26003: _ErrCode_name[4705:4728],
+	26004: _ErrCode_name[4728:4753],
+	26005: _ErrCode_name[4753:4777],
+	26006: _ErrCode_name[4777:4795],
+	26007: _ErrCode_name[4795:4810],
+	28001: _ErrCode_name[4810:4829],
+	28002: _ErrCode_name[4829:4849],
+	28003: _ErrCode_name[4849:4876],
+	28004: _ErrCode_name[4876:4899],
+	28005: _ErrCode_name[4899:4922],
+	30001: _ErrCode_name[4922:4945],
+	30002: _ErrCode_name[4945:4972],
+	30003: _ErrCode_name[4972:4989],
+	30004: _ErrCode_name[4989:5012],
+	30005: _ErrCode_name[5012:5030],
+	30006: _ErrCode_name[5030:5049],
+	30007: _ErrCode_name[5049:5069],
+	30008: _ErrCode_name[5069:5089],
+	30009: _ErrCode_name[5089:5111],
+	30010: _ErrCode_name[5111:5138],
+	30011: _ErrCode_name[5138:5158],
+	30012: _ErrCode_name[5158:5181],
+	30013: _ErrCode_name[5181:5202],
+	30014: _ErrCode_name[5202:5229],
+	30015: _ErrCode_name[5229:5251],
+	30016: _ErrCode_name[5251:5273],
+	30017: _ErrCode_name[5273:5300],
+	30018: _ErrCode_name[5300:5320],
+	30019: _ErrCode_name[5320:5340],
+	30020: _ErrCode_name[5340:5365],
+	30021: _ErrCode_name[5365:5396],
+	30022: _ErrCode_name[5396:5421],
+	30023: _ErrCode_name[5421:5443],
+	30024: _ErrCode_name[5443:5473],
+	30025: _ErrCode_name[5473:5495],
+	30026: _ErrCode_name[5495:5526],
+	30027: _ErrCode_name[5526:5556],
+	30028: _ErrCode_name[5556:5588],
+	30029: _ErrCode_name[5588:5614],
+	30030: _ErrCode_name[5614:5629],
+	30031: _ErrCode_name[5629:5660],
+	30032: _ErrCode_name[5660:5693],
+	30033: _ErrCode_name[5693:5703],
+	30034: _ErrCode_name[5703:5728],
+	30035: _ErrCode_name[5728:5754],
+	30036: _ErrCode_name[5754:5781],
+	30037: _ErrCode_name[5781:5802],
+	30038: _ErrCode_name[5802:5823],
+	30039: _ErrCode_name[5823:5848],
+	30040: _ErrCode_name[5848:5869],
+	30041: _ErrCode_name[5869:5888],
+	30042: _ErrCode_name[5888:5910],
+	30043: _ErrCode_name[5910:5931],
+	30044: _ErrCode_name[5931:5963],
+	30045: _ErrCode_name[5963:5983],
+	32001: _ErrCode_name[5983:5998],
+	32002: _ErrCode_name[5998:6020],
+	32003: _ErrCode_name[6020:6037],
+	32004: _ErrCode_name[6037:6055],
+	34001: _ErrCode_name[6055:6079],
+	34002: _ErrCode_name[6079:6104],
+	34003: _ErrCode_name[6104:6128],
+	34004: _ErrCode_name[6128:6151],
+	34005: _ErrCode_name[6151:6173],
+	34006: _ErrCode_name[6173:6195],
+	34007: _ErrCode_name[6195:6217],
+	34008: _ErrCode_name[6217:6244],
+	34009: _ErrCode_name[6244:6268],
+	34010: _ErrCode_name[6268:6290],
+	34011: _ErrCode_name[6290:6314],
+	34012: _ErrCode_name[6314:6330],
+	34013: _ErrCode_name[6330:6349],
+	34014: _ErrCode_name[6349:6372],
+	34015: _ErrCode_name[6372:6398],
+	34016: _ErrCode_name[6398:6415],
+	34017: _ErrCode_name[6415:6437],
+	34018: _ErrCode_name[6437:6459],
+	34019: _ErrCode_name[6459:6479],
+	34020: _ErrCode_name[6479:6498],
+	34021: _ErrCode_name[6498:6519],
+	36001: _ErrCode_name[6519:6534],
+	36002: _ErrCode_name[6534:6558],
+	36003: _ErrCode_name[6558:6580],
+	36004: _ErrCode_name[6580:6603],
+	36005: _ErrCode_name[6603:6629],
+	36006: _ErrCode_name[6629:6662],
+	36007: _ErrCode_name[6662:6686],
+	36008: _ErrCode_name[6686:6710],
+	36009: _ErrCode_name[6710:6738],
+	36010: _ErrCode_name[6738:6759],
+	36011: _ErrCode_name[6759:6788],
+	36012: _ErrCode_name[6788:6812],
+	36013: _ErrCode_name[6812:6837],
+	36014: _ErrCode_name[6837:6862],
+	36015: _ErrCode_name[6862:6889],
+	36016: _ErrCode_name[6889:6918],
+	36017: _ErrCode_name[6918:6937],
+	36018: _ErrCode_name[6937:6960],
+	36019: _ErrCode_name[6960:6992],
+	36020: _ErrCode_name[6992:7013],
+	36021: _ErrCode_name[7013:7038],
+	36022: _ErrCode_name[7038:7066],
+	36023: _ErrCode_name[7066:7089],
+	36024: _ErrCode_name[7089:7121],
+	36025: _ErrCode_name[7121:7150],
+	36026: _ErrCode_name[7150:7174],
+	36027: _ErrCode_name[7174:7201],
+	36028: _ErrCode_name[7201:7233],
+	36029: _ErrCode_name[7233:7265],
+	36030: _ErrCode_name[7265:7295],
+	36031: _ErrCode_name[7295:7319],
+	36032: _ErrCode_name[7319:7345],
+	36033: _ErrCode_name[7345:7370],
+	36034: _ErrCode_name[7370:7396],
+	36035: _ErrCode_name[7396:7426],
+	36036: _ErrCode_name[7426:7457],
+	36037: _ErrCode_name[7457:7490],
+	36038: _ErrCode_name[7490:7523],
+	36039: _ErrCode_name[7523:7553],
+	36040: _ErrCode_name[7553:7588],
+	36041: _ErrCode_name[7588:7622],
+	36042: _ErrCode_name[7622:7652],
+	36043: _ErrCode_name[7652:7686],
+	36044: _ErrCode_name[7686:7719],
+	36045: _ErrCode_name[7719:7755],
+	36046: _ErrCode_name[7755:7789],
+	36047: _ErrCode_name[7789:7816],
+	36048: _ErrCode_name[7816:7847],
+	36049: _ErrCode_name[7847:7874],
+	36050: _ErrCode_name[7874:7904],
+	36051: _ErrCode_name[7904:7932],
+	36052: _ErrCode_name[7932:7963],
+	36053: _ErrCode_name[7963:7995],
+	36054: _ErrCode_name[7995:8019],
+	36055: _ErrCode_name[8019:8048],
+	36056: _ErrCode_name[8048:8078],
+	36057: _ErrCode_name[8078:8110],
+	36058: _ErrCode_name[8110:8142],
+	36059: _ErrCode_name[8142:8173],
+	36060: _ErrCode_name[8173:8192],
+	36061: _ErrCode_name[8192:8217],
+	36062: _ErrCode_name[8217:8239],
+	36063: _ErrCode_name[8239:8254],
+	36064: _ErrCode_name[8254:8265],
+	36065: _ErrCode_name[8265:8287],
+	36066: _ErrCode_name[8287:8306],
+	36067: _ErrCode_name[8306:8320],
+	36068: _ErrCode_name[8320:8341],
+	36069: _ErrCode_name[8341:8355],
+	36070: _ErrCode_name[8355:8384],
+	36071: _ErrCode_name[8384:8415],
+	36072: _ErrCode_name[8415:8431],
+	36073: _ErrCode_name[8431:8459],
+	38001: _ErrCode_name[8459:8480],
+	38002: _ErrCode_name[8480:8501],
+	38003: _ErrCode_name[8501:8527],
+	38004: _ErrCode_name[8527:8547],
+	38005: _ErrCode_name[8547:8572],
+	38006: _ErrCode_name[8572:8593],
+	38007: _ErrCode_name[8593:8617],
+	38008: _ErrCode_name[8617:8639],
+	38009: _ErrCode_name[8639:8663],
+	38010: _ErrCode_name[8663:8687],
+	38011: _ErrCode_name[8687:8710],
+	38012: _ErrCode_name[8710:8733],
+	38013: _ErrCode_name[8733:8758],
+	38014: _ErrCode_name[8758:8782],
+	38015: _ErrCode_name[8782:8807],
+	38016: _ErrCode_name[8807:8828],
+	38017: _ErrCode_name[8828:8846],
+	38018: _ErrCode_name[8846:8863],
+	38019: _ErrCode_name[8863:8881],
+	38020: _ErrCode_name[8881:8902],
+	38021: _ErrCode_name[8902:8925],
+	38022: _ErrCode_name[8925:8948],
+	38023: _ErrCode_name[8948:8970],
+	38024: _ErrCode_name[8970:8988],
+	38025: _ErrCode_name[8988:9015],
+	38026: _ErrCode_name[9015:9039],
+	38027: _ErrCode_name[9039:9066],
+	38028: _ErrCode_name[9066:9091],
+	38029: _ErrCode_name[9091:9116],
+	38030: _ErrCode_name[9116:9139],
+	38031: _ErrCode_name[9139:9157],
+	38032: _ErrCode_name[9157:9181],
+	38033: _ErrCode_name[9181:9205],
+	38034: _ErrCode_name[9205:9225],
+	38035: _ErrCode_name[9225:9247],
+	38036: _ErrCode_name[9247:9268],
+	38037: _ErrCode_name[9268:9296],
+	38038: _ErrCode_name[9296:9320],
+	38039: _ErrCode_name[9320:9338],
+	38040: _ErrCode_name[9338:9361],
+	38041: _ErrCode_name[9361:9383],
+	38042: _ErrCode_name[9383:9410],
+	38043: _ErrCode_name[9410:9443],
+	38044: _ErrCode_name[9443:9466],
+	38045: _ErrCode_name[9466:9493],
+	38046: _ErrCode_name[9493:9518],
+	38047: _ErrCode_name[9518:9542],
+	38048: _ErrCode_name[9542:9566],
+	38049: _ErrCode_name[9566:9590],
+	38050: _ErrCode_name[9590:9621],
+	38051: _ErrCode_name[9621:9644],
+	38052: _ErrCode_name[9644:9663],
+	38053: _ErrCode_name[9663:9689],
+	38054: _ErrCode_name[9689:9726],
+	38055: _ErrCode_name[9726:9765],
+	38056: _ErrCode_name[9765:9803],
+	38057: _ErrCode_name[9803:9825],
+	38058: _ErrCode_name[9825:9840],
+	40001: _ErrCode_name[9840:9858],
+	40002: _ErrCode_name[9858:9875],
+	40003: _ErrCode_name[9875:9901],
+	40004: _ErrCode_name[9901:9928],
+	40005: _ErrCode_name[9928:9946],
+	40006: _ErrCode_name[9946:9967],
+	40007: _ErrCode_name[9967:9988],
+	40008: _ErrCode_name[9988:10009],
+	40009: _ErrCode_name[10009:10032],
+	40010: _ErrCode_name[10032:10055],
+	40011: _ErrCode_name[10055:10076],
+	40012: _ErrCode_name[10076:10101],
+	40013: _ErrCode_name[10101:10122],
+	40014: _ErrCode_name[10122:10146],
+	40015: _ErrCode_name[10146:10171],
+	40016: _ErrCode_name[10171:10192],
+	40017: _ErrCode_name[10192:10211],
+	40018: _ErrCode_name[10211:10235],
+	40019: _ErrCode_name[10235:10258],
+	40020: _ErrCode_name[10258:10278],
+	40021: _ErrCode_name[10278:10295],
+	40022: _ErrCode_name[10295:10312],
+	40023: _ErrCode_name[10312:10333],
+	40024: _ErrCode_name[10333:10359],
+	40025: _ErrCode_name[10359:10385],
+	40026: _ErrCode_name[10385:10408],
+	40027: _ErrCode_name[10408:10429],
+	40028: _ErrCode_name[10429:10449],
+	40029: _ErrCode_name[10449:10472],
+	40030: _ErrCode_name[10472:10495],
+	40031: _ErrCode_name[10495:10516],
+	40032: _ErrCode_name[10516:10537],
+	40033: _ErrCode_name[10537:10557],
+	40034: _ErrCode_name[10557:10579],
+	40035: _ErrCode_name[10579:10604],
+	40036: _ErrCode_name[10604:10629],
+	40037: _ErrCode_name[10629:10646],
+	40038: _ErrCode_name[10646:10665],
+	40039: _ErrCode_name[10665:10689],
+	40040: _ErrCode_name[10689:10714],
+	40041: _ErrCode_name[10714:10732],
+	40042: _ErrCode_name[10732:10755],
+	40043: _ErrCode_name[10755:10777],
+	40044: _ErrCode_name[10777:10801],
+	40045: _ErrCode_name[10801:10823],
+	40046: _ErrCode_name[10823:10844],
+	40047: _ErrCode_name[10844:10866],
+	40048: _ErrCode_name[10866:10884],
+	40049: _ErrCode_name[10884:10903],
+	40050: _ErrCode_name[10903:10924],
+	40051: _ErrCode_name[10924:10944],
+	40052: _ErrCode_name[10944:10965],
+	40053: _ErrCode_name[10965:10987],
+	40054: _ErrCode_name[10987:11008],
+	40055: _ErrCode_name[11008:11027],
+	40056: _ErrCode_name[11027:11049],
+	40057: _ErrCode_name[11049:11069],
+	40058: _ErrCode_name[11069:11090],
+	40059: _ErrCode_name[11090:11116],
+	40060: _ErrCode_name[11116:11134],
+	40061: _ErrCode_name[11134:11159],
+	40062: _ErrCode_name[11159:11182],
+	40063: _ErrCode_name[11182:11206],
+	40064: _ErrCode_name[11206:11231],
+	40065: _ErrCode_name[11231:11254],
+	40066: _ErrCode_name[11254:11274],
+	40067: _ErrCode_name[11274:11303],
+	40068: _ErrCode_name[11303:11323],
+	40069: _ErrCode_name[11323:11345],
+	40070: _ErrCode_name[11345:11358],
+	40071: _ErrCode_name[11358:11378],
+	40072: _ErrCode_name[11378:11398],
+	40073: _ErrCode_name[11398:11434],
+	40074: _ErrCode_name[11434:11469],
+	40075: _ErrCode_name[11469:11492],
+	40076: _ErrCode_name[11492:11515],
+	40077: _ErrCode_name[11515:11538],
+	40078: _ErrCode_name[11538:11564],
+	40079: _ErrCode_name[11564:11589],
+	40080: _ErrCode_name[11589:11613],
+	40081: _ErrCode_name[11613:11638],
+	40082: _ErrCode_name[11638:11662],
+	40083: _ErrCode_name[11662:11680],
+	42001: _ErrCode_name[11680:11698],
+	42002: _ErrCode_name[11698:11723],
+	42003: _ErrCode_name[11723:11746],
+	42004: _ErrCode_name[11746:11770],
+	42005: _ErrCode_name[11770:11794],
+	42006: _ErrCode_name[11794:11813],
+	42007: _ErrCode_name[11813:11833],
+	42008: _ErrCode_name[11833:11857],
+	42009: _ErrCode_name[11857:11880],
+	42010: _ErrCode_name[11880:11898],
+	42501: _ErrCode_name[11898:11916],
+	42502: _ErrCode_name[11916:11929],
+	42503: _ErrCode_name[11929:11944],
+	42504: _ErrCode_name[11944:11964],
+	42505: _ErrCode_name[11964:11979],
+	43001: _ErrCode_name[11979:12005],
+	43002: _ErrCode_name[12005:12025],
+	43003: _ErrCode_name[12025:12042],
+	43004: _ErrCode_name[12042:12066],
+	43005: _ErrCode_name[12066:12089],
+	43006: _ErrCode_name[12089:12106],
+	43007: _ErrCode_name[12106:12120],
+	43008: _ErrCode_name[12120:12143],
+	44001: _ErrCode_name[12143:12167],
+	44002: _ErrCode_name[12167:12198],
+	44003: _ErrCode_name[12198:12228],
+	44004: _ErrCode_name[12228:12256],
+	44005: _ErrCode_name[12256:12283],
+	44006: _ErrCode_name[12283:12309],
+	44007: _ErrCode_name[12309:12348],
+	44008: _ErrCode_name[12348:12387],
+	44009: _ErrCode_name[12387:12422],
+	44010: _ErrCode_name[12422:12450],
+	44011: _ErrCode_name[12450:12478],
+	44012: _ErrCode_name[12478:12495],
+	44013: _ErrCode_name[12495:12519],
+	44014: _ErrCode_name[12519:12545],
+	44015: _ErrCode_name[12545:12574],
+	44016: _ErrCode_name[12574:12613],
+	44017: _ErrCode_name[12613:12652],
+	44018: _ErrCode_name[12652:12690],
+	44019: _ErrCode_name[12690:12739],
+	44020: _ErrCode_name[12739:12760],
+	46001: _ErrCode_name[12760:12779],
+	46002: _ErrCode_name[12779:12795],
+	46003: _ErrCode_name[12795:12815],
+	46004: _ErrCode_name[12815:12838],
+	46005: _ErrCode_name[12838:12859],
+	46006: _ErrCode_name[12859:12886],
+	46007: _ErrCode_name[12886:12909],
+	46008: _ErrCode_name[12909:12935],
+	46009: _ErrCode_name[12935:12958],
+	46010: _ErrCode_name[12958:12984],
+	46011: _ErrCode_name[12984:13016],
+	46012: _ErrCode_name[13016:13049],
+	46013: _ErrCode_name[13049:13067],
+	46014: _ErrCode_name[13067:13088],
+	46015: _ErrCode_name[13088:13122],
+	46016: _ErrCode_name[13122:13152],
+	46017: _ErrCode_name[13152:13184],
+	46018: _ErrCode_name[13184:13205],
+	46019: _ErrCode_name[13205:13242],
+	46020: _ErrCode_name[13242:13267],
+	46021: _ErrCode_name[13267:13293],
+	46022: _ErrCode_name[13293:13324],
+	46023: _ErrCode_name[13324:13351],
+	46024: _ErrCode_name[13351:13370],
+	46025: _ErrCode_name[13370:13394],
+	46026: _ErrCode_name[13394:13419],
+	46027: _ErrCode_name[13419:13453],
+	46028: _ErrCode_name[13453:13483],
+	46029: _ErrCode_name[13483:13512],
+	46030: _ErrCode_name[13512:13538],
+	46031: _ErrCode_name[13538:13563],
+	46032: _ErrCode_name[13563:13598],
+	46033: _ErrCode_name[13598:13620],
+	46034: _ErrCode_name[13620:13644],
+	46035: _ErrCode_name[13644:13669],
+	48001: _ErrCode_name[13669:13686],
+	48002: _ErrCode_name[13686:13702],
+	48003: _ErrCode_name[13702:13715],
+	49001: _ErrCode_name[13715:13728],
+	49002: _ErrCode_name[13728:13753],
+	50000: _ErrCode_name[13753:13759],
 }
 
 func (i ErrCode) String() string {
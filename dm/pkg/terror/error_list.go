@@ -273,6 +273,11 @@ const (
 	codeConfigInvalidLoadAnalyze
 	codeConfigStrictOptimisticShardMode
 	codeConfigSecretKeyPath
+	codeConfigInvalidTargetKafka
+	codeConfigInvalidRelayStorage
+	codeConfigInvalidShardLockPolicy
+	codeConfigInvalidBinlogErrorRule
+	codeConfigInvalidDMLThrottle
 )
 
 // Binlog operation error code list.
@@ -358,6 +363,7 @@ const (
 	codeRelayPurgeArgsNotValid
 	codePreviousGTIDsNotValid
 	codeRotateEventWithDifferentServerID
+	codeRelayExternalStorage
 )
 
 // Dump unit error code.
@@ -466,6 +472,8 @@ const (
 	codeSyncerGetEvent
 	codeSyncerDownstreamTableNotFound
 	codeSyncerReprocessWithSafeModeFail
+	codeSyncerWriteKafka
+	codeSyncerBinlogErrorRuleApplied
 )
 
 // DM-master error code.
@@ -987,6 +995,11 @@ var (
 	ErrConfigInvalidLoadAnalyze                 = New(codeConfigInvalidLoadAnalyze, ClassConfig, ScopeInternal, LevelMedium, "invalid load analyze option '%s'", "Please choose a valid value in ['required', 'optional', 'off'] or leave it empty.")
 	ErrConfigStrictOptimisticShardMode          = New(codeConfigStrictOptimisticShardMode, ClassConfig, ScopeInternal, LevelMedium, "cannot enable `strict-optimistic-shard-mode` while `shard-mode` is not `optimistic`", "Please set `shard-mode` to `optimistic` if you want to enable `strict-optimistic-shard-mode`.")
 	ErrConfigSecretKeyPath                      = New(codeConfigSecretKeyPath, ClassConfig, ScopeInternal, LevelHigh, "invalid secret key path or content: %v", "Please check whether the path is valid, and has required permission to read the file, and the key is correct.")
+	ErrConfigInvalidTargetKafka                 = New(codeConfigInvalidTargetKafka, ClassConfig, ScopeInternal, LevelMedium, "invalid `target-kafka` config: %s", "Please check the `target-kafka` config in task configuration file.")
+	ErrConfigInvalidRelayStorage                = New(codeConfigInvalidRelayStorage, ClassConfig, ScopeInternal, LevelMedium, "invalid `relay-storage` config: %s", "Please check the `relay-storage` config in source configuration file.")
+	ErrConfigInvalidShardLockPolicy             = New(codeConfigInvalidShardLockPolicy, ClassConfig, ScopeInternal, LevelMedium, "invalid `shard-lock-policy` config: %s", "Please check the `shard-lock-policy` config in task configuration file.")
+	ErrConfigInvalidBinlogErrorRule             = New(codeConfigInvalidBinlogErrorRule, ClassConfig, ScopeInternal, LevelMedium, "invalid `binlog-error-rules` config: %s", "Please check the `binlog-error-rules` config in task configuration file.")
+	ErrConfigInvalidDMLThrottle                 = New(codeConfigInvalidDMLThrottle, ClassConfig, ScopeInternal, LevelMedium, "invalid `throttle` config of syncer: %s", "Please check the `throttle` config of `syncers` in task configuration file.")
 
 	// Binlog operation error.
 	ErrBinlogExtractPosition = New(codeBinlogExtractPosition, ClassBinlogOp, ScopeInternal, LevelHigh, "", "")
@@ -1062,6 +1075,7 @@ var (
 	ErrRelayPurgeArgsNotValid            = New(codeRelayPurgeArgsNotValid, ClassRelayUnit, ScopeInternal, LevelHigh, "args (%T) %+v not valid", "")
 	ErrPreviousGTIDsNotValid             = New(codePreviousGTIDsNotValid, ClassRelayUnit, ScopeInternal, LevelHigh, "previousGTIDs %s not valid", "")
 	ErrRotateEventWithDifferentServerID  = New(codeRotateEventWithDifferentServerID, ClassRelayUnit, ScopeInternal, LevelHigh, "receive fake rotate event with different server_id", "Please use `resume-relay` command if upstream database has changed")
+	ErrRelayExternalStorage              = New(codeRelayExternalStorage, ClassRelayUnit, ScopeInternal, LevelHigh, "fail to %s %s in relay log external storage", "Please check whether the external storage in `relay-storage` is available.")
 
 	// Dump unit error.
 	ErrDumpUnitRuntime        = New(codeDumpUnitRuntime, ClassDumpUnit, ScopeInternal, LevelHigh, "mydumper/dumpling runs with error, with output (may empty): %s", "")
@@ -1166,6 +1180,8 @@ var (
 	ErrSyncerDownstreamTableNotFound        = New(codeSyncerDownstreamTableNotFound, ClassSyncUnit, ScopeInternal, LevelHigh, "downstream table %s not found", "")
 	ErrSyncerCancelledDDL                   = New(codeSyncerCancelledDDL, ClassSyncUnit, ScopeInternal, LevelHigh, "DDL %s executed in background and met error", "Please manually check the error from TiDB and handle it.")
 	ErrSyncerReprocessWithSafeModeFail      = New(codeSyncerReprocessWithSafeModeFail, ClassSyncUnit, ScopeInternal, LevelMedium, "your `safe-mode-duration` in task.yaml is set to 0s, the task can't be re-processed without safe mode currently", "Please stop and re-start this task. If you want to start task successfully, you need set `safe-mode-duration` greater than `0s`.")
+	ErrSyncerWriteKafka                     = New(codeSyncerWriteKafka, ClassSyncUnit, ScopeDownstream, LevelHigh, "write %s to kafka failed", "Please check whether the Kafka cluster in `target-kafka` is available.")
+	ErrSyncerBinlogErrorRuleApplied         = New(codeSyncerBinlogErrorRuleApplied, ClassSyncUnit, ScopeInternal, LevelLow, "binlog error rule %s is applied to the binlog event at %s, origin error: %s", "The task will continue automatically, please check the `binlogErrorRuleRecords` in `query-status`.")
 
 	// DM-master error.
 	ErrMasterSQLOpNilRequest        = New(codeMasterSQLOpNilRequest, ClassDMMaster, ScopeInternal, LevelMedium, "nil request not valid", "")
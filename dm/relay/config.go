@@ -40,6 +40,11 @@ type Config struct {
 
 	// for binlog reader retry
 	ReaderRetry ReaderRetryConfig `toml:"reader-retry" json:"reader-retry"`
+
+	// for keeping sealed relay log files in external storage
+	RelayStorage config.RelayStorageConfig `toml:"relay-storage" json:"relay-storage"`
+	// the relay log files in external storage are kept under the source ID
+	SourceID string `toml:"source-id" json:"source-id"`
 }
 
 func (c *Config) String() string {
@@ -70,6 +75,8 @@ func FromSourceCfg(sourceCfg *config.SourceConfig) *Config {
 			BackoffJitter:   clone.Checker.BackoffJitter,
 			BackoffFactor:   clone.Checker.BackoffFactor,
 		},
+		RelayStorage: clone.RelayStorage,
+		SourceID:     clone.SourceID,
 	}
 	return cfg
 }
@@ -0,0 +1,368 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/go-mysql-org/go-mysql/replication"
+	bstorage "github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/binlog/event"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	dmstorage "github.com/pingcap/tiflow/dm/pkg/storage"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+	"go.uber.org/zap"
+)
+
+const (
+	// placeholderSuffix is the suffix of the temporary placeholder file, which
+	// is not a valid binlog filename so it's ignored when collecting relay log files.
+	placeholderSuffix = ".offloading"
+	uploadBufferSize  = 4 * 1024 * 1024
+)
+
+// externalStorage keeps the sealed relay log files in external storage.
+//
+// A relay log file is uploaded once it's not one of the latest `keep-local-files`
+// files in its sub directory, then the local file is replaced by an empty
+// placeholder with the same name and modification time. So relay-dir still has
+// the full list of relay log files, and the purger works on it as before. The
+// binlog reader reads the external copy when it meets a placeholder, and the
+// external copies whose placeholders have been purged are removed later.
+//
+// The external copies are kept in `<source-id>/<sub-dir>/<filename>`, so the
+// sources sharing a storage don't see each other's files.
+type externalStorage struct {
+	cfg      config.RelayStorageConfig
+	sourceID string
+	relayDir string
+	storage  bstorage.ExternalStorage
+
+	logger log.Logger
+}
+
+// newExternalStorage creates an externalStorage by the relay-storage config.
+func newExternalStorage(ctx context.Context, cfg config.RelayStorageConfig, sourceID, relayDir string) (*externalStorage, error) {
+	storage, err := dmstorage.CreateStorage(ctx, cfg.URL)
+	if err != nil {
+		return nil, terror.ErrRelayExternalStorage.Delegate(err, "open", "storage")
+	}
+	return &externalStorage{
+		cfg:      cfg,
+		sourceID: sourceID,
+		relayDir: relayDir,
+		storage:  storage,
+		logger:   log.With(zap.String("component", "relay external storage"), zap.String("source", sourceID)),
+	}, nil
+}
+
+// run uploads the sealed relay log files and removes the purged ones periodically until ctx is done.
+func (s *externalStorage) run(ctx context.Context) {
+	s.logger.Info("starting relay log external storage", zap.String("storage", s.storage.URI()),
+		zap.Int64("interval", s.cfg.Interval), zap.Int("keep local files", s.cfg.KeepLocalFiles))
+
+	ticker := time.NewTicker(time.Duration(s.cfg.Interval) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.offload(ctx); err != nil {
+				s.logger.Error("upload relay log files", zap.Error(err))
+			}
+			if err := s.removePurged(ctx); err != nil {
+				s.logger.Error("remove purged relay log files", zap.Error(err))
+			}
+		}
+	}
+}
+
+// offload uploads the relay log files except the latest KeepLocalFiles files in each sub directory.
+// the latest file in a sub directory is always kept, because it may be still written by relay.
+func (s *externalStorage) offload(ctx context.Context) error {
+	subDirs, err := utils.ParseUUIDIndex(filepath.Join(s.relayDir, utils.UUIDIndexFilename))
+	if err != nil {
+		return terror.Annotatef(err, "parse UUID index file in %s", s.relayDir)
+	}
+
+	for _, subDir := range subDirs {
+		dir := filepath.Join(s.relayDir, subDir)
+		if !utils.IsDirExists(dir) {
+			continue
+		}
+		files, err := CollectAllBinlogFiles(dir)
+		if err != nil {
+			return terror.Annotatef(err, "dir %s", dir)
+		}
+		for i := 0; i < len(files)-s.cfg.KeepLocalFiles; i++ {
+			if err = s.offloadFile(ctx, subDir, files[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// offloadFile uploads a sealed relay log file and replaces it with a placeholder.
+func (s *externalStorage) offloadFile(ctx context.Context, subDir, filename string) error {
+	fullPath := filepath.Join(s.relayDir, subDir, filename)
+	fi, err := os.Stat(fullPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil // purged
+		}
+		return terror.ErrGetRelayLogStat.Delegate(err, fullPath)
+	}
+	if fi.Size() == 0 {
+		return nil // already uploaded
+	}
+
+	key := s.externalKey(subDir, filename)
+	if err = s.upload(ctx, fullPath, key); err != nil {
+		return terror.ErrRelayExternalStorage.Delegate(err, "upload", key)
+	}
+
+	// replace the file atomically, readers which have opened the file can still read it.
+	placeholder := fullPath + placeholderSuffix
+	if err = os.WriteFile(placeholder, nil, 0o600); err != nil {
+		return terror.Annotatef(terror.ErrRelayWriterFileOperate.New(err.Error()), "create %s", placeholder)
+	}
+	if err = os.Chtimes(placeholder, fi.ModTime(), fi.ModTime()); err != nil {
+		return terror.Annotatef(terror.ErrRelayWriterFileOperate.New(err.Error()), "change times of %s", placeholder)
+	}
+	if !utils.IsFileExists(fullPath) {
+		// purged during uploading
+		if err = os.Remove(placeholder); err != nil {
+			return terror.Annotatef(terror.ErrRelayWriterFileOperate.New(err.Error()), "remove %s", placeholder)
+		}
+		return nil
+	}
+	if err = os.Rename(placeholder, fullPath); err != nil {
+		return terror.Annotatef(terror.ErrRelayWriterFileOperate.New(err.Error()), "rename %s", placeholder)
+	}
+	s.logger.Info("relay log file is uploaded", zap.String("file", fullPath), zap.Int64("size", fi.Size()))
+	return nil
+}
+
+func (s *externalStorage) upload(ctx context.Context, fullPath, key string) error {
+	f, err := os.Open(fullPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w, err := s.storage.Create(ctx, key, nil)
+	if err != nil {
+		return err
+	}
+	buf := make([]byte, uploadBufferSize)
+	for {
+		n, err2 := f.Read(buf)
+		if n > 0 {
+			if _, err = w.Write(ctx, buf[:n]); err != nil {
+				_ = w.Close(ctx)
+				return err
+			}
+		}
+		if err2 == io.EOF {
+			break
+		} else if err2 != nil {
+			_ = w.Close(ctx)
+			return err2
+		}
+	}
+	return w.Close(ctx)
+}
+
+// removePurged removes the external copies whose local placeholders have been purged.
+// Only the sub directories in the UUID index of this relay are checked.
+func (s *externalStorage) removePurged(ctx context.Context) error {
+	subDirs, err := utils.ParseUUIDIndex(filepath.Join(s.relayDir, utils.UUIDIndexFilename))
+	if err != nil {
+		return terror.Annotatef(err, "parse UUID index file in %s", s.relayDir)
+	}
+
+	var purged []string
+	for _, subDir := range subDirs {
+		prefix := s.externalKey(subDir, "")
+		err = s.storage.WalkDir(ctx, &bstorage.WalkOption{SubDir: prefix}, func(path string, _ int64) error {
+			filename, ok := strings.CutPrefix(path, prefix)
+			if !ok || filename == "" || strings.Contains(filename, "/") {
+				return nil
+			}
+			if !utils.IsFileExists(filepath.Join(s.relayDir, subDir, filename)) {
+				purged = append(purged, path)
+			}
+			return nil
+		})
+		if err != nil {
+			return terror.ErrRelayExternalStorage.Delegate(err, "list", prefix)
+		}
+	}
+	if len(purged) == 0 {
+		return nil
+	}
+	if err = s.storage.DeleteFiles(ctx, purged); err != nil {
+		return terror.ErrRelayExternalStorage.Delegate(err, "delete", purged)
+	}
+	s.logger.Info("purged relay log files are removed", zap.Strings("files", purged))
+	return nil
+}
+
+// externalKey returns the path of the relay log file in external storage.
+func (s *externalStorage) externalKey(subDir, filename string) string {
+	return s.sourceID + "/" + subDir + "/" + filename
+}
+
+// externalKeyOf returns the path in external storage of the relay log file in relayDir,
+// and whether the local file is a placeholder of the file in external storage.
+func (s *externalStorage) externalKeyOf(ctx context.Context, relayDir, fullPath string) (string, bool, error) {
+	fi, err := os.Stat(fullPath)
+	if err != nil {
+		return "", false, terror.ErrGetRelayLogStat.Delegate(err, fullPath)
+	}
+	if fi.Size() != 0 {
+		return "", false, nil
+	}
+	rel, err := filepath.Rel(relayDir, fullPath)
+	if err != nil {
+		return "", false, terror.ErrGetRelayLogStat.Delegate(err, fullPath)
+	}
+	subDir, filename := filepath.Split(rel)
+	key := s.externalKey(filepath.Clean(subDir), filename)
+	exist, err := s.storage.FileExists(ctx, key)
+	if err != nil {
+		return "", false, terror.ErrRelayExternalStorage.Delegate(err, "check", key)
+	}
+	return key, exist, nil
+}
+
+// openRelayLogFile opens the relay log file in relayDir, it opens the copy in
+// external storage if the local file is a placeholder.
+func openRelayLogFile(ctx context.Context, external *externalStorage, relayDir, fullPath string) (io.ReadSeekCloser, error) {
+	if external != nil {
+		key, offloaded, err := external.externalKeyOf(ctx, relayDir, fullPath)
+		if err != nil {
+			return nil, err
+		}
+		if offloaded {
+			r, err := external.storage.Open(ctx, key, nil)
+			if err != nil {
+				return nil, terror.ErrRelayExternalStorage.Delegate(err, "open", key)
+			}
+			return r, nil
+		}
+	}
+	f, err := os.Open(fullPath)
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// relayLogFileSize returns the size of the relay log file in relayDir, it
+// returns the size of the copy in external storage if the local file is a placeholder.
+func relayLogFileSize(ctx context.Context, external *externalStorage, relayDir, fullPath string) (int64, error) {
+	if external != nil {
+		key, offloaded, err := external.externalKeyOf(ctx, relayDir, fullPath)
+		if err != nil {
+			return 0, err
+		}
+		if offloaded {
+			r, err := external.storage.Open(ctx, key, nil)
+			if err != nil {
+				return 0, terror.ErrRelayExternalStorage.Delegate(err, "open", key)
+			}
+			defer r.Close()
+			size, err := r.GetFileSize()
+			if err != nil {
+				return 0, terror.ErrRelayExternalStorage.Delegate(err, "get size of", key)
+			}
+			return size, nil
+		}
+	}
+	fi, err := os.Stat(fullPath)
+	if err != nil {
+		return 0, terror.ErrGetRelayLogStat.Delegate(err, fullPath)
+	}
+	return fi.Size(), nil
+}
+
+// relayLogFileOpener opens the relay log files in relayDir, including the ones
+// whose content has been uploaded to external storage.
+type relayLogFileOpener struct {
+	external *externalStorage
+	relayDir string
+}
+
+// Open implements binlog.RelayLogFileOpener.
+func (o *relayLogFileOpener) Open(ctx context.Context, fullPath string) (io.ReadSeekCloser, error) {
+	return openRelayLogFile(ctx, o.external, o.relayDir, fullPath)
+}
+
+// Size implements binlog.RelayLogFileOpener.
+func (o *relayLogFileOpener) Size(ctx context.Context, fullPath string) (int64, error) {
+	return relayLogFileSize(ctx, o.external, o.relayDir, fullPath)
+}
+
+// previousGTIDsFromReader reads the PreviousGTIDsEvent or MariadbGTIDListEvent of the relay log file.
+func previousGTIDsFromReader(r io.ReadSeeker, filePath string) (mysql.GTIDSet, error) {
+	if _, err := r.Seek(binlog.FileHeaderLen, io.SeekStart); err != nil {
+		return nil, terror.ErrParserParseRelayLog.Delegate(err, filePath)
+	}
+
+	var (
+		gs       mysql.GTIDSet
+		eventErr error
+		found    bool
+	)
+	parser := replication.NewBinlogParser()
+	onEvent := func(e *replication.BinlogEvent) error {
+		switch e.Header.EventType {
+		case replication.PREVIOUS_GTIDS_EVENT:
+			gs, eventErr = event.GTIDsFromPreviousGTIDsEvent(e)
+			found = true
+		case replication.MARIADB_GTID_LIST_EVENT:
+			gs, eventErr = event.GTIDsFromMariaDBGTIDListEvent(e)
+			found = true
+		}
+		return nil
+	}
+	for !found {
+		done, err := parser.ParseSingleEvent(r, onEvent)
+		if err != nil {
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				break
+			}
+			return nil, terror.ErrParserParseRelayLog.Delegate(err, filePath)
+		}
+		if done {
+			break
+		}
+	}
+	if !found {
+		return nil, terror.ErrPreviousGTIDNotExist.Generate(filePath)
+	}
+	return gs, eventErr
+}
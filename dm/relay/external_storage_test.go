@@ -0,0 +1,298 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	gmysql "github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/go-mysql-org/go-mysql/replication"
+	bstorage "github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/binlog/event"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/gtid"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func newExternalStorageForTest(t *testing.T, relayDir string, keepLocalFiles int) *externalStorage {
+	t.Helper()
+
+	return newSharedExternalStorageForTest(t, t.TempDir(), "mysql-replica-01", relayDir, keepLocalFiles)
+}
+
+func newSharedExternalStorageForTest(t *testing.T, storageDir, sourceID, relayDir string, keepLocalFiles int) *externalStorage {
+	t.Helper()
+
+	storage, err := bstorage.NewLocalStorage(storageDir)
+	require.NoError(t, err)
+	return &externalStorage{
+		cfg:      config.RelayStorageConfig{Interval: 1, KeepLocalFiles: keepLocalFiles},
+		sourceID: sourceID,
+		relayDir: relayDir,
+		storage:  storage,
+		logger:   log.L(),
+	}
+}
+
+// genRelayLogFile generates a relay log file with a DDL, and returns the GTID of the DDL.
+func genRelayLogFile(t *testing.T, path string, gSet, latestGTID gmysql.GTIDSet, schema string) gmysql.GTIDSet {
+	t.Helper()
+
+	_, data, err := event.GenCommonFileHeader(gmysql.MySQLFlavor, 1, gSet, true, 0)
+	require.NoError(t, err)
+	result, err := event.GenDDLEvents(gmysql.MySQLFlavor, 1, uint32(len(data)), latestGTID, schema,
+		"CREATE DATABASE "+schema, true, false, 0)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, append(data, result.Data...), 0o600))
+	return result.LatestGTID
+}
+
+func prepareRelayDir(t *testing.T, relayDir, subDir string, fileCount int) (gmysql.GTIDSet, []string) {
+	t.Helper()
+
+	require.NoError(t, os.MkdirAll(filepath.Join(relayDir, subDir), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(relayDir, utils.UUIDIndexFilename), []byte(subDir+"\n"), 0o600))
+
+	gSet, err := gtid.ParserGTID(gmysql.MySQLFlavor, "3ccc475b-2343-11e7-be21-6c0b84d59f30:1-14")
+	require.NoError(t, err)
+	latestGTID, err := gtid.ParserGTID(gmysql.MySQLFlavor, "3ccc475b-2343-11e7-be21-6c0b84d59f30:14")
+	require.NoError(t, err)
+	beginGSet := gSet.Clone()
+	files := make([]string, 0, fileCount)
+	for i := 1; i <= fileCount; i++ {
+		filename := fmt.Sprintf("mysql-bin.%06d", i)
+		latestGTID = genRelayLogFile(t, filepath.Join(relayDir, subDir, filename), gSet, latestGTID, fmt.Sprintf("db_%d", i))
+		require.NoError(t, gSet.Update(latestGTID.String()))
+		files = append(files, filename)
+	}
+
+	meta := LocalMeta{BinLogName: files[len(files)-1], BinLogPos: 4}
+	metaFile, err := os.Create(filepath.Join(relayDir, subDir, utils.MetaFilename))
+	require.NoError(t, err)
+	require.NoError(t, toml.NewEncoder(metaFile).Encode(&meta))
+	require.NoError(t, metaFile.Close())
+	return beginGSet, files
+}
+
+func TestExternalStorageOffload(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		relayDir = t.TempDir()
+		subDir   = "b60868af-5a6f-11e9-9ea3-0242ac160006.000001"
+		s        = newExternalStorageForTest(t, relayDir, 1)
+	)
+	_, files := prepareRelayDir(t, relayDir, subDir, 3)
+
+	contents := make(map[string][]byte, len(files))
+	modTimes := make(map[string]time.Time, len(files))
+	for i, f := range files {
+		fullPath := filepath.Join(relayDir, subDir, f)
+		modTime := time.Now().Add(time.Duration(i-10) * time.Hour).Truncate(time.Second)
+		require.NoError(t, os.Chtimes(fullPath, modTime, modTime))
+		modTimes[f] = modTime
+		contents[f], _ = os.ReadFile(fullPath)
+	}
+
+	require.NoError(t, s.offload(ctx))
+	// offload again is a no-op
+	require.NoError(t, s.offload(ctx))
+
+	for i, f := range files {
+		fullPath := filepath.Join(relayDir, subDir, f)
+		fi, err := os.Stat(fullPath)
+		require.NoError(t, err)
+		require.Equal(t, modTimes[f], fi.ModTime())
+
+		exist, err := s.storage.FileExists(ctx, s.externalKey(subDir, f))
+		require.NoError(t, err)
+		if i == len(files)-1 {
+			// the latest file is kept in local
+			require.False(t, exist)
+			require.Equal(t, int64(len(contents[f])), fi.Size())
+		} else {
+			require.True(t, exist)
+			require.Equal(t, int64(0), fi.Size())
+		}
+
+		// the content can be read from both tiers
+		size, err := relayLogFileSize(ctx, s, relayDir, fullPath)
+		require.NoError(t, err)
+		require.Equal(t, int64(len(contents[f])), size)
+		r, err := openRelayLogFile(ctx, s, relayDir, fullPath)
+		require.NoError(t, err)
+		data, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+		require.Equal(t, contents[f], data)
+	}
+
+	// no placeholder file is left
+	names, err := os.ReadDir(filepath.Join(relayDir, subDir))
+	require.NoError(t, err)
+	for _, name := range names {
+		require.False(t, strings.HasSuffix(name.Name(), placeholderSuffix))
+	}
+
+	// the external copy is removed after the placeholder is purged
+	require.NoError(t, os.Remove(filepath.Join(relayDir, subDir, files[0])))
+	require.NoError(t, s.removePurged(ctx))
+	exist, err := s.storage.FileExists(ctx, s.externalKey(subDir, files[0]))
+	require.NoError(t, err)
+	require.False(t, exist)
+	exist, err = s.storage.FileExists(ctx, s.externalKey(subDir, files[1]))
+	require.NoError(t, err)
+	require.True(t, exist)
+}
+
+func TestExternalStorageSharedBySources(t *testing.T) {
+	var (
+		ctx        = context.Background()
+		storageDir = t.TempDir()
+		relayDir1  = t.TempDir()
+		relayDir2  = t.TempDir()
+		subDir     = "b60868af-5a6f-11e9-9ea3-0242ac160006.000001"
+		s1         = newSharedExternalStorageForTest(t, storageDir, "mysql-replica-01", relayDir1, 1)
+		s2         = newSharedExternalStorageForTest(t, storageDir, "mysql-replica-02", relayDir2, 1)
+	)
+	// the sources have the same sub directory, e.g. they're replicas of the same upstream.
+	_, files := prepareRelayDir(t, relayDir1, subDir, 3)
+	_, files2 := prepareRelayDir(t, relayDir2, subDir, 3)
+	require.Equal(t, files, files2)
+	require.NoError(t, s1.offload(ctx))
+	require.NoError(t, s2.offload(ctx))
+
+	// a sub directory out of the UUID index is not checked.
+	other := newSharedExternalStorageForTest(t, storageDir, "mysql-replica-01", relayDir1, 1)
+	require.NoError(t, other.storage.WriteFile(ctx, other.externalKey("other.000001", files[0]), []byte("x")))
+
+	// the purged file of a source doesn't remove the copy of another source.
+	require.NoError(t, os.Remove(filepath.Join(relayDir1, subDir, files[0])))
+	require.NoError(t, s1.removePurged(ctx))
+	require.NoError(t, s2.removePurged(ctx))
+	for _, c := range []struct {
+		s     *externalStorage
+		key   string
+		exist bool
+	}{
+		{s1, s1.externalKey(subDir, files[0]), false},
+		{s1, s1.externalKey(subDir, files[1]), true},
+		{s1, s1.externalKey("other.000001", files[0]), true},
+		{s2, s2.externalKey(subDir, files[0]), true},
+		{s2, s2.externalKey(subDir, files[1]), true},
+	} {
+		exist, err := c.s.storage.FileExists(ctx, c.key)
+		require.NoError(t, err)
+		require.Equal(t, c.exist, exist, c.key)
+	}
+
+	// both sources read their own copies.
+	for _, c := range []struct {
+		s        *externalStorage
+		relayDir string
+	}{{s1, relayDir1}, {s2, relayDir2}} {
+		fullPath := filepath.Join(c.relayDir, subDir, files[1])
+		r, err := openRelayLogFile(ctx, c.s, c.relayDir, fullPath)
+		require.NoError(t, err)
+		data, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+		require.Greater(t, len(data), binlog.FileHeaderLen)
+	}
+}
+
+func TestBinlogReaderWithExternalStorage(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		relayDir = t.TempDir()
+		subDir   = "b60868af-5a6f-11e9-9ea3-0242ac160006.000001"
+		s        = newExternalStorageForTest(t, relayDir, 1)
+	)
+	beginGSet, files := prepareRelayDir(t, relayDir, subDir, 2)
+	require.NoError(t, s.offload(ctx))
+
+	cfg := &BinlogReaderConfig{RelayDir: relayDir, Flavor: gmysql.MySQLFlavor}
+	r := newBinlogReaderForTest(log.L(), cfg, false, "")
+	r.external = s
+	defer r.Close()
+
+	// find the offloaded file by the PreviousGTIDsEvent
+	require.NoError(t, r.updateSubDirs())
+	pos, err := r.getPosByGTID(beginGSet)
+	require.NoError(t, err)
+	require.Equal(t, "mysql-bin|000001.000001", pos.Name)
+
+	// read across the offloaded file and the local file
+	streamer, err := r.StartSyncByPos(*pos)
+	require.NoError(t, err)
+	var schemas []string
+	for len(schemas) < len(files) {
+		ctx2, cancel := context.WithTimeout(ctx, 10*time.Second)
+		ev, err := streamer.GetEvent(ctx2)
+		cancel()
+		require.NoError(t, err)
+		if query, ok := ev.Event.(*replication.QueryEvent); ok {
+			schemas = append(schemas, string(query.Schema))
+		}
+	}
+	require.Equal(t, []string{"db_1", "db_2"}, schemas)
+}
+
+func TestBinlogPosFinderWithExternalStorage(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		relayDir = t.TempDir()
+		subDir   = "b60868af-5a6f-11e9-9ea3-0242ac160006.000001"
+		s        = newExternalStorageForTest(t, relayDir, 1)
+	)
+	_, files := prepareRelayDir(t, relayDir, subDir, 2)
+	require.NoError(t, s.offload(ctx))
+
+	opener := &relayLogFileOpener{external: s, relayDir: relayDir}
+	fullPath := filepath.Join(relayDir, subDir, files[0])
+	size, err := opener.Size(ctx, fullPath)
+	require.NoError(t, err)
+	require.Greater(t, size, int64(binlog.FileHeaderLen))
+
+	finder := binlog.NewLocalBinlogPosFinder(tcontext.Background(), true, gmysql.MySQLFlavor, filepath.Join(relayDir, subDir), opener)
+	loc, posType, err := finder.FindByTimestamp(0)
+	require.NoError(t, err)
+	require.Equal(t, binlog.BelowLowerBoundBinlogPos, posType)
+	require.Equal(t, files[0], loc.Position.Name)
+}
+
+func TestRelayStorageConfigVerify(t *testing.T) {
+	cfg := config.NewSourceConfig()
+	cfg.SourceID = "source"
+	require.NoError(t, cfg.Verify())
+
+	cfg.RelayStorage.URL = "s3://bucket/prefix"
+	require.NoError(t, cfg.Verify())
+	cfg.RelayStorage.KeepLocalFiles = 0
+	require.ErrorContains(t, cfg.Verify(), "keep-local-files")
+	cfg.RelayStorage.KeepLocalFiles = 1
+	cfg.RelayStorage.Interval = 0
+	require.ErrorContains(t, cfg.Verify(), "interval")
+}
@@ -130,17 +130,21 @@ func fileSizeUpdated(path string, latestSize int64) (int, error) {
 	if err != nil {
 		return 0, terror.ErrGetRelayLogStat.Delegate(err, path)
 	}
-	curSize := fi.Size()
+	return compareFileSize(path, fi.Size(), latestSize), nil
+}
+
+// compareFileSize compares the current size of the file with latestSize, the result is the same as fileSizeUpdated.
+func compareFileSize(path string, curSize, latestSize int64) int {
 	switch {
 	case curSize == latestSize:
-		return 0, nil
+		return 0
 	case curSize > latestSize:
 		log.L().Debug("size of relay log file has been changed", zap.String("file", path),
 			zap.Int64("old size", latestSize), zap.Int64("size", curSize))
-		return 1, nil
+		return 1
 	default:
 		log.L().Error("size of relay log file has been changed", zap.String("file", path),
 			zap.Int64("old size", latestSize), zap.Int64("size", curSize))
-		return -1, nil
+		return -1
 	}
 }
@@ -16,7 +16,6 @@ package relay
 import (
 	"context"
 	"io"
-	"os"
 	"path"
 	"path/filepath"
 	"strings"
@@ -74,6 +73,9 @@ type BinlogReader struct {
 	currentSubDir string // current UUID(with suffix)
 
 	lastFileGracefulEnd bool
+
+	// external is used to read the relay log files uploaded to external storage, nil if relay-storage is not set
+	external *externalStorage
 }
 
 // newBinlogReader creates a new BinlogReader.
@@ -113,11 +115,11 @@ func (r *BinlogReader) checkRelayPos(pos mysql.Position) error {
 	pos = realPos
 	relayFilepath := path.Join(r.cfg.RelayDir, currentSubDir, pos.Name)
 	r.tctx.L().Info("start to check relay log file", zap.String("path", relayFilepath), zap.Stringer("position", pos))
-	fi, err := os.Stat(relayFilepath)
+	size, err := relayLogFileSize(r.tctx.Ctx, r.external, r.cfg.RelayDir, relayFilepath)
 	if err != nil {
-		return terror.ErrGetRelayLogStat.Delegate(err, relayFilepath)
+		return err
 	}
-	if fi.Size() < int64(pos.Pos) {
+	if size < int64(pos.Pos) {
 		return terror.ErrRelayLogGivenPosTooBig.Generate(pos)
 	}
 	return nil
@@ -169,6 +171,31 @@ func (r *BinlogReader) IsGTIDCoverPreviousFiles(ctx context.Context, filePath st
 	}
 }
 
+// isGTIDCoverPreviousFiles is like IsGTIDCoverPreviousFiles, but it also supports the relay log files uploaded to external storage.
+func (r *BinlogReader) isGTIDCoverPreviousFiles(ctx context.Context, filePath string, gset mysql.GTIDSet) (bool, error) {
+	if r.external == nil {
+		return r.IsGTIDCoverPreviousFiles(ctx, filePath, gset)
+	}
+	_, offloaded, err := r.external.externalKeyOf(ctx, r.cfg.RelayDir, filePath)
+	if err != nil {
+		return false, err
+	}
+	if !offloaded {
+		return r.IsGTIDCoverPreviousFiles(ctx, filePath, gset)
+	}
+
+	f, err := openRelayLogFile(ctx, r.external, r.cfg.RelayDir, filePath)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+	gs, err := previousGTIDsFromReader(f, filePath)
+	if err != nil {
+		return false, err
+	}
+	return gset.Contain(gs), nil
+}
+
 // getPosByGTID gets file position by gtid, result should be (filename, 4).
 func (r *BinlogReader) getPosByGTID(gset mysql.GTIDSet) (*mysql.Position, error) {
 	// start from newest uuid dir
@@ -192,7 +219,7 @@ func (r *BinlogReader) getPosByGTID(gset mysql.GTIDSet) (*mysql.Position, error)
 			// if input `gset` not contain previous_gtids_event's gset (complementary set of `gset` overlap with
 			// previous_gtids_event), that means there're some needed events in previous files.
 			// so we go to previous one
-			contain, err := r.IsGTIDCoverPreviousFiles(r.tctx.Ctx, filePath, gset)
+			contain, err := r.isGTIDCoverPreviousFiles(r.tctx.Ctx, filePath, gset)
 			if err != nil {
 				return nil, err
 			}
@@ -448,7 +475,7 @@ type binlogFileParseState struct {
 	fullPath                  string
 	relayLogFile, relayLogDir string
 
-	f *os.File
+	f io.ReadSeeker
 
 	// states may change
 	skipGTID            bool
@@ -462,7 +489,7 @@ func (r *BinlogReader) parseFileAsPossible(ctx context.Context, s *LocalStreamer
 	r.tctx.L().Debug("start to parse relay log file", zap.String("file", relayLogFile), zap.Int64("position", offset), zap.String("directory", relayLogDir))
 
 	fullPath := filepath.Join(relayLogDir, relayLogFile)
-	f, err := os.Open(fullPath)
+	f, err := openRelayLogFile(ctx, r.external, r.cfg.RelayDir, fullPath)
 	if err != nil {
 		return false, 0, errors.Trace(err)
 	}
@@ -664,7 +691,7 @@ func (r *BinlogReader) waitBinlogChanged(ctx context.Context, state *binlogFileP
 		// will find the right one
 		if meta.BinLogName != state.relayLogFile {
 			// we need check file size again, as the file may have been changed during our metafile check
-			cmp, err2 := fileSizeUpdated(state.fullPath, state.latestPos)
+			cmp, err2 := r.fileSizeUpdated(ctx, state.fullPath, state.latestPos)
 			if err2 != nil {
 				return false, false, terror.Annotatef(err2, "latestFilePath=%s endOffset=%d", state.fullPath, state.latestPos)
 			}
@@ -689,7 +716,7 @@ func (r *BinlogReader) waitBinlogChanged(ctx context.Context, state *binlogFileP
 		}
 		if switchPath != nil {
 			// we need check file size again, as the file may have been changed during path check
-			cmp, err := fileSizeUpdated(state.fullPath, state.latestPos)
+			cmp, err := r.fileSizeUpdated(ctx, state.fullPath, state.latestPos)
 			if err != nil {
 				return false, false, terror.Annotatef(err, "latestFilePath=%s endOffset=%d", state.fullPath, state.latestPos)
 			}
@@ -726,6 +753,20 @@ func (r *BinlogReader) waitBinlogChanged(ctx context.Context, state *binlogFileP
 	}
 }
 
+// fileSizeUpdated checks whether the relay log file is updated like the package-level fileSizeUpdated,
+// but if the local file is a placeholder of a file uploaded to external storage, it compares the size
+// of the external copy instead.
+func (r *BinlogReader) fileSizeUpdated(ctx context.Context, path string, latestSize int64) (int, error) {
+	if r.external == nil {
+		return fileSizeUpdated(path, latestSize)
+	}
+	size, err := relayLogFileSize(ctx, r.external, r.cfg.RelayDir, path)
+	if err != nil {
+		return 0, err
+	}
+	return compareFileSize(path, size, latestSize), nil
+}
+
 func (r *BinlogReader) parseFormatDescEvent(state *binlogFileParseState) error {
 	// FORMAT_DESCRIPTION event should always be read by default (despite that fact passed offset may be higher than 4)
 	if _, err := state.f.Seek(binlog.FileHeaderLen, io.SeekStart); err != nil {
@@ -110,6 +110,9 @@ type Process interface {
 	NewReader(logger log.Logger, cfg *BinlogReaderConfig) *BinlogReader
 	// IsActive check whether given uuid+filename is active binlog file, if true return current file offset
 	IsActive(uuid, filename string) (bool, int64)
+	// RelayLogFileOpener returns the opener of the relay log files, which reads the
+	// files uploaded to external storage. It returns nil if relay-storage is not set.
+	RelayLogFileOpener() binlog.RelayLogFileOpener
 }
 
 // Relay relays mysql binlog to local file.
@@ -131,6 +134,9 @@ type Relay struct {
 
 	writer    Writer
 	listeners map[Listener]struct{} // make it a set to make it easier to remove listener
+
+	// external is nil if relay-storage is not set
+	external *externalStorage
 }
 
 // NewRealRelay creates an instance of Relay.
@@ -148,7 +154,18 @@ func NewRealRelay(cfg *Config) Process {
 // Init implements the dm.Unit interface.
 // NOTE when Init encounters an error, it will make DM-worker exit when it boots up and assigned relay.
 func (r *Relay) Init(ctx context.Context) (err error) {
-	return reportRelayLogSpaceInBackground(ctx, r.cfg.RelayDir)
+	if err = reportRelayLogSpaceInBackground(ctx, r.cfg.RelayDir); err != nil {
+		return err
+	}
+	if r.cfg.RelayStorage.URL == "" {
+		return nil
+	}
+	r.external, err = newExternalStorage(ctx, r.cfg.RelayStorage, r.cfg.SourceID, r.cfg.RelayDir)
+	if err != nil {
+		return err
+	}
+	go r.external.run(ctx)
+	return nil
 }
 
 // Process implements the dm.Unit interface.
@@ -1217,7 +1234,17 @@ func (r *Relay) notify(e *replication.BinlogEvent) {
 }
 
 func (r *Relay) NewReader(logger log.Logger, cfg *BinlogReaderConfig) *BinlogReader {
-	return newBinlogReader(logger, cfg, r)
+	reader := newBinlogReader(logger, cfg, r)
+	reader.external = r.external
+	return reader
+}
+
+// RelayLogFileOpener implements Process.RelayLogFileOpener.
+func (r *Relay) RelayLogFileOpener() binlog.RelayLogFileOpener {
+	if r.external == nil {
+		return nil
+	}
+	return &relayLogFileOpener{external: r.external, relayDir: r.cfg.RelayDir}
 }
 
 // RegisterListener implements Process.RegisterListener.
@@ -0,0 +1,214 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/go-mysql-org/go-mysql/replication"
+	"github.com/pingcap/tidb/pkg/util/filter"
+	selector "github.com/pingcap/tidb/pkg/util/table-rule-selector"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pb"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/binlog/event"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	parserpkg "github.com/pingcap/tiflow/dm/pkg/parser"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+	"go.uber.org/zap"
+)
+
+// maxBinlogErrorRuleRecords is the max number of applied binlog error rules shown in the status.
+const maxBinlogErrorRuleRecords = 100
+
+var binlogErrorRuleOps = map[string]pb.ErrorOp{
+	config.BinlogErrorSkip:    pb.ErrorOp_Skip,
+	config.BinlogErrorReplace: pb.ErrorOp_Replace,
+	config.BinlogErrorInject:  pb.ErrorOp_Inject,
+}
+
+type binlogErrorRule struct {
+	*config.BinlogErrorRule
+	tableSelector selector.Selector
+	sqlRegexp     *regexp.Regexp
+}
+
+// matchError checks whether the DM error code or the error code of the downstream database is the code of the rule.
+func (r *binlogErrorRule) matchError(err error) bool {
+	if r.ErrorCode == 0 {
+		return true
+	}
+	if e, ok := err.(*terror.Error); ok && int(e.Code()) == r.ErrorCode {
+		return true
+	}
+	return r.ErrorCode <= math.MaxUint16 && conn.IsMySQLError(err, uint16(r.ErrorCode))
+}
+
+// matchTables checks whether all tables of the DDL are matched by the rule.
+func (r *binlogErrorRule) matchTables(tables []*filter.Table) bool {
+	if len(tables) == 0 {
+		return len(r.tableSelector.Match("", "")) > 0
+	}
+	for _, table := range tables {
+		if len(r.tableSelector.Match(table.Schema, table.Name)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// binlogErrorRules matches the errors of DDL binlog events with the binlog error rules in order,
+// and records the applied rules.
+type binlogErrorRules struct {
+	rules []*binlogErrorRule
+
+	mu      sync.RWMutex
+	records []*pb.BinlogErrorRuleRecord
+}
+
+func newBinlogErrorRules(cfgs []*config.BinlogErrorRule) (*binlogErrorRules, error) {
+	rules := make([]*binlogErrorRule, 0, len(cfgs))
+	for _, cfg := range cfgs {
+		tableSelector := selector.NewTrieSelector()
+		if err := tableSelector.Insert(cfg.SchemaPatternOrDefault(), cfg.TablePattern, cfg, selector.Insert); err != nil {
+			return nil, terror.ErrConfigInvalidBinlogErrorRule.Delegate(err, cfg.Name)
+		}
+		sqlRegexp, err := regexp.Compile(cfg.SQLPattern)
+		if err != nil {
+			return nil, terror.ErrConfigInvalidBinlogErrorRule.Delegate(err, cfg.Name)
+		}
+		rules = append(rules, &binlogErrorRule{
+			BinlogErrorRule: cfg,
+			tableSelector:   tableSelector,
+			sqlRegexp:       sqlRegexp,
+		})
+	}
+	return &binlogErrorRules{rules: rules}, nil
+}
+
+// match returns the first rule matched with the error, the tables and the origin SQL of the DDL.
+func (r *binlogErrorRules) match(err error, tables []*filter.Table, originSQL string) *config.BinlogErrorRule {
+	for _, rule := range r.rules {
+		if rule.matchError(err) && rule.sqlRegexp.MatchString(originSQL) && rule.matchTables(tables) {
+			return rule.BinlogErrorRule
+		}
+	}
+	return nil
+}
+
+func (r *binlogErrorRules) record(record *pb.BinlogErrorRuleRecord) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.records = append(r.records, record)
+	if len(r.records) > maxBinlogErrorRuleRecords {
+		r.records = r.records[len(r.records)-maxBinlogErrorRuleRecords:]
+	}
+}
+
+// Records returns the recently applied binlog error rules.
+func (r *binlogErrorRules) Records() []*pb.BinlogErrorRuleRecord {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	records := make([]*pb.BinlogErrorRuleRecord, len(r.records))
+	copy(records, r.records)
+	return records
+}
+
+// applyBinlogErrorRule tries to handle the error of a DDL binlog event with the binlog error rules.
+// if a rule is matched, it sets an operator for the event like `binlog skip/replace/inject` and returns
+// ErrSyncerBinlogErrorRuleApplied to re-sync from the checkpoint, otherwise it returns the origin error.
+func (s *Syncer) applyBinlogErrorRule(ctx context.Context, err error, startLocation binlog.Location, tables []*filter.Table, originSQL string) error {
+	if err == nil || s.binlogErrorRules == nil || utils.IsContextCanceledError(err) {
+		return err
+	}
+	rule := s.binlogErrorRules.match(err, tables, originSQL)
+	if rule == nil {
+		return err
+	}
+
+	pos := startLocation.Position.String()
+	// the event has been handled by the user or a rule but still fails, don't handle it again.
+	if ops := s.streamerController.ListEqualAndAfter(pos); len(ops) > 0 && ops[0].BinlogPos == pos {
+		s.tctx.L().Warn("skip binlog error rule because the event has an operator already",
+			zap.String("rule", rule.Name), zap.String("position", pos), zap.Stringer("operator", ops[0]))
+		return err
+	}
+	req := &pb.HandleWorkerErrorRequest{
+		Op:        binlogErrorRuleOps[rule.Action],
+		Task:      s.cfg.Name,
+		BinlogPos: fmt.Sprintf("%s:%d", startLocation.Position.Name, startLocation.Position.Pos),
+		Sqls:      rule.SQLs,
+	}
+	if _, err2 := s.HandleError(ctx, req); err2 != nil {
+		s.tctx.L().Error("fail to apply binlog error rule", zap.String("rule", rule.Name),
+			zap.String("position", pos), log.ShortError(err2))
+		return err
+	}
+
+	errMsg := err.Error()
+	s.tctx.L().Warn("binlog error rule is applied", zap.String("rule", rule.Name), zap.String("action", rule.Action),
+		zap.String("position", pos), zap.String("origin SQL", originSQL), zap.String("error", errMsg))
+	s.binlogErrorRules.record(&pb.BinlogErrorRuleRecord{
+		Name:      rule.Name,
+		Op:        req.Op,
+		BinlogPos: pos,
+		OriginSQL: originSQL,
+		Error:     errMsg,
+		Time:      time.Now().Unix(),
+	})
+	return terror.ErrSyncerBinlogErrorRuleApplied.Generate(rule.Name, pos, errMsg)
+}
+
+// allBinlogErrorRuleApplied checks whether all the process errors are caused by applying binlog error rules.
+func allBinlogErrorRuleApplied(errs []*pb.ProcessError) bool {
+	for _, err := range errs {
+		if err.ErrCode != int32(terror.ErrSyncerBinlogErrorRuleApplied.Code()) {
+			return false
+		}
+	}
+	return len(errs) > 0
+}
+
+// tablesOfQueryEvent returns the tables of the DDL in the query event, or only the schema of the event if the DDL
+// can't be parsed.
+func (s *Syncer) tablesOfQueryEvent(ev *replication.QueryEvent, originSQL string) []*filter.Table {
+	schemaOnly := []*filter.Table{{Schema: string(ev.Schema)}}
+	// the parser is still usable with the default SQL mode if fail to get the SQL mode.
+	p, _ := event.GetParserForStatusVars(ev.StatusVars)
+	stmts, err := parserpkg.Parse(p, originSQL, "", "")
+	if err != nil || len(stmts) == 0 {
+		return schemaOnly
+	}
+	tables, err := parserpkg.FetchDDLTables(string(ev.Schema), stmts[0], s.SourceTableNamesFlavor)
+	if err != nil || len(tables) == 0 {
+		return schemaOnly
+	}
+	return tables
+}
+
+// sourceTablesOfJob returns the source tables of the DDL job.
+func sourceTablesOfJob(j *job) []*filter.Table {
+	tables := make([]*filter.Table, 0, len(j.sourceTbls))
+	for _, tbls := range j.sourceTbls {
+		tables = append(tables, tbls...)
+	}
+	return tables
+}
@@ -0,0 +1,234 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"testing"
+
+	gmysql "github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/go-mysql-org/go-mysql/replication"
+	"github.com/go-sql-driver/mysql"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/util/filter"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pb"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/syncer/binlogstream"
+	"github.com/pingcap/tiflow/dm/syncer/dbconn"
+	"github.com/pingcap/tiflow/dm/syncer/metrics"
+	"github.com/pingcap/tiflow/dm/unit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBinlogErrorRulesMatch(t *testing.T) {
+	t.Parallel()
+
+	rules, err := newBinlogErrorRules([]*config.BinlogErrorRule{
+		{
+			Name:          "skip-algorithm",
+			ErrorCode:     8200,
+			SchemaPattern: "shard_*",
+			TablePattern:  "t_*",
+			SQLPattern:    `(?i)ALGORITHM\s*=\s*INSTANT`,
+			Action:        config.BinlogErrorSkip,
+		},
+		{
+			Name:       "skip-parse-error",
+			ErrorCode:  int(terror.ErrSyncerParseDDL.Code()),
+			SQLPattern: "^ALTER TABLE",
+			Action:     config.BinlogErrorSkip,
+		},
+		{
+			Name:          "inject-all",
+			SchemaPattern: "db",
+			Action:        config.BinlogErrorInject,
+			SQLs:          []string{"CREATE TABLE db.t (c INT)"},
+		},
+	})
+	require.NoError(t, err)
+
+	var (
+		unsupportedErr = terror.ErrDBExecuteFailed.Delegate(&mysql.MySQLError{Number: 8200, Message: "Unsupported"}, "sql")
+		parseErr       = terror.ErrSyncerParseDDL.Generate("sql")
+		otherErr       = errors.New("other error")
+		shardTable1    = &filter.Table{Schema: "shard_1", Name: "t_1"}
+		shardTable2    = &filter.Table{Schema: "shard_1", Name: "tbl"}
+		dbTable        = &filter.Table{Schema: "db", Name: "t"}
+		algorithmSQL   = "ALTER TABLE t_1 ADD COLUMN c INT, algorithm=instant"
+	)
+	cases := []struct {
+		err    error
+		tables []*filter.Table
+		sql    string
+		rule   string
+	}{
+		{unsupportedErr, []*filter.Table{shardTable1}, algorithmSQL, "skip-algorithm"},
+		{unsupportedErr, []*filter.Table{shardTable1, shardTable2}, algorithmSQL, ""},
+		{unsupportedErr, []*filter.Table{shardTable1}, "ALTER TABLE t_1 ADD COLUMN c INT", ""},
+		{otherErr, []*filter.Table{shardTable1}, algorithmSQL, ""},
+		{parseErr, []*filter.Table{shardTable2}, "ALTER TABLE tbl ADD COLUMN c INT", "skip-parse-error"},
+		{parseErr, []*filter.Table{shardTable2}, "CREATE TABLE tbl (c INT)", ""},
+		{otherErr, []*filter.Table{dbTable}, "DROP TABLE t", "inject-all"},
+		{otherErr, []*filter.Table{{Schema: "db"}}, "DROP DATABASE db", "inject-all"},
+		{otherErr, nil, "DROP TABLE t", ""},
+	}
+	for _, cs := range cases {
+		rule := rules.match(cs.err, cs.tables, cs.sql)
+		if cs.rule == "" {
+			require.Nil(t, rule, cs.sql)
+		} else {
+			require.NotNil(t, rule, cs.sql)
+			require.Equal(t, cs.rule, rule.Name)
+		}
+	}
+
+	// only keep recent records.
+	for i := 0; i < maxBinlogErrorRuleRecords+10; i++ {
+		rules.record(&pb.BinlogErrorRuleRecord{Time: int64(i)})
+	}
+	records := rules.Records()
+	require.Len(t, records, maxBinlogErrorRuleRecords)
+	require.Equal(t, int64(10), records[0].Time)
+	require.Equal(t, int64(maxBinlogErrorRuleRecords+9), records[maxBinlogErrorRuleRecords-1].Time)
+}
+
+func TestApplyBinlogErrorRule(t *testing.T) {
+	var (
+		cfg    = genDefaultSubTaskConfig4Test()
+		syncer = NewSyncer(cfg, nil, nil)
+		ctx    = context.Background()
+		err    error
+		loc1   = binlog.Location{Position: gmysql.Position{Name: "mysql-bin.000001", Pos: 2345}}
+		loc2   = binlog.Location{Position: gmysql.Position{Name: "mysql-bin.000001", Pos: 3456}}
+		tables = []*filter.Table{{Schema: "db", Name: "tb"}}
+		sql    = "ALTER TABLE tb ADD FULLTEXT INDEX idx(c)"
+		ddlErr = terror.ErrDBExecuteFailed.Delegate(&mysql.MySQLError{Number: 8200, Message: "Unsupported"}, sql)
+	)
+	syncer.binlogErrorRules, err = newBinlogErrorRules([]*config.BinlogErrorRule{{
+		Name:       "replace-fulltext",
+		ErrorCode:  8200,
+		SQLPattern: "FULLTEXT",
+		Action:     config.BinlogErrorReplace,
+		SQLs:       []string{"ALTER TABLE db.tb ADD INDEX idx(c)"},
+	}})
+	require.NoError(t, err)
+	mockDB, err := conn.MockDefaultDBProvider()
+	require.NoError(t, err)
+	upstreamDB, err := conn.GetUpstreamDB(&cfg.From) // used to get parser
+	require.NoError(t, err)
+	syncer.fromDB = &dbconn.UpStreamConn{BaseDB: upstreamDB}
+	syncer.streamerController = binlogstream.NewStreamerController4Test(nil, nil)
+
+	// not matched.
+	otherErr := errors.New("other error")
+	require.Equal(t, otherErr, syncer.applyBinlogErrorRule(ctx, otherErr, loc1, tables, sql))
+	require.Len(t, syncer.streamerController.ListEqualAndAfter(""), 0)
+
+	// matched, an operator is set for the event.
+	err = syncer.applyBinlogErrorRule(ctx, ddlErr, loc1, tables, sql)
+	require.True(t, terror.ErrSyncerBinlogErrorRuleApplied.Equal(err))
+	require.Contains(t, err.Error(), "binlog error rule replace-fulltext is applied to the binlog event at (mysql-bin.000001, 2345)")
+	ops := syncer.streamerController.ListEqualAndAfter("")
+	require.Len(t, ops, 1)
+	require.Equal(t, pb.ErrorOp_Replace, ops[0].Op)
+	require.Equal(t, "(mysql-bin.000001, 2345)", ops[0].BinlogPos)
+	require.Equal(t, []string{"ALTER TABLE db.tb ADD INDEX idx(c)"}, ops[0].Sqls)
+	records := syncer.binlogErrorRules.Records()
+	require.Len(t, records, 1)
+	require.Equal(t, "replace-fulltext", records[0].Name)
+	require.Equal(t, pb.ErrorOp_Replace, records[0].Op)
+	require.Equal(t, "(mysql-bin.000001, 2345)", records[0].BinlogPos)
+	require.Equal(t, sql, records[0].OriginSQL)
+	require.Contains(t, records[0].Error, "Unsupported")
+
+	// the event still fails after applying the rule, don't apply it again.
+	require.Equal(t, ddlErr, syncer.applyBinlogErrorRule(ctx, ddlErr, loc1, tables, sql))
+	require.Len(t, syncer.binlogErrorRules.Records(), 1)
+
+	// apply the rule to another event.
+	err = syncer.applyBinlogErrorRule(ctx, ddlErr, loc2, tables, sql)
+	require.True(t, terror.ErrSyncerBinlogErrorRuleApplied.Equal(err))
+	require.Len(t, syncer.streamerController.ListEqualAndAfter(""), 2)
+	require.Len(t, syncer.binlogErrorRules.Records(), 2)
+	require.NoError(t, mockDB.ExpectationsWereMet())
+
+	// the error is resumed automatically only if all errors are caused by the rules.
+	require.False(t, allBinlogErrorRuleApplied(nil))
+	appliedErr := unit.NewProcessError(err)
+	require.True(t, allBinlogErrorRuleApplied([]*pb.ProcessError{appliedErr}))
+	require.False(t, allBinlogErrorRuleApplied([]*pb.ProcessError{appliedErr, unit.NewProcessError(ddlErr)}))
+}
+
+func TestProcessResumeAfterBinlogErrorRuleApplied(t *testing.T) {
+	var (
+		cfg        = genDefaultSubTaskConfig4Test()
+		syncer     = NewSyncer(cfg, nil, nil)
+		ctx        = context.Background()
+		appliedErr = terror.ErrSyncerBinlogErrorRuleApplied.Generate("skip-fulltext", "(mysql-bin.000001, 2345)", "Unsupported")
+		otherErr   = errors.New("other error")
+		resetErr   error
+		runCount   int
+	)
+	resetConn := func(*tcontext.Context, *conn.BaseConn) (*conn.BaseConn, error) {
+		return nil, resetErr
+	}
+	syncer.metricsProxies = metrics.DefaultMetricsProxies.CacheForOneTask("task", "worker", "source")
+	syncer.ddlDBConn = &dbconn.DBConn{ResetBaseConnFn: resetConn}
+	syncer.downstreamTrackConn = &dbconn.DBConn{ResetBaseConnFn: resetConn}
+	syncer.checkpoint.(*RemoteCheckPoint).dbConn = &dbconn.DBConn{ResetBaseConnFn: resetConn}
+
+	// re-sync in the same Process until an error not caused by the rules is met.
+	syncer.runFunc = func(context.Context) error {
+		runCount++
+		if runCount < 3 {
+			return appliedErr
+		}
+		return otherErr
+	}
+	pr := make(chan pb.ProcessResult, 1)
+	syncer.Process(ctx, pr)
+	require.Equal(t, 3, runCount)
+	result := <-pr
+	require.False(t, result.IsCanceled)
+	require.Len(t, result.Errors, 1)
+	require.Contains(t, result.Errors[0].Message, "other error")
+
+	// report the error if fail to reset the conns for re-syncing.
+	runCount = 0
+	resetErr = errors.New("reset conn error")
+	syncer.runFunc = func(context.Context) error {
+		runCount++
+		return appliedErr
+	}
+	syncer.Process(ctx, pr)
+	require.Equal(t, 1, runCount)
+	result = <-pr
+	require.False(t, result.IsCanceled)
+	require.Len(t, result.Errors, 1)
+	require.Contains(t, result.Errors[0].Message, "reset conn error")
+}
+
+func TestTablesOfQueryEvent(t *testing.T) {
+	t.Parallel()
+
+	syncer := NewSyncer(genDefaultSubTaskConfig4Test(), nil, nil)
+	ev := &replication.QueryEvent{Schema: []byte("db")}
+	require.Equal(t, []*filter.Table{{Schema: "db", Name: "tb"}}, syncer.tablesOfQueryEvent(ev, "ALTER TABLE tb ADD COLUMN c INT"))
+	require.Equal(t, []*filter.Table{{Schema: "db2", Name: "tb"}}, syncer.tablesOfQueryEvent(ev, "ALTER TABLE db2.tb ADD COLUMN c INT"))
+	require.Equal(t, []*filter.Table{{Schema: "db"}}, syncer.tablesOfQueryEvent(ev, "ALTER TABLE tb ADD COLUMN c INT, ALGORITHM=UNKNOWN_ALGO"))
+}
@@ -639,6 +639,7 @@ func (ddl *Pessimist) handleDDL(qec *queryEventContext) error {
 
 		// construct & send shard DDL info into etcd, DM-master will handle it.
 		shardInfo := ddl.pessimist.ConstructInfo(ddlInfo.targetTables[0].Schema, ddlInfo.targetTables[0].Name, needHandleDDLs)
+		shardInfo.DDLTs = int64(qec.header.Timestamp)
 		rev, err2 := ddl.pessimist.PutInfo(qec.tctx.Ctx, shardInfo)
 		if err2 != nil {
 			return err2
@@ -139,7 +139,7 @@ func (s *Syncer) genAndFilterInsertDMLs(tctx *tcontext.Context, param *genDMLPar
 	)
 
 	// if downstream pk/uk(not null) exits, then use downstream pk/uk(not null)
-	downstreamTableInfo, err := s.schemaTracker.GetDownStreamTableInfo(tctx, tableID, ti)
+	downstreamTableInfo, err := s.getDownStreamTableInfo(tctx, tableID, ti)
 	if err != nil {
 		return nil, err
 	}
@@ -198,7 +198,7 @@ func (s *Syncer) genAndFilterUpdateDMLs(
 	)
 
 	// if downstream pk/uk(not null) exits, then use downstream pk/uk(not null)
-	downstreamTableInfo, err := s.schemaTracker.GetDownStreamTableInfo(tctx, tableID, ti)
+	downstreamTableInfo, err := s.getDownStreamTableInfo(tctx, tableID, ti)
 	if err != nil {
 		return nil, err
 	}
@@ -270,7 +270,7 @@ func (s *Syncer) genAndFilterDeleteDMLs(tctx *tcontext.Context, param *genDMLPar
 	)
 
 	// if downstream pk/uk(not null) exits, then use downstream pk/uk(not null)
-	downstreamTableInfo, err := s.schemaTracker.GetDownStreamTableInfo(tctx, tableID, ti)
+	downstreamTableInfo, err := s.getDownStreamTableInfo(tctx, tableID, ti)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,249 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	tmysql "github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/syncer/metrics"
+	"go.uber.org/zap"
+)
+
+// dmlThrottleRecoverSteps is the number of healthy check intervals to recover
+// the limits from the minimum to the maximum.
+const dmlThrottleRecoverSteps = 10
+
+// memoryUsageFunc returns the used memory and the memory limit of the downstream.
+type memoryUsageFunc func(ctx context.Context) (used int64, limit int64, err error)
+
+// dmlThrottle limits the DML concurrency and batch size by the downstream health.
+// it halves the limits when the latency, the errors or the memory usage of the
+// downstream cross the thresholds in a check interval, and increases them step
+// by step when the downstream is healthy.
+type dmlThrottle struct {
+	cfg            config.DMLThrottleConfig
+	maxWorkerCount int
+	maxBatch       int
+	minWorkerCount int
+	minBatch       int
+
+	logger        log.Logger
+	metricProxies *metrics.Proxies
+	memoryUsage   memoryUsageFunc
+
+	mu          sync.Mutex
+	workerCount int
+	batch       int
+	running     int
+	// notify is closed and renewed when a running slot may be available.
+	notify chan struct{}
+
+	// statistics in the current check interval.
+	execCount    int
+	execDuration time.Duration
+	errCount     int
+}
+
+// newDMLThrottle creates a dmlThrottle, it returns nil if the throttle is not enabled.
+func newDMLThrottle(
+	cfg config.DMLThrottleConfig,
+	workerCount, batch int,
+	logger log.Logger,
+	metricProxies *metrics.Proxies,
+	memoryUsage memoryUsageFunc,
+) *dmlThrottle {
+	if !cfg.Enable {
+		return nil
+	}
+	t := &dmlThrottle{
+		cfg:            cfg,
+		maxWorkerCount: workerCount,
+		maxBatch:       batch,
+		minWorkerCount: min(max(cfg.MinWorkerCount, 1), workerCount),
+		minBatch:       min(max(cfg.MinBatch, 1), batch),
+		logger:         logger.WithFields(zap.String("component", "dml throttle")),
+		metricProxies:  metricProxies,
+		memoryUsage:    memoryUsage,
+		workerCount:    workerCount,
+		batch:          batch,
+		notify:         make(chan struct{}),
+	}
+	t.updateMetrics()
+	return t
+}
+
+// acquire waits for a running slot to execute a DML batch. it returns false if
+// ctx is done before getting the slot, and the caller should not call release.
+func (t *dmlThrottle) acquire(ctx context.Context) bool {
+	for {
+		t.mu.Lock()
+		if t.running < t.workerCount {
+			t.running++
+			t.mu.Unlock()
+			return true
+		}
+		notify := t.notify
+		t.mu.Unlock()
+
+		select {
+		case <-notify:
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
+
+// release releases the running slot and records the result of the execution.
+func (t *dmlThrottle) release(duration time.Duration, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.running--
+	t.execCount++
+	t.execDuration += duration
+	if err != nil {
+		t.errCount++
+	}
+	t.notifyAll()
+}
+
+// currentBatch returns the current limit of the batch size.
+func (t *dmlThrottle) currentBatch() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.batch
+}
+
+// limits returns the current limits of the concurrency and the batch size.
+func (t *dmlThrottle) limits() (int, int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.workerCount, t.batch
+}
+
+// run checks the downstream health every CheckInterval until ctx is done.
+func (t *dmlThrottle) run(ctx context.Context) {
+	ticker := time.NewTicker(t.cfg.CheckInterval.Duration)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			t.check(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// check checks the downstream health and adjusts the limits.
+func (t *dmlThrottle) check(ctx context.Context) {
+	memoryRatio := t.memoryRatio(ctx)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var reasons []string
+	if t.cfg.ErrorThreshold > 0 && t.errCount >= t.cfg.ErrorThreshold {
+		reasons = append(reasons, "error")
+	}
+	if t.execCount > 0 && t.execDuration/time.Duration(t.execCount) > t.cfg.LatencyThreshold.Duration {
+		reasons = append(reasons, "latency")
+	}
+	if memoryRatio >= t.cfg.MemoryThreshold {
+		reasons = append(reasons, "memory")
+	}
+
+	oldWorkerCount, oldBatch := t.workerCount, t.batch
+	if len(reasons) > 0 {
+		t.workerCount = max(t.workerCount/2, t.minWorkerCount)
+		t.batch = max(t.batch/2, t.minBatch)
+	} else {
+		t.workerCount = min(t.workerCount+recoverStep(t.minWorkerCount, t.maxWorkerCount), t.maxWorkerCount)
+		t.batch = min(t.batch+recoverStep(t.minBatch, t.maxBatch), t.maxBatch)
+	}
+
+	if t.workerCount != oldWorkerCount || t.batch != oldBatch {
+		fields := []zap.Field{
+			zap.Int("old worker count", oldWorkerCount),
+			zap.Int("new worker count", t.workerCount),
+			zap.Int("old batch", oldBatch),
+			zap.Int("new batch", t.batch),
+			zap.Int("executed batches", t.execCount),
+			zap.Int("failed batches", t.errCount),
+			zap.Float64("memory ratio", memoryRatio),
+		}
+		if t.execCount > 0 {
+			fields = append(fields, zap.Duration("average latency", t.execDuration/time.Duration(t.execCount)))
+		}
+		if len(reasons) > 0 {
+			t.logger.Warn("downstream is unhealthy, throttle DML", append(fields, zap.Strings("reasons", reasons))...)
+		} else {
+			t.logger.Info("downstream is healthy, recover DML limits", fields...)
+		}
+		t.updateMetrics()
+		t.notifyAll()
+	}
+
+	t.execCount, t.execDuration, t.errCount = 0, 0, 0
+}
+
+// memoryRatio returns the ratio of the used memory to the memory limit of the
+// downstream, it returns 0 if the ratio can't be got. it's only called in run.
+func (t *dmlThrottle) memoryRatio(ctx context.Context) float64 {
+	if t.memoryUsage == nil {
+		return 0
+	}
+	ctx, cancel := context.WithTimeout(ctx, conn.DefaultDBTimeout)
+	defer cancel()
+	used, limit, err := t.memoryUsage(ctx)
+	if err != nil {
+		if conn.IsMySQLError(err, tmysql.ErrUnknownTable) || conn.IsMySQLError(err, tmysql.ErrNoSuchTable) {
+			t.logger.Info("downstream doesn't support getting memory usage, disable memory check", zap.Error(err))
+			t.memoryUsage = nil
+		} else {
+			t.logger.Warn("fail to get memory usage of downstream", zap.Error(err))
+		}
+		return 0
+	}
+	if limit <= 0 {
+		return 0
+	}
+	return float64(used) / float64(limit)
+}
+
+// notifyAll wakes up all the waiters of acquire, t.mu must be held.
+func (t *dmlThrottle) notifyAll() {
+	close(t.notify)
+	t.notify = make(chan struct{})
+}
+
+// updateMetrics updates the metrics of the limits, t.mu must be held.
+func (t *dmlThrottle) updateMetrics() {
+	if t.metricProxies == nil || t.metricProxies.Metrics == nil {
+		return
+	}
+	t.metricProxies.Metrics.DMLThrottleWorkerCount.Set(float64(t.workerCount))
+	t.metricProxies.Metrics.DMLThrottleBatch.Set(float64(t.batch))
+}
+
+// recoverStep returns the step to increase a limit in a healthy check interval.
+func recoverStep(minLimit, maxLimit int) int {
+	step := (maxLimit - minLimit + dmlThrottleRecoverSteps - 1) / dmlThrottleRecoverSteps
+	return max(step, 1)
+}
@@ -0,0 +1,141 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	tmysql "github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/syncer/metrics"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDMLThrottle(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.DMLThrottleConfig{
+		LatencyThreshold: config.Duration{Duration: 100 * time.Millisecond},
+		ErrorThreshold:   1,
+		MemoryThreshold:  0.8,
+		CheckInterval:    config.Duration{Duration: time.Second},
+		MinWorkerCount:   1,
+		MinBatch:         10,
+	}
+	proxies := metrics.DefaultMetricsProxies.CacheForOneTask("task", "worker", "source")
+	require.Nil(t, newDMLThrottle(cfg, 4, 100, log.L(), proxies, nil))
+
+	var (
+		used   int64
+		memErr error
+	)
+	cfg.Enable = true
+	th := newDMLThrottle(cfg, 4, 100, log.L(), proxies, func(context.Context) (int64, int64, error) {
+		return used, 100, memErr
+	})
+	require.NotNil(t, th)
+	require.Equal(t, 100, th.currentBatch())
+	ctx := context.Background()
+
+	// the concurrency is limited by the worker count.
+	for i := 0; i < 4; i++ {
+		require.True(t, th.acquire(ctx))
+	}
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	require.False(t, th.acquire(canceledCtx))
+	for i := 0; i < 4; i++ {
+		th.release(10*time.Millisecond, nil)
+	}
+
+	// healthy, keep the max limits.
+	th.check(ctx)
+	workerCount, batch := th.limits()
+	require.Equal(t, 4, workerCount)
+	require.Equal(t, 100, batch)
+
+	// high latency, halve the limits.
+	require.True(t, th.acquire(ctx))
+	th.release(time.Second, nil)
+	th.check(ctx)
+	workerCount, batch = th.limits()
+	require.Equal(t, 2, workerCount)
+	require.Equal(t, 50, batch)
+
+	// errors, halve the limits.
+	require.True(t, th.acquire(ctx))
+	th.release(10*time.Millisecond, errors.New("mock error"))
+	th.check(ctx)
+	workerCount, batch = th.limits()
+	require.Equal(t, 1, workerCount)
+	require.Equal(t, 25, batch)
+
+	// memory pressure, the limits don't go below the min limits.
+	used = 90
+	th.check(ctx)
+	th.check(ctx)
+	workerCount, batch = th.limits()
+	require.Equal(t, 1, workerCount)
+	require.Equal(t, 10, batch)
+	require.Equal(t, 10, th.currentBatch())
+
+	// a waiter is woken up when the limits are recovered.
+	require.True(t, th.acquire(ctx))
+	acquired := make(chan bool)
+	go func() {
+		acquired <- th.acquire(ctx)
+	}()
+	select {
+	case <-acquired:
+		require.FailNow(t, "should wait for the running slot")
+	case <-time.After(50 * time.Millisecond):
+	}
+	used = 10
+	th.check(ctx)
+	require.True(t, <-acquired)
+	workerCount, batch = th.limits()
+	require.Equal(t, 2, workerCount)
+	require.Equal(t, 19, batch)
+	th.release(10*time.Millisecond, nil)
+	th.release(10*time.Millisecond, nil)
+
+	// recover gradually to the max limits.
+	for i := 0; i < dmlThrottleRecoverSteps; i++ {
+		th.check(ctx)
+	}
+	workerCount, batch = th.limits()
+	require.Equal(t, 4, workerCount)
+	require.Equal(t, 100, batch)
+
+	// the memory check is disabled when the downstream doesn't support it.
+	memErr = &mysql.MySQLError{Number: tmysql.ErrUnknownTable, Message: "Unknown table 'MEMORY_USAGE' in information_schema"}
+	th.check(ctx)
+	require.Nil(t, th.memoryUsage)
+	workerCount, _ = th.limits()
+	require.Equal(t, 4, workerCount)
+}
+
+func TestRecoverStep(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, 1, recoverStep(1, 1))
+	require.Equal(t, 1, recoverStep(1, 4))
+	require.Equal(t, 2, recoverStep(1, 16))
+	require.Equal(t, 10, recoverStep(1, 100))
+}
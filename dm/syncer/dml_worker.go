@@ -24,6 +24,7 @@ import (
 	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 	"github.com/pingcap/tiflow/dm/syncer/dbconn"
+	"github.com/pingcap/tiflow/dm/syncer/kafkasink"
 	"github.com/pingcap/tiflow/dm/syncer/metrics"
 	"github.com/pingcap/tiflow/pkg/sqlmodel"
 	"go.uber.org/zap"
@@ -37,6 +38,8 @@ type DMLWorker struct {
 	chanSize      int
 	multipleRows  bool
 	toDBConns     []*dbconn.DBConn
+	kafkaSink     *kafkasink.Sink
+	throttle      *dmlThrottle
 	syncCtx       *tcontext.Context
 	logger        log.Logger
 	metricProxies *metrics.Proxies
@@ -119,6 +122,8 @@ func dmlWorkerWrap(inCh chan *job, syncer *Syncer) chan *job {
 		syncCtx:                 syncer.syncCtx, // this ctx can be used to cancel all the workers
 		metricProxies:           syncer.metricsProxies,
 		toDBConns:               syncer.toDBConns,
+		kafkaSink:               syncer.kafkaSink,
+		throttle:                syncer.dmlThrottle,
 		foreignKeyChecksEnabled: isForeignKeyChecksEnabled(syncer.cfg.To.Session),
 		inCh:                    inCh,
 		flushCh:                 make(chan *job),
@@ -174,16 +179,28 @@ func (w *DMLWorker) run() {
 			j.flushWg.Wait()
 			w.updateJobMetricsFunc(true, adminQueueName, j)
 		default:
-			queueBucket := int(utils.GenHashKey(j.dmlQueueKey)) % w.workerCount
+			key := w.queueKey(j)
+			queueBucket := int(utils.GenHashKey(key)) % w.workerCount
 			w.updateJobMetricsFunc(false, queueBucketMapping[queueBucket], j)
 			startTime := time.Now()
-			w.logger.Debug("queue for key", zap.Int("queue", queueBucket), zap.String("key", j.dmlQueueKey))
+			w.logger.Debug("queue for key", zap.Int("queue", queueBucket), zap.String("key", key))
 			jobChs[queueBucket] <- j
 			w.metricProxies.AddJobDurationHistogram.WithLabelValues(j.tp.String(), w.task, queueBucketMapping[queueBucket], w.source).Observe(time.Since(startTime).Seconds())
 		}
 	}
 }
 
+// queueKey returns the key to dispatch the job to a DML queue. The rows sent
+// to Kafka must keep the order of the commit ts per table in each partition,
+// so all the jobs of a table are dispatched to the same queue, which writes
+// them one batch after another.
+func (w *DMLWorker) queueKey(j *job) string {
+	if w.kafkaSink != nil {
+		return j.dml.TargetTableID()
+	}
+	return j.dmlQueueKey
+}
+
 func (w *DMLWorker) sendJobToAllDmlQueue(j *job, jobChs []chan *job, queueBucketMapping []string) {
 	// flush for every DML queue
 	for i, jobCh := range jobChs {
@@ -207,7 +224,7 @@ func (w *DMLWorker) executeJobs(queueID int, jobCh chan *job) {
 		}
 
 		failpoint.Inject("syncDMLBatchNotFull", func() {
-			if len(jobCh) == 0 && len(jobs) < w.batch {
+			if len(jobCh) == 0 && len(jobs) < w.currentBatch() {
 				w.logger.Info("execute not full job queue")
 			}
 		})
@@ -238,7 +255,7 @@ func (w *DMLWorker) executeJobs(queueID int, jobCh chan *job) {
 				disableForeignKeyChecksForBatch = disableForJob
 			}
 			jobs = append(jobs, j)
-			if len(jobs) < w.batch && len(jobCh) > 0 {
+			if len(jobs) < w.currentBatch() && len(jobCh) > 0 {
 				continue
 			}
 		}
@@ -250,8 +267,21 @@ func (w *DMLWorker) executeJobs(queueID int, jobCh chan *job) {
 	}
 }
 
+// currentBatch returns the batch size, which may be reduced by the throttle.
+func (w *DMLWorker) currentBatch() int {
+	if w.throttle != nil {
+		return w.throttle.currentBatch()
+	}
+	return w.batch
+}
+
 // executeBatchJobs execute jobs with batch size.
 func (w *DMLWorker) executeBatchJobs(queueID int, jobs []*job, disableForeignKeyChecks bool) {
+	if w.kafkaSink != nil {
+		w.writeBatchJobsToKafka(queueID, jobs)
+		return
+	}
+
 	var (
 		affect  int
 		queries []string
@@ -329,12 +359,25 @@ func (w *DMLWorker) executeBatchJobs(queueID int, jobs []*job, disableForeignKey
 			}()
 		}
 	}
+	// wait for a running slot before the execution timeout starts, so the
+	// waiting time is not taken out of the execution budget.
+	if w.throttle != nil {
+		if !w.throttle.acquire(w.syncCtx.Ctx) {
+			// the jobs are not executed, so the checkpoint must not be flushed.
+			err = terror.ErrDBExecuteFailed.Delegate(w.syncCtx.Ctx.Err(), "wait for dml throttle")
+			return
+		}
+	}
 	// use background context to execute sqls as much as possible
 	// set timeout to maxDMLConnectionDuration to make sure dmls can be replicated to downstream event if the latency is high
 	// if users need to quit this asap, we can support pause-task/stop-task --force in the future
 	ctx, cancel := w.syncCtx.WithTimeout(maxDMLConnectionDuration)
 	defer cancel()
+	startTime := time.Now()
 	affect, err = db.ExecuteSQL(ctx, w.metricProxies, queries, args...)
+	if w.throttle != nil {
+		w.throttle.release(time.Since(startTime), err)
+	}
 	failpoint.Inject("SafeModeExit", func(val failpoint.Value) {
 		if intVal, ok := val.(int); ok && intVal == 4 && len(jobs) > 0 {
 			w.logger.Warn("fail to exec DML", zap.String("failpoint", "SafeModeExit"))
@@ -356,6 +399,26 @@ func (w *DMLWorker) executeBatchJobs(queueID int, jobs []*job, disableForeignKey
 	}
 }
 
+// writeBatchJobsToKafka sends the row changes of jobs to Kafka, which replaces
+// executing them on the downstream database.
+func (w *DMLWorker) writeBatchJobsToKafka(queueID int, jobs []*job) {
+	rows := make([]kafkasink.Row, 0, len(jobs))
+	for _, j := range jobs {
+		rows = append(rows, kafkasink.Row{Change: j.dml, CommitTs: j.commitTs})
+	}
+
+	ctx, cancel := w.syncCtx.WithTimeout(maxDMLConnectionDuration)
+	defer cancel()
+	if err := w.kafkaSink.WriteRows(ctx.Ctx, rows); err != nil {
+		w.fatalFunc(&job{
+			startLocation:   jobs[0].startLocation,
+			currentLocation: jobs[len(jobs)-1].currentLocation,
+		}, err)
+		return
+	}
+	w.successFunc(queueID, len(rows), jobs)
+}
+
 func (w *DMLWorker) setForeignKeyChecks(queueID int, enable bool) error {
 	value := "0"
 	if enable {
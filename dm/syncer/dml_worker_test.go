@@ -17,6 +17,7 @@ import (
 	"context"
 	"database/sql/driver"
 	"regexp"
+	"strconv"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -30,7 +31,12 @@ import (
 	connpkg "github.com/pingcap/tiflow/dm/pkg/conn"
 	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
 	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
 	"github.com/pingcap/tiflow/dm/syncer/dbconn"
+	"github.com/pingcap/tiflow/dm/syncer/kafkasink"
+	"github.com/pingcap/tiflow/dm/syncer/metrics"
+	"github.com/pingcap/tiflow/pkg/sink/kafka"
 	"github.com/pingcap/tiflow/pkg/sqlmodel"
 	"github.com/stretchr/testify/require"
 )
@@ -312,3 +318,116 @@ func TestExecuteBatchJobsWithForeignKey(t *testing.T) {
 
 	require.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestExecuteBatchJobsWaitThrottle(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	sqlConn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+	defer sqlConn.Close()
+
+	baseConn := connpkg.NewBaseConnForTest(sqlConn, nil)
+	cfg := &config.SubTaskConfig{Name: "test"}
+	dbConn := dbconn.NewDBConn(cfg, baseConn)
+
+	th := newDMLThrottle(config.DMLThrottleConfig{Enable: true}, 1, 100, log.L(), nil, nil)
+	require.True(t, th.acquire(context.Background()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var (
+		succeeded bool
+		fatalErr  error
+	)
+	worker := &DMLWorker{
+		toDBConns:   []*dbconn.DBConn{dbConn},
+		syncCtx:     tcontext.NewContext(ctx, log.L()),
+		throttle:    th,
+		successFunc: func(int, int, []*job) { succeeded = true },
+		fatalFunc:   func(_ *job, err error) { fatalErr = err },
+		logger:      log.L(),
+	}
+
+	source := &cdcmodel.TableName{Schema: "db", Table: "tb"}
+	target := &cdcmodel.TableName{Schema: "db", Table: "tb"}
+	tableInfo := mockTableInfo(t, "create table db.tb(id int primary key, name varchar(24))")
+	insertJob := newDMLJob(sqlmodel.NewRowChange(source, target, nil, []interface{}{1, "a"}, tableInfo, nil, nil), ec)
+
+	// the syncer is stopped while all the running slots are taken, the jobs
+	// are not executed without a slot and reported as canceled.
+	cancel()
+	worker.executeBatchJobs(0, []*job{insertJob}, false)
+	require.False(t, succeeded)
+	require.True(t, terror.ErrDBExecuteFailed.Equal(fatalErr))
+	require.True(t, utils.IsContextCanceledError(fatalErr))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDMLWorkerWriteKafkaInOrder(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.WithValue(context.Background(), "testing.T", t)
+	cfg := &config.SubTaskConfig{
+		Name:     "task",
+		SourceID: "source",
+		TargetKafka: &config.TargetKafkaConfig{
+			SinkURI: "kafka://127.0.0.1:9092/" + kafka.DefaultMockTopicName + "?kafka-version=0.9.0.0&partition-num=1" +
+				"&kafka-client-id=unit-test&auto-create-topic=false&protocol=canal-json",
+		},
+	}
+	kafkaSink, dmlProducer, _, err := kafkasink.NewMockSink(ctx, cfg, log.L())
+	require.NoError(t, err)
+	defer kafkaSink.Close()
+
+	const workerCount = 4
+	worker := &DMLWorker{
+		batch:                2,
+		workerCount:          workerCount,
+		chanSize:             10,
+		kafkaSink:            kafkaSink,
+		syncCtx:              tcontext.Background(),
+		logger:               log.L(),
+		metricProxies:        metrics.DefaultMetricsProxies.CacheForOneTask("task", "worker", "source"),
+		successFunc:          func(int, int, []*job) {},
+		fatalFunc:            func(_ *job, err error) { require.NoError(t, err) },
+		lagFunc:              func(*job, int) {},
+		updateJobMetricsFunc: func(bool, string, *job) {},
+		inCh:                 make(chan *job),
+		flushCh:              make(chan *job),
+	}
+	go func() {
+		worker.run()
+		worker.close()
+	}()
+
+	// the rows of both tables are sent to the only partition, the rows with
+	// different keys of a table are dispatched to different queues if they
+	// are not routed by the table.
+	tableInfo := mockTableInfo(t, "create table db.tb(id int primary key, name varchar(24))")
+	var commitTsAllocator kafkasink.CommitTsAllocator
+	for i := 0; i < 100; i++ {
+		for _, table := range []string{"tb1", "tb2"} {
+			source := &cdcmodel.TableName{Schema: "db", Table: table}
+			j := newDMLJob(sqlmodel.NewRowChange(source, source, nil, []interface{}{i, "a"}, tableInfo, nil, nil), ec)
+			j.dmlQueueKey = strconv.Itoa(i)
+			j.commitTs = commitTsAllocator.Next(1700000000)
+			worker.inCh <- j
+		}
+	}
+	worker.inCh <- newFlushJob(workerCount, 1)
+	<-worker.flushCh
+	close(worker.inCh)
+	<-worker.flushCh
+
+	events := dmlProducer.GetEvents(kafka.DefaultMockTopicName, 0)
+	require.Len(t, events, 200)
+	lastCommitTs := make(map[string]uint64)
+	for _, event := range events {
+		require.Greater(t, event.Ts, lastCommitTs[*event.Table])
+		lastCommitTs[*event.Table] = event.Ts
+	}
+	require.Len(t, lastCommitTs, 2)
+}
@@ -93,6 +93,8 @@ type job struct {
 	flushWg     *sync.WaitGroup // wait group for sync, async and conflict job
 	timestamp   uint32
 	timezone    string
+	// commitTs is the commit ts of the messages sent to Kafka.
+	commitTs uint64
 }
 
 func (j *job) clone() *job {
@@ -0,0 +1,338 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkasink
+
+import (
+	"sync"
+
+	timodel "github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	parserpkg "github.com/pingcap/tiflow/dm/pkg/parser"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/pkg/sqlmodel"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+// CommitTsAllocator allocates the commit ts of the messages from the timestamps
+// of the binlog events, so the codecs can get the physical time from it. The
+// binlog timestamps are in seconds, so the transactions in the same second are
+// told apart by the logical part to keep the commit ts strictly increasing,
+// which the consumers rely on to order the messages. It's not thread-safe.
+type CommitTsAllocator struct {
+	last uint64
+}
+
+// Next allocates the commit ts of a transaction whose binlog event has the
+// timestamp, it's larger than all the allocated commit ts.
+func (a *CommitTsAllocator) Next(timestamp uint32) uint64 {
+	ts := oracle.ComposeTS(int64(timestamp)*1000, 0)
+	if ts <= a.last {
+		ts = a.last + 1
+	}
+	a.last = ts
+	return ts
+}
+
+// Restore makes the allocated commit ts larger than ts, which is the last
+// commit ts allocated before the task is restarted.
+func (a *CommitTsAllocator) Restore(ts uint64) {
+	if ts > a.last {
+		a.last = ts
+	}
+}
+
+// Last returns the last allocated commit ts.
+func (a *CommitTsAllocator) Last() uint64 {
+	return a.last
+}
+
+type cachedTableInfo struct {
+	source *timodel.TableInfo
+	target *model.TableInfo
+}
+
+// tableInfoCache wraps the table infos of the schema tracker into the table
+// infos of TiCDC, and names them by the routed target tables. A table info is
+// rewrapped with a new version after the schema of the source table changes,
+// so the codecs which cache the schemas by version won't use a stale one.
+type tableInfoCache struct {
+	mu      sync.Mutex
+	version uint64
+	tables  map[string]cachedTableInfo
+}
+
+func newTableInfoCache() *tableInfoCache {
+	return &tableInfoCache{tables: make(map[string]cachedTableInfo)}
+}
+
+func (c *tableInfoCache) get(
+	source, target *model.TableName, sourceTableInfo *timodel.TableInfo,
+) *model.TableInfo {
+	key := source.QuoteString() + "->" + target.QuoteString()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if cached, ok := c.tables[key]; ok && cached.source == sourceTableInfo {
+		return cached.target
+	}
+	c.version++
+	info := c.wrap(target, sourceTableInfo)
+	c.tables[key] = cachedTableInfo{source: sourceTableInfo, target: info}
+	return info
+}
+
+// targetTables returns the target tables which the row changes are sent for.
+func (c *tableInfoCache) targetTables() []*model.TableInfo {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	tables := make([]*model.TableInfo, 0, len(c.tables))
+	for _, cached := range c.tables {
+		tables = append(tables, cached.target)
+	}
+	return tables
+}
+
+// wrap must be called with the lock held.
+func (c *tableInfoCache) wrap(target *model.TableName, sourceTableInfo *timodel.TableInfo) *model.TableInfo {
+	ti := sourceTableInfo.Clone()
+	ti.Name = ast.NewCIStr(target.Table)
+	return model.WrapTableInfo(0, target.Schema, c.version, ti)
+}
+
+func (c *tableInfoCache) toRowChangedEvents(change *sqlmodel.RowChange, commitTs uint64) ([]*model.RowChangedEvent, error) {
+	tableInfo := c.get(change.GetSourceTable(), change.GetTargetTable(), change.SourceTableInfo())
+	event := &model.RowChangedEvent{
+		StartTs:         commitTs,
+		CommitTs:        commitTs,
+		PhysicalTableID: tableInfo.ID,
+		TableInfo:       tableInfo,
+	}
+	var err error
+	if event.PreColumns, err = toColumnDatas(change.GetPreValues(), change.SourceTableInfo()); err != nil {
+		return nil, err
+	}
+	if event.Columns, err = toColumnDatas(change.GetPostValues(), change.SourceTableInfo()); err != nil {
+		return nil, err
+	}
+
+	// align with the default behavior of the TiCDC Kafka sinks, an update
+	// which changes the handle key or a unique key is sent as delete and insert.
+	if event.IsUpdate() && model.ShouldSplitUpdateEvent(event) {
+		deleteEvent, insertEvent, err := model.SplitUpdateEvent(event)
+		if err != nil {
+			return nil, terror.ErrSyncerWriteKafka.Delegate(err, "row changes")
+		}
+		return []*model.RowChangedEvent{deleteEvent, insertEvent}, nil
+	}
+	return []*model.RowChangedEvent{event}, nil
+}
+
+// toColumnDatas converts the values of the non-hidden columns to the column
+// datas of the columns visible to TiCDC, which excludes the virtual generated
+// columns.
+func toColumnDatas(values []interface{}, tableInfo *timodel.TableInfo) ([]*model.ColumnData, error) {
+	if values == nil {
+		return nil, nil
+	}
+	datas := make([]*model.ColumnData, 0, len(values))
+	i := 0
+	for _, col := range tableInfo.Columns {
+		if col.Hidden {
+			continue
+		}
+		if i >= len(values) {
+			return nil, terror.ErrSyncerUnitDMLColumnNotMatch.Generate(i+1, len(values))
+		}
+		value := values[i]
+		i++
+		if !model.IsColCDCVisible(col) {
+			continue
+		}
+		datas = append(datas, &model.ColumnData{
+			ColumnID: col.ID,
+			Value:    toColumnValue(value, &col.FieldType),
+		})
+	}
+	return datas, nil
+}
+
+// toColumnValue converts a value decoded from binlog to the type that TiCDC
+// uses for the column, which is what the codecs expect.
+func toColumnValue(value interface{}, ft *types.FieldType) interface{} {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case int:
+		if ft.GetType() == mysql.TypeEnum || ft.GetType() == mysql.TypeSet || ft.GetType() == mysql.TypeBit {
+			return uint64(v)
+		}
+		return int64(v)
+	case int64:
+		if ft.GetType() == mysql.TypeEnum || ft.GetType() == mysql.TypeSet || ft.GetType() == mysql.TypeBit {
+			return uint64(v)
+		}
+	case string:
+		switch ft.GetType() {
+		case mysql.TypeVarchar, mysql.TypeVarString, mysql.TypeString,
+			mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeBlob:
+			return []byte(v)
+		}
+	case []byte:
+		if ft.GetType() == mysql.TypeJSON {
+			return string(v)
+		}
+	}
+	return value
+}
+
+func (c *tableInfoCache) toDDLEvent(query string, commitTs uint64, tableInfo *timodel.TableInfo) (*model.DDLEvent, error) {
+	stmt, err := parser.New().ParseOneStmt(query, "", "")
+	if err != nil {
+		return nil, terror.ErrSyncerParseDDL.Delegate(err, query)
+	}
+	tables, err := parserpkg.FetchDDLTables("", stmt, conn.LCTableNamesSensitive)
+	if err != nil {
+		return nil, err
+	}
+	if len(tables) == 0 {
+		return nil, terror.ErrSyncerParseDDL.Generate(query)
+	}
+	target := tables[0]
+	var pre *model.TableName
+	if isRenameDDL(stmt) && len(tables) > 1 {
+		pre = &model.TableName{Schema: tables[0].Schema, Table: tables[0].Name}
+		target = tables[len(tables)-1]
+	}
+
+	event := &model.DDLEvent{
+		StartTs:  commitTs,
+		CommitTs: commitTs,
+		Query:    query,
+		Type:     ddlActionType(stmt),
+		TableInfo: &model.TableInfo{
+			TableName: model.TableName{Schema: target.Schema, Table: target.Name},
+		},
+	}
+	if tableInfo != nil && target.Name != "" {
+		c.mu.Lock()
+		c.version++
+		event.TableInfo = c.wrap(&event.TableInfo.TableName, tableInfo)
+		c.mu.Unlock()
+	}
+	if pre != nil {
+		event.PreTableInfo = &model.TableInfo{TableName: *pre}
+	}
+	return event, nil
+}
+
+func isRenameDDL(stmt ast.StmtNode) bool {
+	switch v := stmt.(type) {
+	case *ast.RenameTableStmt:
+		return true
+	case *ast.AlterTableStmt:
+		for _, spec := range v.Specs {
+			if spec.Tp == ast.AlterTableRenameTable {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ddlActionType returns the action type of the DDL, DM has split the DDLs so
+// that each of them only has one change.
+func ddlActionType(stmt ast.StmtNode) timodel.ActionType {
+	switch v := stmt.(type) {
+	case *ast.CreateDatabaseStmt:
+		return timodel.ActionCreateSchema
+	case *ast.DropDatabaseStmt:
+		return timodel.ActionDropSchema
+	case *ast.AlterDatabaseStmt:
+		return timodel.ActionModifySchemaCharsetAndCollate
+	case *ast.CreateTableStmt:
+		return timodel.ActionCreateTable
+	case *ast.DropTableStmt:
+		if v.IsView {
+			return timodel.ActionDropView
+		}
+		return timodel.ActionDropTable
+	case *ast.TruncateTableStmt:
+		return timodel.ActionTruncateTable
+	case *ast.RenameTableStmt:
+		return timodel.ActionRenameTable
+	case *ast.CreateIndexStmt:
+		return timodel.ActionAddIndex
+	case *ast.DropIndexStmt:
+		return timodel.ActionDropIndex
+	case *ast.CreateViewStmt:
+		return timodel.ActionCreateView
+	case *ast.AlterTableStmt:
+		if len(v.Specs) != 1 {
+			return timodel.ActionMultiSchemaChange
+		}
+		return alterTableActionType(v.Specs[0])
+	}
+	return timodel.ActionNone
+}
+
+func alterTableActionType(spec *ast.AlterTableSpec) timodel.ActionType {
+	switch spec.Tp {
+	case ast.AlterTableAddColumns:
+		return timodel.ActionAddColumn
+	case ast.AlterTableDropColumn:
+		return timodel.ActionDropColumn
+	case ast.AlterTableModifyColumn, ast.AlterTableChangeColumn, ast.AlterTableRenameColumn:
+		return timodel.ActionModifyColumn
+	case ast.AlterTableAlterColumn:
+		return timodel.ActionSetDefaultValue
+	case ast.AlterTableAddConstraint:
+		switch spec.Constraint.Tp {
+		case ast.ConstraintPrimaryKey:
+			return timodel.ActionAddPrimaryKey
+		case ast.ConstraintForeignKey:
+			return timodel.ActionAddForeignKey
+		default:
+			return timodel.ActionAddIndex
+		}
+	case ast.AlterTableDropIndex:
+		return timodel.ActionDropIndex
+	case ast.AlterTableDropPrimaryKey:
+		return timodel.ActionDropPrimaryKey
+	case ast.AlterTableDropForeignKey:
+		return timodel.ActionDropForeignKey
+	case ast.AlterTableRenameIndex:
+		return timodel.ActionRenameIndex
+	case ast.AlterTableRenameTable:
+		return timodel.ActionRenameTable
+	case ast.AlterTableOption:
+		for _, opt := range spec.Options {
+			if opt.Tp == ast.TableOptionComment {
+				return timodel.ActionModifyTableComment
+			}
+		}
+		return timodel.ActionModifyTableCharsetAndCollate
+	case ast.AlterTableAddPartitions:
+		return timodel.ActionAddTablePartition
+	case ast.AlterTableDropPartition:
+		return timodel.ActionDropTablePartition
+	case ast.AlterTableTruncatePartition:
+		return timodel.ActionTruncateTablePartition
+	}
+	return timodel.ActionNone
+}
@@ -0,0 +1,206 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkasink
+
+import (
+	"testing"
+
+	tiddl "github.com/pingcap/tidb/pkg/ddl"
+	timodel "github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/types"
+	timock "github.com/pingcap/tidb/pkg/util/mock"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/sqlmodel"
+	"github.com/stretchr/testify/require"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+func mockTableInfo(t *testing.T, sql string) *timodel.TableInfo {
+	t.Helper()
+
+	node, err := parser.New().ParseOneStmt(sql, "", "")
+	require.NoError(t, err)
+	ti, err := tiddl.MockTableInfo(timock.NewContext(), node.(*ast.CreateTableStmt), 1)
+	require.NoError(t, err)
+	return ti
+}
+
+func TestCommitTsAllocator(t *testing.T) {
+	t.Parallel()
+
+	var a CommitTsAllocator
+	ts := a.Next(1700000000)
+	require.Equal(t, int64(1700000000000), oracle.ExtractPhysical(ts))
+	require.Equal(t, int64(0), oracle.ExtractLogical(ts))
+	require.Equal(t, ts, a.Last())
+
+	// the transactions in the same second get increasing logical parts.
+	ts2 := a.Next(1700000000)
+	require.Equal(t, int64(1700000000000), oracle.ExtractPhysical(ts2))
+	require.Equal(t, int64(1), oracle.ExtractLogical(ts2))
+
+	// the commit ts never goes back even if the binlog timestamp does.
+	ts3 := a.Next(1699999999)
+	require.Equal(t, ts2+1, ts3)
+
+	ts4 := a.Next(1700000001)
+	require.Equal(t, int64(1700000001000), oracle.ExtractPhysical(ts4))
+	require.Equal(t, int64(0), oracle.ExtractLogical(ts4))
+	require.Equal(t, ts4, a.Last())
+
+	// the restored commit ts is kept only when it's larger.
+	a.Restore(ts3)
+	require.Equal(t, ts4, a.Last())
+	a.Restore(ts4 + 10)
+	require.Equal(t, ts4+11, a.Next(1700000001))
+}
+
+func TestToColumnValue(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		value    interface{}
+		tp       byte
+		expected interface{}
+	}{
+		{nil, mysql.TypeLong, nil},
+		{1, mysql.TypeLong, int64(1)},
+		{1, mysql.TypeEnum, uint64(1)},
+		{int64(3), mysql.TypeSet, uint64(3)},
+		{int64(3), mysql.TypeLonglong, int64(3)},
+		{"abc", mysql.TypeVarchar, []byte("abc")},
+		{"abc", mysql.TypeBlob, []byte("abc")},
+		{"2025-01-01", mysql.TypeDate, "2025-01-01"},
+		{[]byte(`{"a":1}`), mysql.TypeJSON, `{"a":1}`},
+		{[]byte("abc"), mysql.TypeBlob, []byte("abc")},
+		{1.5, mysql.TypeDouble, 1.5},
+	}
+	for _, cs := range cases {
+		ft := types.NewFieldType(cs.tp)
+		require.Equal(t, cs.expected, toColumnValue(cs.value, ft), "%v %d", cs.value, cs.tp)
+	}
+}
+
+func TestToRowChangedEvents(t *testing.T) {
+	t.Parallel()
+
+	source := &model.TableName{Schema: "db", Table: "tb_1"}
+	target := &model.TableName{Schema: "db", Table: "tb"}
+	ti := mockTableInfo(t, "create table db.tb_1(id int primary key, c1 int unique, name varchar(24), v int as (id+1))")
+	cache := newTableInfoCache()
+	commitTs := oracle.ComposeTS(1700000000000, 0)
+
+	change := sqlmodel.NewRowChange(source, target, nil, []interface{}{1, 2, "a", 2}, ti, ti, nil)
+	events, err := cache.toRowChangedEvents(change, commitTs)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	event := events[0]
+	require.True(t, event.IsInsert())
+	require.Equal(t, commitTs, event.CommitTs)
+	require.Equal(t, "db", event.TableInfo.GetSchemaName())
+	require.Equal(t, "tb", event.TableInfo.GetTableName())
+	// the virtual generated column is not sent.
+	require.Len(t, event.Columns, 3)
+	require.Equal(t, int64(1), event.Columns[0].Value)
+	require.Equal(t, []byte("a"), event.Columns[2].Value)
+
+	// the table info is reused when the source table info is not changed.
+	change = sqlmodel.NewRowChange(source, target, []interface{}{1, 2, "a", 2}, []interface{}{1, 2, "b", 2}, ti, ti, nil)
+	events, err = cache.toRowChangedEvents(change, commitTs)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.True(t, events[0].IsUpdate())
+	require.Same(t, event.TableInfo, events[0].TableInfo)
+
+	// an update which changes the unique key is split.
+	change = sqlmodel.NewRowChange(source, target, []interface{}{1, 2, "b", 2}, []interface{}{1, 3, "b", 2}, ti, ti, nil)
+	events, err = cache.toRowChangedEvents(change, commitTs)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.True(t, events[0].IsDelete())
+	require.True(t, events[1].IsInsert())
+
+	// a new table info gets a new version.
+	ti2 := mockTableInfo(t, "create table db.tb_1(id int primary key, c1 int unique, name varchar(24), v int as (id+1), c2 int)")
+	change = sqlmodel.NewRowChange(source, target, nil, []interface{}{2, 3, "c", 3, 4}, ti2, ti2, nil)
+	events, err = cache.toRowChangedEvents(change, commitTs)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Greater(t, events[0].TableInfo.Version, event.TableInfo.Version)
+	require.Len(t, events[0].Columns, 4)
+}
+
+func TestToDDLEvent(t *testing.T) {
+	t.Parallel()
+
+	cache := newTableInfoCache()
+	ti := mockTableInfo(t, "create table db.tb(id int primary key)")
+
+	event, err := cache.toDDLEvent("CREATE TABLE `db`.`tb` (`id` INT PRIMARY KEY)", 10, ti)
+	require.NoError(t, err)
+	require.Equal(t, timodel.ActionCreateTable, event.Type)
+	require.Equal(t, uint64(10), event.CommitTs)
+	require.Equal(t, "db", event.TableInfo.GetSchemaName())
+	require.Equal(t, "tb", event.TableInfo.GetTableName())
+	require.Len(t, event.TableInfo.Columns, 1)
+	require.Nil(t, event.PreTableInfo)
+
+	event, err = cache.toDDLEvent("RENAME TABLE `db`.`tb` TO `db`.`tb2`", 11, ti)
+	require.NoError(t, err)
+	require.Equal(t, timodel.ActionRenameTable, event.Type)
+	require.Equal(t, "tb2", event.TableInfo.GetTableName())
+	require.Equal(t, "tb", event.PreTableInfo.GetTableName())
+
+	event, err = cache.toDDLEvent("DROP DATABASE `db`", 12, nil)
+	require.NoError(t, err)
+	require.Equal(t, timodel.ActionDropSchema, event.Type)
+	require.Equal(t, "db", event.TableInfo.GetSchemaName())
+
+	_, err = cache.toDDLEvent("CREATE TABL", 13, nil)
+	require.Error(t, err)
+}
+
+func TestDDLActionType(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		sql      string
+		expected timodel.ActionType
+	}{
+		{"create database db", timodel.ActionCreateSchema},
+		{"alter database db charset utf8mb4", timodel.ActionModifySchemaCharsetAndCollate},
+		{"create table db.tb(id int)", timodel.ActionCreateTable},
+		{"drop table db.tb", timodel.ActionDropTable},
+		{"drop view db.v", timodel.ActionDropView},
+		{"truncate table db.tb", timodel.ActionTruncateTable},
+		{"create index idx on db.tb(id)", timodel.ActionAddIndex},
+		{"drop index idx on db.tb", timodel.ActionDropIndex},
+		{"alter table db.tb add column c int", timodel.ActionAddColumn},
+		{"alter table db.tb drop column c", timodel.ActionDropColumn},
+		{"alter table db.tb modify column c bigint", timodel.ActionModifyColumn},
+		{"alter table db.tb add primary key(id)", timodel.ActionAddPrimaryKey},
+		{"alter table db.tb rename to db.tb2", timodel.ActionRenameTable},
+		{"alter table db.tb comment 'abc'", timodel.ActionModifyTableComment},
+		{"alter table db.tb add column c int, drop column d", timodel.ActionMultiSchemaChange},
+	}
+	p := parser.New()
+	for _, cs := range cases {
+		stmt, err := p.ParseOneStmt(cs.sql, "", "")
+		require.NoError(t, err)
+		require.Equal(t, cs.expected, ddlActionType(stmt), cs.sql)
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkasink
+
+import (
+	"context"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/ddlsink/mq/ddlproducer"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink/mq/dmlproducer"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/pkg/sink/kafka"
+)
+
+// NewMockSink creates a Sink which sends the messages to the mock producers,
+// it's only used in tests. The ctx must carry the *testing.T with the key
+// "testing.T", which is required by the mock Kafka clients.
+func NewMockSink(
+	ctx context.Context, cfg *config.SubTaskConfig, logger log.Logger,
+) (*Sink, *dmlproducer.MockDMLProducer, *ddlproducer.MockDDLProducer, error) {
+	var (
+		dmlProducer *dmlproducer.MockDMLProducer
+		ddlProducer *ddlproducer.MockDDLProducer
+	)
+	s, err := newSink(ctx, cfg, logger, kafka.NewMockFactory,
+		func(ctx context.Context, changefeedID model.ChangeFeedID, asyncProducer kafka.AsyncProducer,
+			metricsCollector kafka.MetricsCollector, errCh chan error, failpointCh chan error,
+		) dmlproducer.DMLProducer {
+			p := dmlproducer.NewDMLMockProducer(ctx, changefeedID, asyncProducer, metricsCollector, errCh, failpointCh)
+			dmlProducer = p.(*dmlproducer.MockDMLProducer)
+			return p
+		},
+		func(ctx context.Context, changefeedID model.ChangeFeedID, syncProducer kafka.SyncProducer) ddlproducer.DDLProducer {
+			p := ddlproducer.NewMockDDLProducer(ctx, changefeedID, syncProducer)
+			ddlProducer = p.(*ddlproducer.MockDDLProducer)
+			return p
+		})
+	return s, dmlProducer, ddlProducer, err
+}
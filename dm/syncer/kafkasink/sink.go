@@ -0,0 +1,228 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkasink
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/pingcap/errors"
+	timodel "github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tiflow/cdc/model"
+	ddlmq "github.com/pingcap/tiflow/cdc/sink/ddlsink/mq"
+	"github.com/pingcap/tiflow/cdc/sink/ddlsink/mq/ddlproducer"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink"
+	dmlmq "github.com/pingcap/tiflow/cdc/sink/dmlsink/mq"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink/mq/dmlproducer"
+	"github.com/pingcap/tiflow/cdc/sink/tablesink/state"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	cdcconfig "github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/sink/kafka"
+	"github.com/pingcap/tiflow/pkg/sqlmodel"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+)
+
+// changefeedNamespace is the namespace of the ChangeFeedID used by the TiCDC
+// sinks, which only shows up in their logs and metrics.
+const changefeedNamespace = "dm"
+
+// Row is a row change and the commit ts of the binlog event it comes from.
+type Row struct {
+	Change   *sqlmodel.RowChange
+	CommitTs uint64
+}
+
+// Sink sends the row changes and DDLs of a subtask to Kafka. It reuses the
+// Kafka sinks of TiCDC, so the messages can be consumed in the same way as the
+// ones of a changefeed.
+type Sink struct {
+	logger log.Logger
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	dmlSink dmlsink.EventSink[*model.SingleTableTxn]
+	ddlSink *ddlmq.DDLSink
+	// sinkState is shared by all the events, it is always TableSinkSinking.
+	sinkState state.TableSinkState
+
+	// failed is closed after the DML sink meets an error, which is stored in err.
+	failed chan struct{}
+	err    atomic.Error
+
+	tables *tableInfoCache
+}
+
+// NewSink creates a Sink by the target-kafka config of the subtask.
+func NewSink(ctx context.Context, cfg *config.SubTaskConfig, logger log.Logger) (*Sink, error) {
+	return newSink(ctx, cfg, logger, kafka.NewSaramaFactory,
+		dmlproducer.NewKafkaDMLProducer, ddlproducer.NewKafkaDDLProducer)
+}
+
+func newSink(
+	ctx context.Context,
+	cfg *config.SubTaskConfig,
+	logger log.Logger,
+	factoryCreator kafka.FactoryCreator,
+	dmlProducerCreator dmlproducer.Factory,
+	ddlProducerCreator ddlproducer.Factory,
+) (_ *Sink, err error) {
+	if cfg.TargetKafka == nil {
+		return nil, terror.ErrConfigInvalidTargetKafka.Generate("`target-kafka` is not set")
+	}
+	sinkURI, err := url.Parse(cfg.TargetKafka.SinkURI)
+	if err != nil {
+		return nil, terror.ErrConfigInvalidTargetKafka.Delegate(err, "`sink-uri` can't be parsed")
+	}
+	replicaConfig := cdcconfig.GetDefaultReplicaConfig()
+	if err = replicaConfig.ValidateAndAdjust(sinkURI); err != nil {
+		return nil, terror.ErrConfigInvalidTargetKafka.Delegate(err, err.Error())
+	}
+
+	changefeedID := model.ChangeFeedID{Namespace: changefeedNamespace, ID: cfg.Name + "-" + cfg.SourceID}
+	ctx, cancel := context.WithCancel(ctx)
+	defer func() {
+		if err != nil {
+			cancel()
+		}
+	}()
+
+	errCh := make(chan error, 1)
+	dmlSink, err := dmlmq.NewKafkaDMLSink(ctx, changefeedID, sinkURI, replicaConfig, errCh,
+		factoryCreator, dmlProducerCreator)
+	if err != nil {
+		return nil, terror.ErrSyncerWriteKafka.Delegate(err, "row changes")
+	}
+	ddlSink, err := ddlmq.NewKafkaDDLSink(ctx, changefeedID, sinkURI, replicaConfig,
+		factoryCreator, ddlProducerCreator)
+	if err != nil {
+		dmlSink.Close()
+		return nil, terror.ErrSyncerWriteKafka.Delegate(err, "DDLs")
+	}
+
+	s := &Sink{
+		logger:    logger,
+		ctx:       ctx,
+		cancel:    cancel,
+		dmlSink:   dmlSink,
+		ddlSink:   ddlSink,
+		sinkState: state.TableSinkSinking,
+		failed:    make(chan struct{}),
+		tables:    newTableInfoCache(),
+	}
+	go func() {
+		select {
+		case err := <-errCh:
+			s.err.Store(err)
+			close(s.failed)
+		case <-ctx.Done():
+		}
+	}()
+	logger.Info("kafka sink created", zap.String("sink-uri", sinkURI.Redacted()))
+	return s, nil
+}
+
+// WriteRows sends the rows to Kafka and waits until all of them are acked.
+// It's safe to call WriteRows concurrently, the rows of each call are sent in
+// order, but the rows of concurrent calls can be interleaved in a partition.
+// The consumers ignore the rows of a table whose commit ts is less than the
+// ones received before in the same partition, so the rows of a table must be
+// written by one caller in the order of the commit ts.
+func (s *Sink) WriteRows(ctx context.Context, rows []Row) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	txns := make([]*dmlsink.TxnCallbackableEvent, 0, len(rows))
+	var lastTxn *model.SingleTableTxn
+	for _, row := range rows {
+		events, err := s.tables.toRowChangedEvents(row.Change, row.CommitTs)
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			if lastTxn != nil && lastTxn.CommitTs == event.CommitTs && lastTxn.TableInfo == event.TableInfo {
+				lastTxn.Rows = append(lastTxn.Rows, event)
+				continue
+			}
+			lastTxn = &model.SingleTableTxn{
+				PhysicalTableID:  event.PhysicalTableID,
+				TableInfo:        event.TableInfo,
+				TableInfoVersion: event.TableInfo.Version,
+				StartTs:          event.CommitTs,
+				CommitTs:         event.CommitTs,
+				Rows:             []*model.RowChangedEvent{event},
+			}
+			txns = append(txns, &dmlsink.TxnCallbackableEvent{Event: lastTxn, SinkState: &s.sinkState})
+		}
+	}
+
+	var (
+		remaining = atomic.NewInt64(int64(len(txns)))
+		done      = make(chan struct{})
+	)
+	for _, txn := range txns {
+		txn.Callback = func() {
+			if remaining.Dec() == 0 {
+				close(done)
+			}
+		}
+	}
+	if err := s.dmlSink.WriteEvents(txns...); err != nil {
+		return terror.ErrSyncerWriteKafka.Delegate(err, "row changes")
+	}
+
+	select {
+	case <-done:
+		return nil
+	case <-s.failed:
+		return terror.ErrSyncerWriteKafka.Delegate(s.err.Load(), "row changes")
+	case <-ctx.Done():
+		return errors.Trace(ctx.Err())
+	}
+}
+
+// WriteDDL sends a DDL to all the partitions of the topic. tableInfo is the
+// structure of the table after the DDL, it can be nil if the DDL is not about
+// a single table or the table doesn't exist anymore.
+func (s *Sink) WriteDDL(ctx context.Context, query string, commitTs uint64, tableInfo *timodel.TableInfo) error {
+	event, err := s.tables.toDDLEvent(query, commitTs, tableInfo)
+	if err != nil {
+		return err
+	}
+	if err = s.ddlSink.WriteDDLEvent(ctx, event); err != nil {
+		return terror.ErrSyncerWriteKafka.Delegate(err, "DDL "+query)
+	}
+	return nil
+}
+
+// WriteWatermark sends the watermark to all the partitions of the topics, which
+// tells the consumers that all the changes with a commit ts less than or equal
+// to it have been sent, so they can flush them.
+func (s *Sink) WriteWatermark(ctx context.Context, ts uint64) error {
+	if err := s.ddlSink.WriteCheckpointTs(ctx, ts, s.tables.targetTables()); err != nil {
+		return terror.ErrSyncerWriteKafka.Delegate(err, "watermark")
+	}
+	return nil
+}
+
+// Close closes the sink, the rows which are being written are dropped.
+func (s *Sink) Close() {
+	s.dmlSink.Close()
+	s.ddlSink.Close()
+	s.cancel()
+}
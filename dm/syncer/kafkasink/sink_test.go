@@ -0,0 +1,106 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkasink
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/ddlsink/mq/ddlproducer"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink/mq/dmlproducer"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/pkg/sink/kafka"
+	"github.com/pingcap/tiflow/pkg/sqlmodel"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSink(
+	t *testing.T, protocol string,
+) (*Sink, *dmlproducer.MockDMLProducer, *ddlproducer.MockDDLProducer, error) {
+	ctx := context.WithValue(context.Background(), "testing.T", t)
+	cfg := &config.SubTaskConfig{
+		Name:     "task",
+		SourceID: "source",
+		TargetKafka: &config.TargetKafkaConfig{
+			SinkURI: fmt.Sprintf("kafka://127.0.0.1:9092/%s?kafka-version=0.9.0.0&partition-num=1"+
+				"&kafka-client-id=unit-test&auto-create-topic=false&protocol=%s", kafka.DefaultMockTopicName, protocol),
+		},
+	}
+	return NewMockSink(ctx, cfg, log.L())
+}
+
+func TestSinkWrite(t *testing.T) {
+	s, dmlProducer, ddlProducer, err := newTestSink(t, "canal-json")
+	require.NoError(t, err)
+	defer s.Close()
+
+	ctx := context.Background()
+	source := &model.TableName{Schema: "db", Table: "tb_1"}
+	target := &model.TableName{Schema: "db", Table: "tb"}
+	ti := mockTableInfo(t, "create table db.tb_1(id int primary key, c1 int unique, name varchar(24))")
+	var commitTsAllocator CommitTsAllocator
+
+	require.NoError(t, s.WriteDDL(ctx, "CREATE TABLE `db`.`tb` (`id` INT PRIMARY KEY,`c1` INT UNIQUE,`name` VARCHAR(24))",
+		commitTsAllocator.Next(1700000000), ti))
+	require.Len(t, ddlProducer.GetAllEvents(), 1)
+
+	commitTs := commitTsAllocator.Next(1700000001)
+	rows := []Row{
+		{Change: sqlmodel.NewRowChange(source, target, nil, []interface{}{1, 1, "a"}, ti, ti, nil), CommitTs: commitTs},
+		{Change: sqlmodel.NewRowChange(source, target, nil, []interface{}{2, 2, "b"}, ti, ti, nil), CommitTs: commitTs},
+		// split to delete and insert.
+		{Change: sqlmodel.NewRowChange(source, target, []interface{}{2, 2, "b"}, []interface{}{2, 3, "b"}, ti, ti, nil), CommitTs: commitTs},
+		{Change: sqlmodel.NewRowChange(source, target, []interface{}{1, 1, "a"}, nil, ti, ti, nil), CommitTs: commitTsAllocator.Next(1700000002)},
+	}
+	require.NoError(t, s.WriteRows(ctx, rows))
+	require.Len(t, dmlProducer.GetEvents(kafka.DefaultMockTopicName, 0), 5)
+	require.NoError(t, s.WriteRows(ctx, nil))
+}
+
+func TestSinkWriteWatermark(t *testing.T) {
+	s, _, ddlProducer, err := newTestSink(t, "open-protocol")
+	require.NoError(t, err)
+	defer s.Close()
+
+	ctx := context.Background()
+	source := &model.TableName{Schema: "db", Table: "tb_1"}
+	target := &model.TableName{Schema: "db", Table: "tb"}
+	ti := mockTableInfo(t, "create table db.tb_1(id int primary key, name varchar(24))")
+	var commitTsAllocator CommitTsAllocator
+
+	commitTs := commitTsAllocator.Next(1700000000)
+	require.NoError(t, s.WriteRows(ctx, []Row{
+		{Change: sqlmodel.NewRowChange(source, target, nil, []interface{}{1, "a"}, ti, ti, nil), CommitTs: commitTs},
+	}))
+	require.NoError(t, s.WriteWatermark(ctx, commitTs))
+	events := ddlProducer.GetEvents(kafka.DefaultMockTopicName, 0)
+	require.Len(t, events, 1)
+	require.Equal(t, model.MessageTypeResolved, events[0].Type)
+	require.Equal(t, commitTs, events[0].Ts)
+}
+
+func TestNewSinkFailed(t *testing.T) {
+	t.Parallel()
+
+	_, _, _, err := newTestSink(t, "avro")
+	require.True(t, terror.ErrSyncerWriteKafka.Equal(err))
+	require.ErrorContains(t, err, "schema-registry")
+
+	_, err = NewSink(context.Background(), &config.SubTaskConfig{}, log.L())
+	require.True(t, terror.ErrConfigInvalidTargetKafka.Equal(err))
+}
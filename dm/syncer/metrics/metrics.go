@@ -50,6 +50,8 @@ type Metrics struct {
 	ShardLockResolving               prometheus.Gauge
 	FinishedTransactionTotal         prometheus.Counter
 	FlushCheckPointsTimeInterval     prometheus.Observer
+	DMLThrottleWorkerCount           prometheus.Gauge
+	DMLThrottleBatch                 prometheus.Gauge
 }
 
 // Proxies provides the ability to clean Metrics values when syncer is closed.
@@ -84,6 +86,7 @@ type Proxies struct {
 	finishedTransactionTotal        *prometheus.CounterVec
 	ReplicationTransactionBatch     *prometheus.HistogramVec
 	flushCheckPointsTimeInterval    *prometheus.HistogramVec
+	dmlThrottleLimit                *prometheus.GaugeVec
 }
 
 var DefaultMetricsProxies *Proxies
@@ -291,6 +294,13 @@ func (m *Proxies) Init(f promutil.Factory) {
 			Help:      "checkpoint flushed time interval in seconds",
 			Buckets:   prometheus.LinearBuckets(1, 50, 21), // linear from 1 to 1001, i think this is enough
 		}, []string{"worker", "task", "source_id"})
+	m.dmlThrottleLimit = f.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "dm",
+			Subsystem: "syncer",
+			Name:      "dml_throttle_limit",
+			Help:      "current limit of DML concurrency or batch size adjusted by the downstream health",
+		}, []string{"type", "task", "source_id"})
 }
 
 // CacheForOneTask returns a new Proxies with m.Metrics filled. It is used
@@ -317,6 +327,8 @@ func (m *Proxies) CacheForOneTask(taskName, workerName, sourceID string) *Proxie
 	ret.Metrics.ShardLockResolving = m.shardLockResolving.WithLabelValues(taskName, sourceID)
 	ret.Metrics.FinishedTransactionTotal = m.finishedTransactionTotal.WithLabelValues(taskName, workerName, sourceID)
 	ret.Metrics.FlushCheckPointsTimeInterval = m.flushCheckPointsTimeInterval.WithLabelValues(workerName, taskName, sourceID)
+	ret.Metrics.DMLThrottleWorkerCount = m.dmlThrottleLimit.WithLabelValues("worker-count", taskName, sourceID)
+	ret.Metrics.DMLThrottleBatch = m.dmlThrottleLimit.WithLabelValues("batch", taskName, sourceID)
 	return &ret
 }
 
@@ -348,6 +360,7 @@ func (m *Proxies) RegisterMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(m.finishedTransactionTotal)
 	registry.MustRegister(m.ReplicationTransactionBatch)
 	registry.MustRegister(m.flushCheckPointsTimeInterval)
+	registry.MustRegister(m.dmlThrottleLimit)
 }
 
 // RemoveLabelValuesWithTaskInMetrics cleans all Metrics related to the task.
@@ -378,4 +391,5 @@ func (m *Proxies) RemoveLabelValuesWithTaskInMetrics(task string) {
 	m.finishedTransactionTotal.DeletePartialMatch(prometheus.Labels{"task": task})
 	m.ReplicationTransactionBatch.DeletePartialMatch(prometheus.Labels{"task": task})
 	m.flushCheckPointsTimeInterval.DeletePartialMatch(prometheus.Labels{"task": task})
+	m.dmlThrottleLimit.DeletePartialMatch(prometheus.Labels{"task": task})
 }
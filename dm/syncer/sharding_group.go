@@ -89,6 +89,7 @@ import (
 	"github.com/pingcap/tiflow/dm/syncer/dbconn"
 	"github.com/pingcap/tiflow/dm/syncer/metrics"
 	shardmeta "github.com/pingcap/tiflow/dm/syncer/sharding-meta"
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
 )
 
@@ -113,6 +114,11 @@ type ShardingGroup struct {
 
 	flavor     string
 	enableGTID bool
+
+	// DML activity reported to DM-master, which is used by the shard DDL lock policy.
+	// the timestamps are in binlog time, so DM-master can compare them with the binlog time of the shard DDL.
+	lastDMLTs atomic.Int64 // binlog timestamp of the last DML
+	sinceTs   int64        // binlog timestamp since when the group began to record the DML activity, 0 if not known
 }
 
 // NewShardingGroup creates a new ShardingGroup.
@@ -274,6 +280,20 @@ func (sg *ShardingGroup) UnresolvedGroupInfo() *pb.ShardingGroup {
 	return group
 }
 
+// RecordDML records the binlog timestamp of a DML replicated for the group.
+func (sg *ShardingGroup) RecordDML(ts int64) {
+	sg.lastDMLTs.Store(ts)
+}
+
+// DMLActivity returns the DML activity of the group.
+// SinceTs is 0 if the group is created before any binlog event is received.
+func (sg *ShardingGroup) DMLActivity() *pb.ShardDMLActivity {
+	return &pb.ShardDMLActivity{
+		LastDMLTs: sg.lastDMLTs.Load(),
+		SinceTs:   sg.sinceTs,
+	}
+}
+
 // Sources returns all sources (and whether synced).
 func (sg *ShardingGroup) Sources() map[string]bool {
 	sg.RLock()
@@ -386,6 +406,11 @@ type ShardingGroupKeeper struct {
 	db     *conn.BaseDB
 	dbConn *dbconn.DBConn
 
+	// binlog timestamps of the first and the latest binlog events received after the groups are created,
+	// the DML activities of the groups are recorded since the first one.
+	firstEventTs  atomic.Int64
+	latestEventTs atomic.Int64
+
 	tctx *tcontext.Context
 }
 
@@ -418,7 +443,9 @@ func (k *ShardingGroupKeeper) AddGroup(targetTable *filter.Table, sourceIDs []st
 	defer k.Unlock()
 
 	if schemaGroup, ok := k.groups[targetSchemaID]; !ok {
-		k.groups[targetSchemaID] = NewShardingGroup(k.cfg.SourceID, k.shardMetaSchema, k.shardMetaTable, sourceIDs, meta, true, k.cfg.Flavor, k.cfg.EnableGTID)
+		schemaGroup = NewShardingGroup(k.cfg.SourceID, k.shardMetaSchema, k.shardMetaTable, sourceIDs, meta, true, k.cfg.Flavor, k.cfg.EnableGTID)
+		schemaGroup.sinceTs = k.latestEventTs.Load()
+		k.groups[targetSchemaID] = schemaGroup
 	} else {
 		_, _, _, err = schemaGroup.Merge(sourceIDs)
 		if err != nil {
@@ -430,6 +457,8 @@ func (k *ShardingGroupKeeper) AddGroup(targetTable *filter.Table, sourceIDs []st
 	switch {
 	case !ok:
 		group = NewShardingGroup(k.cfg.SourceID, k.shardMetaSchema, k.shardMetaTable, sourceIDs, meta, false, k.cfg.Flavor, k.cfg.EnableGTID)
+		// no DML of the target table can be received before the group is created.
+		group.sinceTs = k.latestEventTs.Load()
 		k.groups[targetTableID] = group
 	case merge:
 		needShardingHandle, synced, remain, err = k.groups[targetTableID].Merge(sourceIDs)
@@ -473,6 +502,8 @@ func (k *ShardingGroupKeeper) clear() {
 	k.Lock()
 	defer k.Unlock()
 	k.groups = make(map[string]*ShardingGroup)
+	k.firstEventTs.Store(0)
+	k.latestEventTs.Store(0)
 }
 
 // ResetGroups resets group's sync status.
@@ -641,6 +672,39 @@ func (k *ShardingGroupKeeper) UnresolvedGroups() []*pb.ShardingGroup {
 	return groups
 }
 
+// RecordDML records the binlog timestamp of a DML replicated for the target table's group.
+func (k *ShardingGroupKeeper) RecordDML(targetTable *filter.Table, ts int64) {
+	if group := k.Group(targetTable); group != nil {
+		group.RecordDML(ts)
+	}
+}
+
+// RecordEvent records the binlog timestamp of a received binlog event.
+func (k *ShardingGroupKeeper) RecordEvent(ts int64) {
+	if ts == 0 {
+		return // fake events, e.g. the fake rotate event.
+	}
+	k.firstEventTs.CompareAndSwap(0, ts)
+	k.latestEventTs.Store(ts)
+}
+
+// DMLActivities returns the DML activities of all sharding groups.
+func (k *ShardingGroupKeeper) DMLActivities() []*pb.ShardDMLActivity {
+	k.RLock()
+	defer k.RUnlock()
+	activities := make([]*pb.ShardDMLActivity, 0, len(k.groups))
+	for target, group := range k.groups {
+		activity := group.DMLActivity()
+		activity.Target = target
+		if activity.SinceTs == 0 {
+			// the group is created before receiving any binlog event.
+			activity.SinceTs = k.firstEventTs.Load()
+		}
+		activities = append(activities, activity)
+	}
+	return activities
+}
+
 // ResolveShardingDDL resolves one sharding DDL in specific group.
 func (k *ShardingGroupKeeper) ResolveShardingDDL(targetTable *filter.Table) (bool, error) {
 	if group := k.Group(targetTable); group != nil {
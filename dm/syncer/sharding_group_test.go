@@ -219,6 +219,45 @@ func (t *testShardingGroupSuite) TestSync(c *check.C) {
 	c.Assert(g1.UnresolvedTables(), check.IsNil)
 }
 
+func (t *testShardingGroupSuite) TestDMLActivity(c *check.C) {
+	k := NewShardingGroupKeeper(tcontext.Background(), t.cfg, nil)
+	k.clear()
+	k.groups[target] = NewShardingGroup(k.cfg.SourceID, k.shardMetaSchema, k.shardMetaTable, []string{source1, source2}, nil, false, "", false)
+
+	// no binlog event received yet.
+	activities := k.DMLActivities()
+	c.Assert(activities, check.HasLen, 1)
+	c.Assert(activities[0].Target, check.Equals, target)
+	c.Assert(activities[0].LastDMLTs, check.Equals, int64(0))
+	c.Assert(activities[0].SinceTs, check.Equals, int64(0))
+
+	// the activity is recorded since the first binlog event, fake events are ignored.
+	k.RecordEvent(0)
+	k.RecordEvent(100)
+	k.RecordEvent(200)
+	activities = k.DMLActivities()
+	c.Assert(activities[0].SinceTs, check.Equals, int64(100))
+
+	// record DML for a non-existing group takes no effect.
+	k.RecordDML(&filter.Table{Schema: targetTbl.Schema, Name: "wrong table"}, 123)
+	k.RecordDML(targetTbl, 456)
+	activities = k.DMLActivities()
+	c.Assert(activities, check.HasLen, 1)
+	c.Assert(activities[0].LastDMLTs, check.Equals, int64(456))
+
+	// the group created later is recorded since the latest binlog event.
+	_, _, _, _, err := k.AddGroup(&filter.Table{Schema: "db", Name: "new"}, []string{source1}, nil, false)
+	c.Assert(err, check.IsNil)
+	for _, activity := range k.DMLActivities() {
+		switch activity.Target {
+		case target:
+			c.Assert(activity.SinceTs, check.Equals, int64(100))
+		default:
+			c.Assert(activity.SinceTs, check.Equals, int64(200))
+		}
+	}
+}
+
 func (t *testShardingGroupSuite) TestTableID(c *check.C) {
 	originTables := []*filter.Table{
 		{Schema: "db", Name: "table"},
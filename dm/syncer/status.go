@@ -68,6 +68,10 @@ func (s *Syncer) Status(sourceStatus *binlog.SourceStatus) interface{} {
 		}
 	}
 
+	if s.binlogErrorRules != nil {
+		st.BinlogErrorRuleRecords = s.binlogErrorRules.Records()
+	}
+
 	st.BinlogType = "unknown"
 	if s.streamerController != nil {
 		st.BinlogType = s.streamerController.GetBinlogType().String()
@@ -76,6 +80,7 @@ func (s *Syncer) Status(sourceStatus *binlog.SourceStatus) interface{} {
 	// only support to show `UnresolvedGroups` in pessimistic mode now.
 	if s.cfg.ShardMode == config.ShardPessimistic {
 		st.UnresolvedGroups = s.sgk.UnresolvedGroups()
+		st.ShardDMLActivities = s.sgk.DMLActivities()
 	}
 
 	pendingShardInfo := s.pessimist.PendingInfo()
@@ -48,6 +48,7 @@ import (
 	"github.com/pingcap/tiflow/dm/pkg/binlog/reader"
 	"github.com/pingcap/tiflow/dm/pkg/conn"
 	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/cputil"
 	fr "github.com/pingcap/tiflow/dm/pkg/func-rollback"
 	"github.com/pingcap/tiflow/dm/pkg/gtid"
 	"github.com/pingcap/tiflow/dm/pkg/ha"
@@ -63,6 +64,7 @@ import (
 	"github.com/pingcap/tiflow/dm/relay"
 	"github.com/pingcap/tiflow/dm/syncer/binlogstream"
 	"github.com/pingcap/tiflow/dm/syncer/dbconn"
+	"github.com/pingcap/tiflow/dm/syncer/kafkasink"
 	"github.com/pingcap/tiflow/dm/syncer/metrics"
 	onlineddl "github.com/pingcap/tiflow/dm/syncer/online-ddl-tools"
 	sm "github.com/pingcap/tiflow/dm/syncer/safe-mode"
@@ -151,6 +153,14 @@ type Syncer struct {
 	ddlDB               *conn.BaseDB
 	ddlDBConn           *dbconn.DBConn
 	downstreamTrackConn *dbconn.DBConn
+	// kafkaSink is not nil when the changes are sent to Kafka instead of
+	// the downstream database, which then only stores the meta data.
+	kafkaSink *kafkasink.Sink
+	// kafkaCommitTs allocates the commit ts of the transactions sent to Kafka,
+	// kafkaTxnCommitTs is the commit ts of the ongoing transaction, it's zero
+	// when there is no ongoing transaction.
+	kafkaCommitTs    kafkasink.CommitTsAllocator
+	kafkaTxnCommitTs uint64
 
 	dmlJobCh            chan *job
 	ddlJobCh            chan *job
@@ -166,6 +176,9 @@ type Syncer struct {
 	exprFilterGroup *ExprFilterGroup
 	sessCtx         sessionctx.Context
 
+	binlogErrorRules *binlogErrorRules
+	dmlThrottle      *dmlThrottle
+
 	running atomic.Bool
 	closed  atomic.Bool
 
@@ -221,6 +234,7 @@ type Syncer struct {
 	cutOverLocation atomic.Pointer[binlog.Location]
 
 	handleJobFunc func(*job) (bool, error)
+	runFunc       func(context.Context) error
 	flushSeq      int64
 
 	// `lower_case_table_names` setting of upstream db
@@ -286,6 +300,7 @@ func NewSyncer(cfg *config.SubTaskConfig, etcdClient *clientv3.Client, relay rel
 	syncer.lastCount.Store(0)
 	syncer.count.Store(0)
 	syncer.handleJobFunc = syncer.handleJob
+	syncer.runFunc = syncer.Run
 	syncer.cli = etcdClient
 
 	syncer.checkpoint = NewRemoteCheckPoint(syncer.tctx, cfg, syncer.metricsProxies, syncer.checkpointID())
@@ -424,6 +439,16 @@ func (s *Syncer) Init(ctx context.Context) (err error) {
 	}
 	s.sessCtx = utils.NewSessionCtx(vars)
 	s.exprFilterGroup = NewExprFilterGroup(s.tctx, s.sessCtx, s.cfg.ExprFilter)
+	s.binlogErrorRules, err = newBinlogErrorRules(s.cfg.BinlogErrorRules)
+	if err != nil {
+		return err
+	}
+	if s.cfg.TargetKafka == nil {
+		s.dmlThrottle = newDMLThrottle(s.cfg.Throttle, s.cfg.WorkerCount, s.cfg.Batch, s.tctx.L(), s.metricsProxies,
+			func(ctx context.Context) (int64, int64, error) {
+				return conn.GetTiDBMemoryUsage(ctx, s.toDB)
+			})
+	}
 	// create an empty Tracker and will be initialized in `Run`
 	s.schemaTracker = schema.NewTracker()
 
@@ -479,6 +504,11 @@ func (s *Syncer) Init(ctx context.Context) (err error) {
 	if err != nil {
 		return err
 	}
+	if s.kafkaSink != nil {
+		if err = s.loadKafkaCommitTs(tctx); err != nil {
+			return err
+		}
+	}
 	if s.SourceTableNamesFlavor == conn.LCTableNamesSensitive {
 		if err = s.checkpoint.CheckAndUpdate(ctx, schemaMap, tableMap); err != nil {
 			return err
@@ -644,6 +674,7 @@ func (s *Syncer) reset() {
 	s.setErrLocation(nil, nil, false)
 	s.waitXIDJob.Store(int64(noWait))
 	s.isTransactionEnd = true
+	s.kafkaTxnCommitTs = 0
 	s.flushSeq = 0
 	s.firstMeetBinlogTS = nil
 	s.exitSafeModeTS = nil
@@ -713,18 +744,46 @@ func (s *Syncer) Process(ctx context.Context, pr chan pb.ProcessResult) {
 	s.metricsProxies.Metrics.ExitWithResumableErrorCounter.Add(0)
 	s.metricsProxies.Metrics.ExitWithNonResumableErrorCounter.Add(0)
 
-	newCtx, cancel := context.WithCancel(ctx)
-	defer cancel()
-
-	// create new done chan
-	// use lock of Syncer to avoid Close while Process
-	s.Lock()
-	if s.isClosed() {
+	for {
+		// use lock of Syncer to avoid Close while Process
+		s.Lock()
+		if s.isClosed() {
+			s.Unlock()
+			return
+		}
 		s.Unlock()
+
+		isCanceled, errs := s.runOnce(ctx)
+
+		// the failed DDLs are handled by the binlog error rules, re-sync from the checkpoint to apply the operators.
+		if !isCanceled && allBinlogErrorRuleApplied(errs) {
+			s.tctx.L().Info("resume syncer after applying binlog error rules", zap.String("errors", unit.JoinProcessErrors(errs)))
+			s.reset()
+			// reset database conns
+			err := s.resetDBs(s.tctx.WithContext(ctx))
+			if err == nil {
+				continue
+			}
+			errs = []*pb.ProcessError{unit.NewProcessError(err)}
+		}
+
+		for _, processError := range errs {
+			s.handleExitErrMetric(processError)
+		}
+		pr <- pb.ProcessResult{
+			IsCanceled: isCanceled,
+			Errors:     errs,
+		}
 		return
 	}
-	s.Unlock()
+}
 
+// runOnce runs the syncer until it quits, and returns whether it's canceled by ctx and the errors it meets.
+func (s *Syncer) runOnce(ctx context.Context) (bool, []*pb.ProcessError) {
+	newCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// create new done chan
 	runFatalChan := make(chan *pb.ProcessError, s.cfg.WorkerCount+1)
 	s.runFatalChan = runFatalChan
 	var (
@@ -754,7 +813,7 @@ func (s *Syncer) Process(ctx context.Context, pr chan pb.ProcessResult) {
 		<-newCtx.Done() // ctx or newCtx
 	}()
 
-	err := s.Run(newCtx)
+	err := s.runFunc(newCtx)
 	if err != nil {
 		// returned error rather than sent to runFatalChan
 		// cancel goroutines created in s.Run
@@ -780,14 +839,7 @@ func (s *Syncer) Process(ctx context.Context, pr chan pb.ProcessResult) {
 		isCanceled = true
 	default:
 	}
-
-	for _, processError := range errs {
-		s.handleExitErrMetric(processError)
-	}
-	pr <- pb.ProcessResult{
-		IsCanceled: isCanceled,
-		Errors:     errs,
-	}
+	return isCanceled, errs
 }
 
 // getTableInfo returns a table info for sourceTable, it should not be modified by caller.
@@ -824,12 +876,13 @@ func (s *Syncer) getTableInfo(tctx *tcontext.Context, sourceTable, targetTable *
 func (s *Syncer) getDBInfoFromDownstream(tctx *tcontext.Context, sourceTable, targetTable *filter.Table) (*model.DBInfo, error) {
 	// TODO: Switch to use the HTTP interface to retrieve the TableInfo directly if HTTP port is available
 	// use parser for downstream.
-	parser2, err := dbconn.GetParserForConn(tctx, s.ddlDBConn)
+	dbConn, fetchTable := s.schemaSource(sourceTable, targetTable)
+	parser2, err := dbconn.GetParserForConn(tctx, dbConn)
 	if err != nil {
 		return nil, terror.ErrSchemaTrackerCannotParseDownstreamTable.Delegate(err, targetTable, sourceTable)
 	}
 
-	createSQL, err := dbconn.GetSchemaCreateSQL(tctx, s.ddlDBConn, targetTable.Schema)
+	createSQL, err := dbconn.GetSchemaCreateSQL(tctx, dbConn, fetchTable.Schema)
 	if err != nil {
 		return nil, terror.ErrSchemaTrackerCannotFetchDownstreamTable.Delegate(err, targetTable, sourceTable)
 	}
@@ -863,12 +916,13 @@ func (s *Syncer) getDBInfoFromDownstream(tctx *tcontext.Context, sourceTable, ta
 func (s *Syncer) trackTableInfoFromDownstream(tctx *tcontext.Context, sourceTable, targetTable *filter.Table) error {
 	// TODO: Switch to use the HTTP interface to retrieve the TableInfo directly if HTTP port is available
 	// use parser for downstream.
-	parser2, err := dbconn.GetParserForConn(tctx, s.ddlDBConn)
+	dbConn, fetchTable := s.schemaSource(sourceTable, targetTable)
+	parser2, err := dbconn.GetParserForConn(tctx, dbConn)
 	if err != nil {
 		return terror.ErrSchemaTrackerCannotParseDownstreamTable.Delegate(err, targetTable, sourceTable)
 	}
 
-	createSQL, err := dbconn.GetTableCreateSQL(tctx, s.ddlDBConn, targetTable.String())
+	createSQL, err := dbconn.GetTableCreateSQL(tctx, dbConn, fetchTable.String())
 	if err != nil {
 		return terror.ErrSchemaTrackerCannotFetchDownstreamTable.Delegate(err, targetTable, sourceTable)
 	}
@@ -908,6 +962,16 @@ func (s *Syncer) trackTableInfoFromDownstream(tctx *tcontext.Context, sourceTabl
 	return nil
 }
 
+// schemaSource returns the connection and the table to fetch the structure of
+// targetTable from. When the changes are sent to Kafka there is no downstream
+// table, so the structure of sourceTable is fetched from the upstream instead.
+func (s *Syncer) schemaSource(sourceTable, targetTable *filter.Table) (*dbconn.DBConn, *filter.Table) {
+	if s.kafkaSink != nil {
+		return s.fromConn, sourceTable
+	}
+	return s.ddlDBConn, targetTable
+}
+
 var dmlMetric = map[sqlmodel.RowChangeType]string{
 	sqlmodel.RowChangeInsert: "insert",
 	sqlmodel.RowChangeUpdate: "update",
@@ -1150,6 +1214,7 @@ func (s *Syncer) handleJob(job *job) (added2Queue bool, err error) {
 		s.waitXIDJob.CAS(int64(waiting), int64(waitComplete))
 		s.saveGlobalPoint(job.location)
 		s.isTransactionEnd = true
+		s.kafkaTxnCommitTs = 0
 		// nolint:nakedret
 		return
 	case skip:
@@ -1168,6 +1233,9 @@ func (s *Syncer) handleJob(job *job) (added2Queue bool, err error) {
 
 	// 2. send the job to queue
 
+	if s.kafkaSink != nil {
+		s.allocKafkaCommitTs(job)
+	}
 	s.addJob(job)
 	added2Queue = true
 
@@ -1222,12 +1290,14 @@ func (s *Syncer) handleJob(job *job) (added2Queue bool, err error) {
 			}
 		})
 		skipCheckFlush = true
+		s.writeKafkaWatermark()
 		err = s.flushCheckPoints()
 		// nolint:nakedret
 		return
 	case flush:
 		s.jobWg.Wait()
 		skipCheckFlush = true
+		s.writeKafkaWatermark()
 		err = s.flushCheckPoints()
 		// nolint:nakedret
 		return
@@ -1238,6 +1308,82 @@ func (s *Syncer) handleJob(job *job) (added2Queue bool, err error) {
 	return
 }
 
+// allocKafkaCommitTs sets the commit ts of the messages of the job, the DMLs
+// of a transaction share the same commit ts.
+func (s *Syncer) allocKafkaCommitTs(job *job) {
+	switch job.tp {
+	case dml:
+		if s.kafkaTxnCommitTs == 0 {
+			var timestamp uint32
+			if job.eventHeader != nil {
+				timestamp = job.eventHeader.Timestamp
+			}
+			s.kafkaTxnCommitTs = s.kafkaCommitTs.Next(timestamp)
+		}
+		job.commitTs = s.kafkaTxnCommitTs
+	case ddl:
+		s.kafkaTxnCommitTs = 0
+		job.commitTs = s.kafkaCommitTs.Next(job.timestamp)
+	}
+}
+
+func (s *Syncer) kafkaCommitTsTableName() string {
+	return dbutil.TableName(s.cfg.MetaSchema, cputil.SyncerKafkaCommitTs(s.cfg.Name))
+}
+
+// loadKafkaCommitTs restores the last commit ts allocated before the task is
+// restarted, the meta schema must have been created by the checkpoint.
+func (s *Syncer) loadKafkaCommitTs(tctx *tcontext.Context) error {
+	createSQL := `CREATE TABLE IF NOT EXISTS ` + s.kafkaCommitTsTableName() + ` (
+		source_id VARCHAR(32) NOT NULL PRIMARY KEY,
+		commit_ts BIGINT UNSIGNED NOT NULL,
+		update_time timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+	)`
+	if _, err := s.ddlDBConn.ExecuteSQL(tctx, s.metricsProxies, []string{createSQL}); err != nil {
+		return terror.WithScope(err, terror.ScopeDownstream)
+	}
+
+	query := `SELECT commit_ts FROM ` + s.kafkaCommitTsTableName() + ` WHERE source_id = ?`
+	rows, err := s.ddlDBConn.QuerySQL(tctx, s.metricsProxies, query, s.cfg.SourceID)
+	if err != nil {
+		return terror.WithScope(err, terror.ScopeDownstream)
+	}
+	defer rows.Close()
+	var commitTs uint64
+	for rows.Next() {
+		if err = rows.Scan(&commitTs); err != nil {
+			return terror.DBErrorAdapt(err, s.ddlDBConn.Scope(), terror.ErrDBDriverError)
+		}
+		s.kafkaCommitTs.Restore(commitTs)
+	}
+	if err = rows.Err(); err != nil {
+		return terror.DBErrorAdapt(err, s.ddlDBConn.Scope(), terror.ErrDBDriverError)
+	}
+	s.tctx.L().Info("load kafka commit ts", zap.Uint64("commit ts", commitTs))
+	return nil
+}
+
+// writeKafkaWatermark sends the commit ts of the last finished transaction to
+// Kafka as the watermark, so the consumers can flush the changes before it.
+// It must be called after all the jobs are done.
+func (s *Syncer) writeKafkaWatermark() {
+	if s.kafkaSink == nil || s.execError.Load() != nil {
+		return
+	}
+	ts := s.kafkaCommitTs.Last()
+	if s.kafkaTxnCommitTs != 0 {
+		// the ongoing transaction may have more changes to send.
+		ts = s.kafkaTxnCommitTs - 1
+	}
+	if ts == 0 {
+		return
+	}
+	if err := s.kafkaSink.WriteWatermark(s.syncCtx.Ctx, ts); err != nil {
+		// the watermark will be sent again in the next flush.
+		s.tctx.L().Warn("failed to write watermark to kafka", zap.Uint64("watermark", ts), zap.Error(err))
+	}
+}
+
 func (s *Syncer) saveGlobalPoint(globalLocation binlog.Location) {
 	if s.cfg.ShardMode == config.ShardPessimistic {
 		globalLocation = s.sgk.AdjustGlobalLocation(globalLocation)
@@ -1358,6 +1504,12 @@ func (s *Syncer) createCheckpointSnapshot(isSyncFlush bool) (*SnapshotInfo, []*f
 		shardMetaSQLs, shardMetaArgs = s.sgk.PrepareFlushSQLs(exceptTableIDs)
 		s.tctx.L().Info("prepare flush sqls", zap.Strings("shard meta sqls", shardMetaSQLs), zap.Reflect("shard meta arguments", shardMetaArgs))
 	}
+	if s.kafkaSink != nil {
+		// the commit ts is flushed with the checkpoint, so the commit ts of the
+		// replayed transactions are larger than the sent watermarks after restart.
+		shardMetaSQLs = append(shardMetaSQLs, "REPLACE INTO "+s.kafkaCommitTsTableName()+" (source_id, commit_ts) VALUES (?, ?)")
+		shardMetaArgs = append(shardMetaArgs, []interface{}{s.cfg.SourceID, s.kafkaCommitTs.Last()})
+	}
 
 	return snapshotInfo, exceptTables, shardMetaSQLs, shardMetaArgs
 }
@@ -1458,7 +1610,9 @@ func (s *Syncer) syncDDL(queueBucket string, db *dbconn.DBConn, ddlJobChan chan
 			failpoint.Goto("bypass")
 		})
 
-		if !ignore {
+		if !ignore && s.kafkaSink != nil {
+			err = s.writeDDLsToKafka(ddlJob)
+		} else if !ignore {
 			failpoint.Inject("SkipSaveGlobalPoint", func() {
 				s.tctx.L().Info("skip save global point", zap.String("failpoint", "SkipSaveGlobalPoint"))
 				panic("SkipSaveGlobalPoint")
@@ -1528,6 +1682,7 @@ func (s *Syncer) syncDDL(queueBucket string, db *dbconn.DBConn, ddlJobChan chan
 		if err != nil {
 			s.execError.Store(err)
 			if !utils.IsContextCanceledError(err) {
+				err = s.applyBinlogErrorRule(s.syncCtx.Ctx, err, ddlJob.startLocation, sourceTablesOfJob(ddlJob), ddlJob.originSQL)
 				err = s.handleEventError(err, ddlJob.startLocation, ddlJob.currentLocation, true, ddlJob.originSQL)
 				s.runFatalChan <- unit.NewProcessError(err)
 			}
@@ -1579,6 +1734,30 @@ func (s *Syncer) syncDDL(queueBucket string, db *dbconn.DBConn, ddlJobChan chan
 	}
 }
 
+// writeDDLsToKafka sends the DDLs of ddlJob to Kafka, which replaces executing
+// them on the downstream database.
+func (s *Syncer) writeDDLsToKafka(ddlJob *job) error {
+	var tableInfo *model.TableInfo
+	tables := make([]*filter.Table, 0, 1)
+	for _, tbls := range ddlJob.sourceTbls {
+		tables = append(tables, tbls...)
+	}
+	if len(tables) == 1 {
+		// the schema tracker has tracked the DDLs when they are sent.
+		ti, err := s.getTrackedTableInfo(tables[0])
+		if err == nil {
+			tableInfo = ti
+		}
+	}
+
+	for _, ddl := range ddlJob.ddls {
+		if err := s.kafkaSink.WriteDDL(s.syncCtx.Ctx, ddl, ddlJob.commitTs, tableInfo); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *Syncer) successFunc(queueID int, statementsCnt int, jobs []*job) {
 	queueBucket := queueBucketName(queueID)
 	if len(jobs) > 0 {
@@ -1822,6 +2001,13 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 	go s.updateLagCronJob(s.runCtx.Ctx)
 	s.runWg.Add(1)
 	go s.updateTSOffsetCronJob(s.runCtx.Ctx)
+	if s.dmlThrottle != nil {
+		s.runWg.Add(1)
+		go func() {
+			defer s.runWg.Done()
+			s.dmlThrottle.run(s.runCtx.Ctx)
+		}()
+	}
 
 	// some prepare work before the binlog event loop:
 	// 1. first we flush checkpoint as needed, so in next resume we won't go to Load unit.
@@ -2247,6 +2433,9 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 		failpoint.Inject("ProcessBinlogSlowDown", nil)
 
 		s.tctx.L().Debug("receive binlog event", zap.Reflect("header", e.Header))
+		if s.cfg.ShardMode == config.ShardPessimistic {
+			s.sgk.RecordEvent(int64(e.Header.Timestamp))
+		}
 
 		startLocation := s.streamerController.GetCurStartLocation()
 		endLocation = s.streamerController.GetCurEndLocation()
@@ -2455,6 +2644,9 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 			s.tctx.L().Warn("unhandled event", zap.String("type", fmt.Sprintf("%T", ev)))
 		}
 		if err2 != nil {
+			if ev, ok := e.Event.(*replication.QueryEvent); ok {
+				err2 = s.applyBinlogErrorRule(s.runCtx.Ctx, err2, startLocation, s.tablesOfQueryEvent(ev, originSQL), originSQL)
+			}
 			if err := s.handleEventError(err2, startLocation, endLocation, e.Header.EventType == replication.QUERY_EVENT, originSQL); err != nil {
 				return err
 			}
@@ -2619,6 +2811,10 @@ func (s *Syncer) handleRowsEvent(ev *replication.RowsEvent, ec eventContext) (*f
 		return nil, s.recordSkipSQLsLocation(&ec)
 	}
 
+	if s.cfg.ShardMode == config.ShardPessimistic {
+		s.sgk.RecordDML(targetTable, int64(ec.header.Timestamp))
+	}
+
 	if (s.cfg.ShardMode == config.ShardPessimistic && s.sgk.InSyncing(sourceTable, targetTable, ec.endLocation)) ||
 		(s.cfg.ShardMode == config.ShardOptimistic && s.osgk.inConflictStage(sourceTable, targetTable)) {
 		// if in unsync stage and not before active DDL, filter it
@@ -3186,6 +3382,17 @@ func (s *Syncer) createDBs(ctx context.Context) error {
 	printServerVersion(s.tctx, s.fromDB.BaseDB, "upstream")
 	printServerVersion(s.tctx, s.toDB, "downstream")
 
+	if s.cfg.TargetKafka != nil {
+		// the sink lives until the syncer is closed, so it doesn't use ctx.
+		s.kafkaSink, err = kafkasink.NewSink(context.Background(), s.cfg, s.tctx.L())
+		if err != nil {
+			dbconn.CloseUpstreamConn(s.tctx, s.fromDB)
+			dbconn.CloseBaseDB(s.tctx, s.toDB)
+			dbconn.CloseBaseDB(s.tctx, s.ddlDB)
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -3194,6 +3401,9 @@ func (s *Syncer) closeDBs() {
 	dbconn.CloseUpstreamConn(s.tctx, s.fromDB)
 	dbconn.CloseBaseDB(s.tctx, s.toDB)
 	dbconn.CloseBaseDB(s.tctx, s.ddlDB)
+	if s.kafkaSink != nil {
+		s.kafkaSink.Close()
+	}
 }
 
 // record skip ddl/dml sqls' position
@@ -3641,7 +3851,7 @@ func (s *Syncer) setGlobalPointByTime(tctx *tcontext.Context, timeStr string) er
 	if s.relay != nil {
 		subDir := s.relay.Status(nil).(*pb.RelayStatus).RelaySubDir
 		relayDir := path.Join(s.cfg.RelayDir, subDir)
-		finder := binlog.NewLocalBinlogPosFinder(tctx, s.cfg.EnableGTID, s.cfg.Flavor, relayDir)
+		finder := binlog.NewLocalBinlogPosFinder(tctx, s.cfg.EnableGTID, s.cfg.Flavor, relayDir, s.relay.RelayLogFileOpener())
 		loc, posTp, err = finder.FindByTimestamp(t.Unix())
 	} else {
 		finder := binlog.NewRemoteBinlogPosFinder(tctx, s.fromDB.BaseDB, s.syncCfg, s.cfg.EnableGTID)
@@ -3702,6 +3912,14 @@ func (s *Syncer) getTrackedTableInfo(table *filter.Table) (*model.TableInfo, err
 }
 
 func (s *Syncer) getDownStreamTableInfo(tctx *tcontext.Context, tableID string, originTI *model.TableInfo) (*schema.DownstreamTableInfo, error) {
+	if s.kafkaSink != nil {
+		// there is no downstream table when the changes are sent to Kafka,
+		// the source table is used to decide the handle of the row changes.
+		return &schema.DownstreamTableInfo{
+			TableInfo:   originTI,
+			WhereHandle: sqlmodel.GetWhereHandle(originTI, originTI),
+		}, nil
+	}
 	return s.schemaTracker.GetDownStreamTableInfo(tctx, tableID, originTI)
 }
 
@@ -1967,3 +1967,27 @@ func TestCheckCanUpdateCfg(t *testing.T) {
 	cfg2.SyncerConfig.Compact = !cfg.SyncerConfig.Compact
 	require.NoError(t, syncer.CheckCanUpdateCfg(cfg))
 }
+
+func TestAllocKafkaCommitTs(t *testing.T) {
+	syncer := &Syncer{}
+	header := &replication.EventHeader{Timestamp: 1700000000}
+
+	// the DMLs of a transaction share the same commit ts.
+	dml1 := &job{tp: dml, eventHeader: header}
+	dml2 := &job{tp: dml, eventHeader: header}
+	syncer.allocKafkaCommitTs(dml1)
+	syncer.allocKafkaCommitTs(dml2)
+	require.NotZero(t, dml1.commitTs)
+	require.Equal(t, dml1.commitTs, dml2.commitTs)
+
+	// the next transaction in the same second gets a larger commit ts.
+	syncer.kafkaTxnCommitTs = 0
+	dml3 := &job{tp: dml, eventHeader: header}
+	syncer.allocKafkaCommitTs(dml3)
+	require.Greater(t, dml3.commitTs, dml2.commitTs)
+
+	ddlJob := &job{tp: ddl, timestamp: header.Timestamp}
+	syncer.allocKafkaCommitTs(ddlJob)
+	require.Greater(t, ddlJob.commitTs, dml3.commitTs)
+	require.Zero(t, syncer.kafkaTxnCommitTs)
+}
@@ -54,6 +54,10 @@ func (d *DummyRelay) NewReader(logger log.Logger, cfg *relay.BinlogReaderConfig)
 	return nil
 }
 
+func (d *DummyRelay) RelayLogFileOpener() binlog.RelayLogFileOpener {
+	return nil
+}
+
 func (d *DummyRelay) RegisterListener(el relay.Listener) {
 }
 
@@ -43,10 +43,19 @@ type ChangefeedInterface interface {
 	Delete(ctx context.Context, namespace string, name string) error
 	// Pause pauses a changefeed with given name
 	Pause(ctx context.Context, namespace string, name string) error
+	// Clone creates a new changefeed from the checkpoint of the given changefeed
+	Clone(ctx context.Context, cfg *v2.CloneChangefeedConfig,
+		namespace string, name string) (*v2.ChangeFeedInfo, error)
 	// Get gets a changefeed detaail info
 	Get(ctx context.Context, namespace string, name string) (*v2.ChangeFeedInfo, error)
 	// List lists all changefeeds
 	List(ctx context.Context, namespace string, state string) ([]v2.ChangefeedCommonInfo, error)
+	// ListTables lists the replication statuses of all table spans of a changefeed
+	ListTables(ctx context.Context, namespace string, name string) ([]v2.TableSpanStatus, error)
+	// MoveTable moves all spans of a table to the target capture
+	MoveTable(ctx context.Context, cfg *v2.MoveTableConfig, namespace string, name string) error
+	// RebalanceTables rebalances all tables of a changefeed among captures
+	RebalanceTables(ctx context.Context, namespace string, name string) error
 }
 
 // changefeeds implements ChangefeedInterface
@@ -128,6 +137,20 @@ func (c *changefeeds) Pause(ctx context.Context,
 		Do(ctx).Error()
 }
 
+// Clone creates a new changefeed from the checkpoint of the given changefeed
+func (c *changefeeds) Clone(ctx context.Context,
+	cfg *v2.CloneChangefeedConfig, namespace string, name string,
+) (*v2.ChangeFeedInfo, error) {
+	result := &v2.ChangeFeedInfo{}
+	u := fmt.Sprintf("changefeeds/%s/clone?namespace=%s", name, namespace)
+	err := c.client.Post().
+		WithURI(u).
+		WithBody(cfg).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
 // Get gets a changefeed detaail info
 func (c *changefeeds) Get(ctx context.Context,
 	namespace string, name string,
@@ -157,3 +180,37 @@ func (c *changefeeds) List(ctx context.Context,
 		Into(result)
 	return result.Items, err
 }
+
+// ListTables lists the replication statuses of all table spans of a changefeed
+func (c *changefeeds) ListTables(ctx context.Context,
+	namespace string, name string,
+) ([]v2.TableSpanStatus, error) {
+	result := &v2.ListResponse[v2.TableSpanStatus]{}
+	u := fmt.Sprintf("changefeeds/%s/tables?namespace=%s", name, namespace)
+	err := c.client.Get().
+		WithURI(u).
+		Do(ctx).
+		Into(result)
+	return result.Items, err
+}
+
+// MoveTable moves all spans of a table to the target capture
+func (c *changefeeds) MoveTable(ctx context.Context,
+	cfg *v2.MoveTableConfig, namespace string, name string,
+) error {
+	u := fmt.Sprintf("changefeeds/%s/tables/move?namespace=%s", name, namespace)
+	return c.client.Post().
+		WithURI(u).
+		WithBody(cfg).
+		Do(ctx).Error()
+}
+
+// RebalanceTables rebalances all tables of a changefeed among captures
+func (c *changefeeds) RebalanceTables(ctx context.Context,
+	namespace string, name string,
+) error {
+	u := fmt.Sprintf("changefeeds/%s/tables/rebalance?namespace=%s", name, namespace)
+	return c.client.Post().
+		WithURI(u).
+		Do(ctx).Error()
+}
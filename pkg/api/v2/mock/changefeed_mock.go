@@ -73,6 +73,21 @@ func (m *MockChangefeedInterface) EXPECT() *MockChangefeedInterfaceMockRecorder
 	return m.recorder
 }
 
+// Clone mocks base method.
+func (m *MockChangefeedInterface) Clone(ctx context.Context, cfg *v2.CloneChangefeedConfig, namespace, name string) (*v2.ChangeFeedInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Clone", ctx, cfg, namespace, name)
+	ret0, _ := ret[0].(*v2.ChangeFeedInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Clone indicates an expected call of Clone.
+func (mr *MockChangefeedInterfaceMockRecorder) Clone(ctx, cfg, namespace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clone", reflect.TypeOf((*MockChangefeedInterface)(nil).Clone), ctx, cfg, namespace, name)
+}
+
 // Create mocks base method.
 func (m *MockChangefeedInterface) Create(ctx context.Context, cfg *v2.ChangefeedConfig) (*v2.ChangeFeedInfo, error) {
 	m.ctrl.T.Helper()
@@ -132,6 +147,35 @@ func (mr *MockChangefeedInterfaceMockRecorder) List(ctx, namespace, state interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockChangefeedInterface)(nil).List), ctx, namespace, state)
 }
 
+// ListTables mocks base method.
+func (m *MockChangefeedInterface) ListTables(ctx context.Context, namespace, name string) ([]v2.TableSpanStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTables", ctx, namespace, name)
+	ret0, _ := ret[0].([]v2.TableSpanStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTables indicates an expected call of ListTables.
+func (mr *MockChangefeedInterfaceMockRecorder) ListTables(ctx, namespace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTables", reflect.TypeOf((*MockChangefeedInterface)(nil).ListTables), ctx, namespace, name)
+}
+
+// MoveTable mocks base method.
+func (m *MockChangefeedInterface) MoveTable(ctx context.Context, cfg *v2.MoveTableConfig, namespace, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveTable", ctx, cfg, namespace, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveTable indicates an expected call of MoveTable.
+func (mr *MockChangefeedInterfaceMockRecorder) MoveTable(ctx, cfg, namespace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTable", reflect.TypeOf((*MockChangefeedInterface)(nil).MoveTable), ctx, cfg, namespace, name)
+}
+
 // Pause mocks base method.
 func (m *MockChangefeedInterface) Pause(ctx context.Context, namespace, name string) error {
 	m.ctrl.T.Helper()
@@ -146,6 +190,20 @@ func (mr *MockChangefeedInterfaceMockRecorder) Pause(ctx, namespace, name interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pause", reflect.TypeOf((*MockChangefeedInterface)(nil).Pause), ctx, namespace, name)
 }
 
+// RebalanceTables mocks base method.
+func (m *MockChangefeedInterface) RebalanceTables(ctx context.Context, namespace, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebalanceTables", ctx, namespace, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RebalanceTables indicates an expected call of RebalanceTables.
+func (mr *MockChangefeedInterfaceMockRecorder) RebalanceTables(ctx, namespace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebalanceTables", reflect.TypeOf((*MockChangefeedInterface)(nil).RebalanceTables), ctx, namespace, name)
+}
+
 // Resume mocks base method.
 func (m *MockChangefeedInterface) Resume(ctx context.Context, cfg *v2.ResumeChangefeedConfig, namespace, name string) error {
 	m.ctrl.T.Helper()
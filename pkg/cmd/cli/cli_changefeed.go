@@ -27,11 +27,15 @@ func newCmdChangefeed(f factory.Factory) *cobra.Command {
 	}
 
 	cmds.AddCommand(newCmdCreateChangefeed(f))
+	cmds.AddCommand(newCmdCloneChangefeed(f))
 	cmds.AddCommand(newCmdUpdateChangefeed(f))
 	cmds.AddCommand(newCmdStatisticsChangefeed(f))
 	cmds.AddCommand(newCmdListChangefeed(f))
+	cmds.AddCommand(newCmdListTables(f))
+	cmds.AddCommand(newCmdMoveTable(f))
 	cmds.AddCommand(newCmdPauseChangefeed(f))
 	cmds.AddCommand(newCmdQueryChangefeed(f))
+	cmds.AddCommand(newCmdRebalanceTables(f))
 	cmds.AddCommand(newCmdRemoveChangefeed(f))
 	cmds.AddCommand(newCmdResumeChangefeed(f))
 
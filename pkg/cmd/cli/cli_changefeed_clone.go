@@ -0,0 +1,134 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"encoding/json"
+
+	"github.com/BurntSushi/toml"
+	"github.com/pingcap/errors"
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	apiv2client "github.com/pingcap/tiflow/pkg/api/v2"
+	cmdcontext "github.com/pingcap/tiflow/pkg/cmd/context"
+	"github.com/pingcap/tiflow/pkg/cmd/factory"
+	"github.com/pingcap/tiflow/pkg/cmd/util"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/filter"
+	"github.com/spf13/cobra"
+)
+
+// cloneChangefeedOptions defines flags for the `cli changefeed clone` command.
+type cloneChangefeedOptions struct {
+	apiClient apiv2client.APIV2Interface
+
+	changefeedID    string
+	namespace       string
+	newChangefeedID string
+	sinkURI         string
+	configFile      string
+}
+
+// newCloneChangefeedOptions creates new options for the `cli changefeed clone` command.
+func newCloneChangefeedOptions() *cloneChangefeedOptions {
+	return &cloneChangefeedOptions{}
+}
+
+// addFlags receives a *cobra.Command reference and binds
+// flags related to template printing to it.
+func (o *cloneChangefeedOptions) addFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVarP(&o.namespace, "namespace", "n", "default", "Replication task (changefeed) Namespace")
+	cmd.PersistentFlags().StringVarP(&o.changefeedID, "changefeed-id", "c", "", "ID of the replication task (changefeed) to clone")
+	cmd.PersistentFlags().StringVar(&o.newChangefeedID, "new-changefeed-id", "", "ID of the new replication task (changefeed)")
+	cmd.PersistentFlags().StringVar(&o.sinkURI, "sink-uri", "", "Sink uri of the new changefeed, default to the one of the cloned changefeed")
+	cmd.PersistentFlags().StringVar(&o.configFile, "config", "",
+		"Path of the configuration file, only the filter section in it overrides the cloned one")
+	_ = cmd.MarkPersistentFlagRequired("changefeed-id")
+}
+
+// complete adapts from the command line args to the data and client required.
+func (o *cloneChangefeedOptions) complete(f factory.Factory) error {
+	apiClient, err := f.APIV2Client()
+	if err != nil {
+		return err
+	}
+	o.apiClient = apiClient
+	return nil
+}
+
+func (o *cloneChangefeedOptions) getCloneChangefeedConfig() (*v2.CloneChangefeedConfig, error) {
+	res := &v2.CloneChangefeedConfig{
+		ID:      o.newChangefeedID,
+		SinkURI: o.sinkURI,
+	}
+	if len(o.configFile) > 0 {
+		cfg := config.GetDefaultReplicaConfig()
+		if err := util.StrictDecodeFile(o.configFile, "TiCDC changefeed", cfg); err != nil {
+			return nil, err
+		}
+		// the filter is decoded into the default config, so it overrides the
+		// cloned one only if the config file has the filter section.
+		metaData, err := toml.DecodeFile(o.configFile, &map[string]interface{}{})
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if !metaData.IsDefined("filter") {
+			return res, nil
+		}
+		if _, err := filter.VerifyTableRules(cfg.Filter); err != nil {
+			return nil, err
+		}
+		res.Filter = v2.ToAPIReplicaConfig(cfg).Filter
+	}
+	return res, nil
+}
+
+// run the `cli changefeed clone` command.
+func (o *cloneChangefeedOptions) run(cmd *cobra.Command) error {
+	ctx := cmdcontext.GetDefaultContext()
+
+	cfg, err := o.getCloneChangefeedConfig()
+	if err != nil {
+		return err
+	}
+	info, err := o.apiClient.Changefeeds().Clone(ctx, cfg, o.namespace, o.changefeedID)
+	if err != nil {
+		return err
+	}
+	infoStr, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+	cmd.Printf("Clone changefeed successfully!\nID: %s\nStartTs: %d\nInfo: %s\n",
+		info.ID, info.StartTs, infoStr)
+	return nil
+}
+
+// newCmdCloneChangefeed creates the `cli changefeed clone` command.
+func newCmdCloneChangefeed(f factory.Factory) *cobra.Command {
+	o := newCloneChangefeedOptions()
+
+	command := &cobra.Command{
+		Use:   "clone",
+		Short: "Create a new replication task (changefeed) from the checkpoint of an existing one",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.complete(f))
+			util.CheckErr(o.run(cmd))
+		},
+	}
+
+	o.addFlags(command)
+
+	return command
+}
@@ -0,0 +1,84 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pingcap/errors"
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	"github.com/pingcap/tiflow/pkg/api/v2/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangefeedCloneCli(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cf := mock.NewMockChangefeedInterface(ctrl)
+	f := &mockFactory{changefeeds: cf}
+	cmd := newCmdCloneChangefeed(f)
+
+	cf.EXPECT().Clone(gomock.Any(), &v2.CloneChangefeedConfig{
+		ID:      "def",
+		SinkURI: "kafka://127.0.0.1:9092/staging",
+	}, "default", "abc").Return(&v2.ChangeFeedInfo{ID: "def", StartTs: 100}, nil)
+	os.Args = []string{
+		"clone", "--changefeed-id=abc", "--new-changefeed-id=def",
+		"--sink-uri=kafka://127.0.0.1:9092/staging",
+	}
+	require.Nil(t, cmd.Execute())
+
+	// the filter config in the config file overrides the cloned one.
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.toml")
+	content := `
+[filter]
+rules = ['test.*']
+`
+	require.Nil(t, os.WriteFile(path, []byte(content), 0o644))
+	o := newCloneChangefeedOptions()
+	o.configFile = path
+	cfg, err := o.getCloneChangefeedConfig()
+	require.Nil(t, err)
+	require.Equal(t, []string{"test.*"}, cfg.Filter.Rules)
+
+	// a config file without the filter section keeps the cloned filter.
+	content = `
+[mounter]
+worker-num = 16
+`
+	require.Nil(t, os.WriteFile(path, []byte(content), 0o644))
+	cfg, err = o.getCloneChangefeedConfig()
+	require.Nil(t, err)
+	require.Nil(t, cfg.Filter)
+
+	cmd = newCmdCloneChangefeed(f)
+	cf.EXPECT().Clone(gomock.Any(), &v2.CloneChangefeedConfig{
+		ID: "def",
+	}, "default", "abc").Return(&v2.ChangeFeedInfo{ID: "def", StartTs: 100}, nil)
+	os.Args = []string{
+		"clone", "--changefeed-id=abc", "--new-changefeed-id=def", "--config=" + path,
+	}
+	require.Nil(t, cmd.Execute())
+
+	cf.EXPECT().Clone(gomock.Any(), gomock.Any(), "test", "abc").Return(nil, errors.New("test"))
+	o = newCloneChangefeedOptions()
+	o.changefeedID = "abc"
+	o.namespace = "test"
+	require.Nil(t, o.complete(f))
+	require.NotNil(t, o.run(cmd))
+}
@@ -0,0 +1,60 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pingcap/errors"
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	"github.com/pingcap/tiflow/pkg/api/v2/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangefeedListTablesCli(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cf := mock.NewMockChangefeedInterface(ctrl)
+	f := &mockFactory{changefeeds: cf}
+	cmd := newCmdListTables(f)
+	b := bytes.NewBufferString("")
+	cmd.SetOut(b)
+
+	tables := []v2.TableSpanStatus{{
+		TableID:      1,
+		StartKey:     "01",
+		EndKey:       "02",
+		CaptureID:    "capture-1",
+		State:        "Replicating",
+		CheckpointTs: 10,
+		ResolvedTs:   11,
+	}}
+	cf.EXPECT().ListTables(gomock.Any(), "default", "abc").Return(tables, nil)
+	os.Args = []string{"list-tables", "--changefeed-id=abc"}
+	require.Nil(t, cmd.Execute())
+	var out []v2.TableSpanStatus
+	require.NoError(t, json.Unmarshal(b.Bytes(), &out))
+	require.Equal(t, tables, out)
+
+	cf.EXPECT().ListTables(gomock.Any(), "test", "abc").Return(nil, errors.New("test"))
+	o := newListTablesOptions()
+	o.changefeedID = "abc"
+	o.namespace = "test"
+	require.Nil(t, o.complete(f))
+	require.NotNil(t, o.run(cmd))
+}
@@ -0,0 +1,94 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	apiv2client "github.com/pingcap/tiflow/pkg/api/v2"
+	"github.com/pingcap/tiflow/pkg/cmd/context"
+	"github.com/pingcap/tiflow/pkg/cmd/factory"
+	"github.com/pingcap/tiflow/pkg/cmd/util"
+	"github.com/spf13/cobra"
+)
+
+// moveTableOptions defines flags for the `cli changefeed move-table` command.
+type moveTableOptions struct {
+	apiClient apiv2client.APIV2Interface
+
+	changefeedID string
+	namespace    string
+	tableID      int64
+	captureID    string
+}
+
+// newMoveTableOptions creates new options for the `cli changefeed move-table` command.
+func newMoveTableOptions() *moveTableOptions {
+	return &moveTableOptions{}
+}
+
+// addFlags receives a *cobra.Command reference and binds
+// flags related to template printing to it.
+func (o *moveTableOptions) addFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVarP(&o.namespace, "namespace", "n", "default", "Replication task (changefeed) Namespace")
+	cmd.PersistentFlags().StringVarP(&o.changefeedID, "changefeed-id", "c", "", "Replication task (changefeed) ID")
+	cmd.PersistentFlags().Int64Var(&o.tableID, "table-id", 0, "ID of the table to move")
+	cmd.PersistentFlags().StringVar(&o.captureID, "capture-id", "", "ID of the target capture")
+	_ = cmd.MarkPersistentFlagRequired("changefeed-id")
+	_ = cmd.MarkPersistentFlagRequired("table-id")
+	_ = cmd.MarkPersistentFlagRequired("capture-id")
+}
+
+// complete adapts from the command line args to the data and client required.
+func (o *moveTableOptions) complete(f factory.Factory) error {
+	apiClient, err := f.APIV2Client()
+	if err != nil {
+		return err
+	}
+	o.apiClient = apiClient
+	return nil
+}
+
+// run the `cli changefeed move-table` command.
+func (o *moveTableOptions) run(cmd *cobra.Command) error {
+	ctx := context.GetDefaultContext()
+
+	err := o.apiClient.Changefeeds().MoveTable(ctx, &v2.MoveTableConfig{
+		TableID:   o.tableID,
+		CaptureID: o.captureID,
+	}, o.namespace, o.changefeedID)
+	if err != nil {
+		return err
+	}
+	cmd.Printf("Move table %d to capture %s successfully!\n", o.tableID, o.captureID)
+	return nil
+}
+
+// newCmdMoveTable creates the `cli changefeed move-table` command.
+func newCmdMoveTable(f factory.Factory) *cobra.Command {
+	o := newMoveTableOptions()
+
+	command := &cobra.Command{
+		Use:   "move-table",
+		Short: "Move a table of a replication task (changefeed) to the target capture",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.complete(f))
+			util.CheckErr(o.run(cmd))
+		},
+	}
+
+	o.addFlags(command)
+
+	return command
+}
@@ -0,0 +1,50 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pingcap/errors"
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	"github.com/pingcap/tiflow/pkg/api/v2/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangefeedMoveTableCli(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cf := mock.NewMockChangefeedInterface(ctrl)
+	f := &mockFactory{changefeeds: cf}
+	cmd := newCmdMoveTable(f)
+	b := bytes.NewBufferString("")
+	cmd.SetOut(b)
+
+	cf.EXPECT().MoveTable(gomock.Any(), &v2.MoveTableConfig{
+		TableID: 1, CaptureID: "capture-1",
+	}, "default", "abc").Return(nil)
+	os.Args = []string{"move-table", "--changefeed-id=abc", "--table-id=1", "--capture-id=capture-1"}
+	require.Nil(t, cmd.Execute())
+	require.Contains(t, b.String(), "Move table 1 to capture capture-1 successfully!")
+
+	cf.EXPECT().MoveTable(gomock.Any(), gomock.Any(), "test", "abc").Return(errors.New("test"))
+	o := newMoveTableOptions()
+	o.changefeedID = "abc"
+	o.namespace = "test"
+	require.Nil(t, o.complete(f))
+	require.NotNil(t, o.run(cmd))
+}
@@ -0,0 +1,78 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	apiv2client "github.com/pingcap/tiflow/pkg/api/v2"
+	"github.com/pingcap/tiflow/pkg/cmd/context"
+	"github.com/pingcap/tiflow/pkg/cmd/factory"
+	"github.com/pingcap/tiflow/pkg/cmd/util"
+	"github.com/spf13/cobra"
+)
+
+// rebalanceTablesOptions defines flags for the `cli changefeed rebalance-tables` command.
+type rebalanceTablesOptions struct {
+	apiClient apiv2client.APIV2Interface
+
+	changefeedID string
+	namespace    string
+}
+
+// newRebalanceTablesOptions creates new options for the `cli changefeed rebalance-tables` command.
+func newRebalanceTablesOptions() *rebalanceTablesOptions {
+	return &rebalanceTablesOptions{}
+}
+
+// addFlags receives a *cobra.Command reference and binds
+// flags related to template printing to it.
+func (o *rebalanceTablesOptions) addFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVarP(&o.namespace, "namespace", "n", "default", "Replication task (changefeed) Namespace")
+	cmd.PersistentFlags().StringVarP(&o.changefeedID, "changefeed-id", "c", "", "Replication task (changefeed) ID")
+	_ = cmd.MarkPersistentFlagRequired("changefeed-id")
+}
+
+// complete adapts from the command line args to the data and client required.
+func (o *rebalanceTablesOptions) complete(f factory.Factory) error {
+	apiClient, err := f.APIV2Client()
+	if err != nil {
+		return err
+	}
+	o.apiClient = apiClient
+	return nil
+}
+
+// run the `cli changefeed rebalance-tables` command.
+func (o *rebalanceTablesOptions) run() error {
+	ctx := context.GetDefaultContext()
+	return o.apiClient.Changefeeds().RebalanceTables(ctx, o.namespace, o.changefeedID)
+}
+
+// newCmdRebalanceTables creates the `cli changefeed rebalance-tables` command.
+func newCmdRebalanceTables(f factory.Factory) *cobra.Command {
+	o := newRebalanceTablesOptions()
+
+	command := &cobra.Command{
+		Use:   "rebalance-tables",
+		Short: "Rebalance tables of a replication task (changefeed) among captures",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.complete(f))
+			util.CheckErr(o.run())
+		},
+	}
+
+	o.addFlags(command)
+
+	return command
+}
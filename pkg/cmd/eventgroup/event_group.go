@@ -1,4 +1,4 @@
-// Copyright 2024 PingCAP, Inc.
+// Copyright 2025 PingCAP, Inc.
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -11,44 +11,48 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package main
+package eventgroup
 
 import (
 	"sort"
 
-	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
 	"github.com/pingcap/log"
 	"github.com/pingcap/tiflow/cdc/model"
 	"go.uber.org/zap"
 )
 
-// EventsGroup could store change event message.
-type eventsGroup struct {
+// Group stores the row changed events of a table received from a partition,
+// and the positions of the messages which the events come from. P is the position
+// type of the message queue, such as the offset of kafka, or the message ID of pulsar.
+type Group[P any] struct {
 	partition int32
 	tableID   int64
 
 	events        []*model.RowChangedEvent
+	positions     []P
 	highWatermark uint64
 }
 
-// NewEventsGroup will create new event group.
-func NewEventsGroup(partition int32, tableID int64) *eventsGroup {
-	return &eventsGroup{
+// New will create new event group.
+func New[P any](partition int32, tableID int64) *Group[P] {
+	return &Group[P]{
 		partition: partition,
 		tableID:   tableID,
 		events:    make([]*model.RowChangedEvent, 0, 1024),
+		positions: make([]P, 0, 1024),
 	}
 }
 
 // Append will append an event to event groups.
-func (g *eventsGroup) Append(row *model.RowChangedEvent, offset kafka.Offset) {
+func (g *Group[P]) Append(row *model.RowChangedEvent, position P) {
 	g.events = append(g.events, row)
+	g.positions = append(g.positions, position)
 	if row.CommitTs > g.highWatermark {
 		g.highWatermark = row.CommitTs
 	}
 	log.Debug("DML event received",
 		zap.Int32("partition", g.partition),
-		zap.Any("offset", offset),
+		zap.Any("position", position),
 		zap.Uint64("commitTs", row.CommitTs),
 		zap.Uint64("highWatermark", g.highWatermark),
 		zap.Int64("tableID", row.GetTableID()),
@@ -57,14 +61,17 @@ func (g *eventsGroup) Append(row *model.RowChangedEvent, offset kafka.Offset) {
 		zap.Any("columns", row.Columns), zap.Any("preColumns", row.PreColumns))
 }
 
-// Resolve will get events where CommitTs is less than resolveTs.
-func (g *eventsGroup) Resolve(resolve uint64) []*model.RowChangedEvent {
+// Resolve will get events where CommitTs is less than resolveTs,
+// and the positions of the messages which the events come from.
+func (g *Group[P]) Resolve(resolve uint64) ([]*model.RowChangedEvent, []P) {
 	i := sort.Search(len(g.events), func(i int) bool {
 		return g.events[i].CommitTs > resolve
 	})
 
 	result := g.events[:i]
+	positions := g.positions[:i]
 	g.events = g.events[i:]
+	g.positions = g.positions[i:]
 	if len(result) != 0 && len(g.events) != 0 {
 		log.Debug("not all events resolved",
 			zap.Int32("partition", g.partition), zap.Int64("tableID", g.tableID),
@@ -72,5 +79,16 @@ func (g *eventsGroup) Resolve(resolve uint64) []*model.RowChangedEvent {
 			zap.Uint64("resolveTs", resolve), zap.Uint64("firstCommitTs", g.events[0].CommitTs))
 	}
 
-	return result
+	return result, positions
+}
+
+// HighWatermark returns the max commitTs of the appended events.
+func (g *Group[P]) HighWatermark() uint64 {
+	return g.highWatermark
+}
+
+// Positions returns the positions of the messages which the events not
+// resolved yet come from.
+func (g *Group[P]) Positions() []P {
+	return g.positions
 }
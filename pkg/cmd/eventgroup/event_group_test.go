@@ -0,0 +1,50 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventgroup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroup(t *testing.T) {
+	t.Parallel()
+
+	group := New[int64](0, 1)
+	require.Empty(t, group.Positions())
+
+	group.Append(NewTestRow(10), 5)
+	group.Append(NewTestRow(20), 7)
+	// a message replayed from an older position.
+	group.Append(NewTestRow(20), 3)
+	group.Append(NewTestRow(30), 8)
+	require.Equal(t, uint64(30), group.HighWatermark())
+	require.Equal(t, []int64{5, 7, 3, 8}, group.Positions())
+
+	events, positions := group.Resolve(20)
+	require.Len(t, events, 3)
+	require.Equal(t, []int64{5, 7, 3}, positions)
+	require.Equal(t, []int64{8}, group.Positions())
+
+	events, positions = group.Resolve(25)
+	require.Empty(t, events)
+	require.Empty(t, positions)
+
+	events, positions = group.Resolve(30)
+	require.Len(t, events, 1)
+	require.Equal(t, []int64{8}, positions)
+	require.Empty(t, group.Positions())
+	require.Equal(t, uint64(30), group.HighWatermark())
+}
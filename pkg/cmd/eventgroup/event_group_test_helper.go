@@ -0,0 +1,28 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventgroup
+
+import "github.com/pingcap/tiflow/cdc/model"
+
+// NewTestRow returns a row changed event of the table `test`.`t` with ID 1,
+// it's only used in the tests of the consumers.
+func NewTestRow(commitTs uint64) *model.RowChangedEvent {
+	return &model.RowChangedEvent{
+		CommitTs:        commitTs,
+		PhysicalTableID: 1,
+		TableInfo: &model.TableInfo{
+			TableName: model.TableName{Schema: "test", Table: "t", TableID: 1},
+		},
+	}
+}
@@ -0,0 +1,350 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redo
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/pingcap/errors"
+	filter "github.com/pingcap/tidb/pkg/util/table-filter"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/redo/reader"
+	cmdcontext "github.com/pingcap/tiflow/pkg/cmd/context"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	dumpFormatJSON = "json"
+	dumpFormatCSV  = "csv"
+)
+
+// dumpOptions defines flags for the `redo dump` command.
+type dumpOptions struct {
+	options
+	startTs     uint64
+	endTs       uint64
+	format      string
+	filterRules []string
+	output      string
+
+	tableFilter filter.Filter
+}
+
+// newDumpOptions creates new dumpOptions for the `redo dump` command.
+func newDumpOptions() *dumpOptions {
+	return &dumpOptions{}
+}
+
+// addFlags receives a *cobra.Command reference and binds
+// flags related to template printing to it.
+func (o *dumpOptions) addFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint64Var(&o.startTs, "start-ts", 0, "dump events with commit ts greater than or equal to start-ts, the checkpoint-ts in meta is used if not specified")
+	cmd.Flags().Uint64Var(&o.endTs, "end-ts", 0, "dump events with commit ts less than or equal to end-ts, the resolved-ts in meta is used if not specified")
+	cmd.Flags().StringVar(&o.format, "format", dumpFormatJSON, "output format (json|csv)")
+	cmd.Flags().StringSliceVar(&o.filterRules, "filter", []string{"*.*"}, "table filter rules, eg, \"test.*\"")
+	cmd.Flags().StringVar(&o.output, "output", "", "file to write the events to, stdout is used if not specified")
+}
+
+//nolint:unparam
+func (o *dumpOptions) complete(cmd *cobra.Command) error {
+	if o.format != dumpFormatJSON && o.format != dumpFormatCSV {
+		return errors.Errorf("unsupported format %s, only json and csv are supported", o.format)
+	}
+	if o.endTs != 0 && o.startTs > o.endTs {
+		return errors.Errorf("start-ts %d is greater than end-ts %d", o.startTs, o.endTs)
+	}
+	tableFilter, err := filter.Parse(o.filterRules)
+	if err != nil {
+		return errors.Annotate(err, "invalid filter rules")
+	}
+	o.tableFilter = filter.CaseInsensitive(tableFilter)
+	return nil
+}
+
+// run runs the `redo dump` command.
+func (o *dumpOptions) run(cmd *cobra.Command) (err error) {
+	ctx := cmdcontext.GetDefaultContext()
+
+	uri, err := o.storageURI()
+	if err != nil {
+		return err
+	}
+	// the reader downloads and sorts logs in a local dir, and it removes the
+	// dir before downloading, so a new dir is always created even if `tmp-dir`
+	// is specified, to leave the other files in `tmp-dir` untouched.
+	dir, err := os.MkdirTemp(o.dir, "redo-dump-")
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cfg := &reader.LogReaderConfig{
+		URI:                *uri,
+		Dir:                dir,
+		UseExternalStorage: true,
+		EndTs:              o.endTs,
+	}
+	if o.startTs > 0 {
+		// the reader reads logs in (StartTs, EndTs].
+		cfg.StartTs = o.startTs - 1
+	}
+	rd, err := reader.NewRedoLogReader(ctx, uri.Scheme, cfg)
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	if o.output != "" {
+		f, err := os.Create(o.output)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		defer func() {
+			if closeErr := f.Close(); err == nil {
+				err = errors.Trace(closeErr)
+			}
+		}()
+		out = f
+	}
+	w := newDumpWriter(o.format, out)
+
+	eg, egCtx := errgroup.WithContext(ctx)
+	eg.Go(func() error {
+		return rd.Run(egCtx)
+	})
+	eg.Go(func() error {
+		if err := o.dump(egCtx, rd, w); err != nil {
+			return err
+		}
+		return w.flush()
+	})
+	return eg.Wait()
+}
+
+// dump reads rows and ddls from the reader and writes them in commit ts order,
+// a ddl is written before rows with the same commit ts.
+func (o *dumpOptions) dump(
+	ctx context.Context, rd reader.RedoLogReader, w dumpWriter,
+) error {
+	row, err := rd.ReadNextRow(ctx)
+	if err != nil {
+		return err
+	}
+	ddl, err := rd.ReadNextDDL(ctx)
+	if err != nil {
+		return err
+	}
+	for row != nil || ddl != nil {
+		if ddl != nil && (row == nil || ddl.CommitTs <= row.CommitTs) {
+			if event := newDDLDumpEvent(ddl); o.match(event) {
+				if err := w.write(event); err != nil {
+					return err
+				}
+			}
+			if ddl, err = rd.ReadNextDDL(ctx); err != nil {
+				return err
+			}
+			continue
+		}
+		if event := newRowDumpEvent(row); o.match(event) {
+			if err := w.write(event); err != nil {
+				return err
+			}
+		}
+		if row, err = rd.ReadNextRow(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *dumpOptions) match(event *dumpEvent) bool {
+	if event.Table == "" {
+		return o.tableFilter.MatchSchema(event.Schema)
+	}
+	return o.tableFilter.MatchTable(event.Schema, event.Table)
+}
+
+// dumpEvent is a row or ddl event read from redo logs.
+type dumpEvent struct {
+	Type       string             `json:"type"`
+	CommitTs   uint64             `json:"commit-ts"`
+	StartTs    uint64             `json:"start-ts"`
+	Schema     string             `json:"schema"`
+	Table      string             `json:"table"`
+	Op         string             `json:"op,omitempty"`
+	Query      string             `json:"query,omitempty"`
+	Columns    map[string]*string `json:"columns,omitempty"`
+	PreColumns map[string]*string `json:"pre-columns,omitempty"`
+}
+
+func newRowDumpEvent(row *model.RowChangedEvent) *dumpEvent {
+	event := &dumpEvent{
+		Type:       "row",
+		CommitTs:   row.CommitTs,
+		StartTs:    row.StartTs,
+		Schema:     row.TableInfo.GetSchemaName(),
+		Table:      row.TableInfo.GetTableName(),
+		Columns:    columnValues(row.GetColumns()),
+		PreColumns: columnValues(row.GetPreColumns()),
+	}
+	switch {
+	case row.IsInsert():
+		event.Op = "insert"
+	case row.IsDelete():
+		event.Op = "delete"
+	default:
+		event.Op = "update"
+	}
+	return event
+}
+
+func newDDLDumpEvent(ddl *model.DDLEvent) *dumpEvent {
+	event := &dumpEvent{
+		Type:     "ddl",
+		CommitTs: ddl.CommitTs,
+		StartTs:  ddl.StartTs,
+		Query:    ddl.Query,
+	}
+	if ddl.TableInfo != nil {
+		event.Schema = ddl.TableInfo.GetSchemaName()
+		event.Table = ddl.TableInfo.GetTableName()
+	}
+	return event
+}
+
+func columnValues(cols []*model.Column) map[string]*string {
+	if len(cols) == 0 {
+		return nil
+	}
+	values := make(map[string]*string, len(cols))
+	for _, col := range cols {
+		if col == nil {
+			continue
+		}
+		if col.Value == nil {
+			values[col.Name] = nil
+			continue
+		}
+		value := model.ColumnValueString(col.Value)
+		values[col.Name] = &value
+	}
+	return values
+}
+
+// dumpWriter writes dump events in a specific format.
+type dumpWriter interface {
+	write(event *dumpEvent) error
+	flush() error
+}
+
+func newDumpWriter(format string, out io.Writer) dumpWriter {
+	if format == dumpFormatCSV {
+		return &csvDumpWriter{w: csv.NewWriter(out)}
+	}
+	return &jsonDumpWriter{enc: json.NewEncoder(out)}
+}
+
+// jsonDumpWriter writes one json object per line.
+type jsonDumpWriter struct {
+	enc *json.Encoder
+}
+
+func (w *jsonDumpWriter) write(event *dumpEvent) error {
+	return errors.Trace(w.enc.Encode(event))
+}
+
+func (w *jsonDumpWriter) flush() error {
+	return nil
+}
+
+// csvDumpWriter writes one record per line with a header, columns and
+// pre-columns are encoded as json objects.
+type csvDumpWriter struct {
+	w             *csv.Writer
+	headerWritten bool
+}
+
+var csvDumpHeader = []string{
+	"type", "commit-ts", "start-ts", "schema", "table", "op", "query", "columns", "pre-columns",
+}
+
+func (w *csvDumpWriter) write(event *dumpEvent) error {
+	if !w.headerWritten {
+		if err := w.w.Write(csvDumpHeader); err != nil {
+			return errors.Trace(err)
+		}
+		w.headerWritten = true
+	}
+	columns, err := marshalColumnValues(event.Columns)
+	if err != nil {
+		return err
+	}
+	preColumns, err := marshalColumnValues(event.PreColumns)
+	if err != nil {
+		return err
+	}
+	return errors.Trace(w.w.Write([]string{
+		event.Type,
+		strconv.FormatUint(event.CommitTs, 10),
+		strconv.FormatUint(event.StartTs, 10),
+		event.Schema,
+		event.Table,
+		event.Op,
+		event.Query,
+		columns,
+		preColumns,
+	}))
+}
+
+func (w *csvDumpWriter) flush() error {
+	w.w.Flush()
+	return errors.Trace(w.w.Error())
+}
+
+func marshalColumnValues(values map[string]*string) (string, error) {
+	if len(values) == 0 {
+		return "", nil
+	}
+	data, err := json.Marshal(values)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	return string(data), nil
+}
+
+// newCmdDump creates the `redo dump` command.
+func newCmdDump(opt *options) *cobra.Command {
+	o := newDumpOptions()
+	command := &cobra.Command{
+		Use:   "dump",
+		Short: "Dump row and ddl events in redo logs as json or csv",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			o.options = *opt
+			if err := o.complete(cmd); err != nil {
+				return err
+			}
+			return o.run(cmd)
+		},
+	}
+	o.addFlags(command)
+
+	return command
+}
@@ -0,0 +1,160 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redo
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tiflow/cdc/model"
+	cmdcontext "github.com/pingcap/tiflow/pkg/cmd/context"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+type mockRedoLogReader struct {
+	rows []*model.RowChangedEvent
+	ddls []*model.DDLEvent
+}
+
+func (r *mockRedoLogReader) Run(ctx context.Context) error {
+	return nil
+}
+
+func (r *mockRedoLogReader) ReadNextRow(ctx context.Context) (*model.RowChangedEvent, error) {
+	if len(r.rows) == 0 {
+		return nil, nil
+	}
+	row := r.rows[0]
+	r.rows = r.rows[1:]
+	return row, nil
+}
+
+func (r *mockRedoLogReader) ReadNextDDL(ctx context.Context) (*model.DDLEvent, error) {
+	if len(r.ddls) == 0 {
+		return nil, nil
+	}
+	ddl := r.ddls[0]
+	r.ddls = r.ddls[1:]
+	return ddl, nil
+}
+
+func (r *mockRedoLogReader) ReadMeta(ctx context.Context) (uint64, uint64, error) {
+	return 0, 0, nil
+}
+
+func TestDumpCompleteAndFormat(t *testing.T) {
+	cmd := &cobra.Command{Use: "test"}
+	o := newDumpOptions()
+	o.format = "xml"
+	require.Error(t, o.complete(cmd))
+
+	o.format = dumpFormatCSV
+	o.startTs, o.endTs = 10, 5
+	require.Error(t, o.complete(cmd))
+
+	o.startTs, o.endTs = 0, 0
+	o.filterRules = []string{"test.t1"}
+	require.NoError(t, o.complete(cmd))
+}
+
+func TestDumpKeepsTmpDir(t *testing.T) {
+	tmpDir := t.TempDir()
+	existing := filepath.Join(tmpDir, "existing")
+	require.NoError(t, os.WriteFile(existing, []byte("data"), 0o644))
+	cmdcontext.SetDefaultContext(context.Background())
+
+	cmd := &cobra.Command{Use: "test"}
+	o := newDumpOptions()
+	o.storage = "file://" + t.TempDir()
+	o.dir = tmpDir
+	o.format = dumpFormatJSON
+	o.filterRules = []string{"*.*"}
+	require.NoError(t, o.complete(cmd))
+	// there are no redo logs in the storage.
+	require.Error(t, o.run(cmd))
+
+	// only the files downloaded by the command are removed.
+	entries, err := os.ReadDir(tmpDir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "existing", entries[0].Name())
+}
+
+func TestDump(t *testing.T) {
+	cols := []*model.Column{
+		{Name: "id", Type: mysql.TypeLong, Flag: model.HandleKeyFlag | model.PrimaryKeyFlag},
+		{Name: "name", Type: mysql.TypeVarchar},
+	}
+	t1 := model.BuildTableInfo("test", "t1", cols, [][]int{{0}})
+	t2 := model.BuildTableInfo("test", "t2", cols, [][]int{{0}})
+	newRow := func(tableInfo *model.TableInfo, commitTs uint64, cols, preCols []*model.Column) *model.RowChangedEvent {
+		return &model.RowChangedEvent{
+			StartTs:    commitTs - 1,
+			CommitTs:   commitTs,
+			TableInfo:  tableInfo,
+			Columns:    model.Columns2ColumnDatas(cols, tableInfo),
+			PreColumns: model.Columns2ColumnDatas(preCols, tableInfo),
+		}
+	}
+	newReader := func() *mockRedoLogReader {
+		return &mockRedoLogReader{
+			rows: []*model.RowChangedEvent{
+				newRow(t1, 100, []*model.Column{
+					{Name: "id", Value: int64(1)}, {Name: "name", Value: "a"},
+				}, nil),
+				newRow(t2, 101, []*model.Column{
+					{Name: "id", Value: int64(2)}, {Name: "name", Value: nil},
+				}, nil),
+				newRow(t1, 102, nil, []*model.Column{
+					{Name: "id", Value: int64(1)}, {Name: "name", Value: "a"},
+				}),
+			},
+			ddls: []*model.DDLEvent{
+				{StartTs: 90, CommitTs: 100, TableInfo: t1, Query: "create table t1 (id int primary key, name varchar(10))"},
+			},
+		}
+	}
+
+	cmd := &cobra.Command{Use: "test"}
+	o := newDumpOptions()
+	o.format = dumpFormatJSON
+	o.filterRules = []string{"test.t1"}
+	require.NoError(t, o.complete(cmd))
+	buf := &bytes.Buffer{}
+	w := newDumpWriter(o.format, buf)
+	require.NoError(t, o.dump(context.Background(), newReader(), w))
+	require.NoError(t, w.flush())
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Equal(t, []string{
+		`{"type":"ddl","commit-ts":100,"start-ts":90,"schema":"test","table":"t1","query":"create table t1 (id int primary key, name varchar(10))"}`,
+		`{"type":"row","commit-ts":100,"start-ts":99,"schema":"test","table":"t1","op":"insert","columns":{"id":"1","name":"a"}}`,
+		`{"type":"row","commit-ts":102,"start-ts":101,"schema":"test","table":"t1","op":"delete","pre-columns":{"id":"1","name":"a"}}`,
+	}, lines)
+
+	o.format = dumpFormatCSV
+	o.filterRules = []string{"test.t2"}
+	require.NoError(t, o.complete(cmd))
+	buf.Reset()
+	w = newDumpWriter(o.format, buf)
+	require.NoError(t, o.dump(context.Background(), newReader(), w))
+	require.NoError(t, w.flush())
+	require.Equal(t, "type,commit-ts,start-ts,schema,table,op,query,columns,pre-columns\n"+
+		`row,101,100,test,t2,insert,,"{""id"":""2"",""name"":null}",`+"\n", buf.String())
+}
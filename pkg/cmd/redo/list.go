@@ -0,0 +1,65 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redo
+
+import (
+	"github.com/pingcap/tiflow/cdc/redo/reader"
+	cmdcontext "github.com/pingcap/tiflow/pkg/cmd/context"
+	"github.com/spf13/cobra"
+)
+
+// listOptions defines flags for the `redo list` command.
+type listOptions struct {
+	options
+}
+
+// newListOptions creates new listOptions for the `redo list` command.
+func newListOptions() *listOptions {
+	return &listOptions{}
+}
+
+// run runs the `redo list` command.
+func (o *listOptions) run(cmd *cobra.Command) error {
+	ctx := cmdcontext.GetDefaultContext()
+
+	uri, err := o.storageURI()
+	if err != nil {
+		return err
+	}
+	files, err := reader.ListLogFiles(ctx, *uri)
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		cmd.Printf("%s\ttype:%s\tcommit-ts:%d\tsize:%d\n",
+			file.Path, file.FileType, file.CommitTs, file.Size)
+	}
+	cmd.Printf("total %d files\n", len(files))
+	return nil
+}
+
+// newCmdList creates the `redo list` command.
+func newCmdList(opt *options) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "list",
+		Short: "List redo log files in the storage",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			o := newListOptions()
+			o.options = *opt
+			return o.run(cmd)
+		},
+	}
+
+	return command
+}
@@ -14,8 +14,12 @@
 package redo
 
 import (
+	"net/url"
+
 	"github.com/pingcap/tiflow/pkg/cmd/util"
+	"github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/logutil"
+	"github.com/pingcap/tiflow/pkg/redo"
 	"github.com/spf13/cobra"
 )
 
@@ -41,6 +45,18 @@ func (o *options) addFlags(cmd *cobra.Command) {
 	cmd.MarkFlagRequired("storage") //nolint:errcheck
 }
 
+// storageURI parses the storage of redo log as an url of external storage.
+func (o *options) storageURI() (*url.URL, error) {
+	uri, err := url.Parse(o.storage)
+	if err != nil {
+		return nil, errors.WrapError(errors.ErrConsistentStorage, err)
+	}
+	if redo.IsLocalStorage(uri.Scheme) {
+		uri.Scheme = "file"
+	}
+	return uri, nil
+}
+
 // NewCmdRedo creates the `redo` command.
 func NewCmdRedo() *cobra.Command {
 	o := newOptions()
@@ -70,6 +86,9 @@ func NewCmdRedo() *cobra.Command {
 	// Add subcommands.
 	cmds.AddCommand(newCmdApply(o))
 	cmds.AddCommand(newCmdMeta(o))
+	cmds.AddCommand(newCmdList(o))
+	cmds.AddCommand(newCmdDump(o))
+	cmds.AddCommand(newCmdValidate(o))
 
 	return cmds
 }
@@ -62,6 +62,7 @@ func newOptions() *Options {
 // flags related to template printing to it.
 func (o *Options) addFlags(cmd *cobra.Command) {
 	cmd.Flags().StringVar(&o.ServerConfig.ClusterID, "cluster-id", "default", "Set cdc cluster id")
+	cmd.Flags().StringToStringVar(&o.ServerConfig.Labels, "labels", nil, "Set capture labels used by changefeed placement constraints, e.g. zone=z1,pool=payment")
 	cmd.Flags().StringVar(&o.ServerConfig.Addr, "addr", o.ServerConfig.Addr, "Set the listening address")
 	cmd.Flags().StringVar(&o.ServerConfig.AdvertiseAddr, "advertise-addr", o.ServerConfig.AdvertiseAddr, "Set the advertise listening address for client communication")
 
@@ -206,6 +207,8 @@ func (o *Options) complete(cmd *cobra.Command) error {
 			cfg.Sorter.SortDir = config.DefaultSortDir
 		case "cluster-id":
 			cfg.ClusterID = o.ServerConfig.ClusterID
+		case "labels":
+			cfg.Labels = o.ServerConfig.Labels
 		case "pd", "config":
 			// do nothing
 		default:
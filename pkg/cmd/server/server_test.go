@@ -209,6 +209,7 @@ func TestParseCfg(t *testing.T) {
 				ResolvedTsStuckInterval:        config.TomlDuration(5 * time.Minute),
 			},
 		},
+		Tracing:   config.NewDefaultTracingConfig(),
 		ClusterID: "default",
 	}, o.ServerConfig)
 }
@@ -244,6 +245,11 @@ cache-size-in-mb = 8
 [kv-client]
 region-retry-duration = "3s"
 
+[tracing]
+enable = true
+endpoint = "127.0.0.1:4317"
+sample-ratio = 0.5
+
 [debug]
 [debug.db]
 count = 5
@@ -356,6 +362,12 @@ check-balance-interval = "10s"
 				ResolvedTsStuckInterval:        config.TomlDuration(5 * time.Minute),
 			},
 		},
+		Tracing: &config.TracingConfig{
+			Enable:      true,
+			Endpoint:    "127.0.0.1:4317",
+			Insecure:    true,
+			SampleRatio: 0.5,
+		},
 		ClusterID: "default",
 	}, o.ServerConfig)
 }
@@ -497,6 +509,7 @@ cert-allowed-cn = ["dd","ee"]
 				ResolvedTsStuckInterval:        config.TomlDuration(5 * time.Minute),
 			},
 		},
+		Tracing:   config.NewDefaultTracingConfig(),
 		ClusterID: "default",
 	}, o.ServerConfig)
 }
@@ -199,6 +199,12 @@ const (
       "log-region-details": false
     }
   },
+  "tracing": {
+    "enable": false,
+    "endpoint": "",
+    "insecure": true,
+    "sample-ratio": 0.0001
+  },
   "cluster-id": "default",
   "gc-tuner-memory-threshold": 0,
   "per-table-memory-quota": 0,
@@ -368,7 +374,9 @@ const (
     "region-per-span": 0,
     "region-threshold": 100001,
     "write-key-threshold": 100001,
-    "region-per-span": 0
+    "region-per-span": 0,
+    "enable-load-balance": false,
+    "load-imbalance-threshold": 0
   },
   "integrity": {
     "integrity-check-level": "none",
@@ -0,0 +1,95 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pingcap/tiflow/pkg/errors"
+	"github.com/robfig/cron"
+)
+
+// PauseWindow is a maintenance window during which the changefeed is paused
+// by the owner automatically, and it is resumed once the window is over.
+type PauseWindow struct {
+	// Cron is a standard 5-field cron expression at which the window starts.
+	Cron string `toml:"cron" json:"cron"`
+	// Duration is how long the changefeed stays paused after the window starts.
+	Duration time.Duration `toml:"duration" json:"duration"`
+	// TimeZone is the IANA time zone name used to interpret Cron, default UTC.
+	TimeZone string `toml:"time-zone" json:"time-zone,omitempty"`
+}
+
+func (w *PauseWindow) validate() error {
+	if w.Duration <= 0 {
+		return errors.ErrInvalidReplicaConfig.GenWithStackByArgs(
+			fmt.Sprintf("the duration of pause window %q must be positive", w.Cron))
+	}
+	if _, err := cron.ParseStandard(w.Cron); err != nil {
+		return errors.ErrInvalidReplicaConfig.GenWithStackByArgs(
+			fmt.Sprintf("invalid cron expression %q of pause window: %s", w.Cron, err))
+	}
+	if _, err := w.location(); err != nil {
+		return errors.ErrInvalidReplicaConfig.GenWithStackByArgs(
+			fmt.Sprintf("invalid time zone %q of pause window: %s", w.TimeZone, err))
+	}
+	return nil
+}
+
+func (w *PauseWindow) location() (*time.Location, error) {
+	if w.TimeZone == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(w.TimeZone)
+}
+
+// ActiveUntil returns the end of the window that contains now,
+// and false if now is not in any occurrence of the window.
+func (w *PauseWindow) ActiveUntil(now time.Time) (time.Time, bool) {
+	schedule, err := cron.ParseStandard(w.Cron)
+	if err != nil || w.Duration <= 0 {
+		return time.Time{}, false
+	}
+	loc, err := w.location()
+	if err != nil {
+		return time.Time{}, false
+	}
+	now = now.In(loc)
+	// Next returns the first start strictly after the given time,
+	// so a window started in (now-duration, now] is still active.
+	start := schedule.Next(now.Add(-w.Duration))
+	if start.IsZero() || start.After(now) {
+		return time.Time{}, false
+	}
+	return start.Add(w.Duration), true
+}
+
+// ActivePauseWindowUntil returns the latest end of the pause windows which
+// contain now, and false if the changefeed is not in any pause window.
+func ActivePauseWindowUntil(windows []*PauseWindow, now time.Time) (time.Time, bool) {
+	var (
+		until  time.Time
+		active bool
+	)
+	for _, w := range windows {
+		if end, ok := w.ActiveUntil(now); ok {
+			active = true
+			if end.After(until) {
+				until = end
+			}
+		}
+	}
+	return until, active
+}
@@ -0,0 +1,86 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePauseWindows(t *testing.T) {
+	t.Parallel()
+
+	sinkURL, err := url.Parse("mysql://127.0.0.1:3306")
+	require.NoError(t, err)
+
+	cfg := GetDefaultReplicaConfig()
+	cfg.PauseWindows = []*PauseWindow{
+		{Cron: "0 2 * * *", Duration: 2 * time.Hour},
+		{Cron: "30 1 * * 6", Duration: time.Hour, TimeZone: "Asia/Shanghai"},
+	}
+	require.NoError(t, cfg.ValidateAndAdjust(sinkURL))
+
+	invalid := []*PauseWindow{
+		{Cron: "0 2 * * *"},
+		{Cron: "0 2 * *", Duration: time.Hour},
+		{Cron: "0 2 * * *", Duration: time.Hour, TimeZone: "Mars/Olympus"},
+	}
+	for _, window := range invalid {
+		cfg = GetDefaultReplicaConfig()
+		cfg.PauseWindows = []*PauseWindow{window}
+		err = cfg.ValidateAndAdjust(sinkURL)
+		require.ErrorIs(t, err, cerror.ErrInvalidReplicaConfig)
+	}
+}
+
+func TestPauseWindowActiveUntil(t *testing.T) {
+	t.Parallel()
+
+	w := &PauseWindow{Cron: "0 2 * * *", Duration: 2 * time.Hour}
+	day := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	_, ok := w.ActiveUntil(day.Add(time.Hour))
+	require.False(t, ok)
+	until, ok := w.ActiveUntil(day.Add(2 * time.Hour))
+	require.True(t, ok)
+	require.True(t, until.Equal(day.Add(4*time.Hour)))
+	until, ok = w.ActiveUntil(day.Add(3*time.Hour + 59*time.Minute))
+	require.True(t, ok)
+	require.True(t, until.Equal(day.Add(4*time.Hour)))
+	_, ok = w.ActiveUntil(day.Add(4 * time.Hour))
+	require.False(t, ok)
+
+	// the cron expression is interpreted in the time zone of the window.
+	w.TimeZone = "Asia/Shanghai"
+	_, ok = w.ActiveUntil(day.Add(2 * time.Hour))
+	require.False(t, ok)
+	until, ok = w.ActiveUntil(day.Add(-6 * time.Hour))
+	require.True(t, ok)
+	require.True(t, until.Equal(day.Add(-4*time.Hour)))
+
+	// the latest end of the overlapped windows is returned.
+	windows := []*PauseWindow{
+		{Cron: "0 2 * * *", Duration: 2 * time.Hour},
+		{Cron: "0 3 * * *", Duration: 3 * time.Hour},
+	}
+	until, ok = ActivePauseWindowUntil(windows, day.Add(3*time.Hour))
+	require.True(t, ok)
+	require.True(t, until.Equal(day.Add(6*time.Hour)))
+	_, ok = ActivePauseWindowUntil(windows, day.Add(7*time.Hour))
+	require.False(t, ok)
+}
@@ -160,6 +160,12 @@ type replicaConfig struct {
 	Integrity                    *integrity.Config   `toml:"integrity" json:"integrity"`
 	ChangefeedErrorStuckDuration *time.Duration      `toml:"changefeed-error-stuck-duration" json:"changefeed-error-stuck-duration,omitempty"`
 	SyncedStatus                 *SyncedStatusConfig `toml:"synced-status" json:"synced-status,omitempty"`
+	// Transforms are the column masking and transformation rules,
+	// they are applied to row changed events before sending to the sink.
+	Transforms []*TransformRule `toml:"transforms" json:"transforms,omitempty"`
+	// PauseWindows are the maintenance windows during which the changefeed
+	// is paused by the owner automatically.
+	PauseWindows []*PauseWindow `toml:"pause-windows" json:"pause-windows,omitempty"`
 
 	// Deprecated: we don't use this field since v8.0.0.
 	SQLMode string `toml:"sql-mode" json:"sql-mode"`
@@ -292,6 +298,25 @@ func (c *ReplicaConfig) ValidateAndAdjust(sinkURI *url.URL) error { // check sin
 		c.Scheduler.EnableTableAcrossNodes = false
 	}
 
+	scheme := sink.GetScheme(sinkURI)
+	for _, rule := range c.Transforms {
+		if err := rule.validate(); err != nil {
+			return err
+		}
+		// the downstream table of a SQL sink doesn't have the added column.
+		if rule.Action == TransformActionAddColumn &&
+			(sink.IsMySQLCompatibleScheme(scheme) || sink.IsPostgresScheme(scheme)) {
+			return cerror.ErrInvalidReplicaConfig.GenWithStackByArgs(
+				"add-column transform rule is not supported by the " + scheme + " sink")
+		}
+	}
+
+	for _, window := range c.PauseWindows {
+		if err := window.validate(); err != nil {
+			return err
+		}
+	}
+
 	if c.Integrity != nil {
 		switch strings.ToLower(sinkURI.Scheme) {
 		case sink.KafkaScheme, sink.KafkaSSLScheme:
@@ -312,6 +337,12 @@ func (c *ReplicaConfig) ValidateAndAdjust(sinkURI *url.URL) error { // check sin
 				"integrity check enabled and column selector set, not allowed")
 
 		}
+
+		if c.Integrity.Enabled() && len(c.Transforms) != 0 {
+			log.Error("it's not allowed to enable the integrity check and column transform at the same time")
+			return cerror.ErrInvalidReplicaConfig.GenWithStack(
+				"integrity check enabled and column transform set, not allowed")
+		}
 	}
 
 	if c.ChangefeedErrorStuckDuration != nil &&
@@ -243,6 +243,13 @@ func TestReplicaConfigValidate(t *testing.T) {
 	}
 	err = conf.ValidateAndAdjust(sinkURL)
 	require.Error(t, err)
+
+	conf.Scheduler = &ChangefeedSchedulerConfig{
+		EnableLoadBalance:      true,
+		LoadImbalanceThreshold: -0.1,
+	}
+	err = conf.ValidateAndAdjust(sinkURL)
+	require.Error(t, err)
 }
 
 func TestValidateIntegrity(t *testing.T) {
@@ -261,6 +268,70 @@ func TestValidateIntegrity(t *testing.T) {
 	require.ErrorIs(t, err, cerror.ErrInvalidReplicaConfig)
 }
 
+func TestValidateTransforms(t *testing.T) {
+	t.Parallel()
+
+	sinkURL, err := url.Parse("kafka://topic?protocol=avro")
+	require.NoError(t, err)
+
+	cfg := GetDefaultReplicaConfig()
+	cfg.Transforms = []*TransformRule{
+		{Matcher: []string{"a.b"}, Columns: []string{"c"}, Action: TransformActionHash},
+		{Matcher: []string{"a.*"}, Columns: []string{"d"}, Action: TransformActionTruncate, Length: util.AddressOf(4)},
+		{Matcher: []string{"a.*"}, Columns: []string{"e"}, Action: TransformActionReplace, Value: util.AddressOf("x")},
+		{
+			Matcher: []string{"*.*"}, Columns: []string{"_commit_ts"},
+			Action: TransformActionAddColumn, Value: util.AddressOf(ComputedColumnCommitTs),
+		},
+	}
+	require.NoError(t, cfg.ValidateAndAdjust(sinkURL))
+
+	invalid := []*TransformRule{
+		{Columns: []string{"c"}, Action: TransformActionHash},
+		{Matcher: []string{"a.b"}, Action: TransformActionRedact},
+		{Matcher: []string{"a.b"}, Columns: []string{"c"}, Action: "unknown"},
+		{Matcher: []string{"a.b"}, Columns: []string{"c"}, Action: TransformActionTruncate},
+		{Matcher: []string{"a.b"}, Columns: []string{"c"}, Action: TransformActionReplace},
+		{
+			Matcher: []string{"a.b"}, Columns: []string{"c", "d"},
+			Action: TransformActionAddColumn, Value: util.AddressOf(ComputedColumnClusterID),
+		},
+		{
+			Matcher: []string{"a.b"}, Columns: []string{"c"},
+			Action: TransformActionAddColumn, Value: util.AddressOf("unknown"),
+		},
+	}
+	for _, rule := range invalid {
+		cfg = GetDefaultReplicaConfig()
+		cfg.Transforms = []*TransformRule{rule}
+		err = cfg.ValidateAndAdjust(sinkURL)
+		require.ErrorIs(t, err, cerror.ErrInvalidReplicaConfig)
+	}
+
+	// the add-column action is not supported by the SQL sinks.
+	for _, uri := range []string{"mysql://127.0.0.1:3306/", "tidb://127.0.0.1:4000/", "postgres://127.0.0.1:5432/"} {
+		sqlSinkURL, err := url.Parse(uri)
+		require.NoError(t, err)
+		cfg = GetDefaultReplicaConfig()
+		cfg.Transforms = []*TransformRule{{
+			Matcher: []string{"*.*"}, Columns: []string{"_commit_ts"},
+			Action: TransformActionAddColumn, Value: util.AddressOf(ComputedColumnCommitTs),
+		}}
+		err = cfg.ValidateAndAdjust(sqlSinkURL)
+		require.ErrorIs(t, err, cerror.ErrInvalidReplicaConfig)
+		require.ErrorContains(t, err, "add-column")
+	}
+
+	// integrity check can not be enabled with column transforms.
+	cfg = GetDefaultReplicaConfig()
+	cfg.Integrity.IntegrityCheckLevel = integrity.CheckLevelCorrectness
+	cfg.Transforms = []*TransformRule{
+		{Matcher: []string{"a.b"}, Columns: []string{"c"}, Action: TransformActionRedact},
+	}
+	err = cfg.ValidateAndAdjust(sinkURL)
+	require.ErrorIs(t, err, cerror.ErrInvalidReplicaConfig)
+}
+
 func TestValidateAndAdjust(t *testing.T) {
 	cfg := GetDefaultReplicaConfig()
 
@@ -15,6 +15,7 @@ package config
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	cerror "github.com/pingcap/tiflow/pkg/errors"
@@ -31,10 +32,32 @@ type ChangefeedSchedulerConfig struct {
 	WriteKeyThreshold int `toml:"write-key-threshold" json:"write-key-threshold"`
 	// Deprecated.
 	RegionPerSpan int `toml:"region-per-span" json:"region-per-span"`
+	// EnableLoadBalance set true to balance spans among captures by their
+	// throughput and lag instead of by the number of spans.
+	EnableLoadBalance bool `toml:"enable-load-balance" json:"enable-load-balance"`
+	// LoadImbalanceThreshold is the ratio by which the load of the busiest
+	// capture must exceed the average load before spans are moved.
+	// Zero means the scheduler default.
+	LoadImbalanceThreshold float64 `toml:"load-imbalance-threshold" json:"load-imbalance-threshold"`
+	// PlacementConstraints restricts the captures that tables of the
+	// changefeed can be placed on by capture labels. A capture must satisfy
+	// all constraints.
+	PlacementConstraints []*PlacementConstraint `toml:"placement-constraints" json:"placement-constraints,omitempty"`
 }
 
 // Validate validates the config.
 func (c *ChangefeedSchedulerConfig) Validate() error {
+	if c.LoadImbalanceThreshold < 0 {
+		return errors.New("load-imbalance-threshold must not be negative")
+	}
+	for _, constraint := range c.PlacementConstraints {
+		if constraint == nil {
+			return errors.New("placement constraint must not be empty")
+		}
+		if err := constraint.validate(); err != nil {
+			return err
+		}
+	}
 	if !c.EnableTableAcrossNodes {
 		return nil
 	}
@@ -47,6 +70,65 @@ func (c *ChangefeedSchedulerConfig) Validate() error {
 	return nil
 }
 
+const (
+	// PlacementOpIn requires the capture label to be one of the values.
+	PlacementOpIn = "in"
+	// PlacementOpNotIn requires the capture label to be absent or not be any
+	// of the values.
+	PlacementOpNotIn = "not-in"
+)
+
+// PlacementConstraint is a constraint on capture labels.
+type PlacementConstraint struct {
+	Key    string   `toml:"key" json:"key"`
+	Op     string   `toml:"op" json:"op"`
+	Values []string `toml:"values" json:"values"`
+}
+
+// Match returns true if the labels satisfy the constraint.
+func (c *PlacementConstraint) Match(labels map[string]string) bool {
+	value, ok := labels[c.Key]
+	in := false
+	if ok {
+		for _, v := range c.Values {
+			if v == value {
+				in = true
+				break
+			}
+		}
+	}
+	if c.Op == PlacementOpNotIn {
+		return !in
+	}
+	return in
+}
+
+// MatchPlacementConstraints returns true if the labels satisfy all constraints.
+func MatchPlacementConstraints(
+	constraints []*PlacementConstraint, labels map[string]string,
+) bool {
+	for _, c := range constraints {
+		if !c.Match(labels) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *PlacementConstraint) validate() error {
+	if c.Key == "" {
+		return errors.New("placement constraint key must not be empty")
+	}
+	if c.Op != PlacementOpIn && c.Op != PlacementOpNotIn {
+		return fmt.Errorf("placement constraint op must be %s or %s, got %s",
+			PlacementOpIn, PlacementOpNotIn, c.Op)
+	}
+	if len(c.Values) == 0 {
+		return fmt.Errorf("placement constraint on %s must have values", c.Key)
+	}
+	return nil
+}
+
 // SchedulerConfig configs TiCDC scheduler.
 type SchedulerConfig struct {
 	// HeartbeatTick is the number of owner tick to initial a heartbeat to captures.
@@ -0,0 +1,48 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlacementConstraints(t *testing.T) {
+	t.Parallel()
+
+	constraints := []*PlacementConstraint{
+		{Key: "zone", Op: PlacementOpIn, Values: []string{"z1", "z2"}},
+		{Key: "pool", Op: PlacementOpNotIn, Values: []string{"payment"}},
+	}
+	require.True(t, MatchPlacementConstraints(nil, nil))
+	require.True(t, MatchPlacementConstraints(constraints, map[string]string{"zone": "z1"}))
+	require.True(t, MatchPlacementConstraints(
+		constraints, map[string]string{"zone": "z2", "pool": "backfill"}))
+	require.False(t, MatchPlacementConstraints(
+		constraints, map[string]string{"zone": "z1", "pool": "payment"}))
+	require.False(t, MatchPlacementConstraints(constraints, map[string]string{"zone": "z3"}))
+	require.False(t, MatchPlacementConstraints(constraints, nil))
+
+	cfg := &ChangefeedSchedulerConfig{PlacementConstraints: constraints}
+	require.NoError(t, cfg.Validate())
+	cfg.PlacementConstraints = []*PlacementConstraint{{Op: PlacementOpIn, Values: []string{"z1"}}}
+	require.Error(t, cfg.Validate())
+	cfg.PlacementConstraints = []*PlacementConstraint{{Key: "zone", Op: "eq", Values: []string{"z1"}}}
+	require.Error(t, cfg.Validate())
+	cfg.PlacementConstraints = []*PlacementConstraint{{Key: "zone", Op: PlacementOpIn}}
+	require.Error(t, cfg.Validate())
+	cfg.PlacementConstraints = []*PlacementConstraint{nil}
+	require.Error(t, cfg.Validate())
+}
@@ -32,6 +32,8 @@ import (
 const (
 	// clusterIDMaxLen is the max length of cdc server cluster id
 	clusterIDMaxLen = 128
+	// labelMaxLen is the max length of a capture label key or value.
+	labelMaxLen = 63
 	// DefaultSortDir is the default value of sort-dir, it will be a subordinate directory of data-dir.
 	DefaultSortDir = "/tmp/sorter"
 
@@ -60,6 +62,8 @@ const (
 var (
 	clusterIDRe = regexp.MustCompile(`^[a-zA-Z0-9]+(-[a-zA-Z0-9]+)*$`)
 
+	labelRe = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9._/-]*[a-zA-Z0-9])?$`)
+
 	// ReservedClusterIDs contains a list of reserved cluster id,
 	// these words are the part of old cdc etcd key prefix
 	// like: /tidb/cdc/owner
@@ -123,6 +127,7 @@ var defaultServerConfig = &ServerConfig{
 		CDCV2:     &CDCV2{Enable: false},
 		Puller:    NewDefaultPullerConfig(),
 	},
+	Tracing:                NewDefaultTracingConfig(),
 	ClusterID:              "default",
 	GcTunerMemoryThreshold: DisableMemoryLimit,
 }
@@ -150,8 +155,12 @@ type ServerConfig struct {
 	Security               *security.Credential `toml:"security" json:"security"`
 	KVClient               *KVClientConfig      `toml:"kv-client" json:"kv-client"`
 	Debug                  *DebugConfig         `toml:"debug" json:"debug"`
+	Tracing                *TracingConfig       `toml:"tracing" json:"tracing"`
 	ClusterID              string               `toml:"cluster-id" json:"cluster-id"`
 	GcTunerMemoryThreshold uint64               `toml:"gc-tuner-memory-threshold" json:"gc-tuner-memory-threshold"`
+	// Labels are advertised by the capture, changefeeds use them to constrain
+	// which captures their tables can be placed on, e.g. zone, rack or pool.
+	Labels map[string]string `toml:"labels" json:"labels,omitempty"`
 
 	// Deprecated: we don't use this field anymore.
 	PerTableMemoryQuota uint64 `toml:"per-table-memory-quota" json:"per-table-memory-quota"`
@@ -223,6 +232,13 @@ func (c *ServerConfig) ValidateAndAdjust() error {
 	} else {
 		return cerror.ErrInvalidServerOption.GenWithStack("advertise address or address does not contain a port")
 	}
+	for key, value := range c.Labels {
+		if !isValidLabel(key) || !isValidLabel(value) {
+			return cerror.ErrInvalidServerOption.GenWithStack(fmt.Sprintf(
+				"bad label %s=%s, please match the pattern \"%s\"",
+				key, value, labelRe.String()))
+		}
+	}
 	if c.GcTTL == 0 {
 		return cerror.ErrInvalidServerOption.GenWithStack("empty GC TTL is not allowed")
 	}
@@ -279,6 +295,13 @@ func (c *ServerConfig) ValidateAndAdjust() error {
 	if err = c.Debug.ValidateAndAdjust(); err != nil {
 		return errors.Trace(err)
 	}
+
+	if c.Tracing == nil {
+		c.Tracing = defaultCfg.Tracing
+	}
+	if err = c.Tracing.ValidateAndAdjust(); err != nil {
+		return errors.Trace(err)
+	}
 	return nil
 }
 
@@ -340,3 +363,10 @@ func isValidClusterID(clusterID string) bool {
 	}
 	return true
 }
+
+// isValidLabel returns true if the capture label key or value matches
+// the pattern "^[a-zA-Z0-9]([a-zA-Z0-9._/-]*[a-zA-Z0-9])?$", length no more
+// than `labelMaxLen`, eg, "zone", "us-west-1a".
+func isValidLabel(label string) bool {
+	return len(label) <= labelMaxLen && labelRe.MatchString(label)
+}
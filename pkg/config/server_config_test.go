@@ -75,6 +75,12 @@ func TestServerConfigValidateAndAdjust(t *testing.T) {
 	conf.Debug.Messages.ServerWorkerPoolSize = 0
 	require.Nil(t, conf.ValidateAndAdjust())
 	require.EqualValues(t, GetDefaultServerConfig().Debug.Messages.ServerWorkerPoolSize, conf.Debug.Messages.ServerWorkerPoolSize)
+	conf.Labels = map[string]string{"zone": "us-west-1a", "pool": "payment"}
+	require.Nil(t, conf.ValidateAndAdjust())
+	conf.Labels = map[string]string{"zone": ""}
+	require.Regexp(t, ".*bad label.*", conf.ValidateAndAdjust())
+	conf.Labels = map[string]string{"-zone": "z1"}
+	require.Regexp(t, ".*bad label.*", conf.ValidateAndAdjust())
 }
 
 func TestDBConfigValidateAndAdjust(t *testing.T) {
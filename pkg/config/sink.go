@@ -139,7 +139,7 @@ type SinkConfig struct {
 	DispatchRules []*DispatchRule `toml:"dispatchers" json:"dispatchers,omitempty"`
 
 	ColumnSelectors []*ColumnSelector `toml:"column-selectors" json:"column-selectors,omitempty"`
-	// SchemaRegistry is only available when the downstream is MQ using avro protocol.
+	// SchemaRegistry is only available when the downstream is MQ using avro or protobuf protocol.
 	SchemaRegistry *string `toml:"schema-registry" json:"schema-registry,omitempty"`
 	// EncoderConcurrency is only available when the downstream is MQ.
 	EncoderConcurrency *int `toml:"encoder-concurrency" json:"encoder-concurrency,omitempty"`
@@ -175,6 +175,11 @@ type SinkConfig struct {
 	PulsarConfig       *PulsarConfig       `toml:"pulsar-config" json:"pulsar-config,omitempty"`
 	MySQLConfig        *MySQLConfig        `toml:"mysql-config" json:"mysql-config,omitempty"`
 	CloudStorageConfig *CloudStorageConfig `toml:"cloud-storage-config" json:"cloud-storage-config,omitempty"`
+	WebhookConfig      *WebhookConfig      `toml:"webhook-config" json:"webhook-config,omitempty"`
+	// ElasticsearchConfig is only available when the downstream is Elasticsearch or OpenSearch.
+	ElasticsearchConfig *ElasticsearchConfig `toml:"elasticsearch-config" json:"elasticsearch-config,omitempty"`
+	// RedisConfig is only available when the downstream is Redis.
+	RedisConfig *RedisConfig `toml:"redis-config" json:"redis-config,omitempty"`
 
 	// AdvanceTimeoutInSec is a duration in second. If a table sink progress hasn't been
 	// advanced for this given duration, the sink will be canceled and re-established.
@@ -203,6 +208,13 @@ type SinkConfig struct {
 	OpenProtocol *OpenProtocolConfig `toml:"open" json:"open,omitempty"`
 	// DebeziumConfig related configurations
 	Debezium *DebeziumConfig `toml:"debezium" json:"debezium,omitempty"`
+
+	// DeadLetter is used to route the rows which are permanently rejected by
+	// the downstream to a separate location, so that the replication can go on.
+	DeadLetter *DeadLetterConfig `toml:"dead-letter" json:"dead-letter,omitempty"`
+
+	// RateLimit limits the throughput of the changefeed written to the sink.
+	RateLimit *RateLimitConfig `toml:"rate-limit" json:"rate-limit,omitempty"`
 }
 
 // MaskSensitiveData masks sensitive data in SinkConfig
@@ -216,6 +228,18 @@ func (s *SinkConfig) MaskSensitiveData() {
 	if s.PulsarConfig != nil {
 		s.PulsarConfig.MaskSensitiveData()
 	}
+	if s.WebhookConfig != nil {
+		s.WebhookConfig.MaskSensitiveData()
+	}
+	if s.ElasticsearchConfig != nil {
+		s.ElasticsearchConfig.MaskSensitiveData()
+	}
+	if s.RedisConfig != nil {
+		s.RedisConfig.MaskSensitiveData()
+	}
+	if s.DeadLetter != nil {
+		s.DeadLetter.MaskSensitiveData()
+	}
 }
 
 // ShouldSendBootstrapMsg returns whether the sink should send bootstrap message.
@@ -694,8 +718,23 @@ type CloudStorageConfig struct {
 
 	// OutputRawChangeEvent controls whether to split the update pk/uk events.
 	OutputRawChangeEvent *bool `toml:"output-raw-change-event" json:"output-raw-change-event,omitempty"`
+	// TableFormat is the format of the tables in the storage, the data files
+	// are organized as Iceberg tables if it is `iceberg`.
+	TableFormat *string `toml:"table-format" json:"table-format,omitempty"`
+	// IcebergSnapshotRetention is how long the snapshots of the Iceberg tables
+	// are kept, e.g. `1h`. Older snapshots except the current one are expired.
+	IcebergSnapshotRetention *string `toml:"iceberg-snapshot-retention" json:"iceberg-snapshot-retention,omitempty"`
+	// IcebergMaxMetadataFiles is the number of previous metadata files kept
+	// for each Iceberg table, older metadata files are deleted.
+	IcebergMaxMetadataFiles *int `toml:"iceberg-max-metadata-files" json:"iceberg-max-metadata-files,omitempty"`
 }
 
+const (
+	// CloudStorageTableFormatIceberg writes the data files of the cloud
+	// storage sink as Iceberg tables.
+	CloudStorageTableFormatIceberg = "iceberg"
+)
+
 // GetOutputRawChangeEvent returns the value of OutputRawChangeEvent
 func (c *CloudStorageConfig) GetOutputRawChangeEvent() bool {
 	if c == nil || c.OutputRawChangeEvent == nil {
@@ -704,12 +743,200 @@ func (c *CloudStorageConfig) GetOutputRawChangeEvent() bool {
 	return *c.OutputRawChangeEvent
 }
 
+// WebhookConfig represents a webhook sink configuration
+type WebhookConfig struct {
+	WorkerCount     *int    `toml:"worker-count" json:"worker-count,omitempty"`
+	MaxBatchRows    *int    `toml:"max-batch-rows" json:"max-batch-rows,omitempty"`
+	Timeout         *string `toml:"timeout" json:"timeout,omitempty"`
+	MaxRetries      *int    `toml:"max-retries" json:"max-retries,omitempty"`
+	BackoffBaseInMs *int64  `toml:"backoff-base-in-ms" json:"backoff-base-in-ms,omitempty"`
+	BackoffMaxInMs  *int64  `toml:"backoff-max-in-ms" json:"backoff-max-in-ms,omitempty"`
+	SSLCa           *string `toml:"ssl-ca" json:"ssl-ca,omitempty"`
+	SSLCert         *string `toml:"ssl-cert" json:"ssl-cert,omitempty"`
+	SSLKey          *string `toml:"ssl-key" json:"ssl-key,omitempty"`
+	// Headers are added to every request sent to the webhook endpoint,
+	// it can be used to carry the authentication information.
+	Headers map[string]string `toml:"headers" json:"headers,omitempty"`
+
+	// OutputRawChangeEvent controls whether to split the update pk/uk events.
+	OutputRawChangeEvent *bool `toml:"output-raw-change-event" json:"output-raw-change-event,omitempty"`
+}
+
+// GetOutputRawChangeEvent returns the value of OutputRawChangeEvent
+func (c *WebhookConfig) GetOutputRawChangeEvent() bool {
+	if c == nil || c.OutputRawChangeEvent == nil {
+		return false
+	}
+	return *c.OutputRawChangeEvent
+}
+
+// MaskSensitiveData masks sensitive data in WebhookConfig
+func (c *WebhookConfig) MaskSensitiveData() {
+	if c.SSLKey != nil {
+		c.SSLKey = aws.String("******")
+	}
+	for k := range c.Headers {
+		c.Headers[k] = "******"
+	}
+}
+
+// ElasticsearchConfig represents an elasticsearch sink configuration
+type ElasticsearchConfig struct {
+	// Index is the expression of the index name, it uses the same syntax as
+	// the topic expression of the MQ sink, for example `{schema}_{table}`.
+	Index           *string `toml:"index" json:"index,omitempty"`
+	WorkerCount     *int    `toml:"worker-count" json:"worker-count,omitempty"`
+	MaxBatchRows    *int    `toml:"max-batch-rows" json:"max-batch-rows,omitempty"`
+	Timeout         *string `toml:"timeout" json:"timeout,omitempty"`
+	MaxRetries      *int    `toml:"max-retries" json:"max-retries,omitempty"`
+	BackoffBaseInMs *int64  `toml:"backoff-base-in-ms" json:"backoff-base-in-ms,omitempty"`
+	BackoffMaxInMs  *int64  `toml:"backoff-max-in-ms" json:"backoff-max-in-ms,omitempty"`
+	Username        *string `toml:"username" json:"username,omitempty"`
+	Password        *string `toml:"password" json:"password,omitempty"`
+	APIKey          *string `toml:"api-key" json:"api-key,omitempty"`
+	SSLCa           *string `toml:"ssl-ca" json:"ssl-ca,omitempty"`
+	SSLCert         *string `toml:"ssl-cert" json:"ssl-cert,omitempty"`
+	SSLKey          *string `toml:"ssl-key" json:"ssl-key,omitempty"`
+}
+
+// MaskSensitiveData masks sensitive data in ElasticsearchConfig
+func (c *ElasticsearchConfig) MaskSensitiveData() {
+	if c.Password != nil {
+		c.Password = aws.String("******")
+	}
+	if c.APIKey != nil {
+		c.APIKey = aws.String("******")
+	}
+	if c.SSLKey != nil {
+		c.SSLKey = aws.String("******")
+	}
+}
+
+const (
+	// RedisModeInvalidate deletes the keys of the changed rows, so that
+	// the application reloads them from TiDB on the next read.
+	RedisModeInvalidate = "invalidate"
+	// RedisModeMaterialize writes the rows to the keys, and deletes the
+	// keys of the deleted rows.
+	RedisModeMaterialize = "materialize"
+)
+
+// RedisConfig represents a redis sink configuration
+type RedisConfig struct {
+	WorkerCount  *int    `toml:"worker-count" json:"worker-count,omitempty"`
+	MaxBatchRows *int    `toml:"max-batch-rows" json:"max-batch-rows,omitempty"`
+	Timeout      *string `toml:"timeout" json:"timeout,omitempty"`
+	MaxRetries   *int    `toml:"max-retries" json:"max-retries,omitempty"`
+	SSLCa        *string `toml:"ssl-ca" json:"ssl-ca,omitempty"`
+	SSLCert      *string `toml:"ssl-cert" json:"ssl-cert,omitempty"`
+	SSLKey       *string `toml:"ssl-key" json:"ssl-key,omitempty"`
+	// Rules decide which tables are written to redis and how, the first
+	// matched rule is applied. The rows of the tables which do not match
+	// any rule are skipped.
+	Rules []*RedisRule `toml:"rules" json:"rules,omitempty"`
+}
+
+// RedisRule represents how the rows of the matched tables are written to redis.
+type RedisRule struct {
+	Matcher []string `toml:"matcher" json:"matcher"`
+	// Mode is either `invalidate` or `materialize`, the default is `invalidate`.
+	Mode string `toml:"mode" json:"mode"`
+	// Key is the template of the keys, `{schema}`, `{table}`, `{pk}` and
+	// `{<column>}` are substituted by the schema name, the table name, the
+	// handle key values joined by ':' and the value of the handle key column.
+	// The default is `{schema}:{table}:{pk}`.
+	Key string `toml:"key" json:"key"`
+	// TTL is the expiration of the keys written in materialize mode,
+	// the keys never expire if it is not set.
+	TTL *string `toml:"ttl" json:"ttl,omitempty"`
+}
+
+// MaskSensitiveData masks sensitive data in RedisConfig
+func (c *RedisConfig) MaskSensitiveData() {
+	if c.SSLKey != nil {
+		c.SSLKey = aws.String("******")
+	}
+}
+
+// DeadLetterConfig represents the configuration of the dead-letter queue.
+// Rows that are rejected permanently are written to the location specified
+// by URI together with the error, instead of stopping the changefeed:
+//   - For MySQL compatible sinks, rows that the downstream rejects with a
+//     non-retryable error, for example a value out of range of the column.
+//   - For MQ sinks, rows that can't be encoded, for example a row larger than
+//     max-message-bytes or a value the protocol can't represent. Errors
+//     returned by the broker when producing messages still stop the
+//     changefeed, because a message may carry several rows.
+type DeadLetterConfig struct {
+	// URI is the location of the dead-letter queue. It can be an external
+	// storage URI such as `s3://bucket/prefix` or `file:///path`, or a kafka
+	// URI such as `kafka://127.0.0.1:9092/topic`.
+	URI *string `toml:"uri" json:"uri,omitempty"`
+}
+
+// IsEnabled returns whether the dead-letter queue is enabled.
+func (c *DeadLetterConfig) IsEnabled() bool {
+	return c != nil && util.GetOrZero(c.URI) != ""
+}
+
+// MaskSensitiveData masks sensitive data in DeadLetterConfig
+func (c *DeadLetterConfig) MaskSensitiveData() {
+	if c.URI != nil {
+		c.URI = aws.String(util.MaskSensitiveDataInURI(*c.URI))
+	}
+}
+
+func (c *DeadLetterConfig) validate() error {
+	if !c.IsEnabled() {
+		return nil
+	}
+	uri, err := url.Parse(*c.URI)
+	if err != nil {
+		return cerror.WrapError(cerror.ErrSinkInvalidConfig, err)
+	}
+	scheme := sink.GetScheme(uri)
+	switch {
+	case sink.IsStorageScheme(scheme):
+	case scheme == sink.KafkaScheme || scheme == sink.KafkaSSLScheme:
+		if strings.Trim(uri.Path, "/") == "" {
+			return cerror.ErrSinkInvalidConfig.GenWithStack(
+				"the topic of the dead-letter queue is not specified in uri %s",
+				util.MaskSensitiveDataInURI(*c.URI))
+		}
+	default:
+		return cerror.ErrSinkInvalidConfig.GenWithStack(
+			"the scheme %s of the dead-letter queue is not supported, "+
+				"only external storage and kafka are supported", scheme)
+	}
+	return nil
+}
+
+// RateLimitConfig represents the throughput limit of a changefeed. The limit is
+// shared by all tables of the changefeed, and divided evenly among the captures
+// replicating the changefeed. Zero means no limit.
+type RateLimitConfig struct {
+	// RowsPerSecond is the max number of rows written to the sink per second.
+	RowsPerSecond *uint64 `toml:"rows-per-second" json:"rows-per-second,omitempty"`
+	// BytesPerSecond is the max size of rows written to the sink per second.
+	BytesPerSecond *uint64 `toml:"bytes-per-second" json:"bytes-per-second,omitempty"`
+}
+
+// IsEnabled returns whether any rate limit is set.
+func (c *RateLimitConfig) IsEnabled() bool {
+	return c != nil && (util.GetOrZero(c.RowsPerSecond) > 0 || util.GetOrZero(c.BytesPerSecond) > 0)
+}
+
 func (s *SinkConfig) validateAndAdjust(sinkURI *url.URL) error {
 	if err := s.validateAndAdjustSinkURI(sinkURI); err != nil {
 		return err
 	}
 
-	if sink.IsMySQLCompatibleScheme(sinkURI.Scheme) {
+	if err := s.DeadLetter.validate(); err != nil {
+		return err
+	}
+
+	if sink.IsMySQLCompatibleScheme(sinkURI.Scheme) || sink.IsPostgresScheme(sinkURI.Scheme) ||
+		sink.IsElasticsearchScheme(sinkURI.Scheme) || sink.IsRedisScheme(sinkURI.Scheme) {
 		return nil
 	}
 
@@ -850,12 +1077,15 @@ func (s *SinkConfig) validateAndAdjustSinkURI(sinkURI *url.URL) error {
 		zap.String("txnAtomicity", string(util.GetOrZero(s.TxnAtomicity))))
 
 	// Check that protocol config is compatible with the scheme.
-	if sink.IsMySQLCompatibleScheme(sinkURI.Scheme) && s.Protocol != nil {
+	if (sink.IsMySQLCompatibleScheme(sinkURI.Scheme) || sink.IsPostgresScheme(sinkURI.Scheme) ||
+		sink.IsElasticsearchScheme(sinkURI.Scheme) || sink.IsRedisScheme(sinkURI.Scheme)) &&
+		s.Protocol != nil {
 		return cerror.ErrSinkURIInvalid.GenWithStackByArgs(fmt.Sprintf("protocol %s "+
 			"is incompatible with %s scheme", util.GetOrZero(s.Protocol), sinkURI.Scheme))
 	}
 	// For testing purposes, any protocol should be legal for blackhole.
-	if sink.IsMQScheme(sinkURI.Scheme) || sink.IsStorageScheme(sinkURI.Scheme) {
+	if sink.IsMQScheme(sinkURI.Scheme) || sink.IsStorageScheme(sinkURI.Scheme) ||
+		sink.IsWebhookScheme(sinkURI.Scheme) {
 		return s.ValidateProtocol(sinkURI.Scheme)
 	}
 	return nil
@@ -881,7 +1111,7 @@ func (s *SinkConfig) ValidateProtocol(scheme string) error {
 		if s.CSVConfig != nil {
 			outputOldValue = s.CSVConfig.OutputOldValue
 		}
-	case ProtocolAvro:
+	case ProtocolAvro, ProtocolProtobuf:
 		outputOldValue = false
 	default:
 		return nil
@@ -893,6 +1123,8 @@ func (s *SinkConfig) ValidateProtocol(scheme string) error {
 		outputRawChangeEvent = s.KafkaConfig.GetOutputRawChangeEvent()
 	case sink.PulsarScheme, sink.PulsarSSLScheme, sink.PulsarHTTPScheme, sink.PulsarHTTPSScheme:
 		outputRawChangeEvent = s.PulsarConfig.GetOutputRawChangeEvent()
+	case sink.WebhookHTTPScheme, sink.WebhookHTTPSScheme:
+		outputRawChangeEvent = s.WebhookConfig.GetOutputRawChangeEvent()
 	default:
 		outputRawChangeEvent = s.CloudStorageConfig.GetOutputRawChangeEvent()
 	}
@@ -40,6 +40,8 @@ const (
 	ProtocolCsv
 	ProtocolDebezium
 	ProtocolSimple
+	ProtocolParquet
+	ProtocolProtobuf
 )
 
 // IsBatchEncode returns whether the protocol is a batch encoder.
@@ -72,6 +74,10 @@ func ParseSinkProtocolFromString(protocol string) (Protocol, error) {
 		return ProtocolDebezium, nil
 	case "simple":
 		return ProtocolSimple, nil
+	case "parquet":
+		return ProtocolParquet, nil
+	case "protobuf":
+		return ProtocolProtobuf, nil
 	default:
 		return ProtocolUnknown, cerror.ErrSinkUnknownProtocol.GenWithStackByArgs(protocol)
 	}
@@ -100,6 +106,10 @@ func (p Protocol) String() string {
 		return "debezium"
 	case ProtocolSimple:
 		return "simple"
+	case ProtocolParquet:
+		return "parquet"
+	case ProtocolProtobuf:
+		return "protobuf"
 	default:
 		panic("unreachable")
 	}
@@ -54,6 +54,10 @@ func TestParseSinkProtocolFromString(t *testing.T) {
 			protocol:             "flat-avro",
 			expectedProtocolEnum: ProtocolAvro,
 		},
+		{
+			protocol:             "protobuf",
+			expectedProtocolEnum: ProtocolProtobuf,
+		},
 		{
 			protocol:             "craft",
 			expectedProtocolEnum: ProtocolCraft,
@@ -101,6 +105,10 @@ func TestString(t *testing.T) {
 			protocolEnum:     ProtocolAvro,
 			expectedProtocol: "avro",
 		},
+		{
+			protocolEnum:     ProtocolProtobuf,
+			expectedProtocol: "protobuf",
+		},
 		{
 			protocolEnum:     ProtocolCraft,
 			expectedProtocol: "craft",
@@ -0,0 +1,55 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// defaultTracingSampleRatio traces one of every ten thousand transactions.
+const defaultTracingSampleRatio = 0.0001
+
+// TracingConfig represents the OpenTelemetry tracing config of the server.
+type TracingConfig struct {
+	// Enable indicates whether to export the traces of sampled transactions.
+	Enable bool `toml:"enable" json:"enable"`
+	// Endpoint is the address of the OTLP gRPC collector, e.g. "127.0.0.1:4317".
+	Endpoint string `toml:"endpoint" json:"endpoint"`
+	// Insecure disables the TLS of the connection to the collector.
+	Insecure bool `toml:"insecure" json:"insecure"`
+	// SampleRatio is the ratio of transactions to be traced, in [0, 1].
+	SampleRatio float64 `toml:"sample-ratio" json:"sample-ratio"`
+}
+
+// NewDefaultTracingConfig returns the default tracing config.
+func NewDefaultTracingConfig() *TracingConfig {
+	return &TracingConfig{
+		Enable:      false,
+		Insecure:    true,
+		SampleRatio: defaultTracingSampleRatio,
+	}
+}
+
+// ValidateAndAdjust validates and adjusts the tracing configuration
+func (c *TracingConfig) ValidateAndAdjust() error {
+	if c.SampleRatio < 0 || c.SampleRatio > 1 {
+		return cerror.ErrInvalidServerOption.GenWithStack(
+			"tracing sample-ratio should be in [0, 1], got %v", c.SampleRatio)
+	}
+	if c.Enable && c.Endpoint == "" {
+		return cerror.ErrInvalidServerOption.GenWithStack(
+			"tracing endpoint should not be empty when tracing is enabled")
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"github.com/pingcap/tiflow/pkg/errors"
+)
+
+// TransformAction is the action of a transform rule.
+type TransformAction string
+
+const (
+	// TransformActionHash replaces the column value with the hex encoded
+	// SHA-256 digest of the salt and the original value.
+	TransformActionHash TransformAction = "hash"
+	// TransformActionRedact replaces every character of the column value with '*'.
+	TransformActionRedact TransformAction = "redact"
+	// TransformActionTruncate keeps at most `length` characters of the column value.
+	TransformActionTruncate TransformAction = "truncate"
+	// TransformActionReplace replaces the column value with a constant value.
+	TransformActionReplace TransformAction = "replace"
+	// TransformActionAddColumn appends a computed column to the row.
+	TransformActionAddColumn TransformAction = "add-column"
+)
+
+const (
+	// ComputedColumnClusterID is the ID of the upstream cluster.
+	ComputedColumnClusterID = "cluster-id"
+	// ComputedColumnCommitTs is the commit ts of the row change.
+	ComputedColumnCommitTs = "commit-ts"
+	// ComputedColumnCommitTime is the physical commit time of the row change.
+	ComputedColumnCommitTime = "commit-time"
+)
+
+// TransformRule represents a column transform rule for tables.
+// The rules are applied to row changed events in the order they are
+// defined, and a column is transformed by the first rule matches it.
+type TransformRule struct {
+	Matcher []string `toml:"matcher" json:"matcher"`
+	// Columns are the column filter rules of the columns to transform.
+	// For the add-column action, it contains exactly one new column name.
+	Columns []string        `toml:"columns" json:"columns"`
+	Action  TransformAction `toml:"action" json:"action"`
+	// Salt is only used by the hash action.
+	Salt *string `toml:"salt" json:"salt,omitempty"`
+	// Length is only used by the truncate action.
+	Length *int `toml:"length" json:"length,omitempty"`
+	// Value is the constant value of the replace action,
+	// or the source of the column added by the add-column action.
+	Value *string `toml:"value" json:"value,omitempty"`
+}
+
+func (r *TransformRule) validate() error {
+	if len(r.Matcher) == 0 {
+		return errors.ErrInvalidReplicaConfig.GenWithStackByArgs(
+			"the matcher of a transform rule must not be empty")
+	}
+	if len(r.Columns) == 0 {
+		return errors.ErrInvalidReplicaConfig.GenWithStackByArgs(
+			"the columns of a transform rule must not be empty")
+	}
+	switch r.Action {
+	case TransformActionHash, TransformActionRedact:
+	case TransformActionTruncate:
+		if r.Length == nil || *r.Length <= 0 {
+			return errors.ErrInvalidReplicaConfig.GenWithStackByArgs(
+				"the length of a truncate transform rule must be positive")
+		}
+	case TransformActionReplace:
+		if r.Value == nil {
+			return errors.ErrInvalidReplicaConfig.GenWithStackByArgs(
+				"the value of a replace transform rule must be set")
+		}
+	case TransformActionAddColumn:
+		if len(r.Columns) != 1 || r.Columns[0] == "" {
+			return errors.ErrInvalidReplicaConfig.GenWithStackByArgs(
+				"an add-column transform rule must specify exactly one column name")
+		}
+		if r.Value == nil {
+			return errors.ErrInvalidReplicaConfig.GenWithStackByArgs(
+				"the value of an add-column transform rule must be set")
+		}
+		switch *r.Value {
+		case ComputedColumnClusterID, ComputedColumnCommitTs, ComputedColumnCommitTime:
+		default:
+			return errors.ErrInvalidReplicaConfig.GenWithStackByArgs(
+				"unknown computed column source " + *r.Value)
+		}
+	default:
+		return errors.ErrInvalidReplicaConfig.GenWithStackByArgs(
+			"unknown transform action " + string(r.Action))
+	}
+	return nil
+}
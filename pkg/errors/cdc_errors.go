@@ -42,6 +42,10 @@ var (
 		"scheduler request failed, %s",
 		errors.RFCCodeText("CDC:ErrSchedulerRequestFailed"),
 	)
+	ErrPlacementConstraintUnsatisfiable = errors.Normalize(
+		"no alive capture satisfies the placement constraints of the changefeed, %d captures are alive",
+		errors.RFCCodeText("CDC:ErrPlacementConstraintUnsatisfiable"),
+	)
 	ErrGetAllStoresFailed = errors.Normalize(
 		"get stores from pd failed",
 		errors.RFCCodeText("CDC:ErrGetAllStoresFailed"),
@@ -139,6 +143,11 @@ var (
 		errors.RFCCodeText("CDC:ErrColumnSelectorFailed"),
 	)
 
+	ErrColumnTransformFailed = errors.Normalize(
+		"column transform failed",
+		errors.RFCCodeText("CDC:ErrColumnTransformFailed"),
+	)
+
 	// internal errors
 	ErrAdminStopProcessor = errors.Normalize(
 		"stop processor by admin command",
@@ -283,6 +292,49 @@ var (
 	ErrPulsarTopicNotExists = errors.Normalize("pulsar topic not exists after creation",
 		errors.RFCCodeText("CDC:ErrPulsarTopicNotExists"),
 	)
+	// for webhook
+	ErrWebhookInvalidConfig = errors.Normalize(
+		"webhook config invalid",
+		errors.RFCCodeText("CDC:ErrWebhookInvalidConfig"),
+	)
+	ErrWebhookSendMessage = errors.Normalize(
+		"webhook send message failed, status code %d, response: %s",
+		errors.RFCCodeText("CDC:ErrWebhookSendMessage"),
+	)
+	ErrWebhookRequest = errors.Normalize(
+		"webhook request failed",
+		errors.RFCCodeText("CDC:ErrWebhookRequest"),
+	)
+	// for elasticsearch
+	ErrElasticsearchInvalidConfig = errors.Normalize(
+		"elasticsearch config invalid",
+		errors.RFCCodeText("CDC:ErrElasticsearchInvalidConfig"),
+	)
+	ErrElasticsearchInvalidIndexExpression = errors.Normalize(
+		"invalid index expression: %s, %s",
+		errors.RFCCodeText("CDC:ErrElasticsearchInvalidIndexExpression"),
+	)
+	ErrElasticsearchBulkRequest = errors.Normalize(
+		"elasticsearch bulk request failed, status code %d, response: %s",
+		errors.RFCCodeText("CDC:ErrElasticsearchBulkRequest"),
+	)
+	ErrElasticsearchDeleteIndex = errors.Normalize(
+		"delete elasticsearch index %s failed",
+		errors.RFCCodeText("CDC:ErrElasticsearchDeleteIndex"),
+	)
+	// for redis
+	ErrRedisInvalidConfig = errors.Normalize(
+		"redis config invalid",
+		errors.RFCCodeText("CDC:ErrRedisInvalidConfig"),
+	)
+	ErrRedisWrite = errors.Normalize(
+		"write to redis failed",
+		errors.RFCCodeText("CDC:ErrRedisWrite"),
+	)
+	ErrDeadLetterWrite = errors.Normalize(
+		"write rows to the dead-letter queue failed",
+		errors.RFCCodeText("CDC:ErrDeadLetterWrite"),
+	)
 
 	ErrRedoConfigInvalid = errors.Normalize(
 		"redo log config invalid",
@@ -367,6 +419,22 @@ var (
 		"MySQL worker panic",
 		errors.RFCCodeText("CDC:ErrMySQLWorkerPanic"),
 	)
+	ErrPostgresTxnError = errors.Normalize(
+		"PostgreSQL txn error",
+		errors.RFCCodeText("CDC:ErrPostgresTxnError"),
+	)
+	ErrPostgresConnectionError = errors.Normalize(
+		"PostgreSQL connection error",
+		errors.RFCCodeText("CDC:ErrPostgresConnectionError"),
+	)
+	ErrPostgresInvalidConfig = errors.Normalize(
+		"PostgreSQL config invalid",
+		errors.RFCCodeText("CDC:ErrPostgresInvalidConfig"),
+	)
+	ErrPostgresUnsupportedType = errors.Normalize(
+		"column %s with type %s is not supported by PostgreSQL sink",
+		errors.RFCCodeText("CDC:ErrPostgresUnsupportedType"),
+	)
 	ErrAvroToEnvelopeError = errors.Normalize(
 		"to envelope failed",
 		errors.RFCCodeText("CDC:ErrAvroToEnvelopeError"),
@@ -439,6 +507,10 @@ var (
 		"csv decode failed",
 		errors.RFCCodeText("CDC:ErrCSVDecodeFailed"),
 	)
+	ErrParquetEncodeFailed = errors.Normalize(
+		"parquet encode failed",
+		errors.RFCCodeText("CDC:ErrParquetEncodeFailed"),
+	)
 	ErrDebeziumEncodeFailed = errors.Normalize(
 		"debezium encode failed",
 		errors.RFCCodeText("CDC:ErrDebeziumEncodeFailed"),
@@ -459,6 +531,14 @@ var (
 		"filename in storage sink is invalid",
 		errors.RFCCodeText("CDC:ErrStorageSinkInvalidFileName"),
 	)
+	ErrIcebergCommitFailed = errors.Normalize(
+		"commit iceberg table %s failed",
+		errors.RFCCodeText("CDC:ErrIcebergCommitFailed"),
+	)
+	ErrIcebergInvalidMetadata = errors.Normalize(
+		"iceberg metadata of table %s is invalid",
+		errors.RFCCodeText("CDC:ErrIcebergInvalidMetadata"),
+	)
 
 	// utilities related errors
 	ErrToTLSConfigFailed = errors.Normalize(
@@ -242,6 +242,23 @@ func (s *ChangefeedReactorState) SetError(lastError *model.RunningError) {
 	})
 }
 
+// SetScheduledPause sets the pause window the changefeed is in,
+// nil means the changefeed is not paused by any pause window.
+func (s *ChangefeedReactorState) SetScheduledPause(pause *model.ScheduledPause) {
+	s.PatchInfo(func(info *model.ChangeFeedInfo) (*model.ChangeFeedInfo, bool, error) {
+		if info == nil {
+			return nil, false, nil
+		}
+		old := info.ScheduledPause
+		if (old == nil && pause == nil) || (old != nil && pause != nil &&
+			old.Until.Equal(pause.Until) && old.Skipped == pause.Skipped) {
+			return info, false, nil
+		}
+		info.ScheduledPause = pause
+		return info, true, nil
+	})
+}
+
 // RemoveChangefeed removes the changefeed and clean the information and status.
 func (s *ChangefeedReactorState) RemoveChangefeed() {
 	// remove info
@@ -32,3 +32,15 @@ func QuoteName(name string) string {
 func EscapeName(name string) string {
 	return strings.Replace(name, "`", "``", -1)
 }
+
+// QuotePostgresSchema quotes a full table name with the identifier quote
+// character of PostgreSQL.
+func QuotePostgresSchema(schema string, table string) string {
+	return QuotePostgresName(schema) + "." + QuotePostgresName(table)
+}
+
+// QuotePostgresName wraps a name with `"`, and replaces all `"` in name with
+// double `"`.
+func QuotePostgresName(name string) string {
+	return `"` + strings.Replace(name, `"`, `""`, -1) + `"`
+}
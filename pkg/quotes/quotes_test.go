@@ -72,3 +72,22 @@ func TestEscapeName(t *testing.T) {
 		require.Equal(t, testCase.expected, escaped)
 	}
 }
+
+func TestQuotePostgresName(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		schema   string
+		table    string
+		expected string
+	}{
+		{"testdb", "t1", `"testdb"."t1"`},
+		{`test"db`, "t`1", `"test""db"."t` + "`" + `1"`},
+		{"", "", `"".""`},
+	}
+	for _, testCase := range cases {
+		name := QuotePostgresSchema(testCase.schema, testCase.table)
+		require.Equal(t, testCase.expected, name)
+	}
+	require.Equal(t, `"t""""bl"`, QuotePostgresName(`t""bl`))
+}
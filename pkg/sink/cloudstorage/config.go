@@ -60,6 +60,14 @@ const (
 	// Second | Minute | Hour | Dom | Month | DowOptional
 	// `0 0 2 * * ?` means 2:00:00 AM every day
 	defaultFileCleanupCronSpec = "0 0 2 * * *"
+
+	// defaultIcebergSnapshotRetention is the default value of
+	// iceberg-snapshot-retention. A snapshot is committed for each flush, so
+	// the snapshots are kept much shorter than the Iceberg default of 5 days.
+	defaultIcebergSnapshotRetention = time.Hour
+	// defaultIcebergMaxMetadataFiles is the default value of
+	// iceberg-max-metadata-files, the same as the Iceberg default.
+	defaultIcebergMaxMetadataFiles = 100
 )
 
 type urlConfig struct {
@@ -80,6 +88,11 @@ type Config struct {
 	EnablePartitionSeparator bool
 	OutputColumnID           bool
 	FlushConcurrency         int
+	// TableFormat is config.CloudStorageTableFormatIceberg if the data
+	// files are written as Iceberg tables, it is empty otherwise.
+	TableFormat              string
+	IcebergSnapshotRetention time.Duration
+	IcebergMaxMetadataFiles  int
 }
 
 // NewConfig returns the default cloud storage sink config.
@@ -90,6 +103,9 @@ func NewConfig() *Config {
 		FileSize:            defaultFileSize,
 		FileExpirationDays:  defaultFileExpirationDays,
 		FileCleanupCronSpec: defaultFileCleanupCronSpec,
+
+		IcebergSnapshotRetention: defaultIcebergSnapshotRetention,
+		IcebergMaxMetadataFiles:  defaultIcebergMaxMetadataFiles,
 	}
 }
 
@@ -141,6 +157,13 @@ func (c *Config) Apply(
 			c.FileCleanupCronSpec = *replicaConfig.Sink.CloudStorageConfig.FileCleanupCronSpec
 		}
 		c.FlushConcurrency = util.GetOrZero(replicaConfig.Sink.CloudStorageConfig.FlushConcurrency)
+		c.TableFormat = util.GetOrZero(replicaConfig.Sink.CloudStorageConfig.TableFormat)
+		if err = getIcebergRetention(replicaConfig.Sink.CloudStorageConfig, c); err != nil {
+			return err
+		}
+	}
+	if err = validateTableFormat(c.TableFormat, replicaConfig); err != nil {
+		return err
 	}
 
 	if c.FileIndexWidth < config.MinFileIndexWidth || c.FileIndexWidth > config.MaxFileIndexWidth {
@@ -153,6 +176,58 @@ func (c *Config) Apply(
 	return nil
 }
 
+// validateTableFormat checks that the changes can be applied to the tables.
+// Iceberg tables identify rows by the handle key, so updates which change the
+// handle key must be split, and tables without a handle key are not allowed.
+func validateTableFormat(format string, replicaConfig *config.ReplicaConfig) error {
+	switch format {
+	case "":
+		return nil
+	case config.CloudStorageTableFormatIceberg:
+	default:
+		return cerror.ErrStorageSinkInvalidConfig.GenWithStack(
+			"unsupported table-format %s", format)
+	}
+	protocol, _ := config.ParseSinkProtocolFromString(util.GetOrZero(replicaConfig.Sink.Protocol))
+	if protocol != config.ProtocolParquet {
+		return cerror.ErrStorageSinkInvalidConfig.GenWithStack(
+			"table-format %s requires the %s protocol", format, config.ProtocolParquet)
+	}
+	if replicaConfig.Sink.CloudStorageConfig.GetOutputRawChangeEvent() {
+		return cerror.ErrStorageSinkInvalidConfig.GenWithStack(
+			"table-format %s is incompatible with output-raw-change-event", format)
+	}
+	if replicaConfig.ForceReplicate {
+		return cerror.ErrStorageSinkInvalidConfig.GenWithStack(
+			"table-format %s is incompatible with force-replicate", format)
+	}
+	return nil
+}
+
+// getIcebergRetention gets the retention of the snapshots and the metadata
+// files of the Iceberg tables.
+func getIcebergRetention(cfg *config.CloudStorageConfig, c *Config) error {
+	if cfg.IcebergSnapshotRetention != nil {
+		d, err := time.ParseDuration(*cfg.IcebergSnapshotRetention)
+		if err != nil {
+			return cerror.WrapError(cerror.ErrStorageSinkInvalidConfig, err)
+		}
+		if d < 0 {
+			return cerror.ErrStorageSinkInvalidConfig.GenWithStack(
+				"iceberg-snapshot-retention %s is negative", d)
+		}
+		c.IcebergSnapshotRetention = d
+	}
+	if cfg.IcebergMaxMetadataFiles != nil {
+		if *cfg.IcebergMaxMetadataFiles < 0 {
+			return cerror.ErrStorageSinkInvalidConfig.GenWithStack(
+				"iceberg-max-metadata-files %d is negative", *cfg.IcebergMaxMetadataFiles)
+		}
+		c.IcebergMaxMetadataFiles = *cfg.IcebergMaxMetadataFiles
+	}
+	return nil
+}
+
 func mergeConfig(
 	replicaConfig *config.ReplicaConfig,
 	urlParameters *urlConfig,
@@ -46,6 +46,78 @@ func TestConfigApply(t *testing.T) {
 	require.Equal(t, expected, cfg)
 }
 
+func TestConfigApplyTableFormat(t *testing.T) {
+	sinkURI, err := url.Parse("file:///tmp/warehouse?protocol=parquet")
+	require.NoError(t, err)
+
+	newReplicaConfig := func(format string) *config.ReplicaConfig {
+		replicaConfig := config.GetDefaultReplicaConfig()
+		replicaConfig.Sink.CloudStorageConfig = &config.CloudStorageConfig{
+			TableFormat: aws.String(format),
+		}
+		require.NoError(t, replicaConfig.ValidateAndAdjust(sinkURI))
+		return replicaConfig
+	}
+
+	cfg := NewConfig()
+	require.NoError(t, cfg.Apply(context.TODO(), sinkURI, newReplicaConfig("iceberg")))
+	require.Equal(t, config.CloudStorageTableFormatIceberg, cfg.TableFormat)
+
+	err = NewConfig().Apply(context.TODO(), sinkURI, newReplicaConfig("delta"))
+	require.ErrorContains(t, err, "unsupported table-format delta")
+
+	replicaConfig := newReplicaConfig("iceberg")
+	replicaConfig.ForceReplicate = true
+	err = NewConfig().Apply(context.TODO(), sinkURI, replicaConfig)
+	require.ErrorContains(t, err, "incompatible with force-replicate")
+
+	replicaConfig = newReplicaConfig("iceberg")
+	replicaConfig.Sink.CloudStorageConfig.OutputRawChangeEvent = aws.Bool(true)
+	err = NewConfig().Apply(context.TODO(), sinkURI, replicaConfig)
+	require.ErrorContains(t, err, "incompatible with output-raw-change-event")
+
+	csvURI, err := url.Parse("file:///tmp/warehouse?protocol=csv")
+	require.NoError(t, err)
+	replicaConfig = config.GetDefaultReplicaConfig()
+	replicaConfig.Sink.CloudStorageConfig = &config.CloudStorageConfig{
+		TableFormat: aws.String("iceberg"),
+	}
+	require.NoError(t, replicaConfig.ValidateAndAdjust(csvURI))
+	err = NewConfig().Apply(context.TODO(), csvURI, replicaConfig)
+	require.ErrorContains(t, err, "requires the parquet protocol")
+}
+
+func TestConfigApplyIcebergRetention(t *testing.T) {
+	sinkURI, err := url.Parse("file:///tmp/warehouse?protocol=parquet")
+	require.NoError(t, err)
+
+	replicaConfig := config.GetDefaultReplicaConfig()
+	replicaConfig.Sink.CloudStorageConfig = &config.CloudStorageConfig{
+		TableFormat: aws.String("iceberg"),
+	}
+	require.NoError(t, replicaConfig.ValidateAndAdjust(sinkURI))
+	cfg := NewConfig()
+	require.NoError(t, cfg.Apply(context.TODO(), sinkURI, replicaConfig))
+	require.Equal(t, defaultIcebergSnapshotRetention, cfg.IcebergSnapshotRetention)
+	require.Equal(t, defaultIcebergMaxMetadataFiles, cfg.IcebergMaxMetadataFiles)
+
+	replicaConfig.Sink.CloudStorageConfig.IcebergSnapshotRetention = aws.String("10m")
+	replicaConfig.Sink.CloudStorageConfig.IcebergMaxMetadataFiles = aws.Int(10)
+	cfg = NewConfig()
+	require.NoError(t, cfg.Apply(context.TODO(), sinkURI, replicaConfig))
+	require.Equal(t, 10*time.Minute, cfg.IcebergSnapshotRetention)
+	require.Equal(t, 10, cfg.IcebergMaxMetadataFiles)
+
+	replicaConfig.Sink.CloudStorageConfig.IcebergSnapshotRetention = aws.String("-1h")
+	err = NewConfig().Apply(context.TODO(), sinkURI, replicaConfig)
+	require.ErrorContains(t, err, "iceberg-snapshot-retention -1h0m0s is negative")
+
+	replicaConfig.Sink.CloudStorageConfig.IcebergSnapshotRetention = aws.String("1h")
+	replicaConfig.Sink.CloudStorageConfig.IcebergMaxMetadataFiles = aws.Int(-1)
+	err = NewConfig().Apply(context.TODO(), sinkURI, replicaConfig)
+	require.ErrorContains(t, err, "iceberg-max-metadata-files -1 is negative")
+}
+
 func TestVerifySinkURIParams(t *testing.T) {
 	testCases := []struct {
 		name        string
@@ -389,7 +389,9 @@ var type2TiDBType = map[byte]string{
 	mysql.TypeTiDBVectorFloat32: "TiDBVECTORFloat32",
 }
 
-func getTiDBTypeFromColumn(col model.ColumnDataX) string {
+// GetTiDBTypeFromColumn returns the TiDB type name of the column,
+// which is carried in the schema to restore the column type.
+func GetTiDBTypeFromColumn(col model.ColumnDataX) string {
 	tt := type2TiDBType[col.GetType()]
 	if col.GetFlag().IsUnsigned() && (tt == "INT" || tt == "BIGINT") {
 		return tt + " UNSIGNED"
@@ -400,7 +402,8 @@ func getTiDBTypeFromColumn(col model.ColumnDataX) string {
 	return tt
 }
 
-func flagFromTiDBType(tp string) model.ColumnFlagType {
+// FlagFromTiDBType returns the column flag implied by the TiDB type name.
+func FlagFromTiDBType(tp string) model.ColumnFlagType {
 	var flag model.ColumnFlagType
 	if strings.Contains(tp, "UNSIGNED") {
 		flag.SetIsUnsigned()
@@ -408,7 +411,8 @@ func flagFromTiDBType(tp string) model.ColumnFlagType {
 	return flag
 }
 
-func mysqlTypeFromTiDBType(tidbType string) byte {
+// MySQLTypeFromTiDBType returns the MySQL type of the TiDB type name.
+func MySQLTypeFromTiDBType(tidbType string) byte {
 	var result byte
 	switch tidbType {
 	case "INT", "INT UNSIGNED":
@@ -643,7 +647,7 @@ func (a *BatchEncoder) columns2AvroData(input avroEncodeInput) (map[string]inter
 }
 
 func (a *BatchEncoder) columnToAvroSchema(col model.ColumnDataX) (interface{}, error) {
-	tt := getTiDBTypeFromColumn(col)
+	tt := GetTiDBTypeFromColumn(col)
 
 	switch col.GetType() {
 	case mysql.TypeTiny, mysql.TypeShort, mysql.TypeInt24:
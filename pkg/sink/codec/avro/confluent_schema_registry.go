@@ -56,8 +56,8 @@ type confluentSchemaManager struct {
 
 type registerRequest struct {
 	Schema string `json:"schema"`
-	// Commented out for compatibility with Confluent 5.4.x
-	// SchemaType string `json:"schemaType"`
+	// SchemaType is omitted for Avro schemas for compatibility with Confluent 5.4.x
+	SchemaType string `json:"schemaType,omitempty"`
 }
 
 type registerResponse struct {
@@ -78,26 +78,40 @@ func NewConfluentSchemaManager(
 	credential *security.Credential,
 ) (SchemaManager, error) {
 	registryURL = strings.TrimRight(registryURL, "/")
+	if err := CheckConfluentSchemaRegistry(ctx, registryURL, credential); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &confluentSchemaManager{
+		registryURL:  registryURL,
+		cache:        make(map[string]*schemaCacheEntry, 1),
+		registryType: common.SchemaRegistryTypeConfluent,
+	}, nil
+}
+
+// CheckConfluentSchemaRegistry tests the connectivity to the Confluent Schema Registry.
+func CheckConfluentSchemaRegistry(
+	ctx context.Context, registryURL string, credential *security.Credential,
+) error {
 	httpCli, err := httputil.NewClient(credential)
 	if err != nil {
-		return nil, errors.Trace(err)
+		return errors.Trace(err)
 	}
 	resp, err := httpCli.Get(ctx, registryURL)
 	if err != nil {
 		log.Error("Test connection to Schema Registry failed", zap.Error(err))
-		return nil, cerror.WrapError(cerror.ErrAvroSchemaAPIError, err)
+		return cerror.WrapError(cerror.ErrAvroSchemaAPIError, err)
 	}
 	defer resp.Body.Close()
 
 	text, err := io.ReadAll(resp.Body)
 	if err != nil {
 		log.Error("Reading response from Schema Registry failed", zap.Error(err))
-		return nil, cerror.WrapError(cerror.ErrAvroSchemaAPIError, err)
+		return cerror.WrapError(cerror.ErrAvroSchemaAPIError, err)
 	}
 
 	if string(text[:]) != "{}" {
 		log.Error("Unexpected response from Schema Registry", zap.ByteString("response", text))
-		return nil, cerror.ErrAvroSchemaAPIError.GenWithStack(
+		return cerror.ErrAvroSchemaAPIError.GenWithStack(
 			"Unexpected response from Schema Registry",
 		)
 	}
@@ -106,12 +120,7 @@ func NewConfluentSchemaManager(
 		"Successfully tested connectivity to Schema Registry",
 		zap.String("registryURL", registryURL),
 	)
-
-	return &confluentSchemaManager{
-		registryURL:  registryURL,
-		cache:        make(map[string]*schemaCacheEntry, 1),
-		registryType: common.SchemaRegistryTypeConfluent,
-	}, nil
+	return nil
 }
 
 // Register a schema in schema registry, no cache
@@ -130,21 +139,39 @@ func (m *confluentSchemaManager) Register(
 		log.Error("Could not compact schema", zap.Error(err))
 		return id, cerror.WrapError(cerror.ErrAvroSchemaAPIError, err)
 	}
+	id.confluentSchemaID, err = RegisterConfluentSchema(
+		ctx, m.registryURL, m.credential, schemaName, "", buffer.String())
+	if err != nil {
+		return id, errors.Trace(err)
+	}
+	return id, nil
+}
+
+// RegisterConfluentSchema registers the schema under the subject in the
+// Confluent Schema Registry, and returns the schema ID. The schemaType is
+// left empty for Avro schemas, which is the default type of the registry.
+func RegisterConfluentSchema(
+	ctx context.Context,
+	registryURL string,
+	credential *security.Credential,
+	subject, schemaType, schema string,
+) (int, error) {
 	reqBody := registerRequest{
-		Schema: buffer.String(),
+		Schema:     schema,
+		SchemaType: schemaType,
 	}
 	payload, err := json.Marshal(&reqBody)
 	if err != nil {
 		log.Error("Could not marshal request to the Registry", zap.Error(err))
-		return id, cerror.WrapError(cerror.ErrAvroSchemaAPIError, err)
+		return 0, cerror.WrapError(cerror.ErrAvroSchemaAPIError, err)
 	}
-	uri := m.registryURL + "/subjects/" + url.QueryEscape(schemaName) + "/versions"
+	uri := registryURL + "/subjects/" + url.QueryEscape(subject) + "/versions"
 	log.Info("Registering schema", zap.String("uri", uri), zap.ByteString("payload", payload))
 
 	req, err := http.NewRequestWithContext(ctx, "POST", uri, bytes.NewReader(payload))
 	if err != nil {
 		log.Error("Failed to NewRequestWithContext", zap.Error(err))
-		return id, cerror.WrapError(cerror.ErrAvroSchemaAPIError, err)
+		return 0, cerror.WrapError(cerror.ErrAvroSchemaAPIError, err)
 	}
 	req.Header.Add(
 		"Accept",
@@ -152,16 +179,16 @@ func (m *confluentSchemaManager) Register(
 			"application/json",
 	)
 	req.Header.Add("Content-Type", "application/vnd.schemaregistry.v1+json")
-	resp, err := httpRetry(ctx, m.credential, req)
+	resp, err := httpRetry(ctx, credential, req)
 	if err != nil {
-		return id, err
+		return 0, err
 	}
 	defer resp.Body.Close()
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		log.Error("Failed to read response from Registry", zap.Error(err))
-		return id, cerror.WrapError(cerror.ErrAvroSchemaAPIError, err)
+		return 0, cerror.WrapError(cerror.ErrAvroSchemaAPIError, err)
 	}
 
 	if resp.StatusCode != 200 {
@@ -175,18 +202,18 @@ func (m *confluentSchemaManager) Register(
 			zap.ByteString("requestBody", payload),
 			zap.ByteString("responseBody", body),
 		)
-		return id, cerror.ErrAvroSchemaAPIError.GenWithStackByArgs()
+		return 0, cerror.ErrAvroSchemaAPIError.GenWithStackByArgs()
 	}
 
 	var jsonResp registerResponse
 	err = json.Unmarshal(body, &jsonResp)
 	if err != nil {
 		log.Error("Failed to parse result from Registry", zap.Error(err))
-		return id, cerror.WrapError(cerror.ErrAvroSchemaAPIError, err)
+		return 0, cerror.WrapError(cerror.ErrAvroSchemaAPIError, err)
 	}
 
 	if jsonResp.SchemaID == 0 {
-		return id, cerror.ErrAvroSchemaAPIError.GenWithStack(
+		return 0, cerror.ErrAvroSchemaAPIError.GenWithStack(
 			"Illegal schema ID returned from Registry %d",
 			jsonResp.SchemaID,
 		)
@@ -196,9 +223,7 @@ func (m *confluentSchemaManager) Register(
 		zap.Int("schemaID", jsonResp.SchemaID),
 		zap.String("uri", uri),
 		zap.ByteString("body", body))
-
-	id.confluentSchemaID = jsonResp.SchemaID
-	return id, nil
+	return jsonResp.SchemaID, nil
 }
 
 // Lookup the cached schema entry first, if not found, fetch from the Registry server.
@@ -215,12 +240,46 @@ func (m *confluentSchemaManager) Lookup(
 	}
 	m.cacheRWLock.RUnlock()
 
-	uri := m.registryURL + "/schemas/ids/" + strconv.Itoa(schemaID.confluentSchemaID)
+	schema, err := LookupConfluentSchema(ctx, m.registryURL, m.credential, schemaID.confluentSchemaID)
+	if err != nil {
+		log.Warn("Lookup schema from the Registry failed",
+			zap.String("key", schemaName),
+			zap.Int("schemaID", schemaID.confluentSchemaID))
+		return nil, errors.Trace(err)
+	}
+
+	cacheEntry := new(schemaCacheEntry)
+	codec, err := GenCodec(schema)
+	if err != nil {
+		log.Error("Creating Avro codec failed", zap.Error(err))
+		return nil, cerror.WrapError(cerror.ErrAvroSchemaAPIError, err)
+	}
+	cacheEntry.codec = codec
+	cacheEntry.schemaID.confluentSchemaID = schemaID.confluentSchemaID
+	cacheEntry.header, err = m.getMsgHeader(schemaID.confluentSchemaID)
+	if err != nil {
+		return nil, err
+	}
+
+	m.cacheRWLock.Lock()
+	m.cache[schemaName] = cacheEntry
+	m.cacheRWLock.Unlock()
+	return cacheEntry.codec, nil
+}
+
+// LookupConfluentSchema fetches the schema of the given ID from the Confluent Schema Registry.
+func LookupConfluentSchema(
+	ctx context.Context,
+	registryURL string,
+	credential *security.Credential,
+	id int,
+) (string, error) {
+	uri := registryURL + "/schemas/ids/" + strconv.Itoa(id)
 
 	req, err := http.NewRequestWithContext(ctx, "GET", uri, nil)
 	if err != nil {
 		log.Error("Error constructing request for Registry lookup", zap.Error(err))
-		return nil, cerror.WrapError(cerror.ErrAvroSchemaAPIError, err)
+		return "", cerror.WrapError(cerror.ErrAvroSchemaAPIError, err)
 	}
 	req.Header.Add(
 		"Accept",
@@ -228,16 +287,16 @@ func (m *confluentSchemaManager) Lookup(
 			"application/json",
 	)
 
-	resp, err := httpRetry(ctx, m.credential, req)
+	resp, err := httpRetry(ctx, credential, req)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 	defer resp.Body.Close()
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		log.Error("Failed to parse result from Registry", zap.Error(err))
-		return nil, cerror.WrapError(cerror.ErrAvroSchemaAPIError, err)
+		return "", cerror.WrapError(cerror.ErrAvroSchemaAPIError, err)
 	}
 
 	if resp.StatusCode != 200 && resp.StatusCode != 404 {
@@ -245,16 +304,14 @@ func (m *confluentSchemaManager) Lookup(
 			zap.Int("status", resp.StatusCode),
 			zap.String("uri", uri),
 			zap.ByteString("responseBody", body))
-		return nil, cerror.ErrAvroSchemaAPIError.GenWithStack(
+		return "", cerror.ErrAvroSchemaAPIError.GenWithStack(
 			"Failed to query schema from the Registry, HTTP error",
 		)
 	}
 
 	if resp.StatusCode == 404 {
-		log.Warn("Specified schema not found in Registry",
-			zap.String("key", schemaName),
-			zap.Int("schemaID", schemaID.confluentSchemaID))
-		return nil, cerror.ErrAvroSchemaAPIError.GenWithStackByArgs(
+		log.Warn("Specified schema not found in Registry", zap.Int("schemaID", id))
+		return "", cerror.ErrAvroSchemaAPIError.GenWithStackByArgs(
 			"Schema not found in Registry",
 		)
 	}
@@ -263,26 +320,9 @@ func (m *confluentSchemaManager) Lookup(
 	err = json.Unmarshal(body, &jsonResp)
 	if err != nil {
 		log.Error("Failed to parse result from Registry", zap.Error(err))
-		return nil, cerror.WrapError(cerror.ErrAvroSchemaAPIError, err)
+		return "", cerror.WrapError(cerror.ErrAvroSchemaAPIError, err)
 	}
-
-	cacheEntry := new(schemaCacheEntry)
-	codec, err := GenCodec(jsonResp.Schema)
-	if err != nil {
-		log.Error("Creating Avro codec failed", zap.Error(err))
-		return nil, cerror.WrapError(cerror.ErrAvroSchemaAPIError, err)
-	}
-	cacheEntry.codec = codec
-	cacheEntry.schemaID.confluentSchemaID = schemaID.confluentSchemaID
-	cacheEntry.header, err = m.getMsgHeader(schemaID.confluentSchemaID)
-	if err != nil {
-		return nil, err
-	}
-
-	m.cacheRWLock.Lock()
-	m.cache[schemaName] = cacheEntry
-	m.cacheRWLock.Unlock()
-	return cacheEntry.codec, nil
+	return jsonResp.Schema, nil
 }
 
 // GetCachedOrRegister checks if the suitable Avro schema has been cached.
@@ -216,9 +216,9 @@ func assembleEvent(
 		}
 		tidbType := holder["tidb_type"].(string)
 
-		mysqlType := mysqlTypeFromTiDBType(tidbType)
+		mysqlType := MySQLTypeFromTiDBType(tidbType)
 
-		flag := flagFromTiDBType(tidbType)
+		flag := FlagFromTiDBType(tidbType)
 		if _, ok := keyMap[colName]; ok {
 			flag.SetIsHandleKey()
 			flag.SetIsPrimaryKey()
@@ -413,7 +413,7 @@ func extractGlueSchemaIDAndBinaryData(data []byte) (string, []byte, error) {
 		return "", nil, errors.ErrAvroInvalidMessage.
 			FastGenByArgs("compression byte is not match, it should be %d", compressionDefaultByte)
 	}
-	id, err := getGlueSchemaIDFromHeader(data[0:18])
+	id, err := GlueSchemaIDFromHeader(data[0:18])
 	if err != nil {
 		return "", nil, errors.Trace(err)
 	}
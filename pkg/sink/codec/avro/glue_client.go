@@ -20,6 +20,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/glue/types"
 	"github.com/google/uuid"
 	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
 )
 
 // glueClient is a partial interface of glue client, used to mock glue client in unit test
@@ -38,6 +39,18 @@ type glueClient interface {
 		optFns ...func(*glue.Options)) (*glue.GetSchemaVersionOutput, error)
 }
 
+// NewMockGlueSchemaRegistry creates a GlueSchemaRegistry backed by an in-memory
+// glue client, it's only used in tests.
+func NewMockGlueSchemaRegistry(dataFormat types.DataFormat) *GlueSchemaRegistry {
+	return &GlueSchemaRegistry{m: &glueSchemaManager{
+		registryName: "mock_registry",
+		client:       newMockGlueClientImpl(),
+		cache:        make(map[string]*schemaCacheEntry),
+		registryType: common.SchemaRegistryTypeGlue,
+		dataFormat:   dataFormat,
+	}}
+}
+
 type mockGlueClientImpl struct {
 	createSchemaInput           map[string]*glue.CreateSchemaInput          // schemaName -> schema
 	registerSchemaVersionsInput map[string]*glue.RegisterSchemaVersionInput // schemaName -> schema
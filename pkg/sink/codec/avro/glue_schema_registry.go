@@ -46,6 +46,8 @@ type glueSchemaManager struct {
 	cacheRWLock  sync.RWMutex
 	cache        map[string]*schemaCacheEntry
 	registryType string
+	// dataFormat is the format of the schemas created by the manager.
+	dataFormat types.DataFormat
 }
 
 // NewGlueSchemaManager creates a new schema manager for AWS Glue Schema Registry
@@ -55,6 +57,14 @@ func NewGlueSchemaManager(
 	ctx context.Context,
 	cfg *config.GlueSchemaRegistryConfig,
 ) (SchemaManager, error) {
+	return newGlueSchemaManager(ctx, cfg, types.DataFormatAvro)
+}
+
+func newGlueSchemaManager(
+	ctx context.Context,
+	cfg *config.GlueSchemaRegistryConfig,
+	dataFormat types.DataFormat,
+) (*glueSchemaManager, error) {
 	var awsCfg aws.Config
 	var err error
 	if cfg.NoCredentials() {
@@ -75,6 +85,7 @@ func NewGlueSchemaManager(
 		client:       client,
 		cache:        make(map[string]*schemaCacheEntry),
 		registryType: common.SchemaRegistryTypeGlue,
+		dataFormat:   dataFormat,
 	}
 	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
@@ -260,7 +271,7 @@ func (m *glueSchemaManager) createSchema(ctx context.Context, schemaName, schema
 			RegistryName: &m.registryName,
 		},
 		SchemaName:       aws.String(schemaName),
-		DataFormat:       types.DataFormatAvro,
+		DataFormat:       m.dataFormat,
 		SchemaDefinition: aws.String(schemaDefinition),
 		// cdc don't need to set compatibility check for schema registry
 		// TiDB do the schema compatibility check for us, we need to accept all schema changes
@@ -330,9 +341,18 @@ func (m *glueSchemaManager) getSchemaByID(ctx context.Context, schemaID string)
 const (
 	headerVersionByte      = uint8(3) // 3 is fixed for the glue message
 	compressionDefaultByte = uint8(0) // 0  no compression
+
+	// glueMsgHeaderLen is the length of the header, which is made up of the
+	// version byte, the compression byte and the 16 bytes schema version ID.
+	glueMsgHeaderLen = 18
 )
 
 func (m *glueSchemaManager) getMsgHeader(schemaID string) ([]byte, error) {
+	return GlueMsgHeader(schemaID)
+}
+
+// GlueMsgHeader returns the header of the glue message encoded with the schema version.
+func GlueMsgHeader(schemaID string) ([]byte, error) {
 	header := []byte{}
 	header = append(header, headerVersionByte)
 	header = append(header, compressionDefaultByte)
@@ -344,10 +364,55 @@ func (m *glueSchemaManager) getMsgHeader(schemaID string) ([]byte, error) {
 	return header, nil
 }
 
-func getGlueSchemaIDFromHeader(header []byte) (string, error) {
-	if len(header) < 18 {
+// GlueSchemaIDFromHeader returns the schema version ID in the header of the glue message.
+func GlueSchemaIDFromHeader(header []byte) (string, error) {
+	if len(header) < glueMsgHeaderLen {
 		return "", cerror.ErrDecodeFailed.GenWithStackByArgs("header is too short")
 	}
 	uuid := uuid.UUID(header[2:18])
 	return uuid.String(), nil
 }
+
+// GlueSchemaRegistry registers and looks up the schemas in the AWS Glue Schema
+// Registry, it's used by the codecs which don't use avro schemas.
+type GlueSchemaRegistry struct {
+	m *glueSchemaManager
+}
+
+// NewGlueSchemaRegistry creates a GlueSchemaRegistry for the schemas in dataFormat.
+func NewGlueSchemaRegistry(
+	ctx context.Context,
+	cfg *config.GlueSchemaRegistryConfig,
+	dataFormat types.DataFormat,
+) (*GlueSchemaRegistry, error) {
+	m, err := newGlueSchemaManager(ctx, cfg, dataFormat)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &GlueSchemaRegistry{m: m}, nil
+}
+
+// Register registers the schema definition with the schema name,
+// and returns the schema version ID.
+func (r *GlueSchemaRegistry) Register(
+	ctx context.Context, schemaName, schemaDefinition string,
+) (string, error) {
+	id, err := r.m.Register(ctx, schemaName, schemaDefinition)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	return id.glueSchemaID, nil
+}
+
+// Lookup returns the schema definition of the schema version ID.
+func (r *GlueSchemaRegistry) Lookup(ctx context.Context, schemaID string) (string, error) {
+	ok, schema, err := r.m.getSchemaByID(ctx, schemaID)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	if !ok {
+		return "", cerror.ErrAvroSchemaAPIError.
+			GenWithStackByArgs("schema not found in registry, id: %s", schemaID)
+	}
+	return schema, nil
+}
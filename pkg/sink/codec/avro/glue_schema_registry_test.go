@@ -17,6 +17,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/service/glue/types"
 	"github.com/pingcap/tiflow/pkg/sink/codec/common"
 	"github.com/stretchr/testify/require"
 )
@@ -27,6 +28,7 @@ func newClueSchemaManagerForTest() *glueSchemaManager {
 		client:       newMockGlueClientImpl(),
 		cache:        make(map[string]*schemaCacheEntry),
 		registryType: common.SchemaRegistryTypeGlue,
+		dataFormat:   types.DataFormatAvro,
 	}
 	return res
 }
@@ -109,7 +111,7 @@ func TestGlueSchemaManager_getMsgHeader(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, header[0], headerVersionByte)
 	require.Equal(t, header[1], compressionDefaultByte)
-	sid, err := getGlueSchemaIDFromHeader(header)
+	sid, err := GlueSchemaIDFromHeader(header)
 	require.NoError(t, err)
 	require.Equal(t, schemaID.glueSchemaID, sid)
 }
@@ -27,6 +27,8 @@ import (
 	"github.com/pingcap/tiflow/pkg/sink/codec/debezium"
 	"github.com/pingcap/tiflow/pkg/sink/codec/maxwell"
 	"github.com/pingcap/tiflow/pkg/sink/codec/open"
+	"github.com/pingcap/tiflow/pkg/sink/codec/parquet"
+	"github.com/pingcap/tiflow/pkg/sink/codec/protobuf"
 	"github.com/pingcap/tiflow/pkg/sink/codec/simple"
 )
 
@@ -42,6 +44,8 @@ func NewRowEventEncoderBuilder(
 		return canal.NewBatchEncoderBuilder(cfg), nil
 	case config.ProtocolAvro:
 		return avro.NewBatchEncoderBuilder(ctx, cfg)
+	case config.ProtocolProtobuf:
+		return protobuf.NewBatchEncoderBuilder(ctx, cfg)
 	case config.ProtocolMaxwell:
 		return maxwell.NewBatchEncoderBuilder(cfg), nil
 	case config.ProtocolCanalJSON:
@@ -66,7 +70,20 @@ func NewTxnEventEncoderBuilder(
 		return csv.NewTxnEventEncoderBuilder(c), nil
 	case config.ProtocolCanalJSON:
 		return canal.NewJSONTxnEventEncoderBuilder(c), nil
+	case config.ProtocolParquet:
+		return parquet.NewTxnEventEncoderBuilder(c), nil
 	default:
 		return nil, cerror.ErrSinkUnknownProtocol.GenWithStackByArgs(c.Protocol)
 	}
 }
+
+// NewFileEncoder returns a FileEncoder for columnar protocols. Nil is returned
+// for other protocols, whose messages can be concatenated into a data file.
+func NewFileEncoder(c *common.Config) codec.FileEncoder {
+	switch c.Protocol {
+	case config.ProtocolParquet:
+		return parquet.NewFileEncoder(c)
+	default:
+		return nil
+	}
+}
@@ -210,7 +210,8 @@ func (c *Config) Apply(sinkURI *url.URL, replicaConfig *config.ReplicaConfig) er
 		c.AvroBigintUnsignedHandlingMode = *urlParameter.AvroBigintUnsignedHandlingMode
 	}
 	if urlParameter.AvroEnableWatermark != nil {
-		if c.EnableTiDBExtension && c.Protocol == config.ProtocolAvro {
+		if c.EnableTiDBExtension &&
+			(c.Protocol == config.ProtocolAvro || c.Protocol == config.ProtocolProtobuf) {
 			c.AvroEnableWatermark = *urlParameter.AvroEnableWatermark
 		}
 	}
@@ -225,6 +226,10 @@ func (c *Config) Apply(sinkURI *url.URL, replicaConfig *config.ReplicaConfig) er
 		return cerror.ErrCodecInvalidConfig.GenWithStack(
 			`force-replicate must be disabled, when using avro protocol`)
 	}
+	if c.Protocol == config.ProtocolProtobuf && replicaConfig.ForceReplicate {
+		return cerror.ErrCodecInvalidConfig.GenWithStack(
+			`force-replicate must be disabled, when using protobuf protocol`)
+	}
 
 	if replicaConfig.Sink != nil {
 		c.Terminator = util.GetOrZero(replicaConfig.Sink.Terminator)
@@ -343,13 +348,31 @@ func (c *Config) WithChangefeedID(id model.ChangeFeedID) *Config {
 // Validate the Config
 func (c *Config) Validate() error {
 	if c.EnableTiDBExtension &&
-		!(c.Protocol == config.ProtocolCanalJSON || c.Protocol == config.ProtocolAvro || c.Protocol == config.ProtocolDebezium) {
+		!(c.Protocol == config.ProtocolCanalJSON || c.Protocol == config.ProtocolAvro ||
+			c.Protocol == config.ProtocolDebezium || c.Protocol == config.ProtocolProtobuf) {
 		log.Warn("ignore invalid config, enable-tidb-extension"+
-			"only supports canal-json/avro/debezium protocol",
+			"only supports canal-json/avro/debezium/protobuf protocol",
 			zap.Bool("enableTidbExtension", c.EnableTiDBExtension),
 			zap.String("protocol", c.Protocol.String()))
 	}
 
+	if c.Protocol == config.ProtocolProtobuf {
+		if c.AvroConfluentSchemaRegistry != "" && c.AvroGlueSchemaRegistry != nil {
+			return cerror.ErrCodecInvalidConfig.GenWithStack(
+				`Protobuf protocol requires only one of "%s" or "%s" to specify the schema registry`,
+				codecOPTAvroSchemaRegistry,
+				coderOPTAvroGlueSchemaRegistry,
+			)
+		}
+		if c.AvroConfluentSchemaRegistry == "" && c.AvroGlueSchemaRegistry == nil {
+			return cerror.ErrCodecInvalidConfig.GenWithStack(
+				`Protobuf protocol requires parameter "%s" or "%s" to specify the schema registry`,
+				codecOPTAvroSchemaRegistry,
+				coderOPTAvroGlueSchemaRegistry,
+			)
+		}
+	}
+
 	if c.Protocol == config.ProtocolAvro {
 		if c.AvroConfluentSchemaRegistry != "" && c.AvroGlueSchemaRegistry != nil {
 			return cerror.ErrCodecInvalidConfig.GenWithStack(
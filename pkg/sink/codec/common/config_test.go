@@ -175,6 +175,46 @@ func TestAvroForceReplicate(t *testing.T) {
 	require.ErrorIs(t, err, cerror.ErrCodecInvalidConfig)
 }
 
+func TestProtobufConfig(t *testing.T) {
+	t.Parallel()
+
+	replicaConfig := config.GetDefaultReplicaConfig()
+	uri := "kafka://127.0.0.1:9092/abc?protocol=protobuf&enable-tidb-extension=true"
+	sinkURI, err := url.Parse(uri)
+	require.NoError(t, err)
+
+	c := NewConfig(config.ProtocolProtobuf)
+	require.NoError(t, c.Apply(sinkURI, replicaConfig))
+	require.True(t, c.EnableTiDBExtension)
+	// `schema-registry` not set
+	err = c.Validate()
+	require.ErrorContains(t, err, `Protobuf protocol requires parameter "schema-registry"`)
+
+	replicaConfig.Sink.SchemaRegistry = util.AddressOf("this-is-a-uri")
+	require.NoError(t, c.Apply(sinkURI, replicaConfig))
+	require.NoError(t, c.Validate())
+
+	// both the confluent and the glue schema registry are set
+	replicaConfig.Sink.KafkaConfig = &config.KafkaConfig{
+		GlueSchemaRegistryConfig: &config.GlueSchemaRegistryConfig{RegistryName: "registry"},
+	}
+	require.NoError(t, c.Apply(sinkURI, replicaConfig))
+	require.ErrorIs(t, c.Validate(), cerror.ErrCodecInvalidConfig)
+
+	// only the glue schema registry is set
+	replicaConfig.Sink.SchemaRegistry = nil
+	c = NewConfig(config.ProtocolProtobuf)
+	require.NoError(t, c.Apply(sinkURI, replicaConfig))
+	require.NoError(t, c.Validate())
+	require.Equal(t, SchemaRegistryTypeGlue, c.SchemaRegistryType())
+
+	// force-replicate is not supported
+	replicaConfig = config.GetDefaultReplicaConfig()
+	replicaConfig.ForceReplicate = true
+	c = NewConfig(config.ProtocolProtobuf)
+	require.ErrorIs(t, c.Apply(sinkURI, replicaConfig), cerror.ErrCodecInvalidConfig)
+}
+
 func TestConfigApplyValidate(t *testing.T) {
 	t.Parallel()
 
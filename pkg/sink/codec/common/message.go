@@ -20,6 +20,7 @@ import (
 
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/tracing"
 	"github.com/tikv/client-go/v2/oracle"
 )
 
@@ -44,13 +45,22 @@ type Message struct {
 
 	// PartitionKey for pulsar, route messages to one or different partitions
 	PartitionKey *string
+
+	// TraceParent is the W3C trace context of the transaction, it's sent
+	// as a message header if not empty, so that consumers can continue the trace.
+	TraceParent string
 }
 
 // Length returns the expected size of the Kafka message
-// We didn't append any `Headers` when send the message, so ignore the calculations related to it.
-// If `ProducerMessage` Headers fields used, this method should also adjust.
+// The only header appended when send the message is the trace parent,
+// if more `ProducerMessage` Headers fields used, this method should also adjust.
 func (m *Message) Length() int {
-	return len(m.Key) + len(m.Value) + MaxRecordOverhead
+	length := len(m.Key) + len(m.Value) + MaxRecordOverhead
+	if m.TraceParent != "" {
+		// header key length, value length and the count of headers are varints.
+		length += len(tracing.TraceParentHeader) + len(m.TraceParent) + 3*binary.MaxVarintLen32
+	}
+	return length
 }
 
 // PhysicalTime returns physical time part of Ts in time.Time
@@ -124,3 +124,15 @@ func TestCreate(t *testing.T) {
 	require.Nil(t, msg.Table)
 	require.Equal(t, config.ProtocolCanal, msg.Protocol)
 }
+
+func TestLengthWithTraceParent(t *testing.T) {
+	t.Parallel()
+
+	msg := NewMsg(config.ProtocolOpen, []byte("key1"), []byte("value1"), 5678,
+		model.MessageTypeRow, nil, nil)
+	length := msg.Length()
+	require.Equal(t, len("key1")+len("value1")+MaxRecordOverhead, length)
+
+	msg.TraceParent = "00-0000000000000001-0000000000000002-01"
+	require.Greater(t, msg.Length(), length+len(msg.TraceParent))
+}
@@ -69,7 +69,7 @@ func NewSQLTestHelper(t *testing.T, tableName, initialCreateTableDDL string) *SQ
 	ts := schemaStorage.GetLastSnapshot().CurrentTs()
 	schemaStorage.AdvanceResolvedTs(ver.Ver)
 
-	mounter := entry.NewMounter(schemaStorage, changefeed, time.UTC, filter, cfg.Integrity)
+	mounter := entry.NewMounter(schemaStorage, changefeed, time.UTC, filter, cfg.Integrity, nil)
 
 	tableInfo, ok := schemaStorage.GetLastSnapshot().TableByName("test", tableName)
 	require.True(t, ok)
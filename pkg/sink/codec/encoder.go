@@ -72,6 +72,14 @@ type TxnEventEncoderBuilder interface {
 	Build() TxnEventEncoder
 }
 
+// FileEncoder is an abstraction for encoders of columnar formats, which have
+// to see all the messages of a data file before the file can be written.
+type FileEncoder interface {
+	// EncodeFile encodes the messages of the same table, which are built by the
+	// TxnEventEncoder of the same protocol, into a single data file.
+	EncodeFile(*model.TableInfo, []*common.Message) ([]byte, error)
+}
+
 // IsColumnValueEqual checks whether the preValue and updatedValue are equal.
 func IsColumnValueEqual(preValue, updatedValue interface{}) bool {
 	if preValue == nil || updatedValue == nil {
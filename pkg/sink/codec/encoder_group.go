@@ -15,6 +15,7 @@ package codec
 
 import (
 	"context"
+	"math"
 	"sync/atomic"
 	"time"
 
@@ -25,7 +26,10 @@ import (
 	"github.com/pingcap/tiflow/cdc/sink/dmlsink"
 	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"github.com/pingcap/tiflow/pkg/sink/deadletter"
+	"github.com/pingcap/tiflow/pkg/tracing"
 	"github.com/pingcap/tiflow/pkg/util"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 )
@@ -58,6 +62,9 @@ type encoderGroup struct {
 
 	outputCh        chan *future
 	bootstrapWorker *bootstrapWorker
+
+	// deadLetter is nil if the dead-letter queue is not enabled.
+	deadLetter deadletter.Writer
 }
 
 // NewEncoderGroup creates a new EncoderGroup instance
@@ -65,6 +72,7 @@ func NewEncoderGroup(
 	cfg *config.SinkConfig,
 	builder RowEventEncoderBuilder,
 	changefeedID model.ChangeFeedID,
+	deadLetter deadletter.Writer,
 ) *encoderGroup {
 	concurrency := util.GetOrZero(cfg.EncoderConcurrency)
 	if concurrency <= 0 {
@@ -102,6 +110,7 @@ func NewEncoderGroup(
 		index:           0,
 		outputCh:        outCh,
 		bootstrapWorker: bootstrapWorker,
+		deadLetter:      deadLetter,
 	}
 }
 
@@ -159,18 +168,39 @@ func (g *encoderGroup) collectMetrics(ctx context.Context) error {
 func (g *encoderGroup) runEncoder(ctx context.Context, idx int) error {
 	encoder := g.builder.Build()
 	inputCh := g.inputCh[idx]
+	// encodedTxns aggregates the rows of the sampled transactions in a batch,
+	// so that one encode span is recorded for each of them.
+	var encodedTxns tracing.TxnSpans
 	for {
 		select {
 		case <-ctx.Done():
 			return nil
 		case future := <-inputCh:
 			for _, event := range future.events {
+				start := time.Now()
 				err := encoder.AppendRowChangedEvent(ctx, future.Key.Topic, event.Event, event.Callback)
 				if err != nil {
-					return errors.Trace(err)
+					if g.deadLetter == nil || !deadletter.IsPermanentEncodeError(err) {
+						return errors.Trace(err)
+					}
+					// The row can never be encoded, route it to the
+					// dead-letter queue and skip it.
+					record := deadletter.NewRecord(g.changefeedID, event.Event, err)
+					if err := g.deadLetter.Write(ctx, record); err != nil {
+						return errors.Trace(err)
+					}
+					event.Callback()
+					continue
 				}
+				encodedTxns.Add(event.Event.CommitTs, start, time.Now())
 			}
 			future.Messages = encoder.Build()
+			encodedTxns.Flush(ctx, tracing.StageEncode, math.MaxUint64,
+				attribute.String("topic", future.Key.Topic),
+				attribute.Int64("partition", int64(future.Key.Partition)))
+			for _, msg := range future.Messages {
+				msg.TraceParent = tracing.TraceParent(msg.Ts)
+			}
 			close(future.done)
 		}
 	}
@@ -0,0 +1,295 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"bytes"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink/codec"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"github.com/tinylib/msgp/msgp"
+	"github.com/xitongsys/parquet-go/marshal"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+const (
+	operationInsert = "I"
+	operationUpdate = "U"
+	operationDelete = "D"
+)
+
+// BatchEncoder converts the rows of transactions to values of parquet physical
+// types. Parquet is a columnar format, so the rows can not be written to the
+// data file one by one, they are kept in messages in an intermediate row format
+// and assembled into a single parquet file by FileEncoder.
+type BatchEncoder struct {
+	config    *common.Config
+	valueBuf  []byte
+	callback  func()
+	batchSize int
+
+	// columns caches the columns of tableInfo.
+	tableInfo *model.TableInfo
+	columns   []*column
+}
+
+// AppendTxnEvent implements the TxnEventEncoder interface
+func (b *BatchEncoder) AppendTxnEvent(
+	e *model.SingleTableTxn,
+	callback func(),
+) error {
+	for _, row := range e.Rows {
+		columns := b.getColumns(row.TableInfo)
+		var err error
+		switch {
+		case row.IsDelete():
+			err = b.appendRow(operationDelete, row.CommitTs, row.PreColumns, columns)
+		case row.IsUpdate():
+			err = b.appendRow(operationUpdate, row.CommitTs, row.Columns, columns)
+		default:
+			err = b.appendRow(operationInsert, row.CommitTs, row.Columns, columns)
+		}
+		if err != nil {
+			return err
+		}
+		b.batchSize++
+	}
+	b.callback = callback
+	return nil
+}
+
+// Build implements the TxnEventEncoder interface
+func (b *BatchEncoder) Build() (messages []*common.Message) {
+	if b.batchSize == 0 {
+		return nil
+	}
+
+	ret := common.NewMsg(config.ProtocolParquet, nil,
+		b.valueBuf, 0, model.MessageTypeRow, nil, nil)
+	ret.SetRowsCount(b.batchSize)
+	ret.Callback = b.callback
+	// the buffer is held by the message until it is flushed, so it can not be reused.
+	b.valueBuf = nil
+	b.callback = nil
+	b.batchSize = 0
+
+	return []*common.Message{ret}
+}
+
+func (b *BatchEncoder) getColumns(tableInfo *model.TableInfo) []*column {
+	if b.tableInfo != tableInfo {
+		b.tableInfo = tableInfo
+		b.columns = newColumns(tableInfo)
+	}
+	return b.columns
+}
+
+// appendRow encodes the row as a msgpack array, which is composed of the
+// operation type, the commit-ts and the converted values of all columns.
+func (b *BatchEncoder) appendRow(
+	op string, commitTs uint64, cols []*model.ColumnData, columns []*column,
+) error {
+	if len(cols) != len(columns) {
+		return cerror.ErrParquetEncodeFailed.GenWithStack(
+			"the column length of row %d doesn't equal to that of table %d",
+			len(cols), len(columns))
+	}
+	b.valueBuf = msgp.AppendArrayHeader(b.valueBuf, uint32(len(cols)+metaColumnCount))
+	b.valueBuf = msgp.AppendString(b.valueBuf, op)
+	b.valueBuf = msgp.AppendInt64(b.valueBuf, int64(commitTs))
+	for i, col := range cols {
+		// column could be nil in a condition described in
+		// https://github.com/pingcap/tiflow/issues/6198#issuecomment-1191132951
+		var value interface{}
+		if col != nil {
+			var err error
+			value, err = columns[i].convert(col.Value, b.config.TimeZone)
+			if err != nil {
+				return cerror.WrapError(cerror.ErrParquetEncodeFailed, err)
+			}
+		}
+		switch v := value.(type) {
+		case nil:
+			b.valueBuf = msgp.AppendNil(b.valueBuf)
+		case int32:
+			b.valueBuf = msgp.AppendInt32(b.valueBuf, v)
+		case int64:
+			b.valueBuf = msgp.AppendInt64(b.valueBuf, v)
+		case float32:
+			b.valueBuf = msgp.AppendFloat32(b.valueBuf, v)
+		case float64:
+			b.valueBuf = msgp.AppendFloat64(b.valueBuf, v)
+		case string:
+			b.valueBuf = msgp.AppendString(b.valueBuf, v)
+		default:
+			return cerror.ErrParquetEncodeFailed.GenWithStack(
+				"unexpected converted value %v of column %s", value, columns[i].info.Name.O)
+		}
+	}
+	return nil
+}
+
+// newBatchEncoder creates a new parquet BatchEncoder.
+func newBatchEncoder(config *common.Config) codec.TxnEventEncoder {
+	return &BatchEncoder{
+		config: config,
+	}
+}
+
+type batchEncoderBuilder struct {
+	config *common.Config
+}
+
+// NewTxnEventEncoderBuilder creates a parquet batchEncoderBuilder.
+func NewTxnEventEncoderBuilder(config *common.Config) codec.TxnEventEncoderBuilder {
+	return &batchEncoderBuilder{config: config}
+}
+
+// Build a parquet BatchEncoder
+func (b *batchEncoderBuilder) Build() codec.TxnEventEncoder {
+	return newBatchEncoder(b.config)
+}
+
+// FileEncoder assembles the messages built by BatchEncoder into parquet files.
+type FileEncoder struct {
+	config *common.Config
+}
+
+// NewFileEncoder creates a parquet FileEncoder.
+func NewFileEncoder(config *common.Config) codec.FileEncoder {
+	return &FileEncoder{config: config}
+}
+
+// EncodeFile implements the FileEncoder interface
+func (f *FileEncoder) EncodeFile(
+	tableInfo *model.TableInfo, msgs []*common.Message,
+) ([]byte, error) {
+	schema := newSchema(newColumns(tableInfo))
+	buf := &bytes.Buffer{}
+	pw, err := writer.NewParquetWriterFromWriter(buf, schema, 1)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrParquetEncodeFailed, err)
+	}
+	// rows are written as slices of values in the order of the schema.
+	pw.MarshalFunc = marshal.MarshalCSV
+
+	for _, msg := range msgs {
+		value := msg.Value
+		for len(value) > 0 {
+			var row []interface{}
+			row, value, err = decodeRow(value, schema[1:])
+			if err != nil {
+				return nil, cerror.WrapError(cerror.ErrParquetEncodeFailed, err)
+			}
+			if err = pw.Write(row); err != nil {
+				return nil, cerror.WrapError(cerror.ErrParquetEncodeFailed, err)
+			}
+		}
+	}
+	if err = pw.WriteStop(); err != nil {
+		return nil, cerror.WrapError(cerror.ErrParquetEncodeFailed, err)
+	}
+	return buf.Bytes(), nil
+}
+
+// Row is a row decoded from the messages built by BatchEncoder.
+type Row struct {
+	// Operation is one of `I`, `U` and `D`.
+	Operation string
+	CommitTs  uint64
+	// Values holds the values of the columns returned by
+	// TableInfo.GetColInfosForRowChangedEvent, in the go types used
+	// for the parquet physical types of the columns.
+	Values []interface{}
+}
+
+// IsDelete returns true if the row is deleted.
+func (r *Row) IsDelete() bool {
+	return r.Operation == operationDelete
+}
+
+// DecodeRows decodes the rows of the messages built by BatchEncoder, it is
+// used by writers which lay out the rows in their own way.
+func DecodeRows(tableInfo *model.TableInfo, msgs []*common.Message) ([]*Row, error) {
+	schema := newSchema(newColumns(tableInfo))
+	var rows []*Row
+	for _, msg := range msgs {
+		value := msg.Value
+		for len(value) > 0 {
+			var (
+				row []interface{}
+				err error
+			)
+			row, value, err = decodeRow(value, schema[1:])
+			if err != nil {
+				return nil, cerror.WrapError(cerror.ErrParquetEncodeFailed, err)
+			}
+			op, _ := row[0].(string)
+			commitTs, _ := row[1].(int64)
+			rows = append(rows, &Row{
+				Operation: op,
+				CommitTs:  uint64(commitTs),
+				Values:    row[metaColumnCount:],
+			})
+		}
+	}
+	return rows, nil
+}
+
+// decodeRow decodes a row encoded by BatchEncoder.appendRow, the values are
+// decoded according to the physical types of the columns.
+func decodeRow(
+	b []byte, elements []*parquet.SchemaElement,
+) ([]interface{}, []byte, error) {
+	n, b, err := msgp.ReadArrayHeaderBytes(b)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	if int(n) != len(elements) {
+		return nil, nil, errors.Errorf(
+			"the column length of row %d doesn't equal to that of schema %d", n, len(elements))
+	}
+
+	row := make([]interface{}, n)
+	for i, e := range elements {
+		if msgp.IsNil(b) {
+			b, err = msgp.ReadNilBytes(b)
+			if err != nil {
+				return nil, nil, errors.Trace(err)
+			}
+			continue
+		}
+		switch e.GetType() {
+		case parquet.Type_INT32:
+			row[i], b, err = msgp.ReadInt32Bytes(b)
+		case parquet.Type_INT64:
+			row[i], b, err = msgp.ReadInt64Bytes(b)
+		case parquet.Type_FLOAT:
+			row[i], b, err = msgp.ReadFloat32Bytes(b)
+		case parquet.Type_DOUBLE:
+			row[i], b, err = msgp.ReadFloat64Bytes(b)
+		default:
+			row[i], b, err = msgp.ReadStringBytes(b)
+		}
+		if err != nil {
+			return nil, nil, errors.Trace(err)
+		}
+	}
+	return row, b, nil
+}
@@ -0,0 +1,173 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/cdc/entry"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go-source/buffer"
+	parquetcommon "github.com/xitongsys/parquet-go/common"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/reader"
+)
+
+func readColumn(t *testing.T, pr *reader.ParquetReader, name string) []interface{} {
+	path := parquetcommon.PathToStr([]string{rootSchemaName, name})
+	values, _, _, err := pr.ReadColumnByPath(path, pr.GetNumRows())
+	require.NoError(t, err)
+	return values
+}
+
+func TestParquetEncodeFile(t *testing.T) {
+	helper := entry.NewSchemaTestHelper(t)
+	defer helper.Close()
+
+	ddl := helper.DDL2Event(`create table test.t(
+		id int primary key, c_tinyint tinyint unsigned, c_bigint bigint unsigned,
+		c_float float, c_double double, c_decimal decimal(20, 4),
+		c_varchar varchar(16), c_blob blob, c_date date, c_datetime datetime(6),
+		c_timestamp timestamp(3) null, c_time time, c_enum enum('a', 'b'), c_json json)`)
+	insert := helper.DML2Event(`insert into test.t values (1, 255, 18446744073709551615,
+		1.5, 2.25, -12345.6789, 'hello', x'00ff', '2024-02-29', '2024-02-29 12:34:56.123456',
+		'1970-01-01 00:00:01.5', '-01:02:03', 'b', '{"a": 1}')`, "test", "t")
+	nullRow := helper.DML2Event(`insert into test.t(id) values (2)`, "test", "t")
+	deleteRow := *insert
+	deleteRow.PreColumns, deleteRow.Columns = insert.Columns, nil
+
+	codecConfig := common.NewConfig(config.ProtocolParquet)
+	codecConfig.TimeZone = time.UTC
+	encoder := NewTxnEventEncoderBuilder(codecConfig).Build()
+
+	var msgs []*common.Message
+	called := 0
+	for _, rows := range [][]*model.RowChangedEvent{{insert, nullRow}, {&deleteRow}} {
+		err := encoder.AppendTxnEvent(&model.SingleTableTxn{
+			TableInfo: ddl.TableInfo,
+			Rows:      rows,
+		}, func() { called++ })
+		require.NoError(t, err)
+		built := encoder.Build()
+		require.Len(t, built, 1)
+		require.Equal(t, len(rows), built[0].GetRowsCount())
+		msgs = append(msgs, built...)
+	}
+	require.Nil(t, encoder.Build())
+	for _, msg := range msgs {
+		msg.Callback()
+	}
+	require.Equal(t, 2, called)
+
+	data, err := NewFileEncoder(codecConfig).EncodeFile(ddl.TableInfo, msgs)
+	require.NoError(t, err)
+
+	file, err := buffer.NewBufferFile(data)
+	require.NoError(t, err)
+	pr, err := reader.NewParquetColumnReader(file, 1)
+	require.NoError(t, err)
+	defer pr.ReadStop()
+	require.Equal(t, int64(3), pr.GetNumRows())
+
+	schema := pr.Footer.GetSchema()
+	require.Len(t, schema, 17)
+	require.Equal(t, parquet.ConvertedType_UINT_64, schema[5].GetConvertedType())
+	require.Equal(t, parquet.ConvertedType_DECIMAL, schema[8].GetConvertedType())
+	require.Equal(t, int32(20), schema[8].GetPrecision())
+	require.Equal(t, int32(4), schema[8].GetScale())
+	require.False(t, schema[12].GetLogicalType().GetTIMESTAMP().GetIsAdjustedToUTC())
+	require.True(t, schema[13].GetLogicalType().GetTIMESTAMP().GetIsAdjustedToUTC())
+
+	require.Equal(t, []interface{}{"I", "I", "D"}, readColumn(t, pr, OperationColumnName))
+	commitTs := readColumn(t, pr, CommitTsColumnName)
+	require.Equal(t, int64(insert.CommitTs), commitTs[0])
+	require.Equal(t, int64(nullRow.CommitTs), commitTs[1])
+	require.Equal(t, []interface{}{int32(1), int32(2), int32(1)}, readColumn(t, pr, "id"))
+	require.Equal(t, []interface{}{int32(255), nil, int32(255)}, readColumn(t, pr, "c_tinyint"))
+	require.Equal(t, []interface{}{int64(-1), nil, int64(-1)}, readColumn(t, pr, "c_bigint"))
+	require.Equal(t, []interface{}{float32(1.5), nil, float32(1.5)}, readColumn(t, pr, "c_float"))
+	require.Equal(t, []interface{}{2.25, nil, 2.25}, readColumn(t, pr, "c_double"))
+	// -123456789 in big-endian two's complement.
+	require.Equal(t, []interface{}{"\xf8\xa4\x32\xeb", nil, "\xf8\xa4\x32\xeb"},
+		readColumn(t, pr, "c_decimal"))
+	require.Equal(t, []interface{}{"hello", nil, "hello"}, readColumn(t, pr, "c_varchar"))
+	require.Equal(t, []interface{}{"\x00\xff", nil, "\x00\xff"}, readColumn(t, pr, "c_blob"))
+	date := int32(time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC).Unix() / secondsPerDay)
+	require.Equal(t, []interface{}{date, nil, date}, readColumn(t, pr, "c_date"))
+	datetime := time.Date(2024, 2, 29, 12, 34, 56, 123456000, time.UTC).UnixMicro()
+	require.Equal(t, []interface{}{datetime, nil, datetime}, readColumn(t, pr, "c_datetime"))
+	require.Equal(t, []interface{}{int64(1500000), nil, int64(1500000)}, readColumn(t, pr, "c_timestamp"))
+	duration := -(time.Hour + 2*time.Minute + 3*time.Second).Microseconds()
+	require.Equal(t, []interface{}{duration, nil, duration}, readColumn(t, pr, "c_time"))
+	require.Equal(t, []interface{}{"b", nil, "b"}, readColumn(t, pr, "c_enum"))
+	require.Equal(t, []interface{}{`{"a": 1}`, nil, `{"a": 1}`}, readColumn(t, pr, "c_json"))
+}
+
+func TestDecimalToBytes(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		value    string
+		scale    int
+		expected []byte
+	}{
+		{value: "0", scale: 0, expected: []byte{0x00}},
+		{value: "1.5", scale: 2, expected: []byte{0x00, 0x96}},
+		{value: "-1", scale: 0, expected: []byte{0xff}},
+		{value: "-128", scale: 0, expected: []byte{0xff, 0x80}},
+		{value: "255", scale: 0, expected: []byte{0x00, 0xff}},
+		{value: "-0.01", scale: 2, expected: []byte{0xff}},
+	}
+	for _, tc := range testCases {
+		actual, err := decimalToBytes(tc.value, tc.scale)
+		require.NoError(t, err)
+		require.Equal(t, string(tc.expected), actual, tc.value)
+	}
+
+	_, err := decimalToBytes("abc", 0)
+	require.Error(t, err)
+}
+
+func TestDecodeRows(t *testing.T) {
+	helper := entry.NewSchemaTestHelper(t)
+	defer helper.Close()
+
+	ddl := helper.DDL2Event(`create table test.t(id int primary key, name varchar(16))`)
+	insert := helper.DML2Event(`insert into test.t values (1, 'a')`, "test", "t")
+	update := *insert
+	update.PreColumns = insert.Columns
+	deleteRow := *insert
+	deleteRow.PreColumns, deleteRow.Columns = insert.Columns, nil
+
+	encoder := NewTxnEventEncoderBuilder(common.NewConfig(config.ProtocolParquet)).Build()
+	err := encoder.AppendTxnEvent(&model.SingleTableTxn{
+		TableInfo: ddl.TableInfo,
+		Rows:      []*model.RowChangedEvent{insert, &update, &deleteRow},
+	}, nil)
+	require.NoError(t, err)
+
+	rows, err := DecodeRows(ddl.TableInfo, encoder.Build())
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+	for i, op := range []string{"I", "U", "D"} {
+		require.Equal(t, op, rows[i].Operation)
+		require.Equal(t, op == "D", rows[i].IsDelete())
+		require.Equal(t, insert.CommitTs, rows[i].CommitTs)
+		require.Equal(t, []interface{}{int32(1), "a"}, rows[i].Values)
+	}
+}
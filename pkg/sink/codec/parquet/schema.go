@@ -0,0 +1,421 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+	timodel "github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/xitongsys/parquet-go/parquet"
+)
+
+const (
+	// OperationColumnName is the name of the column which holds the
+	// operation type of a row, its value is one of `I`, `U` and `D`.
+	OperationColumnName = "_tidb_op"
+	// CommitTsColumnName is the name of the column which holds the
+	// commit-ts of the transaction that the row belongs to.
+	CommitTsColumnName = "_tidb_commit_ts"
+
+	rootSchemaName = "schema"
+	// metaColumnCount is the number of columns prepended to the table columns.
+	metaColumnCount = 2
+
+	dateLayout     = "2006-01-02"
+	datetimeLayout = "2006-01-02 15:04:05.999999999"
+	secondsPerDay  = 24 * 60 * 60
+)
+
+// column describes how a column of the upstream table is stored in parquet files.
+type column struct {
+	info     *timodel.ColumnInfo
+	isBinary bool
+	element  *parquet.SchemaElement
+}
+
+// newColumns returns the columns of the table which are visible to CDC,
+// in the same order as the columns of a RowChangedEvent.
+func newColumns(tableInfo *model.TableInfo) []*column {
+	colInfos := tableInfo.GetColInfosForRowChangedEvent()
+	columns := make([]*column, 0, len(colInfos))
+	for _, colInfo := range colInfos {
+		info := tableInfo.ForceGetColumnInfo(colInfo.ID)
+		col := &column{
+			info:     info,
+			isBinary: tableInfo.ForceGetColumnFlagType(colInfo.ID).IsBinary(),
+		}
+		col.element = newSchemaElement(info.Name.O, &info.FieldType, col.isBinary)
+		columns = append(columns, col)
+	}
+	return columns
+}
+
+// newSchema returns the flattened parquet schema of the given columns,
+// the first element is the root of the schema.
+func newSchema(columns []*column) []*parquet.SchemaElement {
+	root := parquet.NewSchemaElement()
+	root.Name = rootSchemaName
+	root.RepetitionType = parquet.FieldRepetitionTypePtr(parquet.FieldRepetitionType_REQUIRED)
+	root.NumChildren = int32Ptr(int32(len(columns) + metaColumnCount))
+
+	op := newElement(OperationColumnName, parquet.Type_BYTE_ARRAY)
+	op.RepetitionType = parquet.FieldRepetitionTypePtr(parquet.FieldRepetitionType_REQUIRED)
+	setConvertedType(op, parquet.ConvertedType_UTF8)
+	commitTs := newElement(CommitTsColumnName, parquet.Type_INT64)
+	commitTs.RepetitionType = parquet.FieldRepetitionTypePtr(parquet.FieldRepetitionType_REQUIRED)
+	setConvertedType(commitTs, parquet.ConvertedType_UINT_64)
+
+	schema := make([]*parquet.SchemaElement, 0, len(columns)+metaColumnCount+1)
+	schema = append(schema, root, op, commitTs)
+	for _, col := range columns {
+		schema = append(schema, col.element)
+	}
+	return schema
+}
+
+// newSchemaElement maps the TiDB field type to a parquet physical type
+// annotated with the closest logical type.
+func newSchemaElement(name string, ft *types.FieldType, isBinary bool) *parquet.SchemaElement {
+	unsigned := mysql.HasUnsignedFlag(ft.GetFlag())
+	switch ft.GetType() {
+	case mysql.TypeTiny:
+		return newIntElement(name, parquet.Type_INT32, 8, !unsigned)
+	case mysql.TypeShort:
+		return newIntElement(name, parquet.Type_INT32, 16, !unsigned)
+	case mysql.TypeInt24, mysql.TypeLong:
+		return newIntElement(name, parquet.Type_INT32, 32, !unsigned)
+	case mysql.TypeLonglong:
+		return newIntElement(name, parquet.Type_INT64, 64, !unsigned)
+	case mysql.TypeYear:
+		return newIntElement(name, parquet.Type_INT32, 16, true)
+	case mysql.TypeBit:
+		return newIntElement(name, parquet.Type_INT64, 64, false)
+	case mysql.TypeFloat:
+		return newElement(name, parquet.Type_FLOAT)
+	case mysql.TypeDouble:
+		return newElement(name, parquet.Type_DOUBLE)
+	case mysql.TypeNewDecimal:
+		precision, scale := decimalPrecisionAndScale(ft)
+		e := newElement(name, parquet.Type_BYTE_ARRAY)
+		setConvertedType(e, parquet.ConvertedType_DECIMAL)
+		e.Precision = int32Ptr(int32(precision))
+		e.Scale = int32Ptr(int32(scale))
+		e.LogicalType = parquet.NewLogicalType()
+		e.LogicalType.DECIMAL = &parquet.DecimalType{
+			Precision: int32(precision),
+			Scale:     int32(scale),
+		}
+		return e
+	case mysql.TypeDate, mysql.TypeNewDate:
+		e := newElement(name, parquet.Type_INT32)
+		setConvertedType(e, parquet.ConvertedType_DATE)
+		e.LogicalType = parquet.NewLogicalType()
+		e.LogicalType.DATE = parquet.NewDateType()
+		return e
+	case mysql.TypeDatetime, mysql.TypeTimestamp:
+		e := newElement(name, parquet.Type_INT64)
+		e.LogicalType = parquet.NewLogicalType()
+		e.LogicalType.TIMESTAMP = &parquet.TimestampType{
+			// DATETIME is a wall clock time without time zone, while
+			// TIMESTAMP is converted to UTC.
+			IsAdjustedToUTC: ft.GetType() == mysql.TypeTimestamp,
+			Unit:            &parquet.TimeUnit{MICROS: parquet.NewMicroSeconds()},
+		}
+		if ft.GetType() == mysql.TypeTimestamp {
+			setConvertedType(e, parquet.ConvertedType_TIMESTAMP_MICROS)
+		}
+		return e
+	case mysql.TypeDuration:
+		// The range of MySQL TIME is [-838:59:59, 838:59:59], which is beyond
+		// the TIME logical type, so it is stored as an interval in microseconds.
+		e := newElement(name, parquet.Type_INT64)
+		setConvertedType(e, parquet.ConvertedType_INT_64)
+		return e
+	case mysql.TypeJSON:
+		e := newElement(name, parquet.Type_BYTE_ARRAY)
+		setConvertedType(e, parquet.ConvertedType_JSON)
+		e.LogicalType = parquet.NewLogicalType()
+		e.LogicalType.JSON = parquet.NewJsonType()
+		return e
+	case mysql.TypeVarchar, mysql.TypeString, mysql.TypeVarString, mysql.TypeTinyBlob,
+		mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeBlob:
+		if isBinary {
+			return newElement(name, parquet.Type_BYTE_ARRAY)
+		}
+		return newStringElement(name)
+	default:
+		// ENUM, SET, VECTOR and other types are stored as their string representation.
+		return newStringElement(name)
+	}
+}
+
+func newElement(name string, tp parquet.Type) *parquet.SchemaElement {
+	e := parquet.NewSchemaElement()
+	e.Name = name
+	e.Type = parquet.TypePtr(tp)
+	e.RepetitionType = parquet.FieldRepetitionTypePtr(parquet.FieldRepetitionType_OPTIONAL)
+	return e
+}
+
+func newIntElement(name string, tp parquet.Type, bitWidth int8, signed bool) *parquet.SchemaElement {
+	e := newElement(name, tp)
+	setConvertedType(e, intConvertedType(bitWidth, signed))
+	e.LogicalType = parquet.NewLogicalType()
+	e.LogicalType.INTEGER = &parquet.IntType{BitWidth: bitWidth, IsSigned: signed}
+	return e
+}
+
+func intConvertedType(bitWidth int8, signed bool) parquet.ConvertedType {
+	switch bitWidth {
+	case 8:
+		if signed {
+			return parquet.ConvertedType_INT_8
+		}
+		return parquet.ConvertedType_UINT_8
+	case 16:
+		if signed {
+			return parquet.ConvertedType_INT_16
+		}
+		return parquet.ConvertedType_UINT_16
+	case 32:
+		if signed {
+			return parquet.ConvertedType_INT_32
+		}
+		return parquet.ConvertedType_UINT_32
+	default:
+		if signed {
+			return parquet.ConvertedType_INT_64
+		}
+		return parquet.ConvertedType_UINT_64
+	}
+}
+
+func newStringElement(name string) *parquet.SchemaElement {
+	e := newElement(name, parquet.Type_BYTE_ARRAY)
+	setConvertedType(e, parquet.ConvertedType_UTF8)
+	e.LogicalType = parquet.NewLogicalType()
+	e.LogicalType.STRING = parquet.NewStringType()
+	return e
+}
+
+func setConvertedType(e *parquet.SchemaElement, ct parquet.ConvertedType) {
+	e.ConvertedType = parquet.ConvertedTypePtr(ct)
+}
+
+func int32Ptr(v int32) *int32 {
+	return &v
+}
+
+func decimalPrecisionAndScale(ft *types.FieldType) (int, int) {
+	defaultFlen, defaultDecimal := mysql.GetDefaultFieldLengthAndDecimal(mysql.TypeNewDecimal)
+	precision, scale := ft.GetFlen(), ft.GetDecimal()
+	if precision == types.UnspecifiedLength {
+		precision = defaultFlen
+	}
+	if scale == types.UnspecifiedLength {
+		scale = defaultDecimal
+	}
+	return precision, scale
+}
+
+// convert converts the value of a column produced by the mounter to the
+// go type used by the parquet writer for the physical type of the column.
+func (c *column) convert(value interface{}, tz *time.Location) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+	ft := &c.info.FieldType
+	switch c.element.GetType() {
+	case parquet.Type_INT32:
+		switch ft.GetType() {
+		case mysql.TypeDate, mysql.TypeNewDate:
+			t, ok, err := parseTime(value, dateLayout, time.UTC)
+			if err != nil || !ok {
+				return nil, err
+			}
+			return int32(t.Unix() / secondsPerDay), nil
+		default:
+			v, err := toInt64(value)
+			return int32(v), err
+		}
+	case parquet.Type_INT64:
+		switch ft.GetType() {
+		case mysql.TypeDatetime:
+			t, ok, err := parseTime(value, datetimeLayout, time.UTC)
+			if err != nil || !ok {
+				return nil, err
+			}
+			return t.UnixMicro(), nil
+		case mysql.TypeTimestamp:
+			t, ok, err := parseTime(value, datetimeLayout, tz)
+			if err != nil || !ok {
+				return nil, err
+			}
+			return t.UnixMicro(), nil
+		case mysql.TypeDuration:
+			s, ok := value.(string)
+			if !ok {
+				return nil, errors.Errorf("unexpected value %v for duration", value)
+			}
+			d, _, err := types.ParseDuration(types.DefaultStmtNoWarningContext, s, types.MaxFsp)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			return d.Duration.Microseconds(), nil
+		default:
+			return toInt64(value)
+		}
+	case parquet.Type_FLOAT:
+		v, ok := value.(float32)
+		if !ok {
+			return nil, errors.Errorf("unexpected value %v for float", value)
+		}
+		return v, nil
+	case parquet.Type_DOUBLE:
+		v, ok := value.(float64)
+		if !ok {
+			return nil, errors.Errorf("unexpected value %v for double", value)
+		}
+		return v, nil
+	default:
+		if c.element.GetConvertedType() == parquet.ConvertedType_DECIMAL {
+			s, err := toString(value)
+			if err != nil {
+				return nil, err
+			}
+			return decimalToBytes(s, int(c.element.GetScale()))
+		}
+		return c.convertToString(value)
+	}
+}
+
+func (c *column) convertToString(value interface{}) (interface{}, error) {
+	ft := &c.info.FieldType
+	switch ft.GetType() {
+	case mysql.TypeEnum:
+		if number, ok := value.(uint64); ok {
+			enum, err := types.ParseEnumValue(ft.GetElems(), number)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			return enum.Name, nil
+		}
+	case mysql.TypeSet:
+		if number, ok := value.(uint64); ok {
+			set, err := types.ParseSetValue(ft.GetElems(), number)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			return set.Name, nil
+		}
+	case mysql.TypeTiDBVectorFloat32:
+		if vec, ok := value.(types.VectorFloat32); ok {
+			return vec.String(), nil
+		}
+	}
+	return toString(value)
+}
+
+func toInt64(value interface{}) (int64, error) {
+	switch v := value.(type) {
+	case int64:
+		return v, nil
+	case uint64:
+		// unsigned values are stored as their two's complement
+		// representation, which is how parquet defines UINT_64.
+		return int64(v), nil
+	case int32:
+		return int64(v), nil
+	case uint32:
+		return int64(v), nil
+	case int:
+		return int64(v), nil
+	default:
+		return 0, errors.Errorf("unexpected value %v for integer", value)
+	}
+}
+
+func toString(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case []byte:
+		return string(v), nil
+	default:
+		return "", errors.Errorf("unexpected value %v for string", value)
+	}
+}
+
+// parseTime parses the time string formatted by the mounter. Zero or
+// otherwise invalid dates can not be represented by parquet, so false is
+// returned for them and the value is stored as null.
+func parseTime(value interface{}, layout string, loc *time.Location) (time.Time, bool, error) {
+	s, ok := value.(string)
+	if !ok {
+		return time.Time{}, false, errors.Errorf("unexpected value %v for time", value)
+	}
+	t, err := time.ParseInLocation(layout, s, loc)
+	if err != nil {
+		return time.Time{}, false, nil
+	}
+	return t, true, nil
+}
+
+// decimalToBytes returns the big-endian two's complement representation of the
+// unscaled value of the decimal string, as required by the DECIMAL logical type.
+func decimalToBytes(s string, scale int) (string, error) {
+	negative := strings.HasPrefix(s, "-")
+	s = strings.TrimLeft(s, "+-")
+	integer, fraction, _ := strings.Cut(s, ".")
+	if len(fraction) > scale {
+		fraction = fraction[:scale]
+	} else {
+		fraction += strings.Repeat("0", scale-len(fraction))
+	}
+
+	unscaled, ok := new(big.Int).SetString(integer+fraction, 10)
+	if !ok {
+		return "", cerror.ErrParquetEncodeFailed.GenWithStack("invalid decimal value %s", s)
+	}
+	if negative {
+		unscaled.Neg(unscaled)
+	}
+	return string(bigIntToBytes(unscaled)), nil
+}
+
+func bigIntToBytes(v *big.Int) []byte {
+	if v.Sign() >= 0 {
+		b := v.Bytes()
+		// keep the sign bit clear for positive values.
+		if len(b) == 0 || b[0]&0x80 != 0 {
+			b = append([]byte{0}, b...)
+		}
+		return b
+	}
+	// two's complement of a negative value: 2^n + v, where n is a multiple
+	// of 8 that is large enough to hold the value and the sign bit.
+	n := uint((v.BitLen()/8 + 1) * 8)
+	b := new(big.Int).Add(new(big.Int).Lsh(big.NewInt(1), n), v).Bytes()
+	for len(b) < int(n/8) {
+		b = append([]byte{0}, b...)
+	}
+	return b
+}
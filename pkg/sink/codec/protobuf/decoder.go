@@ -0,0 +1,283 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protobuf
+
+import (
+	"context"
+	"database/sql"
+	"encoding/binary"
+	"encoding/json"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink/codec"
+	"github.com/pingcap/tiflow/pkg/sink/codec/avro"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+type decoder struct {
+	config *common.Config
+	topic  string
+
+	upstreamTiDB     *sql.DB
+	tableIDAllocator *common.FakeTableIDAllocator
+
+	schemaM *SchemaManager
+
+	key   []byte
+	value []byte
+}
+
+// NewDecoder return a protobuf decoder
+func NewDecoder(
+	config *common.Config,
+	schemaM *SchemaManager,
+	topic string,
+	db *sql.DB,
+) codec.RowEventDecoder {
+	return &decoder{
+		config:           config,
+		topic:            topic,
+		schemaM:          schemaM,
+		upstreamTiDB:     db,
+		tableIDAllocator: common.NewFakeTableIDAllocator(),
+	}
+}
+
+// AddKeyValue implements the RowEventDecoder interface
+func (d *decoder) AddKeyValue(key, value []byte) error {
+	if d.key != nil || d.value != nil {
+		return errors.New("key or value is not nil")
+	}
+	d.key = key
+	d.value = value
+	return nil
+}
+
+// HasNext implements the RowEventDecoder interface
+func (d *decoder) HasNext() (model.MessageType, bool, error) {
+	if d.key == nil && d.value == nil {
+		return model.MessageTypeUnknown, false, nil
+	}
+
+	// it must a row event.
+	if d.key != nil {
+		return model.MessageTypeRow, true, nil
+	}
+	if len(d.value) < 1 {
+		return model.MessageTypeUnknown, false, cerror.ErrDecodeFailed.GenWithStack(
+			"invalid protobuf message %v", d.value)
+	}
+	switch d.value[0] {
+	case magicByte:
+		return model.MessageTypeRow, true, nil
+	case ddlByte:
+		return model.MessageTypeDDL, true, nil
+	case checkpointByte:
+		return model.MessageTypeResolved, true, nil
+	}
+	return model.MessageTypeUnknown, false, cerror.ErrDecodeFailed.GenWithStack(
+		"invalid protobuf message %v", d.value)
+}
+
+// NextRowChangedEvent returns the next row changed event if exists
+func (d *decoder) NextRowChangedEvent() (*model.RowChangedEvent, error) {
+	ctx := context.Background()
+	keySchema, keyMsg, err := d.decode(ctx, d.key)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	// for the delete event, only have key part, it holds primary key or the unique key columns.
+	// for the insert / update, extract the value part, it holds all columns.
+	isDelete := len(d.value) == 0
+	valueSchema, valueMsg := keySchema, keyMsg
+	if !isDelete {
+		valueSchema, valueMsg, err = d.decode(ctx, d.value)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	d.key, d.value = nil, nil
+
+	event, err := assembleEvent(keySchema, valueSchema, valueMsg, isDelete)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	event.PhysicalTableID = d.tableIDAllocator.AllocateTableID(
+		event.TableInfo.GetSchemaName(), event.TableInfo.GetTableName())
+	return event, nil
+}
+
+func (d *decoder) decode(
+	ctx context.Context, data []byte,
+) (*messageSchema, *dynamicpb.Message, error) {
+	entry, payload, err := d.schemaM.lookup(ctx, data)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	msg := dynamicpb.NewMessage(entry.desc)
+	if err = proto.Unmarshal(payload, msg); err != nil {
+		return nil, nil, cerror.WrapError(cerror.ErrDecodeFailed, err)
+	}
+	return entry.schema, msg, nil
+}
+
+// assembleEvent returns a row changed event, the keySchema holds the primary key or
+// the unique key columns, the valueSchema and the valueMsg hold all columns.
+func assembleEvent(
+	keySchema, valueSchema *messageSchema, valueMsg *dynamicpb.Message, isDelete bool,
+) (*model.RowChangedEvent, error) {
+	pkNameSet := make(map[string]struct{}, len(keySchema.fields))
+	for _, f := range keySchema.fields {
+		pkNameSet[f.name] = struct{}{}
+	}
+
+	fields := valueMsg.Descriptor().Fields()
+	columns := make([]*model.Column, 0, len(valueSchema.fields))
+	for _, f := range valueSchema.fields {
+		// extension fields are not real columns.
+		if f.tidbType == "" {
+			continue
+		}
+		mysqlType := avro.MySQLTypeFromTiDBType(f.tidbType)
+		flag := avro.FlagFromTiDBType(f.tidbType)
+		if f.tp == typeBytes {
+			flag.SetIsBinary()
+		}
+		if _, ok := pkNameSet[f.name]; ok {
+			flag.SetIsHandleKey()
+			flag.SetIsPrimaryKey()
+		}
+
+		var value interface{}
+		fd := fields.ByNumber(protoreflect.FieldNumber(f.number))
+		if valueMsg.Has(fd) {
+			v, err := getColumnValue(valueMsg.Get(fd), f, mysqlType)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			value = v
+		}
+		columns = append(columns, &model.Column{
+			Name:  f.name,
+			Type:  mysqlType,
+			Flag:  flag,
+			Value: value,
+		})
+	}
+
+	// "[namespace.]schema"
+	schemaName := valueSchema.pkg
+	if idx := strings.Index(schemaName, "."); idx >= 0 {
+		schemaName = schemaName[idx+1:]
+	}
+
+	event := new(model.RowChangedEvent)
+	if !isDelete {
+		fd := fields.ByName(tidbCommitTs)
+		if fd == nil {
+			return nil, errors.New("commit ts not found")
+		}
+		event.CommitTs = valueMsg.Get(fd).Uint()
+	}
+	event.TableInfo = model.BuildTableInfoWithPKNames4Test(schemaName, valueSchema.name, columns, pkNameSet)
+	if isDelete {
+		event.PreColumns = model.Columns2ColumnDatas(columns, event.TableInfo)
+	} else {
+		event.Columns = model.Columns2ColumnDatas(columns, event.TableInfo)
+	}
+	return event, nil
+}
+
+// getColumnValue converts the protobuf value to the value of the column.
+func getColumnValue(
+	value protoreflect.Value, field *fieldSchema, mysqlType byte,
+) (interface{}, error) {
+	switch field.tp {
+	case typeInt64:
+		return value.Int(), nil
+	case typeUint64:
+		return value.Uint(), nil
+	case typeFloat:
+		return float32(value.Float()), nil
+	case typeDouble:
+		return value.Float(), nil
+	case typeBytes:
+		return value.Bytes(), nil
+	case typeBool:
+		return value.Bool(), nil
+	}
+
+	s := value.String()
+	switch mysqlType {
+	case mysql.TypeEnum:
+		// enum type is encoded as string,
+		// we need to convert it to int by the order of the enum values definition.
+		enum, err := types.ParseEnum(strings.Split(field.allowed, ","), s, "")
+		if err != nil {
+			return nil, cerror.WrapError(cerror.ErrDecodeFailed, err)
+		}
+		return enum.Value, nil
+	case mysql.TypeSet:
+		// set type is encoded as string,
+		// we need to convert it to int by the order of the set values definition.
+		set, err := types.ParseSet(strings.Split(field.allowed, ","), s, "")
+		if err != nil {
+			return nil, cerror.WrapError(cerror.ErrDecodeFailed, err)
+		}
+		return set.Value, nil
+	}
+	return s, nil
+}
+
+// NextResolvedEvent returns the next resolved event if exists
+func (d *decoder) NextResolvedEvent() (uint64, error) {
+	if len(d.value) != 9 || d.value[0] != checkpointByte {
+		return 0, cerror.ErrDecodeFailed.GenWithStack("invalid checkpoint message %v", d.value)
+	}
+	ts := binary.BigEndian.Uint64(d.value[1:])
+	d.value = nil
+	return ts, nil
+}
+
+// NextDDLEvent returns the next DDL event if exists
+func (d *decoder) NextDDLEvent() (*model.DDLEvent, error) {
+	if len(d.value) == 0 || d.value[0] != ddlByte {
+		return nil, cerror.ErrDecodeFailed.GenWithStack("invalid ddl message %v", d.value)
+	}
+	var event ddlEvent
+	if err := json.Unmarshal(d.value[1:], &event); err != nil {
+		return nil, cerror.WrapError(cerror.ErrDecodeFailed, err)
+	}
+	d.value = nil
+
+	result := new(model.DDLEvent)
+	result.TableInfo = new(model.TableInfo)
+	result.CommitTs = event.CommitTs
+	result.TableInfo.TableName = model.TableName{
+		Schema: event.Schema,
+		Table:  event.Table,
+	}
+	result.Type = event.Type
+	result.Query = event.Query
+	return result, nil
+}
@@ -0,0 +1,408 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protobuf
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	timodel "github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink/codec"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"github.com/tikv/client-go/v2/oracle"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+const (
+	keySchemaSuffix   = "-key"
+	valueSchemaSuffix = "-value"
+
+	insertOperation = "c"
+	updateOperation = "u"
+
+	// the following 2 bytes are used to distinguish the DDL event and the checkpoint
+	// event from the row changed event, only used for testing purpose, same as avro.
+	ddlByte        = uint8(1)
+	checkpointByte = uint8(2)
+)
+
+// BatchEncoder converts the row changed events to protobuf messages,
+// the schema of each message is registered to the schema registry.
+type BatchEncoder struct {
+	namespace string
+	schemaM   *SchemaManager
+	result    []*common.Message
+
+	config *common.Config
+}
+
+// AppendRowChangedEvent appends a row change event to the encoder
+// NOTE: the encoder can only store one RowChangedEvent!
+func (e *BatchEncoder) AppendRowChangedEvent(
+	ctx context.Context,
+	topic string,
+	event *model.RowChangedEvent,
+	callback func(),
+) error {
+	topic = sanitizeTopic(topic)
+
+	key, err := e.encodeKey(ctx, topic, event)
+	if err != nil {
+		log.Error("protobuf encoding key failed", zap.Error(err), zap.Any("event", event))
+		return errors.Trace(err)
+	}
+
+	value, err := e.encodeValue(ctx, topic, event)
+	if err != nil {
+		log.Error("protobuf encoding value failed", zap.Error(err), zap.Any("event", event))
+		return errors.Trace(err)
+	}
+
+	message := common.NewMsg(
+		config.ProtocolProtobuf,
+		key,
+		value,
+		event.CommitTs,
+		model.MessageTypeRow,
+		event.TableInfo.GetSchemaNamePtr(),
+		event.TableInfo.GetTableNamePtr(),
+	)
+	message.Callback = callback
+	message.IncRowsCount()
+
+	if message.Length() > e.config.MaxMessageBytes {
+		log.Warn("Single message is too large for protobuf",
+			zap.Int("maxMessageBytes", e.config.MaxMessageBytes),
+			zap.Int("length", message.Length()),
+			zap.Any("table", event.TableInfo.TableName))
+		return cerror.ErrMessageTooLarge.GenWithStackByArgs(message.Length())
+	}
+
+	e.result = append(e.result, message)
+	return nil
+}
+
+func (e *BatchEncoder) encodeKey(
+	ctx context.Context, topic string, event *model.RowChangedEvent,
+) ([]byte, error) {
+	cols, _ := event.HandleKeyColInfos()
+	if len(cols) == 0 {
+		return nil, nil
+	}
+	return e.encode(ctx, topic+keySchemaSuffix, event, cols, false)
+}
+
+func (e *BatchEncoder) encodeValue(
+	ctx context.Context, topic string, event *model.RowChangedEvent,
+) ([]byte, error) {
+	// the delete event only has the key part.
+	if event.IsDelete() || len(event.Columns) == 0 {
+		return nil, nil
+	}
+	return e.encode(ctx, topic+valueSchemaSuffix, event, event.Columns, e.config.EnableTiDBExtension)
+}
+
+func (e *BatchEncoder) encode(
+	ctx context.Context, subject string, event *model.RowChangedEvent,
+	columns []*model.ColumnData, withExtension bool,
+) ([]byte, error) {
+	tableInfo := event.TableInfo
+	entry, err := e.schemaM.getCachedOrRegister(ctx, subject, tableInfo.Version,
+		func() (*messageSchema, error) {
+			return newMessageSchema(e.namespace, tableInfo, columns, withExtension)
+		})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	msg := dynamicpb.NewMessage(entry.desc)
+	fields := entry.desc.Fields()
+	for _, col := range columns {
+		colx := model.GetColumnDataX(col, tableInfo)
+		if colx.ColumnData == nil || colx.Value == nil {
+			continue
+		}
+		fd := fields.ByNumber(protowire.Number(colx.ColumnID))
+		if fd == nil {
+			return nil, cerror.ErrEncodeFailed.GenWithStack(
+				"column %s not found in the protobuf schema %s", colx.GetName(), subject)
+		}
+		value, err := columnToValue(colx)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		msg.Set(fd, value)
+	}
+	if withExtension {
+		msg.Set(fields.ByName(tidbOp), protoreflect.ValueOfString(getOperation(event)))
+		msg.Set(fields.ByName(tidbCommitTs), protoreflect.ValueOfUint64(event.CommitTs))
+		msg.Set(fields.ByName(tidbPhysicalTime),
+			protoreflect.ValueOfInt64(oracle.ExtractPhysical(event.CommitTs)))
+	}
+
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrEncodeFailed, err)
+	}
+	result := make([]byte, 0, len(entry.header)+len(data))
+	result = append(result, entry.header...)
+	return append(result, data...), nil
+}
+
+func columnToValue(col model.ColumnDataX) (protoreflect.Value, error) {
+	switch col.GetType() {
+	case mysql.TypeTiny, mysql.TypeShort, mysql.TypeInt24, mysql.TypeLong,
+		mysql.TypeLonglong, mysql.TypeYear:
+		// the year column is flagged as unsigned, but it's encoded as int64.
+		unsigned := col.GetFlag().IsUnsigned() && col.GetType() != mysql.TypeYear
+		switch v := col.Value.(type) {
+		case string:
+			if unsigned {
+				n, err := strconv.ParseUint(v, 10, 64)
+				if err != nil {
+					return protoreflect.Value{}, cerror.WrapError(cerror.ErrEncodeFailed, err)
+				}
+				return protoreflect.ValueOfUint64(n), nil
+			}
+			n, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return protoreflect.Value{}, cerror.WrapError(cerror.ErrEncodeFailed, err)
+			}
+			return protoreflect.ValueOfInt64(n), nil
+		case uint64:
+			return protoreflect.ValueOfUint64(v), nil
+		case int64:
+			if unsigned {
+				return protoreflect.ValueOfUint64(uint64(v)), nil
+			}
+			return protoreflect.ValueOfInt64(v), nil
+		}
+	case mysql.TypeBit:
+		switch v := col.Value.(type) {
+		case string:
+			n, err := strconv.ParseUint(v, 10, 64)
+			if err != nil {
+				return protoreflect.Value{}, cerror.WrapError(cerror.ErrEncodeFailed, err)
+			}
+			return protoreflect.ValueOfUint64(n), nil
+		case uint64:
+			return protoreflect.ValueOfUint64(v), nil
+		}
+	case mysql.TypeFloat:
+		switch v := col.Value.(type) {
+		case string:
+			n, err := strconv.ParseFloat(v, 32)
+			if err != nil {
+				return protoreflect.Value{}, cerror.WrapError(cerror.ErrEncodeFailed, err)
+			}
+			return protoreflect.ValueOfFloat32(float32(n)), nil
+		case float32:
+			return protoreflect.ValueOfFloat32(v), nil
+		case float64:
+			return protoreflect.ValueOfFloat32(float32(v)), nil
+		}
+	case mysql.TypeDouble:
+		switch v := col.Value.(type) {
+		case string:
+			n, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return protoreflect.Value{}, cerror.WrapError(cerror.ErrEncodeFailed, err)
+			}
+			return protoreflect.ValueOfFloat64(n), nil
+		case float64:
+			return protoreflect.ValueOfFloat64(v), nil
+		}
+	case mysql.TypeVarchar, mysql.TypeString, mysql.TypeVarString,
+		mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeBlob:
+		isBinary := col.GetFlag().IsBinary()
+		switch v := col.Value.(type) {
+		case string:
+			if isBinary {
+				return protoreflect.ValueOfBytes([]byte(v)), nil
+			}
+			return protoreflect.ValueOfString(v), nil
+		case []byte:
+			if isBinary {
+				return protoreflect.ValueOfBytes(v), nil
+			}
+			return protoreflect.ValueOfString(string(v)), nil
+		}
+	case mysql.TypeEnum:
+		switch v := col.Value.(type) {
+		case string:
+			return protoreflect.ValueOfString(v), nil
+		case uint64:
+			elements := col.GetColumnInfo().FieldType.GetElems()
+			enumVar, err := types.ParseEnumValue(elements, v)
+			if err != nil {
+				return protoreflect.Value{}, cerror.WrapError(cerror.ErrEncodeFailed, err)
+			}
+			return protoreflect.ValueOfString(enumVar.Name), nil
+		}
+	case mysql.TypeSet:
+		switch v := col.Value.(type) {
+		case string:
+			return protoreflect.ValueOfString(v), nil
+		case uint64:
+			elements := col.GetColumnInfo().FieldType.GetElems()
+			setVar, err := types.ParseSetValue(elements, v)
+			if err != nil {
+				return protoreflect.Value{}, cerror.WrapError(cerror.ErrEncodeFailed, err)
+			}
+			return protoreflect.ValueOfString(setVar.Name), nil
+		}
+	case mysql.TypeNewDecimal, mysql.TypeJSON,
+		mysql.TypeDate, mysql.TypeDatetime, mysql.TypeTimestamp, mysql.TypeDuration:
+		if v, ok := col.Value.(string); ok {
+			return protoreflect.ValueOfString(v), nil
+		}
+	case mysql.TypeTiDBVectorFloat32:
+		if v, ok := col.Value.(types.VectorFloat32); ok {
+			return protoreflect.ValueOfString(v.String()), nil
+		}
+	}
+	log.Error("unexpected value for the column type",
+		zap.Any("value", col.Value), zap.Any("mysqlType", col.GetType()))
+	return protoreflect.Value{}, cerror.ErrEncodeFailed.GenWithStack(
+		"unexpected value %v for the column %s", col.Value, col.GetName())
+}
+
+func getOperation(e *model.RowChangedEvent) string {
+	if e.IsInsert() {
+		return insertOperation
+	} else if e.IsUpdate() {
+		return updateOperation
+	}
+	return ""
+}
+
+// sanitizeTopic escapes ".", it may have special meanings for sink connectors
+func sanitizeTopic(name string) string {
+	return strings.ReplaceAll(name, ".", "_")
+}
+
+// EncodeCheckpointEvent only encode checkpoint event if the watermark event is enabled
+// it's only used for the testing purpose.
+func (e *BatchEncoder) EncodeCheckpointEvent(ts uint64) (*common.Message, error) {
+	if e.config.EnableTiDBExtension && e.config.AvroEnableWatermark {
+		value := make([]byte, 9)
+		value[0] = checkpointByte
+		binary.BigEndian.PutUint64(value[1:], ts)
+		return common.NewResolvedMsg(config.ProtocolProtobuf, nil, value, ts), nil
+	}
+	return nil, nil
+}
+
+type ddlEvent struct {
+	Query    string             `json:"query"`
+	Type     timodel.ActionType `json:"type"`
+	Schema   string             `json:"schema"`
+	Table    string             `json:"table"`
+	CommitTs uint64             `json:"commitTs"`
+}
+
+// EncodeDDLEvent only encode DDL event if the watermark event is enabled
+// it's only used for the testing purpose.
+func (e *BatchEncoder) EncodeDDLEvent(event *model.DDLEvent) (*common.Message, error) {
+	if e.config.EnableTiDBExtension && e.config.AvroEnableWatermark {
+		data, err := json.Marshal(&ddlEvent{
+			Query:    event.Query,
+			Type:     event.Type,
+			Schema:   event.TableInfo.TableName.Schema,
+			Table:    event.TableInfo.TableName.Table,
+			CommitTs: event.CommitTs,
+		})
+		if err != nil {
+			return nil, cerror.WrapError(cerror.ErrEncodeFailed, err)
+		}
+		buf := new(bytes.Buffer)
+		buf.WriteByte(ddlByte)
+		buf.Write(data)
+		return common.NewDDLMsg(config.ProtocolProtobuf, nil, buf.Bytes(), event), nil
+	}
+	return nil, nil
+}
+
+// Build Messages
+func (e *BatchEncoder) Build() (messages []*common.Message) {
+	result := e.result
+	e.result = nil
+	return result
+}
+
+type batchEncoderBuilder struct {
+	namespace string
+	config    *common.Config
+	schemaM   *SchemaManager
+}
+
+// NewBatchEncoderBuilder creates a protobuf batchEncoderBuilder.
+func NewBatchEncoderBuilder(
+	ctx context.Context, config *common.Config,
+) (codec.RowEventEncoderBuilder, error) {
+	var (
+		schemaM *SchemaManager
+		err     error
+	)
+	switch config.SchemaRegistryType() {
+	case common.SchemaRegistryTypeConfluent:
+		schemaM, err = NewSchemaManager(ctx, config.AvroConfluentSchemaRegistry, nil)
+	case common.SchemaRegistryTypeGlue:
+		schemaM, err = NewGlueSchemaManager(ctx, config.AvroGlueSchemaRegistry)
+	default:
+		return nil, cerror.ErrAvroSchemaAPIError.GenWithStackByArgs(
+			"protobuf only supports the confluent and glue schema registry")
+	}
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &batchEncoderBuilder{
+		namespace: config.ChangefeedID.Namespace,
+		config:    config,
+		schemaM:   schemaM,
+	}, nil
+}
+
+// Build a protobuf BatchEncoder.
+func (b *batchEncoderBuilder) Build() codec.RowEventEncoder {
+	return NewBatchEncoder(b.namespace, b.schemaM, b.config)
+}
+
+// CleanMetrics is a no-op for protobuf BatchEncoder.
+func (b *batchEncoderBuilder) CleanMetrics() {}
+
+// NewBatchEncoder returns a protobuf encoder.
+func NewBatchEncoder(namespace string, schemaM *SchemaManager, config *common.Config) codec.RowEventEncoder {
+	return &BatchEncoder{
+		namespace: namespace,
+		schemaM:   schemaM,
+		result:    make([]*common.Message, 0, 1),
+		config:    config,
+	}
+}
@@ -0,0 +1,271 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protobuf
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+
+	glueTypes "github.com/aws/aws-sdk-go-v2/service/glue/types"
+	"github.com/jarcoal/httpmock"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/sink/codec/avro"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"github.com/pingcap/tiflow/pkg/sink/codec/utils"
+	"github.com/stretchr/testify/require"
+)
+
+const testRegistryURL = "http://127.0.0.1:8081"
+
+// startMockRegistry mocks the APIs of the Confluent Schema Registry used by the codec.
+func startMockRegistry(t *testing.T) {
+	httpmock.Activate()
+	t.Cleanup(httpmock.DeactivateAndReset)
+
+	var (
+		mu      sync.Mutex
+		schemas = make(map[int]string)
+		ids     = make(map[string]int)
+	)
+	httpmock.RegisterResponder("GET", testRegistryURL, httpmock.NewStringResponder(200, "{}"))
+	httpmock.RegisterResponder("POST", `=~^`+testRegistryURL+`/subjects/(.+)/versions`,
+		func(req *http.Request) (*http.Response, error) {
+			body, err := io.ReadAll(req.Body)
+			if err != nil {
+				return nil, err
+			}
+			var reqData struct {
+				Schema     string `json:"schema"`
+				SchemaType string `json:"schemaType"`
+			}
+			if err = json.Unmarshal(body, &reqData); err != nil {
+				return nil, err
+			}
+			if reqData.SchemaType != schemaType {
+				return httpmock.NewStringResponse(422, "unexpected schema type"), nil
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			id, ok := ids[reqData.Schema]
+			if !ok {
+				id = len(ids) + 1
+				ids[reqData.Schema] = id
+				schemas[id] = reqData.Schema
+			}
+			return httpmock.NewJsonResponse(200, map[string]int{"id": id})
+		})
+	httpmock.RegisterResponder("GET", `=~^`+testRegistryURL+`/schemas/ids/(\d+)`,
+		func(req *http.Request) (*http.Response, error) {
+			id, err := httpmock.GetSubmatchAsInt(req, 1)
+			if err != nil {
+				return nil, err
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			schema, ok := schemas[int(id)]
+			if !ok {
+				return httpmock.NewStringResponse(404, "Not Found"), nil
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{"id": id, "schema": schema})
+		})
+}
+
+func newTestEncoderAndDecoder(
+	ctx context.Context, t *testing.T, codecConfig *common.Config,
+) (*BatchEncoder, *decoder) {
+	startMockRegistry(t)
+	codecConfig.AvroConfluentSchemaRegistry = testRegistryURL
+	builder, err := NewBatchEncoderBuilder(ctx, codecConfig)
+	require.NoError(t, err)
+	encoder := builder.Build().(*BatchEncoder)
+
+	schemaM, err := NewSchemaManager(ctx, testRegistryURL, nil)
+	require.NoError(t, err)
+	return encoder, NewDecoder(codecConfig, schemaM, "", nil).(*decoder)
+}
+
+// normalizeValue converts the value of the mounted column to the decoded one.
+func normalizeValue(value interface{}, binary bool) interface{} {
+	switch v := value.(type) {
+	case []byte:
+		if !binary {
+			return string(v)
+		}
+	case types.VectorFloat32:
+		return v.String()
+	}
+	return value
+}
+
+func TestRowEventE2E(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	codecConfig := common.NewConfig(config.ProtocolProtobuf)
+	codecConfig.EnableTiDBExtension = true
+	encoder, decoder := newTestEncoderAndDecoder(ctx, t, codecConfig)
+
+	_, insertEvent, updateEvent, deleteEvent := utils.NewLargeEvent4Test(t, config.GetDefaultReplicaConfig())
+	for _, event := range []*model.RowChangedEvent{insertEvent, updateEvent, deleteEvent} {
+		err := encoder.AppendRowChangedEvent(ctx, "test.topic", event, func() {})
+		require.NoError(t, err)
+		messages := encoder.Build()
+		require.Len(t, messages, 1)
+		if event.IsDelete() {
+			require.Nil(t, messages[0].Value)
+		}
+
+		require.NoError(t, decoder.AddKeyValue(messages[0].Key, messages[0].Value))
+		tp, hasNext, err := decoder.HasNext()
+		require.NoError(t, err)
+		require.True(t, hasNext)
+		require.Equal(t, model.MessageTypeRow, tp)
+
+		decoded, err := decoder.NextRowChangedEvent()
+		require.NoError(t, err)
+		require.Equal(t, "test", decoded.TableInfo.GetSchemaName())
+		require.Equal(t, "t", decoded.TableInfo.GetTableName())
+		require.NotZero(t, decoded.GetTableID())
+		require.Equal(t, event.IsDelete(), decoded.IsDelete())
+
+		if event.IsDelete() {
+			require.Len(t, decoded.PreColumns, 1)
+			colx := model.GetColumnDataX(decoded.PreColumns[0], decoded.TableInfo)
+			require.Equal(t, "tu1", colx.GetName())
+			require.True(t, colx.GetFlag().IsPrimaryKey())
+			continue
+		}
+		require.Equal(t, event.CommitTs, decoded.CommitTs)
+
+		expected := make(map[string]model.ColumnDataX, len(event.Columns))
+		for _, col := range event.Columns {
+			colx := model.GetColumnDataX(col, event.TableInfo)
+			expected[colx.GetName()] = colx
+		}
+		require.Len(t, decoded.Columns, len(expected))
+		for _, col := range decoded.Columns {
+			colx := model.GetColumnDataX(col, decoded.TableInfo)
+			origin, ok := expected[colx.GetName()]
+			require.True(t, ok, colx.GetName())
+			require.Equal(t, normalizeValue(origin.Value, origin.GetFlag().IsBinary()), colx.Value, colx.GetName())
+		}
+	}
+
+	// the schema is registered only once for the same table version.
+	require.Len(t, encoder.schemaM.subjects, 2)
+	require.Contains(t, encoder.schemaM.subjects, "test_topic-key")
+	require.Contains(t, encoder.schemaM.subjects, "test_topic-value")
+}
+
+func TestRowEventE2EWithGlue(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	codecConfig := common.NewConfig(config.ProtocolProtobuf)
+	codecConfig.EnableTiDBExtension = true
+	schemaM := newSchemaManager(&glueRegistry{
+		registry: avro.NewMockGlueSchemaRegistry(glueTypes.DataFormatProtobuf),
+	})
+	encoder := NewBatchEncoder("", schemaM, codecConfig)
+	decoder := NewDecoder(codecConfig, schemaM, "", nil)
+
+	_, insertEvent, _, _ := utils.NewLargeEvent4Test(t, config.GetDefaultReplicaConfig())
+	err := encoder.AppendRowChangedEvent(ctx, "test.topic", insertEvent, func() {})
+	require.NoError(t, err)
+	messages := encoder.Build()
+	require.Len(t, messages, 1)
+	// the glue header, followed by the message index.
+	require.Equal(t, glueHeaderVersionByte, messages[0].Value[0])
+	require.Equal(t, byte(0), messages[0].Value[glueHeaderLen])
+
+	// the decoder looks up the schema by the schema version ID in the header.
+	schemaM.subjects = make(map[string]*schemaCacheEntry)
+	require.NoError(t, decoder.AddKeyValue(messages[0].Key, messages[0].Value))
+	tp, hasNext, err := decoder.HasNext()
+	require.NoError(t, err)
+	require.True(t, hasNext)
+	require.Equal(t, model.MessageTypeRow, tp)
+
+	decoded, err := decoder.NextRowChangedEvent()
+	require.NoError(t, err)
+	require.Equal(t, "test", decoded.TableInfo.GetSchemaName())
+	require.Equal(t, "t", decoded.TableInfo.GetTableName())
+	require.Equal(t, insertEvent.CommitTs, decoded.CommitTs)
+	require.Len(t, decoded.Columns, len(insertEvent.Columns))
+
+	// a message encoded with the confluent wire format is rejected.
+	_, _, err = schemaM.lookup(ctx, append(getMsgHeader(1), messages[0].Value[glueHeaderLen+1:]...))
+	require.ErrorContains(t, err, "header version byte is not match")
+}
+
+func TestMessageTooLarge(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	codecConfig := common.NewConfig(config.ProtocolProtobuf)
+	codecConfig.MaxMessageBytes = 100
+	encoder, _ := newTestEncoderAndDecoder(ctx, t, codecConfig)
+
+	_, event, _, _ := utils.NewLargeEvent4Test(t, config.GetDefaultReplicaConfig())
+	err := encoder.AppendRowChangedEvent(ctx, "test", event, func() {})
+	require.Regexp(t, ".*CDC:ErrMessageTooLarge.*", err)
+}
+
+func TestDDLAndCheckpointEventE2E(t *testing.T) {
+	codecConfig := common.NewConfig(config.ProtocolProtobuf)
+	codecConfig.EnableTiDBExtension = true
+	codecConfig.AvroEnableWatermark = true
+
+	encoder := NewBatchEncoder(model.DefaultNamespace, nil, codecConfig)
+	decoder := NewDecoder(codecConfig, nil, "", nil)
+
+	ddl, _, _, _ := utils.NewLargeEvent4Test(t, config.GetDefaultReplicaConfig())
+	message, err := encoder.EncodeDDLEvent(ddl)
+	require.NoError(t, err)
+	require.NoError(t, decoder.AddKeyValue(message.Key, message.Value))
+	tp, hasNext, err := decoder.HasNext()
+	require.NoError(t, err)
+	require.True(t, hasNext)
+	require.Equal(t, model.MessageTypeDDL, tp)
+	decodedDDL, err := decoder.NextDDLEvent()
+	require.NoError(t, err)
+	require.Equal(t, ddl.Query, decodedDDL.Query)
+	require.Equal(t, ddl.Type, decodedDDL.Type)
+	require.Equal(t, ddl.CommitTs, decodedDDL.CommitTs)
+	require.Equal(t, ddl.TableInfo.TableName.Table, decodedDDL.TableInfo.TableName.Table)
+
+	message, err = encoder.EncodeCheckpointEvent(417318403368288260)
+	require.NoError(t, err)
+	require.NoError(t, decoder.AddKeyValue(message.Key, message.Value))
+	tp, hasNext, err = decoder.HasNext()
+	require.NoError(t, err)
+	require.True(t, hasNext)
+	require.Equal(t, model.MessageTypeResolved, tp)
+	ts, err := decoder.NextResolvedEvent()
+	require.NoError(t, err)
+	require.Equal(t, uint64(417318403368288260), ts)
+
+	// nothing is sent if the watermark is disabled.
+	codecConfig.AvroEnableWatermark = false
+	message, err = encoder.EncodeCheckpointEvent(417318403368288260)
+	require.NoError(t, err)
+	require.Nil(t, message)
+}
@@ -0,0 +1,282 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protobuf
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink/codec/avro"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// The scalar types used by the generated schemas.
+const (
+	typeInt64  = "int64"
+	typeUint64 = "uint64"
+	typeFloat  = "float"
+	typeDouble = "double"
+	typeString = "string"
+	typeBytes  = "bytes"
+	typeBool   = "bool"
+)
+
+var scalarTypes = map[string]descriptorpb.FieldDescriptorProto_Type{
+	typeInt64:  descriptorpb.FieldDescriptorProto_TYPE_INT64,
+	typeUint64: descriptorpb.FieldDescriptorProto_TYPE_UINT64,
+	typeFloat:  descriptorpb.FieldDescriptorProto_TYPE_FLOAT,
+	typeDouble: descriptorpb.FieldDescriptorProto_TYPE_DOUBLE,
+	typeString: descriptorpb.FieldDescriptorProto_TYPE_STRING,
+	typeBytes:  descriptorpb.FieldDescriptorProto_TYPE_BYTES,
+	typeBool:   descriptorpb.FieldDescriptorProto_TYPE_BOOL,
+}
+
+const (
+	tidbOp           = "_tidb_op"
+	tidbCommitTs     = "_tidb_commit_ts"
+	tidbPhysicalTime = "_tidb_commit_physical_time"
+
+	// extensionFieldNumber is the field number of the first extension field,
+	// the field number of a column is its column ID, which is far less than it.
+	extensionFieldNumber = 100000
+
+	tidbTypeComment = "tidb_type: "
+	allowedComment  = "allowed: "
+)
+
+// fieldSchema is a field of the generated message, all fields are declared
+// as proto3 optional fields, so that NULL values can be distinguished.
+type fieldSchema struct {
+	name   string
+	number int32
+	tp     string
+	// tidbType is the TiDB type of the column, it's empty for extension fields.
+	tidbType string
+	// allowed is the elements of an enum or a set column.
+	allowed string
+}
+
+// messageSchema is the protobuf message generated for a table.
+type messageSchema struct {
+	pkg    string
+	name   string
+	fields []*fieldSchema
+}
+
+// newMessageSchema generates the message schema of the given columns.
+func newMessageSchema(
+	namespace string, tableInfo *model.TableInfo, columns []*model.ColumnData, withExtension bool,
+) (*messageSchema, error) {
+	result := &messageSchema{
+		pkg:  getPackageName(namespace, tableInfo.GetSchemaName()),
+		name: common.SanitizeName(tableInfo.GetTableName()),
+	}
+	for _, col := range columns {
+		colx := model.GetColumnDataX(col, tableInfo)
+		if colx.ColumnData == nil {
+			continue
+		}
+		number := protowire.Number(colx.ColumnID)
+		if number >= extensionFieldNumber ||
+			(number >= protowire.FirstReservedNumber && number <= protowire.LastReservedNumber) {
+			return nil, cerror.ErrEncodeFailed.GenWithStack(
+				"the column id %d of column %s cannot be used as a protobuf field number",
+				colx.ColumnID, colx.GetName())
+		}
+		tp, err := columnType(colx)
+		if err != nil {
+			return nil, err
+		}
+		field := &fieldSchema{
+			name:     common.SanitizeName(colx.GetName()),
+			number:   int32(number),
+			tp:       tp,
+			tidbType: avro.GetTiDBTypeFromColumn(colx),
+		}
+		switch colx.GetType() {
+		case mysql.TypeEnum, mysql.TypeSet:
+			elems := colx.GetColumnInfo().FieldType.GetElems()
+			es := make([]string, 0, len(elems))
+			for _, e := range elems {
+				es = append(es, common.EscapeEnumAndSetOptions(e))
+			}
+			field.allowed = strings.Join(es, ",")
+		}
+		result.fields = append(result.fields, field)
+	}
+	if withExtension {
+		result.fields = append(result.fields,
+			&fieldSchema{name: tidbOp, number: extensionFieldNumber, tp: typeString},
+			&fieldSchema{name: tidbCommitTs, number: extensionFieldNumber + 1, tp: typeUint64},
+			&fieldSchema{name: tidbPhysicalTime, number: extensionFieldNumber + 2, tp: typeInt64},
+		)
+	}
+	return result, nil
+}
+
+// [<namespace>.]<schema>, the package name must not start with a dot.
+func getPackageName(namespace, schema string) string {
+	ns := common.SanitizeName(namespace)
+	s := common.SanitizeName(schema)
+	if ns == "" {
+		return s
+	}
+	if s != "" {
+		return ns + "." + s
+	}
+	return ns
+}
+
+func columnType(col model.ColumnDataX) (string, error) {
+	switch col.GetType() {
+	case mysql.TypeTiny, mysql.TypeShort, mysql.TypeInt24, mysql.TypeLong, mysql.TypeLonglong:
+		if col.GetFlag().IsUnsigned() {
+			return typeUint64, nil
+		}
+		return typeInt64, nil
+	case mysql.TypeYear:
+		return typeInt64, nil
+	case mysql.TypeBit:
+		return typeUint64, nil
+	case mysql.TypeFloat:
+		return typeFloat, nil
+	case mysql.TypeDouble:
+		return typeDouble, nil
+	case mysql.TypeVarchar, mysql.TypeString, mysql.TypeVarString,
+		mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeBlob:
+		if col.GetFlag().IsBinary() {
+			return typeBytes, nil
+		}
+		return typeString, nil
+	case mysql.TypeNewDecimal, mysql.TypeEnum, mysql.TypeSet, mysql.TypeJSON,
+		mysql.TypeDate, mysql.TypeDatetime, mysql.TypeTimestamp, mysql.TypeDuration,
+		mysql.TypeTiDBVectorFloat32:
+		return typeString, nil
+	default:
+		return "", cerror.ErrEncodeFailed.GenWithStack("unknown mysql type %d", col.GetType())
+	}
+}
+
+// String returns the schema in the protobuf language, which is registered
+// to the schema registry. The TiDB type of each column is kept in comments.
+func (s *messageSchema) String() string {
+	var b strings.Builder
+	b.WriteString("syntax = \"proto3\";\n\n")
+	fmt.Fprintf(&b, "package %s;\n\n", s.pkg)
+	fmt.Fprintf(&b, "message %s {\n", s.name)
+	for _, f := range s.fields {
+		if f.tidbType != "" {
+			fmt.Fprintf(&b, "  // %s%s\n", tidbTypeComment, f.tidbType)
+		}
+		if f.allowed != "" {
+			fmt.Fprintf(&b, "  // %s%s\n", allowedComment, f.allowed)
+		}
+		fmt.Fprintf(&b, "  optional %s %s = %d;\n", f.tp, f.name, f.number)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// parseSchema parses the schema generated by String.
+func parseSchema(text string) (*messageSchema, error) {
+	result := new(messageSchema)
+	var tidbType, allowed string
+	scanner := bufio.NewScanner(strings.NewReader(text))
+	scanner.Buffer(nil, len(text)+1)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "", line == "}", strings.HasPrefix(line, "syntax "):
+		case strings.HasPrefix(line, "package "):
+			result.pkg = strings.TrimSuffix(strings.TrimPrefix(line, "package "), ";")
+		case strings.HasPrefix(line, "message "):
+			result.name = strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(line, "message "), "{"))
+		case strings.HasPrefix(line, "// "+tidbTypeComment):
+			tidbType = strings.TrimPrefix(line, "// "+tidbTypeComment)
+		case strings.HasPrefix(line, "// "+allowedComment):
+			allowed = strings.TrimPrefix(line, "// "+allowedComment)
+		case strings.HasPrefix(line, "optional "):
+			// optional <type> <name> = <number>;
+			parts := strings.Fields(strings.TrimSuffix(line, ";"))
+			if len(parts) != 5 || parts[3] != "=" {
+				return nil, cerror.ErrDecodeFailed.GenWithStack("invalid protobuf field %s", line)
+			}
+			number, err := strconv.ParseInt(parts[4], 10, 32)
+			if err != nil {
+				return nil, cerror.WrapError(cerror.ErrDecodeFailed, err)
+			}
+			result.fields = append(result.fields, &fieldSchema{
+				name:     parts[2],
+				number:   int32(number),
+				tp:       parts[1],
+				tidbType: tidbType,
+				allowed:  allowed,
+			})
+			tidbType, allowed = "", ""
+		default:
+			return nil, cerror.ErrDecodeFailed.GenWithStack("unexpected line in protobuf schema: %s", line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, cerror.WrapError(cerror.ErrDecodeFailed, err)
+	}
+	if result.name == "" {
+		return nil, cerror.ErrDecodeFailed.GenWithStack("message not found in protobuf schema")
+	}
+	return result, nil
+}
+
+// descriptor builds the message descriptor used to marshal and unmarshal messages.
+func (s *messageSchema) descriptor() (protoreflect.MessageDescriptor, error) {
+	msg := &descriptorpb.DescriptorProto{Name: proto.String(s.name)}
+	for i, f := range s.fields {
+		tp, ok := scalarTypes[f.tp]
+		if !ok {
+			return nil, cerror.ErrEncodeFailed.GenWithStack("unknown protobuf type %s", f.tp)
+		}
+		// a proto3 optional field is a member of a synthetic oneof.
+		msg.OneofDecl = append(msg.OneofDecl, &descriptorpb.OneofDescriptorProto{
+			Name: proto.String("_" + f.name),
+		})
+		msg.Field = append(msg.Field, &descriptorpb.FieldDescriptorProto{
+			Name:           proto.String(f.name),
+			Number:         proto.Int32(f.number),
+			Label:          descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:           tp.Enum(),
+			OneofIndex:     proto.Int32(int32(i)),
+			Proto3Optional: proto.Bool(true),
+		})
+	}
+	file := &descriptorpb.FileDescriptorProto{
+		Name:        proto.String(s.pkg + "." + s.name + ".proto"),
+		Package:     proto.String(s.pkg),
+		Syntax:      proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{msg},
+	}
+	fd, err := protodesc.NewFile(file, nil)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrEncodeFailed, err)
+	}
+	return fd.Messages().Get(0), nil
+}
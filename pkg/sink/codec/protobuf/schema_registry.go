@@ -0,0 +1,307 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protobuf
+
+import (
+	"context"
+	"encoding/binary"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/service/glue/types"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/security"
+	"github.com/pingcap/tiflow/pkg/sink/codec/avro"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// schemaType is the type of the registered schemas in the Confluent Schema Registry.
+	schemaType = "PROTOBUF"
+
+	// magicByte is the first byte of the Confluent wire format.
+	magicByte = uint8(0)
+	// glueHeaderVersionByte is the first byte of the Glue wire format.
+	glueHeaderVersionByte = uint8(3)
+	// glueHeaderLen is the length of the Glue wire format header, which is made up of
+	// the version byte, the compression byte and the 16 bytes schema version ID.
+	glueHeaderLen = 18
+)
+
+// schemaRegistry is the schema registry which the protobuf schemas are registered to.
+type schemaRegistry interface {
+	// register registers the schema definition to the subject, and returns the
+	// header of the messages encoded with the schema.
+	register(ctx context.Context, subject, definition string) ([]byte, error)
+	// lookup returns the schema definition of the schema ID.
+	lookup(ctx context.Context, schemaID string) (string, error)
+	// splitMessage returns the schema ID and the protobuf payload of the message.
+	splitMessage(data []byte) (string, []byte, error)
+}
+
+// SchemaManager registers the generated protobuf schemas to the schema registry,
+// and caches the message descriptors used by the encoder and the decoder.
+type SchemaManager struct {
+	registry schemaRegistry
+
+	mu sync.RWMutex
+	// subjects caches the schemas registered by the encoder, keyed by the subject.
+	subjects map[string]*schemaCacheEntry
+	// ids caches the schemas looked up by the decoder, keyed by the schema ID.
+	ids map[string]*schemaCacheEntry
+}
+
+type schemaCacheEntry struct {
+	// tableVersion is the table version the schema is generated from,
+	// it's only set for the schemas registered by the encoder.
+	tableVersion uint64
+	schema       *messageSchema
+	desc         protoreflect.MessageDescriptor
+	// header is the wire format header of the messages.
+	header []byte
+}
+
+// NewSchemaManager creates a SchemaManager using the Confluent Schema Registry,
+// it tests the connectivity to the registry.
+func NewSchemaManager(
+	ctx context.Context, registryURL string, credential *security.Credential,
+) (*SchemaManager, error) {
+	registryURL = strings.TrimRight(registryURL, "/")
+	if err := avro.CheckConfluentSchemaRegistry(ctx, registryURL, credential); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return newSchemaManager(&confluentRegistry{
+		registryURL: registryURL,
+		credential:  credential,
+	}), nil
+}
+
+// NewGlueSchemaManager creates a SchemaManager using the AWS Glue Schema Registry,
+// it checks the registry exists.
+func NewGlueSchemaManager(
+	ctx context.Context, cfg *config.GlueSchemaRegistryConfig,
+) (*SchemaManager, error) {
+	registry, err := avro.NewGlueSchemaRegistry(ctx, cfg, types.DataFormatProtobuf)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return newSchemaManager(&glueRegistry{registry: registry}), nil
+}
+
+func newSchemaManager(registry schemaRegistry) *SchemaManager {
+	return &SchemaManager{
+		registry: registry,
+		subjects: make(map[string]*schemaCacheEntry),
+		ids:      make(map[string]*schemaCacheEntry),
+	}
+}
+
+// getCachedOrRegister returns the cached schema of the subject if it's generated from
+// the same table version, otherwise a new schema is generated, registered and cached.
+// Registering an existing schema returns the same ID, so it's safe to register again
+// after a restart.
+func (m *SchemaManager) getCachedOrRegister(
+	ctx context.Context, subject string, tableVersion uint64,
+	schemaGen func() (*messageSchema, error),
+) (*schemaCacheEntry, error) {
+	m.mu.RLock()
+	entry, ok := m.subjects[subject]
+	m.mu.RUnlock()
+	if ok && entry.tableVersion == tableVersion {
+		return entry, nil
+	}
+
+	schema, err := schemaGen()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	desc, err := schema.descriptor()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	header, err := m.registry.register(ctx, subject, schema.String())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	entry = &schemaCacheEntry{
+		tableVersion: tableVersion,
+		schema:       schema,
+		desc:         desc,
+		header:       header,
+	}
+
+	m.mu.Lock()
+	m.subjects[subject] = entry
+	m.mu.Unlock()
+	log.Info("protobuf schema registered",
+		zap.String("subject", subject),
+		zap.Uint64("tableVersion", tableVersion))
+	return entry, nil
+}
+
+// lookup returns the schema of the message and the protobuf payload, the schema
+// is fetched from the registry if not cached yet.
+func (m *SchemaManager) lookup(ctx context.Context, data []byte) (*schemaCacheEntry, []byte, error) {
+	id, payload, err := m.registry.splitMessage(data)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+
+	m.mu.RLock()
+	entry, ok := m.ids[id]
+	m.mu.RUnlock()
+	if ok {
+		return entry, payload, nil
+	}
+
+	text, err := m.registry.lookup(ctx, id)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	schema, err := parseSchema(text)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	desc, err := schema.descriptor()
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	entry = &schemaCacheEntry{
+		schema: schema,
+		desc:   desc,
+		header: data[:len(data)-len(payload)],
+	}
+
+	m.mu.Lock()
+	m.ids[id] = entry
+	m.mu.Unlock()
+	return entry, payload, nil
+}
+
+// confluentRegistry is the Confluent Schema Registry.
+type confluentRegistry struct {
+	registryURL string
+	credential  *security.Credential
+}
+
+func (r *confluentRegistry) register(
+	ctx context.Context, subject, definition string,
+) ([]byte, error) {
+	id, err := avro.RegisterConfluentSchema(
+		ctx, r.registryURL, r.credential, subject, schemaType, definition)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return getMsgHeader(id), nil
+}
+
+func (r *confluentRegistry) lookup(ctx context.Context, schemaID string) (string, error) {
+	id, err := strconv.Atoi(schemaID)
+	if err != nil {
+		return "", cerror.WrapError(cerror.ErrDecodeFailed, err)
+	}
+	text, err := avro.LookupConfluentSchema(ctx, r.registryURL, r.credential, id)
+	return text, errors.Trace(err)
+}
+
+func (r *confluentRegistry) splitMessage(data []byte) (string, []byte, error) {
+	id, payload, err := extractSchemaIDAndBinaryData(data)
+	if err != nil {
+		return "", nil, errors.Trace(err)
+	}
+	return strconv.Itoa(id), payload, nil
+}
+
+// getMsgHeader returns the Confluent wire format header of a protobuf message:
+// the magic byte, the 4 bytes schema ID in big endian, and the message indexes.
+// Each schema only contains one message, so the message indexes is always [0],
+// which is encoded as a single 0 byte.
+func getMsgHeader(schemaID int) []byte {
+	header := make([]byte, 6)
+	header[0] = magicByte
+	binary.BigEndian.PutUint32(header[1:5], uint32(schemaID))
+	return header
+}
+
+// extractSchemaIDAndBinaryData returns the schema ID and the protobuf payload of the message.
+func extractSchemaIDAndBinaryData(data []byte) (int, []byte, error) {
+	if len(data) < 6 {
+		return 0, nil, cerror.ErrDecodeFailed.GenWithStack(
+			"a protobuf message using confluent schema registry should have at least 6 bytes")
+	}
+	if data[0] != magicByte {
+		return 0, nil, cerror.ErrDecodeFailed.GenWithStack("magic byte is not match, it should be 0")
+	}
+	id := int(binary.BigEndian.Uint32(data[1:5]))
+	// the message indexes is an array of zigzag varints prefixed by its length,
+	// the array [0] is optimized to be a single 0 byte.
+	if data[5] != 0 {
+		return 0, nil, cerror.ErrDecodeFailed.GenWithStack(
+			"only the first message of the schema is supported, schema id %d", id)
+	}
+	return id, data[6:], nil
+}
+
+// glueRegistry is the AWS Glue Schema Registry.
+type glueRegistry struct {
+	registry *avro.GlueSchemaRegistry
+}
+
+// register returns the Glue wire format header of a protobuf message: the glue
+// header holding the schema version ID, followed by the varint index of the message
+// in the schema, which is always 0 since each schema only contains one message.
+func (r *glueRegistry) register(
+	ctx context.Context, subject, definition string,
+) ([]byte, error) {
+	id, err := r.registry.Register(ctx, subject, definition)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	header, err := avro.GlueMsgHeader(id)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return append(header, 0), nil
+}
+
+func (r *glueRegistry) lookup(ctx context.Context, schemaID string) (string, error) {
+	text, err := r.registry.Lookup(ctx, schemaID)
+	return text, errors.Trace(err)
+}
+
+func (r *glueRegistry) splitMessage(data []byte) (string, []byte, error) {
+	if len(data) < glueHeaderLen+1 {
+		return "", nil, cerror.ErrDecodeFailed.GenWithStack(
+			"a protobuf message using glue schema registry should have at least %d bytes",
+			glueHeaderLen+1)
+	}
+	if data[0] != glueHeaderVersionByte {
+		return "", nil, cerror.ErrDecodeFailed.GenWithStack(
+			"header version byte is not match, it should be %d", glueHeaderVersionByte)
+	}
+	id, err := avro.GlueSchemaIDFromHeader(data[:glueHeaderLen])
+	if err != nil {
+		return "", nil, errors.Trace(err)
+	}
+	if data[glueHeaderLen] != 0 {
+		return "", nil, cerror.ErrDecodeFailed.GenWithStack(
+			"only the first message of the schema is supported, schema id %s", id)
+	}
+	return id, data[glueHeaderLen+1:], nil
+}
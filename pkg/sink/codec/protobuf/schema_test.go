@@ -0,0 +1,60 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protobuf
+
+import (
+	"testing"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/sink/codec/utils"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestSchemaRoundTrip(t *testing.T) {
+	_, event, _, _ := utils.NewLargeEvent4Test(t, config.GetDefaultReplicaConfig())
+
+	schema, err := newMessageSchema(model.DefaultNamespace, event.TableInfo, event.Columns, true)
+	require.NoError(t, err)
+	require.Equal(t, "default.test", schema.pkg)
+	require.Equal(t, "t", schema.name)
+	require.Len(t, schema.fields, len(event.Columns)+3)
+
+	text := schema.String()
+	require.Contains(t, text, "syntax = \"proto3\";")
+	require.Contains(t, text, "// tidb_type: INT UNSIGNED\n  optional uint64 tu1 = 2;")
+	require.Contains(t, text, "// allowed: a,b,c\n  optional string enumT")
+	require.Contains(t, text, "optional bytes binaryT")
+	require.Contains(t, text, "optional uint64 _tidb_commit_ts = 100001;")
+
+	parsed, err := parseSchema(text)
+	require.NoError(t, err)
+	require.Equal(t, schema, parsed)
+
+	desc, err := parsed.descriptor()
+	require.NoError(t, err)
+	require.Equal(t, len(schema.fields), desc.Fields().Len())
+	for _, f := range schema.fields {
+		fd := desc.Fields().ByName(protoreflect.Name(f.name))
+		require.NotNil(t, fd, f.name)
+		require.Equal(t, f.number, int32(fd.Number()))
+		require.True(t, fd.HasPresence())
+	}
+
+	_, err = parseSchema("syntax = \"proto3\";\n")
+	require.Error(t, err)
+	_, err = parseSchema("message t {\n  optional int64 a;\n}\n")
+	require.Error(t, err)
+}
@@ -0,0 +1,161 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deadletter
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink"
+)
+
+// Record is a row which is permanently rejected by the downstream,
+// it is written to the dead-letter queue in JSON format.
+type Record struct {
+	Namespace  string `json:"namespace"`
+	Changefeed string `json:"changefeed"`
+	Schema     string `json:"schema"`
+	Table      string `json:"table"`
+	// Type is one of INSERT, UPDATE and DELETE.
+	Type     string `json:"type"`
+	StartTs  uint64 `json:"start-ts"`
+	CommitTs uint64 `json:"commit-ts"`
+	// Error is the error returned by the downstream.
+	Error string `json:"error"`
+	// Columns and PreColumns hold the string representation of the
+	// column values, a nil value means NULL.
+	Columns    map[string]interface{} `json:"columns,omitempty"`
+	PreColumns map[string]interface{} `json:"pre-columns,omitempty"`
+}
+
+// NewRecord creates a Record from the row and the error rejecting it.
+func NewRecord(
+	changefeedID model.ChangeFeedID, row *model.RowChangedEvent, err error,
+) *Record {
+	r := &Record{
+		Namespace:  changefeedID.Namespace,
+		Changefeed: changefeedID.ID,
+		Schema:     row.TableInfo.GetSchemaName(),
+		Table:      row.TableInfo.GetTableName(),
+		StartTs:    row.StartTs,
+		CommitTs:   row.CommitTs,
+		Columns:    columnsToMap(row.GetColumns()),
+		PreColumns: columnsToMap(row.GetPreColumns()),
+	}
+	switch {
+	case row.IsInsert():
+		r.Type = "INSERT"
+	case row.IsDelete():
+		r.Type = "DELETE"
+	default:
+		r.Type = "UPDATE"
+	}
+	if err != nil {
+		r.Error = err.Error()
+	}
+	return r
+}
+
+func columnsToMap(cols []*model.Column) map[string]interface{} {
+	if len(cols) == 0 {
+		return nil
+	}
+	m := make(map[string]interface{}, len(cols))
+	for _, col := range cols {
+		if col == nil {
+			continue
+		}
+		if col.Value == nil {
+			m[col.Name] = nil
+			continue
+		}
+		m[col.Name] = model.ColumnValueString(col.Value)
+	}
+	return m
+}
+
+// permanentEncodeErrors are the errors returned by the codecs when a row
+// can never be encoded, no matter how many times it is retried.
+var permanentEncodeErrors = []*errors.Error{
+	cerror.ErrMessageTooLarge,
+	cerror.ErrEncodeFailed,
+	cerror.ErrCanalEncodeFailed,
+	cerror.ErrMaxwellEncodeFailed,
+	cerror.ErrMaxwellInvalidData,
+	cerror.ErrDebeziumEncodeFailed,
+	cerror.ErrCraftCodecInvalidData,
+	cerror.ErrOpenProtocolCodecInvalidData,
+	cerror.ErrAvroEncodeFailed,
+	cerror.ErrAvroEncodeToBinary,
+	cerror.ErrAvroToEnvelopeError,
+	cerror.ErrAvroMarshalFailed,
+}
+
+// IsPermanentEncodeError returns whether err means the row can never be
+// encoded by the codec, so the row can be routed to the dead-letter queue.
+// Errors which may be transient, such as schema registry failures, are
+// not included.
+func IsPermanentEncodeError(err error) bool {
+	if err == nil {
+		return false
+	}
+	rfcCode, hasRFCCode := cerror.RFCCode(err)
+	for _, e := range permanentEncodeErrors {
+		if e.Equal(err) || (hasRFCCode && e.RFCCode() == rfcCode) {
+			return true
+		}
+	}
+	return false
+}
+
+// Writer writes the rejected rows to the dead-letter queue.
+// It is safe for concurrent use.
+type Writer interface {
+	// Write writes the records to the dead-letter queue, the records are
+	// durable once it returns without error.
+	Write(ctx context.Context, records ...*Record) error
+	// Count returns the number of records written by this writer.
+	Count() uint64
+	// Close closes the writer, it can be called multiple times.
+	Close()
+}
+
+// NewWriter creates a Writer by the dead-letter configuration.
+// It returns nil if the dead-letter queue is not enabled.
+func NewWriter(
+	ctx context.Context,
+	changefeedID model.ChangeFeedID,
+	cfg *config.DeadLetterConfig,
+) (Writer, error) {
+	if !cfg.IsEnabled() {
+		return nil, nil
+	}
+	uri, err := url.Parse(*cfg.URI)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrSinkInvalidConfig, err)
+	}
+	scheme := sink.GetScheme(uri)
+	switch {
+	case sink.IsStorageScheme(scheme):
+		return newStorageWriter(ctx, changefeedID, uri)
+	case scheme == sink.KafkaScheme || scheme == sink.KafkaSSLScheme:
+		return newKafkaWriter(ctx, changefeedID, uri)
+	}
+	return nil, errors.Trace(cerror.ErrSinkInvalidConfig.GenWithStack(
+		"the scheme %s of the dead-letter queue is not supported", scheme))
+}
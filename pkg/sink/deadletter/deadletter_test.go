@@ -0,0 +1,135 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deadletter
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pingcap/tiflow/cdc/entry"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWriterDisabled(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	id := model.DefaultChangeFeedID("test")
+	w, err := NewWriter(ctx, id, nil)
+	require.NoError(t, err)
+	require.Nil(t, w)
+
+	w, err = NewWriter(ctx, id, &config.DeadLetterConfig{URI: new(string)})
+	require.NoError(t, err)
+	require.Nil(t, w)
+
+	uri := "blackhole://"
+	_, err = NewWriter(ctx, id, &config.DeadLetterConfig{URI: &uri})
+	require.ErrorContains(t, err, "not supported")
+}
+
+func TestNewRecord(t *testing.T) {
+	helper := entry.NewSchemaTestHelper(t)
+	defer helper.Close()
+
+	_ = helper.DDL2Event("create table test.t(id int primary key, name varchar(10), age int)")
+	insert := helper.DML2Event("insert into test.t values (1, 'alice', null)", "test", "t")
+	insert.CommitTs = 100
+	insert.StartTs = 99
+
+	r := NewRecord(model.DefaultChangeFeedID("test"), insert, errors.New("Data too long"))
+	require.Equal(t, "default", r.Namespace)
+	require.Equal(t, "test", r.Changefeed)
+	require.Equal(t, "test", r.Schema)
+	require.Equal(t, "t", r.Table)
+	require.Equal(t, "INSERT", r.Type)
+	require.Equal(t, uint64(99), r.StartTs)
+	require.Equal(t, uint64(100), r.CommitTs)
+	require.Equal(t, "Data too long", r.Error)
+	require.Equal(t, map[string]interface{}{
+		"id": "1", "name": "alice", "age": nil,
+	}, r.Columns)
+	require.Nil(t, r.PreColumns)
+
+	deleteRow := *insert
+	deleteRow.PreColumns, deleteRow.Columns = insert.Columns, nil
+	r = NewRecord(model.DefaultChangeFeedID("test"), &deleteRow, nil)
+	require.Equal(t, "DELETE", r.Type)
+	require.Empty(t, r.Error)
+	require.Nil(t, r.Columns)
+	require.NotNil(t, r.PreColumns)
+}
+
+func TestStorageWriter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dir := t.TempDir()
+	uri := fmt.Sprintf("file://%s", dir)
+	id := model.DefaultChangeFeedID("test")
+	w, err := NewWriter(ctx, id, &config.DeadLetterConfig{URI: &uri})
+	require.NoError(t, err)
+	require.NotNil(t, w)
+	defer w.Close()
+
+	records := []*Record{
+		{Schema: "test", Table: "t", Type: "INSERT", CommitTs: 10, Error: "err1"},
+		{Schema: "test", Table: "t", Type: "DELETE", CommitTs: 11, Error: "err2"},
+	}
+	require.NoError(t, w.Write(ctx))
+	require.NoError(t, w.Write(ctx, records...))
+	require.Equal(t, uint64(2), w.Count())
+
+	files, err := filepath.Glob(filepath.Join(dir, "default", "test", "10_*.json"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	data, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	var got []*Record
+	for scanner.Scan() {
+		r := &Record{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), r))
+		got = append(got, r)
+	}
+	require.Equal(t, records, got)
+
+	// Close can be called multiple times.
+	w.Close()
+}
+
+func TestIsPermanentEncodeError(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, IsPermanentEncodeError(cerror.ErrMessageTooLarge.GenWithStackByArgs()))
+	require.True(t, IsPermanentEncodeError(cerror.WrapError(cerror.ErrCanalEncodeFailed, errors.New("mock"))))
+	require.True(t, IsPermanentEncodeError(cerror.WrapError(cerror.ErrAvroEncodeFailed, errors.New("mock"))))
+	require.True(t, IsPermanentEncodeError(cerror.ErrEncodeFailed.GenWithStack("unknown mysql type")))
+
+	// schema registry failures and other errors may be transient.
+	require.False(t, IsPermanentEncodeError(cerror.ErrAvroSchemaAPIError.GenWithStack("mock")))
+	require.False(t, IsPermanentEncodeError(errors.New("mock")))
+	require.False(t, IsPermanentEncodeError(nil))
+}
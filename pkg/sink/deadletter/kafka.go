@@ -0,0 +1,106 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deadletter
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"github.com/pingcap/tiflow/pkg/sink/kafka"
+	"github.com/pingcap/tiflow/pkg/util"
+	"go.uber.org/zap"
+)
+
+// kafkaWriter writes the records to a kafka topic, one message per record.
+// All messages are sent to the first partition to keep them in order.
+type kafkaWriter struct {
+	changefeedID model.ChangeFeedID
+	topic        string
+	producer     kafka.SyncProducer
+	count        atomic.Uint64
+	closeOnce    sync.Once
+}
+
+func newKafkaWriter(
+	ctx context.Context, changefeedID model.ChangeFeedID, uri *url.URL,
+) (*kafkaWriter, error) {
+	topic := strings.Trim(uri.Path, "/")
+	if topic == "" {
+		return nil, cerror.ErrSinkInvalidConfig.GenWithStack(
+			"the topic of the dead-letter queue is not specified")
+	}
+	options := kafka.NewOptions()
+	if err := options.Apply(changefeedID, uri, config.GetDefaultReplicaConfig()); err != nil {
+		return nil, cerror.WrapError(cerror.ErrSinkInvalidConfig, err)
+	}
+	factory, err := kafka.NewSaramaFactory(options, changefeedID)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrDeadLetterWrite, err)
+	}
+	producer, err := factory.SyncProducer(ctx)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrDeadLetterWrite, err)
+	}
+	log.Info("dead-letter queue is enabled",
+		zap.String("namespace", changefeedID.Namespace),
+		zap.String("changefeed", changefeedID.ID),
+		zap.String("uri", util.MaskSensitiveDataInURI(uri.String())))
+	return &kafkaWriter{
+		changefeedID: changefeedID,
+		topic:        topic,
+		producer:     producer,
+	}, nil
+}
+
+// Write implements Writer.
+func (w *kafkaWriter) Write(ctx context.Context, records ...*Record) error {
+	for _, r := range records {
+		value, err := json.Marshal(r)
+		if err != nil {
+			return cerror.WrapError(cerror.ErrDeadLetterWrite, err)
+		}
+		message := &common.Message{Value: value, Ts: r.CommitTs, Type: model.MessageTypeRow}
+		if err := w.producer.SendMessage(ctx, w.topic, 0, message); err != nil {
+			return cerror.WrapError(cerror.ErrDeadLetterWrite, err)
+		}
+		w.count.Add(1)
+	}
+	log.Warn("rows are written to the dead-letter queue",
+		zap.String("namespace", w.changefeedID.Namespace),
+		zap.String("changefeed", w.changefeedID.ID),
+		zap.String("topic", w.topic),
+		zap.Int("count", len(records)))
+	return nil
+}
+
+// Count implements Writer.
+func (w *kafkaWriter) Count() uint64 {
+	return w.count.Load()
+}
+
+// Close implements Writer.
+func (w *kafkaWriter) Close() {
+	w.closeOnce.Do(func() {
+		w.producer.Close()
+	})
+}
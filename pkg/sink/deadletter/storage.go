@@ -0,0 +1,97 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deadletter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sync"
+	"sync/atomic"
+
+	"github.com/google/uuid"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/util"
+	"go.uber.org/zap"
+)
+
+// storageWriter writes the records to the external storage. Each call of
+// Write creates a new file `<namespace>/<changefeed>/<commit-ts>_<uuid>.json`
+// which contains one JSON record per line.
+type storageWriter struct {
+	changefeedID model.ChangeFeedID
+	storage      storage.ExternalStorage
+	count        atomic.Uint64
+	closeOnce    sync.Once
+}
+
+func newStorageWriter(
+	ctx context.Context, changefeedID model.ChangeFeedID, uri *url.URL,
+) (*storageWriter, error) {
+	s, err := util.GetExternalStorageWithDefaultTimeout(ctx, uri.String())
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrDeadLetterWrite, err)
+	}
+	log.Info("dead-letter queue is enabled",
+		zap.String("namespace", changefeedID.Namespace),
+		zap.String("changefeed", changefeedID.ID),
+		zap.String("uri", util.MaskSensitiveDataInURI(uri.String())))
+	return &storageWriter{
+		changefeedID: changefeedID,
+		storage:      s,
+	}, nil
+}
+
+// Write implements Writer.
+func (w *storageWriter) Write(ctx context.Context, records ...*Record) error {
+	if len(records) == 0 {
+		return nil
+	}
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for _, r := range records {
+		if err := encoder.Encode(r); err != nil {
+			return cerror.WrapError(cerror.ErrDeadLetterWrite, err)
+		}
+	}
+	name := fmt.Sprintf("%s/%s/%d_%s.json",
+		w.changefeedID.Namespace, w.changefeedID.ID, records[0].CommitTs, uuid.NewString())
+	if err := w.storage.WriteFile(ctx, name, buf.Bytes()); err != nil {
+		return cerror.WrapError(cerror.ErrDeadLetterWrite, err)
+	}
+	w.count.Add(uint64(len(records)))
+	log.Warn("rows are written to the dead-letter queue",
+		zap.String("namespace", w.changefeedID.Namespace),
+		zap.String("changefeed", w.changefeedID.ID),
+		zap.String("file", name),
+		zap.Int("count", len(records)))
+	return nil
+}
+
+// Count implements Writer.
+func (w *storageWriter) Count() uint64 {
+	return w.count.Load()
+}
+
+// Close implements Writer.
+func (w *storageWriter) Close() {
+	w.closeOnce.Do(func() {
+		w.storage.Close()
+	})
+}
@@ -0,0 +1,288 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/retry"
+	"go.uber.org/zap"
+)
+
+const (
+	// contentType is the content type of the bulk request body.
+	contentType = "application/x-ndjson"
+	// maxResponseBodySize is the max size of response body kept in the error.
+	maxResponseBodySize = 1024
+)
+
+// Client sends bulk requests to the elasticsearch cluster.
+type Client struct {
+	changefeedID model.ChangeFeedID
+	cfg          *Config
+	client       *http.Client
+}
+
+// NewClient creates a new elasticsearch client.
+func NewClient(changefeedID model.ChangeFeedID, cfg *Config) *Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConnsPerHost = cfg.WorkerCount
+	if cfg.TLSConfig != nil {
+		transport.TLSClientConfig = cfg.TLSConfig
+	}
+	return &Client{
+		changefeedID: changefeedID,
+		cfg:          cfg,
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   cfg.Timeout,
+		},
+	}
+}
+
+// statusError is returned when the cluster rejects the request or an
+// action in the request.
+type statusError struct {
+	code int
+	body string
+}
+
+func (e *statusError) Error() string {
+	return "elasticsearch responds " + strconv.Itoa(e.code) + ": " + e.body
+}
+
+// isRetryable returns whether the error is worth to retry. The client errors
+// are not retried except timeout and throttling, since the same request
+// always gets the same response.
+func isRetryable(err error) bool {
+	if cerror.IsContextCanceledError(err) {
+		return false
+	}
+	var se *statusError
+	if cerror.As(err, &se) {
+		if se.code >= http.StatusBadRequest && se.code < http.StatusInternalServerError {
+			return se.code == http.StatusRequestTimeout || se.code == http.StatusTooManyRequests
+		}
+	}
+	return true
+}
+
+type actionMeta struct {
+	Index       string `json:"_index"`
+	ID          string `json:"_id"`
+	Version     uint64 `json:"version"`
+	VersionType string `json:"version_type"`
+}
+
+type bulkResponse struct {
+	Errors bool                         `json:"errors"`
+	Items  []map[OpType]*bulkItemResult `json:"items"`
+}
+
+type bulkItemResult struct {
+	Index  string          `json:"_index"`
+	ID     string          `json:"_id"`
+	Status int             `json:"status"`
+	Error  json.RawMessage `json:"error,omitempty"`
+}
+
+// Bulk sends the actions in one bulk request, it retries with backoff until
+// all actions succeed, a non-retryable error occurs or the max retries is
+// reached. The whole request is resent on retry, which is safe because the
+// actions are versioned by the commit ts.
+func (c *Client) Bulk(ctx context.Context, actions []*Action) error {
+	if len(actions) == 0 {
+		return nil
+	}
+	payload, err := encodeBulkBody(actions)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	err = retry.Do(ctx, func() error {
+		return c.post(ctx, actions, payload)
+	}, retry.WithBackoffBaseDelay(c.cfg.BackoffBaseInMs),
+		retry.WithBackoffMaxDelay(c.cfg.BackoffMaxInMs),
+		retry.WithMaxTries(uint64(c.cfg.MaxRetries)+1),
+		retry.WithIsRetryableErr(isRetryable))
+	if err != nil {
+		var se *statusError
+		if cerror.As(err, &se) {
+			return cerror.ErrElasticsearchBulkRequest.GenWithStackByArgs(se.code, se.body)
+		}
+		return cerror.WrapError(cerror.ErrElasticsearchBulkRequest, err, 0, "")
+	}
+	return nil
+}
+
+func encodeBulkBody(actions []*Action) ([]byte, error) {
+	var body bytes.Buffer
+	for _, action := range actions {
+		meta, err := json.Marshal(map[OpType]*actionMeta{
+			action.Op: {
+				Index:       action.Index,
+				ID:          action.ID,
+				Version:     action.Version,
+				VersionType: versionType,
+			},
+		})
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		body.Write(meta)
+		body.WriteByte('\n')
+		if action.Op == OpIndex {
+			body.Write(action.Document)
+			body.WriteByte('\n')
+		}
+	}
+	return body.Bytes(), nil
+}
+
+func (c *Client) post(ctx context.Context, actions []*Action, payload []byte) error {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		c.cfg.Endpoint+"/_bulk", bytes.NewReader(payload))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	httpReq.Header.Set("Content-Type", contentType)
+	c.setAuth(httpReq)
+
+	start := time.Now()
+	resp, err := c.client.Do(httpReq)
+	if err != nil {
+		log.Warn("elasticsearch bulk request failed",
+			zap.String("namespace", c.changefeedID.Namespace),
+			zap.String("changefeed", c.changefeedID.ID),
+			zap.Int("actions", len(actions)),
+			zap.Duration("duration", time.Since(start)),
+			zap.Error(err))
+		return errors.Trace(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBodySize))
+		// Drain the body so that the connection can be reused.
+		_, _ = io.Copy(io.Discard, resp.Body)
+		log.Warn("elasticsearch responds with unexpected status code",
+			zap.String("namespace", c.changefeedID.Namespace),
+			zap.String("changefeed", c.changefeedID.ID),
+			zap.Int("statusCode", resp.StatusCode),
+			zap.ByteString("response", respBody))
+		return &statusError{code: resp.StatusCode, body: string(respBody)}
+	}
+
+	var result bulkResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return errors.Trace(err)
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if !result.Errors {
+		return nil
+	}
+	return c.checkItems(actions, result.Items)
+}
+
+// DeleteIndex deletes the index and all documents in it. A missing index
+// is treated as success, so that the deletion is idempotent.
+func (c *Client) DeleteIndex(ctx context.Context, index string) error {
+	err := retry.Do(ctx, func() error {
+		httpReq, err := http.NewRequestWithContext(ctx, http.MethodDelete,
+			c.cfg.Endpoint+"/"+url.PathEscape(index), nil)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		c.setAuth(httpReq)
+		resp, err := c.client.Do(httpReq)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		defer resp.Body.Close()
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBodySize))
+		_, _ = io.Copy(io.Discard, resp.Body)
+		if resp.StatusCode == http.StatusNotFound ||
+			(resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices) {
+			return nil
+		}
+		return &statusError{code: resp.StatusCode, body: string(respBody)}
+	}, retry.WithBackoffBaseDelay(c.cfg.BackoffBaseInMs),
+		retry.WithBackoffMaxDelay(c.cfg.BackoffMaxInMs),
+		retry.WithMaxTries(uint64(c.cfg.MaxRetries)+1),
+		retry.WithIsRetryableErr(isRetryable))
+	if err != nil {
+		return cerror.WrapError(cerror.ErrElasticsearchDeleteIndex, err, index)
+	}
+	return nil
+}
+
+func (c *Client) setAuth(httpReq *http.Request) {
+	if c.cfg.APIKey != "" {
+		httpReq.Header.Set("Authorization", "ApiKey "+c.cfg.APIKey)
+	} else if c.cfg.Username != "" {
+		httpReq.SetBasicAuth(c.cfg.Username, c.cfg.Password)
+	}
+}
+
+// checkItems returns the most severe error of the actions in a bulk
+// request. A version conflict means the document has been written by a
+// newer change, and a missing document for a delete means it has been
+// deleted already, so both of them are treated as success.
+func (c *Client) checkItems(actions []*Action, items []map[OpType]*bulkItemResult) error {
+	var retryableErr error
+	for i, item := range items {
+		for op, res := range item {
+			if res.Status >= http.StatusOK && res.Status < http.StatusMultipleChoices {
+				continue
+			}
+			if res.Status == http.StatusConflict ||
+				(op == OpDelete && res.Status == http.StatusNotFound) {
+				continue
+			}
+			err := &statusError{
+				code: res.Status,
+				body: fmt.Sprintf("%s %s/%s: %s", op, res.Index, res.ID, res.Error),
+			}
+			if !isRetryable(err) {
+				log.Warn("elasticsearch rejects the action",
+					zap.String("namespace", c.changefeedID.Namespace),
+					zap.String("changefeed", c.changefeedID.ID),
+					zap.Int("position", i),
+					zap.Int("actions", len(actions)),
+					zap.Error(err))
+				return err
+			}
+			if retryableErr == nil {
+				retryableErr = err
+			}
+		}
+	}
+	return retryableErr
+}
+
+// Close closes the idle connections of the client.
+func (c *Client) Close() {
+	c.client.CloseIdleConnections()
+}
@@ -0,0 +1,180 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearch
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) (*Client, func()) {
+	server := httptest.NewServer(handler)
+	cfg := NewConfig()
+	cfg.Endpoint = server.URL
+	cfg.MaxRetries = 2
+	cfg.BackoffBaseInMs = 1
+	cfg.BackoffMaxInMs = 1
+	cfg.Username = "elastic"
+	cfg.Password = "secret"
+	client := NewClient(model.DefaultChangeFeedID("test"), cfg)
+	return client, func() {
+		client.Close()
+		server.Close()
+	}
+}
+
+var testActions = []*Action{
+	{Op: OpIndex, Index: "test_t", ID: "1", Version: 100, Document: []byte(`{"a":1}`)},
+	{Op: OpDelete, Index: "test_t", ID: "2", Version: 101},
+}
+
+func TestClientBulk(t *testing.T) {
+	t.Parallel()
+
+	var (
+		body     string
+		path     string
+		header   http.Header
+		username string
+		password string
+	)
+	client, closer := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		data, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		body = string(data)
+		path = r.URL.Path
+		header = r.Header
+		username, password, _ = r.BasicAuth()
+		// A delete of a missing document is not an error.
+		_, _ = w.Write([]byte(`{"errors":false,"items":[` +
+			`{"index":{"_index":"test_t","_id":"1","status":201}},` +
+			`{"delete":{"_index":"test_t","_id":"2","status":404}}]}`))
+	})
+	defer closer()
+
+	require.NoError(t, client.Bulk(context.Background(), testActions))
+	require.Equal(t, "/_bulk", path)
+	require.Equal(t, contentType, header.Get("Content-Type"))
+	require.Equal(t, "elastic", username)
+	require.Equal(t, "secret", password)
+	require.Equal(t,
+		`{"index":{"_index":"test_t","_id":"1","version":100,"version_type":"external_gte"}}`+"\n"+
+			`{"a":1}`+"\n"+
+			`{"delete":{"_index":"test_t","_id":"2","version":101,"version_type":"external_gte"}}`+"\n",
+		body)
+}
+
+func TestClientBulkItemErrors(t *testing.T) {
+	t.Parallel()
+
+	// A version conflict means a newer change has been written.
+	client, closer := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"errors":true,"items":[` +
+			`{"index":{"_index":"test_t","_id":"1","status":409,` +
+			`"error":{"type":"version_conflict_engine_exception"}}},` +
+			`{"delete":{"_index":"test_t","_id":"2","status":200}}]}`))
+	})
+	require.NoError(t, client.Bulk(context.Background(), testActions))
+	closer()
+
+	// The rejected items are retried.
+	var count atomic.Int32
+	client, closer = newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if count.Add(1) < 3 {
+			_, _ = w.Write([]byte(`{"errors":true,"items":[` +
+				`{"index":{"_index":"test_t","_id":"1","status":429,` +
+				`"error":{"type":"es_rejected_execution_exception"}}},` +
+				`{"delete":{"_index":"test_t","_id":"2","status":200}}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"errors":false,"items":[]}`))
+	})
+	require.NoError(t, client.Bulk(context.Background(), testActions))
+	require.Equal(t, int32(3), count.Load())
+	closer()
+
+	// The mapping errors are not retried.
+	count.Store(0)
+	client, closer = newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		count.Add(1)
+		_, _ = w.Write([]byte(`{"errors":true,"items":[` +
+			`{"index":{"_index":"test_t","_id":"1","status":400,` +
+			`"error":{"type":"mapper_parsing_exception"}}},` +
+			`{"delete":{"_index":"test_t","_id":"2","status":200}}]}`))
+	})
+	defer closer()
+	err := client.Bulk(context.Background(), testActions)
+	require.ErrorContains(t, err, "status code 400")
+	require.ErrorContains(t, err, "mapper_parsing_exception")
+	require.Equal(t, int32(1), count.Load())
+}
+
+func TestClientBulkRequestError(t *testing.T) {
+	t.Parallel()
+
+	var count atomic.Int32
+	client, closer := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		count.Add(1)
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte("unauthorized"))
+	})
+	defer closer()
+
+	err := client.Bulk(context.Background(), testActions)
+	require.ErrorContains(t, err, "status code 401")
+	require.ErrorContains(t, err, "unauthorized")
+	require.Equal(t, int32(1), count.Load())
+}
+
+func TestClientDeleteIndex(t *testing.T) {
+	t.Parallel()
+
+	var (
+		method string
+		path   string
+		count  atomic.Int32
+	)
+	client, closer := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		switch count.Add(1) {
+		case 1:
+			_, _ = w.Write([]byte(`{"acknowledged":true}`))
+		case 2:
+			// A missing index is not an error.
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte("forbidden"))
+		}
+	})
+	defer closer()
+
+	require.NoError(t, client.DeleteIndex(context.Background(), "test_t"))
+	require.Equal(t, http.MethodDelete, method)
+	require.Equal(t, "/test_t", path)
+	require.NoError(t, client.DeleteIndex(context.Background(), "test_t"))
+
+	err := client.DeleteIndex(context.Background(), "test_t")
+	require.ErrorContains(t, err, string(cerror.ErrElasticsearchDeleteIndex.RFCCode()))
+	require.ErrorContains(t, err, "forbidden")
+	require.Equal(t, int32(3), count.Load())
+}
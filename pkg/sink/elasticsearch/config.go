@@ -0,0 +1,276 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearch
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/imdario/mergo"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink/mq/dispatcher/topic"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/security"
+	psink "github.com/pingcap/tiflow/pkg/sink"
+	"github.com/pingcap/tiflow/pkg/util"
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultIndex is the default value of index.
+	defaultIndex topic.Expression = "{schema}_{table}"
+	// defaultWorkerCount is the default value of worker-count.
+	defaultWorkerCount = 8
+	// the upper limit of worker-count.
+	maxWorkerCount = 128
+	// defaultMaxBatchRows is the default value of max-batch-rows.
+	defaultMaxBatchRows = 1024
+	// the upper limit of max-batch-rows.
+	maxMaxBatchRows = 16384
+	// defaultTimeout is the default value of timeout.
+	defaultTimeout = 30 * time.Second
+	// defaultMaxRetries is the default value of max-retries.
+	defaultMaxRetries = 10
+	// defaultBackoffBaseInMs is the default initial backoff between two retries.
+	defaultBackoffBaseInMs = 500
+	// defaultBackoffMaxInMs is the default maximum backoff between two retries.
+	defaultBackoffMaxInMs = 30 * 1000
+)
+
+type urlConfig struct {
+	Index        *string `form:"index"`
+	WorkerCount  *int    `form:"worker-count"`
+	MaxBatchRows *int    `form:"max-batch-rows"`
+	Timeout      *string `form:"timeout"`
+	MaxRetries   *int    `form:"max-retries"`
+}
+
+// Config is the configuration for elasticsearch sink.
+type Config struct {
+	// Endpoint is the base URL of the cluster, the bulk requests are sent
+	// to `{Endpoint}/_bulk`.
+	Endpoint        string
+	Index           topic.Expression
+	WorkerCount     int
+	MaxBatchRows    int
+	Timeout         time.Duration
+	MaxRetries      int
+	BackoffBaseInMs int64
+	BackoffMaxInMs  int64
+	Username        string
+	Password        string
+	APIKey          string
+	TLSConfig       *tls.Config
+}
+
+// NewConfig returns the default elasticsearch sink config.
+func NewConfig() *Config {
+	return &Config{
+		Index:           defaultIndex,
+		WorkerCount:     defaultWorkerCount,
+		MaxBatchRows:    defaultMaxBatchRows,
+		Timeout:         defaultTimeout,
+		MaxRetries:      defaultMaxRetries,
+		BackoffBaseInMs: defaultBackoffBaseInMs,
+		BackoffMaxInMs:  defaultBackoffMaxInMs,
+	}
+}
+
+// Apply applies the sink URI parameters to the config.
+func (c *Config) Apply(
+	sinkURI *url.URL,
+	replicaConfig *config.ReplicaConfig,
+) (err error) {
+	if sinkURI == nil {
+		return cerror.ErrElasticsearchInvalidConfig.GenWithStack(
+			"failed to open elasticsearch sink, empty SinkURI")
+	}
+	scheme := strings.ToLower(sinkURI.Scheme)
+	if !psink.IsElasticsearchScheme(scheme) {
+		return cerror.ErrElasticsearchInvalidConfig.GenWithStack(
+			"can't create elasticsearch sink with unsupported scheme: %s", scheme)
+	}
+	if sinkURI.Host == "" {
+		return cerror.ErrElasticsearchInvalidConfig.GenWithStack(
+			"can't create elasticsearch sink without host")
+	}
+
+	req := &http.Request{URL: sinkURI}
+	urlParameter := &urlConfig{}
+	if err := binding.Query.Bind(req, urlParameter); err != nil {
+		return cerror.WrapError(cerror.ErrElasticsearchInvalidConfig, err)
+	}
+	if urlParameter, err = mergeConfig(replicaConfig, urlParameter); err != nil {
+		return err
+	}
+	if urlParameter.Index != nil && len(*urlParameter.Index) > 0 {
+		c.Index = topic.Expression(*urlParameter.Index)
+	}
+	if err = c.Index.ValidateForElasticsearch(); err != nil {
+		return err
+	}
+	if err = getWorkerCount(urlParameter, &c.WorkerCount); err != nil {
+		return err
+	}
+	if err = getMaxBatchRows(urlParameter, &c.MaxBatchRows); err != nil {
+		return err
+	}
+	if err = getTimeout(urlParameter, &c.Timeout); err != nil {
+		return err
+	}
+	if urlParameter.MaxRetries != nil {
+		if *urlParameter.MaxRetries < 0 {
+			return cerror.WrapError(cerror.ErrElasticsearchInvalidConfig,
+				fmt.Errorf("invalid max-retries %d, it must not be negative", *urlParameter.MaxRetries))
+		}
+		c.MaxRetries = *urlParameter.MaxRetries
+	}
+
+	endpoint := url.URL{
+		Scheme: "http",
+		Host:   sinkURI.Host,
+		Path:   strings.TrimSuffix(sinkURI.Path, "/"),
+	}
+	if psink.IsElasticsearchSSLScheme(scheme) {
+		endpoint.Scheme = "https"
+	}
+	c.Endpoint = endpoint.String()
+	if sinkURI.User != nil {
+		c.Username = sinkURI.User.Username()
+		c.Password, _ = sinkURI.User.Password()
+	}
+
+	esConfig := replicaConfig.Sink.ElasticsearchConfig
+	if esConfig != nil {
+		if esConfig.BackoffBaseInMs != nil && *esConfig.BackoffBaseInMs > 0 {
+			c.BackoffBaseInMs = *esConfig.BackoffBaseInMs
+		}
+		if esConfig.BackoffMaxInMs != nil && *esConfig.BackoffMaxInMs > 0 {
+			c.BackoffMaxInMs = *esConfig.BackoffMaxInMs
+		}
+		// The credentials in the sink URI take precedence over the ones
+		// in the config file.
+		if c.Username == "" {
+			c.Username = util.GetOrZero(esConfig.Username)
+			c.Password = util.GetOrZero(esConfig.Password)
+		}
+		c.APIKey = util.GetOrZero(esConfig.APIKey)
+
+		credential := &security.Credential{
+			CAPath:   util.GetOrZero(esConfig.SSLCa),
+			CertPath: util.GetOrZero(esConfig.SSLCert),
+			KeyPath:  util.GetOrZero(esConfig.SSLKey),
+		}
+		if credential.IsTLSEnabled() {
+			if !psink.IsElasticsearchSSLScheme(scheme) {
+				return cerror.ErrElasticsearchInvalidConfig.GenWithStack(
+					"ssl-ca is set, but the scheme of sink URI is %s", scheme)
+			}
+			c.TLSConfig, err = credential.ToTLSConfig()
+			if err != nil {
+				return cerror.WrapError(cerror.ErrElasticsearchInvalidConfig, err)
+			}
+		}
+	}
+	if c.BackoffMaxInMs < c.BackoffBaseInMs {
+		c.BackoffMaxInMs = c.BackoffBaseInMs
+	}
+	if c.APIKey != "" && c.Username != "" {
+		return cerror.ErrElasticsearchInvalidConfig.GenWithStack(
+			"api-key and username can't be set at the same time")
+	}
+	return nil
+}
+
+func mergeConfig(
+	replicaConfig *config.ReplicaConfig,
+	urlParameters *urlConfig,
+) (*urlConfig, error) {
+	dest := &urlConfig{}
+	if replicaConfig.Sink != nil && replicaConfig.Sink.ElasticsearchConfig != nil {
+		dest.Index = replicaConfig.Sink.ElasticsearchConfig.Index
+		dest.WorkerCount = replicaConfig.Sink.ElasticsearchConfig.WorkerCount
+		dest.MaxBatchRows = replicaConfig.Sink.ElasticsearchConfig.MaxBatchRows
+		dest.Timeout = replicaConfig.Sink.ElasticsearchConfig.Timeout
+		dest.MaxRetries = replicaConfig.Sink.ElasticsearchConfig.MaxRetries
+	}
+	if err := mergo.Merge(dest, urlParameters, mergo.WithOverride); err != nil {
+		return nil, cerror.WrapError(cerror.ErrElasticsearchInvalidConfig, err)
+	}
+	return dest, nil
+}
+
+func getWorkerCount(values *urlConfig, workerCount *int) error {
+	if values.WorkerCount == nil {
+		return nil
+	}
+
+	c := *values.WorkerCount
+	if c <= 0 {
+		return cerror.WrapError(cerror.ErrElasticsearchInvalidConfig,
+			fmt.Errorf("invalid worker-count %d, it must be greater than 0", c))
+	}
+	if c > maxWorkerCount {
+		log.Warn("worker-count is too large",
+			zap.Int("original", c), zap.Int("override", maxWorkerCount))
+		c = maxWorkerCount
+	}
+
+	*workerCount = c
+	return nil
+}
+
+func getMaxBatchRows(values *urlConfig, maxBatchRows *int) error {
+	if values.MaxBatchRows == nil {
+		return nil
+	}
+
+	c := *values.MaxBatchRows
+	if c <= 0 {
+		return cerror.WrapError(cerror.ErrElasticsearchInvalidConfig,
+			fmt.Errorf("invalid max-batch-rows %d, it must be greater than 0", c))
+	}
+	if c > maxMaxBatchRows {
+		log.Warn("max-batch-rows is too large",
+			zap.Int("original", c), zap.Int("override", maxMaxBatchRows))
+		c = maxMaxBatchRows
+	}
+
+	*maxBatchRows = c
+	return nil
+}
+
+func getTimeout(values *urlConfig, timeout *time.Duration) error {
+	if values.Timeout == nil || len(*values.Timeout) == 0 {
+		return nil
+	}
+
+	d, err := time.ParseDuration(*values.Timeout)
+	if err != nil {
+		return cerror.WrapError(cerror.ErrElasticsearchInvalidConfig, err)
+	}
+	if d <= 0 {
+		return cerror.WrapError(cerror.ErrElasticsearchInvalidConfig,
+			fmt.Errorf("invalid timeout %s, it must be greater than 0", d))
+	}
+
+	*timeout = d
+	return nil
+}
@@ -0,0 +1,182 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearch
+
+import (
+	"encoding/json"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink/mq/dispatcher/topic"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// OpType is the type of an action in a bulk request.
+type OpType string
+
+const (
+	// OpIndex creates the document or replaces the existing one.
+	OpIndex OpType = "index"
+	// OpDelete deletes the document.
+	OpDelete OpType = "delete"
+)
+
+// versionType makes elasticsearch keep the document with the larger external
+// version. The same version is accepted, so that resending a batch after a
+// retry or a changefeed restart is idempotent.
+const versionType = "external_gte"
+
+// Action is a single action in a bulk request.
+type Action struct {
+	Op    OpType
+	Index string
+	ID    string
+	// Version is the commit ts of the row change, it is used as the external
+	// version of the document, so an older change never overwrites a newer one.
+	Version uint64
+	// Document is the json encoded source of the document, it is nil for delete.
+	Document []byte
+}
+
+// NewActions converts a row changed event to bulk actions. The document is
+// keyed by the handle key of the row, inserts and updates are converted to
+// index actions, and deletes are converted to delete actions. If an update
+// changes the handle key, the document of the old key is deleted first.
+func NewActions(index topic.Expression, row *model.RowChangedEvent) ([]*Action, error) {
+	tableInfo := row.TableInfo
+	indexName := index.SubstituteForElasticsearch(
+		tableInfo.GetSchemaName(), tableInfo.GetTableName())
+
+	if row.IsDelete() {
+		id, err := documentID(tableInfo, row.PreColumns)
+		if err != nil {
+			return nil, err
+		}
+		return []*Action{{
+			Op: OpDelete, Index: indexName, ID: id, Version: row.CommitTs,
+		}}, nil
+	}
+
+	id, err := documentID(tableInfo, row.Columns)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := document(tableInfo, row.Columns)
+	if err != nil {
+		return nil, err
+	}
+	actions := make([]*Action, 0, 2)
+	if row.IsUpdate() {
+		oldID, err := documentID(tableInfo, row.PreColumns)
+		if err != nil {
+			return nil, err
+		}
+		if oldID != id {
+			actions = append(actions, &Action{
+				Op: OpDelete, Index: indexName, ID: oldID, Version: row.CommitTs,
+			})
+		}
+	}
+	return append(actions, &Action{
+		Op: OpIndex, Index: indexName, ID: id, Version: row.CommitTs, Document: doc,
+	}), nil
+}
+
+// documentID returns the document id of the row. The value of the handle key
+// is used directly if there is only one handle key column, otherwise the
+// values are encoded as a json array.
+func documentID(tableInfo *model.TableInfo, cols []*model.ColumnData) (string, error) {
+	var values []string
+	for _, col := range cols {
+		if col != nil && tableInfo.ForceGetColumnFlagType(col.ColumnID).IsHandleKey() {
+			values = append(values, model.ColumnValueString(col.Value))
+		}
+	}
+	switch len(values) {
+	case 0:
+		return "", cerror.ErrElasticsearchInvalidConfig.GenWithStack(
+			"table %s has no primary key or not null unique key, "+
+				"it can't be replicated to elasticsearch", tableInfo.TableName.String())
+	case 1:
+		return values[0], nil
+	}
+	id, err := json.Marshal(values)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	return string(id), nil
+}
+
+// document encodes the columns as the source of the document.
+func document(tableInfo *model.TableInfo, cols []*model.ColumnData) ([]byte, error) {
+	doc := make(map[string]interface{}, len(cols))
+	for _, col := range cols {
+		colx := model.GetColumnDataX(col, tableInfo)
+		if colx.ColumnData == nil {
+			continue
+		}
+		value, err := columnValue(colx)
+		if err != nil {
+			return nil, err
+		}
+		doc[colx.GetName()] = value
+	}
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrEncodeFailed, err)
+	}
+	return data, nil
+}
+
+// columnValue converts the column value to a value which can be encoded to
+// json. The binary values are encoded as base64 strings, the enum and set
+// values are converted to their names, and the vectors are converted to
+// arrays of float, so that they can be mapped to the dense_vector type.
+func columnValue(col model.ColumnDataX) (interface{}, error) {
+	if col.Value == nil {
+		return nil, nil
+	}
+	switch col.GetType() {
+	case mysql.TypeEnum:
+		if v, ok := col.Value.(uint64); ok {
+			enumVar, err := types.ParseEnumValue(col.GetColumnInfo().FieldType.GetElems(), v)
+			if err != nil {
+				return nil, cerror.WrapError(cerror.ErrEncodeFailed, err)
+			}
+			return enumVar.Name, nil
+		}
+	case mysql.TypeSet:
+		if v, ok := col.Value.(uint64); ok {
+			setVar, err := types.ParseSetValue(col.GetColumnInfo().FieldType.GetElems(), v)
+			if err != nil {
+				return nil, cerror.WrapError(cerror.ErrEncodeFailed, err)
+			}
+			return setVar.Name, nil
+		}
+	case mysql.TypeTiDBVectorFloat32:
+		if v, ok := col.Value.(types.VectorFloat32); ok {
+			return v.Elements(), nil
+		}
+	}
+	if v, ok := col.Value.([]byte); ok {
+		if col.GetFlag().IsBinary() {
+			// []byte is encoded as a base64 string by encoding/json.
+			return v, nil
+		}
+		return string(v), nil
+	}
+	return col.Value, nil
+}
@@ -0,0 +1,117 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearch
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRow(
+	tableInfo *model.TableInfo, preColumns, columns []*model.Column,
+) *model.RowChangedEvent {
+	return &model.RowChangedEvent{
+		CommitTs:   100,
+		TableInfo:  tableInfo,
+		PreColumns: model.Columns2ColumnDatas(preColumns, tableInfo),
+		Columns:    model.Columns2ColumnDatas(columns, tableInfo),
+	}
+}
+
+func TestNewActions(t *testing.T) {
+	t.Parallel()
+
+	tableInfo := model.BuildTableInfo("Test", "T1", []*model.Column{
+		{Name: "id", Type: mysql.TypeLong, Flag: model.HandleKeyFlag | model.PrimaryKeyFlag},
+		{Name: "name", Type: mysql.TypeVarchar},
+		{Name: "data", Type: mysql.TypeBlob, Flag: model.BinaryFlag},
+	}, [][]int{{0}})
+	row := func(id int64, name string) []*model.Column {
+		return []*model.Column{
+			{Name: "id", Value: id},
+			{Name: "name", Value: []byte(name)},
+			{Name: "data", Value: []byte{0x01, 0x02}},
+		}
+	}
+	index := defaultIndex
+
+	// insert
+	actions, err := NewActions(index, newTestRow(tableInfo, nil, row(1, "a")))
+	require.NoError(t, err)
+	require.Len(t, actions, 1)
+	require.Equal(t, OpIndex, actions[0].Op)
+	require.Equal(t, "test_t1", actions[0].Index)
+	require.Equal(t, "1", actions[0].ID)
+	require.Equal(t, uint64(100), actions[0].Version)
+	require.JSONEq(t, `{"id":1,"name":"a","data":"AQI="}`, string(actions[0].Document))
+
+	// update without changing the handle key
+	actions, err = NewActions(index, newTestRow(tableInfo, row(1, "a"), row(1, "b")))
+	require.NoError(t, err)
+	require.Len(t, actions, 1)
+	require.Equal(t, OpIndex, actions[0].Op)
+	require.Equal(t, "1", actions[0].ID)
+	require.JSONEq(t, `{"id":1,"name":"b","data":"AQI="}`, string(actions[0].Document))
+
+	// update the handle key
+	actions, err = NewActions(index, newTestRow(tableInfo, row(1, "a"), row(2, "a")))
+	require.NoError(t, err)
+	require.Len(t, actions, 2)
+	require.Equal(t, OpDelete, actions[0].Op)
+	require.Equal(t, "1", actions[0].ID)
+	require.Nil(t, actions[0].Document)
+	require.Equal(t, OpIndex, actions[1].Op)
+	require.Equal(t, "2", actions[1].ID)
+
+	// delete
+	actions, err = NewActions(index, newTestRow(tableInfo, row(2, "a"), nil))
+	require.NoError(t, err)
+	require.Len(t, actions, 1)
+	require.Equal(t, OpDelete, actions[0].Op)
+	require.Equal(t, "test_t1", actions[0].Index)
+	require.Equal(t, "2", actions[0].ID)
+	require.Equal(t, uint64(100), actions[0].Version)
+}
+
+func TestNewActionsCompositeKey(t *testing.T) {
+	t.Parallel()
+
+	tableInfo := model.BuildTableInfo("test", "t2", []*model.Column{
+		{Name: "a", Type: mysql.TypeLong, Flag: model.HandleKeyFlag | model.PrimaryKeyFlag},
+		{Name: "b", Type: mysql.TypeVarchar, Flag: model.HandleKeyFlag | model.PrimaryKeyFlag},
+	}, [][]int{{0, 1}})
+	actions, err := NewActions("es_{table}", newTestRow(tableInfo, nil, []*model.Column{
+		{Name: "a", Value: int64(1)},
+		{Name: "b", Value: []byte("x")},
+	}))
+	require.NoError(t, err)
+	require.Len(t, actions, 1)
+	require.Equal(t, "es_t2", actions[0].Index)
+	require.Equal(t, `["1","x"]`, actions[0].ID)
+}
+
+func TestNewActionsWithoutHandleKey(t *testing.T) {
+	t.Parallel()
+
+	tableInfo := model.BuildTableInfo("test", "t3", []*model.Column{
+		{Name: "a", Type: mysql.TypeLong},
+	}, nil)
+	_, err := NewActions(defaultIndex, newTestRow(tableInfo, nil, []*model.Column{
+		{Name: "a", Value: int64(1)},
+	}))
+	require.ErrorContains(t, err, "has no primary key or not null unique key")
+}
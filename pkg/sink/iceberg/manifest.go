@@ -0,0 +1,239 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iceberg
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+
+	"github.com/linkedin/goavro/v2"
+	"github.com/pingcap/errors"
+)
+
+const (
+	// the content types of data files.
+	contentData           = 0
+	contentEqualityDelete = 2
+	// the content types of manifests.
+	manifestContentData    = 0
+	manifestContentDeletes = 1
+
+	// entryStatusAdded is the status of the manifest entries of new files.
+	entryStatusAdded = 1
+
+	fileFormatParquet = "PARQUET"
+)
+
+// manifestEntrySchema is the avro schema of the entries in manifest files,
+// the fields which are not used by the sink are omitted.
+const manifestEntrySchema = `{
+  "type": "record",
+  "name": "manifest_entry",
+  "fields": [
+    {"name": "status", "type": "int", "field-id": 0},
+    {"name": "snapshot_id", "type": ["null", "long"], "default": null, "field-id": 1},
+    {"name": "sequence_number", "type": ["null", "long"], "default": null, "field-id": 3},
+    {"name": "file_sequence_number", "type": ["null", "long"], "default": null, "field-id": 4},
+    {"name": "data_file", "field-id": 2, "type": {
+      "type": "record",
+      "name": "r2",
+      "fields": [
+        {"name": "content", "type": "int", "field-id": 134},
+        {"name": "file_path", "type": "string", "field-id": 100},
+        {"name": "file_format", "type": "string", "field-id": 101},
+        {"name": "partition", "field-id": 102, "type": {"type": "record", "name": "r102", "fields": []}},
+        {"name": "record_count", "type": "long", "field-id": 103},
+        {"name": "file_size_in_bytes", "type": "long", "field-id": 104},
+        {"name": "equality_ids", "default": null, "field-id": 135,
+         "type": ["null", {"type": "array", "items": "int", "element-id": 136}]}
+      ]
+    }}
+  ]
+}`
+
+// manifestFileSchema is the avro schema of the entries in manifest lists.
+const manifestFileSchema = `{
+  "type": "record",
+  "name": "manifest_file",
+  "fields": [
+    {"name": "manifest_path", "type": "string", "field-id": 500},
+    {"name": "manifest_length", "type": "long", "field-id": 501},
+    {"name": "partition_spec_id", "type": "int", "field-id": 502},
+    {"name": "content", "type": "int", "field-id": 517},
+    {"name": "sequence_number", "type": "long", "field-id": 515},
+    {"name": "min_sequence_number", "type": "long", "field-id": 516},
+    {"name": "added_snapshot_id", "type": "long", "field-id": 503},
+    {"name": "added_files_count", "type": "int", "field-id": 504},
+    {"name": "existing_files_count", "type": "int", "field-id": 505},
+    {"name": "deleted_files_count", "type": "int", "field-id": 506},
+    {"name": "added_rows_count", "type": "long", "field-id": 512},
+    {"name": "existing_rows_count", "type": "long", "field-id": 513},
+    {"name": "deleted_rows_count", "type": "long", "field-id": 514}
+  ]
+}`
+
+// dataFile is a data file or a delete file added by a snapshot.
+type dataFile struct {
+	content     int
+	path        string
+	recordCount int64
+	fileSize    int64
+	// equalityIDs are the field IDs used to match the deleted rows,
+	// only set for equality delete files.
+	equalityIDs []int
+}
+
+func (f *dataFile) toNative(snapshotID int64) map[string]interface{} {
+	var equalityIDs interface{}
+	if f.content == contentEqualityDelete {
+		ids := make([]interface{}, 0, len(f.equalityIDs))
+		for _, id := range f.equalityIDs {
+			ids = append(ids, int32(id))
+		}
+		equalityIDs = goavro.Union("array", ids)
+	}
+	return map[string]interface{}{
+		"status":      int32(entryStatusAdded),
+		"snapshot_id": goavro.Union("long", snapshotID),
+		// the sequence numbers of added files are inherited from the manifest.
+		"sequence_number":      nil,
+		"file_sequence_number": nil,
+		"data_file": map[string]interface{}{
+			"content":            int32(f.content),
+			"file_path":          f.path,
+			"file_format":        fileFormatParquet,
+			"partition":          map[string]interface{}{},
+			"record_count":       f.recordCount,
+			"file_size_in_bytes": f.fileSize,
+			"equality_ids":       equalityIDs,
+		},
+	}
+}
+
+// encodeManifest encodes a manifest file which contains the files added by
+// a snapshot, all files in a manifest must have the same content type.
+func encodeManifest(
+	schema *Schema, snapshotID int64, content int, files []*dataFile,
+) ([]byte, error) {
+	schemaJSON, err := json.Marshal(schema)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	contentName := "data"
+	if content == manifestContentDeletes {
+		contentName = "deletes"
+	}
+	records := make([]interface{}, 0, len(files))
+	for _, f := range files {
+		records = append(records, f.toNative(snapshotID))
+	}
+	return encodeOCF(manifestEntrySchema, map[string][]byte{
+		"schema":            schemaJSON,
+		"schema-id":         []byte(strconv.Itoa(schema.SchemaID)),
+		"partition-spec":    []byte("[]"),
+		"partition-spec-id": []byte("0"),
+		"format-version":    []byte(strconv.Itoa(formatVersion)),
+		"content":           []byte(contentName),
+	}, records)
+}
+
+// manifestFile is an entry of a manifest list.
+type manifestFile struct {
+	path           string
+	length         int64
+	content        int
+	sequenceNumber int64
+	snapshotID     int64
+	addedFiles     int
+	addedRows      int64
+}
+
+func (m *manifestFile) toNative() map[string]interface{} {
+	return map[string]interface{}{
+		"manifest_path":        m.path,
+		"manifest_length":      m.length,
+		"partition_spec_id":    int32(0),
+		"content":              int32(m.content),
+		"sequence_number":      m.sequenceNumber,
+		"min_sequence_number":  m.sequenceNumber,
+		"added_snapshot_id":    m.snapshotID,
+		"added_files_count":    int32(m.addedFiles),
+		"existing_files_count": int32(0),
+		"deleted_files_count":  int32(0),
+		"added_rows_count":     m.addedRows,
+		"existing_rows_count":  int64(0),
+		"deleted_rows_count":   int64(0),
+	}
+}
+
+// encodeManifestList encodes the manifest list of a snapshot, the manifests
+// of the parent snapshot are carried over as they are.
+func encodeManifestList(
+	snapshot *Snapshot, parentManifests []interface{}, added []*manifestFile,
+) ([]byte, error) {
+	records := make([]interface{}, 0, len(parentManifests)+len(added))
+	records = append(records, parentManifests...)
+	for _, m := range added {
+		records = append(records, m.toNative())
+	}
+	parentID := "null"
+	if snapshot.ParentSnapshotID != nil {
+		parentID = strconv.FormatInt(*snapshot.ParentSnapshotID, 10)
+	}
+	return encodeOCF(manifestFileSchema, map[string][]byte{
+		"snapshot-id":        []byte(strconv.FormatInt(snapshot.SnapshotID, 10)),
+		"parent-snapshot-id": []byte(parentID),
+		"sequence-number":    []byte(strconv.FormatInt(snapshot.SequenceNumber, 10)),
+		"format-version":     []byte(strconv.Itoa(formatVersion)),
+	}, records)
+}
+
+// decodeOCF decodes the records of an avro object container file,
+// e.g. the entries of a manifest list.
+func decodeOCF(data []byte) ([]interface{}, error) {
+	reader, err := goavro.NewOCFReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var records []interface{}
+	for reader.Scan() {
+		record, err := reader.Read()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		records = append(records, record)
+	}
+	return records, errors.Trace(reader.Err())
+}
+
+func encodeOCF(schema string, meta map[string][]byte, records []interface{}) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	writer, err := goavro.NewOCFWriter(goavro.OCFConfig{
+		W:        buf,
+		Schema:   schema,
+		MetaData: meta,
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	// an empty block can't be read, e.g. the manifest list of a truncated table
+	// only has the header.
+	if len(records) > 0 {
+		if err := writer.Append(records); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	return buf.Bytes(), nil
+}
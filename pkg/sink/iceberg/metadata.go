@@ -0,0 +1,219 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iceberg
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/google/uuid"
+)
+
+const (
+	formatVersion = 2
+	// unpartitionedLastPartitionID is the last partition ID of the tables
+	// which have no partition fields, as defined by the spec.
+	unpartitionedLastPartitionID = 999
+
+	mainBranch = "main"
+)
+
+// TableMetadata is the content of an Iceberg table metadata file.
+// Only unpartitioned and unsorted tables are supported.
+type TableMetadata struct {
+	FormatVersion      int                     `json:"format-version"`
+	TableUUID          string                  `json:"table-uuid"`
+	Location           string                  `json:"location"`
+	LastSequenceNumber int64                   `json:"last-sequence-number"`
+	LastUpdatedMs      int64                   `json:"last-updated-ms"`
+	LastColumnID       int                     `json:"last-column-id"`
+	Schemas            []*Schema               `json:"schemas"`
+	CurrentSchemaID    int                     `json:"current-schema-id"`
+	PartitionSpecs     []*PartitionSpec        `json:"partition-specs"`
+	DefaultSpecID      int                     `json:"default-spec-id"`
+	LastPartitionID    int                     `json:"last-partition-id"`
+	Properties         map[string]string       `json:"properties,omitempty"`
+	CurrentSnapshotID  *int64                  `json:"current-snapshot-id,omitempty"`
+	Snapshots          []*Snapshot             `json:"snapshots"`
+	SnapshotLog        []*SnapshotLogEntry     `json:"snapshot-log"`
+	MetadataLog        []*MetadataLogEntry     `json:"metadata-log"`
+	SortOrders         []*SortOrder            `json:"sort-orders"`
+	DefaultSortOrderID int                     `json:"default-sort-order-id"`
+	Refs               map[string]*SnapshotRef `json:"refs"`
+}
+
+// PartitionSpec is a partition spec of an Iceberg table.
+type PartitionSpec struct {
+	SpecID int           `json:"spec-id"`
+	Fields []interface{} `json:"fields"`
+}
+
+// SortOrder is a sort order of an Iceberg table.
+type SortOrder struct {
+	OrderID int           `json:"order-id"`
+	Fields  []interface{} `json:"fields"`
+}
+
+// Snapshot is a snapshot of an Iceberg table.
+type Snapshot struct {
+	SnapshotID       int64             `json:"snapshot-id"`
+	ParentSnapshotID *int64            `json:"parent-snapshot-id,omitempty"`
+	SequenceNumber   int64             `json:"sequence-number"`
+	TimestampMs      int64             `json:"timestamp-ms"`
+	ManifestList     string            `json:"manifest-list"`
+	Summary          map[string]string `json:"summary"`
+	SchemaID         *int              `json:"schema-id,omitempty"`
+}
+
+// SnapshotLogEntry records when the current snapshot was changed.
+type SnapshotLogEntry struct {
+	TimestampMs int64 `json:"timestamp-ms"`
+	SnapshotID  int64 `json:"snapshot-id"`
+}
+
+// MetadataLogEntry records a previous metadata file of the table.
+type MetadataLogEntry struct {
+	TimestampMs  int64  `json:"timestamp-ms"`
+	MetadataFile string `json:"metadata-file"`
+}
+
+// SnapshotRef is a named reference to a snapshot.
+type SnapshotRef struct {
+	SnapshotID int64  `json:"snapshot-id"`
+	Type       string `json:"type"`
+}
+
+// newTableMetadata returns the metadata of an empty table.
+func newTableMetadata(location string, nowMs int64) *TableMetadata {
+	return &TableMetadata{
+		FormatVersion:      formatVersion,
+		TableUUID:          uuid.New().String(),
+		Location:           location,
+		LastUpdatedMs:      nowMs,
+		CurrentSchemaID:    -1,
+		PartitionSpecs:     []*PartitionSpec{{SpecID: 0, Fields: []interface{}{}}},
+		LastPartitionID:    unpartitionedLastPartitionID,
+		Snapshots:          []*Snapshot{},
+		SnapshotLog:        []*SnapshotLogEntry{},
+		MetadataLog:        []*MetadataLogEntry{},
+		SortOrders:         []*SortOrder{{OrderID: 0, Fields: []interface{}{}}},
+		DefaultSortOrderID: 0,
+		Refs:               map[string]*SnapshotRef{},
+	}
+}
+
+// currentSnapshot returns the current snapshot, or nil if the table is empty.
+func (m *TableMetadata) currentSnapshot() *Snapshot {
+	if m.CurrentSnapshotID == nil {
+		return nil
+	}
+	for _, s := range m.Snapshots {
+		if s.SnapshotID == *m.CurrentSnapshotID {
+			return s
+		}
+	}
+	return nil
+}
+
+// setSchema makes the schema the current schema of the table, a new schema is
+// added if the fields are changed. The current schema is returned.
+func (m *TableMetadata) setSchema(schema *Schema) *Schema {
+	for _, s := range m.Schemas {
+		if s.sameAs(schema) {
+			m.CurrentSchemaID = s.SchemaID
+			return s
+		}
+	}
+	schema.SchemaID = 0
+	for _, s := range m.Schemas {
+		if s.SchemaID >= schema.SchemaID {
+			schema.SchemaID = s.SchemaID + 1
+		}
+	}
+	for _, f := range schema.Fields {
+		if f.ID > m.LastColumnID {
+			m.LastColumnID = f.ID
+		}
+	}
+	m.Schemas = append(m.Schemas, schema)
+	m.CurrentSchemaID = schema.SchemaID
+	return schema
+}
+
+// addSnapshot makes the snapshot the current snapshot of the main branch.
+func (m *TableMetadata) addSnapshot(snapshot *Snapshot) {
+	m.LastSequenceNumber = snapshot.SequenceNumber
+	m.LastUpdatedMs = snapshot.TimestampMs
+	m.Snapshots = append(m.Snapshots, snapshot)
+	m.SnapshotLog = append(m.SnapshotLog, &SnapshotLogEntry{
+		TimestampMs: snapshot.TimestampMs,
+		SnapshotID:  snapshot.SnapshotID,
+	})
+	id := snapshot.SnapshotID
+	m.CurrentSnapshotID = &id
+	m.Refs[mainBranch] = &SnapshotRef{SnapshotID: id, Type: "branch"}
+}
+
+// addMetadataLog records the previous metadata file of the table, and returns
+// the oldest entries which are removed to keep at most maxEntries entries.
+func (m *TableMetadata) addMetadataLog(
+	file string, timestampMs int64, maxEntries int,
+) []*MetadataLogEntry {
+	m.MetadataLog = append(m.MetadataLog, &MetadataLogEntry{
+		TimestampMs:  timestampMs,
+		MetadataFile: file,
+	})
+	if len(m.MetadataLog) <= maxEntries {
+		return nil
+	}
+	removed := m.MetadataLog[:len(m.MetadataLog)-maxEntries]
+	m.MetadataLog = append([]*MetadataLogEntry{}, m.MetadataLog[len(removed):]...)
+	return removed
+}
+
+// expiredSnapshots returns the number of the snapshots committed before the
+// time. The snapshots are ordered by the commit time, and the current snapshot
+// is never expired.
+func (m *TableMetadata) expiredSnapshots(beforeMs int64) int {
+	n := 0
+	for n < len(m.Snapshots) && m.Snapshots[n].TimestampMs < beforeMs {
+		if m.CurrentSnapshotID != nil && m.Snapshots[n].SnapshotID == *m.CurrentSnapshotID {
+			break
+		}
+		n++
+	}
+	return n
+}
+
+// removeSnapshots removes the first n snapshots and their snapshot log entries.
+func (m *TableMetadata) removeSnapshots(n int) {
+	removed := make(map[int64]struct{}, n)
+	for _, s := range m.Snapshots[:n] {
+		removed[s.SnapshotID] = struct{}{}
+	}
+	m.Snapshots = append([]*Snapshot{}, m.Snapshots[n:]...)
+	log := make([]*SnapshotLogEntry, 0, len(m.SnapshotLog))
+	for _, entry := range m.SnapshotLog {
+		if _, ok := removed[entry.SnapshotID]; !ok {
+			log = append(log, entry)
+		}
+	}
+	m.SnapshotLog = log
+}
+
+// newSnapshotID returns a random positive snapshot ID.
+func newSnapshotID() int64 {
+	id := uuid.New()
+	return int64(binary.BigEndian.Uint64(id[:8]) & math.MaxInt64)
+}
@@ -0,0 +1,354 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iceberg
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+
+	"github.com/pingcap/errors"
+	timodel "github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/xitongsys/parquet-go/parquet"
+)
+
+const (
+	typeInt         = "int"
+	typeLong        = "long"
+	typeFloat       = "float"
+	typeDouble      = "double"
+	typeDate        = "date"
+	typeTimestamp   = "timestamp"
+	typeTimestampTz = "timestamptz"
+	typeString      = "string"
+	typeBinary      = "binary"
+
+	// maxDecimalPrecision is the maximum precision of the Iceberg decimal type,
+	// wider decimals are stored as strings.
+	maxDecimalPrecision = 38
+	// unsignedBigintPrecision is the precision which is able to hold all
+	// values of BIGINT UNSIGNED.
+	unsignedBigintPrecision = 20
+
+	rootSchemaName = "table"
+)
+
+// Field is a field of an Iceberg schema. The ID of a field is the ID of the
+// upstream column, so that renamed columns keep their data.
+type Field struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	Required bool   `json:"required"`
+	Type     string `json:"type"`
+}
+
+// Schema is an Iceberg schema, which only contains primitive fields.
+type Schema struct {
+	Type               string   `json:"type"`
+	SchemaID           int      `json:"schema-id"`
+	IdentifierFieldIDs []int    `json:"identifier-field-ids,omitempty"`
+	Fields             []*Field `json:"fields"`
+}
+
+// sameAs returns true if the two schemas have the same fields.
+func (s *Schema) sameAs(other *Schema) bool {
+	if len(s.Fields) != len(other.Fields) ||
+		len(s.IdentifierFieldIDs) != len(other.IdentifierFieldIDs) {
+		return false
+	}
+	for i, f := range s.Fields {
+		if *f != *other.Fields[i] {
+			return false
+		}
+	}
+	for i, id := range s.IdentifierFieldIDs {
+		if id != other.IdentifierFieldIDs[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// column describes how a column of the upstream table is stored in Iceberg.
+type column struct {
+	field *Field
+	ft    *types.FieldType
+	// isKey is true if the column is a part of the handle key, rows are
+	// identified by the handle key in equality delete files.
+	isKey bool
+	// precision and scale are only used by decimal fields.
+	precision int
+	scale     int
+}
+
+// newColumns returns the columns of the table in the same order as the values
+// decoded by parquet.DecodeRows.
+func newColumns(tableInfo *model.TableInfo) []*column {
+	colInfos := tableInfo.GetColInfosForRowChangedEvent()
+	columns := make([]*column, 0, len(colInfos))
+	for _, colInfo := range colInfos {
+		info := tableInfo.ForceGetColumnInfo(colInfo.ID)
+		flag := tableInfo.ForceGetColumnFlagType(colInfo.ID)
+		col := &column{
+			ft:    &info.FieldType,
+			isKey: flag.IsHandleKey(),
+		}
+		col.field = &Field{
+			ID:       int(info.ID),
+			Name:     info.Name.O,
+			Required: col.isKey,
+			Type:     col.icebergType(info, flag.IsBinary()),
+		}
+		columns = append(columns, col)
+	}
+	return columns
+}
+
+// newSchema returns the Iceberg schema of the columns, the schema ID is
+// assigned when the schema is added to the table metadata.
+func newSchema(columns []*column) *Schema {
+	schema := &Schema{Type: "struct", Fields: make([]*Field, 0, len(columns))}
+	identifiers := make([]int, 0)
+	for _, col := range columns {
+		schema.Fields = append(schema.Fields, col.field)
+		if col.isKey {
+			identifiers = append(identifiers, col.field.ID)
+		}
+	}
+	// identifier fields can not be floating point numbers.
+	for _, col := range columns {
+		if col.isKey && (col.field.Type == typeFloat || col.field.Type == typeDouble) {
+			identifiers = nil
+			break
+		}
+	}
+	if len(identifiers) > 0 {
+		schema.IdentifierFieldIDs = identifiers
+	}
+	return schema
+}
+
+func (c *column) icebergType(info *timodel.ColumnInfo, isBinary bool) string {
+	ft := &info.FieldType
+	unsigned := mysql.HasUnsignedFlag(ft.GetFlag())
+	switch ft.GetType() {
+	case mysql.TypeTiny, mysql.TypeShort, mysql.TypeInt24, mysql.TypeYear:
+		return typeInt
+	case mysql.TypeLong:
+		if unsigned {
+			return typeLong
+		}
+		return typeInt
+	case mysql.TypeLonglong:
+		if unsigned {
+			c.precision, c.scale = unsignedBigintPrecision, 0
+			return decimalType(c.precision, c.scale)
+		}
+		return typeLong
+	case mysql.TypeBit, mysql.TypeDuration:
+		// TIME is stored as an interval in microseconds, the same as the
+		// parquet protocol, since its range is beyond the Iceberg time type.
+		return typeLong
+	case mysql.TypeFloat:
+		return typeFloat
+	case mysql.TypeDouble:
+		return typeDouble
+	case mysql.TypeNewDecimal:
+		c.precision, c.scale = decimalPrecisionAndScale(ft)
+		if c.precision > maxDecimalPrecision {
+			return typeString
+		}
+		return decimalType(c.precision, c.scale)
+	case mysql.TypeDate, mysql.TypeNewDate:
+		return typeDate
+	case mysql.TypeDatetime:
+		return typeTimestamp
+	case mysql.TypeTimestamp:
+		return typeTimestampTz
+	case mysql.TypeVarchar, mysql.TypeString, mysql.TypeVarString, mysql.TypeTinyBlob,
+		mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeBlob:
+		if isBinary {
+			return typeBinary
+		}
+		return typeString
+	default:
+		// JSON, ENUM, SET, VECTOR and other types are stored as strings.
+		return typeString
+	}
+}
+
+func decimalType(precision, scale int) string {
+	return fmt.Sprintf("decimal(%d, %d)", precision, scale)
+}
+
+func decimalPrecisionAndScale(ft *types.FieldType) (int, int) {
+	defaultFlen, defaultDecimal := mysql.GetDefaultFieldLengthAndDecimal(mysql.TypeNewDecimal)
+	precision, scale := ft.GetFlen(), ft.GetDecimal()
+	if precision == types.UnspecifiedLength {
+		precision = defaultFlen
+	}
+	if scale == types.UnspecifiedLength {
+		scale = defaultDecimal
+	}
+	return precision, scale
+}
+
+// isDecimal returns true if the column is stored as an Iceberg decimal.
+func (c *column) isDecimal() bool {
+	return strings.HasPrefix(c.field.Type, "decimal")
+}
+
+// decimalLength returns the minimum number of bytes to hold the unscaled
+// values of the decimal in two's complement, as required by the spec.
+func (c *column) decimalLength() int {
+	return int(math.Ceil((float64(c.precision)*math.Log2(10) + 1) / 8))
+}
+
+// newElement returns the parquet schema element of the column, the field ID
+// is set so that Iceberg readers are able to resolve the column by ID.
+func (c *column) newElement() *parquet.SchemaElement {
+	e := parquet.NewSchemaElement()
+	e.Name = c.field.Name
+	e.FieldID = int32Ptr(int32(c.field.ID))
+	if c.field.Required {
+		e.RepetitionType = parquet.FieldRepetitionTypePtr(parquet.FieldRepetitionType_REQUIRED)
+	} else {
+		e.RepetitionType = parquet.FieldRepetitionTypePtr(parquet.FieldRepetitionType_OPTIONAL)
+	}
+	switch {
+	case c.field.Type == typeInt:
+		e.Type = parquet.TypePtr(parquet.Type_INT32)
+	case c.field.Type == typeLong:
+		e.Type = parquet.TypePtr(parquet.Type_INT64)
+	case c.field.Type == typeFloat:
+		e.Type = parquet.TypePtr(parquet.Type_FLOAT)
+	case c.field.Type == typeDouble:
+		e.Type = parquet.TypePtr(parquet.Type_DOUBLE)
+	case c.field.Type == typeDate:
+		e.Type = parquet.TypePtr(parquet.Type_INT32)
+		e.ConvertedType = parquet.ConvertedTypePtr(parquet.ConvertedType_DATE)
+		e.LogicalType = parquet.NewLogicalType()
+		e.LogicalType.DATE = parquet.NewDateType()
+	case c.field.Type == typeTimestamp, c.field.Type == typeTimestampTz:
+		e.Type = parquet.TypePtr(parquet.Type_INT64)
+		e.LogicalType = parquet.NewLogicalType()
+		e.LogicalType.TIMESTAMP = &parquet.TimestampType{
+			IsAdjustedToUTC: c.field.Type == typeTimestampTz,
+			Unit:            &parquet.TimeUnit{MICROS: parquet.NewMicroSeconds()},
+		}
+	case c.field.Type == typeBinary:
+		e.Type = parquet.TypePtr(parquet.Type_BYTE_ARRAY)
+	case c.isDecimal():
+		e.Type = parquet.TypePtr(parquet.Type_FIXED_LEN_BYTE_ARRAY)
+		e.TypeLength = int32Ptr(int32(c.decimalLength()))
+		e.ConvertedType = parquet.ConvertedTypePtr(parquet.ConvertedType_DECIMAL)
+		e.Precision = int32Ptr(int32(c.precision))
+		e.Scale = int32Ptr(int32(c.scale))
+		e.LogicalType = parquet.NewLogicalType()
+		e.LogicalType.DECIMAL = &parquet.DecimalType{
+			Precision: int32(c.precision),
+			Scale:     int32(c.scale),
+		}
+	default:
+		e.Type = parquet.TypePtr(parquet.Type_BYTE_ARRAY)
+		e.ConvertedType = parquet.ConvertedTypePtr(parquet.ConvertedType_UTF8)
+		e.LogicalType = parquet.NewLogicalType()
+		e.LogicalType.STRING = parquet.NewStringType()
+	}
+	return e
+}
+
+// convert converts the value decoded by parquet.DecodeRows, which is in the
+// go type of the parquet protocol, to the go type of the Iceberg field.
+func (c *column) convert(value interface{}) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+	switch {
+	case c.field.Type == typeLong:
+		// INT UNSIGNED is stored as the 32-bit two's complement by the
+		// parquet protocol, it is widened to keep the original value.
+		if v, ok := value.(int32); ok {
+			return int64(uint32(v)), nil
+		}
+		return value, nil
+	case c.isDecimal():
+		var unscaled []byte
+		switch v := value.(type) {
+		case int64:
+			// BIGINT UNSIGNED
+			unscaled = append([]byte{0}, new(big.Int).SetUint64(uint64(v)).Bytes()...)
+		case string:
+			unscaled = []byte(v)
+		default:
+			return nil, errors.Errorf("unexpected value %v for decimal", value)
+		}
+		return signExtend(unscaled, c.decimalLength())
+	case c.field.Type == typeString && c.ft.GetType() == mysql.TypeNewDecimal:
+		s, ok := value.(string)
+		if !ok {
+			return nil, errors.Errorf("unexpected value %v for decimal", value)
+		}
+		return decimalToString([]byte(s), c.scale), nil
+	default:
+		return value, nil
+	}
+}
+
+// signExtend extends the big-endian two's complement to the given length.
+func signExtend(b []byte, length int) (string, error) {
+	if len(b) > length {
+		return "", errors.Errorf("decimal value %x overflows %d bytes", b, length)
+	}
+	var pad byte
+	if len(b) > 0 && b[0]&0x80 != 0 {
+		pad = 0xff
+	}
+	ret := make([]byte, length)
+	for i := 0; i < length-len(b); i++ {
+		ret[i] = pad
+	}
+	copy(ret[length-len(b):], b)
+	return string(ret), nil
+}
+
+// decimalToString formats the big-endian two's complement of the unscaled
+// value of a decimal.
+func decimalToString(b []byte, scale int) string {
+	v := new(big.Int).SetBytes(b)
+	if len(b) > 0 && b[0]&0x80 != 0 {
+		v.Sub(v, new(big.Int).Lsh(big.NewInt(1), uint(len(b)*8)))
+	}
+	s := v.String()
+	negative := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(s, "-")
+	if scale > 0 {
+		if len(s) <= scale {
+			s = strings.Repeat("0", scale-len(s)+1) + s
+		}
+		s = s[:len(s)-scale] + "." + s[len(s)-scale:]
+	}
+	if negative {
+		s = "-" + s
+	}
+	return s
+}
+
+func int32Ptr(v int32) *int32 {
+	return &v
+}
@@ -0,0 +1,74 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iceberg
+
+import (
+	"testing"
+
+	"github.com/pingcap/tiflow/cdc/entry"
+	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go/parquet"
+)
+
+func TestNewSchema(t *testing.T) {
+	helper := entry.NewSchemaTestHelper(t)
+	defer helper.Close()
+
+	ddl := helper.DDL2Event(`create table test.t(
+		id bigint primary key, c_int int unsigned, c_bigint bigint unsigned,
+		c_float float, c_decimal decimal(20, 4), c_wide decimal(50, 2),
+		c_varchar varchar(16), c_blob blob, c_date date, c_datetime datetime(6),
+		c_timestamp timestamp(3) null, c_time time, c_json json)`)
+	columns := newColumns(ddl.TableInfo)
+	schema := newSchema(columns)
+
+	expected := []string{
+		typeLong, typeLong, "decimal(20, 0)", typeFloat, "decimal(20, 4)", typeString,
+		typeString, typeBinary, typeDate, typeTimestamp, typeTimestampTz, typeLong, typeString,
+	}
+	require.Len(t, schema.Fields, len(expected))
+	for i, tp := range expected {
+		require.Equal(t, tp, schema.Fields[i].Type, schema.Fields[i].Name)
+		require.Equal(t, i == 0, schema.Fields[i].Required)
+		require.Equal(t, int(ddl.TableInfo.Columns[i].ID), schema.Fields[i].ID)
+	}
+	require.Equal(t, []int{schema.Fields[0].ID}, schema.IdentifierFieldIDs)
+	require.True(t, schema.sameAs(newSchema(newColumns(ddl.TableInfo))))
+
+	e := columns[2].newElement()
+	require.Equal(t, parquet.Type_FIXED_LEN_BYTE_ARRAY, e.GetType())
+	require.Equal(t, int32(9), e.GetTypeLength())
+	require.Equal(t, int32(columns[2].field.ID), e.GetFieldID())
+	require.Equal(t, int32(9), columns[4].newElement().GetTypeLength())
+
+	// INT UNSIGNED is widened.
+	v, err := columns[1].convert(int32(-1))
+	require.NoError(t, err)
+	require.Equal(t, int64(4294967295), v)
+	// BIGINT UNSIGNED is stored as decimal(20, 0).
+	v, err = columns[2].convert(int64(-1))
+	require.NoError(t, err)
+	require.Equal(t, "\x00\xff\xff\xff\xff\xff\xff\xff\xff", v)
+	// -123456789 is sign extended.
+	v, err = columns[4].convert("\xf8\xa4\x32\xeb")
+	require.NoError(t, err)
+	require.Equal(t, "\xff\xff\xff\xff\xff\xf8\xa4\x32\xeb", v)
+	// wide decimals are stored as strings.
+	v, err = columns[5].convert("\xf8\xa4\x32\xeb")
+	require.NoError(t, err)
+	require.Equal(t, "-1234567.89", v)
+	v, err = columns[5].convert("\x05")
+	require.NoError(t, err)
+	require.Equal(t, "0.05", v)
+}
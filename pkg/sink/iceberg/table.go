@@ -0,0 +1,806 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iceberg
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink/codec/parquet"
+	"github.com/xitongsys/parquet-go/marshal"
+	parquetgo "github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/writer"
+	"go.uber.org/zap"
+)
+
+const (
+	metadataDir     = "metadata"
+	dataDir         = "data"
+	versionHintFile = "version-hint.text"
+
+	// commitTsSummaryKey is the key of the snapshot summary which records
+	// the max commit-ts of the changes written by the snapshot.
+	commitTsSummaryKey = "tidb-commit-ts"
+
+	// operationDelete is the operation of the snapshots which truncate the
+	// tables, their manifest lists contain no manifests.
+	operationDelete = "delete"
+)
+
+// Catalog is a Hadoop catalog of the Iceberg tables in a warehouse on
+// external storage, a table is stored in the `<schema>/<table>` directory.
+// Tables are created by their first commits.
+type Catalog struct {
+	storage   storage.ExternalStorage
+	warehouse string
+	// snapshotRetention is how long the snapshots are kept, older snapshots
+	// except the current one are expired by the commits.
+	snapshotRetention time.Duration
+	// maxMetadataFiles is the number of previous metadata files kept in the
+	// metadata log, older metadata files are deleted by the commits.
+	maxMetadataFiles int
+
+	mu     sync.Mutex
+	tables map[string]*Table
+}
+
+// NewCatalog creates a catalog of the warehouse at the root of the storage.
+func NewCatalog(
+	storage storage.ExternalStorage, snapshotRetention time.Duration, maxMetadataFiles int,
+) *Catalog {
+	return &Catalog{
+		storage:           storage,
+		warehouse:         strings.TrimSuffix(storage.URI(), "/"),
+		snapshotRetention: snapshotRetention,
+		maxMetadataFiles:  maxMetadataFiles,
+		tables:            make(map[string]*Table),
+	}
+}
+
+// Table returns the Iceberg table of the upstream table. All partitions of a
+// partitioned table are written to the same Iceberg table.
+func (c *Catalog) Table(schema, table string) *Table {
+	dir := schema + "/" + table
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	t, ok := c.tables[dir]
+	if !ok {
+		t = &Table{
+			storage:           c.storage,
+			name:              schema + "." + table,
+			dir:               dir,
+			warehouse:         c.warehouse,
+			location:          c.warehouse + "/" + dir,
+			snapshotRetention: c.snapshotRetention,
+			maxMetadataFiles:  c.maxMetadataFiles,
+		}
+		c.tables[dir] = t
+	}
+	return t
+}
+
+// evict removes the table from the cache of the catalog and returns it.
+func (c *Catalog) evict(schema, table string) *Table {
+	t := c.Table(schema, table)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.tables, t.dir)
+	return t
+}
+
+// TruncateTable removes all rows of the table by a snapshot without data
+// files, the data files are kept for the previous snapshots.
+func (c *Catalog) TruncateTable(ctx context.Context, schema, table string, commitTs uint64) error {
+	t := c.Table(schema, table)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if err := t.truncate(ctx, commitTs); err != nil {
+		t.version, t.metadata = 0, nil
+		return cerror.WrapError(cerror.ErrIcebergCommitFailed, err, t.name)
+	}
+	return nil
+}
+
+// DropTable removes the table from the catalog and deletes its files. A new
+// table of the same name is created by the next commit.
+func (c *Catalog) DropTable(ctx context.Context, schema, table string) error {
+	t := c.evict(schema, table)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if err := t.drop(ctx); err != nil {
+		return cerror.WrapError(cerror.ErrIcebergCommitFailed, err, t.name)
+	}
+	return nil
+}
+
+// DropSchema drops all tables of the schema.
+func (c *Catalog) DropSchema(ctx context.Context, schema string) error {
+	var tables []string
+	err := c.storage.WalkDir(ctx, &storage.WalkOption{SubDir: schema},
+		func(path string, _ int64) error {
+			// a table has the version hint `<schema>/<table>/metadata/version-hint.text`.
+			parts := strings.Split(path, "/")
+			if len(parts) == 4 && parts[0] == schema &&
+				parts[2] == metadataDir && parts[3] == versionHintFile {
+				tables = append(tables, parts[1])
+			}
+			return nil
+		})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, table := range tables {
+		if err := c.DropTable(ctx, schema, table); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RenameTable registers the table under its new name. The metadata is
+// written to the directory of the new name and removed from the directory of
+// the old name, the data files and manifests are referenced by their absolute
+// paths, so they are kept in place.
+func (c *Catalog) RenameTable(
+	ctx context.Context, oldSchema, oldTable, newSchema, newTable string,
+) error {
+	from := c.evict(oldSchema, oldTable)
+	to := c.evict(newSchema, newTable)
+	from.mu.Lock()
+	defer from.mu.Unlock()
+	to.mu.Lock()
+	defer to.mu.Unlock()
+
+	if err := from.rename(ctx, to); err != nil {
+		return cerror.WrapError(cerror.ErrIcebergCommitFailed, err, from.name)
+	}
+	return nil
+}
+
+// Table is an Iceberg table in a Hadoop catalog. The metadata files are kept
+// in the metadata directory of the table, and the version of the current
+// metadata file is recorded in the version hint file.
+type Table struct {
+	mu sync.Mutex
+
+	storage           storage.ExternalStorage
+	name              string
+	dir               string
+	warehouse         string
+	location          string
+	snapshotRetention time.Duration
+	maxMetadataFiles  int
+	// version is the version of the current metadata file,
+	// it is 0 if the table has not been created.
+	version  int
+	metadata *TableMetadata
+}
+
+// Commit writes the rows to the table in a new snapshot and returns the
+// number of bytes written.
+//
+// Rows are applied with upsert semantics. The rows of the same handle key are
+// merged into their last images, the keys are removed from the previous
+// snapshots by an equality delete file, and the last images are written to a
+// data file. So committing the same rows again after a restart is idempotent.
+func (t *Table) Commit(
+	ctx context.Context, tableInfo *model.TableInfo, rows []*parquet.Row,
+) (int64, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	written, err := t.commit(ctx, tableInfo, rows)
+	if err != nil {
+		// the metadata may be modified, reload it before the next commit.
+		t.version, t.metadata = 0, nil
+		return 0, cerror.WrapError(cerror.ErrIcebergCommitFailed, err, t.name)
+	}
+	return written, nil
+}
+
+func (t *Table) commit(
+	ctx context.Context, tableInfo *model.TableInfo, rows []*parquet.Row,
+) (int64, error) {
+	if err := t.refresh(ctx); err != nil {
+		return 0, err
+	}
+
+	columns := newColumns(tableInfo)
+	var keyColumns []*column
+	for _, col := range columns {
+		if col.isKey {
+			keyColumns = append(keyColumns, col)
+		}
+	}
+	if len(keyColumns) == 0 {
+		return 0, errors.Errorf("table %s has no primary key or not null unique key", t.name)
+	}
+	keys, images, commitTs, err := mergeRows(columns, rows)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now().UnixMilli()
+	meta := t.metadata
+	if meta == nil {
+		meta = newTableMetadata(t.location, now)
+	}
+	parent := meta.currentSnapshot()
+	if parent == nil {
+		// nothing to delete in an empty table.
+		keys = nil
+	}
+	if len(keys) == 0 && len(images) == 0 {
+		return 0, nil
+	}
+	schema := meta.setSchema(newSchema(columns))
+	schemaID := schema.SchemaID
+
+	snapshot := &Snapshot{
+		SnapshotID:     newSnapshotID(),
+		SequenceNumber: meta.LastSequenceNumber + 1,
+		TimestampMs:    now,
+		SchemaID:       &schemaID,
+		Summary: map[string]string{
+			"operation":              "append",
+			"added-data-files":       "0",
+			"added-records":          strconv.Itoa(len(images)),
+			"added-delete-files":     "0",
+			"added-equality-deletes": strconv.Itoa(len(keys)),
+			commitTsSummaryKey:       strconv.FormatUint(commitTs, 10),
+		},
+	}
+	prefix := fmt.Sprintf("%05d-%s", snapshot.SequenceNumber, uuid.New())
+	var (
+		written  int64
+		manifest []*manifestFile
+	)
+	addFile := func(cols []*column, values [][]interface{}, content, manifestContent int, suffix string) error {
+		data, err := encodeParquet(cols, values)
+		if err != nil {
+			return err
+		}
+		file := &dataFile{
+			content:     content,
+			path:        fmt.Sprintf("%s/%s-%s.parquet", dataDir, prefix, suffix),
+			recordCount: int64(len(values)),
+			fileSize:    int64(len(data)),
+		}
+		if content == contentEqualityDelete {
+			for _, col := range cols {
+				file.equalityIDs = append(file.equalityIDs, col.field.ID)
+			}
+		}
+		if err := t.write(ctx, file.path, data); err != nil {
+			return err
+		}
+		file.path = t.location + "/" + file.path
+
+		data, err = encodeManifest(schema, snapshot.SnapshotID, manifestContent, []*dataFile{file})
+		if err != nil {
+			return err
+		}
+		path := fmt.Sprintf("%s/%s-%s.avro", metadataDir, prefix, suffix)
+		if err := t.write(ctx, path, data); err != nil {
+			return err
+		}
+		written += file.fileSize + int64(len(data))
+		manifest = append(manifest, &manifestFile{
+			path:           t.location + "/" + path,
+			length:         int64(len(data)),
+			content:        manifestContent,
+			sequenceNumber: snapshot.SequenceNumber,
+			snapshotID:     snapshot.SnapshotID,
+			addedFiles:     1,
+			addedRows:      file.recordCount,
+		})
+		return nil
+	}
+	if len(keys) > 0 {
+		if err := addFile(keyColumns, keys, contentEqualityDelete,
+			manifestContentDeletes, "deletes"); err != nil {
+			return 0, err
+		}
+		snapshot.Summary["operation"] = "overwrite"
+		snapshot.Summary["added-delete-files"] = "1"
+	}
+	if len(images) > 0 {
+		if err := addFile(columns, images, contentData,
+			manifestContentData, "data"); err != nil {
+			return 0, err
+		}
+		snapshot.Summary["added-data-files"] = "1"
+	}
+
+	var parentManifests []interface{}
+	if parent != nil {
+		snapshot.ParentSnapshotID = &parent.SnapshotID
+		data, err := t.read(ctx, parent.ManifestList)
+		if err != nil {
+			return 0, err
+		}
+		if parentManifests, err = decodeOCF(data); err != nil {
+			return 0, err
+		}
+	}
+	n, err := t.commitSnapshot(ctx, meta, snapshot, parentManifests, manifest)
+	if err != nil {
+		return 0, err
+	}
+	written += n
+
+	log.Debug("iceberg snapshot committed",
+		zap.String("table", t.name),
+		zap.Int64("snapshotID", snapshot.SnapshotID),
+		zap.Int64("sequenceNumber", snapshot.SequenceNumber),
+		zap.Int("records", len(images)),
+		zap.Int("equalityDeletes", len(keys)))
+	return written, nil
+}
+
+// commitSnapshot writes the manifest list of the snapshot, which contains the
+// manifests of its parent and the added manifests, then makes the snapshot the
+// current snapshot by a new metadata file.
+//
+// The snapshots older than the snapshot retention and the metadata files
+// beyond the max metadata files are expired by the commit, their files are
+// deleted after the new metadata file is written.
+func (t *Table) commitSnapshot(
+	ctx context.Context, meta *TableMetadata, snapshot *Snapshot,
+	parentManifests []interface{}, added []*manifestFile,
+) (int64, error) {
+	data, err := encodeManifestList(snapshot, parentManifests, added)
+	if err != nil {
+		return 0, err
+	}
+	path := fmt.Sprintf("%s/snap-%d-%s.avro", metadataDir, snapshot.SnapshotID, uuid.New())
+	if err := t.write(ctx, path, data); err != nil {
+		return 0, err
+	}
+	snapshot.ManifestList = t.location + "/" + path
+
+	previousUpdatedMs := meta.LastUpdatedMs
+	meta.addSnapshot(snapshot)
+	var expiredFiles []string
+	if t.version > 0 {
+		removed := meta.addMetadataLog(t.location+"/"+metadataFile(t.version),
+			previousUpdatedMs, t.maxMetadataFiles)
+		for _, entry := range removed {
+			relative, err := t.relative(entry.MetadataFile)
+			if err != nil {
+				return 0, err
+			}
+			expiredFiles = append(expiredFiles, relative)
+		}
+	}
+	expired := meta.expiredSnapshots(snapshot.TimestampMs - t.snapshotRetention.Milliseconds())
+	if expired > 0 {
+		files, err := t.files(ctx, meta.Snapshots, expired)
+		if err != nil {
+			return 0, err
+		}
+		expiredFiles = append(expiredFiles, files...)
+		meta.removeSnapshots(expired)
+	}
+	n, err := t.writeMetadata(ctx, meta)
+	if err != nil {
+		return 0, err
+	}
+
+	// the snapshot is committed, the expired files are left in the storage
+	// if they can't be deleted.
+	if err := t.deleteFiles(ctx, expiredFiles); err != nil {
+		log.Warn("failed to delete the expired files of the iceberg table",
+			zap.String("table", t.name), zap.Error(err))
+	}
+	if expired > 0 {
+		log.Debug("iceberg snapshots expired",
+			zap.String("table", t.name),
+			zap.Int("snapshots", expired),
+			zap.Int("files", len(expiredFiles)))
+	}
+	return int64(len(data)) + n, nil
+}
+
+// truncate commits a snapshot whose manifest list contains no manifests.
+func (t *Table) truncate(ctx context.Context, commitTs uint64) error {
+	if err := t.refresh(ctx); err != nil {
+		return err
+	}
+	meta := t.metadata
+	if meta == nil {
+		return nil
+	}
+	parent := meta.currentSnapshot()
+	if parent == nil || parent.Summary["operation"] == operationDelete {
+		// the table is empty already.
+		return nil
+	}
+	schemaID := meta.CurrentSchemaID
+	snapshot := &Snapshot{
+		SnapshotID:       newSnapshotID(),
+		ParentSnapshotID: &parent.SnapshotID,
+		SequenceNumber:   meta.LastSequenceNumber + 1,
+		TimestampMs:      time.Now().UnixMilli(),
+		SchemaID:         &schemaID,
+		Summary: map[string]string{
+			"operation":        operationDelete,
+			commitTsSummaryKey: strconv.FormatUint(commitTs, 10),
+		},
+	}
+	if _, err := t.commitSnapshot(ctx, meta, snapshot, nil, nil); err != nil {
+		return err
+	}
+	log.Info("iceberg table truncated",
+		zap.String("table", t.name),
+		zap.Int64("snapshotID", snapshot.SnapshotID),
+		zap.Uint64("commitTs", commitTs))
+	return nil
+}
+
+// drop deletes the files of the table. The files are found by the metadata
+// rather than by the directory, because the files of a renamed table are
+// kept in the directory of its old name, which may hold another table.
+func (t *Table) drop(ctx context.Context) error {
+	if err := t.refresh(ctx); err != nil {
+		return err
+	}
+	meta := t.metadata
+	if meta == nil {
+		return nil
+	}
+	files, err := t.files(ctx, meta.Snapshots, len(meta.Snapshots))
+	if err != nil {
+		return err
+	}
+	// the current metadata file is deleted last, so the deletion can be
+	// retried with the metadata after a failure.
+	files = append(files, t.previousMetadataFiles()...)
+	files = append(files, t.dir+"/"+metadataDir+"/"+versionHintFile, t.dir+"/"+metadataFile(t.version))
+	if err := t.deleteFiles(ctx, files); err != nil {
+		return err
+	}
+	t.version, t.metadata = 0, nil
+	log.Info("iceberg table dropped",
+		zap.String("table", t.name), zap.Int("files", len(files)))
+	return nil
+}
+
+// rename writes the metadata of the table to the directory of another table,
+// then removes the metadata files of the table.
+func (t *Table) rename(ctx context.Context, to *Table) error {
+	if err := t.refresh(ctx); err != nil {
+		return err
+	}
+	if t.metadata == nil {
+		// the table is not created or renamed already.
+		return nil
+	}
+	if err := to.refresh(ctx); err != nil {
+		return err
+	}
+	switch {
+	case to.metadata == nil:
+		meta := *t.metadata
+		meta.Location = to.location
+		// the previous metadata files are removed with the old name.
+		meta.MetadataLog = []*MetadataLogEntry{}
+		if _, err := to.writeMetadata(ctx, &meta); err != nil {
+			return err
+		}
+	case to.metadata.TableUUID != t.metadata.TableUUID:
+		return errors.Errorf("table %s already exists", to.name)
+	}
+	// the version hint is deleted first, so the old name is not visible to
+	// readers even if some metadata files are left by a failure.
+	files := []string{t.dir + "/" + metadataDir + "/" + versionHintFile, t.dir + "/" + metadataFile(t.version)}
+	if err := t.deleteFiles(ctx, append(files, t.previousMetadataFiles()...)); err != nil {
+		return err
+	}
+	log.Info("iceberg table renamed",
+		zap.String("table", t.name), zap.String("newTable", to.name))
+	return nil
+}
+
+// files returns the relative paths of the files which are only referenced by
+// the first n snapshots: their manifest lists, and the manifests and data files
+// which are not referenced by the following snapshots.
+//
+// The manifest list of a snapshot contains the manifests of its parent unless
+// the snapshot truncates the table, so only the manifest lists of the last
+// snapshot and the parents of the truncating snapshots are read.
+func (t *Table) files(ctx context.Context, snapshots []*Snapshot, n int) ([]string, error) {
+	var (
+		files     []string
+		manifests = make(map[string]struct{})
+	)
+	add := func(path string) error {
+		relative, err := t.relative(path)
+		if err != nil {
+			return err
+		}
+		files = append(files, relative)
+		return nil
+	}
+	for i, s := range snapshots[:n] {
+		if i == len(snapshots)-1 || snapshots[i+1].Summary["operation"] == operationDelete {
+			data, err := t.read(ctx, s.ManifestList)
+			if err != nil {
+				return nil, err
+			}
+			records, err := decodeOCF(data)
+			if err != nil {
+				return nil, err
+			}
+			for _, r := range records {
+				path, _ := r.(map[string]interface{})["manifest_path"].(string)
+				manifests[path] = struct{}{}
+			}
+		}
+		if err := add(s.ManifestList); err != nil {
+			return nil, err
+		}
+	}
+	for path := range manifests {
+		data, err := t.read(ctx, path)
+		if err != nil {
+			return nil, err
+		}
+		entries, err := decodeOCF(data)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range entries {
+			file, _ := e.(map[string]interface{})["data_file"].(map[string]interface{})
+			dataPath, _ := file["file_path"].(string)
+			if err := add(dataPath); err != nil {
+				return nil, err
+			}
+		}
+		if err := add(path); err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+// previousMetadataFiles returns the relative paths of the metadata files in
+// the metadata log of the table.
+func (t *Table) previousMetadataFiles() []string {
+	files := make([]string, 0, len(t.metadata.MetadataLog))
+	for _, entry := range t.metadata.MetadataLog {
+		if relative, err := t.relative(entry.MetadataFile); err == nil {
+			files = append(files, relative)
+		}
+	}
+	return files
+}
+
+// deleteFiles deletes the files in order. Files which don't exist are
+// ignored, so the deletion can be retried after a failure.
+func (t *Table) deleteFiles(ctx context.Context, paths []string) error {
+	for _, path := range paths {
+		if err := t.storage.DeleteFile(ctx, path); err != nil {
+			exists, err1 := t.storage.FileExists(ctx, path)
+			if err1 != nil || exists {
+				return errors.Trace(err)
+			}
+		}
+	}
+	return nil
+}
+
+// refresh loads the current metadata of the table if it is changed
+// by another writer, e.g. the table is moved to another capture.
+func (t *Table) refresh(ctx context.Context) error {
+	hint := t.dir + "/" + metadataDir + "/" + versionHintFile
+	exists, err := t.storage.FileExists(ctx, hint)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !exists {
+		t.version, t.metadata = 0, nil
+		return nil
+	}
+	data, err := t.storage.ReadFile(ctx, hint)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	version, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil {
+		return cerror.WrapError(cerror.ErrIcebergInvalidMetadata, err, t.name)
+	}
+	if version == t.version && t.metadata != nil {
+		return nil
+	}
+
+	data, err = t.storage.ReadFile(ctx, t.dir+"/"+metadataFile(version))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	meta := &TableMetadata{}
+	if err := json.Unmarshal(data, meta); err != nil {
+		return cerror.WrapError(cerror.ErrIcebergInvalidMetadata, err, t.name)
+	}
+	if meta.FormatVersion != formatVersion {
+		return cerror.WrapError(cerror.ErrIcebergInvalidMetadata,
+			errors.Errorf("unsupported format version %d", meta.FormatVersion), t.name)
+	}
+	for _, spec := range meta.PartitionSpecs {
+		if spec.SpecID == meta.DefaultSpecID && len(spec.Fields) > 0 {
+			return cerror.WrapError(cerror.ErrIcebergInvalidMetadata,
+				errors.New("partitioned tables are not supported"), t.name)
+		}
+	}
+	if meta.Refs == nil {
+		meta.Refs = make(map[string]*SnapshotRef)
+	}
+	t.version, t.metadata = version, meta
+	return nil
+}
+
+// writeMetadata writes the next version of the metadata file, then points
+// the version hint to it, which makes the new snapshot visible to readers.
+func (t *Table) writeMetadata(ctx context.Context, meta *TableMetadata) (int64, error) {
+	version := t.version + 1
+	path := t.dir + "/" + metadataFile(version)
+	exists, err := t.storage.FileExists(ctx, path)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	if exists {
+		return 0, errors.Errorf("metadata version %d is committed by another writer", version)
+	}
+	data, err := json.MarshalIndent(meta, "", "  ")
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	if err := t.storage.WriteFile(ctx, path, data); err != nil {
+		return 0, errors.Trace(err)
+	}
+	hint := []byte(strconv.Itoa(version))
+	if err := t.storage.WriteFile(ctx, t.dir+"/"+metadataDir+"/"+versionHintFile, hint); err != nil {
+		return 0, errors.Trace(err)
+	}
+	t.version, t.metadata = version, meta
+	return int64(len(data) + len(hint)), nil
+}
+
+func (t *Table) write(ctx context.Context, path string, data []byte) error {
+	return errors.Trace(t.storage.WriteFile(ctx, t.dir+"/"+path, data))
+}
+
+// read reads a file referenced by the metadata with its absolute path.
+func (t *Table) read(ctx context.Context, path string) ([]byte, error) {
+	relative, err := t.relative(path)
+	if err != nil {
+		return nil, err
+	}
+	data, err := t.storage.ReadFile(ctx, relative)
+	return data, errors.Trace(err)
+}
+
+// relative returns the path in the storage of a file referenced by the
+// metadata with its absolute path.
+func (t *Table) relative(path string) (string, error) {
+	relative, ok := strings.CutPrefix(path, t.warehouse+"/")
+	if !ok {
+		return "", cerror.WrapError(cerror.ErrIcebergInvalidMetadata,
+			errors.Errorf("file %s is not in the warehouse %s", path, t.warehouse), t.name)
+	}
+	return relative, nil
+}
+
+func metadataFile(version int) string {
+	return fmt.Sprintf("%s/v%d.metadata.json", metadataDir, version)
+}
+
+// mergeRows converts the rows to the Iceberg values and merges the rows of
+// the same handle key. It returns the keys of all rows, the last images of
+// the keys which are not deleted and the max commit-ts of the rows.
+func mergeRows(
+	columns []*column, rows []*parquet.Row,
+) (keys [][]interface{}, images [][]interface{}, commitTs uint64, err error) {
+	type entry struct {
+		key   []interface{}
+		image []interface{}
+	}
+	entries := make(map[string]*entry, len(rows))
+	order := make([]string, 0, len(rows))
+	var buf strings.Builder
+	for _, row := range rows {
+		if len(row.Values) != len(columns) {
+			return nil, nil, 0, errors.Errorf(
+				"the column length of row %d doesn't equal to that of table %d",
+				len(row.Values), len(columns))
+		}
+		values := make([]interface{}, len(columns))
+		var key []interface{}
+		buf.Reset()
+		for i, col := range columns {
+			if values[i], err = col.convert(row.Values[i]); err != nil {
+				return nil, nil, 0, errors.Annotatef(err, "column %s", col.field.Name)
+			}
+			if col.isKey {
+				key = append(key, values[i])
+				fmt.Fprintf(&buf, "%T:%v\x00", values[i], values[i])
+			}
+		}
+		e, ok := entries[buf.String()]
+		if !ok {
+			e = &entry{key: key}
+			entries[buf.String()] = e
+			order = append(order, buf.String())
+		}
+		e.image = values
+		if row.IsDelete() {
+			e.image = nil
+		}
+		if row.CommitTs > commitTs {
+			commitTs = row.CommitTs
+		}
+	}
+	for _, k := range order {
+		e := entries[k]
+		keys = append(keys, e.key)
+		if e.image != nil {
+			images = append(images, e.image)
+		}
+	}
+	return keys, images, commitTs, nil
+}
+
+// encodeParquet encodes the rows into a parquet file, the field IDs of the
+// columns are set in the schema of the file.
+func encodeParquet(columns []*column, rows [][]interface{}) ([]byte, error) {
+	root := parquetgo.NewSchemaElement()
+	root.Name = rootSchemaName
+	root.RepetitionType = parquetgo.FieldRepetitionTypePtr(parquetgo.FieldRepetitionType_REQUIRED)
+	root.NumChildren = int32Ptr(int32(len(columns)))
+	schema := make([]*parquetgo.SchemaElement, 0, len(columns)+1)
+	schema = append(schema, root)
+	for _, col := range columns {
+		schema = append(schema, col.newElement())
+	}
+
+	buf := &bytes.Buffer{}
+	pw, err := writer.NewParquetWriterFromWriter(buf, schema, 1)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	pw.MarshalFunc = marshal.MarshalCSV
+	for _, row := range rows {
+		if err := pw.Write(row); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	if err := pw.WriteStop(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return buf.Bytes(), nil
+}
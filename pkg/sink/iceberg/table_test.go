@@ -0,0 +1,373 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iceberg
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tiflow/cdc/entry"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"github.com/pingcap/tiflow/pkg/sink/codec/parquet"
+	"github.com/pingcap/tiflow/pkg/util"
+	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go-source/buffer"
+	parquetcommon "github.com/xitongsys/parquet-go/common"
+	"github.com/xitongsys/parquet-go/reader"
+)
+
+func encodeRows(t *testing.T, events ...*model.RowChangedEvent) []*parquet.Row {
+	encoder := parquet.NewTxnEventEncoderBuilder(common.NewConfig(config.ProtocolParquet)).Build()
+	tableInfo := events[0].TableInfo
+	err := encoder.AppendTxnEvent(&model.SingleTableTxn{TableInfo: tableInfo, Rows: events}, nil)
+	require.NoError(t, err)
+	rows, err := parquet.DecodeRows(tableInfo, encoder.Build())
+	require.NoError(t, err)
+	return rows
+}
+
+func readMetadata(t *testing.T, s storage.ExternalStorage, version string) *TableMetadata {
+	return readTableMetadata(t, s, "test/t", version)
+}
+
+func readTableMetadata(t *testing.T, s storage.ExternalStorage, dir, version string) *TableMetadata {
+	ctx := context.Background()
+	hint, err := s.ReadFile(ctx, dir+"/metadata/version-hint.text")
+	require.NoError(t, err)
+	require.Equal(t, version, string(hint))
+	data, err := s.ReadFile(ctx, dir+"/metadata/v"+version+".metadata.json")
+	require.NoError(t, err)
+	meta := &TableMetadata{}
+	require.NoError(t, json.Unmarshal(data, meta))
+	return meta
+}
+
+// readFiles returns the files added by the current snapshot, and the number
+// of manifests in the manifest list.
+func readFiles(
+	t *testing.T, s storage.ExternalStorage, meta *TableMetadata,
+) (map[int][]byte, map[int]map[string]interface{}, int) {
+	ctx := context.Background()
+	relative := func(path string) string {
+		return strings.TrimPrefix(path, strings.TrimSuffix(s.URI(), "/")+"/")
+	}
+	data, err := s.ReadFile(ctx, relative(meta.currentSnapshot().ManifestList))
+	require.NoError(t, err)
+	manifests, err := decodeOCF(data)
+	require.NoError(t, err)
+
+	files := make(map[int][]byte)
+	entries := make(map[int]map[string]interface{})
+	for _, m := range manifests {
+		m := m.(map[string]interface{})
+		if m["added_snapshot_id"] != *meta.CurrentSnapshotID {
+			continue
+		}
+		require.Equal(t, meta.LastSequenceNumber, m["sequence_number"])
+		data, err := s.ReadFile(ctx, relative(m["manifest_path"].(string)))
+		require.NoError(t, err)
+		records, err := decodeOCF(data)
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		file := records[0].(map[string]interface{})["data_file"].(map[string]interface{})
+		content := int(file["content"].(int32))
+		files[content], err = s.ReadFile(ctx, relative(file["file_path"].(string)))
+		require.NoError(t, err)
+		require.Equal(t, int64(len(files[content])), file["file_size_in_bytes"])
+		entries[content] = file
+	}
+	return files, entries, len(manifests)
+}
+
+func readColumns(t *testing.T, data []byte, names ...string) [][]interface{} {
+	file, err := buffer.NewBufferFile(data)
+	require.NoError(t, err)
+	pr, err := reader.NewParquetColumnReader(file, 1)
+	require.NoError(t, err)
+	defer pr.ReadStop()
+	// Iceberg readers resolve the columns by the field IDs.
+	for _, e := range pr.Footer.GetSchema()[1:] {
+		require.True(t, e.IsSetFieldID(), e.GetName())
+	}
+	var ret [][]interface{}
+	for _, name := range names {
+		path := parquetcommon.PathToStr([]string{rootSchemaName, name})
+		values, _, _, err := pr.ReadColumnByPath(path, pr.GetNumRows())
+		require.NoError(t, err)
+		ret = append(ret, values)
+	}
+	return ret
+}
+
+func TestTableCommit(t *testing.T) {
+	helper := entry.NewSchemaTestHelper(t)
+	defer helper.Close()
+
+	ctx := context.Background()
+	s, err := util.GetExternalStorageFromURI(ctx, "file://"+t.TempDir())
+	require.NoError(t, err)
+	table := NewCatalog(s, time.Hour, 100).Table("test", "t")
+
+	// the first snapshot appends the rows.
+	helper.DDL2Event(`create table test.t(id int primary key, name varchar(16))`)
+	insert1 := helper.DML2Event(`insert into test.t values (1, 'a')`, "test", "t")
+	insert2 := helper.DML2Event(`insert into test.t values (2, 'b')`, "test", "t")
+	_, err = table.Commit(ctx, insert1.TableInfo, encodeRows(t, insert1, insert2))
+	require.NoError(t, err)
+
+	meta := readMetadata(t, s, "1")
+	require.Equal(t, strings.TrimSuffix(s.URI(), "/")+"/test/t", meta.Location)
+	require.Len(t, meta.Schemas, 1)
+	require.Equal(t, 0, meta.CurrentSchemaID)
+	require.Equal(t, []int{1}, meta.Schemas[0].IdentifierFieldIDs)
+	require.Len(t, meta.Snapshots, 1)
+	require.Equal(t, "append", meta.Snapshots[0].Summary["operation"])
+	require.Equal(t, "2", meta.Snapshots[0].Summary["added-records"])
+	require.Equal(t, meta.Snapshots[0].SnapshotID, meta.Refs[mainBranch].SnapshotID)
+	files, _, manifests := readFiles(t, s, meta)
+	require.Equal(t, 1, manifests)
+	require.Len(t, files, 1)
+	require.Equal(t, [][]interface{}{{int32(1), int32(2)}, {"a", "b"}},
+		readColumns(t, files[contentData], "id", "name"))
+
+	// the second snapshot updates and deletes the rows by equality deletes.
+	tableInfo := insert1.TableInfo
+	columns := func(id int64, name string) []*model.ColumnData {
+		return model.Columns2ColumnDatas([]*model.Column{
+			{Name: "id", Value: id},
+			{Name: "name", Value: []byte(name)},
+		}, tableInfo)
+	}
+	update1 := &model.RowChangedEvent{
+		TableInfo: tableInfo, PreColumns: columns(1, "a"), Columns: columns(1, "aa"),
+	}
+	delete2 := &model.RowChangedEvent{TableInfo: tableInfo, PreColumns: columns(2, "b")}
+	insert3 := &model.RowChangedEvent{TableInfo: tableInfo, Columns: columns(3, "c")}
+	delete3 := &model.RowChangedEvent{TableInfo: tableInfo, PreColumns: columns(3, "c")}
+	_, err = table.Commit(ctx, update1.TableInfo, encodeRows(t, update1, delete2, insert3, delete3))
+	require.NoError(t, err)
+
+	meta = readMetadata(t, s, "2")
+	require.Len(t, meta.Snapshots, 2)
+	require.Equal(t, meta.Snapshots[0].SnapshotID, *meta.Snapshots[1].ParentSnapshotID)
+	require.Equal(t, int64(2), meta.LastSequenceNumber)
+	require.Equal(t, "overwrite", meta.Snapshots[1].Summary["operation"])
+	require.Equal(t, "3", meta.Snapshots[1].Summary["added-equality-deletes"])
+	require.Len(t, meta.MetadataLog, 1)
+	require.True(t, strings.HasSuffix(meta.MetadataLog[0].MetadataFile, "/test/t/metadata/v1.metadata.json"))
+	files, entries, manifests := readFiles(t, s, meta)
+	require.Equal(t, 3, manifests)
+	require.Equal(t, [][]interface{}{{int32(1)}, {"aa"}},
+		readColumns(t, files[contentData], "id", "name"))
+	require.Equal(t, [][]interface{}{{int32(1), int32(2), int32(3)}},
+		readColumns(t, files[contentEqualityDelete], "id"))
+	require.Equal(t, map[string]interface{}{"array": []interface{}{int32(1)}},
+		entries[contentEqualityDelete]["equality_ids"])
+
+	// a new schema is added after the DDL.
+	helper.DDL2Event(`alter table test.t add column age int`)
+	insert4 := helper.DML2Event(`insert into test.t values (4, 'd', 40)`, "test", "t")
+	_, err = table.Commit(ctx, insert4.TableInfo, encodeRows(t, insert4))
+	require.NoError(t, err)
+	meta = readMetadata(t, s, "3")
+	require.Len(t, meta.Schemas, 2)
+	require.Equal(t, 1, meta.CurrentSchemaID)
+	require.Equal(t, 3, meta.LastColumnID)
+	require.Equal(t, 1, *meta.Snapshots[2].SchemaID)
+
+	// another writer continues with the committed metadata.
+	insert5 := helper.DML2Event(`insert into test.t values (5, 'e', 50)`, "test", "t")
+	_, err = NewCatalog(s, time.Hour, 100).Table("test", "t").Commit(ctx, insert5.TableInfo, encodeRows(t, insert5))
+	require.NoError(t, err)
+	next := readMetadata(t, s, "4")
+	require.Equal(t, meta.TableUUID, next.TableUUID)
+	require.Len(t, next.Snapshots, 4)
+
+	// the stale writer reloads the metadata before committing.
+	insert6 := helper.DML2Event(`insert into test.t values (6, 'f', 60)`, "test", "t")
+	_, err = table.Commit(ctx, insert6.TableInfo, encodeRows(t, insert6))
+	require.NoError(t, err)
+	meta = readMetadata(t, s, "5")
+	require.Len(t, meta.Snapshots, 5)
+	require.Len(t, meta.MetadataLog, 4)
+	_, _, manifests = readFiles(t, s, meta)
+	require.Equal(t, 9, manifests)
+}
+
+func listFiles(t *testing.T, s storage.ExternalStorage) []string {
+	var files []string
+	err := s.WalkDir(context.Background(), &storage.WalkOption{}, func(path string, _ int64) error {
+		files = append(files, path)
+		return nil
+	})
+	require.NoError(t, err)
+	return files
+}
+
+func TestCatalogDDL(t *testing.T) {
+	helper := entry.NewSchemaTestHelper(t)
+	defer helper.Close()
+
+	ctx := context.Background()
+	s, err := util.GetExternalStorageFromURI(ctx, "file://"+t.TempDir())
+	require.NoError(t, err)
+	catalog := NewCatalog(s, time.Hour, 100)
+
+	helper.DDL2Event(`create table test.t(id int primary key, name varchar(16))`)
+	insert1 := helper.DML2Event(`insert into test.t values (1, 'a')`, "test", "t")
+	_, err = catalog.Table("test", "t").Commit(ctx, insert1.TableInfo, encodeRows(t, insert1))
+	require.NoError(t, err)
+
+	// truncating the table commits a snapshot without manifests.
+	require.NoError(t, catalog.TruncateTable(ctx, "test", "t", 100))
+	meta := readMetadata(t, s, "2")
+	require.Len(t, meta.Snapshots, 2)
+	require.Equal(t, operationDelete, meta.Snapshots[1].Summary["operation"])
+	require.Equal(t, "100", meta.Snapshots[1].Summary[commitTsSummaryKey])
+	require.Equal(t, meta.Snapshots[0].SnapshotID, *meta.Snapshots[1].ParentSnapshotID)
+	_, _, manifests := readFiles(t, s, meta)
+	require.Equal(t, 0, manifests)
+	// an empty table is not truncated again.
+	require.NoError(t, catalog.TruncateTable(ctx, "test", "t", 101))
+	readMetadata(t, s, "2")
+	// truncating a table which is not created does nothing.
+	require.NoError(t, catalog.TruncateTable(ctx, "test", "none", 101))
+
+	insert2 := helper.DML2Event(`insert into test.t values (2, 'b')`, "test", "t")
+	_, err = catalog.Table("test", "t").Commit(ctx, insert2.TableInfo, encodeRows(t, insert2))
+	require.NoError(t, err)
+	meta = readMetadata(t, s, "3")
+	files, _, manifests := readFiles(t, s, meta)
+	require.Equal(t, 2, manifests)
+	require.Equal(t, [][]interface{}{{int32(2)}, {"b"}},
+		readColumns(t, files[contentData], "id", "name"))
+
+	// renaming the table moves the metadata to the new name.
+	table := catalog.Table("test", "t")
+	helper.DDL2Event(`rename table test.t to test.t2`)
+	require.NoError(t, catalog.RenameTable(ctx, "test", "t", "test", "t2"))
+	require.NotSame(t, table, catalog.Table("test", "t"))
+	for _, file := range []string{"version-hint.text", "v1.metadata.json", "v3.metadata.json"} {
+		exists, err := s.FileExists(ctx, "test/t/metadata/"+file)
+		require.NoError(t, err)
+		require.False(t, exists, file)
+	}
+	renamed := readTableMetadata(t, s, "test/t2", "1")
+	require.Equal(t, meta.TableUUID, renamed.TableUUID)
+	require.Equal(t, strings.TrimSuffix(s.URI(), "/")+"/test/t2", renamed.Location)
+	require.Equal(t, meta.Snapshots, renamed.Snapshots)
+	require.Empty(t, renamed.MetadataLog)
+	// renaming it again does nothing.
+	require.NoError(t, catalog.RenameTable(ctx, "test", "t", "test", "t2"))
+	readTableMetadata(t, s, "test/t2", "1")
+
+	insert3 := helper.DML2Event(`insert into test.t2 values (3, 'c')`, "test", "t2")
+	_, err = catalog.Table("test", "t2").Commit(ctx, insert3.TableInfo, encodeRows(t, insert3))
+	require.NoError(t, err)
+	renamed = readTableMetadata(t, s, "test/t2", "2")
+	_, _, manifests = readFiles(t, s, renamed)
+	require.Equal(t, 4, manifests)
+
+	// a new table of the old name.
+	helper.DDL2Event(`create table test.t(id int primary key, name varchar(16))`)
+	insert4 := helper.DML2Event(`insert into test.t values (4, 'd')`, "test", "t")
+	_, err = catalog.Table("test", "t").Commit(ctx, insert4.TableInfo, encodeRows(t, insert4))
+	require.NoError(t, err)
+	meta = readMetadata(t, s, "1")
+	require.NotEqual(t, renamed.TableUUID, meta.TableUUID)
+	require.Len(t, meta.Snapshots, 1)
+
+	// dropping the renamed table deletes its files in both directories.
+	table = catalog.Table("test", "t2")
+	require.NoError(t, catalog.DropTable(ctx, "test", "t2"))
+	require.NotSame(t, table, catalog.Table("test", "t2"))
+	for _, file := range listFiles(t, s) {
+		require.False(t, strings.HasPrefix(file, "test/t2/"), file)
+	}
+	// only the files of the new table are kept: the metadata file, the version
+	// hint, the manifest list, the manifest and the data file.
+	require.Len(t, listFiles(t, s), 5)
+	files, _, _ = readFiles(t, s, readMetadata(t, s, "1"))
+	require.Equal(t, [][]interface{}{{int32(4)}, {"d"}},
+		readColumns(t, files[contentData], "id", "name"))
+	// dropping a table which doesn't exist does nothing.
+	require.NoError(t, catalog.DropTable(ctx, "test", "t2"))
+
+	// dropping the schema drops all of its tables.
+	require.NoError(t, catalog.DropSchema(ctx, "test"))
+	require.Empty(t, listFiles(t, s))
+	require.NoError(t, catalog.DropSchema(ctx, "test"))
+}
+
+func TestTableExpire(t *testing.T) {
+	helper := entry.NewSchemaTestHelper(t)
+	defer helper.Close()
+
+	ctx := context.Background()
+	s, err := util.GetExternalStorageFromURI(ctx, "file://"+t.TempDir())
+	require.NoError(t, err)
+	// only the current snapshot and 2 previous metadata files are kept.
+	catalog := NewCatalog(s, 0, 2)
+	table := catalog.Table("test", "t")
+
+	helper.DDL2Event(`create table test.t(id int primary key, name varchar(16))`)
+	commit := func(dml string) {
+		// the snapshots committed in the same millisecond are not expired.
+		time.Sleep(2 * time.Millisecond)
+		event := helper.DML2Event(dml, "test", "t")
+		_, err := table.Commit(ctx, event.TableInfo, encodeRows(t, event))
+		require.NoError(t, err)
+	}
+	commit(`insert into test.t values (1, 'a')`)
+	first := readMetadata(t, s, "1").Snapshots[0]
+	commit(`insert into test.t values (2, 'b')`)
+
+	// the manifest list of the expired snapshot is deleted, and its manifests
+	// are kept for the current snapshot.
+	meta := readMetadata(t, s, "2")
+	require.Len(t, meta.Snapshots, 1)
+	require.Len(t, meta.SnapshotLog, 1)
+	require.Equal(t, first.SnapshotID, *meta.Snapshots[0].ParentSnapshotID)
+	exists, err := s.FileExists(ctx, strings.TrimPrefix(first.ManifestList, strings.TrimSuffix(s.URI(), "/")+"/"))
+	require.NoError(t, err)
+	require.False(t, exists)
+	_, _, manifests := readFiles(t, s, meta)
+	require.Equal(t, 3, manifests)
+
+	// the metadata files out of the metadata log are deleted.
+	commit(`insert into test.t values (3, 'c')`)
+	commit(`insert into test.t values (4, 'd')`)
+	meta = readMetadata(t, s, "4")
+	require.Len(t, meta.MetadataLog, 2)
+	require.True(t, strings.HasSuffix(meta.MetadataLog[0].MetadataFile, "/test/t/metadata/v2.metadata.json"))
+	exists, err = s.FileExists(ctx, "test/t/metadata/v1.metadata.json")
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	// the data files removed by TRUNCATE TABLE are deleted with the last
+	// snapshot before the truncation.
+	time.Sleep(2 * time.Millisecond)
+	require.NoError(t, catalog.TruncateTable(ctx, "test", "t", 100))
+	meta = readMetadata(t, s, "5")
+	require.Len(t, meta.Snapshots, 1)
+	require.Equal(t, operationDelete, meta.Snapshots[0].Summary["operation"])
+	// the metadata files v3, v4 and v5, the version hint and the manifest list.
+	require.Len(t, listFiles(t, s), 5)
+}
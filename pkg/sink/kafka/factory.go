@@ -23,6 +23,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/model"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"github.com/pingcap/tiflow/pkg/tracing"
 	"github.com/pingcap/tiflow/pkg/util"
 	"go.uber.org/zap"
 )
@@ -259,6 +260,12 @@ func (p *saramaAsyncProducer) AsyncSend(ctx context.Context, topic string, parti
 		Value:     sarama.ByteEncoder(message.Value),
 		Metadata:  message.Callback,
 	}
+	if message.TraceParent != "" {
+		msg.Headers = []sarama.RecordHeader{{
+			Key:   []byte(tracing.TraceParentHeader),
+			Value: []byte(message.TraceParent),
+		}}
+	}
 	select {
 	case <-ctx.Done():
 		return errors.Trace(ctx.Err())
@@ -29,6 +29,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/security"
 	"github.com/pingcap/tiflow/pkg/sink/codec/common"
 	pkafka "github.com/pingcap/tiflow/pkg/sink/kafka"
+	"github.com/pingcap/tiflow/pkg/tracing"
 	"github.com/pingcap/tiflow/pkg/util"
 	"github.com/segmentio/kafka-go"
 	"github.com/segmentio/kafka-go/sasl"
@@ -370,13 +371,20 @@ func (a *asyncWriter) AsyncSend(ctx context.Context, topic string, partition int
 		return errors.Trace(ctx.Err())
 	default:
 	}
-	return a.w.WriteMessages(ctx, kafka.Message{
+	msg := kafka.Message{
 		Topic:      topic,
 		Partition:  int(partition),
 		Key:        message.Key,
 		Value:      message.Value,
 		WriterData: message.Callback,
-	})
+	}
+	if message.TraceParent != "" {
+		msg.Headers = []kafka.Header{{
+			Key:   tracing.TraceParentHeader,
+			Value: []byte(message.TraceParent),
+		}}
+	}
+	return a.w.WriteMessages(ctx, msg)
 }
 
 // AsyncRunCallback process the messages that has sent to kafka,
@@ -0,0 +1,183 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/imdario/mergo"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	psink "github.com/pingcap/tiflow/pkg/sink"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultWorkerCount is the default number of workers.
+	DefaultWorkerCount = 16
+	// DefaultMaxTxnRow is the default max number of rows in a transaction.
+	DefaultMaxTxnRow = 256
+	// The upper limit of max worker counts.
+	maxWorkerCount = 1024
+	// The upper limit of max txn rows.
+	maxMaxTxnRow = 2048
+
+	// BackoffBaseDelay indicates the base delay time for retrying.
+	BackoffBaseDelay = 500 * time.Millisecond
+	// BackoffMaxDelay indicates the max delay time for retrying.
+	BackoffMaxDelay = 60 * time.Second
+)
+
+// urlConfig contains the sink URI parameters consumed by TiCDC. All the other
+// parameters are forwarded to the PostgreSQL driver, so driver options like
+// `sslmode` or `connect_timeout` can be set in the sink URI directly.
+type urlConfig struct {
+	WorkerCount *int  `form:"worker-count"`
+	MaxTxnRow   *int  `form:"max-txn-row"`
+	SafeMode    *bool `form:"safe-mode"`
+}
+
+// Config is the configs for PostgreSQL backend.
+type Config struct {
+	WorkerCount int
+	MaxTxnRow   int
+	// SafeMode makes the rows always upserted, otherwise only the rows which
+	// may have been replicated before are upserted.
+	SafeMode bool
+	// DSN is the connection string passed to the PostgreSQL driver.
+	DSN string
+}
+
+// NewConfig returns the default PostgreSQL backend config.
+func NewConfig() *Config {
+	return &Config{
+		WorkerCount: DefaultWorkerCount,
+		MaxTxnRow:   DefaultMaxTxnRow,
+	}
+}
+
+// Apply applies the sink URI parameters and the replica config to the config.
+func (c *Config) Apply(sinkURI *url.URL, replicaConfig *config.ReplicaConfig) error {
+	if sinkURI == nil {
+		return cerror.ErrPostgresInvalidConfig.GenWithStack(
+			"fail to open PostgreSQL sink, empty SinkURI")
+	}
+	scheme := strings.ToLower(sinkURI.Scheme)
+	if !psink.IsPostgresScheme(scheme) {
+		return cerror.ErrPostgresInvalidConfig.GenWithStack(
+			"can't create PostgreSQL sink with unsupported scheme: %s", scheme)
+	}
+
+	req := &http.Request{URL: sinkURI}
+	urlParameter := &urlConfig{}
+	if err := binding.Query.Bind(req, urlParameter); err != nil {
+		return cerror.WrapError(cerror.ErrPostgresInvalidConfig, err)
+	}
+	urlParameter, err := mergeConfig(replicaConfig, urlParameter)
+	if err != nil {
+		return err
+	}
+	if err := getWorkerCount(urlParameter, &c.WorkerCount); err != nil {
+		return err
+	}
+	if err := getMaxTxnRow(urlParameter, &c.MaxTxnRow); err != nil {
+		return err
+	}
+	if urlParameter.SafeMode != nil {
+		c.SafeMode = *urlParameter.SafeMode
+	}
+	c.DSN = generateDSN(sinkURI)
+	return nil
+}
+
+// mergeConfig merges the replica config into the sink URI parameters, the
+// sink URI parameters take precedence.
+func mergeConfig(
+	replicaConfig *config.ReplicaConfig,
+	urlParameters *urlConfig,
+) (*urlConfig, error) {
+	dest := &urlConfig{}
+	if replicaConfig != nil && replicaConfig.Sink != nil {
+		dest.SafeMode = replicaConfig.Sink.SafeMode
+		if replicaConfig.Sink.MySQLConfig != nil {
+			dest.WorkerCount = replicaConfig.Sink.MySQLConfig.WorkerCount
+			dest.MaxTxnRow = replicaConfig.Sink.MySQLConfig.MaxTxnRow
+		}
+	}
+	if err := mergo.Merge(dest, urlParameters, mergo.WithOverride); err != nil {
+		return nil, cerror.WrapError(cerror.ErrPostgresInvalidConfig, err)
+	}
+	// mergo doesn't override with the zero value, so `safe-mode=false` is
+	// applied explicitly.
+	if urlParameters.SafeMode != nil {
+		dest.SafeMode = urlParameters.SafeMode
+	}
+	return dest, nil
+}
+
+// generateDSN strips the parameters consumed by TiCDC from the sink URI, the
+// rest of the URI is a valid PostgreSQL connection URI.
+func generateDSN(sinkURI *url.URL) string {
+	dsn := *sinkURI
+	dsn.Scheme = psink.PostgresScheme
+	query := dsn.Query()
+	query.Del("worker-count")
+	query.Del("max-txn-row")
+	query.Del("safe-mode")
+	dsn.RawQuery = query.Encode()
+	dsn.Fragment = ""
+	return dsn.String()
+}
+
+func getWorkerCount(values *urlConfig, workerCount *int) error {
+	if values.WorkerCount == nil {
+		return nil
+	}
+	c := *values.WorkerCount
+	if c <= 0 {
+		return cerror.WrapError(cerror.ErrPostgresInvalidConfig,
+			fmt.Errorf("invalid worker-count %d, which must be greater than 0", c))
+	}
+	if c > maxWorkerCount {
+		log.Warn("worker-count too large",
+			zap.Int("original", c), zap.Int("override", maxWorkerCount))
+		c = maxWorkerCount
+	}
+	*workerCount = c
+	return nil
+}
+
+func getMaxTxnRow(values *urlConfig, maxTxnRow *int) error {
+	if values.MaxTxnRow == nil {
+		return nil
+	}
+	c := *values.MaxTxnRow
+	if c <= 0 {
+		return cerror.WrapError(cerror.ErrPostgresInvalidConfig,
+			fmt.Errorf("invalid max-txn-row %d, which must be greater than 0", c))
+	}
+	if c > maxMaxTxnRow {
+		log.Warn("max-txn-row too large",
+			zap.Int("original", c), zap.Int("override", maxMaxTxnRow))
+		c = maxMaxTxnRow
+	}
+	*maxTxnRow = c
+	return nil
+}
@@ -0,0 +1,48 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	// register the PostgreSQL driver.
+	_ "github.com/lib/pq"
+	"github.com/pingcap/log"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// ConnectionFactory creates a database connection with the given DSN.
+// It can be replaced in unit tests to return a mocked connection.
+type ConnectionFactory func(ctx context.Context, dsnStr string) (*sql.DB, error)
+
+// CreatePostgresDBConn establishes a connection to a PostgreSQL database and
+// pings it to verify the connection.
+func CreatePostgresDBConn(ctx context.Context, dsnStr string) (*sql.DB, error) {
+	db, err := sql.Open("postgres", dsnStr)
+	if err != nil {
+		return nil, cerror.ErrPostgresConnectionError.Wrap(err).
+			GenWithStack("fail to open PostgreSQL connection")
+	}
+
+	if err = db.PingContext(ctx); err != nil {
+		if closeErr := db.Close(); closeErr != nil {
+			log.Warn("close db failed", zap.Error(closeErr))
+		}
+		return nil, cerror.ErrPostgresConnectionError.Wrap(err).
+			GenWithStack("fail to open PostgreSQL connection")
+	}
+	return db, nil
+}
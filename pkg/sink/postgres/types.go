@@ -0,0 +1,197 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	timodel "github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser/charset"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/types"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// ColumnType returns the PostgreSQL column type used to replicate the given
+// TiDB column. The compatibility table is:
+//
+//	TiDB                          PostgreSQL
+//	TINYINT [UNSIGNED]            smallint
+//	SMALLINT                      smallint
+//	SMALLINT UNSIGNED             integer
+//	MEDIUMINT [UNSIGNED]          integer
+//	INT                           integer
+//	INT UNSIGNED                  bigint
+//	BIGINT                        bigint
+//	BIGINT UNSIGNED               numeric(20)
+//	YEAR                          smallint
+//	BIT(M)                        bigint, numeric(20) if M is 64
+//	FLOAT                         real
+//	DOUBLE                        double precision
+//	DECIMAL(M,D)                  numeric(M,D)
+//	DATE                          date
+//	DATETIME(fsp), TIMESTAMP(fsp) timestamp(fsp)
+//	TIME(fsp)                     interval
+//	CHAR(M), VARCHAR(M)           char(M), varchar(M)
+//	BINARY(M), VARBINARY(M)       bytea
+//	TEXT types                    text
+//	BLOB types                    bytea
+//	JSON                          jsonb
+//	ENUM, SET                     text
+//	VECTOR                        text
+//
+// TIMESTAMP values are replicated in the time zone of the changefeed, as
+// `timestamp without time zone`. All the other types are not supported.
+func ColumnType(col *timodel.ColumnInfo) (string, error) {
+	ft := &col.FieldType
+	unsigned := mysql.HasUnsignedFlag(ft.GetFlag())
+	switch ft.GetType() {
+	case mysql.TypeTiny:
+		return "smallint", nil
+	case mysql.TypeShort:
+		if unsigned {
+			return "integer", nil
+		}
+		return "smallint", nil
+	case mysql.TypeInt24:
+		return "integer", nil
+	case mysql.TypeLong:
+		if unsigned {
+			return "bigint", nil
+		}
+		return "integer", nil
+	case mysql.TypeLonglong:
+		if unsigned {
+			return "numeric(20)", nil
+		}
+		return "bigint", nil
+	case mysql.TypeYear:
+		return "smallint", nil
+	case mysql.TypeBit:
+		if ft.GetFlen() >= 64 {
+			return "numeric(20)", nil
+		}
+		return "bigint", nil
+	case mysql.TypeFloat:
+		return "real", nil
+	case mysql.TypeDouble:
+		return "double precision", nil
+	case mysql.TypeNewDecimal:
+		return fmt.Sprintf("numeric(%d,%d)", ft.GetFlen(), ft.GetDecimal()), nil
+	case mysql.TypeDate, mysql.TypeNewDate:
+		return "date", nil
+	case mysql.TypeDatetime, mysql.TypeTimestamp:
+		return fmt.Sprintf("timestamp(%d)", fsp(ft)), nil
+	case mysql.TypeDuration:
+		return "interval", nil
+	case mysql.TypeString, mysql.TypeVarchar, mysql.TypeVarString:
+		if isBinary(ft) {
+			return "bytea", nil
+		}
+		if ft.GetType() == mysql.TypeString {
+			return fmt.Sprintf("char(%d)", ft.GetFlen()), nil
+		}
+		return fmt.Sprintf("varchar(%d)", ft.GetFlen()), nil
+	case mysql.TypeTinyBlob, mysql.TypeBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob:
+		if isBinary(ft) {
+			return "bytea", nil
+		}
+		return "text", nil
+	case mysql.TypeJSON:
+		return "jsonb", nil
+	case mysql.TypeEnum, mysql.TypeSet, mysql.TypeTiDBVectorFloat32:
+		return "text", nil
+	}
+	return "", cerror.ErrPostgresUnsupportedType.GenWithStackByArgs(
+		col.Name.O, types.TypeToStr(ft.GetType(), ft.GetCharset()))
+}
+
+// ConvertValue converts the value of a TiDB column, as decoded by the
+// mounter, into a value accepted by the PostgreSQL driver for the column type
+// returned by ColumnType.
+func ConvertValue(col *timodel.ColumnInfo, value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	ft := &col.FieldType
+	switch ft.GetType() {
+	case mysql.TypeEnum:
+		v, ok := value.(uint64)
+		if !ok {
+			break
+		}
+		if v == 0 {
+			return ""
+		}
+		e, err := types.ParseEnumValue(ft.GetElems(), v)
+		if err != nil {
+			return strconv.FormatUint(v, 10)
+		}
+		return e.Name
+	case mysql.TypeSet:
+		v, ok := value.(uint64)
+		if !ok {
+			break
+		}
+		s, err := types.ParseSetValue(ft.GetElems(), v)
+		if err != nil {
+			return strconv.FormatUint(v, 10)
+		}
+		return s.Name
+	case mysql.TypeDate, mysql.TypeNewDate, mysql.TypeDatetime, mysql.TypeTimestamp:
+		// PostgreSQL rejects zero dates, replicate them as NULL.
+		if v, ok := value.(string); ok && isZeroDate(v) {
+			return nil
+		}
+	case mysql.TypeTiDBVectorFloat32:
+		if v, ok := value.(types.VectorFloat32); ok {
+			return v.String()
+		}
+	}
+
+	switch v := value.(type) {
+	case uint64:
+		// The driver does not accept uint64 values with the high bit set.
+		if v > math.MaxInt64 {
+			return strconv.FormatUint(v, 10)
+		}
+		return int64(v)
+	case []byte:
+		if !isBinary(ft) {
+			return string(v)
+		}
+	}
+	return value
+}
+
+func isBinary(ft *types.FieldType) bool {
+	return ft.GetCharset() == "" || ft.GetCharset() == charset.CharsetBin
+}
+
+func isZeroDate(v string) bool {
+	// The format is "YYYY-MM-DD" followed by an optional time part.
+	if len(v) < 10 {
+		return false
+	}
+	return v[5:7] == "00" || v[8:10] == "00"
+}
+
+func fsp(ft *types.FieldType) int {
+	if d := ft.GetDecimal(); d > 0 && d <= 6 {
+		return d
+	}
+	return 0
+}
@@ -0,0 +1,93 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newColumn(tp byte, flag uint, flen, decimal int, charset string, elems ...string) *model.ColumnInfo {
+	ft := types.NewFieldType(tp)
+	ft.SetFlag(flag)
+	ft.SetFlen(flen)
+	ft.SetDecimal(decimal)
+	ft.SetCharset(charset)
+	ft.SetElems(elems)
+	return &model.ColumnInfo{Name: ast.NewCIStr("c"), FieldType: *ft}
+}
+
+func TestColumnType(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		col      *model.ColumnInfo
+		expected string
+	}{
+		{newColumn(mysql.TypeTiny, 0, 4, 0, ""), "smallint"},
+		{newColumn(mysql.TypeShort, mysql.UnsignedFlag, 6, 0, ""), "integer"},
+		{newColumn(mysql.TypeLong, 0, 11, 0, ""), "integer"},
+		{newColumn(mysql.TypeLong, mysql.UnsignedFlag, 10, 0, ""), "bigint"},
+		{newColumn(mysql.TypeLonglong, mysql.UnsignedFlag, 20, 0, ""), "numeric(20)"},
+		{newColumn(mysql.TypeBit, mysql.UnsignedFlag, 1, 0, ""), "bigint"},
+		{newColumn(mysql.TypeBit, mysql.UnsignedFlag, 64, 0, ""), "numeric(20)"},
+		{newColumn(mysql.TypeNewDecimal, 0, 10, 2, ""), "numeric(10,2)"},
+		{newColumn(mysql.TypeDatetime, 0, 0, 3, ""), "timestamp(3)"},
+		{newColumn(mysql.TypeTimestamp, 0, 0, 0, ""), "timestamp(0)"},
+		{newColumn(mysql.TypeDuration, 0, 0, 0, ""), "interval"},
+		{newColumn(mysql.TypeString, 0, 10, 0, "utf8mb4"), "char(10)"},
+		{newColumn(mysql.TypeVarchar, 0, 255, 0, "utf8mb4"), "varchar(255)"},
+		{newColumn(mysql.TypeVarchar, mysql.BinaryFlag, 255, 0, "binary"), "bytea"},
+		{newColumn(mysql.TypeBlob, 0, 0, 0, "utf8mb4"), "text"},
+		{newColumn(mysql.TypeBlob, mysql.BinaryFlag, 0, 0, "binary"), "bytea"},
+		{newColumn(mysql.TypeJSON, 0, 0, 0, ""), "jsonb"},
+		{newColumn(mysql.TypeEnum, 0, 0, 0, "utf8mb4", "a", "b"), "text"},
+	}
+	for _, c := range cases {
+		tp, err := ColumnType(c.col)
+		require.NoError(t, err)
+		require.Equal(t, c.expected, tp)
+	}
+
+	_, err := ColumnType(newColumn(mysql.TypeGeometry, 0, 0, 0, ""))
+	require.ErrorContains(t, err, "is not supported by PostgreSQL sink")
+}
+
+func TestConvertValue(t *testing.T) {
+	t.Parallel()
+
+	enum := newColumn(mysql.TypeEnum, 0, 0, 0, "utf8mb4", "a", "b")
+	require.Equal(t, "b", ConvertValue(enum, uint64(2)))
+	set := newColumn(mysql.TypeSet, 0, 0, 0, "utf8mb4", "a", "b")
+	require.Equal(t, "a,b", ConvertValue(set, uint64(3)))
+
+	datetime := newColumn(mysql.TypeDatetime, 0, 0, 0, "")
+	require.Nil(t, ConvertValue(datetime, "0000-00-00 00:00:00"))
+	require.Equal(t, "2024-01-02 03:04:05", ConvertValue(datetime, "2024-01-02 03:04:05"))
+
+	bigint := newColumn(mysql.TypeLonglong, mysql.UnsignedFlag, 20, 0, "")
+	require.Equal(t, int64(1), ConvertValue(bigint, uint64(1)))
+	require.Equal(t, "18446744073709551615", ConvertValue(bigint, uint64(18446744073709551615)))
+
+	varchar := newColumn(mysql.TypeVarchar, 0, 10, 0, "utf8mb4")
+	require.Equal(t, "abc", ConvertValue(varchar, []byte("abc")))
+	binary := newColumn(mysql.TypeVarchar, mysql.BinaryFlag, 10, 0, "binary")
+	require.Equal(t, []byte("abc"), ConvertValue(binary, []byte("abc")))
+	require.Nil(t, ConvertValue(varchar, nil))
+}
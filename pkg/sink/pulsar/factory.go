@@ -29,21 +29,37 @@ type FactoryCreator func(config *config.PulsarConfig, changefeedID model.ChangeF
 
 // NewCreatorFactory returns a factory implemented based on kafka-go
 func NewCreatorFactory(config *config.PulsarConfig, changefeedID model.ChangeFeedID, sinkConfig *config.SinkConfig) (pulsar.Client, error) {
+	option, err := NewClientOptions(config, sinkConfig)
+	if err != nil {
+		return nil, err
+	}
+	option.CustomMetricsLabels = map[string]string{
+		"changefeed": changefeedID.ID,
+		"namespace":  changefeedID.Namespace,
+	}
+	// add pulsar default metrics
+	option.MetricsRegisterer = mq.GetMetricRegistry()
+	log.Info("pulsar client factory created",
+		zap.Stringer("changefeedID", changefeedID),
+		zap.Any("clientOptions", option))
+
+	pulsarClient, err := pulsar.NewClient(option)
+	if err != nil {
+		log.Error("cannot connect to pulsar", zap.Error(err))
+		return nil, err
+	}
+	return pulsarClient, nil
+}
+
+// NewClientOptions returns the pulsar client options, including the
+// authentication and TLS settings, built from the pulsar config.
+func NewClientOptions(config *config.PulsarConfig, sinkConfig *config.SinkConfig) (pulsar.ClientOptions, error) {
 	option := pulsar.ClientOptions{
-		URL: config.BrokerURL,
-		CustomMetricsLabels: map[string]string{
-			"changefeed": changefeedID.ID,
-			"namespace":  changefeedID.Namespace,
-		},
+		URL:               config.BrokerURL,
 		ConnectionTimeout: config.ConnectionTimeout.Duration(),
 		OperationTimeout:  config.OperationTimeout.Duration(),
-		// add pulsar default metrics
-		MetricsRegisterer: mq.GetMetricRegistry(),
 		Logger:            NewPulsarLogger(log.L()),
 	}
-	log.Info("pulsar client factory created",
-		zap.Stringer("changefeedID", changefeedID),
-		zap.Any("clientOptions", option))
 
 	var err error
 	// ismTLSAuthentication is true if it is mTLS authentication
@@ -51,14 +67,14 @@ func NewCreatorFactory(config *config.PulsarConfig, changefeedID model.ChangeFee
 	ismTLSAuthentication, option.Authentication, err = setupAuthentication(config)
 	if err != nil {
 		log.Error("setup pulsar authentication fail", zap.Error(err))
-		return nil, err
+		return option, err
 	}
 	// When mTLS authentication is enabled, trust certs file path is required.
 	if ismTLSAuthentication {
 		if sinkConfig.PulsarConfig != nil && sinkConfig.PulsarConfig.TLSTrustCertsFilePath != nil {
 			option.TLSTrustCertsFilePath = *sinkConfig.PulsarConfig.TLSTrustCertsFilePath
 		} else {
-			return nil, cerror.ErrPulsarInvalidConfig.
+			return option, cerror.ErrPulsarInvalidConfig.
 				GenWithStackByArgs("pulsar tls trust certs file path is not set when mTLS authentication is enabled")
 		}
 	}
@@ -81,13 +97,7 @@ func NewCreatorFactory(config *config.PulsarConfig, changefeedID model.ChangeFee
 			log.Info("pulsar tls certificate file and tls key file path is set, tls ")
 		}
 	}
-
-	pulsarClient, err := pulsar.NewClient(option)
-	if err != nil {
-		log.Error("cannot connect to pulsar", zap.Error(err))
-		return nil, err
-	}
-	return pulsarClient, nil
+	return option, nil
 }
 
 // setupAuthentication sets up authentication for pulsar client
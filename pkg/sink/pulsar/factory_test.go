@@ -0,0 +1,55 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulsar
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewClientOptions(t *testing.T) {
+	t.Parallel()
+
+	sinkURI, err := url.Parse("pulsar+ssl://127.0.0.1:6651/persistent://public/default/test")
+	require.NoError(t, err)
+	pulsarConfig, err := NewPulsarConfig(sinkURI, &config.PulsarConfig{
+		TLSTrustCertsFilePath: util.AddressOf("ca.pem"),
+		TLSCertificateFile:    util.AddressOf("cert.pem"),
+		TLSKeyFilePath:        util.AddressOf("key.pem"),
+		AuthenticationToken:   util.AddressOf("token"),
+	})
+	require.NoError(t, err)
+	sinkConfig := &config.SinkConfig{PulsarConfig: pulsarConfig}
+
+	option, err := NewClientOptions(pulsarConfig, sinkConfig)
+	require.NoError(t, err)
+	require.Equal(t, "pulsar+ssl://127.0.0.1:6651", option.URL)
+	require.Equal(t, "ca.pem", option.TLSTrustCertsFilePath)
+	require.Equal(t, "cert.pem", option.TLSCertificateFile)
+	require.Equal(t, "key.pem", option.TLSKeyFilePath)
+	require.NotNil(t, option.Authentication)
+	require.Equal(t, pulsarConfig.OperationTimeout.Duration(), option.OperationTimeout)
+
+	// mTLS authentication requires the trust certs file.
+	pulsarConfig.AuthenticationToken = nil
+	pulsarConfig.TLSTrustCertsFilePath = nil
+	pulsarConfig.AuthTLSCertificatePath = util.AddressOf("client.pem")
+	pulsarConfig.AuthTLSPrivateKeyPath = util.AddressOf("client.key")
+	_, err = NewClientOptions(pulsarConfig, sinkConfig)
+	require.ErrorContains(t, err, "trust certs file path is not set")
+}
@@ -0,0 +1,292 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/imdario/mergo"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/security"
+	psink "github.com/pingcap/tiflow/pkg/sink"
+	"github.com/pingcap/tiflow/pkg/util"
+	goredis "github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultPort is the default port of redis.
+	defaultPort = "6379"
+	// defaultWorkerCount is the default value of worker-count.
+	defaultWorkerCount = 8
+	// the upper limit of worker-count.
+	maxWorkerCount = 128
+	// defaultMaxBatchRows is the default value of max-batch-rows.
+	defaultMaxBatchRows = 512
+	// the upper limit of max-batch-rows.
+	maxMaxBatchRows = 8192
+	// defaultTimeout is the default value of timeout.
+	defaultTimeout = 5 * time.Second
+	// defaultMaxRetries is the default value of max-retries.
+	defaultMaxRetries = 10
+	// defaultMinRetryBackoff is the initial backoff between two retries.
+	defaultMinRetryBackoff = 100 * time.Millisecond
+	// defaultMaxRetryBackoff is the maximum backoff between two retries.
+	defaultMaxRetryBackoff = 5 * time.Second
+)
+
+type urlConfig struct {
+	WorkerCount  *int    `form:"worker-count"`
+	MaxBatchRows *int    `form:"max-batch-rows"`
+	Timeout      *string `form:"timeout"`
+	MaxRetries   *int    `form:"max-retries"`
+}
+
+// Config is the configuration for redis sink.
+type Config struct {
+	Addr         string
+	Username     string
+	Password     string
+	DB           int
+	WorkerCount  int
+	MaxBatchRows int
+	Timeout      time.Duration
+	MaxRetries   int
+	TLSConfig    *tls.Config
+	// Rules decide which tables are written to redis and how.
+	Rules []*Rule
+}
+
+// NewConfig returns the default redis sink config.
+func NewConfig() *Config {
+	return &Config{
+		WorkerCount:  defaultWorkerCount,
+		MaxBatchRows: defaultMaxBatchRows,
+		Timeout:      defaultTimeout,
+		MaxRetries:   defaultMaxRetries,
+	}
+}
+
+// Apply applies the sink URI parameters to the config.
+// The sink URI is in form of `redis://[[user]:password@]host[:port][/db]`.
+func (c *Config) Apply(
+	sinkURI *url.URL,
+	replicaConfig *config.ReplicaConfig,
+) (err error) {
+	if sinkURI == nil {
+		return cerror.ErrRedisInvalidConfig.GenWithStack(
+			"failed to open redis sink, empty SinkURI")
+	}
+	scheme := strings.ToLower(sinkURI.Scheme)
+	if !psink.IsRedisScheme(scheme) {
+		return cerror.ErrRedisInvalidConfig.GenWithStack(
+			"can't create redis sink with unsupported scheme: %s", scheme)
+	}
+	if sinkURI.Hostname() == "" {
+		return cerror.ErrRedisInvalidConfig.GenWithStack(
+			"can't create redis sink without host")
+	}
+	port := sinkURI.Port()
+	if port == "" {
+		port = defaultPort
+	}
+	c.Addr = sinkURI.Hostname() + ":" + port
+	if db := strings.Trim(sinkURI.Path, "/"); db != "" {
+		if c.DB, err = strconv.Atoi(db); err != nil || c.DB < 0 {
+			return cerror.ErrRedisInvalidConfig.GenWithStack(
+				"invalid database %s in sink URI", db)
+		}
+	}
+	if sinkURI.User != nil {
+		c.Username = sinkURI.User.Username()
+		c.Password, _ = sinkURI.User.Password()
+	}
+
+	req := &http.Request{URL: sinkURI}
+	urlParameter := &urlConfig{}
+	if err := binding.Query.Bind(req, urlParameter); err != nil {
+		return cerror.WrapError(cerror.ErrRedisInvalidConfig, err)
+	}
+	if urlParameter, err = mergeConfig(replicaConfig, urlParameter); err != nil {
+		return err
+	}
+	if err = getWorkerCount(urlParameter, &c.WorkerCount); err != nil {
+		return err
+	}
+	if err = getMaxBatchRows(urlParameter, &c.MaxBatchRows); err != nil {
+		return err
+	}
+	if err = getTimeout(urlParameter, &c.Timeout); err != nil {
+		return err
+	}
+	if urlParameter.MaxRetries != nil {
+		if *urlParameter.MaxRetries < 0 {
+			return cerror.WrapError(cerror.ErrRedisInvalidConfig,
+				fmt.Errorf("invalid max-retries %d, it must not be negative", *urlParameter.MaxRetries))
+		}
+		c.MaxRetries = *urlParameter.MaxRetries
+	}
+
+	redisConfig := replicaConfig.Sink.RedisConfig
+	if redisConfig == nil || len(redisConfig.Rules) == 0 {
+		return cerror.ErrRedisInvalidConfig.GenWithStack(
+			"no rule is configured, please specify the tables by sink.redis-config.rules")
+	}
+	c.Rules = make([]*Rule, 0, len(redisConfig.Rules))
+	for _, ruleConfig := range redisConfig.Rules {
+		rule, err := newRule(ruleConfig, replicaConfig.CaseSensitive)
+		if err != nil {
+			return err
+		}
+		c.Rules = append(c.Rules, rule)
+	}
+
+	credential := &security.Credential{
+		CAPath:   util.GetOrZero(redisConfig.SSLCa),
+		CertPath: util.GetOrZero(redisConfig.SSLCert),
+		KeyPath:  util.GetOrZero(redisConfig.SSLKey),
+	}
+	if credential.IsTLSEnabled() {
+		if scheme != psink.RedisSSLScheme {
+			return cerror.ErrRedisInvalidConfig.GenWithStack(
+				"ssl-ca is set, but the scheme of sink URI is %s", scheme)
+		}
+		c.TLSConfig, err = credential.ToTLSConfig()
+		if err != nil {
+			return cerror.WrapError(cerror.ErrRedisInvalidConfig, err)
+		}
+	} else if scheme == psink.RedisSSLScheme {
+		c.TLSConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	return nil
+}
+
+// MatchRule returns the first rule matching the table,
+// it returns nil if the table does not match any rule.
+func (c *Config) MatchRule(schema, table string) *Rule {
+	for _, rule := range c.Rules {
+		if rule.MatchTable(schema, table) {
+			return rule
+		}
+	}
+	return nil
+}
+
+// ClientOptions returns the options of the redis client. The commands are
+// retried by the client with backoff if the connection fails.
+func (c *Config) ClientOptions() *goredis.Options {
+	// go-redis takes 0 as its own default, -1 disables the retry.
+	maxRetries := c.MaxRetries
+	if maxRetries == 0 {
+		maxRetries = -1
+	}
+	return &goredis.Options{
+		Addr:            c.Addr,
+		Username:        c.Username,
+		Password:        c.Password,
+		DB:              c.DB,
+		PoolSize:        c.WorkerCount,
+		DialTimeout:     c.Timeout,
+		ReadTimeout:     c.Timeout,
+		WriteTimeout:    c.Timeout,
+		MaxRetries:      maxRetries,
+		MinRetryBackoff: defaultMinRetryBackoff,
+		MaxRetryBackoff: defaultMaxRetryBackoff,
+		TLSConfig:       c.TLSConfig,
+	}
+}
+
+func mergeConfig(
+	replicaConfig *config.ReplicaConfig,
+	urlParameters *urlConfig,
+) (*urlConfig, error) {
+	dest := &urlConfig{}
+	if replicaConfig.Sink != nil && replicaConfig.Sink.RedisConfig != nil {
+		dest.WorkerCount = replicaConfig.Sink.RedisConfig.WorkerCount
+		dest.MaxBatchRows = replicaConfig.Sink.RedisConfig.MaxBatchRows
+		dest.Timeout = replicaConfig.Sink.RedisConfig.Timeout
+		dest.MaxRetries = replicaConfig.Sink.RedisConfig.MaxRetries
+	}
+	if err := mergo.Merge(dest, urlParameters, mergo.WithOverride); err != nil {
+		return nil, cerror.WrapError(cerror.ErrRedisInvalidConfig, err)
+	}
+	return dest, nil
+}
+
+func getWorkerCount(values *urlConfig, workerCount *int) error {
+	if values.WorkerCount == nil {
+		return nil
+	}
+
+	c := *values.WorkerCount
+	if c <= 0 {
+		return cerror.WrapError(cerror.ErrRedisInvalidConfig,
+			fmt.Errorf("invalid worker-count %d, it must be greater than 0", c))
+	}
+	if c > maxWorkerCount {
+		log.Warn("worker-count is too large",
+			zap.Int("original", c), zap.Int("override", maxWorkerCount))
+		c = maxWorkerCount
+	}
+
+	*workerCount = c
+	return nil
+}
+
+func getMaxBatchRows(values *urlConfig, maxBatchRows *int) error {
+	if values.MaxBatchRows == nil {
+		return nil
+	}
+
+	c := *values.MaxBatchRows
+	if c <= 0 {
+		return cerror.WrapError(cerror.ErrRedisInvalidConfig,
+			fmt.Errorf("invalid max-batch-rows %d, it must be greater than 0", c))
+	}
+	if c > maxMaxBatchRows {
+		log.Warn("max-batch-rows is too large",
+			zap.Int("original", c), zap.Int("override", maxMaxBatchRows))
+		c = maxMaxBatchRows
+	}
+
+	*maxBatchRows = c
+	return nil
+}
+
+func getTimeout(values *urlConfig, timeout *time.Duration) error {
+	if values.Timeout == nil || len(*values.Timeout) == 0 {
+		return nil
+	}
+
+	d, err := time.ParseDuration(*values.Timeout)
+	if err != nil {
+		return cerror.WrapError(cerror.ErrRedisInvalidConfig, err)
+	}
+	if d <= 0 {
+		return cerror.WrapError(cerror.ErrRedisInvalidConfig,
+			fmt.Errorf("invalid timeout %s, it must be greater than 0", d))
+	}
+
+	*timeout = d
+	return nil
+}
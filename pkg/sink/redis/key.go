@@ -0,0 +1,170 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"strings"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+const (
+	// defaultKeyTemplate is the default template of the keys.
+	defaultKeyTemplate = "{schema}:{table}:{pk}"
+
+	placeholderSchema = "schema"
+	placeholderTable  = "table"
+	placeholderPK     = "pk"
+	// pkSeparator joins the values of the handle key columns for {pk}.
+	pkSeparator = ":"
+)
+
+// globEscaper escapes the special characters of the glob pattern of redis.
+var globEscaper = strings.NewReplacer(`\`, `\\`, "*", `\*`, "?", `\?`, "[", `\[`, "]", `\]`)
+
+// keySegment is either a literal or a placeholder of a key template.
+type keySegment struct {
+	literal     string
+	placeholder string
+}
+
+// KeyTemplate builds the keys of rows. `{schema}`, `{table}`, `{pk}` and
+// `{<column>}` are substituted by the schema name, the table name, the
+// handle key values joined by ':' and the value of the handle key column.
+type KeyTemplate struct {
+	template string
+	segments []keySegment
+}
+
+// ParseKeyTemplate parses a key template. The template must contain `{pk}`
+// or a column placeholder, otherwise all rows of a table share one key.
+func ParseKeyTemplate(template string) (*KeyTemplate, error) {
+	t := &KeyTemplate{template: template}
+	hasRowPlaceholder := false
+	rest := template
+	for len(rest) > 0 {
+		start := strings.IndexByte(rest, '{')
+		if start < 0 {
+			if strings.IndexByte(rest, '}') >= 0 {
+				return nil, cerror.ErrRedisInvalidConfig.GenWithStack(
+					"unmatched '}' in key template %s", template)
+			}
+			t.segments = append(t.segments, keySegment{literal: rest})
+			break
+		}
+		if start > 0 {
+			if strings.IndexByte(rest[:start], '}') >= 0 {
+				return nil, cerror.ErrRedisInvalidConfig.GenWithStack(
+					"unmatched '}' in key template %s", template)
+			}
+			t.segments = append(t.segments, keySegment{literal: rest[:start]})
+		}
+		end := strings.IndexByte(rest[start:], '}')
+		if end < 0 {
+			return nil, cerror.ErrRedisInvalidConfig.GenWithStack(
+				"unmatched '{' in key template %s", template)
+		}
+		name := rest[start+1 : start+end]
+		if name == "" || strings.ContainsAny(name, "{") {
+			return nil, cerror.ErrRedisInvalidConfig.GenWithStack(
+				"invalid placeholder in key template %s", template)
+		}
+		name = strings.ToLower(name)
+		if name != placeholderSchema && name != placeholderTable {
+			hasRowPlaceholder = true
+		}
+		t.segments = append(t.segments, keySegment{placeholder: name})
+		rest = rest[start+end+1:]
+	}
+	if !hasRowPlaceholder {
+		return nil, cerror.ErrRedisInvalidConfig.GenWithStack(
+			"key template %s must contain {pk} or a handle key column", template)
+	}
+	return t, nil
+}
+
+// String returns the template.
+func (t *KeyTemplate) String() string {
+	return t.template
+}
+
+// Pattern returns the glob pattern matching the keys of all rows of the table.
+// It returns false if the template doesn't contain `{table}`, since the keys
+// of the table can't be told apart from the keys of other tables.
+func (t *KeyTemplate) Pattern(schema, table string) (string, bool) {
+	var (
+		b        strings.Builder
+		hasTable bool
+	)
+	for _, seg := range t.segments {
+		switch seg.placeholder {
+		case "":
+			b.WriteString(globEscaper.Replace(seg.literal))
+		case placeholderSchema:
+			b.WriteString(globEscaper.Replace(schema))
+		case placeholderTable:
+			hasTable = true
+			b.WriteString(globEscaper.Replace(table))
+		default:
+			b.WriteString("*")
+		}
+	}
+	return b.String(), hasTable
+}
+
+// Render builds the key of the row from the given columns, only the handle
+// key columns can be referenced by the template.
+func (t *KeyTemplate) Render(tableInfo *model.TableInfo, cols []*model.ColumnData) (string, error) {
+	var (
+		pk     []string
+		values = make(map[string]string)
+	)
+	for _, col := range cols {
+		if col == nil || !tableInfo.ForceGetColumnFlagType(col.ColumnID).IsHandleKey() {
+			continue
+		}
+		value := model.ColumnValueString(col.Value)
+		pk = append(pk, value)
+		values[strings.ToLower(tableInfo.ForceGetColumnName(col.ColumnID))] = value
+	}
+	if len(pk) == 0 {
+		return "", cerror.ErrRedisInvalidConfig.GenWithStack(
+			"table %s has no primary key or not null unique key, "+
+				"it can't be replicated to redis", tableInfo.TableName.String())
+	}
+
+	var b strings.Builder
+	for _, seg := range t.segments {
+		switch seg.placeholder {
+		case "":
+			b.WriteString(seg.literal)
+		case placeholderSchema:
+			b.WriteString(tableInfo.GetSchemaName())
+		case placeholderTable:
+			b.WriteString(tableInfo.GetTableName())
+		case placeholderPK:
+			b.WriteString(strings.Join(pk, pkSeparator))
+		default:
+			value, ok := values[seg.placeholder]
+			if !ok {
+				return "", cerror.ErrRedisInvalidConfig.GenWithStack(
+					"column %s in key template %s is not a handle key column of table %s",
+					seg.placeholder, t.template, tableInfo.TableName.String())
+			}
+			b.WriteString(value)
+		}
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,101 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseKeyTemplate(t *testing.T) {
+	t.Parallel()
+
+	for _, template := range []string{"{schema}:{table}:{pk}", "user:{ID}", "{a}-{b}"} {
+		_, err := ParseKeyTemplate(template)
+		require.NoError(t, err, template)
+	}
+	for _, template := range []string{"", "user", "{schema}:{table}", "user:{id", "user:id}", "user:{}", "{a{b}}"} {
+		_, err := ParseKeyTemplate(template)
+		require.Error(t, err, template)
+	}
+}
+
+func TestKeyTemplateRender(t *testing.T) {
+	t.Parallel()
+
+	tableInfo := model.BuildTableInfo("test", "t", []*model.Column{
+		{Name: "a", Type: mysql.TypeLong, Flag: model.HandleKeyFlag | model.PrimaryKeyFlag},
+		{Name: "B", Type: mysql.TypeVarchar, Flag: model.HandleKeyFlag | model.PrimaryKeyFlag},
+		{Name: "c", Type: mysql.TypeVarchar},
+	}, [][]int{{0, 1}})
+	cols := model.Columns2ColumnDatas([]*model.Column{
+		{Name: "a", Value: int64(1)},
+		{Name: "B", Value: []byte("x")},
+		{Name: "c", Value: []byte("y")},
+	}, tableInfo)
+
+	cases := []struct {
+		template string
+		expected string
+	}{
+		{defaultKeyTemplate, "test:t:1:x"},
+		{"user:{a}", "user:1"},
+		{"{b}/{A}", "x/1"},
+	}
+	for _, c := range cases {
+		template, err := ParseKeyTemplate(c.template)
+		require.NoError(t, err)
+		key, err := template.Render(tableInfo, cols)
+		require.NoError(t, err)
+		require.Equal(t, c.expected, key)
+	}
+
+	template, err := ParseKeyTemplate("user:{c}")
+	require.NoError(t, err)
+	_, err = template.Render(tableInfo, cols)
+	require.ErrorContains(t, err, "not a handle key column")
+
+	noPK := model.BuildTableInfo("test", "t2", []*model.Column{
+		{Name: "a", Type: mysql.TypeLong},
+	}, nil)
+	_, err = template.Render(noPK, model.Columns2ColumnDatas(
+		[]*model.Column{{Name: "a", Value: int64(1)}}, noPK))
+	require.ErrorContains(t, err, "has no primary key or not null unique key")
+}
+
+func TestKeyTemplatePattern(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		template string
+		schema   string
+		table    string
+		expected string
+		ok       bool
+	}{
+		{defaultKeyTemplate, "test", "t", "test:t:*", true},
+		{"{a}-{table}[{b}]", "test", "t*", `*-t\*\[*\]`, true},
+		{"user:{id}", "test", "user", "user:*", false},
+	}
+	for _, c := range cases {
+		template, err := ParseKeyTemplate(c.template)
+		require.NoError(t, err)
+		pattern, ok := template.Pattern(c.schema, c.table)
+		require.Equal(t, c.expected, pattern, c.template)
+		require.Equal(t, c.ok, ok, c.template)
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"strings"
+	"time"
+
+	filter "github.com/pingcap/tidb/pkg/util/table-filter"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// Rule decides how the rows of the matched tables are written to redis.
+type Rule struct {
+	filter.Filter
+	Mode string
+	Key  *KeyTemplate
+	TTL  time.Duration
+}
+
+func newRule(cfg *config.RedisRule, caseSensitive bool) (*Rule, error) {
+	f, err := filter.Parse(cfg.Matcher)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrFilterRuleInvalid, err, cfg.Matcher)
+	}
+	if !caseSensitive {
+		f = filter.CaseInsensitive(f)
+	}
+
+	r := &Rule{Filter: f, Mode: strings.ToLower(cfg.Mode)}
+	switch r.Mode {
+	case "":
+		r.Mode = config.RedisModeInvalidate
+	case config.RedisModeInvalidate, config.RedisModeMaterialize:
+	default:
+		return nil, cerror.ErrRedisInvalidConfig.GenWithStack(
+			"invalid mode %s, it must be %s or %s",
+			cfg.Mode, config.RedisModeInvalidate, config.RedisModeMaterialize)
+	}
+
+	template := cfg.Key
+	if template == "" {
+		template = defaultKeyTemplate
+	}
+	if r.Key, err = ParseKeyTemplate(template); err != nil {
+		return nil, err
+	}
+
+	if cfg.TTL != nil && len(*cfg.TTL) > 0 {
+		if r.Mode != config.RedisModeMaterialize {
+			return nil, cerror.ErrRedisInvalidConfig.GenWithStack(
+				"ttl is only available in %s mode", config.RedisModeMaterialize)
+		}
+		if r.TTL, err = time.ParseDuration(*cfg.TTL); err != nil {
+			return nil, cerror.WrapError(cerror.ErrRedisInvalidConfig, err)
+		}
+		if r.TTL < time.Second {
+			return nil, cerror.ErrRedisInvalidConfig.GenWithStack(
+				"invalid ttl %s, it must not be less than 1s", r.TTL)
+		}
+	}
+	return r, nil
+}
+
+// Command is a write to a single key.
+type Command struct {
+	Key string
+	// Row is written to the key if it is not nil, otherwise the key is deleted.
+	Row *model.RowChangedEvent
+	TTL time.Duration
+}
+
+// NewCommands converts a row changed event to the commands. In invalidate
+// mode, the keys of both the old and the new row are deleted. In materialize
+// mode, inserts and updates write the row to the key, and deletes delete the
+// key. If an update changes the key, the old key is deleted first.
+func (r *Rule) NewCommands(row *model.RowChangedEvent) ([]*Command, error) {
+	var oldKey, newKey string
+	if len(row.PreColumns) != 0 {
+		key, err := r.Key.Render(row.TableInfo, row.PreColumns)
+		if err != nil {
+			return nil, err
+		}
+		oldKey = key
+	}
+	if len(row.Columns) != 0 {
+		key, err := r.Key.Render(row.TableInfo, row.Columns)
+		if err != nil {
+			return nil, err
+		}
+		newKey = key
+	}
+
+	commands := make([]*Command, 0, 2)
+	if oldKey != "" && oldKey != newKey {
+		commands = append(commands, &Command{Key: oldKey})
+	}
+	if newKey != "" {
+		cmd := &Command{Key: newKey}
+		if r.Mode == config.RedisModeMaterialize {
+			cmd.Row = row
+			cmd.TTL = r.TTL
+		}
+		commands = append(commands, cmd)
+	}
+	return commands, nil
+}
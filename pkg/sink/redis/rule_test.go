@@ -0,0 +1,108 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRule(t *testing.T) {
+	t.Parallel()
+
+	rule, err := newRule(&config.RedisRule{Matcher: []string{"Test.*"}}, false)
+	require.NoError(t, err)
+	require.Equal(t, config.RedisModeInvalidate, rule.Mode)
+	require.Equal(t, defaultKeyTemplate, rule.Key.String())
+	require.True(t, rule.MatchTable("test", "t"))
+	require.False(t, rule.MatchTable("other", "t"))
+
+	rule, err = newRule(&config.RedisRule{
+		Matcher: []string{"test.user"},
+		Mode:    "Materialize",
+		Key:     "user:{id}",
+		TTL:     util.AddressOf("1h"),
+	}, true)
+	require.NoError(t, err)
+	require.Equal(t, config.RedisModeMaterialize, rule.Mode)
+	require.Equal(t, time.Hour, rule.TTL)
+
+	cases := []struct {
+		rule    *config.RedisRule
+		wantErr string
+	}{
+		{&config.RedisRule{Matcher: []string{"["}}, "ErrFilterRuleInvalid"},
+		{&config.RedisRule{Matcher: []string{"*.*"}, Mode: "write"}, "invalid mode"},
+		{&config.RedisRule{Matcher: []string{"*.*"}, Key: "user"}, "must contain {pk}"},
+		{&config.RedisRule{Matcher: []string{"*.*"}, TTL: util.AddressOf("1h")}, "only available in materialize mode"},
+		{&config.RedisRule{Matcher: []string{"*.*"}, Mode: "materialize", TTL: util.AddressOf("1ms")}, "invalid ttl"},
+	}
+	for _, c := range cases {
+		_, err := newRule(c.rule, false)
+		require.ErrorContains(t, err, c.wantErr)
+	}
+}
+
+func TestNewCommands(t *testing.T) {
+	t.Parallel()
+
+	tableInfo := model.BuildTableInfo("test", "user", []*model.Column{
+		{Name: "id", Type: mysql.TypeLong, Flag: model.HandleKeyFlag | model.PrimaryKeyFlag},
+		{Name: "name", Type: mysql.TypeVarchar},
+	}, [][]int{{0}})
+	row := func(id int64, name string) []*model.ColumnData {
+		return model.Columns2ColumnDatas([]*model.Column{
+			{Name: "id", Value: id},
+			{Name: "name", Value: []byte(name)},
+		}, tableInfo)
+	}
+	insert := &model.RowChangedEvent{TableInfo: tableInfo, Columns: row(1, "a")}
+	update := &model.RowChangedEvent{TableInfo: tableInfo, PreColumns: row(1, "a"), Columns: row(1, "b")}
+	updateKey := &model.RowChangedEvent{TableInfo: tableInfo, PreColumns: row(1, "a"), Columns: row(2, "a")}
+	del := &model.RowChangedEvent{TableInfo: tableInfo, PreColumns: row(2, "a")}
+
+	invalidate, err := newRule(&config.RedisRule{Matcher: []string{"*.*"}, Key: "user:{id}"}, false)
+	require.NoError(t, err)
+	materialize, err := newRule(&config.RedisRule{
+		Matcher: []string{"*.*"}, Mode: config.RedisModeMaterialize, Key: "user:{id}",
+		TTL: util.AddressOf("10s"),
+	}, false)
+	require.NoError(t, err)
+
+	cases := []struct {
+		rule     *Rule
+		row      *model.RowChangedEvent
+		expected []*Command
+	}{
+		{invalidate, insert, []*Command{{Key: "user:1"}}},
+		{invalidate, update, []*Command{{Key: "user:1"}}},
+		{invalidate, updateKey, []*Command{{Key: "user:1"}, {Key: "user:2"}}},
+		{invalidate, del, []*Command{{Key: "user:2"}}},
+		{materialize, insert, []*Command{{Key: "user:1", Row: insert, TTL: 10 * time.Second}}},
+		{materialize, update, []*Command{{Key: "user:1", Row: update, TTL: 10 * time.Second}}},
+		{materialize, updateKey, []*Command{{Key: "user:1"}, {Key: "user:2", Row: updateKey, TTL: 10 * time.Second}}},
+		{materialize, del, []*Command{{Key: "user:2"}}},
+	}
+	for i, c := range cases {
+		commands, err := c.rule.NewCommands(c.row)
+		require.NoError(t, err)
+		require.Equal(t, c.expected, commands, i)
+	}
+}
@@ -76,6 +76,26 @@ const (
 	PulsarHTTPScheme = "pulsar+http"
 	// PulsarHTTPSScheme indicates the schema is pulsar with https protocol
 	PulsarHTTPSScheme = "pulsar+https"
+	// WebhookHTTPScheme indicates the scheme is a http webhook.
+	WebhookHTTPScheme = "http"
+	// WebhookHTTPSScheme indicates the scheme is a https webhook.
+	WebhookHTTPSScheme = "https"
+	// PostgresScheme indicates the scheme is PostgreSQL.
+	PostgresScheme = "postgres"
+	// PostgreSQLScheme is an alias for "postgres".
+	PostgreSQLScheme = "postgresql"
+	// ElasticsearchScheme indicates the scheme is elasticsearch with http protocol.
+	ElasticsearchScheme = "elasticsearch"
+	// ElasticsearchSSLScheme indicates the scheme is elasticsearch with https protocol.
+	ElasticsearchSSLScheme = "elasticsearch+ssl"
+	// OpenSearchScheme indicates the scheme is opensearch with http protocol.
+	OpenSearchScheme = "opensearch"
+	// OpenSearchSSLScheme indicates the scheme is opensearch with https protocol.
+	OpenSearchSSLScheme = "opensearch+ssl"
+	// RedisScheme indicates the scheme is redis.
+	RedisScheme = "redis"
+	// RedisSSLScheme indicates the scheme is redis+ssl.
+	RedisSSLScheme = "redis+ssl"
 )
 
 // IsMQScheme returns true if the scheme belong to mq scheme.
@@ -101,6 +121,35 @@ func IsPulsarScheme(scheme string) bool {
 	return scheme == PulsarScheme || scheme == PulsarSSLScheme || scheme == PulsarHTTPScheme || scheme == PulsarHTTPSScheme
 }
 
+// IsWebhookScheme returns true if the scheme belong to webhook scheme.
+func IsWebhookScheme(scheme string) bool {
+	return scheme == WebhookHTTPScheme || scheme == WebhookHTTPSScheme
+}
+
+// IsPostgresScheme returns true if the scheme belong to PostgreSQL scheme.
+func IsPostgresScheme(scheme string) bool {
+	return scheme == PostgresScheme || scheme == PostgreSQLScheme
+}
+
+// IsElasticsearchScheme returns true if the scheme belong to elasticsearch scheme.
+// OpenSearch is compatible with the elasticsearch bulk API, so it is
+// handled by the same sink.
+func IsElasticsearchScheme(scheme string) bool {
+	return scheme == ElasticsearchScheme || scheme == ElasticsearchSSLScheme ||
+		scheme == OpenSearchScheme || scheme == OpenSearchSSLScheme
+}
+
+// IsElasticsearchSSLScheme returns true if the scheme is an elasticsearch
+// scheme with https protocol.
+func IsElasticsearchSSLScheme(scheme string) bool {
+	return scheme == ElasticsearchSSLScheme || scheme == OpenSearchSSLScheme
+}
+
+// IsRedisScheme returns true if the scheme belong to redis scheme.
+func IsRedisScheme(scheme string) bool {
+	return scheme == RedisScheme || scheme == RedisSSLScheme
+}
+
 // IsBlackHoleScheme returns true if the scheme belong to blackhole scheme.
 func IsBlackHoleScheme(scheme string) bool {
 	return scheme == BlackHoleScheme
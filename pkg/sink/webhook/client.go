@@ -0,0 +1,207 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/retry"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"go.uber.org/zap"
+)
+
+const (
+	// HeaderChangefeed is the header which carries the changefeed ID.
+	HeaderChangefeed = "X-Ticdc-Changefeed"
+	// HeaderEventType is the header which carries the type of events in the body.
+	HeaderEventType = "X-Ticdc-Event-Type"
+	// HeaderSchema is the header which carries the schema name of events.
+	HeaderSchema = "X-Ticdc-Schema"
+	// HeaderTable is the header which carries the table name of events.
+	HeaderTable = "X-Ticdc-Table"
+	// HeaderCommitTs is the header which carries the max commit ts of events.
+	HeaderCommitTs = "X-Ticdc-Commit-Ts"
+	// HeaderMessageCount is the header which carries the number of messages in the body.
+	HeaderMessageCount = "X-Ticdc-Message-Count"
+
+	// contentType is the content type of the request body. Each message is
+	// encoded as a JSON document, and messages are separated by '\n'.
+	contentType = "application/x-ndjson"
+	// maxResponseBodySize is the max size of response body kept in the error.
+	maxResponseBodySize = 1024
+)
+
+// EventType is the type of events carried by a request.
+type EventType string
+
+const (
+	// EventTypeRow indicates the request carries row changed events.
+	EventTypeRow EventType = "row"
+	// EventTypeDDL indicates the request carries a DDL event.
+	EventTypeDDL EventType = "ddl"
+	// EventTypeCheckpoint indicates the request carries a checkpoint event.
+	EventTypeCheckpoint EventType = "checkpoint"
+)
+
+// Request is a batch of messages sent to the endpoint in one HTTP request.
+type Request struct {
+	EventType EventType
+	Schema    string
+	Table     string
+	CommitTs  uint64
+	Messages  []*common.Message
+}
+
+// Client sends requests to the webhook endpoint.
+type Client struct {
+	changefeedID model.ChangeFeedID
+	cfg          *Config
+	client       *http.Client
+}
+
+// NewClient creates a new webhook client.
+func NewClient(changefeedID model.ChangeFeedID, cfg *Config) *Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConnsPerHost = cfg.WorkerCount
+	if cfg.TLSConfig != nil {
+		transport.TLSClientConfig = cfg.TLSConfig
+	}
+	return &Client{
+		changefeedID: changefeedID,
+		cfg:          cfg,
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   cfg.Timeout,
+		},
+	}
+}
+
+// statusError is returned when the endpoint responds with a non 2xx status code.
+type statusError struct {
+	code int
+	body string
+}
+
+func (e *statusError) Error() string {
+	return "webhook responds " + strconv.Itoa(e.code) + ": " + e.body
+}
+
+// isRetryable returns whether the error is worth to retry. The client errors
+// are not retried except timeout and throttling, since the same request
+// always gets the same response.
+func isRetryable(err error) bool {
+	if cerror.IsContextCanceledError(err) {
+		return false
+	}
+	var se *statusError
+	if cerror.As(err, &se) {
+		if se.code >= http.StatusBadRequest && se.code < http.StatusInternalServerError {
+			return se.code == http.StatusRequestTimeout || se.code == http.StatusTooManyRequests
+		}
+	}
+	return true
+}
+
+// Send posts the messages to the endpoint, it retries with backoff
+// until the request succeeds, a non-retryable error occurs or
+// the max retries is reached.
+func (c *Client) Send(ctx context.Context, req *Request) error {
+	if len(req.Messages) == 0 {
+		return nil
+	}
+	var body bytes.Buffer
+	for i, msg := range req.Messages {
+		if i > 0 {
+			body.WriteByte('\n')
+		}
+		body.Write(msg.Value)
+	}
+	payload := body.Bytes()
+
+	err := retry.Do(ctx, func() error {
+		return c.post(ctx, req, payload)
+	}, retry.WithBackoffBaseDelay(c.cfg.BackoffBaseInMs),
+		retry.WithBackoffMaxDelay(c.cfg.BackoffMaxInMs),
+		retry.WithMaxTries(uint64(c.cfg.MaxRetries)+1),
+		retry.WithIsRetryableErr(isRetryable))
+	if err != nil {
+		var se *statusError
+		if cerror.As(err, &se) {
+			return cerror.ErrWebhookSendMessage.GenWithStackByArgs(se.code, se.body)
+		}
+		return cerror.WrapError(cerror.ErrWebhookRequest, err)
+	}
+	return nil
+}
+
+func (c *Client) post(ctx context.Context, req *Request, payload []byte) error {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.cfg.Endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for k, v := range c.cfg.Headers {
+		httpReq.Header.Set(k, v)
+	}
+	httpReq.Header.Set("Content-Type", contentType)
+	httpReq.Header.Set(HeaderChangefeed, c.changefeedID.String())
+	httpReq.Header.Set(HeaderEventType, string(req.EventType))
+	if req.Schema != "" {
+		httpReq.Header.Set(HeaderSchema, req.Schema)
+	}
+	if req.Table != "" {
+		httpReq.Header.Set(HeaderTable, req.Table)
+	}
+	httpReq.Header.Set(HeaderCommitTs, strconv.FormatUint(req.CommitTs, 10))
+	httpReq.Header.Set(HeaderMessageCount, strconv.Itoa(len(req.Messages)))
+
+	start := time.Now()
+	resp, err := c.client.Do(httpReq)
+	if err != nil {
+		log.Warn("webhook request failed",
+			zap.String("namespace", c.changefeedID.Namespace),
+			zap.String("changefeed", c.changefeedID.ID),
+			zap.String("eventType", string(req.EventType)),
+			zap.Duration("duration", time.Since(start)),
+			zap.Error(err))
+		return errors.Trace(err)
+	}
+	defer resp.Body.Close()
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBodySize))
+	// Drain the body so that the connection can be reused.
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		log.Warn("webhook responds with unexpected status code",
+			zap.String("namespace", c.changefeedID.Namespace),
+			zap.String("changefeed", c.changefeedID.ID),
+			zap.String("eventType", string(req.EventType)),
+			zap.Int("statusCode", resp.StatusCode),
+			zap.ByteString("response", respBody))
+		return &statusError{code: resp.StatusCode, body: string(respBody)}
+	}
+	return nil
+}
+
+// Close closes the idle connections of the client.
+func (c *Client) Close() {
+	c.client.CloseIdleConnections()
+}
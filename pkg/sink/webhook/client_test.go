@@ -0,0 +1,140 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) (*Client, func()) {
+	server := httptest.NewServer(handler)
+	cfg := NewConfig()
+	cfg.Endpoint = server.URL
+	cfg.MaxRetries = 2
+	cfg.BackoffBaseInMs = 1
+	cfg.BackoffMaxInMs = 1
+	cfg.Headers["Authorization"] = "Bearer token"
+	client := NewClient(model.DefaultChangeFeedID("test"), cfg)
+	return client, func() {
+		client.Close()
+		server.Close()
+	}
+}
+
+func TestClientSend(t *testing.T) {
+	t.Parallel()
+
+	var body string
+	var header http.Header
+	client, closer := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		data, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		body = string(data)
+		header = r.Header
+		w.WriteHeader(http.StatusOK)
+	})
+	defer closer()
+
+	err := client.Send(context.Background(), &Request{
+		EventType: EventTypeRow,
+		Schema:    "test",
+		Table:     "t",
+		CommitTs:  100,
+		Messages: []*common.Message{
+			{Value: []byte(`{"a":1}`)},
+			{Value: []byte(`{"a":2}`)},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "{\"a\":1}\n{\"a\":2}", body)
+	require.Equal(t, contentType, header.Get("Content-Type"))
+	require.Equal(t, "Bearer token", header.Get("Authorization"))
+	require.Equal(t, "row", header.Get(HeaderEventType))
+	require.Equal(t, "test", header.Get(HeaderSchema))
+	require.Equal(t, "t", header.Get(HeaderTable))
+	require.Equal(t, "100", header.Get(HeaderCommitTs))
+	require.Equal(t, "2", header.Get(HeaderMessageCount))
+}
+
+func TestClientRetry(t *testing.T) {
+	t.Parallel()
+
+	var count atomic.Int32
+	client, closer := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if count.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer closer()
+
+	err := client.Send(context.Background(), &Request{
+		EventType: EventTypeDDL,
+		Messages:  []*common.Message{{Value: []byte(`{}`)}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(3), count.Load())
+}
+
+func TestClientNonRetryableError(t *testing.T) {
+	t.Parallel()
+
+	var count atomic.Int32
+	client, closer := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		count.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte("bad request"))
+	})
+	defer closer()
+
+	err := client.Send(context.Background(), &Request{
+		EventType: EventTypeRow,
+		Messages:  []*common.Message{{Value: []byte(`{}`)}},
+	})
+	require.ErrorContains(t, err, "status code 400")
+	require.ErrorContains(t, err, "bad request")
+	require.Equal(t, int32(1), count.Load())
+}
+
+func TestClientTransportError(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	cfg := NewConfig()
+	cfg.Endpoint = server.URL
+	cfg.MaxRetries = 1
+	cfg.BackoffBaseInMs = 1
+	cfg.BackoffMaxInMs = 1
+	client := NewClient(model.DefaultChangeFeedID("test"), cfg)
+	defer client.Close()
+	server.Close()
+
+	err := client.Send(context.Background(), &Request{
+		EventType: EventTypeRow,
+		Messages:  []*common.Message{{Value: []byte(`{}`)}},
+	})
+	require.ErrorContains(t, err, string(cerror.ErrWebhookRequest.RFCCode()))
+	require.NotContains(t, err.Error(), "status code")
+}
@@ -0,0 +1,250 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/imdario/mergo"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/security"
+	psink "github.com/pingcap/tiflow/pkg/sink"
+	"github.com/pingcap/tiflow/pkg/util"
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultWorkerCount is the default value of worker-count.
+	defaultWorkerCount = 8
+	// the upper limit of worker-count.
+	maxWorkerCount = 128
+	// defaultMaxBatchRows is the default value of max-batch-rows.
+	defaultMaxBatchRows = 256
+	// the upper limit of max-batch-rows.
+	maxMaxBatchRows = 8192
+	// defaultTimeout is the default value of timeout.
+	defaultTimeout = 10 * time.Second
+	// defaultMaxRetries is the default value of max-retries.
+	defaultMaxRetries = 10
+	// defaultBackoffBaseInMs is the default initial backoff between two retries.
+	defaultBackoffBaseInMs = 500
+	// defaultBackoffMaxInMs is the default maximum backoff between two retries.
+	defaultBackoffMaxInMs = 30 * 1000
+)
+
+type urlConfig struct {
+	WorkerCount  *int    `form:"worker-count"`
+	MaxBatchRows *int    `form:"max-batch-rows"`
+	Timeout      *string `form:"timeout"`
+	MaxRetries   *int    `form:"max-retries"`
+}
+
+// Config is the configuration for webhook sink.
+type Config struct {
+	// Endpoint is the URL the events are posted to. The query parameters of
+	// the sink URI are consumed by TiCDC and are not forwarded to the endpoint.
+	Endpoint        string
+	WorkerCount     int
+	MaxBatchRows    int
+	Timeout         time.Duration
+	MaxRetries      int
+	BackoffBaseInMs int64
+	BackoffMaxInMs  int64
+	Headers         map[string]string
+	TLSConfig       *tls.Config
+
+	OutputRawChangeEvent bool
+}
+
+// NewConfig returns the default webhook sink config.
+func NewConfig() *Config {
+	return &Config{
+		WorkerCount:     defaultWorkerCount,
+		MaxBatchRows:    defaultMaxBatchRows,
+		Timeout:         defaultTimeout,
+		MaxRetries:      defaultMaxRetries,
+		BackoffBaseInMs: defaultBackoffBaseInMs,
+		BackoffMaxInMs:  defaultBackoffMaxInMs,
+		Headers:         make(map[string]string),
+	}
+}
+
+// Apply applies the sink URI parameters to the config.
+func (c *Config) Apply(
+	sinkURI *url.URL,
+	replicaConfig *config.ReplicaConfig,
+) (err error) {
+	if sinkURI == nil {
+		return cerror.ErrWebhookInvalidConfig.GenWithStack(
+			"failed to open webhook sink, empty SinkURI")
+	}
+	scheme := strings.ToLower(sinkURI.Scheme)
+	if !psink.IsWebhookScheme(scheme) {
+		return cerror.ErrWebhookInvalidConfig.GenWithStack(
+			"can't create webhook sink with unsupported scheme: %s", scheme)
+	}
+	if sinkURI.Host == "" {
+		return cerror.ErrWebhookInvalidConfig.GenWithStack(
+			"can't create webhook sink without host")
+	}
+
+	req := &http.Request{URL: sinkURI}
+	urlParameter := &urlConfig{}
+	if err := binding.Query.Bind(req, urlParameter); err != nil {
+		return cerror.WrapError(cerror.ErrWebhookInvalidConfig, err)
+	}
+	if urlParameter, err = mergeConfig(replicaConfig, urlParameter); err != nil {
+		return err
+	}
+	if err = getWorkerCount(urlParameter, &c.WorkerCount); err != nil {
+		return err
+	}
+	if err = getMaxBatchRows(urlParameter, &c.MaxBatchRows); err != nil {
+		return err
+	}
+	if err = getTimeout(urlParameter, &c.Timeout); err != nil {
+		return err
+	}
+	if urlParameter.MaxRetries != nil {
+		if *urlParameter.MaxRetries < 0 {
+			return cerror.WrapError(cerror.ErrWebhookInvalidConfig,
+				fmt.Errorf("invalid max-retries %d, it must not be negative", *urlParameter.MaxRetries))
+		}
+		c.MaxRetries = *urlParameter.MaxRetries
+	}
+
+	endpoint := *sinkURI
+	endpoint.Scheme = scheme
+	endpoint.RawQuery = ""
+	endpoint.Fragment = ""
+	c.Endpoint = endpoint.String()
+
+	webhookConfig := replicaConfig.Sink.WebhookConfig
+	if webhookConfig == nil {
+		return nil
+	}
+	if webhookConfig.BackoffBaseInMs != nil && *webhookConfig.BackoffBaseInMs > 0 {
+		c.BackoffBaseInMs = *webhookConfig.BackoffBaseInMs
+	}
+	if webhookConfig.BackoffMaxInMs != nil && *webhookConfig.BackoffMaxInMs > 0 {
+		c.BackoffMaxInMs = *webhookConfig.BackoffMaxInMs
+	}
+	if c.BackoffMaxInMs < c.BackoffBaseInMs {
+		c.BackoffMaxInMs = c.BackoffBaseInMs
+	}
+	for k, v := range webhookConfig.Headers {
+		c.Headers[k] = v
+	}
+	c.OutputRawChangeEvent = webhookConfig.GetOutputRawChangeEvent()
+
+	credential := &security.Credential{
+		CAPath:   util.GetOrZero(webhookConfig.SSLCa),
+		CertPath: util.GetOrZero(webhookConfig.SSLCert),
+		KeyPath:  util.GetOrZero(webhookConfig.SSLKey),
+	}
+	if credential.IsTLSEnabled() {
+		if scheme != psink.WebhookHTTPSScheme {
+			return cerror.ErrWebhookInvalidConfig.GenWithStack(
+				"ssl-ca is set, but the scheme of sink URI is %s", scheme)
+		}
+		c.TLSConfig, err = credential.ToTLSConfig()
+		if err != nil {
+			return cerror.WrapError(cerror.ErrWebhookInvalidConfig, err)
+		}
+	}
+	return nil
+}
+
+func mergeConfig(
+	replicaConfig *config.ReplicaConfig,
+	urlParameters *urlConfig,
+) (*urlConfig, error) {
+	dest := &urlConfig{}
+	if replicaConfig.Sink != nil && replicaConfig.Sink.WebhookConfig != nil {
+		dest.WorkerCount = replicaConfig.Sink.WebhookConfig.WorkerCount
+		dest.MaxBatchRows = replicaConfig.Sink.WebhookConfig.MaxBatchRows
+		dest.Timeout = replicaConfig.Sink.WebhookConfig.Timeout
+		dest.MaxRetries = replicaConfig.Sink.WebhookConfig.MaxRetries
+	}
+	if err := mergo.Merge(dest, urlParameters, mergo.WithOverride); err != nil {
+		return nil, cerror.WrapError(cerror.ErrWebhookInvalidConfig, err)
+	}
+	return dest, nil
+}
+
+func getWorkerCount(values *urlConfig, workerCount *int) error {
+	if values.WorkerCount == nil {
+		return nil
+	}
+
+	c := *values.WorkerCount
+	if c <= 0 {
+		return cerror.WrapError(cerror.ErrWebhookInvalidConfig,
+			fmt.Errorf("invalid worker-count %d, it must be greater than 0", c))
+	}
+	if c > maxWorkerCount {
+		log.Warn("worker-count is too large",
+			zap.Int("original", c), zap.Int("override", maxWorkerCount))
+		c = maxWorkerCount
+	}
+
+	*workerCount = c
+	return nil
+}
+
+func getMaxBatchRows(values *urlConfig, maxBatchRows *int) error {
+	if values.MaxBatchRows == nil {
+		return nil
+	}
+
+	c := *values.MaxBatchRows
+	if c <= 0 {
+		return cerror.WrapError(cerror.ErrWebhookInvalidConfig,
+			fmt.Errorf("invalid max-batch-rows %d, it must be greater than 0", c))
+	}
+	if c > maxMaxBatchRows {
+		log.Warn("max-batch-rows is too large",
+			zap.Int("original", c), zap.Int("override", maxMaxBatchRows))
+		c = maxMaxBatchRows
+	}
+
+	*maxBatchRows = c
+	return nil
+}
+
+func getTimeout(values *urlConfig, timeout *time.Duration) error {
+	if values.Timeout == nil || len(*values.Timeout) == 0 {
+		return nil
+	}
+
+	d, err := time.ParseDuration(*values.Timeout)
+	if err != nil {
+		return cerror.WrapError(cerror.ErrWebhookInvalidConfig, err)
+	}
+	if d <= 0 {
+		return cerror.WrapError(cerror.ErrWebhookInvalidConfig,
+			fmt.Errorf("invalid timeout %s, it must be greater than 0", d))
+	}
+
+	*timeout = d
+	return nil
+}
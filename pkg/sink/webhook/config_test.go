@@ -0,0 +1,91 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigApply(t *testing.T) {
+	t.Parallel()
+
+	expected := NewConfig()
+	expected.Endpoint = "http://127.0.0.1:8080/events"
+	expected.WorkerCount = 4
+	expected.MaxBatchRows = 64
+	expected.Timeout = 3 * time.Second
+	expected.MaxRetries = 2
+	uri := "http://127.0.0.1:8080/events?worker-count=4&max-batch-rows=64" +
+		"&timeout=3s&max-retries=2&protocol=canal-json"
+	sinkURI, err := url.Parse(uri)
+	require.Nil(t, err)
+	cfg := NewConfig()
+	err = cfg.Apply(sinkURI, config.GetDefaultReplicaConfig())
+	require.Nil(t, err)
+	require.Equal(t, expected, cfg)
+}
+
+func TestConfigApplyWithReplicaConfig(t *testing.T) {
+	t.Parallel()
+
+	replicaConfig := config.GetDefaultReplicaConfig()
+	replicaConfig.Sink.WebhookConfig = &config.WebhookConfig{
+		WorkerCount:     util.AddressOf(2),
+		MaxBatchRows:    util.AddressOf(1024),
+		BackoffBaseInMs: util.AddressOf(int64(100)),
+		BackoffMaxInMs:  util.AddressOf(int64(50)),
+		Headers:         map[string]string{"Authorization": "Bearer token"},
+	}
+	// The sink URI has higher priority.
+	sinkURI, err := url.Parse("https://example.com:443/cdc?worker-count=3")
+	require.Nil(t, err)
+	cfg := NewConfig()
+	err = cfg.Apply(sinkURI, replicaConfig)
+	require.Nil(t, err)
+	require.Equal(t, "https://example.com:443/cdc", cfg.Endpoint)
+	require.Equal(t, 3, cfg.WorkerCount)
+	require.Equal(t, 1024, cfg.MaxBatchRows)
+	require.Equal(t, int64(100), cfg.BackoffBaseInMs)
+	require.Equal(t, int64(100), cfg.BackoffMaxInMs)
+	require.Equal(t, "Bearer token", cfg.Headers["Authorization"])
+}
+
+func TestConfigApplyInvalid(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		uri    string
+		errMsg string
+	}{
+		{uri: "kafka://127.0.0.1:9092/topic", errMsg: "unsupported scheme"},
+		{uri: "http:///events", errMsg: "without host"},
+		{uri: "http://127.0.0.1/events?worker-count=0", errMsg: "invalid worker-count"},
+		{uri: "http://127.0.0.1/events?max-batch-rows=-1", errMsg: "invalid max-batch-rows"},
+		{uri: "http://127.0.0.1/events?timeout=abc", errMsg: "invalid duration"},
+		{uri: "http://127.0.0.1/events?max-retries=-1", errMsg: "invalid max-retries"},
+	}
+	for _, tc := range testCases {
+		sinkURI, err := url.Parse(tc.uri)
+		require.Nil(t, err)
+		cfg := NewConfig()
+		err = cfg.Apply(sinkURI, config.GetDefaultReplicaConfig())
+		require.ErrorContains(t, err, tc.errMsg, tc.uri)
+	}
+}
@@ -0,0 +1,256 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlmodel
+
+import (
+	"strconv"
+	"strings"
+
+	timodel "github.com/pingcap/tidb/pkg/meta/model"
+	cdcmodel "github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/pkg/quotes"
+)
+
+// GenPostgresUpsertSQL generates the PostgreSQL INSERT ... ON CONFLICT DO
+// UPDATE SQL and its arguments. The conflict target is the PK or the NOT NULL
+// UK of the target table, a plain INSERT is generated if there is no such key.
+// Input `changes` should have same target table and different keys, otherwise
+// the behaviour is undefined.
+func GenPostgresUpsertSQL(changes ...*RowChange) (string, []interface{}) {
+	return genPostgresInsertSQL(true, changes...)
+}
+
+// GenPostgresInsertSQL generates the PostgreSQL INSERT SQL and its arguments.
+// Input `changes` should have same target table, otherwise the behaviour is
+// undefined.
+func GenPostgresInsertSQL(changes ...*RowChange) (string, []interface{}) {
+	return genPostgresInsertSQL(false, changes...)
+}
+
+func genPostgresInsertSQL(upsert bool, changes ...*RowChange) (string, []interface{}) {
+	if len(changes) == 0 {
+		log.L().DPanic("row changes is empty")
+		return "", nil
+	}
+
+	first := changes[0]
+
+	var buf strings.Builder
+	buf.Grow(1024)
+	buf.WriteString("INSERT INTO ")
+	buf.WriteString(postgresTableName(first.targetTable))
+	buf.WriteString(" (")
+
+	generatedColumns := generatedColumnsNameSet(first.targetTableInfo.Columns)
+	columns := make([]*timodel.ColumnInfo, 0, len(first.sourceTableInfo.Columns))
+	colIdx := make([]int, 0, len(first.sourceTableInfo.Columns))
+	for i, col := range first.sourceTableInfo.Columns {
+		if _, ok := generatedColumns[col.Name.L]; ok {
+			continue
+		}
+		if len(columns) > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(quotes.QuotePostgresName(col.Name.O))
+		columns = append(columns, col)
+		colIdx = append(colIdx, i)
+	}
+	buf.WriteString(") VALUES ")
+
+	args := make([]interface{}, 0, len(changes)*len(columns))
+	for i, change := range changes {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteByte('(')
+		for j, idx := range colIdx {
+			if j > 0 {
+				buf.WriteByte(',')
+			}
+			args = append(args, change.postValues[idx])
+			writePostgresPlaceholder(&buf, len(args))
+		}
+		buf.WriteByte(')')
+	}
+
+	if !upsert {
+		return buf.String(), args
+	}
+	uniqueIdx := first.UniqueNotNullIdx()
+	if uniqueIdx == nil {
+		return buf.String(), args
+	}
+
+	keyColumns := make(map[string]struct{}, len(uniqueIdx.Columns))
+	buf.WriteString(" ON CONFLICT (")
+	for i, col := range uniqueIdx.Columns {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(quotes.QuotePostgresName(col.Name.O))
+		keyColumns[col.Name.L] = struct{}{}
+	}
+	buf.WriteString(") DO ")
+
+	writtenFirstCol := false
+	for _, col := range columns {
+		if _, ok := keyColumns[col.Name.L]; ok {
+			continue
+		}
+		if writtenFirstCol {
+			buf.WriteByte(',')
+		} else {
+			buf.WriteString("UPDATE SET ")
+		}
+		writtenFirstCol = true
+		colName := quotes.QuotePostgresName(col.Name.O)
+		buf.WriteString(colName + "=EXCLUDED." + colName)
+	}
+	if !writtenFirstCol {
+		// all columns are part of the key, there is nothing to update.
+		buf.WriteString("NOTHING")
+	}
+	return buf.String(), args
+}
+
+// GenPostgresDeleteSQL generates the PostgreSQL DELETE SQL and its arguments.
+// Input `changes` should have same target table, otherwise the behaviour is
+// undefined. PostgreSQL does not support DELETE ... LIMIT, so if a change can
+// not be identified by a unique key, the row is located by its ctid and only
+// one change should be passed, otherwise duplicated rows may be missed.
+func GenPostgresDeleteSQL(changes ...*RowChange) (string, []interface{}) {
+	if len(changes) == 0 {
+		log.L().DPanic("row changes is empty")
+		return "", nil
+	}
+
+	first := changes[0]
+	tableName := postgresTableName(first.targetTable)
+
+	var buf strings.Builder
+	buf.Grow(1024)
+	buf.WriteString("DELETE FROM ")
+	buf.WriteString(tableName)
+	buf.WriteString(" WHERE (")
+
+	args := make([]interface{}, 0, len(changes)*CommonIndexColumnsCount)
+	for i, c := range changes {
+		if i > 0 {
+			buf.WriteString(") OR (")
+		}
+		args = genPostgresRowWhere(&buf, tableName, c, args)
+	}
+	buf.WriteString(")")
+	return buf.String(), args
+}
+
+// GenPostgresUpdateSQL generates the PostgreSQL UPDATE SQL and its arguments.
+// Like GenPostgresDeleteSQL, the row is located by its ctid if it can not be
+// identified by a unique key.
+func GenPostgresUpdateSQL(change *RowChange) (string, []interface{}) {
+	tableName := postgresTableName(change.targetTable)
+
+	var buf strings.Builder
+	buf.Grow(1024)
+	buf.WriteString("UPDATE ")
+	buf.WriteString(tableName)
+	buf.WriteString(" SET ")
+
+	generatedColumns := generatedColumnsNameSet(change.targetTableInfo.Columns)
+	args := make([]interface{}, 0, len(change.sourceTableInfo.Columns)+CommonIndexColumnsCount)
+	for i, col := range change.sourceTableInfo.Columns {
+		if _, ok := generatedColumns[col.Name.L]; ok {
+			continue
+		}
+		if len(args) > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(quotes.QuotePostgresName(col.Name.O))
+		buf.WriteByte('=')
+		args = append(args, change.postValues[i])
+		writePostgresPlaceholder(&buf, len(args))
+	}
+	buf.WriteString(" WHERE ")
+	args = genPostgresRowWhere(&buf, tableName, change, args)
+	return buf.String(), args
+}
+
+// genPostgresRowWhere writes the condition which locates the row of the pre
+// values of `c`.
+func genPostgresRowWhere(
+	buf *strings.Builder,
+	tableName string,
+	c *RowChange,
+	args []interface{},
+) []interface{} {
+	c.lazyInitWhereHandle()
+	uniqueIndex := c.whereHandle.getWhereIdxByData(c.preValues)
+	if uniqueIndex != nil {
+		columns, values := getColsAndValuesOfIdx(c.sourceTableInfo.Columns, uniqueIndex, c.preValues)
+		return genPostgresWhere(buf, columns, values, args)
+	}
+
+	generatedColumns := generatedColumnsNameSet(c.targetTableInfo.Columns)
+	columns := make([]*timodel.ColumnInfo, 0, len(c.sourceTableInfo.Columns))
+	values := make([]interface{}, 0, len(c.sourceTableInfo.Columns))
+	for j, col := range c.sourceTableInfo.Columns {
+		if _, ok := generatedColumns[col.Name.L]; ok {
+			continue
+		}
+		columns = append(columns, col)
+		values = append(values, c.preValues[j])
+	}
+	buf.WriteString("ctid = (SELECT ctid FROM ")
+	buf.WriteString(tableName)
+	buf.WriteString(" WHERE ")
+	args = genPostgresWhere(buf, columns, values, args)
+	buf.WriteString(" LIMIT 1)")
+	return args
+}
+
+// genPostgresWhere writes the conditions to `buf` and appends the non NULL
+// values to `args`. PostgreSQL does not accept a placeholder after IS, so
+// NULL values are written as literals.
+func genPostgresWhere(
+	buf *strings.Builder,
+	columns []*timodel.ColumnInfo,
+	values []interface{},
+	args []interface{},
+) []interface{} {
+	for i, col := range columns {
+		if i != 0 {
+			buf.WriteString(" AND ")
+		}
+		buf.WriteString(quotes.QuotePostgresName(col.Name.O))
+		if values[i] == nil {
+			buf.WriteString(" IS NULL")
+			continue
+		}
+		args = append(args, values[i])
+		buf.WriteString(" = ")
+		writePostgresPlaceholder(buf, len(args))
+	}
+	return args
+}
+
+func postgresTableName(table *cdcmodel.TableName) string {
+	return quotes.QuotePostgresSchema(table.Schema, table.Table)
+}
+
+// writePostgresPlaceholder writes the n-th positional parameter like $1.
+func writePostgresPlaceholder(buf *strings.Builder, n int) {
+	buf.WriteByte('$')
+	buf.WriteString(strconv.Itoa(n))
+}
@@ -0,0 +1,103 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlmodel
+
+import (
+	"testing"
+
+	cdcmodel "github.com/pingcap/tiflow/cdc/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenPostgresUpsertSQL(t *testing.T) {
+	t.Parallel()
+
+	source := &cdcmodel.TableName{Schema: "db", Table: "tb"}
+	ti := mockTableInfo(t, "CREATE TABLE tb (id INT PRIMARY KEY, c INT, c2 INT AS (c + 1) STORED)")
+
+	change1 := NewRowChange(source, nil, nil, []interface{}{1, 2, 3}, ti, nil, nil)
+	change2 := NewRowChange(source, nil, nil, []interface{}{4, 5, 6}, ti, nil, nil)
+	sql, args := GenPostgresUpsertSQL(change1, change2)
+	require.Equal(t, `INSERT INTO "db"."tb" ("id","c") VALUES ($1,$2),($3,$4) `+
+		`ON CONFLICT ("id") DO UPDATE SET "c"=EXCLUDED."c"`, sql)
+	require.Equal(t, []interface{}{1, 2, 4, 5}, args)
+
+	// all columns are part of the key.
+	ti = mockTableInfo(t, "CREATE TABLE tb (a INT, b INT, PRIMARY KEY (a, b))")
+	change := NewRowChange(source, nil, nil, []interface{}{1, 2}, ti, nil, nil)
+	sql, args = GenPostgresUpsertSQL(change)
+	require.Equal(t, `INSERT INTO "db"."tb" ("a","b") VALUES ($1,$2) ON CONFLICT ("a","b") DO NOTHING`, sql)
+	require.Equal(t, []interface{}{1, 2}, args)
+
+	// no key.
+	ti = mockTableInfo(t, "CREATE TABLE tb (a INT, b INT, UNIQUE KEY (a))")
+	change = NewRowChange(source, nil, nil, []interface{}{1, 2}, ti, nil, nil)
+	sql, args = GenPostgresUpsertSQL(change)
+	require.Equal(t, `INSERT INTO "db"."tb" ("a","b") VALUES ($1,$2)`, sql)
+	require.Equal(t, []interface{}{1, 2}, args)
+}
+
+func TestGenPostgresInsertSQL(t *testing.T) {
+	t.Parallel()
+
+	source := &cdcmodel.TableName{Schema: "db", Table: "tb"}
+	ti := mockTableInfo(t, "CREATE TABLE tb (id INT PRIMARY KEY, c INT, c2 INT AS (c + 1) STORED)")
+
+	change1 := NewRowChange(source, nil, nil, []interface{}{1, 2, 3}, ti, nil, nil)
+	change2 := NewRowChange(source, nil, nil, []interface{}{4, 5, 6}, ti, nil, nil)
+	sql, args := GenPostgresInsertSQL(change1, change2)
+	require.Equal(t, `INSERT INTO "db"."tb" ("id","c") VALUES ($1,$2),($3,$4)`, sql)
+	require.Equal(t, []interface{}{1, 2, 4, 5}, args)
+}
+
+func TestGenPostgresUpdateSQL(t *testing.T) {
+	t.Parallel()
+
+	source := &cdcmodel.TableName{Schema: "db", Table: "tb"}
+	ti := mockTableInfo(t, "CREATE TABLE tb (id INT PRIMARY KEY, c INT, c2 INT AS (c + 1) STORED)")
+
+	change := NewRowChange(source, nil, []interface{}{1, 2, 3}, []interface{}{1, 3, 4}, ti, nil, nil)
+	sql, args := GenPostgresUpdateSQL(change)
+	require.Equal(t, `UPDATE "db"."tb" SET "id"=$1,"c"=$2 WHERE "id" = $3`, sql)
+	require.Equal(t, []interface{}{1, 3, 1}, args)
+
+	// no key.
+	ti = mockTableInfo(t, "CREATE TABLE tb (a INT, b INT)")
+	change = NewRowChange(source, nil, []interface{}{1, nil}, []interface{}{1, 2}, ti, nil, nil)
+	sql, args = GenPostgresUpdateSQL(change)
+	require.Equal(t, `UPDATE "db"."tb" SET "a"=$1,"b"=$2 WHERE ctid = `+
+		`(SELECT ctid FROM "db"."tb" WHERE "a" = $3 AND "b" IS NULL LIMIT 1)`, sql)
+	require.Equal(t, []interface{}{1, 2, 1}, args)
+}
+
+func TestGenPostgresDeleteSQL(t *testing.T) {
+	t.Parallel()
+
+	source := &cdcmodel.TableName{Schema: "db", Table: "tb"}
+	ti := mockTableInfo(t, "CREATE TABLE tb (a INT NOT NULL, b INT, c INT, UNIQUE KEY (a, b))")
+
+	// a nullable unique key is used when the values are not NULL.
+	change1 := NewRowChange(source, nil, []interface{}{1, 2, 3}, nil, ti, nil, nil)
+	change2 := NewRowChange(source, nil, []interface{}{4, 5, 6}, nil, ti, nil, nil)
+	sql, args := GenPostgresDeleteSQL(change1, change2)
+	require.Equal(t, `DELETE FROM "db"."tb" WHERE ("a" = $1 AND "b" = $2) OR ("a" = $3 AND "b" = $4)`, sql)
+	require.Equal(t, []interface{}{1, 2, 4, 5}, args)
+
+	// otherwise the row is located by its ctid.
+	change := NewRowChange(source, nil, []interface{}{1, nil, 3}, nil, ti, nil, nil)
+	sql, args = GenPostgresDeleteSQL(change)
+	require.Equal(t, `DELETE FROM "db"."tb" WHERE (ctid = (SELECT ctid FROM "db"."tb" `+
+		`WHERE "a" = $1 AND "b" IS NULL AND "c" = $2 LIMIT 1))`, sql)
+	require.Equal(t, []interface{}{1, 3}, args)
+}
@@ -0,0 +1,265 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/pkg/config"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// Stages of the capture pipeline, each of them is recorded as a span
+// of the sampled transaction.
+const (
+	StageKVReceive   = "kv-receive"
+	StageSorterWrite = "sorter-write"
+	StageSorterRead  = "sorter-read"
+	StageMount       = "mount"
+	StageEncode      = "encode"
+	StageSinkFlush   = "sink-flush"
+)
+
+// TraceParentHeader is the W3C trace context header carried by the messages
+// sent to the message queue.
+const TraceParentHeader = "traceparent"
+
+const tracerName = "github.com/pingcap/tiflow"
+
+var (
+	enabled atomic.Bool
+	// sampleBound is compared with the hash of commitTs to decide whether
+	// a transaction is sampled.
+	sampleBound atomic.Uint64
+	tracer      atomic.Value
+)
+
+// Init sets up the OTLP exporter and enables tracing if it's enabled in the
+// config. The returned function flushes the pending spans and stops tracing.
+func Init(ctx context.Context, cfg *config.TracingConfig, captureAddr string) (func(context.Context) error, error) {
+	if cfg == nil || !cfg.Enable {
+		return func(context.Context) error { return nil }, nil
+	}
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Endpoint)}
+	if cfg.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	res := resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName("ticdc"),
+		semconv.ServiceInstanceID(captureAddr))
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		// The sampling decision is made by Sampled, spans are only created
+		// for sampled transactions.
+		sdktrace.WithSampler(sdktrace.AlwaysSample()),
+	)
+	enable(provider, cfg.SampleRatio)
+	log.Info("tracing enabled",
+		zap.String("endpoint", cfg.Endpoint),
+		zap.Float64("sampleRatio", cfg.SampleRatio))
+
+	return func(ctx context.Context) error {
+		enabled.Store(false)
+		return errors.Trace(provider.Shutdown(ctx))
+	}, nil
+}
+
+func enable(provider trace.TracerProvider, sampleRatio float64) {
+	bound := uint64(math.MaxUint64)
+	if sampleRatio < 1 {
+		bound = uint64(sampleRatio * math.MaxUint64)
+	}
+	sampleBound.Store(bound)
+	tracer.Store(provider.Tracer(tracerName))
+	enabled.Store(true)
+}
+
+// mix64 scatters the commitTs, whose low bits are mostly zero,
+// so that the sampling is uniform.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+// Sampled returns whether the transaction with the commitTs is traced.
+// It's deterministic, so that all stages make the same decision without
+// passing the trace context along with the events.
+func Sampled(commitTs uint64) bool {
+	if !enabled.Load() || commitTs == 0 {
+		return false
+	}
+	return mix64(commitTs) <= sampleBound.Load()
+}
+
+// txnSpanContext returns the span context of the root span of the transaction.
+// All stages of the transaction share the same trace id derived from the commitTs.
+func txnSpanContext(commitTs uint64) trace.SpanContext {
+	var (
+		traceID trace.TraceID
+		spanID  trace.SpanID
+	)
+	binary.BigEndian.PutUint64(traceID[:8], commitTs)
+	binary.BigEndian.PutUint64(traceID[8:], mix64(commitTs))
+	binary.BigEndian.PutUint64(spanID[:], mix64(^commitTs)|1)
+	return trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+		Remote:     true,
+	})
+}
+
+// RecordTxnSpan records a span of the stage for the transaction, which starts
+// at the given time and ends now. It's a no-op if the transaction is not sampled.
+func RecordTxnSpan(
+	ctx context.Context, stage string, commitTs uint64, start time.Time, attrs ...attribute.KeyValue,
+) {
+	if !Sampled(commitTs) {
+		return
+	}
+	recordSpan(ctx, stage, commitTs, start, time.Now(), attrs...)
+}
+
+func recordSpan(
+	ctx context.Context, stage string, commitTs uint64, start, end time.Time, attrs ...attribute.KeyValue,
+) {
+	ctx = trace.ContextWithRemoteSpanContext(ctx, txnSpanContext(commitTs))
+	attrs = append(attrs, attribute.Int64("commit-ts", int64(commitTs)))
+	_, span := tracer.Load().(trace.Tracer).Start(ctx, stage,
+		trace.WithTimestamp(start), trace.WithAttributes(attrs...))
+	span.End(trace.WithTimestamp(end))
+}
+
+// TraceParent returns the W3C trace context of the transaction,
+// it returns an empty string if the transaction is not sampled.
+func TraceParent(commitTs uint64) string {
+	if !Sampled(commitTs) {
+		return ""
+	}
+	sc := txnSpanContext(commitTs)
+	return fmt.Sprintf("00-%s-%s-%s", sc.TraceID(), sc.SpanID(), sc.TraceFlags())
+}
+
+// PendingTxns tracks the sampled transactions which are written to a sink
+// but not flushed yet, it records the flush span once the checkpoint of the
+// sink passes the transaction.
+type PendingTxns struct {
+	mu   sync.Mutex
+	txns []pendingTxn
+}
+
+type pendingTxn struct {
+	commitTs uint64
+	start    time.Time
+}
+
+// Add tracks the transaction if it's sampled.
+func (p *PendingTxns) Add(commitTs uint64) {
+	if !Sampled(commitTs) {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if n := len(p.txns); n > 0 && p.txns[n-1].commitTs == commitTs {
+		return
+	}
+	p.txns = append(p.txns, pendingTxn{commitTs: commitTs, start: time.Now()})
+}
+
+// Flush records the stage span for transactions with commitTs less than
+// or equal to the checkpointTs.
+func (p *PendingTxns) Flush(ctx context.Context, stage string, checkpointTs uint64, attrs ...attribute.KeyValue) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	i := 0
+	for ; i < len(p.txns) && p.txns[i].commitTs <= checkpointTs; i++ {
+		RecordTxnSpan(ctx, stage, p.txns[i].commitTs, p.txns[i].start, attrs...)
+	}
+	p.txns = p.txns[i:]
+}
+
+// TxnSpans aggregates the rows of the sampled transactions processed by a
+// stage, so that only one span is recorded for each transaction. The span
+// starts when the first row enters the stage and ends when the last row
+// leaves it.
+type TxnSpans struct {
+	mu   sync.Mutex
+	txns map[uint64]*txnSpan
+}
+
+type txnSpan struct {
+	start time.Time
+	end   time.Time
+}
+
+// Add records that a row of the transaction is processed from start to end.
+// It's a no-op if the transaction is not sampled.
+func (s *TxnSpans) Add(commitTs uint64, start, end time.Time) {
+	if !Sampled(commitTs) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.txns == nil {
+		s.txns = make(map[uint64]*txnSpan)
+	}
+	txn, ok := s.txns[commitTs]
+	if !ok {
+		s.txns[commitTs] = &txnSpan{start: start, end: end}
+		return
+	}
+	if start.Before(txn.start) {
+		txn.start = start
+	}
+	if end.After(txn.end) {
+		txn.end = end
+	}
+}
+
+// Flush records the stage span for transactions with commitTs less than
+// or equal to the resolvedTs, all rows of them must have been added.
+func (s *TxnSpans) Flush(ctx context.Context, stage string, resolvedTs uint64, attrs ...attribute.KeyValue) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for commitTs, txn := range s.txns {
+		if commitTs > resolvedTs {
+			continue
+		}
+		recordSpan(ctx, stage, commitTs, txn.start, txn.end, attrs...)
+		delete(s.txns, commitTs)
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestSampled(t *testing.T) {
+	enabled.Store(false)
+	require.False(t, Sampled(100))
+	require.Empty(t, TraceParent(100))
+
+	provider := sdktrace.NewTracerProvider()
+	defer enabled.Store(false)
+	enable(provider, 0)
+	sampled := 0
+	for ts := uint64(1); ts <= 10000; ts++ {
+		if Sampled(ts << 18) {
+			sampled++
+		}
+	}
+	require.Equal(t, 0, sampled)
+
+	enable(provider, 0.1)
+	for ts := uint64(1); ts <= 10000; ts++ {
+		if Sampled(ts << 18) {
+			sampled++
+		}
+	}
+	// the commitTs is scattered, so the sampling is roughly uniform.
+	require.InDelta(t, 1000, sampled, 200)
+
+	enable(provider, 1)
+	require.True(t, Sampled(1<<18))
+	require.False(t, Sampled(0))
+}
+
+func TestRecordTxnSpan(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	defer enabled.Store(false)
+	enable(provider, 1)
+
+	ctx := context.Background()
+	commitTs := uint64(449000000000000000)
+	start := time.Now()
+	RecordTxnSpan(ctx, StageMount, commitTs, start)
+	RecordTxnSpan(ctx, StageEncode, commitTs, start)
+	RecordTxnSpan(ctx, StageEncode, commitTs+1, start)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 3)
+	require.Equal(t, StageMount, spans[0].Name())
+	require.Equal(t, start, spans[0].StartTime())
+	// spans of the same transaction share the trace id.
+	require.Equal(t, spans[0].SpanContext().TraceID(), spans[1].SpanContext().TraceID())
+	require.Equal(t, spans[0].Parent().SpanID(), spans[1].Parent().SpanID())
+	require.NotEqual(t, spans[0].SpanContext().TraceID(), spans[2].SpanContext().TraceID())
+
+	traceParent := TraceParent(commitTs)
+	require.True(t, strings.HasPrefix(traceParent,
+		"00-"+spans[0].SpanContext().TraceID().String()+"-"+spans[0].Parent().SpanID().String()))
+	require.True(t, strings.HasSuffix(traceParent, "-01"))
+}
+
+func TestPendingTxns(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	defer enabled.Store(false)
+	enable(provider, 1)
+
+	ctx := context.Background()
+	p := &PendingTxns{}
+	p.Add(10)
+	p.Add(10)
+	p.Add(20)
+	p.Add(30)
+
+	p.Flush(ctx, StageSinkFlush, 5)
+	require.Len(t, recorder.Ended(), 0)
+	p.Flush(ctx, StageSinkFlush, 20)
+	require.Len(t, recorder.Ended(), 2)
+	p.Flush(ctx, StageSinkFlush, 30)
+	spans := recorder.Ended()
+	require.Len(t, spans, 3)
+	for _, span := range spans {
+		require.Equal(t, StageSinkFlush, span.Name())
+	}
+	require.Empty(t, p.txns)
+}
+
+func TestTxnSpans(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	defer enabled.Store(false)
+	enable(provider, 1)
+
+	ctx := context.Background()
+	s := &TxnSpans{}
+	base := time.Now()
+	// rows of the transaction 10 are interleaved with the transaction 20.
+	s.Add(10, base, base.Add(time.Second))
+	s.Add(20, base.Add(time.Second), base.Add(2*time.Second))
+	s.Add(10, base.Add(2*time.Second), base.Add(3*time.Second))
+
+	s.Flush(ctx, StageKVReceive, 5)
+	require.Len(t, recorder.Ended(), 0)
+	s.Flush(ctx, StageKVReceive, 10)
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	require.Equal(t, StageKVReceive, spans[0].Name())
+	require.Equal(t, base, spans[0].StartTime())
+	require.Equal(t, base.Add(3*time.Second), spans[0].EndTime())
+
+	s.Flush(ctx, StageKVReceive, 30)
+	require.Len(t, recorder.Ended(), 2)
+	require.Empty(t, s.txns)
+}
@@ -0,0 +1,357 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transform
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/charset"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/types"
+	filter "github.com/pingcap/tidb/pkg/util/table-filter"
+	cdcmodel "github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/errors"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+// commitTimeLayout is the layout of the commit-time computed column,
+// it's the same as the format of a datetime(3) value produced by the mounter.
+const commitTimeLayout = "2006-01-02 15:04:05.000"
+
+type rule struct {
+	cfg     *config.TransformRule
+	tableF  filter.Filter
+	columnM filter.ColumnFilter
+}
+
+func newRule(cfg *config.TransformRule, caseSensitive bool) (*rule, error) {
+	tableF, err := filter.Parse(cfg.Matcher)
+	if err != nil {
+		return nil, errors.WrapError(errors.ErrFilterRuleInvalid, err, cfg.Matcher)
+	}
+	if !caseSensitive {
+		tableF = filter.CaseInsensitive(tableF)
+	}
+	r := &rule{cfg: cfg, tableF: tableF}
+	// the columns of an add-column rule are the names of new columns.
+	if cfg.Action != config.TransformActionAddColumn {
+		r.columnM, err = filter.ParseColumnFilter(cfg.Columns)
+		if err != nil {
+			return nil, errors.WrapError(errors.ErrFilterRuleInvalid, err, cfg.Columns)
+		}
+	}
+	return r, nil
+}
+
+// transform returns the transformed value of a string column.
+func (r *rule) transform(value interface{}, binary bool) interface{} {
+	var b []byte
+	switch v := value.(type) {
+	case []byte:
+		b = v
+	case string:
+		b = []byte(v)
+	default:
+		// NULL values are kept as is.
+		return value
+	}
+
+	switch r.cfg.Action {
+	case config.TransformActionHash:
+		h := sha256.New()
+		if r.cfg.Salt != nil {
+			h.Write([]byte(*r.cfg.Salt))
+		}
+		h.Write(b)
+		return []byte(hex.EncodeToString(h.Sum(nil)))
+	case config.TransformActionRedact:
+		n := len(b)
+		if !binary {
+			n = utf8.RuneCount(b)
+		}
+		return []byte(strings.Repeat("*", n))
+	case config.TransformActionTruncate:
+		length := *r.cfg.Length
+		if binary {
+			if len(b) > length {
+				b = b[:length]
+			}
+			return b
+		}
+		for i := range string(b) {
+			if length == 0 {
+				return b[:i]
+			}
+			length--
+		}
+		return b
+	case config.TransformActionReplace:
+		return []byte(*r.cfg.Value)
+	}
+	return value
+}
+
+type computedColumn struct {
+	id     int64
+	source string
+}
+
+// tableTransform is the compiled transform of a table.
+type tableTransform struct {
+	// source is the table info the transform is built from.
+	source *cdcmodel.TableInfo
+	// target is the table info of the transformed rows, it differs from
+	// source only if there are computed columns.
+	target *cdcmodel.TableInfo
+	// columns maps the column ID to the rule used to transform it.
+	columns map[int64]*rule
+	// binary records the columns whose values are transformed byte by byte.
+	binary   map[int64]bool
+	computed []computedColumn
+}
+
+func (t *tableTransform) isEmpty() bool {
+	return len(t.columns) == 0 && len(t.computed) == 0
+}
+
+// Transformer applies the column transform rules of a changefeed to
+// row changed events. It's safe for concurrent use.
+type Transformer struct {
+	rules     []*rule
+	clusterID uint64
+	tz        *time.Location
+
+	mu sync.RWMutex
+	// tables caches the compiled transforms, keyed by the logical table ID.
+	tables map[int64]*tableTransform
+}
+
+// New creates a Transformer for the given replica config.
+// It returns nil if no transform rule is configured.
+func New(cfg *config.ReplicaConfig, clusterID uint64, tz *time.Location) (*Transformer, error) {
+	if len(cfg.Transforms) == 0 {
+		return nil, nil
+	}
+	rules := make([]*rule, 0, len(cfg.Transforms))
+	for _, r := range cfg.Transforms {
+		rule, err := newRule(r, cfg.CaseSensitive)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return &Transformer{
+		rules:     rules,
+		clusterID: clusterID,
+		tz:        tz,
+		tables:    make(map[int64]*tableTransform),
+	}, nil
+}
+
+// Apply transforms the given row changed event in place.
+func (tr *Transformer) Apply(row *cdcmodel.RowChangedEvent) error {
+	t, err := tr.getTableTransform(row.TableInfo)
+	if err != nil {
+		return err
+	}
+	if t.isEmpty() {
+		return nil
+	}
+
+	for _, columns := range [][]*cdcmodel.ColumnData{row.Columns, row.PreColumns} {
+		for _, col := range columns {
+			if col == nil {
+				continue
+			}
+			if r, ok := t.columns[col.ColumnID]; ok {
+				col.Value = r.transform(col.Value, t.binary[col.ColumnID])
+			}
+		}
+	}
+
+	for _, c := range t.computed {
+		value := tr.computedValue(c.source, row)
+		if len(row.Columns) != 0 {
+			row.Columns = append(row.Columns, &cdcmodel.ColumnData{ColumnID: c.id, Value: value})
+		}
+		if len(row.PreColumns) != 0 {
+			row.PreColumns = append(row.PreColumns, &cdcmodel.ColumnData{ColumnID: c.id, Value: value})
+		}
+	}
+	row.TableInfo = t.target
+	// the upstream checksum does not match the transformed row anymore.
+	row.Checksum = nil
+	return nil
+}
+
+// VerifyTables returns an error if the transform rules
+// cannot be applied to any of the given tables.
+func (tr *Transformer) VerifyTables(infos []*cdcmodel.TableInfo) error {
+	for _, info := range infos {
+		if _, err := tr.build(info); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (tr *Transformer) computedValue(source string, row *cdcmodel.RowChangedEvent) interface{} {
+	switch source {
+	case config.ComputedColumnClusterID:
+		return tr.clusterID
+	case config.ComputedColumnCommitTs:
+		return row.CommitTs
+	case config.ComputedColumnCommitTime:
+		return oracle.GetTimeFromTS(row.CommitTs).In(tr.tz).Format(commitTimeLayout)
+	}
+	return nil
+}
+
+func (tr *Transformer) getTableTransform(info *cdcmodel.TableInfo) (*tableTransform, error) {
+	tr.mu.RLock()
+	t, ok := tr.tables[info.ID]
+	tr.mu.RUnlock()
+	// the table info changes after a DDL, rebuild the transform in this case.
+	if ok && t.source == info {
+		return t, nil
+	}
+
+	t, err := tr.build(info)
+	if err != nil {
+		return nil, err
+	}
+	tr.mu.Lock()
+	tr.tables[info.ID] = t
+	tr.mu.Unlock()
+	return t, nil
+}
+
+func (tr *Transformer) build(info *cdcmodel.TableInfo) (*tableTransform, error) {
+	t := &tableTransform{
+		source:  info,
+		target:  info,
+		columns: make(map[int64]*rule),
+		binary:  make(map[int64]bool),
+	}
+	schema, table := info.GetSchemaName(), info.GetTableName()
+	var newColumns []*model.ColumnInfo
+	for _, r := range tr.rules {
+		if !r.tableF.MatchTable(schema, table) {
+			continue
+		}
+		if r.cfg.Action == config.TransformActionAddColumn {
+			col, err := newComputedColumnInfo(info, r.cfg.Columns[0], *r.cfg.Value, newColumns)
+			if err != nil {
+				return nil, err
+			}
+			newColumns = append(newColumns, col)
+			t.computed = append(t.computed, computedColumn{id: col.ID, source: *r.cfg.Value})
+			continue
+		}
+		for _, col := range info.Columns {
+			if !cdcmodel.IsColCDCVisible(col) || !r.columnM.MatchColumn(col.Name.O) {
+				continue
+			}
+			// a column is transformed by the first rule matches it.
+			if _, ok := t.columns[col.ID]; ok {
+				continue
+			}
+			if err := verifyColumn(info, col, r.cfg.Action); err != nil {
+				return nil, err
+			}
+			t.columns[col.ID] = r
+			t.binary[col.ID] = col.GetCharset() == charset.CharsetBin
+		}
+	}
+
+	if len(newColumns) != 0 {
+		cloned := info.TableInfo.Clone()
+		for _, col := range newColumns {
+			col.Offset = len(cloned.Columns)
+			cloned.Columns = append(cloned.Columns, col)
+			cloned.MaxColumnID = col.ID
+		}
+		t.target = cdcmodel.WrapTableInfo(info.SchemaID, info.TableName.Schema, info.Version, cloned)
+	}
+	return t, nil
+}
+
+// verifyColumn checks whether the action can be applied to the column.
+func verifyColumn(info *cdcmodel.TableInfo, col *model.ColumnInfo, action config.TransformAction) error {
+	switch col.GetType() {
+	case mysql.TypeString, mysql.TypeVarString, mysql.TypeVarchar,
+		mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeBlob:
+	default:
+		return errors.ErrColumnTransformFailed.GenWithStack(
+			"only string columns can be transformed, table: %s, column: %s",
+			info.TableName.String(), col.Name.O)
+	}
+	// only the hash action keeps the values distinct, other actions
+	// would make different rows have the same key.
+	flag := info.ForceGetColumnFlagType(col.ID)
+	if action != config.TransformActionHash && (flag.IsPrimaryKey() || flag.IsUniqueKey()) {
+		return errors.ErrColumnTransformFailed.GenWithStack(
+			"the %s action cannot be applied to a primary key or unique key column, "+
+				"table: %s, column: %s", action, info.TableName.String(), col.Name.O)
+	}
+	return nil
+}
+
+func newComputedColumnInfo(
+	info *cdcmodel.TableInfo, name, source string, added []*model.ColumnInfo,
+) (*model.ColumnInfo, error) {
+	maxID := info.MaxColumnID
+	for _, col := range info.Columns {
+		if strings.EqualFold(col.Name.O, name) {
+			return nil, errors.ErrColumnTransformFailed.GenWithStack(
+				"the computed column %s already exists in table %s", name, info.TableName.String())
+		}
+		maxID = max(maxID, col.ID)
+	}
+	for _, col := range added {
+		if strings.EqualFold(col.Name.O, name) {
+			return nil, errors.ErrColumnTransformFailed.GenWithStack(
+				"the computed column %s is added more than once to table %s", name, info.TableName.String())
+		}
+		maxID = max(maxID, col.ID)
+	}
+
+	var ft *types.FieldType
+	switch source {
+	case config.ComputedColumnClusterID, config.ComputedColumnCommitTs:
+		ft = types.NewFieldType(mysql.TypeLonglong)
+		ft.AddFlag(mysql.UnsignedFlag | mysql.NotNullFlag)
+	case config.ComputedColumnCommitTime:
+		ft = types.NewFieldType(mysql.TypeDatetime)
+		ft.SetDecimal(3)
+		ft.AddFlag(mysql.NotNullFlag)
+	default:
+		return nil, errors.ErrColumnTransformFailed.GenWithStack(
+			"unknown computed column source %s", source)
+	}
+	return &model.ColumnInfo{
+		ID:        maxID + 1,
+		Name:      ast.NewCIStr(name),
+		State:     model.StatePublic,
+		FieldType: *ft,
+	}, nil
+}
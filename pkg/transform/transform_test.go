@@ -0,0 +1,215 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transform
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tidb/pkg/parser/charset"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/integrity"
+	"github.com/pingcap/tiflow/pkg/util"
+	"github.com/stretchr/testify/require"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+func newTestTableInfo() *model.TableInfo {
+	return model.BuildTableInfo("test", "users", []*model.Column{
+		{
+			Name: "id",
+			Type: mysql.TypeLonglong,
+			Flag: model.HandleKeyFlag | model.PrimaryKeyFlag,
+		},
+		{Name: "name", Type: mysql.TypeVarchar, Charset: charset.CharsetUTF8MB4},
+		{Name: "email", Type: mysql.TypeVarchar, Charset: charset.CharsetUTF8MB4},
+		{Name: "phone", Type: mysql.TypeVarchar, Charset: charset.CharsetUTF8MB4},
+		{Name: "avatar", Type: mysql.TypeBlob, Charset: charset.CharsetBin, Flag: model.BinaryFlag},
+		{Name: "age", Type: mysql.TypeLong},
+		{Name: "code", Type: mysql.TypeVarchar, Charset: charset.CharsetUTF8MB4, Flag: model.UniqueKeyFlag},
+	}, [][]int{{0}, {6}})
+}
+
+func newTestRow(tableInfo *model.TableInfo, commitTs uint64) *model.RowChangedEvent {
+	return &model.RowChangedEvent{
+		CommitTs:  commitTs,
+		TableInfo: tableInfo,
+		Columns: model.Columns2ColumnDatas([]*model.Column{
+			{Name: "id", Value: int64(1)},
+			{Name: "name", Value: []byte("张三丰")},
+			{Name: "email", Value: []byte("foo@example.com")},
+			{Name: "phone", Value: nil},
+			{Name: "avatar", Value: []byte{0x01, 0x02, 0x03}},
+			{Name: "age", Value: int64(20)},
+			{Name: "code", Value: []byte("a1")},
+		}, tableInfo),
+		Checksum: &integrity.Checksum{Current: 1},
+	}
+}
+
+func TestNewWithoutRules(t *testing.T) {
+	t.Parallel()
+
+	transformer, err := New(config.GetDefaultReplicaConfig(), 1, time.UTC)
+	require.NoError(t, err)
+	require.Nil(t, transformer)
+
+	cfg := config.GetDefaultReplicaConfig()
+	cfg.Transforms = []*config.TransformRule{
+		{Matcher: []string{"test.[a"}, Columns: []string{"name"}, Action: config.TransformActionRedact},
+	}
+	_, err = New(cfg, 1, time.UTC)
+	require.Regexp(t, ".*CDC:ErrFilterRuleInvalid.*", err)
+}
+
+func TestApplyMaskActions(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.GetDefaultReplicaConfig()
+	cfg.Transforms = []*config.TransformRule{
+		{
+			Matcher: []string{"test.users"}, Columns: []string{"email"},
+			Action: config.TransformActionHash, Salt: util.AddressOf("salt"),
+		},
+		// name is matched by the first rule only.
+		{
+			Matcher: []string{"test.*"}, Columns: []string{"name", "phone"},
+			Action: config.TransformActionRedact,
+		},
+		{
+			Matcher: []string{"test.*"}, Columns: []string{"name"},
+			Action: config.TransformActionReplace, Value: util.AddressOf("x"),
+		},
+		{
+			Matcher: []string{"test.*"}, Columns: []string{"avatar"},
+			Action: config.TransformActionTruncate, Length: util.AddressOf(2),
+		},
+		{
+			Matcher: []string{"other.*"}, Columns: []string{"*"},
+			Action: config.TransformActionReplace, Value: util.AddressOf("x"),
+		},
+	}
+	transformer, err := New(cfg, 1, time.UTC)
+	require.NoError(t, err)
+
+	tableInfo := newTestTableInfo()
+	row := newTestRow(tableInfo, 100)
+	row.PreColumns = newTestRow(tableInfo, 100).Columns
+	require.NoError(t, transformer.Apply(row))
+
+	digest := sha256.Sum256([]byte("saltfoo@example.com"))
+	for _, columns := range [][]*model.ColumnData{row.Columns, row.PreColumns} {
+		require.Equal(t, int64(1), columns[0].Value)
+		require.Equal(t, []byte("***"), columns[1].Value)
+		require.Equal(t, []byte(hex.EncodeToString(digest[:])), columns[2].Value)
+		require.Nil(t, columns[3].Value)
+		require.Equal(t, []byte{0x01, 0x02}, columns[4].Value)
+		require.Equal(t, int64(20), columns[5].Value)
+		require.Equal(t, []byte("a1"), columns[6].Value)
+	}
+	require.Same(t, tableInfo, row.TableInfo)
+	require.Nil(t, row.Checksum)
+}
+
+func TestApplyTruncate(t *testing.T) {
+	t.Parallel()
+
+	r := &rule{cfg: &config.TransformRule{
+		Action: config.TransformActionTruncate, Length: util.AddressOf(2),
+	}}
+	require.Equal(t, []byte("张三"), r.transform([]byte("张三丰"), false))
+	require.Equal(t, []byte("ab"), r.transform("ab", false))
+	require.Equal(t, []byte{0xe5, 0xbc}, r.transform([]byte("张三丰"), true))
+	require.Nil(t, r.transform(nil, false))
+}
+
+func TestApplyAddColumn(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.GetDefaultReplicaConfig()
+	cfg.Transforms = []*config.TransformRule{
+		{
+			Matcher: []string{"test.users"}, Columns: []string{"_cluster_id"},
+			Action: config.TransformActionAddColumn, Value: util.AddressOf(config.ComputedColumnClusterID),
+		},
+		{
+			Matcher: []string{"test.users"}, Columns: []string{"_commit_ts"},
+			Action: config.TransformActionAddColumn, Value: util.AddressOf(config.ComputedColumnCommitTs),
+		},
+		{
+			Matcher: []string{"test.users"}, Columns: []string{"_commit_time"},
+			Action: config.TransformActionAddColumn, Value: util.AddressOf(config.ComputedColumnCommitTime),
+		},
+	}
+	transformer, err := New(cfg, 7, time.UTC)
+	require.NoError(t, err)
+
+	tableInfo := newTestTableInfo()
+	commitTs := oracle.GoTimeToTS(time.Date(2024, 1, 2, 3, 4, 5, 6e6, time.UTC))
+	row := newTestRow(tableInfo, commitTs)
+	require.NoError(t, transformer.Apply(row))
+
+	require.Len(t, row.Columns, 10)
+	require.Empty(t, row.PreColumns)
+	require.NotSame(t, tableInfo, row.TableInfo)
+	require.Len(t, tableInfo.Columns, 7)
+	require.Equal(t, "_cluster_id", row.TableInfo.ForceGetColumnName(row.Columns[7].ColumnID))
+	require.Equal(t, uint64(7), row.Columns[7].Value)
+	require.Equal(t, "_commit_ts", row.TableInfo.ForceGetColumnName(row.Columns[8].ColumnID))
+	require.Equal(t, commitTs, row.Columns[8].Value)
+	require.Equal(t, "_commit_time", row.TableInfo.ForceGetColumnName(row.Columns[9].ColumnID))
+	require.Equal(t, "2024-01-02 03:04:05.006", row.Columns[9].Value)
+	require.Equal(t, 9, row.TableInfo.RowColumnsOffset[row.Columns[9].ColumnID])
+	require.True(t, row.TableInfo.ForceGetColumnFlagType(row.Columns[8].ColumnID).IsUnsigned())
+
+	// the derived table info is reused by rows of the same table version.
+	next := newTestRow(tableInfo, commitTs)
+	next.PreColumns, next.Columns = next.Columns, nil
+	require.NoError(t, transformer.Apply(next))
+	require.Same(t, row.TableInfo, next.TableInfo)
+	require.Len(t, next.PreColumns, 10)
+	require.Empty(t, next.Columns)
+}
+
+func TestVerifyTables(t *testing.T) {
+	t.Parallel()
+
+	testCases := []*config.TransformRule{
+		// only string columns can be transformed.
+		{Matcher: []string{"test.users"}, Columns: []string{"age"}, Action: config.TransformActionHash},
+		// only the hash action can be applied to the unique key.
+		{Matcher: []string{"test.users"}, Columns: []string{"code"}, Action: config.TransformActionRedact},
+		// the computed column already exists.
+		{
+			Matcher: []string{"test.users"}, Columns: []string{"Name"},
+			Action: config.TransformActionAddColumn, Value: util.AddressOf(config.ComputedColumnCommitTs),
+		},
+	}
+	for _, rule := range testCases {
+		cfg := config.GetDefaultReplicaConfig()
+		cfg.Transforms = []*config.TransformRule{rule}
+		transformer, err := New(cfg, 1, time.UTC)
+		require.NoError(t, err)
+		err = transformer.VerifyTables([]*model.TableInfo{newTestTableInfo()})
+		require.True(t, errors.ErrColumnTransformFailed.Equal(err), err)
+
+		err = transformer.Apply(newTestRow(newTestTableInfo(), 1))
+		require.True(t, errors.ErrColumnTransformFailed.Equal(err), err)
+	}
+}